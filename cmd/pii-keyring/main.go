@@ -0,0 +1,284 @@
+// Command pii-keyring はユーザー・トークン・招待の名前とメールアドレスの暗号化に使う鍵リングを管理する
+//
+//	pii-keyring generate          新しい鍵リングを作成する
+//	pii-keyring rotate            新しい主鍵を追加する（サーバーの再起動後、再暗号化のジョブが古い鍵のデータキーを暗号化し直す）
+//	pii-keyring retire <key-id>   再暗号化が完了した古い鍵を削除する
+//	pii-keyring encrypt-users     暗号化前のスキーマのユーザー・トークン・招待の名前とメールアドレスを暗号化する（移行用）
+//
+// 鍵リングのファイルは -file（既定は PII_KEYRING_FILE、未設定の場合は .keys/pii-keyring.json）。
+// encrypt-users はサーバーと同じ DB_HOST / DB_USER / DB_PASSWORD / DB_NAME / DB_SSLMODE のデータベースに接続する。
+package main
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/example/go-react-cqrs-template/internal/infrastructure"
+	"github.com/example/go-react-cqrs-template/internal/pii"
+	"github.com/example/go-react-cqrs-template/internal/tenant"
+)
+
+// encryptUsersBatchSize 1トランザクションで暗号化するユーザー（トークン・招待）の最大件数
+const encryptUsersBatchSize = 100
+
+func main() {
+	file := flag.String("file", getEnv("PII_KEYRING_FILE", filepath.Join(".keys", "pii-keyring.json")), "keyring file")
+	flag.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), "usage: pii-keyring [-file path] generate | rotate | retire <key-id> | encrypt-users")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	if err := run(*file, flag.Args()); err != nil {
+		fmt.Fprintln(os.Stderr, "pii-keyring:", err)
+		os.Exit(1)
+	}
+}
+
+// run サブコマンドを実行する
+func run(path string, args []string) error {
+	if len(args) == 0 {
+		flag.Usage()
+		return errors.New("missing command")
+	}
+	switch cmd := args[0]; cmd {
+	case "generate":
+		if _, err := os.Stat(path); err == nil {
+			return fmt.Errorf("%s already exists", path)
+		}
+		keyring, err := pii.GenerateKeyring()
+		if err != nil {
+			return err
+		}
+		if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+			return err
+		}
+		if err := keyring.WriteFile(path); err != nil {
+			return err
+		}
+		fmt.Printf("generated %s (primary key %s)\n", path, keyring.PrimaryKeyID())
+		return nil
+	case "rotate":
+		keyring, err := pii.LoadKeyring(path)
+		if err != nil {
+			return err
+		}
+		rotated, err := keyring.Rotate()
+		if err != nil {
+			return err
+		}
+		if err := rotated.WriteFile(path); err != nil {
+			return err
+		}
+		fmt.Printf("rotated %s (primary key %s, previous %s)\n", path, rotated.PrimaryKeyID(), keyring.PrimaryKeyID())
+		return nil
+	case "retire":
+		if len(args) != 2 {
+			return errors.New("usage: pii-keyring retire <key-id>")
+		}
+		keyring, err := pii.LoadKeyring(path)
+		if err != nil {
+			return err
+		}
+		retired, err := keyring.Retire(args[1])
+		if err != nil {
+			return err
+		}
+		if err := retired.WriteFile(path); err != nil {
+			return err
+		}
+		fmt.Printf("retired %s from %s\n", args[1], path)
+		return nil
+	case "encrypt-users":
+		keyring, err := pii.LoadKeyring(path)
+		if err != nil {
+			return err
+		}
+		db, err := infrastructure.NewDB(infrastructure.Config{
+			Host:     getEnv("DB_HOST", "localhost"),
+			Port:     5432,
+			User:     getEnv("DB_USER", "postgres"),
+			Password: getEnv("DB_PASSWORD", "postgres"),
+			DBName:   getEnv("DB_NAME", "app_db"),
+			SSLMode:  getEnv("DB_SSLMODE", "disable"),
+		})
+		if err != nil {
+			return err
+		}
+		defer db.Close()
+		// すべての組織の行を暗号化する（行レベルセキュリティを適用していても参照できるように）
+		ctx := tenant.WithAllOrgs(context.Background())
+		n, err := encryptUsers(ctx, db, keyring)
+		fmt.Printf("encrypted %d users\n", n)
+		if err != nil {
+			return err
+		}
+		for _, table := range []string{"user_tokens", "invitations"} {
+			n, err := encryptEmails(ctx, db, keyring, table)
+			fmt.Printf("encrypted %d %s\n", n, table)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	default:
+		flag.Usage()
+		return fmt.Errorf("unknown command %q", cmd)
+	}
+}
+
+// encryptUsers 暗号化前の列（name / email）の値を暗号化した列に書き込み、件数を返す
+//
+// db/migrations/20261018090000_encrypt_user_pii.sql で暗号化した列を追加した後、task db:migrate で
+// 平文の列を削除する前に実行する。平文の列は現在のスキーマ（sqlc）にないため SQL を直接実行する。
+func encryptUsers(ctx context.Context, db *sql.DB, keyring *pii.Keyring) (int, error) {
+	total := 0
+	for {
+		n, err := encryptUsersBatch(ctx, db, keyring)
+		total += n
+		if err != nil || n < encryptUsersBatchSize {
+			return total, err
+		}
+	}
+}
+
+// encryptUsersBatch 未暗号化のユーザーを最大 encryptUsersBatchSize 件暗号化する
+func encryptUsersBatch(ctx context.Context, db *sql.DB, keyring *pii.Keyring) (int, error) {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+	if err := infrastructure.SetTenant(ctx, tx); err != nil {
+		return 0, err
+	}
+
+	rows, err := tx.QueryContext(ctx, `SELECT id, org_id, name, email FROM users
+WHERE pii_key_id IS NULL
+ORDER BY id
+LIMIT $1
+FOR UPDATE`, encryptUsersBatchSize)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list users: %w", err)
+	}
+	type plainUser struct{ id, orgID, name, email string }
+	var users []plainUser
+	for rows.Next() {
+		var u plainUser
+		if err := rows.Scan(&u.id, &u.orgID, &u.name, &u.email); err != nil {
+			rows.Close()
+			return 0, err
+		}
+		users = append(users, u)
+	}
+	if err := rows.Close(); err != nil {
+		return 0, err
+	}
+	if err := rows.Err(); err != nil {
+		return 0, err
+	}
+
+	for _, u := range users {
+		sealed, err := keyring.Seal(u.id, u.name, u.email)
+		if err != nil {
+			return 0, err
+		}
+		if _, err := tx.ExecContext(ctx, `UPDATE users
+SET name_ciphertext = $1, email_ciphertext = $2, email_index = $3, pii_key_id = $4, pii_data_key = $5
+WHERE id = $6`,
+			sealed.Values[0], sealed.Values[1], keyring.BlindIndex(u.orgID, u.email), sealed.KeyID, sealed.DataKey, u.id,
+		); err != nil {
+			return 0, fmt.Errorf("failed to encrypt user %s: %w", u.id, err)
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+	return len(users), nil
+}
+
+// encryptEmails table（user_tokens / invitations）の暗号化前の列（email）の値を暗号化した列に書き込み、件数を返す
+//
+// db/migrations/20261018200336_encrypt_token_and_invitation_pii.sql で暗号化した列を追加した後、
+// task db:migrate で平文の列を削除する前に実行する。
+func encryptEmails(ctx context.Context, db *sql.DB, keyring *pii.Keyring, table string) (int, error) {
+	total := 0
+	for {
+		n, err := encryptEmailsBatch(ctx, db, keyring, table)
+		total += n
+		if err != nil || n < encryptUsersBatchSize {
+			return total, err
+		}
+	}
+}
+
+// encryptEmailsBatch table の未暗号化の行を最大 encryptUsersBatchSize 件暗号化する
+//
+// table は呼び出し側で固定した名前のみ渡す（SQL に埋め込むため）。
+func encryptEmailsBatch(ctx context.Context, db *sql.DB, keyring *pii.Keyring, table string) (int, error) {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+	if err := infrastructure.SetTenant(ctx, tx); err != nil {
+		return 0, err
+	}
+
+	rows, err := tx.QueryContext(ctx, `SELECT id, email FROM `+table+`
+WHERE pii_key_id IS NULL
+ORDER BY id
+LIMIT $1
+FOR UPDATE`, encryptUsersBatchSize)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list %s: %w", table, err)
+	}
+	type plainRow struct{ id, email string }
+	var plain []plainRow
+	for rows.Next() {
+		var r plainRow
+		if err := rows.Scan(&r.id, &r.email); err != nil {
+			rows.Close()
+			return 0, err
+		}
+		plain = append(plain, r)
+	}
+	if err := rows.Close(); err != nil {
+		return 0, err
+	}
+	if err := rows.Err(); err != nil {
+		return 0, err
+	}
+
+	for _, r := range plain {
+		sealed, err := keyring.Seal(r.id, r.email)
+		if err != nil {
+			return 0, err
+		}
+		if _, err := tx.ExecContext(ctx, `UPDATE `+table+`
+SET email_ciphertext = $1, pii_key_id = $2, pii_data_key = $3
+WHERE id = $4`,
+			sealed.Values[0], sealed.KeyID, sealed.DataKey, r.id,
+		); err != nil {
+			return 0, fmt.Errorf("failed to encrypt %s %s: %w", table, r.id, err)
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+	return len(plain), nil
+}
+
+// getEnv 環境変数を取得、なければデフォルト値を返す
+func getEnv(key, defaultValue string) string {
+	value := os.Getenv(key)
+	if value == "" {
+		return defaultValue
+	}
+	return value
+}
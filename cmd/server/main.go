@@ -1,21 +1,39 @@
 package main
 
 import (
+	"context"
+	"crypto/rand"
+	"database/sql"
+	"errors"
+	"fmt"
 	"log/slog"
 	"net/http"
 	"os"
+	"os/signal"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
 
-	"github.com/example/go-react-cqrs-template/internal/handler"
+	"github.com/example/go-react-cqrs-template/internal/auth"
+	"github.com/example/go-react-cqrs-template/internal/command"
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/eventstream"
 	"github.com/example/go-react-cqrs-template/internal/handler/validation"
 	"github.com/example/go-react-cqrs-template/internal/infrastructure"
+	"github.com/example/go-react-cqrs-template/internal/mail"
+	"github.com/example/go-react-cqrs-template/internal/outbox"
+	"github.com/example/go-react-cqrs-template/internal/pii"
 	"github.com/example/go-react-cqrs-template/internal/pkg/logger"
 	"github.com/example/go-react-cqrs-template/internal/queryservice"
+	"github.com/example/go-react-cqrs-template/internal/sessioncache"
+	"github.com/example/go-react-cqrs-template/internal/tenant"
 	"github.com/example/go-react-cqrs-template/internal/usecase"
-	openapispec "github.com/example/go-react-cqrs-template/openapi"
-	"github.com/example/go-react-cqrs-template/pkg/generated/openapi"
-	"github.com/go-chi/chi/v5"
-	"github.com/go-chi/chi/v5/middleware"
-	"github.com/go-chi/cors"
+	"github.com/example/go-react-cqrs-template/internal/usercache"
+	"github.com/example/go-react-cqrs-template/internal/webhook"
+	"github.com/prometheus/client_golang/prometheus"
 )
 
 func main() {
@@ -52,78 +70,654 @@ func main() {
 
 	log.Info("successfully connected to database")
 
-	// 各層の初期化
-	txManager := infrastructure.NewTransactionManager(db)
-	userQueryService := queryservice.NewUserQueryService(db)
-
-	// Usecases
-	createUserUsecase := usecase.NewCreateUserUsecase(userQueryService, txManager)
-	findUserUsecase := usecase.NewFindUserUsecase(userQueryService)
-	listUsersUsecase := usecase.NewListUsersUsecase(userQueryService)
-	updateUserUsecase := usecase.NewUpdateUserUsecase(userQueryService, txManager)
-	deleteUserUsecase := usecase.NewDeleteUserUsecase(userQueryService, txManager)
-
-	userHandler := handler.NewUserHandler(
-		createUserUsecase,
-		findUserUsecase,
-		listUsersUsecase,
-		updateUserUsecase,
-		deleteUserUsecase,
-		log,
-	)
+	// レスポンスバリデーションのモード（off / warn / strict）
+	responseValidation, err := validation.ParseResponseMode(getEnv("OPENAPI_RESPONSE_VALIDATION", "off"))
+	if err != nil {
+		log.Error("invalid OPENAPI_RESPONSE_VALIDATION",
+			slog.String("error", err.Error()),
+		)
+		os.Exit(1)
+	}
 
-	// ルーターの設定
-	r := chi.NewRouter()
-
-	// ミドルウェア
-	r.Use(logger.Middleware) // 構造化ログミドルウェア（リクエストID付与）
-	r.Use(middleware.Recoverer)
-	r.Use(cors.Handler(cors.Options{
-		AllowedOrigins:   []string{"http://localhost:3000"},
-		AllowedMethods:   []string{"GET", "POST", "PUT", "DELETE", "OPTIONS"},
-		AllowedHeaders:   []string{"Accept", "Authorization", "Content-Type"},
-		ExposedHeaders:   []string{"Link"},
-		AllowCredentials: true,
-		MaxAge:           300,
-	}))
-
-	log.Info("middleware configured",
-		slog.String("cors_origin", "http://localhost:3000"),
-	)
+	// ユーザーの変更ストリーム（/users:watch）のハートビート間隔
+	streamHeartbeat, err := time.ParseDuration(getEnv("USER_STREAM_HEARTBEAT", "15s"))
+	if err != nil || streamHeartbeat <= 0 {
+		log.Error("invalid USER_STREAM_HEARTBEAT",
+			slog.String("value", getEnv("USER_STREAM_HEARTBEAT", "15s")),
+		)
+		os.Exit(1)
+	}
+	userEvents := eventstream.NewHub(eventstream.NewSQLRepository(db), log)
+
+	// ユーザーの名前とメールアドレスの暗号化の鍵リング（PII_KEYRING_FILE）
+	piiKeyring, err := newPIIKeyring(log)
+	if err != nil {
+		log.Error("invalid pii keyring configuration",
+			slog.String("error", err.Error()),
+		)
+		os.Exit(1)
+	}
+
+	// ユーザーの読み取りキャッシュ（USER_CACHE_SIZE=0 で無効）
+	userCache, err := newUserCache(db, piiKeyring)
+	if err != nil {
+		log.Error("invalid user cache configuration",
+			slog.String("error", err.Error()),
+		)
+		os.Exit(1)
+	}
+	if userCache != nil {
+		prometheus.MustRegister(userCache)
+	}
+
+	// ログインのセッショントークンとアカウントロック
+	sessions, lockout, err := newAuthConfig(log)
+	if err != nil {
+		log.Error("invalid auth configuration",
+			slog.String("error", err.Error()),
+		)
+		os.Exit(1)
+	}
+
+	// セッションの有効期限と読み取りキャッシュ（SESSION_CACHE_SIZE=0 で無効）
+	sessionPolicy, err := newSessionPolicy()
+	if err != nil {
+		log.Error("invalid session configuration",
+			slog.String("error", err.Error()),
+		)
+		os.Exit(1)
+	}
+	sessionCache, err := newSessionCache(db)
+	if err != nil {
+		log.Error("invalid session cache configuration",
+			slog.String("error", err.Error()),
+		)
+		os.Exit(1)
+	}
+	if sessionCache != nil {
+		prometheus.MustRegister(sessionCache)
+	}
 
-	// OpenAPIバリデーションミドルウェアの初期化
-	validationMiddleware, err := validation.NewMiddleware(openapispec.Spec)
+	// 二要素認証（MFA_REQUIRE_ADMIN=true で管理者に必須）
+	mfaPolicy, err := newMFAPolicy()
 	if err != nil {
-		log.Error("failed to create validation middleware",
+		log.Error("invalid mfa configuration",
 			slog.String("error", err.Error()),
 		)
 		os.Exit(1)
 	}
-	log.Info("OpenAPI validation middleware initialized")
 
-	// OpenAPI生成のハンドラーを使用してAPIルートを設定
-	r.Route("/api/v1", func(r chi.Router) {
-		// OpenAPI仕様に基づくリクエストバリデーション
-		r.Use(validationMiddleware.Handler)
-		// OpenAPI仕様に従ったルーティングを自動生成
-		openapi.HandlerFromMux(userHandler, r)
+	// シングルサインオン（OIDC_ISSUER が空の場合は無効）
+	oidcProviders, oidcConfig, err := newOIDCConfig(log)
+	if err != nil {
+		log.Error("invalid oidc configuration",
+			slog.String("error", err.Error()),
+		)
+		os.Exit(1)
+	}
+
+	mailer, err := newMailer(log)
+	if err != nil {
+		log.Error("invalid mail configuration",
+			slog.String("error", err.Error()),
+		)
+		os.Exit(1)
+	}
+	userTokens, err := newUserTokenConfig()
+	if err != nil {
+		log.Error("invalid user token configuration",
+			slog.String("error", err.Error()),
+		)
+		os.Exit(1)
+	}
+	scimTokens, err := newSCIMTokens()
+	if err != nil {
+		log.Error("invalid scim configuration",
+			slog.String("error", err.Error()),
+		)
+		os.Exit(1)
+	}
+
+	r, err := newRouter(db, log, routerConfig{
+		ResponseValidation: responseValidation,
+		UserEvents:         userEvents,
+		PIIKeyring:         piiKeyring,
+		UserCache:          userCache,
+		UserCacheControl:   os.Getenv("USER_HTTP_CACHE_CONTROL"),
+		StreamHeartbeat:    streamHeartbeat,
+		SCIMBearerTokens:   scimTokens,
+		Sessions:           sessions,
+		PasswordHasher:     auth.NewPasswordHasher(auth.DefaultArgon2Params),
+		Lockout:            lockout,
+		SessionPolicy:      sessionPolicy,
+		SessionCache:       sessionCache,
+		TOTP:               auth.NewTOTP(getEnv("MFA_ISSUER", "go-react-cqrs-template")),
+		MFAPolicy:          mfaPolicy,
+		OIDCProviders:      oidcProviders,
+		OIDC:               oidcConfig,
+		Mailer:             mailer,
+		UserTokens:         userTokens,
 	})
+	if err != nil {
+		log.Error("failed to create router",
+			slog.String("error", err.Error()),
+		)
+		os.Exit(1)
+	}
+
+	// シグナル受信で停止するコンテキスト
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	// 変更ストリーム（コミット時の NOTIFY で outbox を追従し、接続中のクライアントに配る）
+	wake, err := eventstream.Listen(ctx, dbConfig.DSN(), outbox.NotifyChannel, log)
+	if err != nil {
+		// LISTEN できない場合もポーリングで追従する
+		log.Warn("failed to listen for outbox notifications, falling back to polling",
+			slog.String("error", err.Error()),
+		)
+	}
+	// 読み取りキャッシュの無効化（他のレプリカでのコミットを NOTIFY で受け取る）
+	if userCache != nil {
+		if err := userCache.Listen(ctx, dbConfig.DSN(), command.UserChangedChannel, log); err != nil {
+			// LISTEN できない場合も TTL で失効する
+			log.Warn("failed to listen for user changes, cache entries expire by TTL only",
+				slog.String("error", err.Error()),
+			)
+		}
+	}
+	if sessionCache != nil {
+		if err := sessionCache.Listen(ctx, dbConfig.DSN(), command.SessionChangedChannel, log); err != nil {
+			// LISTEN できない場合も TTL で失効する
+			log.Warn("failed to listen for session revocations, cache entries expire by TTL only",
+				slog.String("error", err.Error()),
+			)
+		}
+	}
+	streamDone := make(chan struct{})
+	go func() {
+		defer close(streamDone)
+		userEvents.Run(ctx, wake)
+	}()
+
+	txManager := infrastructure.NewTransactionManager(db)
+	webhookRepository := webhook.NewSQLRepository()
+
+	// outbox リレー（ドメインイベントを購読ごとのWebhook配信に振り分ける）
+	var relayDone chan struct{}
+	if getEnv("OUTBOX_RELAY_ENABLED", "true") == "true" {
+		relay := outbox.NewRelay(
+			outbox.NewSQLRepository(),
+			txManager,
+			webhook.NewDispatcher(webhookRepository, txManager, log),
+			log,
+		)
+		relayDone = make(chan struct{})
+		go func() {
+			defer close(relayDone)
+			relay.Run(ctx)
+		}()
+	}
+
+	// Webhook ワーカー（署名付きの配信とリトライ）
+	var workerDone chan struct{}
+	if getEnv("WEBHOOK_WORKER_ENABLED", "true") == "true" {
+		maxAttempts, err := strconv.Atoi(getEnv("WEBHOOK_MAX_ATTEMPTS", "10"))
+		if err != nil || maxAttempts < 1 {
+			log.Error("invalid WEBHOOK_MAX_ATTEMPTS",
+				slog.String("value", getEnv("WEBHOOK_MAX_ATTEMPTS", "10")),
+			)
+			os.Exit(1)
+		}
+		worker := webhook.NewWorker(webhookRepository, txManager, log, webhook.WithMaxAttempts(maxAttempts))
+		workerDone = make(chan struct{})
+		go func() {
+			defer close(workerDone)
+			worker.Run(ctx)
+		}()
+	}
+
+	// 招待の期限切れ（有効期限を過ぎた招待を定期的に期限切れにしてユーザーログに記録する）
+	var invitationExpiryDone chan struct{}
+	if getEnv("INVITATION_EXPIRY_ENABLED", "true") == "true" {
+		interval, err := time.ParseDuration(getEnv("INVITATION_EXPIRY_INTERVAL", "1m"))
+		if err != nil || interval <= 0 {
+			log.Error("invalid INVITATION_EXPIRY_INTERVAL",
+				slog.String("value", getEnv("INVITATION_EXPIRY_INTERVAL", "1m")),
+			)
+			os.Exit(1)
+		}
+		expireInvitations := usecase.NewExpireInvitationsUsecase(
+			queryservice.NewOrganizationQueryService(db),
+			command.NewUserRepository(piiKeyring),
+			command.NewInvitationRepository(piiKeyring),
+			txManager,
+		)
+		invitationExpiryDone = make(chan struct{})
+		go func() {
+			defer close(invitationExpiryDone)
+			runInvitationExpiry(ctx, expireInvitations, interval, log)
+		}()
+	}
+
+	// 個人情報の再暗号化（鍵のローテーション後に古い鍵で暗号化したデータキーを主鍵で暗号化し直す）
+	var reencryptionDone chan struct{}
+	if getEnv("PII_REENCRYPT_ENABLED", "true") == "true" {
+		interval, err := time.ParseDuration(getEnv("PII_REENCRYPT_INTERVAL", "10m"))
+		if err != nil || interval <= 0 {
+			log.Error("invalid PII_REENCRYPT_INTERVAL",
+				slog.String("value", getEnv("PII_REENCRYPT_INTERVAL", "10m")),
+			)
+			os.Exit(1)
+		}
+		reencryptUsers := usecase.NewReencryptUsersUsecase(
+			queryservice.NewOrganizationQueryService(db),
+			command.NewUserRepository(piiKeyring),
+			txManager,
+		)
+		reencryptionDone = make(chan struct{})
+		go func() {
+			defer close(reencryptionDone)
+			runUserReencryption(ctx, reencryptUsers, interval, log)
+		}()
+	}
 
 	// サーバー起動
 	port := getEnv("PORT", "8080")
-	log.Info("server starting",
-		slog.String("port", port),
-		slog.String("address", ":"+port),
-	)
-	if err := http.ListenAndServe(":"+port, r); err != nil {
+	// gRPC クライアントは TLS なしの HTTP/2（h2c）で接続するため、HTTP/1.1 と併せて受け付ける
+	protocols := new(http.Protocols)
+	protocols.SetHTTP1(true)
+	protocols.SetUnencryptedHTTP2(true)
+	srv := &http.Server{Addr: ":" + port, Handler: r, Protocols: protocols}
+	// メトリクスは内部向けの別のリスナーで公開する（既定はループバックのみ）
+	metricsAddr := getEnv("METRICS_ADDR", "127.0.0.1:9090")
+	metricsSrv := &http.Server{Addr: metricsAddr, Handler: newMetricsHandler()}
+	serverErr := make(chan error, 2)
+	go func() {
+		log.Info("server starting",
+			slog.String("port", port),
+			slog.String("address", ":"+port),
+		)
+		serverErr <- srv.ListenAndServe()
+	}()
+	go func() {
+		log.Info("metrics server starting",
+			slog.String("address", metricsAddr),
+		)
+		serverErr <- metricsSrv.ListenAndServe()
+	}()
+
+	select {
+	case err := <-serverErr:
 		log.Error("server failed to start",
 			slog.String("error", err.Error()),
 			slog.String("port", port),
 		)
 		os.Exit(1)
+	case <-ctx.Done():
+	}
+
+	// グレースフルシャットダウン
+	log.Info("shutting down server")
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	if err := srv.Shutdown(shutdownCtx); err != nil {
+		log.Error("failed to shut down server gracefully",
+			slog.String("error", err.Error()),
+		)
+	}
+	if err := metricsSrv.Shutdown(shutdownCtx); err != nil {
+		log.Error("failed to shut down metrics server gracefully",
+			slog.String("error", err.Error()),
+		)
+	}
+	<-streamDone
+	if relayDone != nil {
+		<-relayDone
+	}
+	if workerDone != nil {
+		<-workerDone
+	}
+	if invitationExpiryDone != nil {
+		<-invitationExpiryDone
+	}
+	if reencryptionDone != nil {
+		<-reencryptionDone
+	}
+}
+
+// runInvitationExpiry コンテキストがキャンセルされるまで interval ごとに期限切れの招待を処理する
+func runInvitationExpiry(ctx context.Context, expire *usecase.ExpireInvitationsUsecase, interval time.Duration, log *slog.Logger) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		n, err := expire.Execute(ctx)
+		if err != nil && ctx.Err() == nil {
+			log.Error("failed to expire invitations",
+				slog.String("error", err.Error()),
+			)
+		}
+		if n > 0 {
+			log.Info("invitations expired",
+				slog.Int("count", n),
+			)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// runUserReencryption コンテキストがキャンセルされるまで interval ごとにユーザーの個人情報を主鍵で暗号化し直す
+func runUserReencryption(ctx context.Context, reencrypt *usecase.ReencryptUsersUsecase, interval time.Duration, log *slog.Logger) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		n, err := reencrypt.Execute(ctx)
+		if err != nil && ctx.Err() == nil {
+			log.Error("failed to reencrypt users",
+				slog.String("error", err.Error()),
+			)
+		}
+		if n > 0 {
+			log.Info("users reencrypted",
+				slog.Int("count", n),
+			)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// devInsecureDefaults 開発用の安全でない既定値（鍵リング・トークンの鍵の自動生成）を許可するか
+//
+// DEV_INSECURE_DEFAULTS=true の場合のみ許可する。本番で設定漏れに気付かずに起動しないよう、既定では許可しない。
+func devInsecureDefaults() bool {
+	return getEnv("DEV_INSECURE_DEFAULTS", "false") == "true"
+}
+
+// newPIIKeyring 環境変数 PII_KEYRING_FILE の鍵リングを読み込む
+//
+// PII_KEYRING_FILE が未設定の場合はエラーにする。DEV_INSECURE_DEFAULTS=true の場合のみ開発用に
+// .keys/pii-keyring.json を使い、ファイルがなければ生成する。設定されている場合はファイルがなければエラーにする
+// （鍵を失うと既存のユーザーを復号できなくなるため生成しない）。
+func newPIIKeyring(log *slog.Logger) (*pii.Keyring, error) {
+	path := os.Getenv("PII_KEYRING_FILE")
+	if path != "" {
+		return pii.LoadKeyring(path)
+	}
+	if !devInsecureDefaults() {
+		return nil, errors.New("PII_KEYRING_FILE is not set (create one with `go run ./cmd/pii-keyring generate`, or set DEV_INSECURE_DEFAULTS=true for development)")
+	}
+	path = filepath.Join(".keys", "pii-keyring.json")
+	keyring, err := pii.LoadKeyring(path)
+	if err == nil || !errors.Is(err, os.ErrNotExist) {
+		return keyring, err
+	}
+	if keyring, err = pii.GenerateKeyring(); err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return nil, fmt.Errorf("failed to create keyring directory: %w", err)
+	}
+	if err := keyring.WriteFile(path); err != nil {
+		return nil, err
+	}
+	log.Warn("PII_KEYRING_FILE is not set, generated a development keyring (DEV_INSECURE_DEFAULTS)",
+		slog.String("path", path),
+	)
+	return keyring, nil
+}
+
+// newUserCache 環境変数の設定に従ってユーザーの読み取りキャッシュを作成する（無効な場合は nil）
+func newUserCache(db *sql.DB, keyring *pii.Keyring) (*usercache.Cache, error) {
+	size, err := strconv.Atoi(getEnv("USER_CACHE_SIZE", "10000"))
+	if err != nil || size < 0 {
+		return nil, fmt.Errorf("invalid USER_CACHE_SIZE: %q", getEnv("USER_CACHE_SIZE", "10000"))
+	}
+	if size == 0 {
+		return nil, nil
+	}
+	ttl, err := time.ParseDuration(getEnv("USER_CACHE_TTL", "1m"))
+	if err != nil || ttl <= 0 {
+		return nil, fmt.Errorf("invalid USER_CACHE_TTL: %q", getEnv("USER_CACHE_TTL", "1m"))
 	}
+	negativeTTL, err := time.ParseDuration(getEnv("USER_CACHE_NEGATIVE_TTL", "10s"))
+	if err != nil || negativeTTL < 0 {
+		return nil, fmt.Errorf("invalid USER_CACHE_NEGATIVE_TTL: %q", getEnv("USER_CACHE_NEGATIVE_TTL", "10s"))
+	}
+	return usercache.New(
+		queryservice.NewUserQueryService(db, keyring),
+		usercache.WithSize(size),
+		usercache.WithTTL(ttl),
+		usercache.WithNegativeTTL(negativeTTL),
+	), nil
+}
+
+// newAuthConfig 環境変数の設定に従ってセッショントークンの発行とアカウントロックの方針を作成する
+//
+// AUTH_TOKEN_SECRET が未設定の場合はエラーにする。DEV_INSECURE_DEFAULTS=true の場合のみ起動ごとにランダムな鍵を生成する
+// （再起動やレプリカ間でトークンが無効になる）。
+func newAuthConfig(log *slog.Logger) (*auth.TokenIssuer, domain.LockoutPolicy, error) {
+	secret := []byte(os.Getenv("AUTH_TOKEN_SECRET"))
+	if len(secret) == 0 {
+		if !devInsecureDefaults() {
+			return nil, domain.LockoutPolicy{}, errors.New("AUTH_TOKEN_SECRET is not set (or set DEV_INSECURE_DEFAULTS=true for development)")
+		}
+		secret = make([]byte, auth.TokenSecretMinLength)
+		if _, err := rand.Read(secret); err != nil {
+			return nil, domain.LockoutPolicy{}, fmt.Errorf("failed to generate token secret: %w", err)
+		}
+		log.Warn("AUTH_TOKEN_SECRET is not set, using a random secret (DEV_INSECURE_DEFAULTS; tokens are invalidated on restart)")
+	}
+	sessions, err := auth.NewTokenIssuer(secret)
+	if err != nil {
+		return nil, domain.LockoutPolicy{}, err
+	}
+
+	lockout := domain.DefaultLockoutPolicy
+	maxAttempts, err := strconv.Atoi(getEnv("LOGIN_MAX_ATTEMPTS", strconv.Itoa(lockout.MaxAttempts)))
+	if err != nil || maxAttempts < 1 {
+		return nil, domain.LockoutPolicy{}, fmt.Errorf("invalid LOGIN_MAX_ATTEMPTS: %q", os.Getenv("LOGIN_MAX_ATTEMPTS"))
+	}
+	duration, err := time.ParseDuration(getEnv("LOGIN_LOCKOUT_DURATION", lockout.Duration.String()))
+	if err != nil || duration <= 0 {
+		return nil, domain.LockoutPolicy{}, fmt.Errorf("invalid LOGIN_LOCKOUT_DURATION: %q", os.Getenv("LOGIN_LOCKOUT_DURATION"))
+	}
+	lockout.MaxAttempts = maxAttempts
+	lockout.Duration = duration
+	return sessions, lockout, nil
+}
+
+// newSessionPolicy 環境変数の設定に従ってセッションの有効期限の方針を作成する
+//
+// SESSION_ABSOLUTE_TIMEOUT が未設定の場合は、以前のトークンの有効期間の設定 AUTH_TOKEN_TTL を使用する。
+func newSessionPolicy() (domain.SessionPolicy, error) {
+	policy := domain.DefaultSessionPolicy
+	idle, err := time.ParseDuration(getEnv("SESSION_IDLE_TIMEOUT", policy.IdleTimeout.String()))
+	if err != nil || idle <= 0 {
+		return domain.SessionPolicy{}, fmt.Errorf("invalid SESSION_IDLE_TIMEOUT: %q", os.Getenv("SESSION_IDLE_TIMEOUT"))
+	}
+	absoluteDefault := getEnv("AUTH_TOKEN_TTL", policy.AbsoluteTimeout.String())
+	absolute, err := time.ParseDuration(getEnv("SESSION_ABSOLUTE_TIMEOUT", absoluteDefault))
+	if err != nil || absolute <= 0 {
+		return domain.SessionPolicy{}, fmt.Errorf("invalid SESSION_ABSOLUTE_TIMEOUT: %q", getEnv("SESSION_ABSOLUTE_TIMEOUT", absoluteDefault))
+	}
+	policy.IdleTimeout = idle
+	policy.AbsoluteTimeout = absolute
+	return policy, nil
 }
 
+// newSessionCache 環境変数の設定に従ってセッションの読み取りキャッシュを作成する（無効な場合は nil）
+func newSessionCache(db *sql.DB) (*sessioncache.Cache, error) {
+	size, err := strconv.Atoi(getEnv("SESSION_CACHE_SIZE", "10000"))
+	if err != nil || size < 0 {
+		return nil, fmt.Errorf("invalid SESSION_CACHE_SIZE: %q", getEnv("SESSION_CACHE_SIZE", "10000"))
+	}
+	if size == 0 {
+		return nil, nil
+	}
+	ttl, err := time.ParseDuration(getEnv("SESSION_CACHE_TTL", "10s"))
+	if err != nil || ttl <= 0 {
+		return nil, fmt.Errorf("invalid SESSION_CACHE_TTL: %q", getEnv("SESSION_CACHE_TTL", "10s"))
+	}
+	return sessioncache.New(
+		queryservice.NewSessionQueryService(db),
+		sessioncache.WithSize(size),
+		sessioncache.WithTTL(ttl),
+	), nil
+}
+
+// newMFAPolicy 環境変数の設定に従って二要素認証の方針を作成する
+//
+// MFA_REQUIRE_ADMIN=true の場合は MFA_ADMIN_GROUP のグループのメンバーを管理者として二要素認証を必須とする。
+func newMFAPolicy() (domain.MFAPolicy, error) {
+	policy := domain.DefaultMFAPolicy
+	policy.RequireForAdmins = getEnv("MFA_REQUIRE_ADMIN", "false") == "true"
+	policy.AdminGroup = getEnv("MFA_ADMIN_GROUP", policy.AdminGroup)
+	ttl, err := time.ParseDuration(getEnv("MFA_CHALLENGE_TTL", policy.ChallengeTTL.String()))
+	if err != nil || ttl <= 0 {
+		return domain.MFAPolicy{}, fmt.Errorf("invalid MFA_CHALLENGE_TTL: %q", os.Getenv("MFA_CHALLENGE_TTL"))
+	}
+	policy.ChallengeTTL = ttl
+	return policy, nil
+}
+
+// newOIDCConfig 環境変数の設定に従ってシングルサインオンの IdP とログインの設定を作成する
+//
+// OIDC_ISSUER が空の場合は IdP を登録しない。IdP は OIDC_PROVIDER の名前（/auth/oidc/{provider}）で登録し、
+// リダイレクト先は OIDC_REDIRECT_URL（既定は APP_BASE_URL の /login/oidc/callback）とする。
+func newOIDCConfig(log *slog.Logger) (map[string]usecase.OIDCProvider, usecase.OIDCConfig, error) {
+	cfg := usecase.DefaultOIDCConfig
+	cfg.AutoProvision = getEnv("OIDC_AUTO_PROVISION", "false") == "true"
+	ttl, err := time.ParseDuration(getEnv("OIDC_STATE_TTL", cfg.StateTTL.String()))
+	if err != nil || ttl <= 0 {
+		return nil, usecase.OIDCConfig{}, fmt.Errorf("invalid OIDC_STATE_TTL: %q", os.Getenv("OIDC_STATE_TTL"))
+	}
+	cfg.StateTTL = ttl
+
+	issuer := os.Getenv("OIDC_ISSUER")
+	if issuer == "" {
+		return nil, cfg, nil
+	}
+	name := getEnv("OIDC_PROVIDER", "company")
+	if !oidcProviderName.MatchString(name) {
+		return nil, usecase.OIDCConfig{}, fmt.Errorf("invalid OIDC_PROVIDER: %q", name)
+	}
+	if os.Getenv("OIDC_CLIENT_ID") == "" {
+		return nil, usecase.OIDCConfig{}, fmt.Errorf("OIDC_CLIENT_ID is required when OIDC_ISSUER is set")
+	}
+	redirectURL := getEnv("OIDC_REDIRECT_URL", strings.TrimRight(getEnv("APP_BASE_URL", usecase.DefaultUserTokenConfig.BaseURL), "/")+"/login/oidc/callback")
+	provider := auth.NewOIDCProvider(auth.OIDCConfig{
+		Issuer:       issuer,
+		ClientID:     os.Getenv("OIDC_CLIENT_ID"),
+		ClientSecret: os.Getenv("OIDC_CLIENT_SECRET"),
+		RedirectURL:  redirectURL,
+		Scopes:       strings.Fields(os.Getenv("OIDC_SCOPES")),
+	})
+	log.Info("OIDC login enabled",
+		slog.String("provider", name),
+		slog.String("issuer", issuer),
+		slog.Bool("auto_provision", cfg.AutoProvision),
+	)
+	return map[string]usecase.OIDCProvider{name: provider}, cfg, nil
+}
+
+// oidcProviderName IdP の名前の形式（OpenAPI の provider パラメーターと同じ）
+var oidcProviderName = regexp.MustCompile(`^[a-z0-9-]{1,32}$`)
+
+// newMailer 環境変数 MAIL_DRIVER に従ってメールの送信方法を作成する
+//
+// log（既定）はログに出力し、file は MAIL_FILE_DIR に .eml ファイルとして書き出す（いずれも送信しない）。
+// smtp は SMTP_HOST / SMTP_PORT / SMTP_USERNAME / SMTP_PASSWORD のサーバーから送信する。
+func newMailer(log *slog.Logger) (mail.Mailer, error) {
+	from := getEnv("MAIL_FROM", "no-reply@example.com")
+	switch driver := getEnv("MAIL_DRIVER", "log"); driver {
+	case "log":
+		return mail.NewLogMailer(log), nil
+	case "file":
+		return mail.NewFileMailer(getEnv("MAIL_FILE_DIR", "tmp/mail"), from)
+	case "smtp":
+		port, err := strconv.Atoi(getEnv("SMTP_PORT", "587"))
+		if err != nil || port <= 0 {
+			return nil, fmt.Errorf("invalid SMTP_PORT: %q", os.Getenv("SMTP_PORT"))
+		}
+		return mail.NewSMTPMailer(mail.SMTPConfig{
+			Host:     os.Getenv("SMTP_HOST"),
+			Port:     port,
+			Username: os.Getenv("SMTP_USERNAME"),
+			Password: os.Getenv("SMTP_PASSWORD"),
+			From:     from,
+		})
+	default:
+		return nil, fmt.Errorf("unknown MAIL_DRIVER: %q (log / file / smtp)", driver)
+	}
+}
+
+// newUserTokenConfig 環境変数の設定に従ってメールで送るトークンの設定を作成する
+func newUserTokenConfig() (usecase.UserTokenConfig, error) {
+	cfg := usecase.DefaultUserTokenConfig
+	cfg.BaseURL = getEnv("APP_BASE_URL", cfg.BaseURL)
+	for _, d := range []struct {
+		key string
+		ttl *time.Duration
+	}{
+		{"EMAIL_VERIFICATION_TTL", &cfg.EmailVerificationTTL},
+		{"PASSWORD_RESET_TTL", &cfg.PasswordResetTTL},
+		{"INVITATION_TTL", &cfg.InvitationTTL},
+	} {
+		ttl, err := time.ParseDuration(getEnv(d.key, d.ttl.String()))
+		if err != nil || ttl <= 0 {
+			return usecase.UserTokenConfig{}, fmt.Errorf("invalid %s: %q", d.key, os.Getenv(d.key))
+		}
+		*d.ttl = ttl
+	}
+	return cfg, nil
+}
+
+// newSCIMTokens 環境変数の設定に従って SCIM プロビジョニングの組織IDごとの Bearer トークンを作成する
+//
+// SCIM_BEARER_TOKEN は既定の組織のトークン、SCIM_ORG_BEARER_TOKENS は <組織ID>:<トークン> の
+// カンマ区切りで各組織のトークンとする。トークンで組織を決めるため、同じトークンは複数の組織に設定できない。
+func newSCIMTokens() (map[string]string, error) {
+	tokens := map[string]string{}
+	if token := os.Getenv("SCIM_BEARER_TOKEN"); token != "" {
+		tokens[tenant.DefaultOrgID] = token
+	}
+	for _, pair := range strings.Split(os.Getenv("SCIM_ORG_BEARER_TOKENS"), ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		orgID, token, ok := strings.Cut(pair, ":")
+		if !ok || !scimOrgID.MatchString(orgID) || token == "" {
+			return nil, fmt.Errorf("invalid SCIM_ORG_BEARER_TOKENS entry for organization %q", orgID)
+		}
+		if _, exists := tokens[orgID]; exists {
+			return nil, fmt.Errorf("duplicate SCIM bearer token for organization %s", orgID)
+		}
+		tokens[orgID] = token
+	}
+	seen := make(map[string]bool, len(tokens))
+	for _, token := range tokens {
+		if seen[token] {
+			return nil, fmt.Errorf("SCIM bearer tokens must be unique per organization")
+		}
+		seen[token] = true
+	}
+	return tokens, nil
+}
+
+// scimOrgID SCIM_ORG_BEARER_TOKENS の組織IDの形式（ULID）
+var scimOrgID = regexp.MustCompile(`^[0-9A-HJKMNP-TV-Z]{26}$`)
+
 // getEnv 環境変数を取得、なければデフォルト値を返す
 func getEnv(key, defaultValue string) string {
 	value := os.Getenv(key)
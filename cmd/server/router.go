@@ -0,0 +1,349 @@
+package main
+
+import (
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/example/go-react-cqrs-template/internal/auth"
+	"github.com/example/go-react-cqrs-template/internal/command"
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/eventstream"
+	"github.com/example/go-react-cqrs-template/internal/handler"
+	"github.com/example/go-react-cqrs-template/internal/handler/apidocs"
+	"github.com/example/go-react-cqrs-template/internal/handler/graph"
+	"github.com/example/go-react-cqrs-template/internal/handler/rpc"
+	"github.com/example/go-react-cqrs-template/internal/handler/scim"
+	"github.com/example/go-react-cqrs-template/internal/handler/validation"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure"
+	"github.com/example/go-react-cqrs-template/internal/mail"
+	"github.com/example/go-react-cqrs-template/internal/pii"
+	"github.com/example/go-react-cqrs-template/internal/pkg/logger"
+	"github.com/example/go-react-cqrs-template/internal/queryservice"
+	"github.com/example/go-react-cqrs-template/internal/sessioncache"
+	"github.com/example/go-react-cqrs-template/internal/tenant"
+	"github.com/example/go-react-cqrs-template/internal/usecase"
+	"github.com/example/go-react-cqrs-template/internal/usercache"
+	openapispec "github.com/example/go-react-cqrs-template/openapi"
+	"github.com/example/go-react-cqrs-template/pkg/generated/openapi"
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/go-chi/cors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// routerConfig ルーターの動作設定
+type routerConfig struct {
+	// ResponseValidation はOpenAPI仕様に対するレスポンスバリデーションのモード
+	ResponseValidation validation.ResponseMode
+	// UserEvents はユーザーの変更ストリーム（/users:watch）の配信元
+	UserEvents *eventstream.Hub
+	// PIIKeyring はユーザー・トークン・招待の名前とメールアドレスの暗号化に使う鍵リング
+	PIIKeyring *pii.Keyring
+	// UserCache はユーザーの読み取りキャッシュ（nil の場合はキャッシュしない）
+	UserCache *usercache.Cache
+	// UserCacheControl はユーザーの取得・一覧のレスポンスの Cache-Control（空の場合は handler.DefaultCacheControl）
+	UserCacheControl string
+	// StreamHeartbeat は変更ストリームのハートビート間隔（0 の場合はデフォルト）
+	StreamHeartbeat time.Duration
+	// SCIMBearerTokens は SCIM プロビジョニング（/scim/v2）の組織IDごとの Bearer トークン（空の場合は無効）
+	SCIMBearerTokens map[string]string
+	// Sessions はログインで発行するセッショントークンの発行・検証
+	Sessions *auth.TokenIssuer
+	// PasswordHasher はパスワードのハッシュ化と照合
+	PasswordHasher *auth.PasswordHasher
+	// Lockout はログインの失敗によるアカウントロックの方針
+	Lockout domain.LockoutPolicy
+	// SessionPolicy はセッションの無操作・ログインからの有効期限の方針
+	SessionPolicy domain.SessionPolicy
+	// SessionCache はセッションの読み取りキャッシュ（nil の場合はキャッシュしない）
+	SessionCache *sessioncache.Cache
+	// TOTP は二要素認証の秘密鍵の生成と確認コードの照合
+	TOTP *auth.TOTP
+	// MFAPolicy は二要素認証を必須とする方針とログインの2段階目の有効期間
+	MFAPolicy domain.MFAPolicy
+	// OIDCProviders はシングルサインオンの IdP（名前ごと、空の場合は無効）
+	OIDCProviders map[string]usecase.OIDCProvider
+	// OIDC は IdP でのログインの有効期間とユーザーの自動作成の設定
+	OIDC usecase.OIDCConfig
+	// Mailer はメールアドレスの確認・パスワードのリセットのメールの送信
+	Mailer mail.Mailer
+	// UserTokens はメールで送るトークンの有効期間とリンクの URL
+	UserTokens usecase.UserTokenConfig
+}
+
+// newRouter 各層を初期化し、アプリケーション全体のHTTPハンドラーを組み立てる
+func newRouter(db *sql.DB, log *slog.Logger, cfg routerConfig) (http.Handler, error) {
+	// 各層の初期化
+	txManager := infrastructure.NewTransactionManager(db)
+	var userQueryService usecase.UserQueryRepository = queryservice.NewUserQueryService(db, cfg.PIIKeyring)
+	userLogQueryService := queryservice.NewUserLogQueryService(db)
+	var userRepository usecase.UserCommandRepository = command.NewUserRepository(cfg.PIIKeyring)
+	if cfg.UserCache != nil {
+		// 読み取りはキャッシュを経由し、コミットした変更はキャッシュから削除する
+		userQueryService = cfg.UserCache
+		userRepository = usercache.NewCommandRepository(userRepository, cfg.UserCache)
+	}
+	webhookQueryService := queryservice.NewWebhookQueryService(db)
+	webhookRepository := command.NewWebhookRepository()
+	organizationQueryService := queryservice.NewOrganizationQueryService(db)
+	organizationRepository := command.NewOrganizationRepository()
+	groupQueryService := queryservice.NewGroupQueryService(db)
+	groupRepository := command.NewGroupRepository()
+	credentialRepository := command.NewCredentialRepository()
+	totpCredentialRepository := command.NewTOTPCredentialRepository()
+	userTokenRepository := command.NewUserTokenRepository(cfg.PIIKeyring)
+	externalIdentityRepository := command.NewExternalIdentityRepository()
+	invitationQueryService := queryservice.NewInvitationQueryService(db, cfg.PIIKeyring)
+	invitationRepository := command.NewInvitationRepository(cfg.PIIKeyring)
+	var sessionQueryService usecase.SessionQueryRepository = queryservice.NewSessionQueryService(db)
+	var sessionRepository usecase.SessionCommandRepository = command.NewSessionRepository()
+	if cfg.SessionCache != nil {
+		// 認証ミドルウェアの読み取りはキャッシュを経由し、取り消したセッションはキャッシュから削除する
+		sessionQueryService = cfg.SessionCache
+		sessionRepository = sessioncache.NewCommandRepository(sessionRepository, cfg.SessionCache)
+	}
+
+	// Usecases
+	createUserUsecase := usecase.NewCreateUserUsecase(userQueryService, userRepository, txManager)
+	findUserUsecase := usecase.NewFindUserUsecase(userQueryService)
+	findUserByEmailUsecase := usecase.NewFindUserByEmailUsecase(userQueryService)
+	listUsersUsecase := usecase.NewListUsersUsecase(userQueryService)
+	updateUserUsecase := usecase.NewUpdateUserUsecase(userQueryService, userRepository, txManager)
+	patchUserUsecase := usecase.NewPatchUserUsecase(userQueryService, userRepository, txManager)
+	deleteUserUsecase := usecase.NewDeleteUserUsecase(userQueryService, userRepository, txManager)
+	batchUsersUsecase := usecase.NewBatchUsersUsecase(userRepository, txManager)
+	changeUserStatusUsecase := usecase.NewChangeUserStatusUsecase(userRepository, sessionRepository, txManager)
+	listUserLogsUsecase := usecase.NewListUserLogsUsecase(userLogQueryService)
+	createWebhookSubscriptionUsecase := usecase.NewCreateWebhookSubscriptionUsecase(webhookRepository, txManager)
+	findWebhookSubscriptionUsecase := usecase.NewFindWebhookSubscriptionUsecase(webhookQueryService)
+	listWebhookSubscriptionsUsecase := usecase.NewListWebhookSubscriptionsUsecase(webhookQueryService)
+	updateWebhookSubscriptionUsecase := usecase.NewUpdateWebhookSubscriptionUsecase(webhookRepository, txManager)
+	deleteWebhookSubscriptionUsecase := usecase.NewDeleteWebhookSubscriptionUsecase(webhookRepository, txManager)
+	listWebhookDeliveriesUsecase := usecase.NewListWebhookDeliveriesUsecase(webhookQueryService)
+	createOrganizationUsecase := usecase.NewCreateOrganizationUsecase(organizationRepository, txManager)
+	findOrganizationUsecase := usecase.NewFindOrganizationUsecase(organizationQueryService)
+	listOrganizationsUsecase := usecase.NewListOrganizationsUsecase(organizationQueryService)
+	createGroupUsecase := usecase.NewCreateGroupUsecase(groupRepository, txManager)
+	findGroupUsecase := usecase.NewFindGroupUsecase(groupQueryService)
+	addGroupMembersUsecase := usecase.NewAddGroupMembersUsecase(groupRepository, userRepository, txManager)
+	removeGroupMembersUsecase := usecase.NewRemoveGroupMembersUsecase(groupRepository, userRepository, txManager)
+	listGroupMembersUsecase := usecase.NewListGroupMembersUsecase(groupQueryService)
+	listUserGroupsUsecase := usecase.NewListUserGroupsUsecase(userQueryService, groupQueryService)
+	loginUsecase := usecase.NewLoginUsecase(userRepository, credentialRepository, totpCredentialRepository, userTokenRepository, sessionRepository, groupQueryService, cfg.PasswordHasher, cfg.Sessions, txManager, cfg.Lockout, cfg.SessionPolicy, cfg.MFAPolicy)
+	changePasswordUsecase := usecase.NewChangePasswordUsecase(userRepository, credentialRepository, cfg.PasswordHasher, txManager, cfg.Lockout)
+	requestEmailVerificationUsecase := usecase.NewRequestEmailVerificationUsecase(userRepository, userTokenRepository, cfg.Mailer, txManager, cfg.UserTokens)
+	verifyEmailUsecase := usecase.NewVerifyEmailUsecase(userRepository, userTokenRepository, txManager)
+	requestPasswordResetUsecase := usecase.NewRequestPasswordResetUsecase(userRepository, userTokenRepository, cfg.Mailer, txManager, cfg.UserTokens)
+	resetPasswordUsecase := usecase.NewResetPasswordUsecase(userRepository, credentialRepository, userTokenRepository, sessionRepository, cfg.PasswordHasher, txManager)
+	createInvitationUsecase := usecase.NewCreateInvitationUsecase(userRepository, invitationRepository, cfg.Mailer, txManager, cfg.UserTokens)
+	listInvitationsUsecase := usecase.NewListInvitationsUsecase(invitationQueryService)
+	resendInvitationUsecase := usecase.NewResendInvitationUsecase(userRepository, invitationRepository, cfg.Mailer, txManager, cfg.UserTokens)
+	revokeInvitationUsecase := usecase.NewRevokeInvitationUsecase(userRepository, invitationRepository, txManager)
+	acceptInvitationUsecase := usecase.NewAcceptInvitationUsecase(userRepository, credentialRepository, invitationRepository, cfg.PasswordHasher, txManager)
+	authenticateSessionUsecase := usecase.NewAuthenticateSessionUsecase(sessionQueryService, sessionRepository, txManager, cfg.SessionPolicy)
+	listSessionsUsecase := usecase.NewListSessionsUsecase(userQueryService, sessionQueryService, cfg.SessionPolicy)
+	revokeSessionUsecase := usecase.NewRevokeSessionUsecase(userRepository, sessionRepository, txManager)
+	revokeAllSessionsUsecase := usecase.NewRevokeAllSessionsUsecase(userRepository, sessionRepository, txManager)
+	enrollTOTPUsecase := usecase.NewEnrollTOTPUsecase(userRepository, credentialRepository, totpCredentialRepository, cfg.PasswordHasher, cfg.TOTP, txManager, cfg.Lockout)
+	confirmTOTPUsecase := usecase.NewConfirmTOTPUsecase(userRepository, credentialRepository, totpCredentialRepository, cfg.TOTP, txManager, cfg.Lockout)
+	disableTOTPUsecase := usecase.NewDisableTOTPUsecase(userRepository, credentialRepository, totpCredentialRepository, groupQueryService, cfg.TOTP, txManager, cfg.Lockout, cfg.MFAPolicy)
+	verifyMFALoginUsecase := usecase.NewVerifyMFALoginUsecase(userRepository, credentialRepository, totpCredentialRepository, userTokenRepository, sessionRepository, cfg.TOTP, cfg.Sessions, txManager, cfg.Lockout, cfg.SessionPolicy)
+	startOIDCLoginUsecase := usecase.NewStartOIDCLoginUsecase(externalIdentityRepository, cfg.OIDCProviders, txManager, cfg.OIDC)
+	completeOIDCLoginUsecase := usecase.NewCompleteOIDCLoginUsecase(userRepository, externalIdentityRepository, externalIdentityRepository, totpCredentialRepository, userTokenRepository, sessionRepository, groupQueryService, createUserUsecase, cfg.OIDCProviders, cfg.Sessions, txManager, cfg.OIDC, cfg.SessionPolicy, cfg.MFAPolicy)
+
+	userHandler := handler.NewUserHandler(
+		createUserUsecase,
+		findUserUsecase,
+		listUsersUsecase,
+		updateUserUsecase,
+		patchUserUsecase,
+		deleteUserUsecase,
+		batchUsersUsecase,
+		changeUserStatusUsecase,
+		log,
+		handler.WithCacheControl(cfg.UserCacheControl),
+	)
+	webhookHandler := handler.NewWebhookHandler(
+		createWebhookSubscriptionUsecase,
+		findWebhookSubscriptionUsecase,
+		listWebhookSubscriptionsUsecase,
+		updateWebhookSubscriptionUsecase,
+		deleteWebhookSubscriptionUsecase,
+		listWebhookDeliveriesUsecase,
+		log,
+	)
+	userStreamHandler := handler.NewUserStreamHandler(cfg.UserEvents, cfg.StreamHeartbeat, log)
+	organizationHandler := handler.NewOrganizationHandler(
+		createOrganizationUsecase,
+		findOrganizationUsecase,
+		listOrganizationsUsecase,
+		log,
+	)
+	orgUserHandler := handler.NewOrgUserHandler(userHandler, findOrganizationUsecase, log)
+	groupHandler := handler.NewGroupHandler(
+		createGroupUsecase,
+		findGroupUsecase,
+		addGroupMembersUsecase,
+		removeGroupMembersUsecase,
+		listGroupMembersUsecase,
+		listUserGroupsUsecase,
+		log,
+	)
+	authHandler := handler.NewAuthHandler(
+		loginUsecase,
+		changePasswordUsecase,
+		requestEmailVerificationUsecase,
+		verifyEmailUsecase,
+		requestPasswordResetUsecase,
+		resetPasswordUsecase,
+		log,
+	)
+	invitationHandler := handler.NewInvitationHandler(
+		createInvitationUsecase,
+		listInvitationsUsecase,
+		resendInvitationUsecase,
+		revokeInvitationUsecase,
+		acceptInvitationUsecase,
+		log,
+	)
+	sessionHandler := handler.NewSessionHandler(
+		listSessionsUsecase,
+		revokeSessionUsecase,
+		revokeAllSessionsUsecase,
+		log,
+	)
+	mfaHandler := handler.NewMFAHandler(
+		enrollTOTPUsecase,
+		confirmTOTPUsecase,
+		disableTOTPUsecase,
+		verifyMFALoginUsecase,
+		log,
+	)
+	oidcHandler := handler.NewOIDCHandler(
+		startOIDCLoginUsecase,
+		completeOIDCLoginUsecase,
+		log,
+	)
+	server := handler.NewServer(userHandler, webhookHandler, userStreamHandler, organizationHandler, orgUserHandler, groupHandler, authHandler, invitationHandler, sessionHandler, mfaHandler, oidcHandler)
+
+	// ルーターの設定
+	r := chi.NewRouter()
+
+	// ミドルウェア
+	r.Use(logger.Middleware) // 構造化ログミドルウェア（リクエストID付与）
+	r.Use(middleware.Recoverer)
+	r.Use(cors.Handler(cors.Options{
+		AllowedOrigins:   []string{"http://localhost:3000"},
+		AllowedMethods:   []string{"GET", "POST", "PUT", "PATCH", "DELETE", "OPTIONS"},
+		AllowedHeaders:   []string{"Accept", "Authorization", "Content-Type", "Last-Event-ID", tenant.Header, logger.RequestIDHeader},
+		ExposedHeaders:   []string{"ETag", "Last-Modified", "Link", logger.RequestIDHeader},
+		AllowCredentials: true,
+		MaxAge:           300,
+	}))
+	// セッショントークンの検証（認証済みのユーザーの組織をテナントとする）
+	r.Use(handler.AuthMiddleware(cfg.Sessions, authenticateSessionUsecase, log))
+	// 対象テナント（組織）の解決（REST / GraphQL / gRPC / SCIM で共通）
+	// ログインと招待の受諾は未認証でも X-Org-ID で組織を指定できる
+	r.Use(handler.TenantMiddleware(findOrganizationUsecase, log, "/api/v1/auth/", "/api/v1/invitations:accept"))
+
+	log.Info("middleware configured",
+		slog.String("cors_origin", "http://localhost:3000"),
+	)
+
+	// OpenAPIバリデーションミドルウェアの初期化
+	validationMiddleware, err := validation.NewMiddleware(
+		openapispec.Spec,
+		validation.WithResponseValidation(cfg.ResponseValidation),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create validation middleware: %w", err)
+	}
+	log.Info("OpenAPI validation middleware initialized",
+		slog.String("response_validation", string(cfg.ResponseValidation)),
+	)
+
+	// OpenAPI仕様の配信とAPIエクスプローラー
+	docsHandler, err := apidocs.NewHandler(openapispec.Spec, "/api/v1")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create API docs handler: %w", err)
+	}
+	explorer := docsHandler.Explorer("/docs")
+	r.Handle("/docs", explorer)
+	r.Handle("/docs/*", explorer)
+
+	// GraphQL（ユーザーと監査ログの読み取りモデル）
+	graphHandler, err := graph.NewHandler(
+		createUserUsecase,
+		findUserUsecase,
+		listUsersUsecase,
+		patchUserUsecase,
+		deleteUserUsecase,
+		listUserLogsUsecase,
+		log,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create GraphQL handler: %w", err)
+	}
+	r.Handle("/graphql", graphHandler)
+
+	// gRPC / Connect（UserService とサーバーリフレクション）
+	userService := rpc.NewUserService(
+		createUserUsecase,
+		findUserUsecase,
+		listUsersUsecase,
+		patchUserUsecase,
+		deleteUserUsecase,
+		cfg.UserEvents,
+		log,
+	)
+	for path, h := range rpc.Handlers(userService) {
+		r.Mount(path, h)
+	}
+
+	// SCIM 2.0 プロビジョニング（OpenAPI仕様の対象外のため /api/v1 のバリデーションを通さない）
+	if len(cfg.SCIMBearerTokens) > 0 {
+		scimHandler := scim.NewHandler(
+			createUserUsecase,
+			findUserUsecase,
+			findUserByEmailUsecase,
+			listUsersUsecase,
+			updateUserUsecase,
+			patchUserUsecase,
+			deleteUserUsecase,
+			cfg.SCIMBearerTokens,
+			log,
+		)
+		r.Mount(scim.BasePath, scimHandler.Routes())
+		log.Info("SCIM provisioning enabled",
+			slog.String("path", scim.BasePath),
+			slog.Int("organizations", len(cfg.SCIMBearerTokens)),
+		)
+	}
+
+	// OpenAPI生成のハンドラーを使用してAPIルートを設定
+	r.Route("/api/v1", func(r chi.Router) {
+		r.Get("/openapi.yaml", docsHandler.ServeYAML)
+		r.Get("/openapi.json", docsHandler.ServeJSON)
+
+		r.Group(func(r chi.Router) {
+			// OpenAPI仕様に基づくリクエスト（およびオプションでレスポンス）バリデーション
+			r.Use(validationMiddleware.Handler)
+			// OpenAPI仕様に従ったルーティングを自動生成
+			openapi.HandlerFromMux(server, r)
+		})
+	})
+
+	return r, nil
+}
+
+// newMetricsHandler Prometheus メトリクス（/metrics）のハンドラーを作成する
+//
+// API とは別の内部向けのリスナー（METRICS_ADDR）で公開し、API のポートには含めない。
+func newMetricsHandler() http.Handler {
+	mux := http.NewServeMux()
+	mux.Handle("GET /metrics", promhttp.Handler())
+	return mux
+}
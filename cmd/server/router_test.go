@@ -0,0 +1,505 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/example/go-react-cqrs-template/internal/auth"
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/eventstream"
+	"github.com/example/go-react-cqrs-template/internal/handler/validation"
+	"github.com/example/go-react-cqrs-template/internal/mail"
+	"github.com/example/go-react-cqrs-template/internal/queryservice"
+	"github.com/example/go-react-cqrs-template/internal/sessioncache"
+	"github.com/example/go-react-cqrs-template/internal/testutil/dbtest"
+	"github.com/example/go-react-cqrs-template/internal/testutil/mailtest"
+	"github.com/example/go-react-cqrs-template/internal/usecase"
+	"github.com/example/go-react-cqrs-template/internal/usercache"
+	"github.com/example/go-react-cqrs-template/pkg/generated/openapi"
+)
+
+// newTestServer 実データベースに接続したルーター全体を起動する
+func newTestServer(t *testing.T) *httptest.Server {
+	t.Helper()
+	srv, _ := newTestServerWithMailDir(t)
+	return srv
+}
+
+// newTestServerWithMailDir ルーター全体を起動し、送信したメールを書き出すディレクトリと共に返す
+func newTestServerWithMailDir(t *testing.T) (*httptest.Server, string) {
+	t.Helper()
+	db := dbtest.New(t)
+	log := slog.New(slog.NewTextHandler(io.Discard, nil))
+	mailDir := t.TempDir()
+	mailer, err := mail.NewFileMailer(mailDir, "no-reply@example.com")
+	if err != nil {
+		t.Fatalf("NewFileMailer() unexpected error: %v", err)
+	}
+
+	keyring := dbtest.Keyring(t)
+
+	// テストではAPI仕様とのずれを 500 として検出する
+	r, err := newRouter(db, log, routerConfig{
+		ResponseValidation: validation.ResponseModeStrict,
+		UserEvents:         eventstream.NewHub(eventstream.NewSQLRepository(db), log),
+		PIIKeyring:         keyring,
+		// 更新・削除後の読み取りでキャッシュの無効化も検証する
+		UserCache: usercache.New(queryservice.NewUserQueryService(db, keyring)),
+		Sessions:  mustNewTokenIssuer(t),
+		// テストではハッシュ化の負荷を下げる
+		PasswordHasher: auth.NewPasswordHasher(auth.Argon2Params{Memory: 64, Iterations: 1, Parallelism: 1, SaltLength: 16, KeyLength: 32}),
+		Lockout:        domain.DefaultLockoutPolicy,
+		SessionPolicy:  domain.DefaultSessionPolicy,
+		// 取り消し後の認証でキャッシュの無効化も検証する
+		SessionCache: sessioncache.New(queryservice.NewSessionQueryService(db)),
+		TOTP:         auth.NewTOTP("Example"),
+		MFAPolicy:    domain.DefaultMFAPolicy,
+		OIDC:         usecase.DefaultOIDCConfig,
+		Mailer:       mailer,
+		UserTokens:   usecase.DefaultUserTokenConfig,
+	})
+	if err != nil {
+		t.Fatalf("newRouter() unexpected error: %v", err)
+	}
+	srv := httptest.NewServer(r)
+	t.Cleanup(srv.Close)
+	return srv, mailDir
+}
+
+// mustNewTokenIssuer テスト用のセッショントークンの発行を作成する
+func mustNewTokenIssuer(t *testing.T) *auth.TokenIssuer {
+	t.Helper()
+	sessions, err := auth.NewTokenIssuer([]byte("0123456789abcdef0123456789abcdef"))
+	if err != nil {
+		t.Fatalf("NewTokenIssuer() unexpected error: %v", err)
+	}
+	return sessions
+}
+
+// doJSON JSONリクエストを送信しレスポンスを返す
+func doJSON(t *testing.T, method, url string, body any) *http.Response {
+	t.Helper()
+	var reader io.Reader
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			t.Fatalf("failed to marshal body: %v", err)
+		}
+		reader = bytes.NewReader(b)
+	}
+	req, err := http.NewRequest(method, url, reader)
+	if err != nil {
+		t.Fatalf("failed to create request: %v", err)
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("%s %s failed: %v", method, url, err)
+	}
+	t.Cleanup(func() { resp.Body.Close() })
+	return resp
+}
+
+// doWithToken セッショントークンを Bearer で送るボディなしのリクエストを送信しレスポンスを返す
+func doWithToken(t *testing.T, method, url, token string) *http.Response {
+	t.Helper()
+	req, err := http.NewRequest(method, url, nil)
+	if err != nil {
+		t.Fatalf("failed to create request: %v", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("%s %s failed: %v", method, url, err)
+	}
+	t.Cleanup(func() { resp.Body.Close() })
+	return resp
+}
+
+// decodeJSON レスポンスボディをデコードする
+func decodeJSON(t *testing.T, resp *http.Response, v any) {
+	t.Helper()
+	if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+}
+
+func TestRouter_UserLifecycle(t *testing.T) {
+	srv := newTestServer(t)
+	base := srv.URL + "/api/v1/users"
+
+	// 作成
+	resp := doJSON(t, http.MethodPost, base, map[string]string{"name": "John Doe", "email": "john@example.com"})
+	if resp.StatusCode != http.StatusCreated {
+		t.Fatalf("POST /users status = %d, want %d", resp.StatusCode, http.StatusCreated)
+	}
+
+	// 重複
+	resp = doJSON(t, http.MethodPost, base, map[string]string{"name": "Other", "email": "john@example.com"})
+	if resp.StatusCode != http.StatusConflict {
+		t.Fatalf("POST /users duplicate status = %d, want %d", resp.StatusCode, http.StatusConflict)
+	}
+
+	// 一覧
+	resp = doJSON(t, http.MethodGet, base, nil)
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("GET /users status = %d, want %d", resp.StatusCode, http.StatusOK)
+	}
+	var list openapi.UserList
+	decodeJSON(t, resp, &list)
+	if list.Total != 1 || len(list.Users) != 1 {
+		t.Fatalf("GET /users = %+v, want one user", list)
+	}
+	id := list.Users[0].Id
+
+	// 取得（読み取りキャッシュに載せる）
+	resp = doJSON(t, http.MethodGet, base+"/"+id, nil)
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("GET /users/{id} status = %d, want %d", resp.StatusCode, http.StatusOK)
+	}
+
+	// 更新
+	resp = doJSON(t, http.MethodPut, base+"/"+id, map[string]string{"name": "John Smith", "email": "john@example.com"})
+	if resp.StatusCode != http.StatusNoContent {
+		t.Fatalf("PUT /users/{id} status = %d, want %d", resp.StatusCode, http.StatusNoContent)
+	}
+
+	// 取得（更新のコミットでキャッシュは無効化されている）
+	resp = doJSON(t, http.MethodGet, base+"/"+id, nil)
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("GET /users/{id} status = %d, want %d", resp.StatusCode, http.StatusOK)
+	}
+	var user openapi.User
+	decodeJSON(t, resp, &user)
+	if user.Name != "John Smith" || string(user.Email) != "john@example.com" {
+		t.Errorf("GET /users/{id} = %+v, want updated name", user)
+	}
+
+	// 削除
+	resp = doJSON(t, http.MethodDelete, base+"/"+id, nil)
+	if resp.StatusCode != http.StatusNoContent {
+		t.Fatalf("DELETE /users/{id} status = %d, want %d", resp.StatusCode, http.StatusNoContent)
+	}
+	resp = doJSON(t, http.MethodGet, base+"/"+id, nil)
+	if resp.StatusCode != http.StatusNotFound {
+		t.Errorf("GET /users/{id} after delete status = %d, want %d", resp.StatusCode, http.StatusNotFound)
+	}
+}
+
+func TestRouter_PasswordLogin(t *testing.T) {
+	srv, mailDir := newTestServerWithMailDir(t)
+	base := srv.URL + "/api/v1"
+
+	resp := doJSON(t, http.MethodPost, base+"/users", map[string]string{"name": "John Doe", "email": "john@example.com"})
+	if resp.StatusCode != http.StatusCreated {
+		t.Fatalf("POST /users status = %d, want %d", resp.StatusCode, http.StatusCreated)
+	}
+	var user openapi.User
+	decodeJSON(t, resp, &user)
+
+	// 未ログインではパスワードを設定できないため、最初のパスワードはリセットのメールで設定する
+	resp = doJSON(t, http.MethodPost, base+"/users/"+user.Id+"/password", map[string]string{"newPassword": "correct horse battery"})
+	if resp.StatusCode != http.StatusUnauthorized {
+		t.Fatalf("POST /users/{id}/password without login status = %d, want %d", resp.StatusCode, http.StatusUnauthorized)
+	}
+	resp = doJSON(t, http.MethodPost, base+"/auth/password-reset", map[string]string{"email": "john@example.com"})
+	if resp.StatusCode != http.StatusAccepted {
+		t.Fatalf("POST /auth/password-reset status = %d, want %d", resp.StatusCode, http.StatusAccepted)
+	}
+	token := mailtest.LinkToken(t, mailtest.Last(t, mailDir))
+	resp = doJSON(t, http.MethodPost, base+"/auth/password-reset:confirm", map[string]string{"token": token, "newPassword": "correct horse battery"})
+	if resp.StatusCode != http.StatusNoContent {
+		t.Fatalf("POST /auth/password-reset:confirm status = %d, want %d", resp.StatusCode, http.StatusNoContent)
+	}
+
+	// 不一致（失敗回数の保存を含めてコミットされる）
+	resp = doJSON(t, http.MethodPost, base+"/auth/login", map[string]string{"email": "john@example.com", "password": "wrong password"})
+	if resp.StatusCode != http.StatusUnauthorized {
+		t.Fatalf("POST /auth/login with wrong password status = %d, want %d", resp.StatusCode, http.StatusUnauthorized)
+	}
+
+	resp = doJSON(t, http.MethodPost, base+"/auth/login", map[string]string{"email": "john@example.com", "password": "correct horse battery"})
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("POST /auth/login status = %d, want %d", resp.StatusCode, http.StatusOK)
+	}
+	var login openapi.LoginResponse
+	decodeJSON(t, resp, &login)
+	if login.User.Id != user.Id {
+		t.Errorf("login.User.Id = %s, want %s", login.User.Id, user.Id)
+	}
+
+	// 発行されたトークンで認証したリクエスト
+	for _, tt := range []struct {
+		token string
+		want  int
+	}{
+		{token: login.Token, want: http.StatusOK},
+		{token: login.Token + "x", want: http.StatusUnauthorized},
+	} {
+		resp := doWithToken(t, http.MethodGet, base+"/users/"+user.Id, tt.token)
+		if resp.StatusCode != tt.want {
+			t.Errorf("GET /users/{id} with token status = %d, want %d", resp.StatusCode, tt.want)
+		}
+	}
+
+	// ログインで作成したセッション
+	resp = doWithToken(t, http.MethodGet, base+"/users/"+user.Id+"/sessions", login.Token)
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("GET /users/{id}/sessions status = %d, want %d", resp.StatusCode, http.StatusOK)
+	}
+	var sessions openapi.SessionList
+	decodeJSON(t, resp, &sessions)
+	if len(sessions.Sessions) != 1 || sessions.Sessions[0].Id != login.SessionId || !sessions.Sessions[0].Current {
+		t.Errorf("GET /users/{id}/sessions = %+v, want current session %s", sessions, login.SessionId)
+	}
+
+	// 取り消したセッションのトークンは認証に使用できない（キャッシュ済みのセッションも削除される）
+	resp = doWithToken(t, http.MethodPost, base+"/users/"+user.Id+"/sessions:revoke", login.Token)
+	if resp.StatusCode != http.StatusNoContent {
+		t.Fatalf("POST /users/{id}/sessions:revoke status = %d, want %d", resp.StatusCode, http.StatusNoContent)
+	}
+	resp = doWithToken(t, http.MethodGet, base+"/users/"+user.Id, login.Token)
+	if resp.StatusCode != http.StatusUnauthorized {
+		t.Errorf("GET /users/{id} with revoked token status = %d, want %d", resp.StatusCode, http.StatusUnauthorized)
+	}
+}
+
+func TestRouter_EmailVerificationAndPasswordReset(t *testing.T) {
+	srv, mailDir := newTestServerWithMailDir(t)
+	base := srv.URL + "/api/v1"
+
+	resp := doJSON(t, http.MethodPost, base+"/users", map[string]string{"name": "John Doe", "email": "john@example.com"})
+	if resp.StatusCode != http.StatusCreated {
+		t.Fatalf("POST /users status = %d, want %d", resp.StatusCode, http.StatusCreated)
+	}
+	var user openapi.User
+	decodeJSON(t, resp, &user)
+
+	// パスワードのリセット
+	resp = doJSON(t, http.MethodPost, base+"/auth/password-reset", map[string]string{"email": "john@example.com"})
+	if resp.StatusCode != http.StatusAccepted {
+		t.Fatalf("POST /auth/password-reset status = %d, want %d", resp.StatusCode, http.StatusAccepted)
+	}
+	token := mailtest.LinkToken(t, mailtest.Last(t, mailDir))
+	resp = doJSON(t, http.MethodPost, base+"/auth/password-reset:confirm", map[string]string{"token": token, "newPassword": "brand new password"})
+	if resp.StatusCode != http.StatusNoContent {
+		t.Fatalf("POST /auth/password-reset:confirm status = %d, want %d", resp.StatusCode, http.StatusNoContent)
+	}
+	resp = doJSON(t, http.MethodPost, base+"/auth/login", map[string]string{"email": "john@example.com", "password": "brand new password"})
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("POST /auth/login with reset password status = %d, want %d", resp.StatusCode, http.StatusOK)
+	}
+	var login openapi.LoginResponse
+	decodeJSON(t, resp, &login)
+
+	// ログイン中に確認のメールを送り、トークンで確認する（トークンの保存と使用済みへの更新がコミットされる）
+	resp = doWithToken(t, http.MethodPost, base+"/users/"+user.Id+"/email-verification", login.Token)
+	if resp.StatusCode != http.StatusAccepted {
+		t.Fatalf("POST /users/{id}/email-verification status = %d, want %d", resp.StatusCode, http.StatusAccepted)
+	}
+	token = mailtest.LinkToken(t, mailtest.Last(t, mailDir))
+	resp = doJSON(t, http.MethodPost, base+"/auth/email-verification:confirm", map[string]string{"token": token})
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("POST /auth/email-verification:confirm status = %d, want %d", resp.StatusCode, http.StatusOK)
+	}
+	resp = doJSON(t, http.MethodGet, base+"/users/"+user.Id, nil)
+	decodeJSON(t, resp, &user)
+	if user.EmailVerifiedAt == nil {
+		t.Errorf("GET /users/{id} emailVerifiedAt = nil, want timestamp")
+	}
+	resp = doJSON(t, http.MethodPost, base+"/auth/email-verification:confirm", map[string]string{"token": token})
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Errorf("reused token status = %d, want %d", resp.StatusCode, http.StatusBadRequest)
+	}
+}
+
+func TestRouter_Invitations(t *testing.T) {
+	srv, mailDir := newTestServerWithMailDir(t)
+	base := srv.URL + "/api/v1"
+
+	resp := doJSON(t, http.MethodPost, base+"/invitations", map[string]string{"email": "john@example.com"})
+	if resp.StatusCode != http.StatusCreated {
+		t.Fatalf("POST /invitations status = %d, want %d", resp.StatusCode, http.StatusCreated)
+	}
+	var inv openapi.Invitation
+	decodeJSON(t, resp, &inv)
+
+	resp = doJSON(t, http.MethodGet, base+"/invitations", nil)
+	var list openapi.InvitationList
+	decodeJSON(t, resp, &list)
+	if list.Total != 1 || len(list.Invitations) != 1 || list.Invitations[0].Id != inv.Id {
+		t.Errorf("GET /invitations = %+v, want the created invitation", list)
+	}
+
+	// 再送したリンクで承諾する（トークンの置き換えと承諾がコミットされる）
+	resp = doJSON(t, http.MethodPost, base+"/invitations/"+inv.Id+":resend", nil)
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("POST /invitations/{id}:resend status = %d, want %d", resp.StatusCode, http.StatusOK)
+	}
+	token := mailtest.LinkToken(t, mailtest.Last(t, mailDir))
+	resp = doJSON(t, http.MethodPost, base+"/invitations:accept", map[string]string{"token": token, "name": "John Doe", "password": "correct horse battery"})
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("POST /invitations:accept status = %d, want %d", resp.StatusCode, http.StatusOK)
+	}
+	resp = doJSON(t, http.MethodGet, base+"/users/"+inv.UserId, nil)
+	var user openapi.User
+	decodeJSON(t, resp, &user)
+	if user.Status != openapi.Active || user.Name != "John Doe" || user.EmailVerifiedAt == nil {
+		t.Errorf("GET /users/{id} = %+v, want active verified John Doe", user)
+	}
+	resp = doJSON(t, http.MethodPost, base+"/auth/login", map[string]string{"email": "john@example.com", "password": "correct horse battery"})
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("POST /auth/login as invited user status = %d, want %d", resp.StatusCode, http.StatusOK)
+	}
+
+	// 承諾済みの招待は一覧に含まれず、取り消せない
+	resp = doJSON(t, http.MethodGet, base+"/invitations", nil)
+	decodeJSON(t, resp, &list)
+	if list.Total != 0 {
+		t.Errorf("GET /invitations total = %d, want 0", list.Total)
+	}
+	resp = doJSON(t, http.MethodPost, base+"/invitations/"+inv.Id+":revoke", nil)
+	if resp.StatusCode != http.StatusConflict {
+		t.Errorf("POST /invitations/{id}:revoke status = %d, want %d", resp.StatusCode, http.StatusConflict)
+	}
+}
+
+func TestRouter_RequestValidation(t *testing.T) {
+	srv := newTestServer(t)
+	base := srv.URL + "/api/v1/users"
+
+	tests := []struct {
+		name   string
+		method string
+		url    string
+		body   any
+	}{
+		{name: "invalid email", method: http.MethodPost, url: base, body: map[string]string{"name": "John", "email": "invalid"}},
+		{name: "missing name", method: http.MethodPost, url: base, body: map[string]string{"email": "john@example.com"}},
+		{name: "limit out of range", method: http.MethodGet, url: base + "?limit=999"},
+		{name: "invalid user id", method: http.MethodGet, url: base + "/not-a-ulid"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := doJSON(t, tt.method, tt.url, tt.body)
+			if resp.StatusCode != http.StatusBadRequest {
+				t.Errorf("status = %d, want %d", resp.StatusCode, http.StatusBadRequest)
+			}
+		})
+	}
+}
+
+func TestRouter_APIDocs(t *testing.T) {
+	// 仕様の配信はデータベースを使わない
+	log := slog.New(slog.NewTextHandler(io.Discard, nil))
+	r, err := newRouter(nil, log, routerConfig{
+		ResponseValidation: validation.ResponseModeStrict,
+		UserEvents:         eventstream.NewHub(eventstream.NewSQLRepository(nil), log),
+	})
+	if err != nil {
+		t.Fatalf("newRouter() unexpected error: %v", err)
+	}
+	srv := httptest.NewServer(r)
+	t.Cleanup(srv.Close)
+
+	tests := []struct {
+		path            string
+		wantContentType string
+	}{
+		{path: "/api/v1/openapi.yaml", wantContentType: "application/yaml"},
+		{path: "/api/v1/openapi.json", wantContentType: "application/json"},
+		{path: "/docs/", wantContentType: "text/html; charset=utf-8"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			resp := doJSON(t, http.MethodGet, srv.URL+tt.path, nil)
+			if resp.StatusCode != http.StatusOK {
+				t.Fatalf("status = %d, want %d", resp.StatusCode, http.StatusOK)
+			}
+			if ct := resp.Header.Get("Content-Type"); ct != tt.wantContentType {
+				t.Errorf("Content-Type = %q, want %q", ct, tt.wantContentType)
+			}
+		})
+	}
+}
+
+// TestRouter_Metrics メトリクスは API のルーターではなく内部向けのハンドラーで公開する
+func TestRouter_Metrics(t *testing.T) {
+	log := slog.New(slog.NewTextHandler(io.Discard, nil))
+	r, err := newRouter(nil, log, routerConfig{
+		ResponseValidation: validation.ResponseModeStrict,
+		UserEvents:         eventstream.NewHub(eventstream.NewSQLRepository(nil), log),
+	})
+	if err != nil {
+		t.Fatalf("newRouter() unexpected error: %v", err)
+	}
+	srv := httptest.NewServer(r)
+	t.Cleanup(srv.Close)
+	if resp := doJSON(t, http.MethodGet, srv.URL+"/metrics", nil); resp.StatusCode == http.StatusOK {
+		t.Errorf("GET /metrics on API status = %d, want not found", resp.StatusCode)
+	}
+
+	metrics := httptest.NewServer(newMetricsHandler())
+	t.Cleanup(metrics.Close)
+	resp := doJSON(t, http.MethodGet, metrics.URL+"/metrics", nil)
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("GET /metrics status = %d, want %d", resp.StatusCode, http.StatusOK)
+	}
+	if ct := resp.Header.Get("Content-Type"); !strings.HasPrefix(ct, "text/plain") {
+		t.Errorf("Content-Type = %q, want text/plain", ct)
+	}
+}
+
+func TestRouter_GraphQL(t *testing.T) {
+	srv := newTestServer(t)
+
+	resp := doJSON(t, http.MethodPost, srv.URL+"/graphql", map[string]string{
+		"query": `mutation { createUser(input: {name: "John Doe", email: "john@example.com"}) { id } }`,
+	})
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("POST /graphql status = %d, want %d", resp.StatusCode, http.StatusOK)
+	}
+
+	// 一覧のユーザーのログは GetUserLogsByUserIDs でまとめて取得される
+	resp = doJSON(t, http.MethodPost, srv.URL+"/graphql", map[string]string{
+		"query": `{ users(first: 10) { totalCount edges { node { email logs { totalCount edges { node { action } } } } } } }`,
+	})
+	var got struct {
+		Data struct {
+			Users struct {
+				TotalCount int
+				Edges      []struct {
+					Node struct {
+						Email string
+						Logs  struct {
+							TotalCount int
+							Edges      []struct{ Node struct{ Action string } }
+						}
+					}
+				}
+			}
+		}
+		Errors []map[string]any
+	}
+	decodeJSON(t, resp, &got)
+	if len(got.Errors) > 0 {
+		t.Fatalf("errors = %v", got.Errors)
+	}
+	users := got.Data.Users
+	if users.TotalCount != 1 || len(users.Edges) != 1 || users.Edges[0].Node.Email != "john@example.com" {
+		t.Fatalf("users = %+v", users)
+	}
+	if logs := users.Edges[0].Node.Logs; logs.TotalCount != 1 || logs.Edges[0].Node.Action != "CREATED" {
+		t.Errorf("logs = %+v, want one CREATED", logs)
+	}
+}
@@ -0,0 +1,38 @@
+-- 既存のデータベースを組織（テナント）対応のスキーマに移行する
+-- 既存の行はすべて既定の組織（tenant.DefaultOrgID）に所属させる。
+-- NOT NULL 列の追加は既存の行があると psqldef では適用できないため、このファイルを先に適用してから task db:migrate を実行する。
+
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS organizations (
+    id VARCHAR(26) PRIMARY KEY,
+    name VARCHAR(255) NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE INDEX IF NOT EXISTS idx_organizations_created_at ON organizations(created_at DESC);
+
+INSERT INTO organizations (id, name)
+VALUES ('00000000000000000000000000', 'Default')
+ON CONFLICT (id) DO NOTHING;
+
+ALTER TABLE users ADD COLUMN org_id VARCHAR(26) NOT NULL DEFAULT '00000000000000000000000000' REFERENCES organizations(id);
+ALTER TABLE users ALTER COLUMN org_id DROP DEFAULT;
+ALTER TABLE users DROP CONSTRAINT IF EXISTS users_email_key;
+ALTER TABLE users ADD CONSTRAINT users_org_id_email_key UNIQUE (org_id, email);
+DROP INDEX IF EXISTS idx_users_email;
+DROP INDEX IF EXISTS idx_users_created_at;
+CREATE INDEX IF NOT EXISTS idx_users_org_created_at ON users(org_id, created_at DESC);
+
+ALTER TABLE user_logs ADD COLUMN org_id VARCHAR(26) NOT NULL DEFAULT '00000000000000000000000000' REFERENCES organizations(id);
+ALTER TABLE user_logs ALTER COLUMN org_id DROP DEFAULT;
+
+ALTER TABLE webhook_subscriptions ADD COLUMN org_id VARCHAR(26) NOT NULL DEFAULT '00000000000000000000000000' REFERENCES organizations(id);
+ALTER TABLE webhook_subscriptions ALTER COLUMN org_id DROP DEFAULT;
+DROP INDEX IF EXISTS idx_webhook_subscriptions_created_at;
+CREATE INDEX IF NOT EXISTS idx_webhook_subscriptions_org_created_at ON webhook_subscriptions(org_id, created_at DESC);
+
+ALTER TABLE outbox ADD COLUMN org_id VARCHAR(26) NOT NULL DEFAULT '00000000000000000000000000';
+ALTER TABLE outbox ALTER COLUMN org_id DROP DEFAULT;
+
+COMMIT;
@@ -0,0 +1,15 @@
+-- 既存のデータベースのユーザーの名前とメールアドレスを暗号化するための列を追加する
+-- 既存の行があると NOT NULL の列を psqldef で追加できないため、以下の順に適用する:
+--   1. このファイルを psql で適用する（暗号化した列を NULL 許容で追加する）
+--   2. go run ./cmd/pii-keyring encrypt-users で既存の行を暗号化する
+--   3. task db:migrate で平文の列（name / email）を削除し、暗号化した列を NOT NULL にする
+
+BEGIN;
+
+ALTER TABLE users ADD COLUMN IF NOT EXISTS name_ciphertext BYTEA;
+ALTER TABLE users ADD COLUMN IF NOT EXISTS email_ciphertext BYTEA;
+ALTER TABLE users ADD COLUMN IF NOT EXISTS email_index BYTEA;
+ALTER TABLE users ADD COLUMN IF NOT EXISTS pii_key_id VARCHAR(64);
+ALTER TABLE users ADD COLUMN IF NOT EXISTS pii_data_key BYTEA;
+
+COMMIT;
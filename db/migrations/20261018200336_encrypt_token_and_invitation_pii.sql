@@ -0,0 +1,25 @@
+-- メールで送るトークンと招待のメールアドレスを暗号化するための列を追加し、
+-- outbox と Webhook の配信に保存済みのユーザーの名前とメールアドレスを削除する
+-- 既存の行があると NOT NULL の列を psqldef で追加できないため、以下の順に適用する:
+--   1. このファイルを psql で適用する（暗号化した列を NULL 許容で追加する）
+--   2. go run ./cmd/pii-keyring encrypt-users で既存の行を暗号化する
+--   3. task db:migrate で平文の列（user_tokens.email / invitations.email / user_external_identities.email）を削除し、
+--      暗号化した列を NOT NULL にする
+
+BEGIN;
+
+ALTER TABLE user_tokens ADD COLUMN IF NOT EXISTS email_ciphertext BYTEA;
+ALTER TABLE user_tokens ADD COLUMN IF NOT EXISTS pii_key_id VARCHAR(64);
+ALTER TABLE user_tokens ADD COLUMN IF NOT EXISTS pii_data_key BYTEA;
+
+ALTER TABLE invitations ADD COLUMN IF NOT EXISTS email_ciphertext BYTEA;
+ALTER TABLE invitations ADD COLUMN IF NOT EXISTS pii_key_id VARCHAR(64);
+ALTER TABLE invitations ADD COLUMN IF NOT EXISTS pii_data_key BYTEA;
+
+UPDATE outbox SET payload = payload - 'name' - 'email'
+WHERE aggregate_type = 'user' AND (payload ? 'name' OR payload ? 'email');
+
+UPDATE webhook_deliveries SET payload = payload #- '{data,name}' #- '{data,email}'
+WHERE payload -> 'data' ? 'name' OR payload -> 'data' ? 'email';
+
+COMMIT;
@@ -0,0 +1,57 @@
+-- name: GetGroupByID :one
+SELECT id, org_id, name, created_at, updated_at
+FROM groups
+WHERE org_id = $1 AND id = $2;
+
+-- name: GetGroupByIDForUpdate :one
+SELECT id, org_id, name, created_at, updated_at
+FROM groups
+WHERE org_id = $1 AND id = $2
+FOR UPDATE;
+
+-- name: GetGroupByNameForUpdate :one
+SELECT id, org_id, name, created_at, updated_at
+FROM groups
+WHERE org_id = $1 AND name = $2
+FOR UPDATE;
+
+-- name: UpsertGroup :exec
+INSERT INTO groups (id, org_id, name, created_at, updated_at)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (id) DO UPDATE SET
+    name = EXCLUDED.name,
+    updated_at = EXCLUDED.updated_at;
+
+-- name: GetGroupMember :one
+SELECT group_id, user_id, org_id, role, created_at, updated_at
+FROM group_members
+WHERE org_id = $1 AND group_id = $2 AND user_id = $3;
+
+-- name: UpsertGroupMember :exec
+INSERT INTO group_members (group_id, user_id, org_id, role, created_at, updated_at)
+VALUES ($1, $2, $3, $4, $5, $6)
+ON CONFLICT (group_id, user_id) DO UPDATE SET
+    role = EXCLUDED.role,
+    updated_at = EXCLUDED.updated_at;
+
+-- name: DeleteGroupMember :execrows
+DELETE FROM group_members
+WHERE org_id = $1 AND group_id = $2 AND user_id = $3;
+
+-- name: ListGroupMembers :many
+SELECT group_id, user_id, org_id, role, created_at, updated_at
+FROM group_members
+WHERE org_id = $1 AND group_id = $2
+ORDER BY created_at, user_id
+LIMIT $3 OFFSET $4;
+
+-- name: CountGroupMembers :one
+SELECT COUNT(*) FROM group_members WHERE org_id = $1 AND group_id = $2;
+
+-- name: ListGroupsByUserID :many
+-- ユーザーが所属するグループをグループ名の順にロールと共に取得する
+SELECT g.id, g.name, g.created_at, g.updated_at, m.role, m.created_at AS joined_at
+FROM group_members m
+JOIN groups g ON g.id = m.group_id
+WHERE m.org_id = $1 AND m.user_id = $2
+ORDER BY g.name, g.id;
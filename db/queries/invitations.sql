@@ -0,0 +1,58 @@
+-- name: GetInvitationByIDForUpdate :one
+SELECT id, org_id, user_id, email_ciphertext, pii_key_id, pii_data_key, invited_by, status, token_hash, expires_at, accepted_at, created_at, updated_at
+FROM invitations
+WHERE org_id = $1 AND id = $2
+FOR UPDATE;
+
+-- name: GetInvitationByTokenHashForUpdate :one
+SELECT id, org_id, user_id, email_ciphertext, pii_key_id, pii_data_key, invited_by, status, token_hash, expires_at, accepted_at, created_at, updated_at
+FROM invitations
+WHERE org_id = $1 AND token_hash = $2
+FOR UPDATE;
+
+-- name: ListExpiredInvitationsForUpdate :many
+-- 有効期限を過ぎた承諾待ちの招待を取得する（他のトランザクションが処理中の招待は飛ばす）
+SELECT id, org_id, user_id, email_ciphertext, pii_key_id, pii_data_key, invited_by, status, token_hash, expires_at, accepted_at, created_at, updated_at
+FROM invitations
+WHERE org_id = $1 AND status = 'pending' AND expires_at <= $2
+ORDER BY expires_at, id
+LIMIT $3
+FOR UPDATE SKIP LOCKED;
+
+-- name: ListInvitationDataKeysForUpdate :many
+-- 主鍵以外の鍵で暗号化したデータキーを取得する（他のトランザクションがロック中の行は飛ばす）
+SELECT id, pii_key_id, pii_data_key
+FROM invitations
+WHERE org_id = $1 AND pii_key_id <> $2
+ORDER BY id
+LIMIT $3
+FOR UPDATE SKIP LOCKED;
+
+-- name: UpdateInvitationDataKey :exec
+UPDATE invitations
+SET pii_key_id = $1, pii_data_key = $2
+WHERE org_id = $3 AND id = $4;
+
+-- name: UpsertInvitation :exec
+INSERT INTO invitations (id, org_id, user_id, email_ciphertext, pii_key_id, pii_data_key, invited_by, status, token_hash, expires_at, accepted_at, created_at, updated_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+ON CONFLICT (id) DO UPDATE SET
+    email_ciphertext = EXCLUDED.email_ciphertext,
+    pii_key_id = EXCLUDED.pii_key_id,
+    pii_data_key = EXCLUDED.pii_data_key,
+    status = EXCLUDED.status,
+    token_hash = EXCLUDED.token_hash,
+    expires_at = EXCLUDED.expires_at,
+    accepted_at = EXCLUDED.accepted_at,
+    updated_at = EXCLUDED.updated_at;
+
+-- name: ListPendingInvitations :many
+-- 承諾待ちで有効期限内の招待を新しい順に取得する
+SELECT id, org_id, user_id, email_ciphertext, pii_key_id, pii_data_key, invited_by, status, token_hash, expires_at, accepted_at, created_at, updated_at
+FROM invitations
+WHERE org_id = $1 AND status = 'pending' AND expires_at > $2
+ORDER BY created_at DESC, id DESC
+LIMIT $3 OFFSET $4;
+
+-- name: CountPendingInvitations :one
+SELECT COUNT(*) FROM invitations WHERE org_id = $1 AND status = 'pending' AND expires_at > $2;
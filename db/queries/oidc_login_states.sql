@@ -0,0 +1,11 @@
+-- name: GetOIDCLoginStateByHashForUpdate :one
+SELECT id, org_id, provider, state_hash, nonce, code_verifier, expires_at, used_at, created_at
+FROM oidc_login_states
+WHERE org_id = $1 AND provider = $2 AND state_hash = $3
+FOR UPDATE;
+
+-- name: UpsertOIDCLoginState :exec
+INSERT INTO oidc_login_states (id, org_id, provider, state_hash, nonce, code_verifier, expires_at, used_at, created_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+ON CONFLICT (id) DO UPDATE SET
+    used_at = EXCLUDED.used_at;
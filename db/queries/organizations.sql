@@ -0,0 +1,20 @@
+-- name: GetOrganizationByID :one
+SELECT id, name, created_at, updated_at
+FROM organizations
+WHERE id = $1;
+
+-- name: ListOrganizations :many
+SELECT id, name, created_at, updated_at
+FROM organizations
+ORDER BY created_at DESC, id DESC
+LIMIT $1 OFFSET $2;
+
+-- name: CountOrganizations :one
+SELECT COUNT(*) FROM organizations;
+
+-- name: UpsertOrganization :exec
+INSERT INTO organizations (id, name, created_at, updated_at)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (id) DO UPDATE SET
+    name = EXCLUDED.name,
+    updated_at = EXCLUDED.updated_at;
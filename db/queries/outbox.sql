@@ -0,0 +1,59 @@
+-- name: CreateOutboxEvent :exec
+INSERT INTO outbox (id, org_id, aggregate_type, aggregate_id, event_type, payload, occurred_at, next_attempt_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8);
+
+-- name: ClaimOutboxEvents :many
+-- 集約ごとに最も古い未配信イベントのみを行ロック付きで取得する（同一集約内の配信順序を保証）
+-- リレーはシステム全体で一つのため組織をまたいで取得する
+SELECT seq, id, org_id, aggregate_type, aggregate_id, event_type, payload, occurred_at, published_at, attempts, last_error, next_attempt_at
+FROM outbox o
+WHERE o.published_at IS NULL
+  AND o.next_attempt_at <= $1
+  AND NOT EXISTS (
+    SELECT 1
+    FROM outbox earlier
+    WHERE earlier.aggregate_type = o.aggregate_type
+      AND earlier.aggregate_id = o.aggregate_id
+      AND earlier.published_at IS NULL
+      AND earlier.seq < o.seq
+  )
+ORDER BY o.seq
+LIMIT $2
+FOR UPDATE SKIP LOCKED;
+
+-- name: MarkOutboxEventPublished :exec
+UPDATE outbox
+SET published_at = $1, last_error = NULL
+WHERE seq = $2;
+
+-- name: MarkOutboxEventFailed :exec
+UPDATE outbox
+SET attempts = attempts + 1, last_error = $1, next_attempt_at = $2
+WHERE seq = $3;
+
+-- name: DeletePublishedOutboxEvents :execrows
+DELETE FROM outbox
+WHERE published_at IS NOT NULL AND published_at < $1;
+
+-- name: ListOutboxEventsByAggregate :many
+SELECT seq, id, org_id, aggregate_type, aggregate_id, event_type, payload, occurred_at, published_at, attempts, last_error, next_attempt_at
+FROM outbox
+WHERE aggregate_type = $1 AND aggregate_id = $2
+ORDER BY seq;
+
+-- name: ListOutboxEventsAfter :many
+-- 変更ストリームの追従と Last-Event-ID からの再送に使用する（配信状態に関わらず seq 順）
+-- 組織をまたいで取得し、購読者ごとに org_id で絞り込む
+SELECT seq, id, org_id, aggregate_type, aggregate_id, event_type, payload, occurred_at, published_at, attempts, last_error, next_attempt_at
+FROM outbox
+WHERE seq > $1
+ORDER BY seq
+LIMIT $2;
+
+-- name: GetOutboxSeqBounds :one
+SELECT COALESCE(MIN(seq), 0)::BIGINT AS min_seq, COALESCE(MAX(seq), 0)::BIGINT AS max_seq
+FROM outbox;
+
+-- name: NotifyOutbox :exec
+-- NOTIFY はトランザクションのコミット時に配送され、ロールバック時は破棄される
+SELECT pg_notify(sqlc.arg(channel)::TEXT, sqlc.arg(payload)::TEXT);
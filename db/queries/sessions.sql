@@ -0,0 +1,36 @@
+-- name: GetSessionByID :one
+SELECT id, org_id, user_id, device, ip_address, user_agent, created_at, last_seen_at, expires_at, revoked_at
+FROM sessions
+WHERE org_id = $1 AND id = $2;
+
+-- name: GetSessionByIDForUpdate :one
+SELECT id, org_id, user_id, device, ip_address, user_agent, created_at, last_seen_at, expires_at, revoked_at
+FROM sessions
+WHERE org_id = $1 AND id = $2
+FOR UPDATE;
+
+-- name: ListActiveSessionsByUserID :many
+-- 取り消されておらず有効期限内のユーザーのセッションを最近使用した順に取得する
+SELECT id, org_id, user_id, device, ip_address, user_agent, created_at, last_seen_at, expires_at, revoked_at
+FROM sessions
+WHERE org_id = $1 AND user_id = $2 AND revoked_at IS NULL AND expires_at > $3 AND last_seen_at > $4
+ORDER BY last_seen_at DESC, id DESC;
+
+-- name: ListUnexpiredSessionsByUserIDForUpdate :many
+-- 取り消されておらずログインからの有効期限内のユーザーのセッションを取得する（無操作で失効したセッションも含む）
+SELECT id, org_id, user_id, device, ip_address, user_agent, created_at, last_seen_at, expires_at, revoked_at
+FROM sessions
+WHERE org_id = $1 AND user_id = $2 AND revoked_at IS NULL AND expires_at > $3
+ORDER BY id
+FOR UPDATE;
+
+-- name: NotifySessionChanged :exec
+-- セッションの取り消しをコミット時に各レプリカの読み取りキャッシュへ通知する（ロールバック時は破棄される）
+SELECT pg_notify(sqlc.arg(channel)::TEXT, sqlc.arg(session_key)::TEXT);
+
+-- name: UpsertSession :exec
+INSERT INTO sessions (id, org_id, user_id, device, ip_address, user_agent, created_at, last_seen_at, expires_at, revoked_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+ON CONFLICT (id) DO UPDATE SET
+    last_seen_at = EXCLUDED.last_seen_at,
+    revoked_at = EXCLUDED.revoked_at;
@@ -0,0 +1,15 @@
+-- name: GetUserCredentialForUpdate :one
+SELECT user_id, org_id, password_hash, failed_attempts, locked_until, password_changed_at, created_at, updated_at
+FROM user_credentials
+WHERE org_id = $1 AND user_id = $2
+FOR UPDATE;
+
+-- name: UpsertUserCredential :exec
+INSERT INTO user_credentials (user_id, org_id, password_hash, failed_attempts, locked_until, password_changed_at, created_at, updated_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+ON CONFLICT (user_id) DO UPDATE SET
+    password_hash = EXCLUDED.password_hash,
+    failed_attempts = EXCLUDED.failed_attempts,
+    locked_until = EXCLUDED.locked_until,
+    password_changed_at = EXCLUDED.password_changed_at,
+    updated_at = EXCLUDED.updated_at;
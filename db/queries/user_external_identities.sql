@@ -0,0 +1,11 @@
+-- name: GetUserExternalIdentityForUpdate :one
+SELECT org_id, provider, subject, user_id, last_login_at, created_at
+FROM user_external_identities
+WHERE org_id = $1 AND provider = $2 AND subject = $3
+FOR UPDATE;
+
+-- name: UpsertUserExternalIdentity :exec
+INSERT INTO user_external_identities (org_id, provider, subject, user_id, last_login_at, created_at)
+VALUES ($1, $2, $3, $4, $5, $6)
+ON CONFLICT (org_id, provider, subject) DO UPDATE SET
+    last_login_at = EXCLUDED.last_login_at;
@@ -1,13 +1,31 @@
 -- name: CreateUserLog :exec
-INSERT INTO user_logs (id, user_id, action, created_at)
-VALUES ($1, $2, $3, $4);
+INSERT INTO user_logs (id, org_id, user_id, action, reason, created_at)
+VALUES ($1, $2, $3, $4, $5, $6);
 
 -- name: GetUserLogsByUserID :many
-SELECT id, user_id, action, created_at
+SELECT id, org_id, user_id, action, reason, created_at
 FROM user_logs
-WHERE user_id = $1
+WHERE org_id = $1 AND user_id = $2
 ORDER BY created_at DESC
-LIMIT $2 OFFSET $3;
+LIMIT $3 OFFSET $4;
 
 -- name: CountUserLogsByUserID :one
-SELECT COUNT(*) FROM user_logs WHERE user_id = $1;
+SELECT COUNT(*) FROM user_logs WHERE org_id = $1 AND user_id = $2;
+
+-- name: GetUserLogsByUserIDs :many
+-- 複数ユーザーのログをまとめて取得する（ユーザーごとに新しい順で row_offset / row_limit を適用する）
+SELECT id, user_id, action, reason, created_at
+FROM (
+    SELECT id, user_id, action, reason, created_at,
+           ROW_NUMBER() OVER (PARTITION BY user_id ORDER BY created_at DESC, id DESC) AS rn
+    FROM user_logs
+    WHERE org_id = sqlc.arg(org_id) AND user_id = ANY(sqlc.arg(user_ids)::TEXT[])
+) ranked
+WHERE rn > sqlc.arg(row_offset)::INT AND rn <= sqlc.arg(row_offset)::INT + sqlc.arg(row_limit)::INT
+ORDER BY user_id, rn;
+
+-- name: CountUserLogsByUserIDs :many
+SELECT user_id, COUNT(*) AS count
+FROM user_logs
+WHERE org_id = sqlc.arg(org_id) AND user_id = ANY(sqlc.arg(user_ids)::TEXT[])
+GROUP BY user_id;
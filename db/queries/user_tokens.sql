@@ -0,0 +1,31 @@
+-- name: GetUserTokenByHashForUpdate :one
+SELECT id, org_id, user_id, purpose, token_hash, email_ciphertext, pii_key_id, pii_data_key, expires_at, used_at, created_at
+FROM user_tokens
+WHERE org_id = $1 AND purpose = $2 AND token_hash = $3
+FOR UPDATE;
+
+-- name: ListUserTokenDataKeysForUpdate :many
+-- 主鍵以外の鍵で暗号化したデータキーを取得する（他のトランザクションがロック中の行は飛ばす）
+SELECT id, pii_key_id, pii_data_key
+FROM user_tokens
+WHERE org_id = $1 AND pii_key_id <> $2
+ORDER BY id
+LIMIT $3
+FOR UPDATE SKIP LOCKED;
+
+-- name: RevokeUserTokens :exec
+-- ユーザーの未使用のトークンを使用済みにする（新しいトークンの発行時に以前のトークンを無効化する）
+UPDATE user_tokens
+SET used_at = $4
+WHERE org_id = $1 AND user_id = $2 AND purpose = $3 AND used_at IS NULL;
+
+-- name: UpdateUserTokenDataKey :exec
+UPDATE user_tokens
+SET pii_key_id = $1, pii_data_key = $2
+WHERE org_id = $3 AND id = $4;
+
+-- name: UpsertUserToken :exec
+INSERT INTO user_tokens (id, org_id, user_id, purpose, token_hash, email_ciphertext, pii_key_id, pii_data_key, expires_at, used_at, created_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+ON CONFLICT (id) DO UPDATE SET
+    used_at = EXCLUDED.used_at;
@@ -0,0 +1,19 @@
+-- name: DeleteUserTOTPCredential :exec
+DELETE FROM user_totp_credentials
+WHERE org_id = $1 AND user_id = $2;
+
+-- name: GetUserTOTPCredentialForUpdate :one
+SELECT user_id, org_id, secret, confirmed_at, last_used_step, recovery_code_hashes, created_at, updated_at
+FROM user_totp_credentials
+WHERE org_id = $1 AND user_id = $2
+FOR UPDATE;
+
+-- name: UpsertUserTOTPCredential :exec
+INSERT INTO user_totp_credentials (user_id, org_id, secret, confirmed_at, last_used_step, recovery_code_hashes, created_at, updated_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+ON CONFLICT (user_id) DO UPDATE SET
+    secret = EXCLUDED.secret,
+    confirmed_at = EXCLUDED.confirmed_at,
+    last_used_step = EXCLUDED.last_used_step,
+    recovery_code_hashes = EXCLUDED.recovery_code_hashes,
+    updated_at = EXCLUDED.updated_at;
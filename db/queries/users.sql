@@ -1,50 +1,79 @@
 -- name: GetUserByID :one
-SELECT id, name, email, created_at, updated_at
+SELECT id, org_id, name_ciphertext, email_ciphertext, email_index, pii_key_id, pii_data_key, status, email_verified_at, created_at, updated_at
 FROM users
-WHERE id = $1;
+WHERE org_id = $1 AND id = $2;
 
 -- name: GetUserByEmail :one
-SELECT id, name, email, created_at, updated_at
+SELECT id, org_id, name_ciphertext, email_ciphertext, email_index, pii_key_id, pii_data_key, status, email_verified_at, created_at, updated_at
 FROM users
-WHERE email = $1;
+WHERE org_id = $1 AND email_index = $2;
 
 -- name: ListUsers :many
-SELECT id, name, email, created_at, updated_at
+SELECT id, org_id, name_ciphertext, email_ciphertext, email_index, pii_key_id, pii_data_key, status, email_verified_at, created_at, updated_at
 FROM users
+WHERE org_id = sqlc.arg(org_id)
+  AND (sqlc.narg(status)::text IS NULL OR status = sqlc.narg(status))
 ORDER BY created_at DESC
-LIMIT $1 OFFSET $2;
+LIMIT sqlc.arg('limit') OFFSET sqlc.arg('offset');
 
 -- name: CountUsers :one
-SELECT COUNT(*) FROM users;
+SELECT COUNT(*) FROM users
+WHERE org_id = sqlc.arg(org_id)
+  AND (sqlc.narg(status)::text IS NULL OR status = sqlc.narg(status));
 
 -- name: CreateUser :exec
-INSERT INTO users (id, name, email, created_at, updated_at)
-VALUES ($1, $2, $3, $4, $5);
+INSERT INTO users (id, org_id, name_ciphertext, email_ciphertext, email_index, pii_key_id, pii_data_key, created_at, updated_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9);
 
 -- name: UpdateUser :exec
 UPDATE users
-SET name = $1, email = $2, updated_at = $3
-WHERE id = $4;
+SET name_ciphertext = $1, email_ciphertext = $2, email_index = $3, pii_key_id = $4, pii_data_key = $5, updated_at = $6
+WHERE org_id = $7 AND id = $8;
 
 -- name: DeleteUser :exec
-DELETE FROM users WHERE id = $1;
+DELETE FROM users WHERE org_id = $1 AND id = $2;
 
 -- name: GetUserByIDForUpdate :one
-SELECT id, name, email, created_at, updated_at
+SELECT id, org_id, name_ciphertext, email_ciphertext, email_index, pii_key_id, pii_data_key, status, email_verified_at, created_at, updated_at
 FROM users
-WHERE id = $1
+WHERE org_id = $1 AND id = $2
 FOR UPDATE;
 
 -- name: GetUserByEmailForUpdate :one
-SELECT id, name, email, created_at, updated_at
+SELECT id, org_id, name_ciphertext, email_ciphertext, email_index, pii_key_id, pii_data_key, status, email_verified_at, created_at, updated_at
 FROM users
-WHERE email = $1
+WHERE org_id = $1 AND email_index = $2
 FOR UPDATE;
 
--- name: UpsertUser :exec
-INSERT INTO users (id, name, email, created_at, updated_at)
-VALUES ($1, $2, $3, $4, $5)
+-- name: ListUserDataKeysForUpdate :many
+-- 主鍵以外の鍵で暗号化したデータキーを取得する（他のトランザクションがロック中の行は飛ばす）
+SELECT id, pii_key_id, pii_data_key
+FROM users
+WHERE org_id = $1 AND pii_key_id <> $2
+ORDER BY id
+LIMIT $3
+FOR UPDATE SKIP LOCKED;
+
+-- name: UpdateUserDataKey :exec
+UPDATE users
+SET pii_key_id = $1, pii_data_key = $2
+WHERE org_id = $3 AND id = $4;
+
+-- name: NotifyUserChanged :exec
+-- ユーザーの変更をコミット時に各レプリカの読み取りキャッシュへ通知する（ロールバック時は破棄される）
+SELECT pg_notify(sqlc.arg(channel)::TEXT, sqlc.arg(user_id)::TEXT);
+
+-- name: UpsertUser :execrows
+-- 他の組織の同じIDのユーザーは更新しない（影響行数が 0 になる）
+INSERT INTO users (id, org_id, name_ciphertext, email_ciphertext, email_index, pii_key_id, pii_data_key, status, email_verified_at, created_at, updated_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
 ON CONFLICT (id) DO UPDATE SET
-    name = EXCLUDED.name,
-    email = EXCLUDED.email,
-    updated_at = EXCLUDED.updated_at;
+    name_ciphertext = EXCLUDED.name_ciphertext,
+    email_ciphertext = EXCLUDED.email_ciphertext,
+    email_index = EXCLUDED.email_index,
+    pii_key_id = EXCLUDED.pii_key_id,
+    pii_data_key = EXCLUDED.pii_data_key,
+    status = EXCLUDED.status,
+    email_verified_at = EXCLUDED.email_verified_at,
+    updated_at = EXCLUDED.updated_at
+WHERE users.org_id = EXCLUDED.org_id;
@@ -0,0 +1,92 @@
+-- name: UpsertWebhookSubscription :exec
+INSERT INTO webhook_subscriptions (id, org_id, url, secret, event_types, active, created_at, updated_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+ON CONFLICT (id) DO UPDATE
+SET url = EXCLUDED.url,
+    secret = EXCLUDED.secret,
+    event_types = EXCLUDED.event_types,
+    active = EXCLUDED.active,
+    updated_at = EXCLUDED.updated_at
+WHERE webhook_subscriptions.org_id = EXCLUDED.org_id;
+
+-- name: GetWebhookSubscriptionByID :one
+SELECT id, org_id, url, secret, event_types, active, created_at, updated_at
+FROM webhook_subscriptions
+WHERE org_id = $1 AND id = $2;
+
+-- name: GetWebhookSubscriptionByIDForUpdate :one
+SELECT id, org_id, url, secret, event_types, active, created_at, updated_at
+FROM webhook_subscriptions
+WHERE org_id = $1 AND id = $2
+FOR UPDATE;
+
+-- name: ListWebhookSubscriptions :many
+SELECT id, org_id, url, secret, event_types, active, created_at, updated_at
+FROM webhook_subscriptions
+WHERE org_id = $1
+ORDER BY created_at DESC, id DESC
+LIMIT $2 OFFSET $3;
+
+-- name: CountWebhookSubscriptions :one
+SELECT COUNT(*) FROM webhook_subscriptions WHERE org_id = $1;
+
+-- name: DeleteWebhookSubscription :exec
+DELETE FROM webhook_subscriptions
+WHERE org_id = $1 AND id = $2;
+
+-- name: ListActiveWebhookSubscriptionsByEventType :many
+SELECT id, org_id, url, secret, event_types, active, created_at, updated_at
+FROM webhook_subscriptions
+WHERE org_id = sqlc.arg(org_id) AND active AND sqlc.arg(event_type)::text = ANY(event_types)
+ORDER BY id;
+
+-- name: CreateWebhookDelivery :execrows
+-- 同じイベントの再配信（outbox の at-least-once）では配信を重複して作成しない
+INSERT INTO webhook_deliveries (id, subscription_id, event_id, event_type, payload, status, attempts, next_attempt_at, created_at, updated_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+ON CONFLICT (subscription_id, event_id) DO NOTHING;
+
+-- name: ClaimWebhookDeliveries :many
+-- 配信時刻に達した配信を購読の宛先と共に取得し、配信時刻を lease_until に進めてリースする（ワーカーの処理のため組織をまたぐ）
+WITH claimed AS (
+    SELECT d.id
+    FROM webhook_deliveries d
+    WHERE d.status = 'pending'
+      AND d.next_attempt_at <= sqlc.arg(now)
+    ORDER BY d.next_attempt_at, d.id
+    LIMIT sqlc.arg('limit')
+    FOR UPDATE SKIP LOCKED
+)
+UPDATE webhook_deliveries d
+SET next_attempt_at = sqlc.arg(lease_until)
+FROM claimed, webhook_subscriptions s
+WHERE d.id = claimed.id
+  AND s.id = d.subscription_id
+RETURNING d.id, d.subscription_id, d.event_id, d.event_type, d.payload, d.status, d.attempts, d.last_status_code, d.last_error, d.next_attempt_at, d.delivered_at, d.created_at, d.updated_at, s.url, s.secret;
+
+-- name: UpdateWebhookDelivery :exec
+UPDATE webhook_deliveries
+SET status = $1,
+    attempts = $2,
+    last_status_code = $3,
+    last_error = $4,
+    next_attempt_at = $5,
+    delivered_at = $6,
+    updated_at = $7
+WHERE id = $8;
+
+-- name: ListWebhookDeliveries :many
+SELECT d.id, d.subscription_id, d.event_id, d.event_type, d.payload, d.status, d.attempts, d.last_status_code, d.last_error, d.next_attempt_at, d.delivered_at, d.created_at, d.updated_at
+FROM webhook_deliveries d
+WHERE d.subscription_id = sqlc.arg(subscription_id)
+  AND EXISTS (SELECT 1 FROM webhook_subscriptions s WHERE s.id = d.subscription_id AND s.org_id = sqlc.arg(org_id))
+  AND (sqlc.narg(status)::text IS NULL OR d.status = sqlc.narg(status))
+ORDER BY d.created_at DESC, d.id DESC
+LIMIT sqlc.arg('limit') OFFSET sqlc.arg('offset');
+
+-- name: CountWebhookDeliveries :one
+SELECT COUNT(*)
+FROM webhook_deliveries d
+WHERE d.subscription_id = sqlc.arg(subscription_id)
+  AND EXISTS (SELECT 1 FROM webhook_subscriptions s WHERE s.id = d.subscription_id AND s.org_id = sqlc.arg(org_id))
+  AND (sqlc.narg(status)::text IS NULL OR d.status = sqlc.narg(status));
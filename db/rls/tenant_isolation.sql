@@ -0,0 +1,88 @@
+-- Row-level security for tenant isolation (optional)
+--
+-- クエリはすべて org_id で絞り込んでいるが、これを適用するとデータベースでもテナントを分離する。
+-- TransactionManager と読み取り（infrastructure.RunReadOnly）はトランザクションの開始時に app.org_id を
+-- 対象テナントの組織IDに設定するため、他の組織の行は読み書きできない。
+-- app.org_id が未設定のトランザクションと接続には行を返さない（設定し忘れた処理は他の組織の行を参照できない）。
+-- outbox のリレーや Webhook のワーカーなど組織をまたぐ処理だけが明示的に '*' を設定し（tenant.WithAllOrgs）、
+-- すべての行を参照できる。
+--
+-- テーブルの所有者にも適用するため FORCE ROW LEVEL SECURITY を指定する。
+
+ALTER TABLE users ENABLE ROW LEVEL SECURITY;
+ALTER TABLE users FORCE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS tenant_isolation ON users;
+CREATE POLICY tenant_isolation ON users
+    USING (org_id = current_setting('app.org_id', true) OR current_setting('app.org_id', true) = '*');
+
+ALTER TABLE user_logs ENABLE ROW LEVEL SECURITY;
+ALTER TABLE user_logs FORCE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS tenant_isolation ON user_logs;
+CREATE POLICY tenant_isolation ON user_logs
+    USING (org_id = current_setting('app.org_id', true) OR current_setting('app.org_id', true) = '*');
+
+ALTER TABLE webhook_subscriptions ENABLE ROW LEVEL SECURITY;
+ALTER TABLE webhook_subscriptions FORCE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS tenant_isolation ON webhook_subscriptions;
+CREATE POLICY tenant_isolation ON webhook_subscriptions
+    USING (org_id = current_setting('app.org_id', true) OR current_setting('app.org_id', true) = '*');
+
+ALTER TABLE outbox ENABLE ROW LEVEL SECURITY;
+ALTER TABLE outbox FORCE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS tenant_isolation ON outbox;
+CREATE POLICY tenant_isolation ON outbox
+    USING (org_id = current_setting('app.org_id', true) OR current_setting('app.org_id', true) = '*');
+
+ALTER TABLE groups ENABLE ROW LEVEL SECURITY;
+ALTER TABLE groups FORCE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS tenant_isolation ON groups;
+CREATE POLICY tenant_isolation ON groups
+    USING (org_id = current_setting('app.org_id', true) OR current_setting('app.org_id', true) = '*');
+
+ALTER TABLE group_members ENABLE ROW LEVEL SECURITY;
+ALTER TABLE group_members FORCE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS tenant_isolation ON group_members;
+CREATE POLICY tenant_isolation ON group_members
+    USING (org_id = current_setting('app.org_id', true) OR current_setting('app.org_id', true) = '*');
+
+ALTER TABLE user_credentials ENABLE ROW LEVEL SECURITY;
+ALTER TABLE user_credentials FORCE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS tenant_isolation ON user_credentials;
+CREATE POLICY tenant_isolation ON user_credentials
+    USING (org_id = current_setting('app.org_id', true) OR current_setting('app.org_id', true) = '*');
+
+ALTER TABLE user_tokens ENABLE ROW LEVEL SECURITY;
+ALTER TABLE user_tokens FORCE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS tenant_isolation ON user_tokens;
+CREATE POLICY tenant_isolation ON user_tokens
+    USING (org_id = current_setting('app.org_id', true) OR current_setting('app.org_id', true) = '*');
+
+ALTER TABLE invitations ENABLE ROW LEVEL SECURITY;
+ALTER TABLE invitations FORCE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS tenant_isolation ON invitations;
+CREATE POLICY tenant_isolation ON invitations
+    USING (org_id = current_setting('app.org_id', true) OR current_setting('app.org_id', true) = '*');
+
+ALTER TABLE sessions ENABLE ROW LEVEL SECURITY;
+ALTER TABLE sessions FORCE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS tenant_isolation ON sessions;
+CREATE POLICY tenant_isolation ON sessions
+    USING (org_id = current_setting('app.org_id', true) OR current_setting('app.org_id', true) = '*');
+
+ALTER TABLE user_totp_credentials ENABLE ROW LEVEL SECURITY;
+ALTER TABLE user_totp_credentials FORCE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS tenant_isolation ON user_totp_credentials;
+CREATE POLICY tenant_isolation ON user_totp_credentials
+    USING (org_id = current_setting('app.org_id', true) OR current_setting('app.org_id', true) = '*');
+
+ALTER TABLE user_external_identities ENABLE ROW LEVEL SECURITY;
+ALTER TABLE user_external_identities FORCE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS tenant_isolation ON user_external_identities;
+CREATE POLICY tenant_isolation ON user_external_identities
+    USING (org_id = current_setting('app.org_id', true) OR current_setting('app.org_id', true) = '*');
+
+ALTER TABLE oidc_login_states ENABLE ROW LEVEL SECURITY;
+ALTER TABLE oidc_login_states FORCE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS tenant_isolation ON oidc_login_states;
+CREATE POLICY tenant_isolation ON oidc_login_states
+    USING (org_id = current_setting('app.org_id', true) OR current_setting('app.org_id', true) = '*');
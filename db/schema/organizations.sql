@@ -0,0 +1,11 @@
+-- Organizations table (tenants)
+-- ユーザーなどテナントごとのデータは org_id でこのテーブルを参照する
+CREATE TABLE IF NOT EXISTS organizations (
+    id VARCHAR(26) PRIMARY KEY,
+    name VARCHAR(255) NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Index for created_at for sorting
+CREATE INDEX IF NOT EXISTS idx_organizations_created_at ON organizations(created_at DESC);
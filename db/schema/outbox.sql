@@ -0,0 +1,23 @@
+-- Transactional outbox table
+-- ドメインイベントは状態変更と同じトランザクションで書き込まれ、リレーが配信する
+CREATE TABLE IF NOT EXISTS outbox (
+    seq BIGSERIAL PRIMARY KEY,
+    id VARCHAR(26) NOT NULL UNIQUE,
+    -- org_id はイベントが発生した組織（変更ストリームと Webhook の配信先の絞り込みに使用）
+    org_id VARCHAR(26) NOT NULL,
+    aggregate_type VARCHAR(50) NOT NULL,
+    aggregate_id VARCHAR(26) NOT NULL,
+    event_type VARCHAR(100) NOT NULL,
+    payload JSONB NOT NULL,
+    occurred_at TIMESTAMP NOT NULL,
+    published_at TIMESTAMP,
+    attempts INTEGER NOT NULL DEFAULT 0,
+    last_error TEXT,
+    next_attempt_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Index for finding the oldest pending event per aggregate
+CREATE INDEX IF NOT EXISTS idx_outbox_pending ON outbox(aggregate_type, aggregate_id, seq) WHERE published_at IS NULL;
+
+-- Index for purging published events
+CREATE INDEX IF NOT EXISTS idx_outbox_published_at ON outbox(published_at) WHERE published_at IS NOT NULL;
@@ -1,14 +1,30 @@
 -- Users table
+-- メールアドレスは組織ごとに一意
 CREATE TABLE IF NOT EXISTS users (
     id VARCHAR(26) PRIMARY KEY,
-    name VARCHAR(255) NOT NULL,
-    email VARCHAR(255) NOT NULL UNIQUE,
+    org_id VARCHAR(26) NOT NULL REFERENCES organizations(id),
+    -- 名前・メールアドレスはデータキーで暗号化して保存する（internal/pii を参照）
+    name_ciphertext BYTEA NOT NULL,
+    email_ciphertext BYTEA NOT NULL,
+    -- メールアドレスのブラインドインデックス（組織ごとの HMAC-SHA256）で検索と一意性の検証を行う
+    email_index BYTEA NOT NULL,
+    -- データキーを暗号化した鍵リングの鍵のIDと、暗号化したデータキー
+    pii_key_id VARCHAR(64) NOT NULL,
+    pii_data_key BYTEA NOT NULL,
+    -- アカウントのステータス（遷移規則は domain.UserStatus を参照）
+    status VARCHAR(20) NOT NULL DEFAULT 'active' CHECK (status IN ('invited', 'active', 'suspended', 'deactivated')),
+    -- メールアドレスの確認が完了した時刻（未確認の場合は NULL）
+    email_verified_at TIMESTAMP,
     created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
-    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (org_id, email_index)
 );
 
--- Index for email lookup
-CREATE INDEX IF NOT EXISTS idx_users_email ON users(email);
+-- Index for created_at for sorting within an organization
+CREATE INDEX IF NOT EXISTS idx_users_org_created_at ON users(org_id, created_at DESC);
 
--- Index for created_at for sorting
-CREATE INDEX IF NOT EXISTS idx_users_created_at ON users(created_at DESC);
+-- Index for filtering by status within an organization
+CREATE INDEX IF NOT EXISTS idx_users_org_status_created_at ON users(org_id, status, created_at DESC);
+
+-- Index for finding data keys to rewrap after a key rotation
+CREATE INDEX IF NOT EXISTS idx_users_org_pii_key_id ON users(org_id, pii_key_id);
@@ -0,0 +1,14 @@
+-- Password credentials of users
+-- パスワードはハッシュ（argon2id または bcrypt の PHC 文字列）のみを保存する
+CREATE TABLE IF NOT EXISTS user_credentials (
+    user_id VARCHAR(26) PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+    org_id VARCHAR(26) NOT NULL REFERENCES organizations(id),
+    password_hash TEXT NOT NULL,
+    -- 最後に成功してからの連続したログインの失敗回数
+    failed_attempts INTEGER NOT NULL DEFAULT 0,
+    -- ロックが解除される時刻（ロックされていない場合は NULL）
+    locked_until TIMESTAMP,
+    password_changed_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
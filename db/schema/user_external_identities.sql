@@ -0,0 +1,30 @@
+-- Links between users and accounts of external identity providers (OpenID Connect)
+-- IdP のユーザーは設定した IdP の名前（provider）と ID トークンの sub（subject）の組で識別する
+CREATE TABLE IF NOT EXISTS user_external_identities (
+    org_id VARCHAR(26) NOT NULL REFERENCES organizations(id),
+    provider VARCHAR(32) NOT NULL,
+    subject VARCHAR(255) NOT NULL,
+    user_id VARCHAR(26) NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    -- 最後にログインした時刻（未ログインの場合は NULL）
+    last_login_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (org_id, provider, subject)
+);
+
+-- Index for looking up the links of a user
+CREATE INDEX IF NOT EXISTS idx_user_external_identities_user_id ON user_external_identities(user_id);
+
+-- Pending OpenID Connect authorization requests (until the callback from the IdP)
+-- state は SHA-256 のハッシュのみを保存する（nonce と PKCE の code_verifier はコールバックで使う）
+CREATE TABLE IF NOT EXISTS oidc_login_states (
+    id VARCHAR(26) PRIMARY KEY,
+    org_id VARCHAR(26) NOT NULL REFERENCES organizations(id),
+    provider VARCHAR(32) NOT NULL,
+    state_hash VARCHAR(64) NOT NULL UNIQUE,
+    nonce VARCHAR(64) NOT NULL,
+    code_verifier VARCHAR(128) NOT NULL,
+    expires_at TIMESTAMP NOT NULL,
+    -- コールバックで使用した時刻（未使用の場合は NULL）
+    used_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
@@ -0,0 +1,28 @@
+-- Groups (teams) of users within an organization
+-- グループ名は組織ごとに一意
+CREATE TABLE IF NOT EXISTS groups (
+    id VARCHAR(26) PRIMARY KEY,
+    org_id VARCHAR(26) NOT NULL REFERENCES organizations(id),
+    name VARCHAR(100) NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (org_id, name)
+);
+
+-- Group membership with a role
+-- グループまたはユーザーを削除するとメンバーシップも削除される
+CREATE TABLE IF NOT EXISTS group_members (
+    group_id VARCHAR(26) NOT NULL REFERENCES groups(id) ON DELETE CASCADE,
+    user_id VARCHAR(26) NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    org_id VARCHAR(26) NOT NULL REFERENCES organizations(id),
+    role VARCHAR(20) NOT NULL CHECK (role IN ('owner', 'member')),
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (group_id, user_id)
+);
+
+-- Index for listing the groups of a user
+CREATE INDEX IF NOT EXISTS idx_group_members_user_id ON group_members(user_id);
+
+-- Index for listing the members of a group in join order
+CREATE INDEX IF NOT EXISTS idx_group_members_group_created_at ON group_members(group_id, created_at);
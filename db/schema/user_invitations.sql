@@ -0,0 +1,27 @@
+-- Invitations of users by email
+-- 招待したユーザーは users に invited として作成し、承諾すると active になる
+-- トークンそのものは保存せず、SHA-256 のハッシュのみを保存する（再送のたびに置き換える）
+CREATE TABLE IF NOT EXISTS invitations (
+    id VARCHAR(26) PRIMARY KEY,
+    org_id VARCHAR(26) NOT NULL REFERENCES organizations(id),
+    user_id VARCHAR(26) NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    -- 招待を送ったメールアドレス
+    -- users と同じくデータキーで暗号化し、データキーを暗号化した鍵のIDと共に保存する（internal/pii を参照）
+    email_ciphertext BYTEA NOT NULL,
+    pii_key_id VARCHAR(64) NOT NULL,
+    pii_data_key BYTEA NOT NULL,
+    -- 招待したユーザー（認証なしで招待した場合と招待したユーザーを削除した場合は NULL）
+    invited_by VARCHAR(26) REFERENCES users(id) ON DELETE SET NULL,
+    status VARCHAR(20) NOT NULL CHECK (status IN ('pending', 'accepted', 'expired', 'revoked')),
+    token_hash VARCHAR(64) NOT NULL UNIQUE,
+    expires_at TIMESTAMP NOT NULL,
+    accepted_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Index for listing pending invitations and expiring them
+CREATE INDEX IF NOT EXISTS idx_invitations_org_status_expires_at ON invitations(org_id, status, expires_at);
+
+-- Index for finding data keys to rewrap after a key rotation
+CREATE INDEX IF NOT EXISTS idx_invitations_org_pii_key_id ON invitations(org_id, pii_key_id);
@@ -1,8 +1,11 @@
 -- User logs table
 CREATE TABLE IF NOT EXISTS user_logs (
     id VARCHAR(26) PRIMARY KEY,
+    org_id VARCHAR(26) NOT NULL REFERENCES organizations(id),
     user_id VARCHAR(26) NOT NULL,
     action VARCHAR(50) NOT NULL,
+    -- ステータス変更などの操作理由
+    reason TEXT,
     created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
 );
 
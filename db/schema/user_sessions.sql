@@ -0,0 +1,19 @@
+-- Server-side sessions issued at login
+-- セッショントークンはセッションのIDを含み、認証のたびに取り消し・有効期限を確認する
+CREATE TABLE IF NOT EXISTS sessions (
+    id VARCHAR(26) PRIMARY KEY,
+    org_id VARCHAR(26) NOT NULL REFERENCES organizations(id),
+    user_id VARCHAR(26) NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    -- クライアントが申告した端末名（省略時は空文字）
+    device VARCHAR(100) NOT NULL DEFAULT '',
+    ip_address VARCHAR(45) NOT NULL DEFAULT '',
+    user_agent VARCHAR(500) NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    last_seen_at TIMESTAMP NOT NULL,
+    -- ログインからの有効期限（利用中でも延長しない）
+    expires_at TIMESTAMP NOT NULL,
+    revoked_at TIMESTAMP
+);
+
+-- Index for listing and revoking sessions of a user
+CREATE INDEX IF NOT EXISTS idx_sessions_org_user_id ON sessions(org_id, user_id, last_seen_at DESC);
@@ -0,0 +1,24 @@
+-- Single-use tokens (email verification and password reset sent by email, and the second step of MFA login)
+-- トークンそのものは保存せず、SHA-256 のハッシュのみを保存する
+CREATE TABLE IF NOT EXISTS user_tokens (
+    id VARCHAR(26) PRIMARY KEY,
+    org_id VARCHAR(26) NOT NULL REFERENCES organizations(id),
+    user_id VARCHAR(26) NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    purpose VARCHAR(32) NOT NULL CHECK (purpose IN ('email_verification', 'password_reset', 'mfa_challenge')),
+    token_hash VARCHAR(64) NOT NULL UNIQUE,
+    -- 発行時のメールアドレス（メールアドレスを変更すると確認のトークンは使えなくなる）
+    -- users と同じくデータキーで暗号化し、データキーを暗号化した鍵のIDと共に保存する（internal/pii を参照）
+    email_ciphertext BYTEA NOT NULL,
+    pii_key_id VARCHAR(64) NOT NULL,
+    pii_data_key BYTEA NOT NULL,
+    expires_at TIMESTAMP NOT NULL,
+    -- 使用または無効化された時刻（未使用の場合は NULL）
+    used_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Index for revoking the unused tokens of a user
+CREATE INDEX IF NOT EXISTS idx_user_tokens_user_purpose ON user_tokens(user_id, purpose);
+
+-- Index for finding data keys to rewrap after a key rotation
+CREATE INDEX IF NOT EXISTS idx_user_tokens_org_pii_key_id ON user_tokens(org_id, pii_key_id);
@@ -0,0 +1,16 @@
+-- TOTP two-factor authentication of users
+-- リカバリーコードは SHA-256 のハッシュのみを保存する
+CREATE TABLE IF NOT EXISTS user_totp_credentials (
+    user_id VARCHAR(26) PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+    org_id VARCHAR(26) NOT NULL REFERENCES organizations(id),
+    -- 認証アプリと共有する秘密鍵（base32）
+    secret VARCHAR(64) NOT NULL,
+    -- 確認コードで有効化した時刻（登録中の場合は NULL）
+    confirmed_at TIMESTAMP,
+    -- 最後に使用した確認コードの時間ステップ（同じコードの再使用を防ぐ）
+    last_used_step BIGINT NOT NULL DEFAULT 0,
+    -- 未使用のリカバリーコードのハッシュ
+    recovery_code_hashes TEXT[] NOT NULL DEFAULT '{}',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
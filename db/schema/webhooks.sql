@@ -0,0 +1,39 @@
+-- Outgoing webhook subscriptions
+CREATE TABLE IF NOT EXISTS webhook_subscriptions (
+    id VARCHAR(26) PRIMARY KEY,
+    org_id VARCHAR(26) NOT NULL REFERENCES organizations(id),
+    url VARCHAR(2048) NOT NULL,
+    secret VARCHAR(256) NOT NULL,
+    event_types TEXT[] NOT NULL,
+    active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Index for listing subscriptions within an organization
+CREATE INDEX IF NOT EXISTS idx_webhook_subscriptions_org_created_at ON webhook_subscriptions(org_id, created_at DESC);
+
+-- Webhook deliveries (one row per subscription and event, also serves as the delivery log)
+-- 購読を削除すると配信ログも削除される
+CREATE TABLE IF NOT EXISTS webhook_deliveries (
+    id VARCHAR(26) PRIMARY KEY,
+    subscription_id VARCHAR(26) NOT NULL REFERENCES webhook_subscriptions(id) ON DELETE CASCADE,
+    event_id VARCHAR(26) NOT NULL,
+    event_type VARCHAR(100) NOT NULL,
+    payload JSONB NOT NULL,
+    status VARCHAR(20) NOT NULL CHECK (status IN ('pending', 'succeeded', 'dead')),
+    attempts INTEGER NOT NULL DEFAULT 0,
+    last_status_code INTEGER,
+    last_error TEXT,
+    next_attempt_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    delivered_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (subscription_id, event_id)
+);
+
+-- Index for finding due deliveries
+CREATE INDEX IF NOT EXISTS idx_webhook_deliveries_pending ON webhook_deliveries(next_attempt_at) WHERE status = 'pending';
+
+-- Index for the delivery log of a subscription
+CREATE INDEX IF NOT EXISTS idx_webhook_deliveries_subscription ON webhook_deliveries(subscription_id, created_at DESC);
@@ -0,0 +1,5 @@
+-- Default organization
+-- テナントを指定しないリクエスト（tenant.DefaultOrgID）の組織。db/schema の適用後に毎回実行してよい
+INSERT INTO organizations (id, name)
+VALUES ('00000000000000000000000000', 'Default')
+ON CONFLICT (id) DO NOTHING;
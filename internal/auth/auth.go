@@ -0,0 +1,38 @@
+// Package auth はパスワードのハッシュ化と、ログインで発行するセッショントークンを提供する
+//
+//   - PasswordHasher: argon2id でハッシュ化し、PHC 文字列形式（$argon2id$v=19$m=...,t=...,p=...$salt$hash）で保存する。
+//     他のシステムから移行した bcrypt のハッシュ（$2a$ / $2b$ / $2y$）も照合できる
+//   - TokenIssuer: ユーザーID・組織ID・セッションIDを HMAC-SHA256 で署名したトークンを発行・検証する。
+//     トークンは TokenPrefix で始まり、Authorization: Bearer で送られた SCIM などの他のトークンと区別できる
+//
+// 認証済みのユーザーIDは WithUserID、セッションIDは WithSessionID でコンテキストに設定する
+// （所属する組織は tenant.WithPrincipalOrgID）。
+package auth
+
+import "context"
+
+type userIDKey struct{}
+
+// WithUserID 認証済みのユーザーIDを設定したコンテキストを返す
+func WithUserID(ctx context.Context, userID string) context.Context {
+	return context.WithValue(ctx, userIDKey{}, userID)
+}
+
+// UserID 認証済みのユーザーIDを取得する（認証されていない場合は ok=false）
+func UserID(ctx context.Context) (userID string, ok bool) {
+	userID, ok = ctx.Value(userIDKey{}).(string)
+	return userID, ok
+}
+
+type sessionIDKey struct{}
+
+// WithSessionID 認証に使用したセッションのIDを設定したコンテキストを返す
+func WithSessionID(ctx context.Context, sessionID string) context.Context {
+	return context.WithValue(ctx, sessionIDKey{}, sessionID)
+}
+
+// SessionID 認証に使用したセッションのIDを取得する（セッションで認証されていない場合は ok=false）
+func SessionID(ctx context.Context) (sessionID string, ok bool) {
+	sessionID, ok = ctx.Value(sessionIDKey{}).(string)
+	return sessionID, ok
+}
@@ -0,0 +1,130 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/coreos/go-oidc/v3/oidc"
+	"golang.org/x/oauth2"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+)
+
+// oidcHTTPTimeout IdP へのリクエスト（ディスカバリー・JWKS の取得・トークンの交換）のタイムアウト
+const oidcHTTPTimeout = 10 * time.Second
+
+// OIDCConfig OpenID Connect の IdP と、IdP に登録したクライアントの設定
+type OIDCConfig struct {
+	// Issuer は IdP の issuer（{Issuer}/.well-known/openid-configuration からエンドポイントと JWKS を取得する）
+	Issuer       string
+	ClientID     string
+	ClientSecret string
+	// RedirectURL は IdP に登録したリダイレクト先（認可コードを受け取るフロントエンドのページ）
+	RedirectURL string
+	// Scopes は openid に加えて要求するスコープ（省略時は email と profile）
+	Scopes []string
+}
+
+// OIDCProvider OpenID Connect の認可コードフロー（PKCE）の Relying Party（usecase.OIDCProvider の実装）
+//
+// ディスカバリーは最初に使用したときに行い、成功した結果を使い続ける（起動時に IdP に接続できなくても
+// サーバーは起動でき、失敗した場合は次の使用時に再試行する）。ID トークンの署名はディスカバリーで
+// 取得した JWKS で検証し、鍵のローテーションに合わせて JWKS を取得し直す。
+type OIDCProvider struct {
+	config OIDCConfig
+	client *http.Client
+
+	mu       sync.Mutex
+	oauth    *oauth2.Config
+	verifier *oidc.IDTokenVerifier
+}
+
+// NewOIDCProvider OIDCProviderのコンストラクタ
+func NewOIDCProvider(config OIDCConfig) *OIDCProvider {
+	return &OIDCProvider{
+		config: config,
+		client: &http.Client{Timeout: oidcHTTPTimeout},
+	}
+}
+
+// AuthCodeURL IdP の認可エンドポイントの URL を返す（PKCE の S256 のチャレンジと nonce を付ける）
+func (p *OIDCProvider) AuthCodeURL(ctx context.Context, state, nonce, codeVerifier string) (string, error) {
+	oauth, _, err := p.discover(ctx)
+	if err != nil {
+		return "", err
+	}
+	return oauth.AuthCodeURL(state, oidc.Nonce(nonce), oauth2.S256ChallengeOption(codeVerifier)), nil
+}
+
+// Exchange 認可コードを code_verifier と共にトークンに交換し、ID トークンを検証してクレームを返す
+//
+// ID トークンは署名・issuer・audience（クライアントID）・有効期限に加え、認可リクエストの nonce と一致することを検証する。
+func (p *OIDCProvider) Exchange(ctx context.Context, code, codeVerifier, nonce string) (*domain.ExternalIdentityClaims, error) {
+	oauth, verifier, err := p.discover(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := oauth.Exchange(oidc.ClientContext(ctx, p.client), code, oauth2.VerifierOption(codeVerifier))
+	if err != nil {
+		return nil, fmt.Errorf("failed to exchange authorization code: %w", err)
+	}
+	rawIDToken, ok := token.Extra("id_token").(string)
+	if !ok || rawIDToken == "" {
+		return nil, errors.New("token response does not contain an id_token")
+	}
+	idToken, err := verifier.Verify(ctx, rawIDToken)
+	if err != nil {
+		return nil, fmt.Errorf("failed to verify id token: %w", err)
+	}
+	if idToken.Nonce != nonce {
+		return nil, errors.New("id token nonce does not match")
+	}
+
+	var claims struct {
+		Email         string `json:"email"`
+		EmailVerified bool   `json:"email_verified"`
+		Name          string `json:"name"`
+	}
+	if err := idToken.Claims(&claims); err != nil {
+		return nil, fmt.Errorf("failed to parse id token claims: %w", err)
+	}
+	return &domain.ExternalIdentityClaims{
+		Subject:       idToken.Subject,
+		Email:         claims.Email,
+		EmailVerified: claims.EmailVerified,
+		Name:          claims.Name,
+	}, nil
+}
+
+// discover IdP のディスカバリーを行い、クライアントの設定と ID トークンの検証を返す（成功した結果は再利用する）
+func (p *OIDCProvider) discover(ctx context.Context) (*oauth2.Config, *oidc.IDTokenVerifier, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.oauth != nil {
+		return p.oauth, p.verifier, nil
+	}
+
+	// JWKS の取得はディスカバリーのコンテキストで行うため、リクエストのキャンセルを引き継がない
+	provider, err := oidc.NewProvider(oidc.ClientContext(context.WithoutCancel(ctx), p.client), p.config.Issuer)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to discover oidc provider %s: %w", p.config.Issuer, err)
+	}
+	scopes := p.config.Scopes
+	if len(scopes) == 0 {
+		scopes = []string{"email", "profile"}
+	}
+	p.oauth = &oauth2.Config{
+		ClientID:     p.config.ClientID,
+		ClientSecret: p.config.ClientSecret,
+		Endpoint:     provider.Endpoint(),
+		RedirectURL:  p.config.RedirectURL,
+		Scopes:       append([]string{oidc.ScopeOpenID}, scopes...),
+	}
+	p.verifier = provider.Verifier(&oidc.Config{ClientID: p.config.ClientID})
+	return p.oauth, p.verifier, nil
+}
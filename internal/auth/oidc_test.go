@@ -0,0 +1,99 @@
+package auth
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/example/go-react-cqrs-template/internal/testutil/oidctest"
+)
+
+func TestOIDCProvider_AuthCodeFlow(t *testing.T) {
+	ctx := context.Background()
+	idp := oidctest.New(t, "example-client", "example-secret")
+	idp.SetUser(oidctest.User{Subject: "emp-001", Email: "john@example.com", EmailVerified: true, Name: "John Doe"})
+	const verifier = "dBjftJeZ4CVP-mB92K27uhbUJU1p1r_wW1gFWFOEjXk"
+
+	newProvider := func() *OIDCProvider {
+		return NewOIDCProvider(OIDCConfig{
+			Issuer:       idp.Issuer(),
+			ClientID:     idp.ClientID,
+			ClientSecret: idp.ClientSecret,
+			RedirectURL:  "http://localhost:5173/login/callback",
+		})
+	}
+	// authorize 認可リクエストを作成し、IdP が発行した認可コードを返す
+	authorize := func(t *testing.T, p *OIDCProvider, nonce string) string {
+		t.Helper()
+		authURL, err := p.AuthCodeURL(ctx, "state-1", nonce, verifier)
+		if err != nil {
+			t.Fatalf("AuthCodeURL() unexpected error: %v", err)
+		}
+		code, state := idp.Authorize(t, authURL)
+		if state != "state-1" {
+			t.Fatalf("state = %q, want state-1", state)
+		}
+		return code
+	}
+
+	t.Run("authorization request", func(t *testing.T) {
+		authURL, err := newProvider().AuthCodeURL(ctx, "state-1", "nonce-1", verifier)
+		if err != nil {
+			t.Fatalf("AuthCodeURL() unexpected error: %v", err)
+		}
+		u, err := url.Parse(authURL)
+		if err != nil {
+			t.Fatalf("authorization url %q is invalid: %v", authURL, err)
+		}
+		q := u.Query()
+		// RFC 7636 の例: code_verifier に対する S256 のチャレンジ
+		if q.Get("code_challenge") != "E9Melhoa2OwvFrEMTJguCHaoeK1t8URWbuGJSstw-cM" || q.Get("code_challenge_method") != "S256" {
+			t.Errorf("pkce = %q (%s), want S256 challenge of the verifier", q.Get("code_challenge"), q.Get("code_challenge_method"))
+		}
+		if q.Get("nonce") != "nonce-1" || !strings.Contains(q.Get("scope"), "openid") || q.Get("code_verifier") != "" {
+			t.Errorf("query = %v, want nonce and openid scope without the verifier", q)
+		}
+	})
+
+	t.Run("exchange", func(t *testing.T) {
+		p := newProvider()
+		claims, err := p.Exchange(ctx, authorize(t, p, "nonce-1"), verifier, "nonce-1")
+		if err != nil {
+			t.Fatalf("Exchange() unexpected error: %v", err)
+		}
+		if claims.Subject != "emp-001" || claims.Email != "john@example.com" || !claims.EmailVerified || claims.Name != "John Doe" {
+			t.Errorf("claims = %+v, want claims of emp-001", claims)
+		}
+	})
+
+	t.Run("wrong code verifier", func(t *testing.T) {
+		p := newProvider()
+		if _, err := p.Exchange(ctx, authorize(t, p, "nonce-1"), verifier+"x", "nonce-1"); err == nil {
+			t.Error("Exchange() error = nil, want error")
+		}
+	})
+
+	t.Run("nonce mismatch", func(t *testing.T) {
+		p := newProvider()
+		if _, err := p.Exchange(ctx, authorize(t, p, "nonce-1"), verifier, "nonce-2"); err == nil {
+			t.Error("Exchange() error = nil, want error")
+		}
+	})
+
+	t.Run("untrusted signing key", func(t *testing.T) {
+		p := newProvider()
+		code := authorize(t, p, "nonce-1")
+		idp.UseUntrustedKey()
+		if _, err := p.Exchange(ctx, code, verifier, "nonce-1"); err == nil || !strings.Contains(err.Error(), "verify") {
+			t.Errorf("Exchange() error = %v, want signature verification error", err)
+		}
+	})
+}
+
+func TestOIDCProvider_DiscoveryFailure(t *testing.T) {
+	p := NewOIDCProvider(OIDCConfig{Issuer: "http://127.0.0.1:1", ClientID: "example-client"})
+	if _, err := p.AuthCodeURL(context.Background(), "state", "nonce", "verifier"); err == nil {
+		t.Error("AuthCodeURL() error = nil, want discovery error")
+	}
+}
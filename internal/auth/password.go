@@ -0,0 +1,113 @@
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// ErrUnsupportedHash は照合できない形式のハッシュの場合のエラー
+var ErrUnsupportedHash = errors.New("auth: unsupported password hash")
+
+// Argon2Params argon2id のパラメータ
+type Argon2Params struct {
+	// Memory はメモリ使用量（KiB）
+	Memory uint32
+	// Iterations は反復回数
+	Iterations uint32
+	// Parallelism は並列度
+	Parallelism uint8
+	// SaltLength / KeyLength はソルトと導出する鍵の長さ（バイト）
+	SaltLength uint32
+	KeyLength  uint32
+}
+
+// DefaultArgon2Params RFC 9106 の推奨値（メモリ 64 MiB、3 回、並列度 4）
+var DefaultArgon2Params = Argon2Params{
+	Memory:      64 * 1024,
+	Iterations:  3,
+	Parallelism: 4,
+	SaltLength:  16,
+	KeyLength:   32,
+}
+
+// PasswordHasher パスワードのハッシュ化と照合（usecase.PasswordHasher の実装）
+type PasswordHasher struct {
+	params Argon2Params
+}
+
+// NewPasswordHasher PasswordHasherのコンストラクタ
+//
+// params は新しく作成するハッシュにのみ使い、照合は保存済みのハッシュのパラメータで行う。
+func NewPasswordHasher(params Argon2Params) *PasswordHasher {
+	return &PasswordHasher{params: params}
+}
+
+// Hash パスワードを argon2id でハッシュ化し、PHC 文字列形式で返す
+func (h *PasswordHasher) Hash(password string) (string, error) {
+	salt := make([]byte, h.params.SaltLength)
+	if _, err := rand.Read(salt); err != nil {
+		return "", fmt.Errorf("failed to generate salt: %w", err)
+	}
+	key := argon2.IDKey([]byte(password), salt, h.params.Iterations, h.params.Memory, h.params.Parallelism, h.params.KeyLength)
+	return fmt.Sprintf("$argon2id$v=%d$m=%d,t=%d,p=%d$%s$%s",
+		argon2.Version, h.params.Memory, h.params.Iterations, h.params.Parallelism,
+		base64.RawStdEncoding.EncodeToString(salt),
+		base64.RawStdEncoding.EncodeToString(key),
+	), nil
+}
+
+// Verify パスワードがハッシュと一致するか照合する
+//
+// 一致しない場合は false を返し、ハッシュの形式が不正な場合はエラーを返す。
+func (h *PasswordHasher) Verify(encoded, password string) (bool, error) {
+	switch {
+	case strings.HasPrefix(encoded, "$argon2id$"):
+		return verifyArgon2id(encoded, password)
+	case strings.HasPrefix(encoded, "$2a$"), strings.HasPrefix(encoded, "$2b$"), strings.HasPrefix(encoded, "$2y$"):
+		err := bcrypt.CompareHashAndPassword([]byte(encoded), []byte(password))
+		if errors.Is(err, bcrypt.ErrMismatchedHashAndPassword) {
+			return false, nil
+		}
+		if err != nil {
+			return false, fmt.Errorf("%w: %v", ErrUnsupportedHash, err)
+		}
+		return true, nil
+	}
+	return false, ErrUnsupportedHash
+}
+
+// verifyArgon2id PHC 文字列形式の argon2id のハッシュと照合する
+func verifyArgon2id(encoded, password string) (bool, error) {
+	// "", "argon2id", "v=19", "m=...,t=...,p=...", salt, hash
+	parts := strings.Split(encoded, "$")
+	if len(parts) != 6 {
+		return false, ErrUnsupportedHash
+	}
+	var version int
+	if _, err := fmt.Sscanf(parts[2], "v=%d", &version); err != nil || version != argon2.Version {
+		return false, ErrUnsupportedHash
+	}
+	var memory, iterations uint32
+	var parallelism uint8
+	if _, err := fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &memory, &iterations, &parallelism); err != nil {
+		return false, ErrUnsupportedHash
+	}
+	salt, err := base64.RawStdEncoding.DecodeString(parts[4])
+	if err != nil {
+		return false, ErrUnsupportedHash
+	}
+	want, err := base64.RawStdEncoding.DecodeString(parts[5])
+	if err != nil || len(want) == 0 {
+		return false, ErrUnsupportedHash
+	}
+
+	got := argon2.IDKey([]byte(password), salt, iterations, memory, parallelism, uint32(len(want)))
+	return subtle.ConstantTimeCompare(got, want) == 1, nil
+}
@@ -0,0 +1,80 @@
+package auth
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// testParams テスト用に負荷を下げたパラメータ
+var testParams = Argon2Params{Memory: 64, Iterations: 1, Parallelism: 1, SaltLength: 16, KeyLength: 32}
+
+func TestPasswordHasher_HashAndVerify(t *testing.T) {
+	h := NewPasswordHasher(testParams)
+
+	hash, err := h.Hash("correct horse battery")
+	if err != nil {
+		t.Fatalf("Hash() unexpected error: %v", err)
+	}
+	if !strings.HasPrefix(hash, "$argon2id$v=19$m=64,t=1,p=1$") {
+		t.Errorf("Hash() = %q, want PHC string of argon2id", hash)
+	}
+	if other, _ := h.Hash("correct horse battery"); other == hash {
+		t.Error("Hash() returned the same value twice, want random salt")
+	}
+
+	// 照合は保存済みのハッシュのパラメータで行う
+	verifier := NewPasswordHasher(DefaultArgon2Params)
+	tests := []struct {
+		name     string
+		password string
+		want     bool
+	}{
+		{name: "match", password: "correct horse battery", want: true},
+		{name: "mismatch", password: "correct horse batterY", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := verifier.Verify(hash, tt.password)
+			if err != nil {
+				t.Fatalf("Verify() unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("Verify() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPasswordHasher_VerifyBcrypt(t *testing.T) {
+	hash, err := bcrypt.GenerateFromPassword([]byte("correct horse battery"), bcrypt.MinCost)
+	if err != nil {
+		t.Fatalf("GenerateFromPassword() unexpected error: %v", err)
+	}
+	h := NewPasswordHasher(testParams)
+
+	if ok, err := h.Verify(string(hash), "correct horse battery"); err != nil || !ok {
+		t.Errorf("Verify(bcrypt) = %v, %v, want true", ok, err)
+	}
+	if ok, err := h.Verify(string(hash), "wrong"); err != nil || ok {
+		t.Errorf("Verify(bcrypt, wrong) = %v, %v, want false", ok, err)
+	}
+}
+
+func TestPasswordHasher_VerifyMalformed(t *testing.T) {
+	h := NewPasswordHasher(testParams)
+	for _, hash := range []string{
+		"",
+		"plain-text",
+		"$argon2i$v=19$m=64,t=1,p=1$c2FsdA$aGFzaA",
+		"$argon2id$v=18$m=64,t=1,p=1$c2FsdA$aGFzaA",
+		"$argon2id$v=19$m=64,t=1$c2FsdA$aGFzaA",
+		"$argon2id$v=19$m=64,t=1,p=1$!!!$aGFzaA",
+	} {
+		if _, err := h.Verify(hash, "password"); !errors.Is(err, ErrUnsupportedHash) {
+			t.Errorf("Verify(%q) error = %v, want ErrUnsupportedHash", hash, err)
+		}
+	}
+}
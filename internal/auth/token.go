@@ -0,0 +1,102 @@
+package auth
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// TokenPrefix セッショントークンの接頭辞
+const TokenPrefix = "sess_"
+
+// TokenSecretMinLength 署名鍵の最小長（バイト）
+const TokenSecretMinLength = 32
+
+// ErrInvalidToken はトークンの形式や署名が不正な場合のエラー
+var ErrInvalidToken = errors.New("auth: invalid session token")
+
+// ErrTokenExpired はトークンの有効期限が切れている場合のエラー
+var ErrTokenExpired = errors.New("auth: session token expired")
+
+// Claims セッショントークンに含める情報
+type Claims struct {
+	// UserID はログインしたユーザーのID
+	UserID string `json:"sub"`
+	// OrgID はユーザーが所属する組織のID
+	OrgID string `json:"org"`
+	// SessionID はログインで作成したセッションのID（認証のたびに取り消し・有効期限を確認する）
+	SessionID string `json:"sid"`
+	// IssuedAt / ExpiresAt は発行時刻と有効期限（unix 秒）
+	IssuedAt  int64 `json:"iat"`
+	ExpiresAt int64 `json:"exp"`
+}
+
+// TokenIssuer セッショントークンの発行と検証（usecase.SessionIssuer の実装）
+//
+// トークンは "sess_" + base64url(JSON の Claims) + "." + base64url(HMAC-SHA256(secret, ペイロード部分))。
+// 署名と有効期限の検証のみを行い、セッションの取り消しは呼び出し側がセッションIDで確認する。
+type TokenIssuer struct {
+	secret []byte
+}
+
+// NewTokenIssuer TokenIssuerのコンストラクタ
+//
+// secret は TokenSecretMinLength バイト以上。
+func NewTokenIssuer(secret []byte) (*TokenIssuer, error) {
+	if len(secret) < TokenSecretMinLength {
+		return nil, fmt.Errorf("auth: token secret must be at least %d bytes", TokenSecretMinLength)
+	}
+	return &TokenIssuer{secret: secret}, nil
+}
+
+// Issue ユーザーのセッションのトークンを発行する（有効期限はセッションの有効期限）
+func (i *TokenIssuer) Issue(userID, orgID, sessionID string, now, expiresAt time.Time) (string, error) {
+	payload, err := json.Marshal(Claims{
+		UserID:    userID,
+		OrgID:     orgID,
+		SessionID: sessionID,
+		IssuedAt:  now.Unix(),
+		ExpiresAt: expiresAt.Unix(),
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to encode token claims: %w", err)
+	}
+	body := base64.RawURLEncoding.EncodeToString(payload)
+	return TokenPrefix + body + "." + i.sign(body), nil
+}
+
+// Parse トークンの署名と有効期限を検証し、含まれる情報を返す
+func (i *TokenIssuer) Parse(token string, now time.Time) (*Claims, error) {
+	rest, ok := strings.CutPrefix(token, TokenPrefix)
+	if !ok {
+		return nil, ErrInvalidToken
+	}
+	body, sig, ok := strings.Cut(rest, ".")
+	if !ok || !hmac.Equal([]byte(sig), []byte(i.sign(body))) {
+		return nil, ErrInvalidToken
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(body)
+	if err != nil {
+		return nil, ErrInvalidToken
+	}
+	var claims Claims
+	if err := json.Unmarshal(payload, &claims); err != nil || claims.UserID == "" || claims.OrgID == "" || claims.SessionID == "" {
+		return nil, ErrInvalidToken
+	}
+	if now.Unix() >= claims.ExpiresAt {
+		return nil, ErrTokenExpired
+	}
+	return &claims, nil
+}
+
+// sign ペイロード部分の HMAC-SHA256 を base64url で返す
+func (i *TokenIssuer) sign(body string) string {
+	mac := hmac.New(sha256.New, i.secret)
+	mac.Write([]byte(body))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,65 @@
+package auth
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+const testSecret = "0123456789abcdef0123456789abcdef"
+
+func TestTokenIssuer_IssueAndParse(t *testing.T) {
+	issuer, err := NewTokenIssuer([]byte(testSecret))
+	if err != nil {
+		t.Fatalf("NewTokenIssuer() unexpected error: %v", err)
+	}
+	now := time.Unix(1700000000, 0)
+	expiresAt := now.Add(time.Hour)
+	token, err := issuer.Issue("01ARZ3NDEKTSV4RRFFQ69G5FAV", "00000000000000000000000000", "01BX5ZZKBKACTAV9WEVGEMMVRZ", now, expiresAt)
+	if err != nil {
+		t.Fatalf("Issue() unexpected error: %v", err)
+	}
+	if !strings.HasPrefix(token, TokenPrefix) {
+		t.Errorf("Issue() = %q, want prefix %q", token, TokenPrefix)
+	}
+	// セッションIDのないトークンは受け付けない
+	withoutSession, err := issuer.Issue("01ARZ3NDEKTSV4RRFFQ69G5FAV", "00000000000000000000000000", "", now, expiresAt)
+	if err != nil {
+		t.Fatalf("Issue() unexpected error: %v", err)
+	}
+
+	other, _ := NewTokenIssuer([]byte(strings.Repeat("x", TokenSecretMinLength)))
+	tests := []struct {
+		name    string
+		issuer  *TokenIssuer
+		token   string
+		now     time.Time
+		wantErr error
+	}{
+		{name: "valid", issuer: issuer, token: token, now: now},
+		{name: "expired", issuer: issuer, token: token, now: expiresAt, wantErr: ErrTokenExpired},
+		{name: "other secret", issuer: other, token: token, now: now, wantErr: ErrInvalidToken},
+		{name: "tampered signature", issuer: issuer, token: token + "x", now: now, wantErr: ErrInvalidToken},
+		{name: "missing prefix", issuer: issuer, token: strings.TrimPrefix(token, TokenPrefix), now: now, wantErr: ErrInvalidToken},
+		{name: "malformed", issuer: issuer, token: TokenPrefix + "abc", now: now, wantErr: ErrInvalidToken},
+		{name: "missing session", issuer: issuer, token: withoutSession, now: now, wantErr: ErrInvalidToken},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			claims, err := tt.issuer.Parse(tt.token, tt.now)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Parse() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr == nil && (claims.UserID != "01ARZ3NDEKTSV4RRFFQ69G5FAV" || claims.OrgID != "00000000000000000000000000" || claims.SessionID != "01BX5ZZKBKACTAV9WEVGEMMVRZ") {
+				t.Errorf("Parse() = %+v, want issued user, organization and session", claims)
+			}
+		})
+	}
+}
+
+func TestNewTokenIssuer_ShortSecret(t *testing.T) {
+	if _, err := NewTokenIssuer([]byte("short")); err == nil {
+		t.Error("NewTokenIssuer() with a short secret succeeded, want error")
+	}
+}
@@ -0,0 +1,66 @@
+package auth
+
+import (
+	"bytes"
+	"image/png"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/pquerna/otp"
+	"github.com/pquerna/otp/totp"
+)
+
+func TestTOTP_GenerateAndVerify(t *testing.T) {
+	tp := NewTOTP("Example")
+	secret, uri, err := tp.GenerateSecret("john@example.com")
+	if err != nil {
+		t.Fatalf("GenerateSecret() unexpected error: %v", err)
+	}
+	u, err := url.Parse(uri)
+	if err != nil {
+		t.Fatalf("otpauth uri %q is invalid: %v", uri, err)
+	}
+	if u.Scheme != "otpauth" || u.Host != "totp" || u.Query().Get("secret") != secret || u.Query().Get("issuer") != "Example" {
+		t.Errorf("otpauth uri = %q, want totp uri with secret and issuer", uri)
+	}
+
+	qr, err := tp.QRCode(uri)
+	if err != nil {
+		t.Fatalf("QRCode() unexpected error: %v", err)
+	}
+	if _, err := png.Decode(bytes.NewReader(qr)); err != nil {
+		t.Errorf("QRCode() is not a PNG image: %v", err)
+	}
+
+	now := time.Unix(1700000000, 0)
+	codeAt := func(at time.Time) string {
+		code, err := totp.GenerateCodeCustom(secret, at, totp.ValidateOpts{Period: TOTPPeriod, Digits: otp.DigitsSix, Algorithm: otp.AlgorithmSHA1})
+		if err != nil {
+			t.Fatalf("GenerateCodeCustom() unexpected error: %v", err)
+		}
+		return code
+	}
+	step := now.Unix() / TOTPPeriod
+	tests := []struct {
+		name     string
+		code     string
+		wantStep int64
+		wantOK   bool
+	}{
+		{name: "current", code: codeAt(now), wantStep: step, wantOK: true},
+		{name: "previous step", code: codeAt(now.Add(-TOTPPeriod * time.Second)), wantStep: step - 1, wantOK: true},
+		{name: "next step", code: codeAt(now.Add(TOTPPeriod * time.Second)), wantStep: step + 1, wantOK: true},
+		{name: "too old", code: codeAt(now.Add(-2 * TOTPPeriod * time.Second)), wantOK: false},
+		{name: "surrounding spaces", code: " " + codeAt(now) + " ", wantStep: step, wantOK: true},
+		{name: "wrong length", code: "12345", wantOK: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := tp.Verify(secret, tt.code, now)
+			if ok != tt.wantOK || (ok && got != tt.wantStep) {
+				t.Errorf("Verify() = (%d, %v), want (%d, %v)", got, ok, tt.wantStep, tt.wantOK)
+			}
+		})
+	}
+}
@@ -0,0 +1,69 @@
+package command
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure/dao"
+	"github.com/example/go-react-cqrs-template/internal/tenant"
+)
+
+// CredentialRepository パスワード認証情報のリポジトリ（usecase.CredentialCommandRepository の実装）
+type CredentialRepository struct{}
+
+// NewCredentialRepository CredentialRepositoryのコンストラクタ
+func NewCredentialRepository() *CredentialRepository {
+	return &CredentialRepository{}
+}
+
+// FindCredentialForUpdate ユーザーIDで認証情報を検索しロックを取得
+func (r *CredentialRepository) FindCredentialForUpdate(ctx context.Context, tx infrastructure.DBTX, userID string) (*domain.Credential, error) {
+	cred, err := dao.New(tx).GetUserCredentialForUpdate(ctx, dao.GetUserCredentialForUpdateParams{
+		OrgID:  tenant.OrgID(ctx),
+		UserID: userID,
+	})
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find credential for update: %w", err)
+	}
+	return toDomainCredential(cred), nil
+}
+
+// SaveCredential 認証情報を保存
+func (r *CredentialRepository) SaveCredential(ctx context.Context, tx infrastructure.DBTX, cred *domain.Credential) error {
+	err := dao.New(tx).UpsertUserCredential(ctx, dao.UpsertUserCredentialParams{
+		UserID:            cred.UserID,
+		OrgID:             tenant.OrgID(ctx),
+		PasswordHash:      cred.PasswordHash,
+		FailedAttempts:    int32(cred.FailedAttempts),
+		LockedUntil:       sql.NullTime{Time: cred.LockedUntil, Valid: !cred.LockedUntil.IsZero()},
+		PasswordChangedAt: cred.PasswordChangedAt,
+		CreatedAt:         cred.CreatedAt,
+		UpdatedAt:         cred.UpdatedAt,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to save credential: %w", err)
+	}
+	return nil
+}
+
+// toDomainCredential dao.UserCredentialをdomain.Credentialに変換
+func toDomainCredential(c dao.UserCredential) *domain.Credential {
+	cred := &domain.Credential{
+		UserID:            c.UserID,
+		PasswordHash:      c.PasswordHash,
+		FailedAttempts:    int(c.FailedAttempts),
+		PasswordChangedAt: c.PasswordChangedAt,
+		CreatedAt:         c.CreatedAt,
+		UpdatedAt:         c.UpdatedAt,
+	}
+	if c.LockedUntil.Valid {
+		cred.LockedUntil = c.LockedUntil.Time
+	}
+	return cred
+}
@@ -0,0 +1,119 @@
+package command
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure/dao"
+	"github.com/example/go-react-cqrs-template/internal/tenant"
+)
+
+// ExternalIdentityRepository 外部の IdP のユーザーとの紐付けと認可リクエストの状態のリポジトリ
+// （usecase.ExternalIdentityCommandRepository / usecase.OIDCLoginStateCommandRepository の実装）
+type ExternalIdentityRepository struct{}
+
+// NewExternalIdentityRepository ExternalIdentityRepositoryのコンストラクタ
+func NewExternalIdentityRepository() *ExternalIdentityRepository {
+	return &ExternalIdentityRepository{}
+}
+
+// FindExternalIdentityForUpdate IdP の名前と subject で紐付けを検索しロックを取得
+func (r *ExternalIdentityRepository) FindExternalIdentityForUpdate(ctx context.Context, tx infrastructure.DBTX, provider, subject string) (*domain.ExternalIdentity, error) {
+	identity, err := dao.New(tx).GetUserExternalIdentityForUpdate(ctx, dao.GetUserExternalIdentityForUpdateParams{
+		OrgID:    tenant.OrgID(ctx),
+		Provider: provider,
+		Subject:  subject,
+	})
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find external identity for update: %w", err)
+	}
+	return toDomainExternalIdentity(identity), nil
+}
+
+// SaveExternalIdentity 紐付けを保存
+func (r *ExternalIdentityRepository) SaveExternalIdentity(ctx context.Context, tx infrastructure.DBTX, identity *domain.ExternalIdentity) error {
+	err := dao.New(tx).UpsertUserExternalIdentity(ctx, dao.UpsertUserExternalIdentityParams{
+		OrgID:       tenant.OrgID(ctx),
+		Provider:    identity.Provider,
+		Subject:     identity.Subject,
+		UserID:      identity.UserID,
+		LastLoginAt: sql.NullTime{Time: identity.LastLoginAt, Valid: !identity.LastLoginAt.IsZero()},
+		CreatedAt:   identity.CreatedAt,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to save external identity: %w", err)
+	}
+	return nil
+}
+
+// FindOIDCLoginStateForUpdate IdP の名前と state のハッシュで認可リクエストの状態を検索しロックを取得
+func (r *ExternalIdentityRepository) FindOIDCLoginStateForUpdate(ctx context.Context, tx infrastructure.DBTX, provider, stateHash string) (*domain.OIDCLoginState, error) {
+	state, err := dao.New(tx).GetOIDCLoginStateByHashForUpdate(ctx, dao.GetOIDCLoginStateByHashForUpdateParams{
+		OrgID:     tenant.OrgID(ctx),
+		Provider:  provider,
+		StateHash: stateHash,
+	})
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find oidc login state for update: %w", err)
+	}
+	return toDomainOIDCLoginState(state), nil
+}
+
+// SaveOIDCLoginState 認可リクエストの状態を保存
+func (r *ExternalIdentityRepository) SaveOIDCLoginState(ctx context.Context, tx infrastructure.DBTX, state *domain.OIDCLoginState) error {
+	err := dao.New(tx).UpsertOIDCLoginState(ctx, dao.UpsertOIDCLoginStateParams{
+		ID:           state.ID,
+		OrgID:        tenant.OrgID(ctx),
+		Provider:     state.Provider,
+		StateHash:    state.StateHash,
+		Nonce:        state.Nonce,
+		CodeVerifier: state.CodeVerifier,
+		ExpiresAt:    state.ExpiresAt,
+		UsedAt:       sql.NullTime{Time: state.UsedAt, Valid: !state.UsedAt.IsZero()},
+		CreatedAt:    state.CreatedAt,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to save oidc login state: %w", err)
+	}
+	return nil
+}
+
+// toDomainExternalIdentity dao.UserExternalIdentityをdomain.ExternalIdentityに変換
+func toDomainExternalIdentity(i dao.UserExternalIdentity) *domain.ExternalIdentity {
+	identity := &domain.ExternalIdentity{
+		Provider:  i.Provider,
+		Subject:   i.Subject,
+		UserID:    i.UserID,
+		CreatedAt: i.CreatedAt,
+	}
+	if i.LastLoginAt.Valid {
+		identity.LastLoginAt = i.LastLoginAt.Time
+	}
+	return identity
+}
+
+// toDomainOIDCLoginState dao.OidcLoginStateをdomain.OIDCLoginStateに変換
+func toDomainOIDCLoginState(s dao.OidcLoginState) *domain.OIDCLoginState {
+	state := &domain.OIDCLoginState{
+		ID:           s.ID,
+		Provider:     s.Provider,
+		StateHash:    s.StateHash,
+		Nonce:        s.Nonce,
+		CodeVerifier: s.CodeVerifier,
+		ExpiresAt:    s.ExpiresAt,
+		CreatedAt:    s.CreatedAt,
+	}
+	if s.UsedAt.Valid {
+		state.UsedAt = s.UsedAt.Time
+	}
+	return state
+}
@@ -0,0 +1,131 @@
+package command
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure/dao"
+	"github.com/example/go-react-cqrs-template/internal/tenant"
+)
+
+// GroupRepository コマンド側のグループリポジトリ（usecase.GroupCommandRepository の実装）
+type GroupRepository struct{}
+
+// NewGroupRepository GroupRepositoryのコンストラクタ
+func NewGroupRepository() *GroupRepository {
+	return &GroupRepository{}
+}
+
+// SaveGroup グループを保存
+func (r *GroupRepository) SaveGroup(ctx context.Context, tx infrastructure.DBTX, group *domain.Group) error {
+	err := dao.New(tx).UpsertGroup(ctx, dao.UpsertGroupParams{
+		ID:        group.ID,
+		OrgID:     tenant.OrgID(ctx),
+		Name:      group.Name,
+		CreatedAt: group.CreatedAt,
+		UpdatedAt: group.UpdatedAt,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to save group: %w", err)
+	}
+	return nil
+}
+
+// FindGroupByIDForUpdate IDでグループを検索しロックを取得
+func (r *GroupRepository) FindGroupByIDForUpdate(ctx context.Context, tx infrastructure.DBTX, id string) (*domain.Group, error) {
+	group, err := dao.New(tx).GetGroupByIDForUpdate(ctx, dao.GetGroupByIDForUpdateParams{
+		OrgID: tenant.OrgID(ctx),
+		ID:    id,
+	})
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find group for update: %w", err)
+	}
+	return toDomainGroup(group), nil
+}
+
+// FindGroupByNameForUpdate グループ名でグループを検索しロックを取得
+func (r *GroupRepository) FindGroupByNameForUpdate(ctx context.Context, tx infrastructure.DBTX, name string) (*domain.Group, error) {
+	group, err := dao.New(tx).GetGroupByNameForUpdate(ctx, dao.GetGroupByNameForUpdateParams{
+		OrgID: tenant.OrgID(ctx),
+		Name:  name,
+	})
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find group by name for update: %w", err)
+	}
+	return toDomainGroup(group), nil
+}
+
+// FindGroupMember メンバーシップを検索（グループのロックを取得した上で使用する）
+func (r *GroupRepository) FindGroupMember(ctx context.Context, tx infrastructure.DBTX, groupID, userID string) (*domain.GroupMember, error) {
+	member, err := dao.New(tx).GetGroupMember(ctx, dao.GetGroupMemberParams{
+		OrgID:   tenant.OrgID(ctx),
+		GroupID: groupID,
+		UserID:  userID,
+	})
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find group member: %w", err)
+	}
+	return toDomainGroupMember(member), nil
+}
+
+// SaveGroupMember メンバーシップを保存
+func (r *GroupRepository) SaveGroupMember(ctx context.Context, tx infrastructure.DBTX, member *domain.GroupMember) error {
+	err := dao.New(tx).UpsertGroupMember(ctx, dao.UpsertGroupMemberParams{
+		GroupID:   member.GroupID,
+		UserID:    member.UserID,
+		OrgID:     tenant.OrgID(ctx),
+		Role:      string(member.Role),
+		CreatedAt: member.CreatedAt,
+		UpdatedAt: member.UpdatedAt,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to save group member: %w", err)
+	}
+	return nil
+}
+
+// DeleteGroupMember メンバーシップを削除
+func (r *GroupRepository) DeleteGroupMember(ctx context.Context, tx infrastructure.DBTX, groupID, userID string) (bool, error) {
+	affected, err := dao.New(tx).DeleteGroupMember(ctx, dao.DeleteGroupMemberParams{
+		OrgID:   tenant.OrgID(ctx),
+		GroupID: groupID,
+		UserID:  userID,
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to delete group member: %w", err)
+	}
+	return affected > 0, nil
+}
+
+// toDomainGroup dao.Groupをdomain.Groupに変換
+func toDomainGroup(g dao.Group) *domain.Group {
+	return &domain.Group{
+		ID:        g.ID,
+		Name:      g.Name,
+		CreatedAt: g.CreatedAt,
+		UpdatedAt: g.UpdatedAt,
+	}
+}
+
+// toDomainGroupMember dao.GroupMemberをdomain.GroupMemberに変換
+func toDomainGroupMember(m dao.GroupMember) *domain.GroupMember {
+	return &domain.GroupMember{
+		GroupID:   m.GroupID,
+		UserID:    m.UserID,
+		Role:      domain.GroupRole(m.Role),
+		CreatedAt: m.CreatedAt,
+		UpdatedAt: m.UpdatedAt,
+	}
+}
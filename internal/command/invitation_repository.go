@@ -0,0 +1,124 @@
+package command
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure/dao"
+	"github.com/example/go-react-cqrs-template/internal/pii"
+	"github.com/example/go-react-cqrs-template/internal/tenant"
+)
+
+// InvitationRepository 招待のリポジトリ（usecase.InvitationCommandRepository の実装）
+type InvitationRepository struct {
+	keyring *pii.Keyring
+}
+
+// NewInvitationRepository InvitationRepositoryのコンストラクタ（keyring で招待先のメールアドレスを暗号化・復号する）
+func NewInvitationRepository(keyring *pii.Keyring) *InvitationRepository {
+	return &InvitationRepository{keyring: keyring}
+}
+
+// FindInvitationByIDForUpdate IDで招待を検索しロックを取得
+func (r *InvitationRepository) FindInvitationByIDForUpdate(ctx context.Context, tx infrastructure.DBTX, id string) (*domain.Invitation, error) {
+	inv, err := dao.New(tx).GetInvitationByIDForUpdate(ctx, dao.GetInvitationByIDForUpdateParams{
+		OrgID: tenant.OrgID(ctx),
+		ID:    id,
+	})
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find invitation for update: %w", err)
+	}
+	return toDomainInvitation(r.keyring, inv)
+}
+
+// FindInvitationByTokenForUpdate トークンのハッシュで招待を検索しロックを取得
+func (r *InvitationRepository) FindInvitationByTokenForUpdate(ctx context.Context, tx infrastructure.DBTX, tokenHash string) (*domain.Invitation, error) {
+	inv, err := dao.New(tx).GetInvitationByTokenHashForUpdate(ctx, dao.GetInvitationByTokenHashForUpdateParams{
+		OrgID:     tenant.OrgID(ctx),
+		TokenHash: tokenHash,
+	})
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find invitation by token for update: %w", err)
+	}
+	return toDomainInvitation(r.keyring, inv)
+}
+
+// FindExpiredInvitationsForUpdate 有効期限を過ぎた承諾待ちの招待をロックして取得（他のトランザクションがロック中の招待は飛ばす）
+func (r *InvitationRepository) FindExpiredInvitationsForUpdate(ctx context.Context, tx infrastructure.DBTX, now time.Time, limit int) ([]*domain.Invitation, error) {
+	rows, err := dao.New(tx).ListExpiredInvitationsForUpdate(ctx, dao.ListExpiredInvitationsForUpdateParams{
+		OrgID:     tenant.OrgID(ctx),
+		ExpiresAt: now,
+		Limit:     int32(limit),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to find expired invitations: %w", err)
+	}
+	result := make([]*domain.Invitation, len(rows))
+	for i, inv := range rows {
+		if result[i], err = toDomainInvitation(r.keyring, inv); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+// SaveInvitation 招待を保存
+func (r *InvitationRepository) SaveInvitation(ctx context.Context, tx infrastructure.DBTX, inv *domain.Invitation) error {
+	sealed, err := r.keyring.Seal(inv.ID, inv.Email)
+	if err != nil {
+		return fmt.Errorf("failed to encrypt invitation: %w", err)
+	}
+	err = dao.New(tx).UpsertInvitation(ctx, dao.UpsertInvitationParams{
+		ID:              inv.ID,
+		OrgID:           tenant.OrgID(ctx),
+		UserID:          inv.UserID,
+		EmailCiphertext: sealed.Values[0],
+		PiiKeyID:        sealed.KeyID,
+		PiiDataKey:      sealed.DataKey,
+		InvitedBy:       sql.NullString{String: inv.InvitedBy, Valid: inv.InvitedBy != ""},
+		Status:          string(inv.Status),
+		TokenHash:       inv.TokenHash,
+		ExpiresAt:       inv.ExpiresAt,
+		AcceptedAt:      sql.NullTime{Time: inv.AcceptedAt, Valid: !inv.AcceptedAt.IsZero()},
+		CreatedAt:       inv.CreatedAt,
+		UpdatedAt:       inv.UpdatedAt,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to save invitation: %w", err)
+	}
+	return nil
+}
+
+// toDomainInvitation dao.Invitationを復号してdomain.Invitationに変換
+func toDomainInvitation(keyring *pii.Keyring, inv dao.Invitation) (*domain.Invitation, error) {
+	values, err := keyring.Open(inv.ID, &pii.Sealed{
+		KeyID:   inv.PiiKeyID,
+		DataKey: inv.PiiDataKey,
+		Values:  [][]byte{inv.EmailCiphertext},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt invitation %s: %w", inv.ID, err)
+	}
+	return &domain.Invitation{
+		ID:         inv.ID,
+		UserID:     inv.UserID,
+		Email:      values[0],
+		InvitedBy:  inv.InvitedBy.String,
+		Status:     domain.InvitationStatus(inv.Status),
+		TokenHash:  inv.TokenHash,
+		ExpiresAt:  inv.ExpiresAt,
+		AcceptedAt: inv.AcceptedAt.Time,
+		CreatedAt:  inv.CreatedAt,
+		UpdatedAt:  inv.UpdatedAt,
+	}, nil
+}
@@ -0,0 +1,32 @@
+package command
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure/dao"
+)
+
+// OrganizationRepository コマンド側の組織リポジトリ（usecase.OrganizationCommandRepository の実装）
+type OrganizationRepository struct{}
+
+// NewOrganizationRepository OrganizationRepositoryのコンストラクタ
+func NewOrganizationRepository() *OrganizationRepository {
+	return &OrganizationRepository{}
+}
+
+// SaveOrganization 組織を保存
+func (r *OrganizationRepository) SaveOrganization(ctx context.Context, tx infrastructure.DBTX, org *domain.Organization) error {
+	err := dao.New(tx).UpsertOrganization(ctx, dao.UpsertOrganizationParams{
+		ID:        org.ID,
+		Name:      org.Name,
+		CreatedAt: org.CreatedAt,
+		UpdatedAt: org.UpdatedAt,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to save organization: %w", err)
+	}
+	return nil
+}
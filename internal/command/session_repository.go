@@ -0,0 +1,113 @@
+package command
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure/dao"
+	"github.com/example/go-react-cqrs-template/internal/tenant"
+)
+
+// SessionChangedChannel セッションの取り消しをコミット時に通知する PostgreSQL の LISTEN/NOTIFY チャネル
+// （ペイロードは SessionChangedPayload）
+const SessionChangedChannel = "session_changed"
+
+// SessionChangedPayload 組織 orgID のセッション id の SessionChangedChannel の通知のペイロード
+func SessionChangedPayload(orgID, id string) string {
+	return orgID + "/" + id
+}
+
+// SessionRepository セッションのリポジトリ（usecase.SessionCommandRepository の実装）
+type SessionRepository struct{}
+
+// NewSessionRepository SessionRepositoryのコンストラクタ
+func NewSessionRepository() *SessionRepository {
+	return &SessionRepository{}
+}
+
+// FindSessionByIDForUpdate IDでセッションを検索しロックを取得
+func (r *SessionRepository) FindSessionByIDForUpdate(ctx context.Context, tx infrastructure.DBTX, id string) (*domain.Session, error) {
+	s, err := dao.New(tx).GetSessionByIDForUpdate(ctx, dao.GetSessionByIDForUpdateParams{
+		OrgID: tenant.OrgID(ctx),
+		ID:    id,
+	})
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find session for update: %w", err)
+	}
+	return toDomainSession(s), nil
+}
+
+// FindUnexpiredSessionsForUpdate 取り消されておらずログインからの有効期限内のユーザーのセッションをロックして取得
+func (r *SessionRepository) FindUnexpiredSessionsForUpdate(ctx context.Context, tx infrastructure.DBTX, userID string, now time.Time) ([]*domain.Session, error) {
+	rows, err := dao.New(tx).ListUnexpiredSessionsByUserIDForUpdate(ctx, dao.ListUnexpiredSessionsByUserIDForUpdateParams{
+		OrgID:     tenant.OrgID(ctx),
+		UserID:    userID,
+		ExpiresAt: now,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to find unexpired sessions for update: %w", err)
+	}
+	result := make([]*domain.Session, len(rows))
+	for i, s := range rows {
+		result[i] = toDomainSession(s)
+	}
+	return result, nil
+}
+
+// SaveSession セッションを保存（作成後に変更できるのは最終利用時刻と取り消した時刻のみ）
+//
+// 取り消したセッションは SessionChangedChannel に通知し、各レプリカの読み取りキャッシュから削除させる。
+func (r *SessionRepository) SaveSession(ctx context.Context, tx infrastructure.DBTX, session *domain.Session) error {
+	queries := dao.New(tx)
+	err := queries.UpsertSession(ctx, dao.UpsertSessionParams{
+		ID:         session.ID,
+		OrgID:      tenant.OrgID(ctx),
+		UserID:     session.UserID,
+		Device:     session.Device,
+		IpAddress:  session.IPAddress,
+		UserAgent:  session.UserAgent,
+		CreatedAt:  session.CreatedAt,
+		LastSeenAt: session.LastSeenAt,
+		ExpiresAt:  session.ExpiresAt,
+		RevokedAt:  sql.NullTime{Time: session.RevokedAt, Valid: !session.RevokedAt.IsZero()},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to save session: %w", err)
+	}
+
+	if session.RevokedAt.IsZero() {
+		return nil
+	}
+	err = queries.NotifySessionChanged(ctx, dao.NotifySessionChangedParams{
+		Channel:    SessionChangedChannel,
+		SessionKey: SessionChangedPayload(tenant.OrgID(ctx), session.ID),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to notify session change: %w", err)
+	}
+	return nil
+}
+
+// toDomainSession dao.Sessionをdomain.Sessionに変換
+func toDomainSession(s dao.Session) *domain.Session {
+	return &domain.Session{
+		ID:     s.ID,
+		UserID: s.UserID,
+		SessionClient: domain.SessionClient{
+			Device:    s.Device,
+			IPAddress: s.IpAddress,
+			UserAgent: s.UserAgent,
+		},
+		CreatedAt:  s.CreatedAt,
+		LastSeenAt: s.LastSeenAt,
+		ExpiresAt:  s.ExpiresAt,
+		RevokedAt:  s.RevokedAt.Time,
+	}
+}
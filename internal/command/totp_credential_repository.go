@@ -0,0 +1,81 @@
+package command
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure/dao"
+	"github.com/example/go-react-cqrs-template/internal/tenant"
+)
+
+// TOTPCredentialRepository TOTP の二要素認証の登録のリポジトリ（usecase.TOTPCredentialCommandRepository の実装）
+type TOTPCredentialRepository struct{}
+
+// NewTOTPCredentialRepository TOTPCredentialRepositoryのコンストラクタ
+func NewTOTPCredentialRepository() *TOTPCredentialRepository {
+	return &TOTPCredentialRepository{}
+}
+
+// FindTOTPCredentialForUpdate ユーザーIDで TOTP の登録を検索しロックを取得
+func (r *TOTPCredentialRepository) FindTOTPCredentialForUpdate(ctx context.Context, tx infrastructure.DBTX, userID string) (*domain.TOTPCredential, error) {
+	cred, err := dao.New(tx).GetUserTOTPCredentialForUpdate(ctx, dao.GetUserTOTPCredentialForUpdateParams{
+		OrgID:  tenant.OrgID(ctx),
+		UserID: userID,
+	})
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find totp credential for update: %w", err)
+	}
+	return toDomainTOTPCredential(cred), nil
+}
+
+// SaveTOTPCredential TOTP の登録を保存
+func (r *TOTPCredentialRepository) SaveTOTPCredential(ctx context.Context, tx infrastructure.DBTX, cred *domain.TOTPCredential) error {
+	err := dao.New(tx).UpsertUserTOTPCredential(ctx, dao.UpsertUserTOTPCredentialParams{
+		UserID:             cred.UserID,
+		OrgID:              tenant.OrgID(ctx),
+		Secret:             cred.Secret,
+		ConfirmedAt:        sql.NullTime{Time: cred.ConfirmedAt, Valid: !cred.ConfirmedAt.IsZero()},
+		LastUsedStep:       cred.LastUsedStep,
+		RecoveryCodeHashes: cred.RecoveryCodeHashes,
+		CreatedAt:          cred.CreatedAt,
+		UpdatedAt:          cred.UpdatedAt,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to save totp credential: %w", err)
+	}
+	return nil
+}
+
+// DeleteTOTPCredential TOTP の登録を削除
+func (r *TOTPCredentialRepository) DeleteTOTPCredential(ctx context.Context, tx infrastructure.DBTX, userID string) error {
+	err := dao.New(tx).DeleteUserTOTPCredential(ctx, dao.DeleteUserTOTPCredentialParams{
+		OrgID:  tenant.OrgID(ctx),
+		UserID: userID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to delete totp credential: %w", err)
+	}
+	return nil
+}
+
+// toDomainTOTPCredential dao.UserTotpCredentialをdomain.TOTPCredentialに変換
+func toDomainTOTPCredential(c dao.UserTotpCredential) *domain.TOTPCredential {
+	cred := &domain.TOTPCredential{
+		UserID:             c.UserID,
+		Secret:             c.Secret,
+		LastUsedStep:       c.LastUsedStep,
+		RecoveryCodeHashes: c.RecoveryCodeHashes,
+		CreatedAt:          c.CreatedAt,
+		UpdatedAt:          c.UpdatedAt,
+	}
+	if c.ConfirmedAt.Valid {
+		cred.ConfirmedAt = c.ConfirmedAt.Time
+	}
+	return cred
+}
@@ -8,30 +8,50 @@ import (
 	"github.com/example/go-react-cqrs-template/internal/domain"
 	"github.com/example/go-react-cqrs-template/internal/infrastructure"
 	"github.com/example/go-react-cqrs-template/internal/infrastructure/dao"
+	"github.com/example/go-react-cqrs-template/internal/pii"
+	"github.com/example/go-react-cqrs-template/internal/tenant"
 )
 
-// Save ユーザーを保存（トランザクション内で使用）
-func Save(ctx context.Context, tx infrastructure.DBTX, user *domain.User) error {
+// Save ユーザーをコンテキストの組織に保存（トランザクション内で使用）
+//
+// 名前とメールアドレスは新しいデータキーで暗号化し、メールアドレスのブラインドインデックスと共に保存する。
+// 他の組織に同じIDのユーザーがいる場合は更新せずにエラーを返す。
+func Save(ctx context.Context, tx infrastructure.DBTX, keyring *pii.Keyring, user *domain.User) error {
 	queries := dao.New(tx)
-	err := queries.UpsertUser(ctx, dao.UpsertUserParams{
-		ID:        user.ID,
-		Name:      user.Name,
-		Email:     user.Email,
-		CreatedAt: user.CreatedAt,
-		UpdatedAt: user.UpdatedAt,
+	orgID := tenant.OrgID(ctx)
+	sealed, err := keyring.Seal(user.ID, user.Name, user.Email)
+	if err != nil {
+		return fmt.Errorf("failed to encrypt user: %w", err)
+	}
+	n, err := queries.UpsertUser(ctx, dao.UpsertUserParams{
+		ID:              user.ID,
+		OrgID:           orgID,
+		NameCiphertext:  sealed.Values[0],
+		EmailCiphertext: sealed.Values[1],
+		EmailIndex:      keyring.BlindIndex(orgID, user.Email),
+		PiiKeyID:        sealed.KeyID,
+		PiiDataKey:      sealed.DataKey,
+		Status:          string(user.Status),
+		EmailVerifiedAt: sql.NullTime{Time: user.EmailVerifiedAt, Valid: !user.EmailVerifiedAt.IsZero()},
+		CreatedAt:       user.CreatedAt,
+		UpdatedAt:       user.UpdatedAt,
 	})
 	if err != nil {
 		return fmt.Errorf("failed to save user: %w", err)
 	}
+	if n == 0 {
+		return fmt.Errorf("failed to save user: %s belongs to another organization than %s", user.ID, orgID)
+	}
 	return nil
 }
 
 // Delete ユーザーを削除（トランザクション内で使用）
 func Delete(ctx context.Context, tx infrastructure.DBTX, id string) error {
 	queries := dao.New(tx)
+	orgID := tenant.OrgID(ctx)
 
 	// ユーザーの存在確認（FOR UPDATEでロック取得）
-	_, err := queries.GetUserByIDForUpdate(ctx, id)
+	_, err := queries.GetUserByIDForUpdate(ctx, dao.GetUserByIDForUpdateParams{OrgID: orgID, ID: id})
 	if err == sql.ErrNoRows {
 		return fmt.Errorf("user not found: %s", id)
 	}
@@ -40,7 +60,7 @@ func Delete(ctx context.Context, tx infrastructure.DBTX, id string) error {
 	}
 
 	// 削除実行
-	if err := queries.DeleteUser(ctx, id); err != nil {
+	if err := queries.DeleteUser(ctx, dao.DeleteUserParams{OrgID: orgID, ID: id}); err != nil {
 		return fmt.Errorf("failed to delete user: %w", err)
 	}
 
@@ -48,38 +68,163 @@ func Delete(ctx context.Context, tx infrastructure.DBTX, id string) error {
 }
 
 // FindByIDForUpdate IDでユーザーを検索しロックを取得（トランザクション内で使用）
-func FindByIDForUpdate(ctx context.Context, tx infrastructure.DBTX, id string) (*domain.User, error) {
+func FindByIDForUpdate(ctx context.Context, tx infrastructure.DBTX, keyring *pii.Keyring, id string) (*domain.User, error) {
 	queries := dao.New(tx)
-	user, err := queries.GetUserByIDForUpdate(ctx, id)
+	user, err := queries.GetUserByIDForUpdate(ctx, dao.GetUserByIDForUpdateParams{
+		OrgID: tenant.OrgID(ctx),
+		ID:    id,
+	})
 	if err == sql.ErrNoRows {
 		return nil, nil
 	}
 	if err != nil {
 		return nil, fmt.Errorf("failed to find user for update: %w", err)
 	}
-	return toDomainUser(user), nil
+	return toDomainUser(keyring, user)
 }
 
 // FindByEmailForUpdate メールアドレスでユーザーを検索しロックを取得（トランザクション内で使用）
-func FindByEmailForUpdate(ctx context.Context, tx infrastructure.DBTX, email string) (*domain.User, error) {
+//
+// メールアドレスのブラインドインデックスで検索する。
+func FindByEmailForUpdate(ctx context.Context, tx infrastructure.DBTX, keyring *pii.Keyring, email string) (*domain.User, error) {
 	queries := dao.New(tx)
-	user, err := queries.GetUserByEmailForUpdate(ctx, email)
+	orgID := tenant.OrgID(ctx)
+	user, err := queries.GetUserByEmailForUpdate(ctx, dao.GetUserByEmailForUpdateParams{
+		OrgID:      orgID,
+		EmailIndex: keyring.BlindIndex(orgID, email),
+	})
 	if err == sql.ErrNoRows {
 		return nil, nil
 	}
 	if err != nil {
 		return nil, fmt.Errorf("failed to find user by email for update: %w", err)
 	}
-	return toDomainUser(user), nil
+	return toDomainUser(keyring, user)
 }
 
-// toDomainUser dao.Userをdomain.Userに変換
-func toDomainUser(u dao.User) *domain.User {
-	return &domain.User{
-		ID:        u.ID,
-		Name:      u.Name,
-		Email:     u.Email,
-		CreatedAt: u.CreatedAt,
-		UpdatedAt: u.UpdatedAt,
+// ReencryptUsers 主鍵以外の鍵で暗号化したデータキーを主鍵で暗号化し直す（トランザクション内で使用）
+//
+// コンテキストの組織のユーザー・メールで送るトークン・招待の順に合計で最大 limit 件を処理し、
+// 処理した件数を返す。名前とメールアドレスの暗号文は変わらない。
+func ReencryptUsers(ctx context.Context, tx infrastructure.DBTX, keyring *pii.Keyring, limit int) (int, error) {
+	queries := dao.New(tx)
+	orgID := tenant.OrgID(ctx)
+	n := 0
+	for _, reencrypt := range []func(context.Context, *dao.Queries, *pii.Keyring, string, int) (int, error){
+		reencryptUserDataKeys,
+		reencryptUserTokenDataKeys,
+		reencryptInvitationDataKeys,
+	} {
+		if n == limit {
+			break
+		}
+		m, err := reencrypt(ctx, queries, keyring, orgID, limit-n)
+		if err != nil {
+			return n, err
+		}
+		n += m
+	}
+	return n, nil
+}
+
+// reencryptUserDataKeys ユーザーのデータキーを最大 limit 件主鍵で暗号化し直す
+func reencryptUserDataKeys(ctx context.Context, queries *dao.Queries, keyring *pii.Keyring, orgID string, limit int) (int, error) {
+	rows, err := queries.ListUserDataKeysForUpdate(ctx, dao.ListUserDataKeysForUpdateParams{
+		OrgID:    orgID,
+		PiiKeyID: keyring.PrimaryKeyID(),
+		Limit:    int32(limit),
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to list user data keys: %w", err)
 	}
+	for _, row := range rows {
+		sealed := &pii.Sealed{KeyID: row.PiiKeyID, DataKey: row.PiiDataKey}
+		if _, err := keyring.Rewrap(row.ID, sealed); err != nil {
+			return 0, fmt.Errorf("failed to rewrap data key of user %s: %w", row.ID, err)
+		}
+		if err := queries.UpdateUserDataKey(ctx, dao.UpdateUserDataKeyParams{
+			PiiKeyID:   sealed.KeyID,
+			PiiDataKey: sealed.DataKey,
+			OrgID:      orgID,
+			ID:         row.ID,
+		}); err != nil {
+			return 0, fmt.Errorf("failed to update data key of user %s: %w", row.ID, err)
+		}
+	}
+	return len(rows), nil
+}
+
+// reencryptUserTokenDataKeys メールで送るトークンのデータキーを最大 limit 件主鍵で暗号化し直す
+func reencryptUserTokenDataKeys(ctx context.Context, queries *dao.Queries, keyring *pii.Keyring, orgID string, limit int) (int, error) {
+	rows, err := queries.ListUserTokenDataKeysForUpdate(ctx, dao.ListUserTokenDataKeysForUpdateParams{
+		OrgID:    orgID,
+		PiiKeyID: keyring.PrimaryKeyID(),
+		Limit:    int32(limit),
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to list user token data keys: %w", err)
+	}
+	for _, row := range rows {
+		sealed := &pii.Sealed{KeyID: row.PiiKeyID, DataKey: row.PiiDataKey}
+		if _, err := keyring.Rewrap(row.ID, sealed); err != nil {
+			return 0, fmt.Errorf("failed to rewrap data key of user token %s: %w", row.ID, err)
+		}
+		if err := queries.UpdateUserTokenDataKey(ctx, dao.UpdateUserTokenDataKeyParams{
+			PiiKeyID:   sealed.KeyID,
+			PiiDataKey: sealed.DataKey,
+			OrgID:      orgID,
+			ID:         row.ID,
+		}); err != nil {
+			return 0, fmt.Errorf("failed to update data key of user token %s: %w", row.ID, err)
+		}
+	}
+	return len(rows), nil
+}
+
+// reencryptInvitationDataKeys 招待のデータキーを最大 limit 件主鍵で暗号化し直す
+func reencryptInvitationDataKeys(ctx context.Context, queries *dao.Queries, keyring *pii.Keyring, orgID string, limit int) (int, error) {
+	rows, err := queries.ListInvitationDataKeysForUpdate(ctx, dao.ListInvitationDataKeysForUpdateParams{
+		OrgID:    orgID,
+		PiiKeyID: keyring.PrimaryKeyID(),
+		Limit:    int32(limit),
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to list invitation data keys: %w", err)
+	}
+	for _, row := range rows {
+		sealed := &pii.Sealed{KeyID: row.PiiKeyID, DataKey: row.PiiDataKey}
+		if _, err := keyring.Rewrap(row.ID, sealed); err != nil {
+			return 0, fmt.Errorf("failed to rewrap data key of invitation %s: %w", row.ID, err)
+		}
+		if err := queries.UpdateInvitationDataKey(ctx, dao.UpdateInvitationDataKeyParams{
+			PiiKeyID:   sealed.KeyID,
+			PiiDataKey: sealed.DataKey,
+			OrgID:      orgID,
+			ID:         row.ID,
+		}); err != nil {
+			return 0, fmt.Errorf("failed to update data key of invitation %s: %w", row.ID, err)
+		}
+	}
+	return len(rows), nil
+}
+
+// toDomainUser dao.Userを復号してdomain.Userに変換
+func toDomainUser(keyring *pii.Keyring, u dao.User) (*domain.User, error) {
+	values, err := keyring.Open(u.ID, &pii.Sealed{
+		KeyID:   u.PiiKeyID,
+		DataKey: u.PiiDataKey,
+		Values:  [][]byte{u.NameCiphertext, u.EmailCiphertext},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt user %s: %w", u.ID, err)
+	}
+	return &domain.User{
+		ID:              u.ID,
+		Name:            values[0],
+		Email:           values[1],
+		Status:          domain.UserStatus(u.Status),
+		EmailVerifiedAt: u.EmailVerifiedAt.Time,
+		CreatedAt:       u.CreatedAt,
+		UpdatedAt:       u.UpdatedAt,
+	}, nil
 }
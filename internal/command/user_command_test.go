@@ -0,0 +1,204 @@
+package command_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/example/go-react-cqrs-template/internal/command"
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure/dao"
+	"github.com/example/go-react-cqrs-template/internal/tenant"
+	"github.com/example/go-react-cqrs-template/internal/testutil/dbtest"
+)
+
+func TestSave_InsertAndUpdate(t *testing.T) {
+	db := dbtest.New(t)
+	keyring := dbtest.Keyring(t)
+	ctx := context.Background()
+
+	user, err := domain.NewUser("John Doe", "john@example.com")
+	if err != nil {
+		t.Fatalf("Failed to create user: %v", err)
+	}
+	if err := command.Save(ctx, db, keyring, user); err != nil {
+		t.Fatalf("Save() insert unexpected error: %v", err)
+	}
+
+	if err := user.Update("John Smith", "smith@example.com"); err != nil {
+		t.Fatalf("Update() unexpected error: %v", err)
+	}
+	if err := command.Save(ctx, db, keyring, user); err != nil {
+		t.Fatalf("Save() update unexpected error: %v", err)
+	}
+
+	got, err := command.FindByIDForUpdate(ctx, db, keyring, user.ID)
+	if err != nil {
+		t.Fatalf("FindByIDForUpdate() unexpected error: %v", err)
+	}
+	if got == nil {
+		t.Fatal("FindByIDForUpdate() returned nil")
+	}
+	if got.Name != "John Smith" || got.Email != "smith@example.com" {
+		t.Errorf("FindByIDForUpdate() = %+v, want updated values", got)
+	}
+
+	// 名前とメールアドレスは平文で保存しない
+	var name, email []byte
+	if err := db.QueryRowContext(ctx, "SELECT name_ciphertext, email_ciphertext FROM users WHERE id = $1", user.ID).Scan(&name, &email); err != nil {
+		t.Fatalf("failed to read stored user: %v", err)
+	}
+	if bytes.Contains(name, []byte("John Smith")) || bytes.Contains(email, []byte("smith@example.com")) {
+		t.Error("stored user contains plaintext name or email")
+	}
+	byEmail, err := command.FindByEmailForUpdate(ctx, db, keyring, "smith@example.com")
+	if err != nil || byEmail == nil || byEmail.ID != user.ID {
+		t.Errorf("FindByEmailForUpdate() = %v, %v, want %s", byEmail, err, user.ID)
+	}
+}
+
+func TestSave_DuplicateEmail(t *testing.T) {
+	db := dbtest.New(t)
+	keyring := dbtest.Keyring(t)
+	ctx := context.Background()
+
+	first, _ := domain.NewUser("John Doe", "john@example.com")
+	second, _ := domain.NewUser("Johnny Doe", "john@example.com")
+	if err := command.Save(ctx, db, keyring, first); err != nil {
+		t.Fatalf("Save() unexpected error: %v", err)
+	}
+	// ブラインドインデックスで組織内のメールアドレスの一意性を検証する
+	if err := command.Save(ctx, db, keyring, second); err == nil {
+		t.Error("Save() with duplicate email should fail")
+	}
+}
+
+func TestReencryptUsers(t *testing.T) {
+	db := dbtest.New(t)
+	old := dbtest.Keyring(t)
+	ctx := context.Background()
+
+	users := make([]*domain.User, 3)
+	for i := range users {
+		users[i], _ = domain.NewUser("John Doe", fmt.Sprintf("john%d@example.com", i))
+		if err := command.Save(ctx, db, old, users[i]); err != nil {
+			t.Fatalf("Save() unexpected error: %v", err)
+		}
+	}
+	token, _, _ := domain.NewUserToken(users[0], domain.UserTokenPurposePasswordReset, time.Hour)
+	if err := command.NewUserTokenRepository(old).SaveUserToken(ctx, db, token); err != nil {
+		t.Fatalf("SaveUserToken() unexpected error: %v", err)
+	}
+	inv, _, _ := domain.NewInvitation(users[1], "", time.Hour)
+	if err := command.NewInvitationRepository(old).SaveInvitation(ctx, db, inv); err != nil {
+		t.Fatalf("SaveInvitation() unexpected error: %v", err)
+	}
+	rotated, err := old.Rotate()
+	if err != nil {
+		t.Fatalf("Rotate() unexpected error: %v", err)
+	}
+
+	if n, err := command.ReencryptUsers(ctx, db, rotated, 2); err != nil || n != 2 {
+		t.Fatalf("ReencryptUsers() = %d, %v, want 2", n, err)
+	}
+	if n, err := command.ReencryptUsers(ctx, db, rotated, 2); err != nil || n != 2 {
+		t.Fatalf("ReencryptUsers() = %d, %v, want 2", n, err)
+	}
+	if n, err := command.ReencryptUsers(ctx, db, rotated, 2); err != nil || n != 1 {
+		t.Fatalf("ReencryptUsers() = %d, %v, want 1", n, err)
+	}
+	if n, err := command.ReencryptUsers(ctx, db, rotated, 2); err != nil || n != 0 {
+		t.Fatalf("ReencryptUsers() = %d, %v, want 0", n, err)
+	}
+
+	// 古い鍵を削除しても復号・検索できる
+	retired, err := rotated.Retire(old.PrimaryKeyID())
+	if err != nil {
+		t.Fatalf("Retire() unexpected error: %v", err)
+	}
+	for _, user := range users {
+		got, err := command.FindByEmailForUpdate(ctx, db, retired, user.Email)
+		if err != nil || got == nil || got.ID != user.ID {
+			t.Errorf("FindByEmailForUpdate(%s) = %v, %v, want %s", user.Email, got, err, user.ID)
+		}
+	}
+	gotToken, err := command.NewUserTokenRepository(retired).FindUserTokenForUpdate(ctx, db, token.Purpose, token.TokenHash)
+	if err != nil || gotToken == nil || gotToken.Email != users[0].Email {
+		t.Errorf("FindUserTokenForUpdate() = %v, %v, want email %s", gotToken, err, users[0].Email)
+	}
+	gotInv, err := command.NewInvitationRepository(retired).FindInvitationByIDForUpdate(ctx, db, inv.ID)
+	if err != nil || gotInv == nil || gotInv.Email != users[1].Email {
+		t.Errorf("FindInvitationByIDForUpdate() = %v, %v, want email %s", gotInv, err, users[1].Email)
+	}
+}
+
+func TestFindForUpdate_NotFound(t *testing.T) {
+	db := dbtest.New(t)
+	keyring := dbtest.Keyring(t)
+	ctx := context.Background()
+
+	byID, err := command.FindByIDForUpdate(ctx, db, keyring, "01ARZ3NDEKTSV4RRFFQ69G5FAV")
+	if err != nil || byID != nil {
+		t.Errorf("FindByIDForUpdate() = %v, %v, want nil, nil", byID, err)
+	}
+	byEmail, err := command.FindByEmailForUpdate(ctx, db, keyring, "nobody@example.com")
+	if err != nil || byEmail != nil {
+		t.Errorf("FindByEmailForUpdate() = %v, %v, want nil, nil", byEmail, err)
+	}
+}
+
+func TestDelete(t *testing.T) {
+	db := dbtest.New(t)
+	keyring := dbtest.Keyring(t)
+	ctx := context.Background()
+	tm := infrastructure.NewTransactionManager(db)
+
+	user, err := domain.NewUser("John Doe", "john@example.com")
+	if err != nil {
+		t.Fatalf("Failed to create user: %v", err)
+	}
+	if err := command.Save(ctx, db, keyring, user); err != nil {
+		t.Fatalf("Save() unexpected error: %v", err)
+	}
+
+	err = tm.RunInTransaction(ctx, func(ctx context.Context, tx infrastructure.DBTX) error {
+		return command.Delete(ctx, tx, user.ID)
+	})
+	if err != nil {
+		t.Fatalf("Delete() unexpected error: %v", err)
+	}
+
+	got, err := command.FindByEmailForUpdate(ctx, db, keyring, user.Email)
+	if err != nil || got != nil {
+		t.Errorf("FindByEmailForUpdate() after delete = %v, %v, want nil, nil", got, err)
+	}
+
+	if err := command.Delete(ctx, db, user.ID); err == nil {
+		t.Error("Delete() of missing user should fail")
+	}
+}
+
+func TestSaveUserLog(t *testing.T) {
+	db := dbtest.New(t)
+	ctx := context.Background()
+
+	log := domain.NewUserLog("01ARZ3NDEKTSV4RRFFQ69G5FAV", domain.UserLogActionCreated)
+	if err := command.SaveUserLog(ctx, db, log); err != nil {
+		t.Fatalf("SaveUserLog() unexpected error: %v", err)
+	}
+
+	logs, err := dao.New(db).GetUserLogsByUserID(ctx, dao.GetUserLogsByUserIDParams{
+		OrgID:  tenant.DefaultOrgID,
+		UserID: log.UserID,
+		Limit:  10,
+	})
+	if err != nil {
+		t.Fatalf("GetUserLogsByUserID() unexpected error: %v", err)
+	}
+	if len(logs) != 1 || logs[0].ID != log.ID || logs[0].Action != string(domain.UserLogActionCreated) {
+		t.Errorf("GetUserLogsByUserID() = %+v, want the saved log", logs)
+	}
+}
@@ -0,0 +1,102 @@
+package command
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure/dao"
+	"github.com/example/go-react-cqrs-template/internal/outbox"
+	"github.com/example/go-react-cqrs-template/internal/tenant"
+)
+
+// UserChangedChannel ユーザーの変更をコミット時に通知する PostgreSQL の LISTEN/NOTIFY チャネル（ペイロードはユーザーID）
+const UserChangedChannel = "user_changed"
+
+// UserEventPayload outbox に書き込むユーザーイベントのペイロード（API の UserEventData と同じ形）
+//
+// outbox・Webhook の配信・変更ストリームは平文で保存・送信されるため、名前とメールアドレスは含めない。
+// 受信者はユーザーのIDでユーザーを取得する。
+type UserEventPayload struct {
+	ID     string `json:"id"`
+	Status string `json:"status"`
+	// EmailVerifiedAt は未確認の場合は出力しない
+	EmailVerifiedAt *time.Time `json:"emailVerifiedAt,omitempty"`
+	CreatedAt       time.Time  `json:"createdAt"`
+	UpdatedAt       time.Time  `json:"updatedAt"`
+}
+
+// NewUserEventPayload イベント発生時点のユーザーからペイロードを作成
+func NewUserEventPayload(user domain.User) UserEventPayload {
+	payload := UserEventPayload{
+		ID:        user.ID,
+		Status:    string(user.Status),
+		CreatedAt: user.CreatedAt,
+		UpdatedAt: user.UpdatedAt,
+	}
+	if !user.EmailVerifiedAt.IsZero() {
+		verifiedAt := user.EmailVerifiedAt
+		payload.EmailVerifiedAt = &verifiedAt
+	}
+	return payload
+}
+
+// SaveUserEvents ユーザーのドメインイベントを outbox に保存（トランザクション内で使用）
+//
+// 状態変更と同じトランザクションで呼び出すことで、コミットされた変更のイベントのみが配信される。
+// イベントにはコンテキストの組織を記録し、変更ストリームと Webhook はその組織の購読者にのみ配信する。
+// 併せて outbox.NotifyChannel に NOTIFY を発行し、コミット時に各レプリカの変更ストリームを起こす。
+// 変更されたユーザーのIDは UserChangedChannel に通知し、各レプリカの読み取りキャッシュから削除させる。
+func SaveUserEvents(ctx context.Context, tx infrastructure.DBTX, events []domain.UserEvent) error {
+	queries := dao.New(tx)
+	for _, event := range events {
+		payload, err := json.Marshal(NewUserEventPayload(event.User))
+		if err != nil {
+			return fmt.Errorf("failed to marshal user event payload: %w", err)
+		}
+
+		err = queries.CreateOutboxEvent(ctx, dao.CreateOutboxEventParams{
+			ID:            event.ID,
+			OrgID:         tenant.OrgID(ctx),
+			AggregateType: domain.UserAggregateType,
+			AggregateID:   event.User.ID,
+			EventType:     string(event.Type),
+			Payload:       payload,
+			OccurredAt:    event.OccurredAt,
+			NextAttemptAt: event.OccurredAt,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to save user event: %w", err)
+		}
+	}
+
+	if len(events) == 0 {
+		return nil
+	}
+	err := queries.NotifyOutbox(ctx, dao.NotifyOutboxParams{
+		Channel: outbox.NotifyChannel,
+		Payload: domain.UserAggregateType,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to notify user events: %w", err)
+	}
+
+	notified := make(map[string]bool, len(events))
+	for _, event := range events {
+		if notified[event.User.ID] {
+			continue
+		}
+		notified[event.User.ID] = true
+		err := queries.NotifyUserChanged(ctx, dao.NotifyUserChangedParams{
+			Channel: UserChangedChannel,
+			UserID:  event.User.ID,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to notify user change: %w", err)
+		}
+	}
+	return nil
+}
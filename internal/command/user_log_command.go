@@ -2,11 +2,13 @@ package command
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 
 	"github.com/example/go-react-cqrs-template/internal/domain"
 	"github.com/example/go-react-cqrs-template/internal/infrastructure"
 	"github.com/example/go-react-cqrs-template/internal/infrastructure/dao"
+	"github.com/example/go-react-cqrs-template/internal/tenant"
 )
 
 // SaveUserLog ユーザーログを保存（トランザクション内で使用）
@@ -14,8 +16,10 @@ func SaveUserLog(ctx context.Context, tx infrastructure.DBTX, log *domain.UserLo
 	queries := dao.New(tx)
 	err := queries.CreateUserLog(ctx, dao.CreateUserLogParams{
 		ID:        log.ID,
+		OrgID:     tenant.OrgID(ctx),
 		UserID:    log.UserID,
 		Action:    string(log.Action),
+		Reason:    sql.NullString{String: log.Reason, Valid: log.Reason != ""},
 		CreatedAt: log.CreatedAt,
 	})
 	if err != nil {
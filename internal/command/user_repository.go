@@ -0,0 +1,54 @@
+package command
+
+import (
+	"context"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure"
+	"github.com/example/go-react-cqrs-template/internal/pii"
+)
+
+// UserRepository コマンド側のユーザーリポジトリ（usecase.UserCommandRepository と usecase.UserReencryptionRepository の実装）
+type UserRepository struct {
+	keyring *pii.Keyring
+}
+
+// NewUserRepository UserRepositoryのコンストラクタ（keyring で名前とメールアドレスを暗号化・復号する）
+func NewUserRepository(keyring *pii.Keyring) *UserRepository {
+	return &UserRepository{keyring: keyring}
+}
+
+// Save ユーザーを保存
+func (r *UserRepository) Save(ctx context.Context, tx infrastructure.DBTX, user *domain.User) error {
+	return Save(ctx, tx, r.keyring, user)
+}
+
+// Delete ユーザーを削除
+func (r *UserRepository) Delete(ctx context.Context, tx infrastructure.DBTX, id string) error {
+	return Delete(ctx, tx, id)
+}
+
+// FindByIDForUpdate IDでユーザーを検索しロックを取得
+func (r *UserRepository) FindByIDForUpdate(ctx context.Context, tx infrastructure.DBTX, id string) (*domain.User, error) {
+	return FindByIDForUpdate(ctx, tx, r.keyring, id)
+}
+
+// FindByEmailForUpdate メールアドレスでユーザーを検索しロックを取得
+func (r *UserRepository) FindByEmailForUpdate(ctx context.Context, tx infrastructure.DBTX, email string) (*domain.User, error) {
+	return FindByEmailForUpdate(ctx, tx, r.keyring, email)
+}
+
+// ReencryptUsers 主鍵以外の鍵で暗号化したユーザー・トークン・招待のデータキーを主鍵で暗号化し直す
+func (r *UserRepository) ReencryptUsers(ctx context.Context, tx infrastructure.DBTX, limit int) (int, error) {
+	return ReencryptUsers(ctx, tx, r.keyring, limit)
+}
+
+// SaveUserLog ユーザーログを保存
+func (r *UserRepository) SaveUserLog(ctx context.Context, tx infrastructure.DBTX, log *domain.UserLog) error {
+	return SaveUserLog(ctx, tx, log)
+}
+
+// SaveEvents ユーザーのドメインイベントを outbox に保存
+func (r *UserRepository) SaveEvents(ctx context.Context, tx infrastructure.DBTX, events []domain.UserEvent) error {
+	return SaveUserEvents(ctx, tx, events)
+}
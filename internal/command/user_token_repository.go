@@ -0,0 +1,101 @@
+package command
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure/dao"
+	"github.com/example/go-react-cqrs-template/internal/pii"
+	"github.com/example/go-react-cqrs-template/internal/tenant"
+)
+
+// UserTokenRepository メールで送るトークンのリポジトリ（usecase.UserTokenCommandRepository の実装）
+type UserTokenRepository struct {
+	keyring *pii.Keyring
+}
+
+// NewUserTokenRepository UserTokenRepositoryのコンストラクタ（keyring で送信先のメールアドレスを暗号化・復号する）
+func NewUserTokenRepository(keyring *pii.Keyring) *UserTokenRepository {
+	return &UserTokenRepository{keyring: keyring}
+}
+
+// FindUserTokenForUpdate 用途とハッシュでトークンを検索しロックを取得
+func (r *UserTokenRepository) FindUserTokenForUpdate(ctx context.Context, tx infrastructure.DBTX, purpose domain.UserTokenPurpose, tokenHash string) (*domain.UserToken, error) {
+	token, err := dao.New(tx).GetUserTokenByHashForUpdate(ctx, dao.GetUserTokenByHashForUpdateParams{
+		OrgID:     tenant.OrgID(ctx),
+		Purpose:   string(purpose),
+		TokenHash: tokenHash,
+	})
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find user token for update: %w", err)
+	}
+	return toDomainUserToken(r.keyring, token)
+}
+
+// SaveUserToken トークンを保存
+func (r *UserTokenRepository) SaveUserToken(ctx context.Context, tx infrastructure.DBTX, token *domain.UserToken) error {
+	sealed, err := r.keyring.Seal(token.ID, token.Email)
+	if err != nil {
+		return fmt.Errorf("failed to encrypt user token: %w", err)
+	}
+	err = dao.New(tx).UpsertUserToken(ctx, dao.UpsertUserTokenParams{
+		ID:              token.ID,
+		OrgID:           tenant.OrgID(ctx),
+		UserID:          token.UserID,
+		Purpose:         string(token.Purpose),
+		TokenHash:       token.TokenHash,
+		EmailCiphertext: sealed.Values[0],
+		PiiKeyID:        sealed.KeyID,
+		PiiDataKey:      sealed.DataKey,
+		ExpiresAt:       token.ExpiresAt,
+		UsedAt:          sql.NullTime{Time: token.UsedAt, Valid: !token.UsedAt.IsZero()},
+		CreatedAt:       token.CreatedAt,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to save user token: %w", err)
+	}
+	return nil
+}
+
+// RevokeUserTokens ユーザーの未使用のトークンを使用済みにする
+func (r *UserTokenRepository) RevokeUserTokens(ctx context.Context, tx infrastructure.DBTX, userID string, purpose domain.UserTokenPurpose, now time.Time) error {
+	err := dao.New(tx).RevokeUserTokens(ctx, dao.RevokeUserTokensParams{
+		OrgID:   tenant.OrgID(ctx),
+		UserID:  userID,
+		Purpose: string(purpose),
+		UsedAt:  sql.NullTime{Time: now, Valid: true},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to revoke user tokens: %w", err)
+	}
+	return nil
+}
+
+// toDomainUserToken dao.UserTokenを復号してdomain.UserTokenに変換
+func toDomainUserToken(keyring *pii.Keyring, t dao.UserToken) (*domain.UserToken, error) {
+	values, err := keyring.Open(t.ID, &pii.Sealed{
+		KeyID:   t.PiiKeyID,
+		DataKey: t.PiiDataKey,
+		Values:  [][]byte{t.EmailCiphertext},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt user token %s: %w", t.ID, err)
+	}
+	return &domain.UserToken{
+		ID:        t.ID,
+		UserID:    t.UserID,
+		Purpose:   domain.UserTokenPurpose(t.Purpose),
+		TokenHash: t.TokenHash,
+		Email:     values[0],
+		ExpiresAt: t.ExpiresAt,
+		UsedAt:    t.UsedAt.Time,
+		CreatedAt: t.CreatedAt,
+	}, nil
+}
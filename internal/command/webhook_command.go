@@ -0,0 +1,87 @@
+package command
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure/dao"
+	"github.com/example/go-react-cqrs-template/internal/tenant"
+)
+
+// SaveWebhookSubscription Webhook購読を保存（トランザクション内で使用）
+func SaveWebhookSubscription(ctx context.Context, tx infrastructure.DBTX, sub *domain.WebhookSubscription) error {
+	queries := dao.New(tx)
+	err := queries.UpsertWebhookSubscription(ctx, dao.UpsertWebhookSubscriptionParams{
+		ID:         sub.ID,
+		OrgID:      tenant.OrgID(ctx),
+		Url:        sub.URL,
+		Secret:     sub.Secret,
+		EventTypes: fromDomainEventTypes(sub.EventTypes),
+		Active:     sub.Active,
+		CreatedAt:  sub.CreatedAt,
+		UpdatedAt:  sub.UpdatedAt,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to save webhook subscription: %w", err)
+	}
+	return nil
+}
+
+// DeleteWebhookSubscription Webhook購読を削除（トランザクション内で使用）
+//
+// 配信ログは外部キーの ON DELETE CASCADE により合わせて削除される。
+func DeleteWebhookSubscription(ctx context.Context, tx infrastructure.DBTX, id string) error {
+	queries := dao.New(tx)
+	if err := queries.DeleteWebhookSubscription(ctx, dao.DeleteWebhookSubscriptionParams{
+		OrgID: tenant.OrgID(ctx),
+		ID:    id,
+	}); err != nil {
+		return fmt.Errorf("failed to delete webhook subscription: %w", err)
+	}
+	return nil
+}
+
+// FindWebhookSubscriptionByIDForUpdate IDでWebhook購読を検索しロックを取得（トランザクション内で使用）
+func FindWebhookSubscriptionByIDForUpdate(ctx context.Context, tx infrastructure.DBTX, id string) (*domain.WebhookSubscription, error) {
+	queries := dao.New(tx)
+	sub, err := queries.GetWebhookSubscriptionByIDForUpdate(ctx, dao.GetWebhookSubscriptionByIDForUpdateParams{
+		OrgID: tenant.OrgID(ctx),
+		ID:    id,
+	})
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find webhook subscription for update: %w", err)
+	}
+	return toDomainWebhookSubscription(sub), nil
+}
+
+// toDomainWebhookSubscription dao.WebhookSubscriptionをdomain.WebhookSubscriptionに変換
+func toDomainWebhookSubscription(s dao.WebhookSubscription) *domain.WebhookSubscription {
+	eventTypes := make([]domain.UserEventType, len(s.EventTypes))
+	for i, t := range s.EventTypes {
+		eventTypes[i] = domain.UserEventType(t)
+	}
+	return &domain.WebhookSubscription{
+		ID:         s.ID,
+		URL:        s.Url,
+		Secret:     s.Secret,
+		EventTypes: eventTypes,
+		Active:     s.Active,
+		CreatedAt:  s.CreatedAt,
+		UpdatedAt:  s.UpdatedAt,
+	}
+}
+
+// fromDomainEventTypes イベント種別を TEXT[] の値に変換
+func fromDomainEventTypes(eventTypes []domain.UserEventType) []string {
+	result := make([]string, len(eventTypes))
+	for i, t := range eventTypes {
+		result[i] = string(t)
+	}
+	return result
+}
@@ -0,0 +1,31 @@
+package command
+
+import (
+	"context"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure"
+)
+
+// WebhookRepository コマンド側のWebhook購読リポジトリ（usecase.WebhookCommandRepository の実装）
+type WebhookRepository struct{}
+
+// NewWebhookRepository WebhookRepositoryのコンストラクタ
+func NewWebhookRepository() *WebhookRepository {
+	return &WebhookRepository{}
+}
+
+// SaveSubscription Webhook購読を保存
+func (r *WebhookRepository) SaveSubscription(ctx context.Context, tx infrastructure.DBTX, sub *domain.WebhookSubscription) error {
+	return SaveWebhookSubscription(ctx, tx, sub)
+}
+
+// DeleteSubscription Webhook購読を削除
+func (r *WebhookRepository) DeleteSubscription(ctx context.Context, tx infrastructure.DBTX, id string) error {
+	return DeleteWebhookSubscription(ctx, tx, id)
+}
+
+// FindSubscriptionByIDForUpdate IDでWebhook購読を検索しロックを取得
+func (r *WebhookRepository) FindSubscriptionByIDForUpdate(ctx context.Context, tx infrastructure.DBTX, id string) (*domain.WebhookSubscription, error) {
+	return FindWebhookSubscriptionByIDForUpdate(ctx, tx, id)
+}
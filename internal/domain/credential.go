@@ -0,0 +1,106 @@
+package domain
+
+import (
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	// PasswordMinLength パスワードの最小長（文字数）
+	PasswordMinLength = 12
+	// PasswordMaxLength パスワードの最大長（文字数、ハッシュ計算の負荷を抑えるため上限を設ける）
+	PasswordMaxLength = 128
+)
+
+// ValidatePassword パスワードポリシーを検証する
+//
+// 文字種の組み合わせは求めず、長さとメールアドレスと同一でないことのみを確認する。
+func ValidatePassword(password, email string) error {
+	n := utf8.RuneCountInString(password)
+	if n < PasswordMinLength || n > PasswordMaxLength {
+		return ErrPasswordLength()
+	}
+	if strings.TrimSpace(password) == "" {
+		return ErrPasswordBlank()
+	}
+	if email != "" && strings.EqualFold(password, email) {
+		return ErrPasswordSameAsEmail()
+	}
+	return nil
+}
+
+// LockoutPolicy ログインの失敗によるアカウントロックの方針
+type LockoutPolicy struct {
+	// MaxAttempts はロックするまでに許容する連続した失敗の回数
+	MaxAttempts int
+	// Duration はロックする期間
+	Duration time.Duration
+}
+
+// DefaultLockoutPolicy 既定のアカウントロックの方針（5回連続で失敗すると15分間ロックする）
+var DefaultLockoutPolicy = LockoutPolicy{MaxAttempts: 5, Duration: 15 * time.Minute}
+
+// Credential ユーザーのパスワード認証情報
+//
+// パスワードはハッシュ（PHC 文字列形式）のみを保持する。
+type Credential struct {
+	UserID       string
+	PasswordHash string
+	// FailedAttempts は最後に成功してからの連続した失敗の回数
+	FailedAttempts int
+	// LockedUntil はロックが解除される時刻（ロックされていない場合はゼロ値）
+	LockedUntil       time.Time
+	PasswordChangedAt time.Time
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+}
+
+// NewCredential パスワード認証情報を作成
+func NewCredential(userID, passwordHash string) *Credential {
+	now := time.Now()
+	return &Credential{
+		UserID:            userID,
+		PasswordHash:      passwordHash,
+		PasswordChangedAt: now,
+		CreatedAt:         now,
+		UpdatedAt:         now,
+	}
+}
+
+// ChangePassword パスワードのハッシュを置き換え、失敗回数とロックを解除する
+func (c *Credential) ChangePassword(passwordHash string) {
+	now := time.Now()
+	c.PasswordHash = passwordHash
+	c.FailedAttempts = 0
+	c.LockedUntil = time.Time{}
+	c.PasswordChangedAt = now
+	c.UpdatedAt = now
+}
+
+// IsLocked 時刻 now にロックされているかどうか
+func (c *Credential) IsLocked(now time.Time) bool {
+	return now.Before(c.LockedUntil)
+}
+
+// RecordFailure パスワードの照合の失敗を記録する
+//
+// 連続した失敗が policy.MaxAttempts に達した場合は policy.Duration の間ロックし、true を返す。
+// ロックが解除された後は再び MaxAttempts 回まで失敗できる。
+func (c *Credential) RecordFailure(now time.Time, policy LockoutPolicy) (locked bool) {
+	c.FailedAttempts++
+	c.UpdatedAt = now
+	if policy.MaxAttempts <= 0 || c.FailedAttempts < policy.MaxAttempts {
+		return false
+	}
+	c.FailedAttempts = 0
+	c.LockedUntil = now.Add(policy.Duration)
+	return true
+}
+
+// RecordSuccess パスワードの照合の成功を記録し、失敗回数をリセットする
+func (c *Credential) RecordSuccess(now time.Time) {
+	c.FailedAttempts = 0
+	c.LockedUntil = time.Time{}
+	c.UpdatedAt = now
+}
@@ -0,0 +1,84 @@
+package domain
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestValidatePassword(t *testing.T) {
+	tests := []struct {
+		name     string
+		password string
+		email    string
+		wantErr  bool
+	}{
+		{name: "valid", password: "correct horse battery", email: "john@example.com"},
+		{name: "multibyte characters count as one", password: strings.Repeat("パ", PasswordMinLength), email: "john@example.com"},
+		{name: "too short", password: strings.Repeat("a", PasswordMinLength-1), email: "john@example.com", wantErr: true},
+		{name: "too long", password: strings.Repeat("a", PasswordMaxLength+1), email: "john@example.com", wantErr: true},
+		{name: "whitespace only", password: strings.Repeat(" ", PasswordMinLength), email: "john@example.com", wantErr: true},
+		{name: "same as email", password: "John.Doe@Example.com", email: "john.doe@example.com", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidatePassword(tt.password, tt.email)
+			if !tt.wantErr {
+				if err != nil {
+					t.Errorf("ValidatePassword() unexpected error: %v", err)
+				}
+				return
+			}
+			var validationErr *ValidationError
+			if !errors.As(err, &validationErr) || validationErr.Field != "newPassword" {
+				t.Errorf("ValidatePassword() error = %v, want validation error on newPassword", err)
+			}
+		})
+	}
+}
+
+func TestCredential_Lockout(t *testing.T) {
+	policy := LockoutPolicy{MaxAttempts: 3, Duration: 15 * time.Minute}
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	cred := NewCredential("01ARZ3NDEKTSV4RRFFQ69G5FAV", "$argon2id$...")
+
+	for i := 1; i < policy.MaxAttempts; i++ {
+		if cred.RecordFailure(now, policy) {
+			t.Fatalf("RecordFailure() #%d locked, want not locked", i)
+		}
+	}
+	if !cred.RecordFailure(now, policy) {
+		t.Fatalf("RecordFailure() #%d did not lock", policy.MaxAttempts)
+	}
+	if !cred.IsLocked(now.Add(policy.Duration - time.Second)) {
+		t.Error("IsLocked() = false before the lockout expires, want true")
+	}
+	if cred.IsLocked(now.Add(policy.Duration)) {
+		t.Error("IsLocked() = true after the lockout expires, want false")
+	}
+
+	// ロックの解除後は再び MaxAttempts 回まで失敗できる
+	later := now.Add(policy.Duration)
+	if cred.RecordFailure(later, policy) {
+		t.Error("RecordFailure() after the lockout locked again on the first failure")
+	}
+
+	cred.RecordSuccess(later)
+	if cred.FailedAttempts != 0 || !cred.LockedUntil.IsZero() {
+		t.Errorf("after RecordSuccess() = %+v, want reset", cred)
+	}
+}
+
+func TestCredential_ChangePasswordUnlocks(t *testing.T) {
+	policy := LockoutPolicy{MaxAttempts: 1, Duration: time.Hour}
+	cred := NewCredential("01ARZ3NDEKTSV4RRFFQ69G5FAV", "old")
+	cred.RecordFailure(time.Now(), policy)
+
+	cred.ChangePassword("new")
+
+	if cred.PasswordHash != "new" || cred.IsLocked(time.Now()) || cred.FailedAttempts != 0 {
+		t.Errorf("after ChangePassword() = %+v, want new hash and unlocked", cred)
+	}
+}
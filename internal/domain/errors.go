@@ -1,6 +1,9 @@
 package domain
 
-import "fmt"
+import (
+	"errors"
+	"fmt"
+)
 
 // ErrorCode はドメインエラーの種類を識別するコード
 type ErrorCode string
@@ -12,6 +15,8 @@ const (
 	ErrCodeNotFound ErrorCode = "NOT_FOUND"
 	// ErrCodeConflict はリソースの競合エラー
 	ErrCodeConflict ErrorCode = "CONFLICT"
+	// ErrCodeUnauthenticated は認証に失敗したエラー
+	ErrCodeUnauthenticated ErrorCode = "UNAUTHENTICATED"
 )
 
 // DomainError はドメイン層のエラーを表す基本構造体
@@ -94,6 +99,49 @@ func NewConflictError(resource, message, userMessage string) *ConflictError {
 	}
 }
 
+// --- 認証エラー ---
+
+// AuthenticationError は認証に失敗したエラーを表す
+type AuthenticationError struct {
+	DomainError
+}
+
+// NewAuthenticationError は認証エラーを作成
+func NewAuthenticationError(message, userMessage string) *AuthenticationError {
+	return &AuthenticationError{
+		DomainError: DomainError{
+			Code:        ErrCodeUnauthenticated,
+			Message:     message,
+			UserMessage: userMessage,
+		},
+	}
+}
+
+// AsDomainError はエラーに含まれる DomainError を取り出す（各種のドメインエラーは DomainError を埋め込んでいる）
+func AsDomainError(err error) *DomainError {
+	var validationErr *ValidationError
+	if errors.As(err, &validationErr) {
+		return &validationErr.DomainError
+	}
+	var notFoundErr *NotFoundError
+	if errors.As(err, &notFoundErr) {
+		return &notFoundErr.DomainError
+	}
+	var conflictErr *ConflictError
+	if errors.As(err, &conflictErr) {
+		return &conflictErr.DomainError
+	}
+	var authErr *AuthenticationError
+	if errors.As(err, &authErr) {
+		return &authErr.DomainError
+	}
+	var domainErr *DomainError
+	if errors.As(err, &domainErr) {
+		return domainErr
+	}
+	return nil
+}
+
 // --- User 関連のエラー（よく使うものを定義） ---
 
 // ErrUserNotFound はユーザーが見つからないエラー
@@ -131,3 +179,444 @@ func ErrEmailRequired() *ValidationError {
 		"メールアドレスは必須です",
 	)
 }
+
+// ErrUserStatusInvalid は未定義のステータスのエラー
+func ErrUserStatusInvalid(status string) *ValidationError {
+	return NewValidationError(
+		"status",
+		fmt.Sprintf("unknown user status: %s", status),
+		"ステータスは invited、active、suspended、deactivated のいずれかで指定してください",
+	)
+}
+
+// ErrUserStatusTransition は許可されていないステータス遷移のエラー
+func ErrUserStatusTransition(from, to UserStatus) *ValidationError {
+	return NewValidationError(
+		"status",
+		fmt.Sprintf("cannot change user status from %s to %s", from, to),
+		fmt.Sprintf("ステータスを %s から %s に変更することはできません", from, to),
+	)
+}
+
+// ErrUserStatusReasonRequired はステータス変更理由が必須エラー
+func ErrUserStatusReasonRequired() *ValidationError {
+	return NewValidationError(
+		"reason",
+		"reason is required",
+		"理由は必須です",
+	)
+}
+
+// ErrUserStatusReasonTooLong はステータス変更理由が長すぎるエラー
+func ErrUserStatusReasonTooLong() *ValidationError {
+	return NewValidationError(
+		"reason",
+		fmt.Sprintf("reason must be at most %d characters", userStatusReasonMaxLength),
+		fmt.Sprintf("理由は%d文字以下で指定してください", userStatusReasonMaxLength),
+	)
+}
+
+// --- Credential 関連のエラー ---
+
+// ErrInvalidCredentials はメールアドレスまたはパスワードが一致しないエラー
+//
+// ユーザーの有無を推測されないよう、どちらが一致しないかは区別しない。
+func ErrInvalidCredentials() *AuthenticationError {
+	return NewAuthenticationError(
+		"invalid email or password",
+		"メールアドレスまたはパスワードが正しくありません",
+	)
+}
+
+// ErrAccountLocked はログインの失敗が続いたためアカウントがロックされているエラー
+func ErrAccountLocked(userID string) *AuthenticationError {
+	return NewAuthenticationError(
+		fmt.Sprintf("account is locked: %s", userID),
+		"ログインの失敗が続いたため、アカウントを一時的にロックしています。しばらくしてから再度お試しください",
+	)
+}
+
+// ErrUserNotActive は利用中でないユーザーがログインしようとしたエラー
+func ErrUserNotActive(userID string, status UserStatus) *AuthenticationError {
+	return NewAuthenticationError(
+		fmt.Sprintf("user is not active: %s (status: %s)", userID, status),
+		"このアカウントは現在利用できません",
+	)
+}
+
+// ErrPasswordLength はパスワードの長さが不正なエラー
+func ErrPasswordLength() *ValidationError {
+	return NewValidationError(
+		"newPassword",
+		fmt.Sprintf("password must be %d to %d characters", PasswordMinLength, PasswordMaxLength),
+		fmt.Sprintf("パスワードは%d文字以上%d文字以下で指定してください", PasswordMinLength, PasswordMaxLength),
+	)
+}
+
+// ErrPasswordBlank はパスワードが空白のみのエラー
+func ErrPasswordBlank() *ValidationError {
+	return NewValidationError(
+		"newPassword",
+		"password must not consist only of whitespace",
+		"パスワードを空白のみにすることはできません",
+	)
+}
+
+// ErrPasswordSameAsEmail はパスワードがメールアドレスと同じエラー
+func ErrPasswordSameAsEmail() *ValidationError {
+	return NewValidationError(
+		"newPassword",
+		"password must not be the same as the email",
+		"パスワードをメールアドレスと同じにすることはできません",
+	)
+}
+
+// ErrCurrentPasswordRequired はパスワードの変更に現在のパスワードが必須エラー
+func ErrCurrentPasswordRequired() *ValidationError {
+	return NewValidationError(
+		"currentPassword",
+		"current password is required to change the password",
+		"現在のパスワードは必須です",
+	)
+}
+
+// --- UserToken 関連のエラー ---
+
+// ErrUserTokenInvalid はメールアドレスの確認・パスワードのリセットのトークンが無効なエラー
+//
+// 存在しない・使用済み・期限切れのいずれかは区別しない。
+func ErrUserTokenInvalid() *ValidationError {
+	return NewValidationError(
+		"token",
+		"token is invalid or expired",
+		"リンクが無効か、有効期限が切れています。もう一度やり直してください",
+	)
+}
+
+// ErrEmailAlreadyVerified はメールアドレスが既に確認済みのエラー
+func ErrEmailAlreadyVerified(userID string) *ConflictError {
+	return NewConflictError(
+		"user",
+		fmt.Sprintf("email is already verified: %s", userID),
+		"このメールアドレスは既に確認済みです",
+	)
+}
+
+// --- Invitation 関連のエラー ---
+
+// ErrInvitationNotFound は招待が見つからないエラー
+func ErrInvitationNotFound(invitationID string) *NotFoundError {
+	return NewNotFoundError(
+		"invitation",
+		fmt.Sprintf("invitation not found: %s", invitationID),
+		"指定された招待が見つかりません",
+	)
+}
+
+// ErrInvitationExpired は招待の有効期限が切れているエラー
+func ErrInvitationExpired() *ValidationError {
+	return NewValidationError(
+		"token",
+		"invitation has expired",
+		"招待の有効期限が切れています。招待の再送を依頼してください",
+	)
+}
+
+// ErrInvitationNotPending は承諾済み・取り消し済みの招待を操作しようとしたエラー
+func ErrInvitationNotPending(invitationID string, status InvitationStatus) *ConflictError {
+	return NewConflictError(
+		"invitation",
+		fmt.Sprintf("invitation %s is %s", invitationID, status),
+		"承諾済みまたは取り消し済みの招待は変更できません",
+	)
+}
+
+// --- Session 関連のエラー ---
+
+// ErrSessionNotFound はセッションが見つからないエラー
+func ErrSessionNotFound(sessionID string) *NotFoundError {
+	return NewNotFoundError(
+		"session",
+		fmt.Sprintf("session not found: %s", sessionID),
+		"指定されたセッションが見つかりません",
+	)
+}
+
+// ErrSessionRevoked はログアウト・取り消し済みのセッションで認証しようとしたエラー
+func ErrSessionRevoked(sessionID string) *AuthenticationError {
+	return NewAuthenticationError(
+		fmt.Sprintf("session is revoked: %s", sessionID),
+		"セッションは無効になりました。再度ログインしてください",
+	)
+}
+
+// ErrSessionExpired は無操作のまま一定時間が経過した、またはログインから一定時間が経過したセッションのエラー
+func ErrSessionExpired(sessionID string) *AuthenticationError {
+	return NewAuthenticationError(
+		fmt.Sprintf("session has expired: %s", sessionID),
+		"セッションの有効期限が切れています。再度ログインしてください",
+	)
+}
+
+// --- MFA 関連のエラー ---
+
+// ErrMFANotEnrolled は TOTP の二要素認証が登録・有効化されていないエラー
+func ErrMFANotEnrolled(userID string) *NotFoundError {
+	return NewNotFoundError(
+		"mfa",
+		fmt.Sprintf("totp is not enrolled: %s", userID),
+		"二要素認証は登録されていません",
+	)
+}
+
+// ErrMFAAlreadyEnabled は TOTP の二要素認証が既に有効なエラー
+func ErrMFAAlreadyEnabled(userID string) *ConflictError {
+	return NewConflictError(
+		"mfa",
+		fmt.Sprintf("totp is already enabled: %s", userID),
+		"二要素認証は既に有効です",
+	)
+}
+
+// ErrMFARequired は二要素認証が必須のユーザーが無効化しようとしたエラー
+func ErrMFARequired(userID string) *ConflictError {
+	return NewConflictError(
+		"mfa",
+		fmt.Sprintf("mfa is required by policy: %s", userID),
+		"管理者のアカウントでは二要素認証を無効にできません",
+	)
+}
+
+// ErrMFAEnrollmentRequired は二要素認証が必須のユーザーが登録せずにログインしようとしたエラー
+func ErrMFAEnrollmentRequired(userID string) *AuthenticationError {
+	return NewAuthenticationError(
+		fmt.Sprintf("mfa enrollment is required by policy: %s", userID),
+		"管理者のアカウントでは二要素認証の登録が必要です。登録してから再度ログインしてください",
+	)
+}
+
+// ErrInvalidMFACode は確認コードまたはリカバリーコードが一致しないエラー
+func ErrInvalidMFACode() *AuthenticationError {
+	return NewAuthenticationError(
+		"invalid mfa code",
+		"確認コードが正しくありません",
+	)
+}
+
+// ErrMFAChallengeInvalid はログインの2段階目のトークンが無効なエラー
+//
+// 存在しない・使用済み・期限切れのいずれかは区別しない。
+func ErrMFAChallengeInvalid() *AuthenticationError {
+	return NewAuthenticationError(
+		"mfa challenge is invalid or expired",
+		"ログインの有効期限が切れました。もう一度ログインしてください",
+	)
+}
+
+// --- OIDC 関連のエラー ---
+
+// ErrOIDCProviderNotFound は設定されていない IdP でログインしようとしたエラー
+func ErrOIDCProviderNotFound(provider string) *NotFoundError {
+	return NewNotFoundError(
+		"oidc_provider",
+		fmt.Sprintf("oidc provider not found: %s", provider),
+		"指定されたIDプロバイダーは利用できません",
+	)
+}
+
+// ErrOIDCStateInvalid は IdP からのコールバックの state が無効なエラー
+//
+// 存在しない・使用済み・期限切れのいずれかは区別しない。
+func ErrOIDCStateInvalid() *ValidationError {
+	return NewValidationError(
+		"state",
+		"oidc state is invalid or expired",
+		"ログインの有効期限が切れました。もう一度ログインしてください",
+	)
+}
+
+// ErrOIDCLoginFailed は認可コードの交換または ID トークンの検証に失敗したエラー
+func ErrOIDCLoginFailed(provider string, err error) *AuthenticationError {
+	return NewAuthenticationError(
+		fmt.Sprintf("oidc login with %s failed: %v", provider, err),
+		"IDプロバイダーでの認証に失敗しました",
+	)
+}
+
+// ErrExternalIdentityNotLinked は IdP のユーザーに紐付くユーザーがいないエラー
+//
+// 紐付けがなく、IdP で確認済みのメールアドレスのユーザーもいない（自動作成もしない）場合に返す。
+func ErrExternalIdentityNotLinked(provider, subject string) *AuthenticationError {
+	return NewAuthenticationError(
+		fmt.Sprintf("external identity is not linked to any user: %s/%s", provider, subject),
+		"このIDプロバイダーのアカウントに紐付くユーザーがいません",
+	)
+}
+
+// --- Organization 関連のエラー ---
+
+// ErrOrganizationNotFound は組織が見つからないエラー
+func ErrOrganizationNotFound(orgID string) *NotFoundError {
+	return NewNotFoundError(
+		"organization",
+		fmt.Sprintf("organization not found: %s", orgID),
+		"指定された組織が見つかりません",
+	)
+}
+
+// ErrOrganizationNameRequired は組織名が必須エラー
+func ErrOrganizationNameRequired() *ValidationError {
+	return NewValidationError(
+		"name",
+		"organization name is required",
+		"組織名は必須です",
+	)
+}
+
+// ErrOrganizationNameTooLong は組織名が長すぎるエラー
+func ErrOrganizationNameTooLong() *ValidationError {
+	return NewValidationError(
+		"name",
+		fmt.Sprintf("organization name must be at most %d characters", organizationNameMaxLength),
+		fmt.Sprintf("組織名は%d文字以下で指定してください", organizationNameMaxLength),
+	)
+}
+
+// --- Group 関連のエラー ---
+
+// ErrGroupNotFound はグループが見つからないエラー
+func ErrGroupNotFound(groupID string) *NotFoundError {
+	return NewNotFoundError(
+		"group",
+		fmt.Sprintf("group not found: %s", groupID),
+		"指定されたグループが見つかりません",
+	)
+}
+
+// ErrGroupNameAlreadyExists はグループ名が既に存在するエラー
+func ErrGroupNameAlreadyExists(name string) *ConflictError {
+	return NewConflictError(
+		"group",
+		fmt.Sprintf("group name already exists: %s", name),
+		"このグループ名は既に使用されています",
+	)
+}
+
+// ErrGroupNameRequired はグループ名が必須エラー
+func ErrGroupNameRequired() *ValidationError {
+	return NewValidationError(
+		"name",
+		"group name is required",
+		"グループ名は必須です",
+	)
+}
+
+// ErrGroupNameTooLong はグループ名が長すぎるエラー
+func ErrGroupNameTooLong() *ValidationError {
+	return NewValidationError(
+		"name",
+		fmt.Sprintf("group name must be at most %d characters", groupNameMaxLength),
+		fmt.Sprintf("グループ名は%d文字以下で指定してください", groupNameMaxLength),
+	)
+}
+
+// ErrGroupRoleInvalid は未定義のロールのエラー
+func ErrGroupRoleInvalid(role string) *ValidationError {
+	return NewValidationError(
+		"role",
+		fmt.Sprintf("unknown group role: %s", role),
+		"ロールは owner または member で指定してください",
+	)
+}
+
+// ErrGroupMembersRequired はメンバーが未指定のエラー
+func ErrGroupMembersRequired() *ValidationError {
+	return NewValidationError(
+		"members",
+		"at least one member is required",
+		"メンバーを1人以上指定してください",
+	)
+}
+
+// ErrGroupMembersTooMany は一度に指定したメンバーが多すぎるエラー
+func ErrGroupMembersTooMany() *ValidationError {
+	return NewValidationError(
+		"members",
+		fmt.Sprintf("at most %d members can be specified at once", GroupMembersMaxBatch),
+		fmt.Sprintf("メンバーは一度に%d人まで指定できます", GroupMembersMaxBatch),
+	)
+}
+
+// ErrGroupMemberDuplicated は同じユーザーが重複して指定されたエラー
+func ErrGroupMemberDuplicated(userID string) *ValidationError {
+	return NewValidationError(
+		"members",
+		fmt.Sprintf("user is specified more than once: %s", userID),
+		"同じユーザーが重複して指定されています",
+	)
+}
+
+// --- Webhook 関連のエラー ---
+
+// ErrWebhookSubscriptionNotFound はWebhook購読が見つからないエラー
+func ErrWebhookSubscriptionNotFound(subscriptionID string) *NotFoundError {
+	return NewNotFoundError(
+		"webhook_subscription",
+		fmt.Sprintf("webhook subscription not found: %s", subscriptionID),
+		"指定されたWebhook購読が見つかりません",
+	)
+}
+
+// ErrWebhookURLRequired はコールバックURLが必須エラー
+func ErrWebhookURLRequired() *ValidationError {
+	return NewValidationError(
+		"url",
+		"webhook url is required",
+		"URLは必須です",
+	)
+}
+
+// ErrWebhookURLInvalid はコールバックURLが不正なエラー
+func ErrWebhookURLInvalid(url string) *ValidationError {
+	return NewValidationError(
+		"url",
+		fmt.Sprintf("webhook url must be an absolute http(s) url: %s", url),
+		"URLは http または https の絶対URLで指定してください",
+	)
+}
+
+// ErrWebhookURLNotAllowed はコールバックURLが内部のアドレスを指すエラー
+func ErrWebhookURLNotAllowed(url string) *ValidationError {
+	return NewValidationError(
+		"url",
+		fmt.Sprintf("webhook url must not point to a loopback, link-local or private address: %s", url),
+		"URLにループバック・リンクローカル・プライベートのアドレスは指定できません",
+	)
+}
+
+// ErrWebhookSecretLength はシークレットの長さが不正なエラー
+func ErrWebhookSecretLength() *ValidationError {
+	return NewValidationError(
+		"secret",
+		fmt.Sprintf("webhook secret must be %d to %d characters", WebhookSecretMinLength, WebhookSecretMaxLength),
+		fmt.Sprintf("シークレットは%d文字以上%d文字以下で指定してください", WebhookSecretMinLength, WebhookSecretMaxLength),
+	)
+}
+
+// ErrWebhookEventsRequired はイベント種別が未指定のエラー
+func ErrWebhookEventsRequired() *ValidationError {
+	return NewValidationError(
+		"events",
+		"at least one webhook event type is required",
+		"イベント種別を1つ以上指定してください",
+	)
+}
+
+// ErrWebhookEventTypeUnknown は未定義のイベント種別のエラー
+func ErrWebhookEventTypeUnknown(eventType string) *ValidationError {
+	return NewValidationError(
+		"events",
+		fmt.Sprintf("unknown webhook event type: %s", eventType),
+		"未定義のイベント種別が指定されています",
+	)
+}
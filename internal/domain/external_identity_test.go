@@ -0,0 +1,46 @@
+package domain
+
+import (
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+)
+
+func TestNewOIDCLoginState(t *testing.T) {
+	st, state, err := NewOIDCLoginState("company", 10*time.Minute)
+	if err != nil {
+		t.Fatalf("NewOIDCLoginState() unexpected error: %v", err)
+	}
+	if st.StateHash != HashUserToken(state) || st.StateHash == state {
+		t.Errorf("StateHash = %q, want hash of the state", st.StateHash)
+	}
+	// RFC 7636: code_verifier は 43〜128 文字の unreserved 文字
+	verifier := regexp.MustCompile(`^[A-Za-z0-9\-._~]{43,128}$`)
+	if !verifier.MatchString(st.CodeVerifier) {
+		t.Errorf("CodeVerifier = %q, want RFC 7636 code verifier", st.CodeVerifier)
+	}
+	if st.Nonce == "" || st.Nonce == state || st.Nonce == st.CodeVerifier {
+		t.Errorf("Nonce = %q, want a separate random value", st.Nonce)
+	}
+	if st.Provider != "company" || !st.ExpiresAt.After(st.CreatedAt) {
+		t.Errorf("state = %+v, want provider company expiring after creation", st)
+	}
+}
+
+func TestOIDCLoginState_Use(t *testing.T) {
+	st, _, err := NewOIDCLoginState("company", 10*time.Minute)
+	if err != nil {
+		t.Fatalf("NewOIDCLoginState() unexpected error: %v", err)
+	}
+	var validationErr *ValidationError
+	if err := st.Use(st.ExpiresAt); !errors.As(err, &validationErr) {
+		t.Errorf("Use() at expiry error = %v, want ValidationError", err)
+	}
+	if err := st.Use(st.CreatedAt); err != nil {
+		t.Fatalf("Use() unexpected error: %v", err)
+	}
+	if err := st.Use(st.CreatedAt); !errors.As(err, &validationErr) {
+		t.Errorf("second Use() error = %v, want ValidationError", err)
+	}
+}
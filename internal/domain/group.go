@@ -0,0 +1,115 @@
+package domain
+
+import (
+	"crypto/rand"
+	"time"
+	"unicode/utf8"
+
+	"github.com/oklog/ulid/v2"
+)
+
+// groupNameMaxLength グループ名の最大長
+const groupNameMaxLength = 100
+
+// GroupMembersMaxBatch 一度に追加・削除できるメンバーの最大数
+const GroupMembersMaxBatch = 100
+
+// GroupRole グループ内のロール
+type GroupRole string
+
+const (
+	// GroupRoleOwner グループのオーナー
+	GroupRoleOwner GroupRole = "owner"
+	// GroupRoleMember グループのメンバー
+	GroupRoleMember GroupRole = "member"
+)
+
+// Valid 定義済みのロールかどうか
+func (r GroupRole) Valid() bool {
+	switch r {
+	case GroupRoleOwner, GroupRoleMember:
+		return true
+	}
+	return false
+}
+
+// Group ユーザーのグループ（チーム）
+//
+// グループ名は組織ごとに一意。
+type Group struct {
+	ID        string
+	Name      string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// NewGroup グループを作成
+func NewGroup(name string) (*Group, error) {
+	if err := validateGroupName(name); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	return &Group{
+		ID:        ulid.MustNew(ulid.Timestamp(now), rand.Reader).String(),
+		Name:      name,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}, nil
+}
+
+// validateGroupName グループ名を検証
+func validateGroupName(name string) error {
+	if name == "" {
+		return ErrGroupNameRequired()
+	}
+	if utf8.RuneCountInString(name) > groupNameMaxLength {
+		return ErrGroupNameTooLong()
+	}
+	return nil
+}
+
+// GroupMember グループのメンバーシップ
+type GroupMember struct {
+	GroupID   string
+	UserID    string
+	Role      GroupRole
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// NewGroupMember メンバーシップを作成
+func NewGroupMember(groupID, userID string, role GroupRole) (*GroupMember, error) {
+	if !role.Valid() {
+		return nil, ErrGroupRoleInvalid(string(role))
+	}
+
+	now := time.Now()
+	return &GroupMember{
+		GroupID:   groupID,
+		UserID:    userID,
+		Role:      role,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}, nil
+}
+
+// ChangeRole ロールを変更する（変更があった場合は true を返す）
+func (m *GroupMember) ChangeRole(role GroupRole) (bool, error) {
+	if !role.Valid() {
+		return false, ErrGroupRoleInvalid(string(role))
+	}
+	if m.Role == role {
+		return false, nil
+	}
+	m.Role = role
+	m.UpdatedAt = time.Now()
+	return true, nil
+}
+
+// GroupMembership ユーザーから見た所属グループ（グループとそのユーザーのロール）
+type GroupMembership struct {
+	Group    *Group
+	Role     GroupRole
+	JoinedAt time.Time
+}
@@ -0,0 +1,117 @@
+package domain
+
+import (
+	"crypto/rand"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+)
+
+// InvitationStatus 招待の状態
+type InvitationStatus string
+
+const (
+	// InvitationStatusPending 承諾待ち
+	InvitationStatusPending InvitationStatus = "pending"
+	// InvitationStatusAccepted 承諾済み
+	InvitationStatusAccepted InvitationStatus = "accepted"
+	// InvitationStatusExpired 有効期限切れ（再送すると承諾待ちに戻る）
+	InvitationStatusExpired InvitationStatus = "expired"
+	// InvitationStatusRevoked 取り消し済み
+	InvitationStatusRevoked InvitationStatus = "revoked"
+)
+
+// Invitation 招待済みのユーザーへの招待
+//
+// トークンは UserToken と同じく SHA-256 のハッシュのみを保持し、再送のたびに新しいトークンに置き換える。
+type Invitation struct {
+	ID     string
+	UserID string
+	// Email は招待を送ったメールアドレス（承諾前にユーザーのメールアドレスが変わると承諾できない）
+	Email string
+	// InvitedBy は招待したユーザーのID（認証なしで招待した場合は空）
+	InvitedBy string
+	Status    InvitationStatus
+	TokenHash string
+	ExpiresAt time.Time
+	// AcceptedAt は承諾した時刻（承諾前はゼロ値）
+	AcceptedAt time.Time
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+// NewInvitation 招待済みのユーザーへの招待を作成し、メールで送るトークンと共に返す
+func NewInvitation(user *User, invitedBy string, ttl time.Duration) (*Invitation, string, error) {
+	token, err := newSecretToken()
+	if err != nil {
+		return nil, "", err
+	}
+
+	now := time.Now()
+	return &Invitation{
+		ID:        ulid.MustNew(ulid.Timestamp(now), rand.Reader).String(),
+		UserID:    user.ID,
+		Email:     user.Email,
+		InvitedBy: invitedBy,
+		Status:    InvitationStatusPending,
+		TokenHash: HashUserToken(token),
+		ExpiresAt: now.Add(ttl),
+		CreatedAt: now,
+		UpdatedAt: now,
+	}, token, nil
+}
+
+// Expire 有効期限を過ぎた承諾待ちの招待を期限切れにする（期限切れにした場合は true）
+func (i *Invitation) Expire(now time.Time) bool {
+	if i.Status != InvitationStatusPending || now.Before(i.ExpiresAt) {
+		return false
+	}
+	i.Status = InvitationStatusExpired
+	i.UpdatedAt = now
+	return true
+}
+
+// Renew 新しいトークンを発行し、有効期限を延ばして承諾待ちに戻す
+//
+// 送り先はユーザーの現在のメールアドレスとする。以前のトークンは使えなくなる。
+func (i *Invitation) Renew(user *User, ttl time.Duration) (string, error) {
+	if i.Status != InvitationStatusPending && i.Status != InvitationStatusExpired {
+		return "", ErrInvitationNotPending(i.ID, i.Status)
+	}
+	token, err := newSecretToken()
+	if err != nil {
+		return "", err
+	}
+
+	now := time.Now()
+	i.Email = user.Email
+	i.Status = InvitationStatusPending
+	i.TokenHash = HashUserToken(token)
+	i.ExpiresAt = now.Add(ttl)
+	i.UpdatedAt = now
+	return token, nil
+}
+
+// Accept 承諾待ちの招待を承諾済みにする（期限切れは ErrInvitationExpired、それ以外は ErrUserTokenInvalid）
+func (i *Invitation) Accept(now time.Time) error {
+	switch {
+	case i.Status == InvitationStatusExpired, i.Status == InvitationStatusPending && !now.Before(i.ExpiresAt):
+		return ErrInvitationExpired()
+	case i.Status != InvitationStatusPending:
+		return ErrUserTokenInvalid()
+	}
+	i.Status = InvitationStatusAccepted
+	i.AcceptedAt = now
+	i.UpdatedAt = now
+	return nil
+}
+
+// Revoke 承諾前の招待を取り消す
+func (i *Invitation) Revoke(now time.Time) error {
+	if i.Status != InvitationStatusPending && i.Status != InvitationStatusExpired {
+		return ErrInvitationNotPending(i.ID, i.Status)
+	}
+	i.Status = InvitationStatusRevoked
+	i.UpdatedAt = now
+	return nil
+}
@@ -0,0 +1,153 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestNewInvitedUser(t *testing.T) {
+	user, err := NewInvitedUser("John Doe", "john@example.com")
+	if err != nil {
+		t.Fatalf("NewInvitedUser() unexpected error: %v", err)
+	}
+	if user.Status != UserStatusInvited {
+		t.Errorf("Status = %q, want %q", user.Status, UserStatusInvited)
+	}
+	events := user.PullEvents()
+	if len(events) != 1 || events[0].Type != UserEventTypeCreated || events[0].User.Status != UserStatusInvited {
+		t.Errorf("events = %+v, want one created event with invited status", events)
+	}
+}
+
+func TestNewInvitation(t *testing.T) {
+	user, err := NewInvitedUser("John Doe", "john@example.com")
+	if err != nil {
+		t.Fatalf("NewInvitedUser() unexpected error: %v", err)
+	}
+
+	inv, secret, err := NewInvitation(user, "01ARZ3NDEKTSV4RRFFQ69G5FAV", time.Hour)
+	if err != nil {
+		t.Fatalf("NewInvitation() unexpected error: %v", err)
+	}
+	if inv.UserID != user.ID || inv.Email != user.Email || inv.Status != InvitationStatusPending {
+		t.Errorf("NewInvitation() = %+v, want pending invitation for %s", inv, user.ID)
+	}
+	if inv.TokenHash != HashUserToken(secret) || inv.TokenHash == secret {
+		t.Errorf("TokenHash = %q, want hash of the secret", inv.TokenHash)
+	}
+	if got := inv.ExpiresAt.Sub(inv.CreatedAt); got != time.Hour {
+		t.Errorf("ExpiresAt - CreatedAt = %v, want 1h", got)
+	}
+}
+
+func TestInvitation_Expire(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name string
+		inv  Invitation
+		want bool
+	}{
+		{name: "pending before expiry", inv: Invitation{Status: InvitationStatusPending, ExpiresAt: now.Add(time.Minute)}},
+		{name: "pending at expiry", inv: Invitation{Status: InvitationStatusPending, ExpiresAt: now}, want: true},
+		{name: "accepted", inv: Invitation{Status: InvitationStatusAccepted, ExpiresAt: now.Add(-time.Minute)}},
+		{name: "already expired", inv: Invitation{Status: InvitationStatusExpired, ExpiresAt: now.Add(-time.Minute)}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			inv := tt.inv
+			before := inv.Status
+			if got := inv.Expire(now); got != tt.want {
+				t.Fatalf("Expire() = %v, want %v", got, tt.want)
+			}
+			if tt.want && inv.Status != InvitationStatusExpired {
+				t.Errorf("Status = %q, want %q", inv.Status, InvitationStatusExpired)
+			}
+			if !tt.want && inv.Status != before {
+				t.Errorf("Status = %q, want unchanged %q", inv.Status, before)
+			}
+		})
+	}
+}
+
+func TestInvitation_Accept(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name    string
+		inv     Invitation
+		wantErr any
+	}{
+		{name: "pending", inv: Invitation{Status: InvitationStatusPending, ExpiresAt: now.Add(time.Minute)}},
+		{name: "pending past expiry", inv: Invitation{Status: InvitationStatusPending, ExpiresAt: now}, wantErr: new(*ValidationError)},
+		{name: "expired", inv: Invitation{Status: InvitationStatusExpired, ExpiresAt: now.Add(time.Minute)}, wantErr: new(*ValidationError)},
+		{name: "revoked", inv: Invitation{Status: InvitationStatusRevoked, ExpiresAt: now.Add(time.Minute)}, wantErr: new(*ValidationError)},
+		{name: "already accepted", inv: Invitation{Status: InvitationStatusAccepted, ExpiresAt: now.Add(time.Minute)}, wantErr: new(*ValidationError)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			inv := tt.inv
+			err := inv.Accept(now)
+			if tt.wantErr != nil {
+				if !errors.As(err, tt.wantErr) {
+					t.Fatalf("Accept() error = %v, want %T", err, tt.wantErr)
+				}
+				if inv.Status != tt.inv.Status {
+					t.Errorf("Status = %q, want unchanged %q", inv.Status, tt.inv.Status)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Accept() unexpected error: %v", err)
+			}
+			if inv.Status != InvitationStatusAccepted || !inv.AcceptedAt.Equal(now) {
+				t.Errorf("Accept() = %+v, want accepted at %v", inv, now)
+			}
+		})
+	}
+}
+
+func TestInvitation_RenewAndRevoke(t *testing.T) {
+	user, err := NewInvitedUser("John Doe", "john@example.com")
+	if err != nil {
+		t.Fatalf("NewInvitedUser() unexpected error: %v", err)
+	}
+	now := time.Now()
+
+	for _, status := range []InvitationStatus{InvitationStatusAccepted, InvitationStatusRevoked} {
+		inv := Invitation{ID: "inv", Status: status}
+		var conflictErr *ConflictError
+		if _, err := inv.Renew(user, time.Hour); !errors.As(err, &conflictErr) {
+			t.Errorf("Renew() on %s error = %v, want ConflictError", status, err)
+		}
+		if err := inv.Revoke(now); !errors.As(err, &conflictErr) {
+			t.Errorf("Revoke() on %s error = %v, want ConflictError", status, err)
+		}
+	}
+
+	// 期限切れの招待は新しいトークンで承諾待ちに戻る
+	inv, secret, err := NewInvitation(user, "", time.Hour)
+	if err != nil {
+		t.Fatalf("NewInvitation() unexpected error: %v", err)
+	}
+	inv.Expire(inv.ExpiresAt)
+	user.Email = "john.doe@example.com"
+	renewed, err := inv.Renew(user, 2*time.Hour)
+	if err != nil {
+		t.Fatalf("Renew() unexpected error: %v", err)
+	}
+	if renewed == secret || inv.TokenHash != HashUserToken(renewed) {
+		t.Errorf("Renew() did not replace the token")
+	}
+	if inv.Status != InvitationStatusPending || inv.Email != user.Email || !inv.ExpiresAt.After(now.Add(time.Hour)) {
+		t.Errorf("Renew() = %+v, want pending invitation to %s with extended expiry", inv, user.Email)
+	}
+
+	if err := inv.Revoke(now); err != nil {
+		t.Fatalf("Revoke() unexpected error: %v", err)
+	}
+	if inv.Status != InvitationStatusRevoked {
+		t.Errorf("Status = %q, want %q", inv.Status, InvitationStatusRevoked)
+	}
+}
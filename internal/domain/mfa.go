@@ -0,0 +1,154 @@
+package domain
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/hex"
+	"strings"
+	"time"
+)
+
+const (
+	// RecoveryCodeCount 二要素認証の有効化で発行するリカバリーコードの数
+	RecoveryCodeCount = 10
+	// recoveryCodeBytes リカバリーコードのランダムなバイト数（base32 で16文字）
+	recoveryCodeBytes = 10
+)
+
+// recoveryCodeEncoding リカバリーコードの符号化（読み間違えにくいよう小文字の base32 とする）
+var recoveryCodeEncoding = base32.NewEncoding("abcdefghijklmnopqrstuvwxyz234567").WithPadding(base32.NoPadding)
+
+// MFAPolicy 二要素認証を必須とする方針
+//
+// ユーザーにロールの属性はないため、管理者のロールは AdminGroup のグループのメンバーであることで表す。
+type MFAPolicy struct {
+	// RequireForAdmins は管理者に二要素認証を必須とするかどうか
+	RequireForAdmins bool
+	// AdminGroup は管理者のロールを表すグループの名前
+	AdminGroup string
+	// ChallengeTTL はパスワードの照合後、確認コードを入力するまでの有効期間
+	ChallengeTTL time.Duration
+}
+
+// DefaultMFAPolicy 既定の二要素認証の方針（必須としない。管理者は admins グループのメンバー、確認コードの入力は5分以内）
+var DefaultMFAPolicy = MFAPolicy{AdminGroup: "admins", ChallengeTTL: 5 * time.Minute}
+
+// IsRequired グループ memberships に所属するユーザーに二要素認証が必須かどうか
+func (p MFAPolicy) IsRequired(memberships []*GroupMembership) bool {
+	if !p.RequireForAdmins {
+		return false
+	}
+	for _, m := range memberships {
+		if m.Group != nil && m.Group.Name == p.AdminGroup {
+			return true
+		}
+	}
+	return false
+}
+
+// TOTPCredential ユーザーの TOTP（時間ベースのワンタイムパスワード）による二要素認証の登録
+//
+// 登録を開始すると秘密鍵を保存し、認証アプリの確認コードで確認すると有効になる。
+// リカバリーコードはハッシュ（SHA-256）のみを保持し、使用したコードは削除する。
+type TOTPCredential struct {
+	UserID string
+	// Secret は認証アプリと共有する秘密鍵（base32）
+	Secret string
+	// ConfirmedAt は確認コードで有効化した時刻（登録中の場合はゼロ値）
+	ConfirmedAt time.Time
+	// LastUsedStep は最後に使用した確認コードの時間ステップ（同じコードの再使用を防ぐ）
+	LastUsedStep int64
+	// RecoveryCodeHashes は未使用のリカバリーコードのハッシュ
+	RecoveryCodeHashes []string
+	CreatedAt          time.Time
+	UpdatedAt          time.Time
+}
+
+// NewTOTPCredential 秘密鍵 secret で登録中の TOTP の二要素認証を作成
+func NewTOTPCredential(userID, secret string, now time.Time) *TOTPCredential {
+	return &TOTPCredential{
+		UserID:    userID,
+		Secret:    secret,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+}
+
+// IsEnabled 確認コードで有効化されているかどうか
+func (c *TOTPCredential) IsEnabled() bool {
+	return !c.ConfirmedAt.IsZero()
+}
+
+// Confirm 時間ステップ step の確認コードで有効化し、リカバリーコードを発行して返す
+//
+// リカバリーコードを返すのはこのときのみで、以降はハッシュのみを保持する。
+func (c *TOTPCredential) Confirm(step int64, now time.Time) ([]string, error) {
+	codes, hashes, err := newRecoveryCodes()
+	if err != nil {
+		return nil, err
+	}
+	c.ConfirmedAt = now
+	c.LastUsedStep = step
+	c.RecoveryCodeHashes = hashes
+	c.UpdatedAt = now
+	return codes, nil
+}
+
+// UseStep 時間ステップ step の確認コードを使用済みにする
+//
+// 最後に使用したステップ以前のコードは、盗み見たコードの再使用を防ぐため false を返す。
+func (c *TOTPCredential) UseStep(step int64, now time.Time) bool {
+	if step <= c.LastUsedStep {
+		return false
+	}
+	c.LastUsedStep = step
+	c.UpdatedAt = now
+	return true
+}
+
+// UseRecoveryCode リカバリーコードを照合し、一致した場合は使用済みとして削除する（一致しない場合は false）
+func (c *TOTPCredential) UseRecoveryCode(code string, now time.Time) bool {
+	hash := HashRecoveryCode(code)
+	for i, h := range c.RecoveryCodeHashes {
+		if subtle.ConstantTimeCompare([]byte(h), []byte(hash)) == 1 {
+			c.RecoveryCodeHashes = append(c.RecoveryCodeHashes[:i:i], c.RecoveryCodeHashes[i+1:]...)
+			c.UpdatedAt = now
+			return true
+		}
+	}
+	return false
+}
+
+// newRecoveryCodes RecoveryCodeCount 個のリカバリーコードとそのハッシュを作成する
+func newRecoveryCodes() (codes, hashes []string, err error) {
+	codes = make([]string, 0, RecoveryCodeCount)
+	hashes = make([]string, 0, RecoveryCodeCount)
+	b := make([]byte, recoveryCodeBytes)
+	for range RecoveryCodeCount {
+		if _, err := rand.Read(b); err != nil {
+			return nil, nil, err
+		}
+		s := recoveryCodeEncoding.EncodeToString(b)
+		// 4文字ずつハイフンで区切る（例: abcd-efgh-ijkl-mnop）
+		code := s[0:4] + "-" + s[4:8] + "-" + s[8:12] + "-" + s[12:16]
+		codes = append(codes, code)
+		hashes = append(hashes, HashRecoveryCode(code))
+	}
+	return codes, hashes, nil
+}
+
+// HashRecoveryCode リカバリーコードを保存・照合用のハッシュに変換する
+//
+// 入力の揺れを許容するため、大文字・小文字とハイフン・空白の有無は区別しない。
+func HashRecoveryCode(code string) string {
+	normalized := strings.Map(func(r rune) rune {
+		if r == '-' || r == ' ' {
+			return -1
+		}
+		return r
+	}, strings.ToLower(code))
+	sum := sha256.Sum256([]byte(normalized))
+	return hex.EncodeToString(sum[:])
+}
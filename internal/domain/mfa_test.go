@@ -0,0 +1,109 @@
+package domain
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestMFAPolicy_IsRequired(t *testing.T) {
+	admins := []*GroupMembership{{Group: &Group{Name: "admins"}, Role: GroupRoleMember}}
+	others := []*GroupMembership{{Group: &Group{Name: "engineering"}, Role: GroupRoleOwner}}
+	required := MFAPolicy{RequireForAdmins: true, AdminGroup: "admins"}
+
+	tests := []struct {
+		name        string
+		policy      MFAPolicy
+		memberships []*GroupMembership
+		want        bool
+	}{
+		{name: "admin", policy: required, memberships: admins, want: true},
+		{name: "not admin", policy: required, memberships: others},
+		{name: "no groups", policy: required},
+		{name: "not required", policy: DefaultMFAPolicy, memberships: admins},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.policy.IsRequired(tt.memberships); got != tt.want {
+				t.Errorf("IsRequired() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTOTPCredential_Confirm(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	cred := NewTOTPCredential("user", "SECRET", now)
+	if cred.IsEnabled() {
+		t.Fatal("IsEnabled() = true before confirmation")
+	}
+
+	codes, err := cred.Confirm(100, now)
+	if err != nil {
+		t.Fatalf("Confirm() unexpected error: %v", err)
+	}
+	if !cred.IsEnabled() || cred.LastUsedStep != 100 {
+		t.Errorf("after Confirm() = %+v, want enabled at step 100", cred)
+	}
+	if len(codes) != RecoveryCodeCount || len(cred.RecoveryCodeHashes) != RecoveryCodeCount {
+		t.Fatalf("Confirm() = %d codes (%d hashes), want %d", len(codes), len(cred.RecoveryCodeHashes), RecoveryCodeCount)
+	}
+	format := regexp.MustCompile(`^[a-z2-7]{4}-[a-z2-7]{4}-[a-z2-7]{4}-[a-z2-7]{4}$`)
+	seen := make(map[string]bool)
+	for i, code := range codes {
+		if !format.MatchString(code) {
+			t.Errorf("recovery code %q does not match %s", code, format)
+		}
+		if seen[code] {
+			t.Errorf("recovery code %q is duplicated", code)
+		}
+		seen[code] = true
+		if cred.RecoveryCodeHashes[i] != HashRecoveryCode(code) || cred.RecoveryCodeHashes[i] == code {
+			t.Errorf("RecoveryCodeHashes[%d] = %q, want hash of the code", i, cred.RecoveryCodeHashes[i])
+		}
+	}
+}
+
+func TestTOTPCredential_UseStep(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	cred := &TOTPCredential{LastUsedStep: 100}
+
+	if cred.UseStep(100, now) {
+		t.Error("UseStep(100) = true, want false for the last used step")
+	}
+	if cred.UseStep(99, now) {
+		t.Error("UseStep(99) = true, want false for an earlier step")
+	}
+	if !cred.UseStep(101, now) || cred.LastUsedStep != 101 {
+		t.Errorf("UseStep(101) did not update LastUsedStep (%d)", cred.LastUsedStep)
+	}
+}
+
+func TestTOTPCredential_UseRecoveryCode(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	cred := NewTOTPCredential("user", "SECRET", now)
+	codes, err := cred.Confirm(1, now)
+	if err != nil {
+		t.Fatalf("Confirm() unexpected error: %v", err)
+	}
+
+	if cred.UseRecoveryCode("aaaa-bbbb-cccc-dddd", now) {
+		t.Error("UseRecoveryCode() = true for an unknown code")
+	}
+	// 大文字・小文字とハイフンの有無は区別しない
+	input := strings.ToUpper(strings.ReplaceAll(codes[3], "-", ""))
+	if !cred.UseRecoveryCode(input, now) {
+		t.Fatalf("UseRecoveryCode(%q) = false, want true", input)
+	}
+	if len(cred.RecoveryCodeHashes) != RecoveryCodeCount-1 {
+		t.Errorf("len(RecoveryCodeHashes) = %d, want %d", len(cred.RecoveryCodeHashes), RecoveryCodeCount-1)
+	}
+	if cred.UseRecoveryCode(codes[3], now) {
+		t.Error("UseRecoveryCode() = true for a used code")
+	}
+	if !cred.UseRecoveryCode(codes[4], now) {
+		t.Error("UseRecoveryCode() = false for another unused code")
+	}
+}
@@ -0,0 +1,48 @@
+package domain
+
+import (
+	"crypto/rand"
+	"time"
+	"unicode/utf8"
+
+	"github.com/oklog/ulid/v2"
+)
+
+// organizationNameMaxLength 組織名の最大長
+const organizationNameMaxLength = 255
+
+// Organization 組織（テナント）
+//
+// ユーザーやWebhook購読は組織ごとに分離され、メールアドレスの一意性も組織ごとに判定する。
+type Organization struct {
+	ID        string
+	Name      string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// NewOrganization 組織を作成
+func NewOrganization(name string) (*Organization, error) {
+	if err := validateOrganizationName(name); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	return &Organization{
+		ID:        ulid.MustNew(ulid.Timestamp(now), rand.Reader).String(),
+		Name:      name,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}, nil
+}
+
+// validateOrganizationName 組織名を検証
+func validateOrganizationName(name string) error {
+	if name == "" {
+		return ErrOrganizationNameRequired()
+	}
+	if utf8.RuneCountInString(name) > organizationNameMaxLength {
+		return ErrOrganizationNameTooLong()
+	}
+	return nil
+}
@@ -0,0 +1,39 @@
+package domain
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestNewOrganization(t *testing.T) {
+	tests := []struct {
+		name      string
+		orgName   string
+		wantField string
+	}{
+		{name: "valid", orgName: "Acme Inc."},
+		{name: "max length", orgName: strings.Repeat("組", organizationNameMaxLength)},
+		{name: "empty name", orgName: "", wantField: "name"},
+		{name: "too long", orgName: strings.Repeat("a", organizationNameMaxLength+1), wantField: "name"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			org, err := NewOrganization(tt.orgName)
+			if tt.wantField == "" {
+				if err != nil {
+					t.Fatalf("NewOrganization() unexpected error: %v", err)
+				}
+				if org.ID == "" || org.Name != tt.orgName || org.CreatedAt.IsZero() {
+					t.Errorf("NewOrganization() = %+v, want ID, Name and CreatedAt", org)
+				}
+				return
+			}
+			var validationErr *ValidationError
+			if !errors.As(err, &validationErr) || validationErr.Field != tt.wantField {
+				t.Fatalf("NewOrganization() error = %v, want validation error on %s", err, tt.wantField)
+			}
+		})
+	}
+}
@@ -0,0 +1,116 @@
+package domain
+
+import (
+	"crypto/rand"
+	"time"
+	"unicode/utf8"
+
+	"github.com/oklog/ulid/v2"
+)
+
+const (
+	// sessionDeviceMaxLength 端末名の最大長（超える分は切り詰める）
+	sessionDeviceMaxLength = 100
+	// sessionUserAgentMaxLength User-Agent の最大長（超える分は切り詰める）
+	sessionUserAgentMaxLength = 500
+	// SessionTouchInterval 最終利用時刻を更新する最小の間隔（リクエストごとの書き込みを避ける）
+	SessionTouchInterval = time.Minute
+)
+
+// SessionPolicy セッションの有効期限の方針
+type SessionPolicy struct {
+	// IdleTimeout は最後に利用してから失効するまでの時間
+	IdleTimeout time.Duration
+	// AbsoluteTimeout はログインしてから失効するまでの時間（利用中でも延長しない）
+	AbsoluteTimeout time.Duration
+}
+
+// DefaultSessionPolicy 既定のセッションの方針（30分間利用がないか、ログインから8時間で失効する）
+var DefaultSessionPolicy = SessionPolicy{IdleTimeout: 30 * time.Minute, AbsoluteTimeout: 8 * time.Hour}
+
+// SessionClient ログインした端末の情報
+type SessionClient struct {
+	// Device はクライアントが申告した端末名（省略可）
+	Device    string
+	IPAddress string
+	UserAgent string
+}
+
+// Session ログインで発行したセッション
+//
+// セッショントークンはセッションのIDを含み、認証のたびに取り消し・有効期限を確認する。
+type Session struct {
+	ID     string
+	UserID string
+	SessionClient
+	CreatedAt  time.Time
+	LastSeenAt time.Time
+	// ExpiresAt はログインからの有効期限（SessionPolicy.AbsoluteTimeout）
+	ExpiresAt time.Time
+	// RevokedAt は取り消した時刻（有効な間はゼロ値）
+	RevokedAt time.Time
+}
+
+// NewSession ユーザーのセッションを作成
+//
+// 有効期限はトークンの有効期限と揃えるため秒単位に切り捨てる。
+func NewSession(userID string, client SessionClient, policy SessionPolicy, now time.Time) *Session {
+	client.Device = truncateRunes(client.Device, sessionDeviceMaxLength)
+	client.UserAgent = truncateRunes(client.UserAgent, sessionUserAgentMaxLength)
+	return &Session{
+		ID:            ulid.MustNew(ulid.Timestamp(now), rand.Reader).String(),
+		UserID:        userID,
+		SessionClient: client,
+		CreatedAt:     now,
+		LastSeenAt:    now,
+		ExpiresAt:     now.Add(policy.AbsoluteTimeout).Truncate(time.Second),
+	}
+}
+
+// Validate セッションで認証できるか検証する（取り消し済みは ErrSessionRevoked、失効は ErrSessionExpired）
+func (s *Session) Validate(now time.Time, policy SessionPolicy) error {
+	if s.IsRevoked() {
+		return ErrSessionRevoked(s.ID)
+	}
+	if !now.Before(s.ExpiresAt) || !now.Before(s.LastSeenAt.Add(policy.IdleTimeout)) {
+		return ErrSessionExpired(s.ID)
+	}
+	return nil
+}
+
+// IsActive 取り消されておらず、有効期限内かどうか
+func (s *Session) IsActive(now time.Time, policy SessionPolicy) bool {
+	return s.Validate(now, policy) == nil
+}
+
+// IsRevoked 取り消し済みかどうか
+func (s *Session) IsRevoked() bool {
+	return !s.RevokedAt.IsZero()
+}
+
+// NeedsTouch 最終利用時刻を更新する必要があるか（前回の更新から SessionTouchInterval 以上経過している）
+func (s *Session) NeedsTouch(now time.Time) bool {
+	return !now.Before(s.LastSeenAt.Add(SessionTouchInterval))
+}
+
+// Touch 最終利用時刻を更新する
+func (s *Session) Touch(now time.Time) {
+	s.LastSeenAt = now
+}
+
+// Revoke セッションを取り消す（取り消し済みの場合は何もせず false を返す）
+func (s *Session) Revoke(now time.Time) bool {
+	if s.IsRevoked() {
+		return false
+	}
+	s.RevokedAt = now
+	return true
+}
+
+// truncateRunes 文字列を最大 max 文字に切り詰める
+func truncateRunes(s string, max int) string {
+	if utf8.RuneCountInString(s) <= max {
+		return s
+	}
+	return string([]rune(s)[:max])
+}
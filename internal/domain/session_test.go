@@ -0,0 +1,106 @@
+package domain
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+)
+
+func TestNewSession(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 500, time.UTC)
+	client := SessionClient{Device: strings.Repeat("端", 150), IPAddress: "192.0.2.1", UserAgent: strings.Repeat("a", 600)}
+
+	s := NewSession("01ARZ3NDEKTSV4RRFFQ69G5FAV", client, DefaultSessionPolicy, now)
+
+	if s.ID == "" || s.UserID != "01ARZ3NDEKTSV4RRFFQ69G5FAV" || s.IPAddress != "192.0.2.1" {
+		t.Errorf("NewSession() = %+v, want session for the user", s)
+	}
+	if n := utf8.RuneCountInString(s.Device); n != sessionDeviceMaxLength {
+		t.Errorf("len(Device) = %d, want %d", n, sessionDeviceMaxLength)
+	}
+	if n := len(s.UserAgent); n != sessionUserAgentMaxLength {
+		t.Errorf("len(UserAgent) = %d, want %d", n, sessionUserAgentMaxLength)
+	}
+	if want := now.Add(DefaultSessionPolicy.AbsoluteTimeout).Truncate(time.Second); !s.ExpiresAt.Equal(want) {
+		t.Errorf("ExpiresAt = %v, want %v", s.ExpiresAt, want)
+	}
+	if !s.LastSeenAt.Equal(now) || s.IsRevoked() {
+		t.Errorf("NewSession() = %+v, want unrevoked session last seen at creation", s)
+	}
+}
+
+func TestSession_Validate(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	policy := SessionPolicy{IdleTimeout: 30 * time.Minute, AbsoluteTimeout: 8 * time.Hour}
+
+	tests := []struct {
+		name    string
+		session Session
+		wantErr string
+	}{
+		{
+			name:    "active",
+			session: Session{LastSeenAt: now.Add(-29 * time.Minute), ExpiresAt: now.Add(time.Hour)},
+		},
+		{
+			name:    "idle",
+			session: Session{LastSeenAt: now.Add(-30 * time.Minute), ExpiresAt: now.Add(time.Hour)},
+			wantErr: "expired",
+		},
+		{
+			name:    "past absolute timeout",
+			session: Session{LastSeenAt: now, ExpiresAt: now},
+			wantErr: "expired",
+		},
+		{
+			name:    "revoked",
+			session: Session{LastSeenAt: now, ExpiresAt: now.Add(time.Hour), RevokedAt: now},
+			wantErr: "revoked",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.session.Validate(now, policy)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("Validate() unexpected error: %v", err)
+				}
+				return
+			}
+			var authErr *AuthenticationError
+			if !errors.As(err, &authErr) || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Validate() error = %v, want %s authentication error", err, tt.wantErr)
+			}
+			if tt.session.IsActive(now, policy) {
+				t.Error("IsActive() = true, want false")
+			}
+		})
+	}
+}
+
+func TestSession_TouchAndRevoke(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	s := NewSession("01ARZ3NDEKTSV4RRFFQ69G5FAV", SessionClient{}, DefaultSessionPolicy, now)
+
+	if s.NeedsTouch(now.Add(SessionTouchInterval - time.Second)) {
+		t.Error("NeedsTouch() within the interval = true, want false")
+	}
+	later := now.Add(SessionTouchInterval)
+	if !s.NeedsTouch(later) {
+		t.Fatal("NeedsTouch() after the interval = false, want true")
+	}
+	s.Touch(later)
+	if !s.LastSeenAt.Equal(later) {
+		t.Errorf("LastSeenAt = %v, want %v", s.LastSeenAt, later)
+	}
+
+	if !s.Revoke(later) {
+		t.Fatal("Revoke() = false, want true")
+	}
+	if s.Revoke(later.Add(time.Minute)) || !s.RevokedAt.Equal(later) {
+		t.Errorf("Revoke() twice changed RevokedAt to %v, want %v", s.RevokedAt, later)
+	}
+}
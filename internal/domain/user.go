@@ -9,15 +9,33 @@ import (
 
 // User ドメインモデル
 type User struct {
-	ID        string
-	Name      string
-	Email     string
-	CreatedAt time.Time
-	UpdatedAt time.Time
+	ID     string
+	Name   string
+	Email  string
+	Status UserStatus
+	// EmailVerifiedAt はメールアドレスの確認が完了した時刻（未確認の場合はゼロ値）
+	EmailVerifiedAt time.Time
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+
+	// events は永続化前の未発行ドメインイベント
+	events []UserEvent
 }
 
 // NewUser ユーザーを作成
 func NewUser(name, email string) (*User, error) {
+	return newUser(name, email, UserStatusActive)
+}
+
+// NewInvitedUser 招待済み（UserStatusInvited）のユーザーを作成
+//
+// 招待を承諾すると Activate で利用を開始する。
+func NewInvitedUser(name, email string) (*User, error) {
+	return newUser(name, email, UserStatusInvited)
+}
+
+// newUser ステータスを指定してユーザーを作成
+func newUser(name, email string, status UserStatus) (*User, error) {
 	if name == "" {
 		return nil, ErrNameRequired()
 	}
@@ -26,23 +44,71 @@ func NewUser(name, email string) (*User, error) {
 	}
 
 	now := time.Now()
-	return &User{
+	user := &User{
 		ID:        ulid.MustNew(ulid.Timestamp(now), rand.Reader).String(),
 		Name:      name,
 		Email:     email,
+		Status:    status,
 		CreatedAt: now,
 		UpdatedAt: now,
-	}, nil
+	}
+	user.record(UserEventTypeCreated, now)
+	return user, nil
 }
 
-// Update ユーザー情報を更新
+// Update ユーザー情報を置き換える（部分更新は呼び出し側で現在の値に適用してから渡す）
+//
+// メールアドレスを変更した場合は確認済みの状態を解除する。
 func (u *User) Update(name, email string) error {
-	if name != "" {
-		u.Name = name
+	if name == "" {
+		return ErrNameRequired()
 	}
-	if email != "" {
-		u.Email = email
+	if email == "" {
+		return ErrEmailRequired()
 	}
+
+	if email != u.Email {
+		u.EmailVerifiedAt = time.Time{}
+	}
+	u.Name = name
+	u.Email = email
 	u.UpdatedAt = time.Now()
+	u.record(UserEventTypeUpdated, u.UpdatedAt)
 	return nil
 }
+
+// VerifyEmail メールアドレスを確認済みにする
+func (u *User) VerifyEmail(now time.Time) {
+	u.EmailVerifiedAt = now
+	u.UpdatedAt = now
+	u.record(UserEventTypeUpdated, now)
+}
+
+// IsEmailVerified メールアドレスが確認済みかどうか
+func (u *User) IsEmailVerified() bool {
+	return !u.EmailVerifiedAt.IsZero()
+}
+
+// Delete ユーザーを削除済みとしてイベントを記録（永続化からの削除はリポジトリが行う）
+func (u *User) Delete() {
+	u.record(UserEventTypeDeleted, time.Now())
+}
+
+// Snapshot 未発行イベントを含まないユーザーのコピーを返す
+func (u *User) Snapshot() User {
+	snapshot := *u
+	snapshot.events = nil
+	return snapshot
+}
+
+// PullEvents 未発行のドメインイベントを取り出し、集約からは削除する
+func (u *User) PullEvents() []UserEvent {
+	events := u.events
+	u.events = nil
+	return events
+}
+
+// record ドメインイベントを記録
+func (u *User) record(eventType UserEventType, now time.Time) {
+	u.events = append(u.events, newUserEvent(eventType, u.Snapshot(), now))
+}
@@ -0,0 +1,52 @@
+package domain
+
+import (
+	"crypto/rand"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+)
+
+// UserEventType ユーザー集約が発行するドメインイベントの種別
+type UserEventType string
+
+const (
+	// UserEventTypeCreated ユーザー作成
+	UserEventTypeCreated UserEventType = "UserCreated"
+	// UserEventTypeUpdated ユーザー更新
+	UserEventTypeUpdated UserEventType = "UserUpdated"
+	// UserEventTypeDeleted ユーザー削除
+	UserEventTypeDeleted UserEventType = "UserDeleted"
+)
+
+// UserAggregateType ドメインイベントの集約種別
+const UserAggregateType = "user"
+
+// UserEvent ユーザー集約のドメインイベント
+//
+// User はイベント発生時点のユーザーのスナップショット。削除イベントでは削除直前の状態を表す。
+type UserEvent struct {
+	ID         string
+	Type       UserEventType
+	User       User
+	OccurredAt time.Time
+}
+
+// newUserEvent ユーザーのスナップショットからイベントを作成
+func newUserEvent(eventType UserEventType, user User, now time.Time) UserEvent {
+	return UserEvent{
+		ID:         ulid.MustNew(ulid.Timestamp(now), rand.Reader).String(),
+		Type:       eventType,
+		User:       user,
+		OccurredAt: now,
+	}
+}
+
+// Valid 定義済みのイベント種別か
+func (t UserEventType) Valid() bool {
+	switch t {
+	case UserEventTypeCreated, UserEventTypeUpdated, UserEventTypeDeleted:
+		return true
+	}
+	return false
+}
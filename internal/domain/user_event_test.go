@@ -0,0 +1,60 @@
+package domain
+
+import (
+	"testing"
+)
+
+func TestUser_PullEvents(t *testing.T) {
+	user, err := NewUser("John Doe", "john@example.com")
+	if err != nil {
+		t.Fatalf("Failed to create user: %v", err)
+	}
+	if err := user.Update("John Smith", "john@example.com"); err != nil {
+		t.Fatalf("Update() unexpected error: %v", err)
+	}
+	user.Delete()
+
+	events := user.PullEvents()
+	wantTypes := []UserEventType{UserEventTypeCreated, UserEventTypeUpdated, UserEventTypeDeleted}
+	if len(events) != len(wantTypes) {
+		t.Fatalf("PullEvents() returned %d events, want %d", len(events), len(wantTypes))
+	}
+	for i, want := range wantTypes {
+		if events[i].Type != want {
+			t.Errorf("events[%d].Type = %v, want %v", i, events[i].Type, want)
+		}
+		if events[i].ID == "" || events[i].OccurredAt.IsZero() {
+			t.Errorf("events[%d] = %+v, want ID and OccurredAt", i, events[i])
+		}
+		if events[i].User.ID != user.ID {
+			t.Errorf("events[%d].User.ID = %v, want %v", i, events[i].User.ID, user.ID)
+		}
+	}
+
+	// スナップショットはイベント発生時点の状態を保持する
+	if events[0].User.Name != "John Doe" {
+		t.Errorf("UserCreated snapshot name = %v, want %v", events[0].User.Name, "John Doe")
+	}
+	if events[1].User.Name != "John Smith" {
+		t.Errorf("UserUpdated snapshot name = %v, want %v", events[1].User.Name, "John Smith")
+	}
+
+	if remaining := user.PullEvents(); len(remaining) != 0 {
+		t.Errorf("PullEvents() after pull returned %d events, want 0", len(remaining))
+	}
+}
+
+func TestUser_Snapshot(t *testing.T) {
+	user, err := NewUser("John Doe", "john@example.com")
+	if err != nil {
+		t.Fatalf("Failed to create user: %v", err)
+	}
+
+	snapshot := user.Snapshot()
+	if events := snapshot.PullEvents(); len(events) != 0 {
+		t.Errorf("Snapshot() carried %d events, want 0", len(events))
+	}
+	if events := user.PullEvents(); len(events) != 1 {
+		t.Errorf("PullEvents() on original returned %d events, want 1", len(events))
+	}
+}
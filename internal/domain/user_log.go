@@ -15,13 +15,63 @@ const (
 	UserLogActionCreated UserLogAction = "created"
 	// UserLogActionDeleted ユーザー削除
 	UserLogActionDeleted UserLogAction = "deleted"
+	// UserLogActionGroupJoined グループへの参加
+	UserLogActionGroupJoined UserLogAction = "group_joined"
+	// UserLogActionGroupLeft グループからの脱退
+	UserLogActionGroupLeft UserLogAction = "group_left"
+	// UserLogActionGroupRoleChanged グループ内のロールの変更
+	UserLogActionGroupRoleChanged UserLogAction = "group_role_changed"
+	// UserLogActionSuspended ユーザーの一時停止
+	UserLogActionSuspended UserLogAction = "suspended"
+	// UserLogActionReactivated ユーザーの再開
+	UserLogActionReactivated UserLogAction = "reactivated"
+	// UserLogActionDeactivated ユーザーの無効化
+	UserLogActionDeactivated UserLogAction = "deactivated"
+	// UserLogActionPasswordSet パスワードの初回設定
+	UserLogActionPasswordSet UserLogAction = "password_set"
+	// UserLogActionPasswordChanged パスワードの変更
+	UserLogActionPasswordChanged UserLogAction = "password_changed"
+	// UserLogActionLoginSucceeded ログインの成功
+	UserLogActionLoginSucceeded UserLogAction = "login_succeeded"
+	// UserLogActionLoginFailed パスワードの照合の失敗（ログインとパスワード変更の両方で記録する）
+	UserLogActionLoginFailed UserLogAction = "login_failed"
+	// UserLogActionLockedOut 連続した失敗によるアカウントのロック
+	UserLogActionLockedOut UserLogAction = "locked_out"
+	// UserLogActionEmailVerified メールアドレスの確認
+	UserLogActionEmailVerified UserLogAction = "email_verified"
+	// UserLogActionPasswordReset パスワードのリセット
+	UserLogActionPasswordReset UserLogAction = "password_reset"
+	// UserLogActionInvited 招待によるユーザー作成
+	UserLogActionInvited UserLogAction = "invited"
+	// UserLogActionInvitationAccepted 招待の承諾
+	UserLogActionInvitationAccepted UserLogAction = "invitation_accepted"
+	// UserLogActionInvitationExpired 招待の有効期限切れ
+	UserLogActionInvitationExpired UserLogAction = "invitation_expired"
+	// UserLogActionInvitationRevoked 招待の取り消し
+	UserLogActionInvitationRevoked UserLogAction = "invitation_revoked"
+	// UserLogActionSessionRevoked セッションの取り消し
+	UserLogActionSessionRevoked UserLogAction = "session_revoked"
+	// UserLogActionAllSessionsRevoked すべてのセッションの取り消し（ステータス変更・パスワードのリセットによるものを含む）
+	UserLogActionAllSessionsRevoked UserLogAction = "all_sessions_revoked"
+	// UserLogActionMFAEnrollmentStarted TOTP の二要素認証の登録の開始（秘密鍵の発行）
+	UserLogActionMFAEnrollmentStarted UserLogAction = "mfa_enrollment_started"
+	// UserLogActionMFAEnabled TOTP の二要素認証の有効化
+	UserLogActionMFAEnabled UserLogAction = "mfa_enabled"
+	// UserLogActionMFADisabled TOTP の二要素認証の無効化
+	UserLogActionMFADisabled UserLogAction = "mfa_disabled"
+	// UserLogActionMFARecoveryCodeUsed リカバリーコードの使用
+	UserLogActionMFARecoveryCodeUsed UserLogAction = "mfa_recovery_code_used"
+	// UserLogActionExternalIdentityLinked 外部の IdP のユーザーとの紐付け（OpenID Connect のログイン時）
+	UserLogActionExternalIdentityLinked UserLogAction = "external_identity_linked"
 )
 
 // UserLog ユーザーログのドメインモデル
 type UserLog struct {
-	ID        string
-	UserID    string
-	Action    UserLogAction
+	ID     string
+	UserID string
+	Action UserLogAction
+	// Reason はステータス変更などの操作理由（理由のない操作では空）
+	Reason    string
 	CreatedAt time.Time
 }
 
@@ -35,3 +85,10 @@ func NewUserLog(userID string, action UserLogAction) *UserLog {
 		CreatedAt: now,
 	}
 }
+
+// NewUserLogWithReason 理由付きのユーザーログを作成
+func NewUserLogWithReason(userID string, action UserLogAction, reason string) *UserLog {
+	log := NewUserLog(userID, action)
+	log.Reason = reason
+	return log
+}
@@ -0,0 +1,107 @@
+package domain
+
+import (
+	"time"
+	"unicode/utf8"
+)
+
+// userStatusReasonMaxLength ステータス変更理由の最大長
+const userStatusReasonMaxLength = 500
+
+// UserStatus ユーザーアカウントのステータス
+type UserStatus string
+
+const (
+	// UserStatusInvited 招待済み（まだ利用を開始していない）
+	UserStatusInvited UserStatus = "invited"
+	// UserStatusActive 利用中
+	UserStatusActive UserStatus = "active"
+	// UserStatusSuspended 一時停止中
+	UserStatusSuspended UserStatus = "suspended"
+	// UserStatusDeactivated 無効化済み
+	UserStatusDeactivated UserStatus = "deactivated"
+)
+
+// Valid 定義済みのステータスかどうか
+func (s UserStatus) Valid() bool {
+	switch s {
+	case UserStatusInvited, UserStatusActive, UserStatusSuspended, UserStatusDeactivated:
+		return true
+	}
+	return false
+}
+
+// userStatusTransitions 許可されるステータス遷移（遷移元 → 遷移先）
+var userStatusTransitions = map[UserStatus][]UserStatus{
+	UserStatusInvited:     {UserStatusActive, UserStatusDeactivated},
+	UserStatusActive:      {UserStatusSuspended, UserStatusDeactivated},
+	UserStatusSuspended:   {UserStatusActive, UserStatusDeactivated},
+	UserStatusDeactivated: {UserStatusActive},
+}
+
+// CanTransitionTo 指定したステータスへ遷移できるかどうか
+func (s UserStatus) CanTransitionTo(to UserStatus) bool {
+	for _, next := range userStatusTransitions[s] {
+		if next == to {
+			return true
+		}
+	}
+	return false
+}
+
+// Activate 招待済みのユーザーを利用中にする
+func (u *User) Activate() error {
+	return u.transition(UserStatusInvited, UserStatusActive)
+}
+
+// Suspend 利用中のユーザーを一時停止する
+func (u *User) Suspend(reason string) error {
+	if err := validateUserStatusReason(reason); err != nil {
+		return err
+	}
+	return u.transition(u.Status, UserStatusSuspended)
+}
+
+// Reactivate 一時停止中または無効化済みのユーザーを利用中に戻す
+//
+// 招待済みのユーザーは Activate で利用を開始する。
+func (u *User) Reactivate(reason string) error {
+	if err := validateUserStatusReason(reason); err != nil {
+		return err
+	}
+	if u.Status == UserStatusInvited {
+		return ErrUserStatusTransition(u.Status, UserStatusActive)
+	}
+	return u.transition(u.Status, UserStatusActive)
+}
+
+// Deactivate ユーザーを無効化する
+func (u *User) Deactivate(reason string) error {
+	if err := validateUserStatusReason(reason); err != nil {
+		return err
+	}
+	return u.transition(u.Status, UserStatusDeactivated)
+}
+
+// transition ステータスを遷移させてイベントを記録（from が現在のステータスと異なる場合も不正な遷移とする）
+func (u *User) transition(from, to UserStatus) error {
+	if u.Status != from || !from.CanTransitionTo(to) {
+		return ErrUserStatusTransition(u.Status, to)
+	}
+
+	u.Status = to
+	u.UpdatedAt = time.Now()
+	u.record(UserEventTypeUpdated, u.UpdatedAt)
+	return nil
+}
+
+// validateUserStatusReason ステータス変更理由を検証
+func validateUserStatusReason(reason string) error {
+	if reason == "" {
+		return ErrUserStatusReasonRequired()
+	}
+	if utf8.RuneCountInString(reason) > userStatusReasonMaxLength {
+		return ErrUserStatusReasonTooLong()
+	}
+	return nil
+}
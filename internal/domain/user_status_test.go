@@ -0,0 +1,105 @@
+package domain
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestUser_StatusTransitions(t *testing.T) {
+	change := map[string]func(u *User) error{
+		"activate":   func(u *User) error { return u.Activate() },
+		"suspend":    func(u *User) error { return u.Suspend("policy violation") },
+		"reactivate": func(u *User) error { return u.Reactivate("resolved") },
+		"deactivate": func(u *User) error { return u.Deactivate("left the company") },
+	}
+
+	tests := []struct {
+		from UserStatus
+		op   string
+		want UserStatus // 空の場合は不正な遷移
+	}{
+		{from: UserStatusInvited, op: "activate", want: UserStatusActive},
+		{from: UserStatusInvited, op: "suspend"},
+		{from: UserStatusInvited, op: "reactivate"},
+		{from: UserStatusInvited, op: "deactivate", want: UserStatusDeactivated},
+		{from: UserStatusActive, op: "activate"},
+		{from: UserStatusActive, op: "suspend", want: UserStatusSuspended},
+		{from: UserStatusActive, op: "reactivate"},
+		{from: UserStatusActive, op: "deactivate", want: UserStatusDeactivated},
+		{from: UserStatusSuspended, op: "activate"},
+		{from: UserStatusSuspended, op: "suspend"},
+		{from: UserStatusSuspended, op: "reactivate", want: UserStatusActive},
+		{from: UserStatusSuspended, op: "deactivate", want: UserStatusDeactivated},
+		{from: UserStatusDeactivated, op: "activate"},
+		{from: UserStatusDeactivated, op: "suspend"},
+		{from: UserStatusDeactivated, op: "reactivate", want: UserStatusActive},
+		{from: UserStatusDeactivated, op: "deactivate"},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.from)+" "+tt.op, func(t *testing.T) {
+			user, err := NewUser("John Doe", "john@example.com")
+			if err != nil {
+				t.Fatalf("NewUser() unexpected error: %v", err)
+			}
+			user.Status = tt.from
+			user.PullEvents()
+
+			err = change[tt.op](user)
+
+			if tt.want == "" {
+				var validationErr *ValidationError
+				if !errors.As(err, &validationErr) || validationErr.Field != "status" {
+					t.Fatalf("%s error = %v, want validation error on status", tt.op, err)
+				}
+				if user.Status != tt.from {
+					t.Errorf("Status = %s, want unchanged %s", user.Status, tt.from)
+				}
+				if events := user.PullEvents(); len(events) != 0 {
+					t.Errorf("len(events) = %d, want 0", len(events))
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("%s unexpected error: %v", tt.op, err)
+			}
+			if user.Status != tt.want {
+				t.Errorf("Status = %s, want %s", user.Status, tt.want)
+			}
+			events := user.PullEvents()
+			if len(events) != 1 || events[0].Type != UserEventTypeUpdated || events[0].User.Status != tt.want {
+				t.Errorf("events = %+v, want one UserUpdated with status %s", events, tt.want)
+			}
+		})
+	}
+}
+
+func TestUser_StatusReason(t *testing.T) {
+	tests := []struct {
+		name   string
+		reason string
+	}{
+		{name: "empty", reason: ""},
+		{name: "too long", reason: strings.Repeat("あ", userStatusReasonMaxLength+1)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			user, err := NewUser("John Doe", "john@example.com")
+			if err != nil {
+				t.Fatalf("NewUser() unexpected error: %v", err)
+			}
+
+			err = user.Suspend(tt.reason)
+
+			var validationErr *ValidationError
+			if !errors.As(err, &validationErr) || validationErr.Field != "reason" {
+				t.Fatalf("Suspend() error = %v, want validation error on reason", err)
+			}
+			if user.Status != UserStatusActive {
+				t.Errorf("Status = %s, want %s", user.Status, UserStatusActive)
+			}
+		})
+	}
+}
@@ -71,50 +71,61 @@ func TestNewUser(t *testing.T) {
 }
 
 func TestUser_Update(t *testing.T) {
-	user, err := NewUser("John Doe", "john@example.com")
-	if err != nil {
-		t.Fatalf("Failed to create user: %v", err)
-	}
-
-	originalUpdatedAt := user.UpdatedAt
-
 	tests := []struct {
-		name    string
-		newName string
+		name     string
+		newName  string
 		newEmail string
+		wantErr  error
 	}{
 		{
-			name:     "update name and email",
+			name:     "replace name and email",
 			newName:  "Jane Doe",
 			newEmail: "jane@example.com",
 		},
 		{
-			name:     "update name only",
-			newName:  "John Smith",
-			newEmail: "",
-		},
-		{
-			name:     "update email only",
+			name:     "empty name",
 			newName:  "",
 			newEmail: "smith@example.com",
+			wantErr:  ErrNameRequired(),
+		},
+		{
+			name:     "empty email",
+			newName:  "John Smith",
+			newEmail: "",
+			wantErr:  ErrEmailRequired(),
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			err := user.Update(tt.newName, tt.newEmail)
+			user, err := NewUser("John Doe", "john@example.com")
 			if err != nil {
-				t.Errorf("Update() unexpected error: %v", err)
+				t.Fatalf("Failed to create user: %v", err)
 			}
+			user.PullEvents()
+			originalUpdatedAt := user.UpdatedAt
+
+			err = user.Update(tt.newName, tt.newEmail)
 
-			if tt.newName != "" && user.Name != tt.newName {
+			if tt.wantErr != nil {
+				if err == nil || err.Error() != tt.wantErr.Error() {
+					t.Fatalf("Update() error = %v, want %v", err, tt.wantErr)
+				}
+				// 失敗した場合は値もイベントも変わらない
+				if user.Name != "John Doe" || user.Email != "john@example.com" || len(user.PullEvents()) != 0 {
+					t.Errorf("Update() changed user on error: %+v", user)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Update() unexpected error: %v", err)
+			}
+			if user.Name != tt.newName {
 				t.Errorf("Update() name = %v, want %v", user.Name, tt.newName)
 			}
-
-			if tt.newEmail != "" && user.Email != tt.newEmail {
+			if user.Email != tt.newEmail {
 				t.Errorf("Update() email = %v, want %v", user.Email, tt.newEmail)
 			}
-
 			if !user.UpdatedAt.After(originalUpdatedAt) {
 				t.Error("Update() UpdatedAt should be updated")
 			}
@@ -0,0 +1,91 @@
+package domain
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+)
+
+// UserTokenPurpose ユーザーに送るトークンの用途
+type UserTokenPurpose string
+
+const (
+	// UserTokenPurposeEmailVerification メールアドレスの確認
+	UserTokenPurposeEmailVerification UserTokenPurpose = "email_verification"
+	// UserTokenPurposePasswordReset パスワードのリセット
+	UserTokenPurposePasswordReset UserTokenPurpose = "password_reset"
+	// UserTokenPurposeMFAChallenge 二要素認証のログインの2段階目（パスワードの照合後に発行する）
+	UserTokenPurposeMFAChallenge UserTokenPurpose = "mfa_challenge"
+)
+
+// userTokenBytes トークンのランダムなバイト数
+const userTokenBytes = 32
+
+// UserToken メールで送る一度限りのトークン（二要素認証のログインの2段階目のトークンにも使用する）
+//
+// トークンそのものは保存せず、SHA-256 のハッシュで照合する（十分なエントロピーがあるため低速なハッシュは不要）。
+type UserToken struct {
+	ID      string
+	UserID  string
+	Purpose UserTokenPurpose
+	// TokenHash はトークンの SHA-256（16進数）
+	TokenHash string
+	// Email は発行時のメールアドレス（変更後は確認のトークンとして使えない）
+	Email     string
+	ExpiresAt time.Time
+	// UsedAt は使用または無効化された時刻（未使用の場合はゼロ値）
+	UsedAt    time.Time
+	CreatedAt time.Time
+}
+
+// NewUserToken ユーザーのトークンを作成し、メールで送るトークンと共に返す
+func NewUserToken(user *User, purpose UserTokenPurpose, ttl time.Duration) (*UserToken, string, error) {
+	token, err := newSecretToken()
+	if err != nil {
+		return nil, "", err
+	}
+
+	now := time.Now()
+	return &UserToken{
+		ID:        ulid.MustNew(ulid.Timestamp(now), rand.Reader).String(),
+		UserID:    user.ID,
+		Purpose:   purpose,
+		TokenHash: HashUserToken(token),
+		Email:     user.Email,
+		ExpiresAt: now.Add(ttl),
+		CreatedAt: now,
+	}, token, nil
+}
+
+// newSecretToken メールで送るランダムなトークンを作成する
+func newSecretToken() (string, error) {
+	b := make([]byte, userTokenBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// HashUserToken トークンを保存・照合用のハッシュに変換する（招待のトークンにも使用する）
+func HashUserToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// IsUsable 時刻 now に使用できるかどうか（未使用かつ有効期限内）
+func (t *UserToken) IsUsable(now time.Time) bool {
+	return t.UsedAt.IsZero() && now.Before(t.ExpiresAt)
+}
+
+// Use トークンを使用済みにする（使用できない場合は ErrUserTokenInvalid）
+func (t *UserToken) Use(now time.Time) error {
+	if !t.IsUsable(now) {
+		return ErrUserTokenInvalid()
+	}
+	t.UsedAt = now
+	return nil
+}
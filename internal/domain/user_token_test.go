@@ -0,0 +1,106 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestNewUserToken(t *testing.T) {
+	user, err := NewUser("John Doe", "john@example.com")
+	if err != nil {
+		t.Fatalf("NewUser() unexpected error: %v", err)
+	}
+
+	token, secret, err := NewUserToken(user, UserTokenPurposeEmailVerification, time.Hour)
+	if err != nil {
+		t.Fatalf("NewUserToken() unexpected error: %v", err)
+	}
+	if token.UserID != user.ID || token.Email != user.Email || token.Purpose != UserTokenPurposeEmailVerification {
+		t.Errorf("NewUserToken() = %+v, want token for %s", token, user.ID)
+	}
+	if token.TokenHash != HashUserToken(secret) || token.TokenHash == secret {
+		t.Errorf("TokenHash = %q, want hash of the secret", token.TokenHash)
+	}
+	if got := token.ExpiresAt.Sub(token.CreatedAt); got != time.Hour {
+		t.Errorf("ExpiresAt - CreatedAt = %v, want 1h", got)
+	}
+
+	_, other, err := NewUserToken(user, UserTokenPurposeEmailVerification, time.Hour)
+	if err != nil {
+		t.Fatalf("NewUserToken() unexpected error: %v", err)
+	}
+	if other == secret {
+		t.Error("NewUserToken() returned the same secret twice")
+	}
+}
+
+func TestUserToken_Use(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name    string
+		token   UserToken
+		wantErr bool
+	}{
+		{name: "usable", token: UserToken{ExpiresAt: now.Add(time.Minute)}},
+		{name: "expired", token: UserToken{ExpiresAt: now}, wantErr: true},
+		{name: "already used", token: UserToken{ExpiresAt: now.Add(time.Minute), UsedAt: now.Add(-time.Minute)}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			token := tt.token
+			err := token.Use(now)
+			if !tt.wantErr {
+				if err != nil {
+					t.Fatalf("Use() unexpected error: %v", err)
+				}
+				if !token.UsedAt.Equal(now) {
+					t.Errorf("UsedAt = %v, want %v", token.UsedAt, now)
+				}
+				// 2回目は使用できない
+				if err := token.Use(now); err == nil {
+					t.Error("Use() twice succeeded, want error")
+				}
+				return
+			}
+			var validationErr *ValidationError
+			if !errors.As(err, &validationErr) || validationErr.Field != "token" {
+				t.Errorf("Use() error = %v, want validation error on token", err)
+			}
+		})
+	}
+}
+
+func TestUser_VerifyEmail(t *testing.T) {
+	user, err := NewUser("John Doe", "john@example.com")
+	if err != nil {
+		t.Fatalf("NewUser() unexpected error: %v", err)
+	}
+	user.PullEvents()
+
+	now := time.Now()
+	user.VerifyEmail(now)
+	if !user.IsEmailVerified() || !user.EmailVerifiedAt.Equal(now) {
+		t.Fatalf("EmailVerifiedAt = %v, want %v", user.EmailVerifiedAt, now)
+	}
+	if events := user.PullEvents(); len(events) != 1 || events[0].Type != UserEventTypeUpdated {
+		t.Errorf("events = %+v, want one %s event", events, UserEventTypeUpdated)
+	}
+
+	// 名前だけの変更では確認済みのまま
+	if err := user.Update("Jane Doe", "john@example.com"); err != nil {
+		t.Fatalf("Update() unexpected error: %v", err)
+	}
+	if !user.IsEmailVerified() {
+		t.Error("IsEmailVerified() = false after changing only the name, want true")
+	}
+
+	// メールアドレスを変更すると未確認に戻る
+	if err := user.Update("Jane Doe", "jane@example.com"); err != nil {
+		t.Fatalf("Update() unexpected error: %v", err)
+	}
+	if user.IsEmailVerified() {
+		t.Error("IsEmailVerified() = true after changing the email, want false")
+	}
+}
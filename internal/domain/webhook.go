@@ -0,0 +1,175 @@
+package domain
+
+import (
+	"crypto/rand"
+	"net/netip"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+)
+
+const (
+	// WebhookSecretMinLength 署名用シークレットの最小長
+	WebhookSecretMinLength = 16
+	// WebhookSecretMaxLength 署名用シークレットの最大長
+	WebhookSecretMaxLength = 256
+	// webhookURLMaxLength コールバックURLの最大長
+	webhookURLMaxLength = 2048
+)
+
+// WebhookSubscription 外部へのイベント通知の購読
+type WebhookSubscription struct {
+	ID string
+	// URL はイベントを POST するコールバックURL（http / https）
+	URL string
+	// Secret はペイロードの HMAC-SHA256 署名に使う共有シークレット
+	Secret string
+	// EventTypes は配信対象のイベント種別
+	EventTypes []UserEventType
+	// Active が false の場合、新しいイベントの配信を作成しない
+	Active    bool
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// NewWebhookSubscription Webhook購読を作成
+func NewWebhookSubscription(callbackURL, secret string, eventTypes []UserEventType, active bool) (*WebhookSubscription, error) {
+	if err := validateWebhookURL(callbackURL); err != nil {
+		return nil, err
+	}
+	if err := validateWebhookSecret(secret); err != nil {
+		return nil, err
+	}
+	types, err := normalizeWebhookEventTypes(eventTypes)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	return &WebhookSubscription{
+		ID:         ulid.MustNew(ulid.Timestamp(now), rand.Reader).String(),
+		URL:        callbackURL,
+		Secret:     secret,
+		EventTypes: types,
+		Active:     active,
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}, nil
+}
+
+// Update Webhook購読を更新（空文字・nil の項目は変更しない）
+func (s *WebhookSubscription) Update(callbackURL, secret string, eventTypes []UserEventType, active *bool) error {
+	if callbackURL != "" {
+		if err := validateWebhookURL(callbackURL); err != nil {
+			return err
+		}
+	}
+	if secret != "" {
+		if err := validateWebhookSecret(secret); err != nil {
+			return err
+		}
+	}
+	var types []UserEventType
+	if eventTypes != nil {
+		normalized, err := normalizeWebhookEventTypes(eventTypes)
+		if err != nil {
+			return err
+		}
+		types = normalized
+	}
+
+	if callbackURL != "" {
+		s.URL = callbackURL
+	}
+	if secret != "" {
+		s.Secret = secret
+	}
+	if types != nil {
+		s.EventTypes = types
+	}
+	if active != nil {
+		s.Active = *active
+	}
+	s.UpdatedAt = time.Now()
+	return nil
+}
+
+// Subscribes 購読が有効で、指定したイベント種別を配信対象に含むか
+func (s *WebhookSubscription) Subscribes(eventType UserEventType) bool {
+	if !s.Active {
+		return false
+	}
+	for _, t := range s.EventTypes {
+		if t == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+// webhookSharedAddressSpace キャリアグレード NAT の共有アドレス空間（RFC 6598）
+var webhookSharedAddressSpace = netip.MustParsePrefix("100.64.0.0/10")
+
+// WebhookAddressAllowed Webhook の配信先として許可する IP アドレスか
+//
+// ループバック・リンクローカル（クラウドのメタデータの 169.254.169.254 を含む）・プライベート・共有アドレス空間・
+// マルチキャスト・未指定のアドレスは許可しない（内部のサービスにリクエストを送らせる SSRF を防ぐため）。
+func WebhookAddressAllowed(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	return addr.IsGlobalUnicast() && !addr.IsPrivate() && !webhookSharedAddressSpace.Contains(addr)
+}
+
+// validateWebhookURL コールバックURLを検証
+//
+// ホストが IP アドレスまたは localhost の場合は WebhookAddressAllowed で検証する。ホスト名の解決先は
+// 配信時に検証する（登録後に DNS の応答が変わる場合があるため）。
+func validateWebhookURL(callbackURL string) error {
+	if callbackURL == "" {
+		return ErrWebhookURLRequired()
+	}
+	if len(callbackURL) > webhookURLMaxLength {
+		return ErrWebhookURLInvalid(callbackURL)
+	}
+	u, err := url.Parse(callbackURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return ErrWebhookURLInvalid(callbackURL)
+	}
+	host := strings.TrimSuffix(strings.ToLower(u.Hostname()), ".")
+	if host == "localhost" || strings.HasSuffix(host, ".localhost") {
+		return ErrWebhookURLNotAllowed(callbackURL)
+	}
+	if addr, err := netip.ParseAddr(host); err == nil && !WebhookAddressAllowed(addr) {
+		return ErrWebhookURLNotAllowed(callbackURL)
+	}
+	return nil
+}
+
+// validateWebhookSecret 署名用シークレットの長さを検証
+func validateWebhookSecret(secret string) error {
+	if len(secret) < WebhookSecretMinLength || len(secret) > WebhookSecretMaxLength {
+		return ErrWebhookSecretLength()
+	}
+	return nil
+}
+
+// normalizeWebhookEventTypes イベント種別を検証し、重複を取り除く
+func normalizeWebhookEventTypes(eventTypes []UserEventType) ([]UserEventType, error) {
+	if len(eventTypes) == 0 {
+		return nil, ErrWebhookEventsRequired()
+	}
+	seen := make(map[UserEventType]bool, len(eventTypes))
+	result := make([]UserEventType, 0, len(eventTypes))
+	for _, t := range eventTypes {
+		if !t.Valid() {
+			return nil, ErrWebhookEventTypeUnknown(string(t))
+		}
+		if seen[t] {
+			continue
+		}
+		seen[t] = true
+		result = append(result, t)
+	}
+	return result, nil
+}
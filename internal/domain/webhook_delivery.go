@@ -0,0 +1,93 @@
+package domain
+
+import (
+	"crypto/rand"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+)
+
+// WebhookDeliveryStatus Webhook配信の状態
+type WebhookDeliveryStatus string
+
+const (
+	// WebhookDeliveryStatusPending 初回配信またはリトライ待ち
+	WebhookDeliveryStatusPending WebhookDeliveryStatus = "pending"
+	// WebhookDeliveryStatusSucceeded 2xx 応答で配信済み
+	WebhookDeliveryStatusSucceeded WebhookDeliveryStatus = "succeeded"
+	// WebhookDeliveryStatusDead リトライ上限に達した（デッドレター）
+	WebhookDeliveryStatusDead WebhookDeliveryStatus = "dead"
+)
+
+// Valid 定義済みの配信状態か
+func (s WebhookDeliveryStatus) Valid() bool {
+	switch s {
+	case WebhookDeliveryStatusPending, WebhookDeliveryStatusSucceeded, WebhookDeliveryStatusDead:
+		return true
+	}
+	return false
+}
+
+// WebhookDelivery 購読ごとのイベント配信（配信ログを兼ねる）
+type WebhookDelivery struct {
+	ID             string
+	SubscriptionID string
+	EventID        string
+	EventType      UserEventType
+	// Payload は受信者に POST する JSON ボディ
+	Payload []byte
+	Status  WebhookDeliveryStatus
+	// Attempts はこれまでに行った配信の試行回数
+	Attempts int
+	// LastStatusCode は直近の試行の HTTP ステータス（応答がなかった場合は 0）
+	LastStatusCode int
+	// LastError は直近の失敗の内容（成功時は空）
+	LastError string
+	// NextAttemptAt は次回の配信時刻（pending の場合のみ意味を持つ）
+	NextAttemptAt time.Time
+	// DeliveredAt は配信に成功した時刻（未配信の場合はゼロ値）
+	DeliveredAt time.Time
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// NewWebhookDelivery 配信待ちのWebhook配信を作成
+func NewWebhookDelivery(subscriptionID, eventID string, eventType UserEventType, payload []byte, now time.Time) *WebhookDelivery {
+	return &WebhookDelivery{
+		ID:             ulid.MustNew(ulid.Timestamp(now), rand.Reader).String(),
+		SubscriptionID: subscriptionID,
+		EventID:        eventID,
+		EventType:      eventType,
+		Payload:        payload,
+		Status:         WebhookDeliveryStatusPending,
+		NextAttemptAt:  now,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	}
+}
+
+// Succeed 配信成功を記録
+func (d *WebhookDelivery) Succeed(statusCode int, now time.Time) {
+	d.Attempts++
+	d.Status = WebhookDeliveryStatusSucceeded
+	d.LastStatusCode = statusCode
+	d.LastError = ""
+	d.DeliveredAt = now
+	d.UpdatedAt = now
+}
+
+// Fail 配信失敗を記録
+//
+// 試行回数が maxAttempts に達した場合はデッドレターにし、それ以外は nextAttemptAt に再配信する。
+// statusCode は応答がなかった場合 0 を渡す。
+func (d *WebhookDelivery) Fail(statusCode int, reason string, now, nextAttemptAt time.Time, maxAttempts int) {
+	d.Attempts++
+	d.LastStatusCode = statusCode
+	d.LastError = reason
+	d.UpdatedAt = now
+	if d.Attempts >= maxAttempts {
+		d.Status = WebhookDeliveryStatusDead
+		return
+	}
+	d.NextAttemptAt = nextAttemptAt
+}
@@ -0,0 +1,105 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+const testWebhookSecret = "0123456789abcdef"
+
+func TestNewWebhookSubscription(t *testing.T) {
+	tests := []struct {
+		name      string
+		url       string
+		secret    string
+		events    []UserEventType
+		wantField string
+	}{
+		{name: "valid", url: "https://example.com/hooks", secret: testWebhookSecret, events: []UserEventType{UserEventTypeCreated}},
+		{name: "empty url", url: "", secret: testWebhookSecret, events: []UserEventType{UserEventTypeCreated}, wantField: "url"},
+		{name: "relative url", url: "/hooks", secret: testWebhookSecret, events: []UserEventType{UserEventTypeCreated}, wantField: "url"},
+		{name: "unsupported scheme", url: "ftp://example.com/hooks", secret: testWebhookSecret, events: []UserEventType{UserEventTypeCreated}, wantField: "url"},
+		{name: "public ip", url: "https://93.184.216.34/hooks", secret: testWebhookSecret, events: []UserEventType{UserEventTypeCreated}},
+		{name: "localhost", url: "http://localhost:8080/hooks", secret: testWebhookSecret, events: []UserEventType{UserEventTypeCreated}, wantField: "url"},
+		{name: "loopback", url: "http://127.0.0.1/hooks", secret: testWebhookSecret, events: []UserEventType{UserEventTypeCreated}, wantField: "url"},
+		{name: "ipv6 loopback", url: "http://[::1]/hooks", secret: testWebhookSecret, events: []UserEventType{UserEventTypeCreated}, wantField: "url"},
+		{name: "cloud metadata", url: "http://169.254.169.254/latest/meta-data", secret: testWebhookSecret, events: []UserEventType{UserEventTypeCreated}, wantField: "url"},
+		{name: "private network", url: "http://10.0.0.5/hooks", secret: testWebhookSecret, events: []UserEventType{UserEventTypeCreated}, wantField: "url"},
+		{name: "ipv4-mapped private", url: "http://[::ffff:192.168.0.1]/hooks", secret: testWebhookSecret, events: []UserEventType{UserEventTypeCreated}, wantField: "url"},
+		{name: "short secret", url: "https://example.com/hooks", secret: "short", events: []UserEventType{UserEventTypeCreated}, wantField: "secret"},
+		{name: "no events", url: "https://example.com/hooks", secret: testWebhookSecret, wantField: "events"},
+		{name: "unknown event", url: "https://example.com/hooks", secret: testWebhookSecret, events: []UserEventType{"UserRenamed"}, wantField: "events"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sub, err := NewWebhookSubscription(tt.url, tt.secret, tt.events, true)
+			if tt.wantField == "" {
+				if err != nil {
+					t.Fatalf("NewWebhookSubscription() unexpected error: %v", err)
+				}
+				if sub.ID == "" || !sub.Active {
+					t.Errorf("NewWebhookSubscription() = %+v, want ID and Active", sub)
+				}
+				return
+			}
+			var validationErr *ValidationError
+			if !errors.As(err, &validationErr) || validationErr.Field != tt.wantField {
+				t.Fatalf("NewWebhookSubscription() error = %v, want validation error on %s", err, tt.wantField)
+			}
+		})
+	}
+}
+
+func TestWebhookSubscription_Subscribes(t *testing.T) {
+	sub, err := NewWebhookSubscription("https://example.com/hooks", testWebhookSecret,
+		[]UserEventType{UserEventTypeCreated, UserEventTypeCreated, UserEventTypeDeleted}, true)
+	if err != nil {
+		t.Fatalf("NewWebhookSubscription() unexpected error: %v", err)
+	}
+	if len(sub.EventTypes) != 2 {
+		t.Errorf("EventTypes = %v, want duplicates removed", sub.EventTypes)
+	}
+	if !sub.Subscribes(UserEventTypeCreated) || sub.Subscribes(UserEventTypeUpdated) {
+		t.Errorf("Subscribes() does not follow EventTypes %v", sub.EventTypes)
+	}
+
+	inactive := false
+	if err := sub.Update("", "", nil, &inactive); err != nil {
+		t.Fatalf("Update() unexpected error: %v", err)
+	}
+	if sub.Subscribes(UserEventTypeCreated) {
+		t.Error("inactive subscription should not subscribe to any event")
+	}
+}
+
+func TestWebhookDelivery_Fail(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	d := NewWebhookDelivery("sub", "event", UserEventTypeCreated, []byte(`{}`), now)
+
+	retryAt := now.Add(time.Minute)
+	d.Fail(500, "server error", now, retryAt, 2)
+	if d.Status != WebhookDeliveryStatusPending || d.Attempts != 1 || !d.NextAttemptAt.Equal(retryAt) {
+		t.Fatalf("after first failure = %+v, want pending retry at %v", d, retryAt)
+	}
+
+	d.Fail(0, "connection refused", now, now.Add(2*time.Minute), 2)
+	if d.Status != WebhookDeliveryStatusDead || d.Attempts != 2 {
+		t.Errorf("after max attempts = %+v, want dead", d)
+	}
+	if d.LastStatusCode != 0 || d.LastError != "connection refused" {
+		t.Errorf("last result = (%d, %q), want (0, connection refused)", d.LastStatusCode, d.LastError)
+	}
+}
+
+func TestWebhookDelivery_Succeed(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	d := NewWebhookDelivery("sub", "event", UserEventTypeCreated, []byte(`{}`), now)
+	d.Fail(503, "unavailable", now, now.Add(time.Second), 5)
+
+	d.Succeed(204, now.Add(time.Second))
+	if d.Status != WebhookDeliveryStatusSucceeded || d.Attempts != 2 || d.LastError != "" || d.DeliveredAt.IsZero() {
+		t.Errorf("after success = %+v, want succeeded with cleared error", d)
+	}
+}
@@ -0,0 +1,37 @@
+// Package eventstream は outbox に書き込まれたドメインイベントをプロセス内の購読者にファンアウトする
+//
+// 各レプリカの Hub は outbox を seq 順に追従し、新しいイベントを購読者（SSE 接続など）に配る。
+// コミットは PostgreSQL の LISTEN/NOTIFY（outbox.NotifyChannel）で通知され、通知を取りこぼした場合に
+// 備えて一定間隔のポーリングも併用する。outbox がレプリカ間で共有されるため、どのレプリカで
+// コミットされた変更もすべてのレプリカの購読者に届く。
+//
+// 購読者は最後に受け取ったイベントの seq を指定すると、outbox に残っている範囲で取りこぼした
+// イベントを再送（Replay）してから追従を始められる。配信済みイベントが保持期間を過ぎて削除されている
+// 場合は Subscription.Truncated が true になるため、購読者は状態を取り直すこと。
+//
+// BIGSERIAL の seq は採番順でありコミット順ではないため、追従中に欠番を見つけた場合は実行中の
+// トランザクションのコミットを一定時間（WithGapTimeout）待ってから先に進む。ロールバックされた
+// 欠番は待機後に読み飛ばす。
+package eventstream
+
+import (
+	"context"
+	"errors"
+
+	"github.com/example/go-react-cqrs-template/internal/outbox"
+)
+
+var (
+	// ErrHubClosed は Hub が停止したことを表す
+	ErrHubClosed = errors.New("event stream hub closed")
+	// ErrSlowSubscriber は購読者の受信が追いつかずに購読を打ち切ったことを表す
+	ErrSlowSubscriber = errors.New("event stream subscriber is too slow")
+)
+
+// Repository outbox のイベントを seq 順に読み出す
+type Repository interface {
+	// ListAfter は afterSeq より大きい seq のイベントを seq 順に最大 limit 件返す
+	ListAfter(ctx context.Context, afterSeq int64, limit int) ([]outbox.Event, error)
+	// Bounds は outbox に残っているイベントの最小・最大の seq を返す（空の場合はどちらも 0）
+	Bounds(ctx context.Context) (minSeq, maxSeq int64, err error)
+}
@@ -0,0 +1,334 @@
+package eventstream
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/example/go-react-cqrs-template/internal/outbox"
+	"github.com/example/go-react-cqrs-template/internal/tenant"
+)
+
+// Hub outbox を追従し、新しいイベントを購読者にファンアウトする
+type Hub struct {
+	repo Repository
+	log  *slog.Logger
+
+	batchSize    int
+	pollInterval time.Duration
+	gapTimeout   time.Duration
+	bufferSize   int
+	now          func() time.Time
+
+	// ready は追従を始める位置（cursor）が決まったときに閉じられる
+	ready chan struct{}
+
+	mu          sync.Mutex
+	cursor      int64
+	gapSince    time.Time
+	subscribers map[*Subscription]struct{}
+	closed      bool
+}
+
+// Option はHubの設定を変更する
+type Option func(*Hub)
+
+// WithBatchSize 一度に outbox から読み出すイベント数の上限を設定する
+func WithBatchSize(n int) Option {
+	return func(h *Hub) {
+		if n > 0 {
+			h.batchSize = n
+		}
+	}
+}
+
+// WithPollInterval NOTIFY を取りこぼした場合に備えたポーリング間隔を設定する
+func WithPollInterval(d time.Duration) Option {
+	return func(h *Hub) {
+		if d > 0 {
+			h.pollInterval = d
+		}
+	}
+}
+
+// WithGapTimeout seq の欠番を見つけたときに未コミットのトランザクションを待つ時間を設定する
+func WithGapTimeout(d time.Duration) Option {
+	return func(h *Hub) {
+		if d >= 0 {
+			h.gapTimeout = d
+		}
+	}
+}
+
+// WithBufferSize 購読者ごとの未受信イベントの上限を設定する（超えた購読者は切断される）
+func WithBufferSize(n int) Option {
+	return func(h *Hub) {
+		if n > 0 {
+			h.bufferSize = n
+		}
+	}
+}
+
+// withClock テスト用に現在時刻の取得関数を差し替える
+func withClock(now func() time.Time) Option {
+	return func(h *Hub) {
+		h.now = now
+	}
+}
+
+// NewHub Hubのコンストラクタ
+func NewHub(repo Repository, log *slog.Logger, opts ...Option) *Hub {
+	h := &Hub{
+		repo:         repo,
+		log:          log,
+		batchSize:    100,
+		pollInterval: time.Second,
+		gapTimeout:   3 * time.Second,
+		bufferSize:   256,
+		now:          time.Now,
+		ready:        make(chan struct{}),
+		subscribers:  make(map[*Subscription]struct{}),
+	}
+	for _, opt := range opts {
+		opt(h)
+	}
+	return h
+}
+
+// Run コンテキストがキャンセルされるまで outbox を追従する
+//
+// wake への送信（NOTIFY の受信）ごとに直ちに追従し、それ以外はポーリング間隔ごとに追従する。
+// 停止時にはすべての購読を ErrHubClosed で終了させる。
+func (h *Hub) Run(ctx context.Context, wake <-chan struct{}) error {
+	h.log.Info("event stream hub started",
+		slog.Duration("poll_interval", h.pollInterval),
+		slog.Duration("gap_timeout", h.gapTimeout),
+	)
+	defer h.close()
+
+	ticker := time.NewTicker(h.pollInterval)
+	defer ticker.Stop()
+	for {
+		if err := h.Poll(ctx); err != nil && ctx.Err() == nil {
+			h.log.Error("failed to poll event stream", slog.String("error", err.Error()))
+		}
+
+		select {
+		case <-ctx.Done():
+			h.log.Info("event stream hub stopped")
+			return nil
+		case <-wake:
+		case <-ticker.C:
+		}
+	}
+}
+
+// Poll outbox の新しいイベントを読み出して購読者に配る
+//
+// 初回は outbox の末尾を追従の開始位置とし、それ以前のイベントは購読者の Replay でのみ配信する。
+func (h *Hub) Poll(ctx context.Context) error {
+	select {
+	case <-h.ready:
+	default:
+		_, maxSeq, err := h.repo.Bounds(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to initialize event stream cursor: %w", err)
+		}
+		h.mu.Lock()
+		h.cursor = maxSeq
+		h.mu.Unlock()
+		close(h.ready)
+		return nil
+	}
+
+	for {
+		h.mu.Lock()
+		cursor := h.cursor
+		h.mu.Unlock()
+
+		events, err := h.repo.ListAfter(ctx, cursor, h.batchSize)
+		if err != nil {
+			return fmt.Errorf("failed to list outbox events: %w", err)
+		}
+		if !h.broadcast(events) || len(events) < h.batchSize {
+			return nil
+		}
+	}
+}
+
+// broadcast イベントを seq 順に購読者に配り、cursor を進める
+//
+// 欠番の待機中で途中までしか進めなかった場合は false を返す。
+func (h *Hub) broadcast(events []outbox.Event) bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for _, event := range events {
+		if event.Seq != h.cursor+1 {
+			now := h.now()
+			if h.gapSince.IsZero() {
+				h.gapSince = now
+			}
+			if now.Sub(h.gapSince) < h.gapTimeout {
+				return false
+			}
+			h.log.Debug("skipping outbox sequence gap",
+				slog.Int64("from", h.cursor+1),
+				slog.Int64("to", event.Seq-1),
+			)
+		}
+		h.gapSince = time.Time{}
+		h.cursor = event.Seq
+
+		for sub := range h.subscribers {
+			if event.Seq <= sub.after || event.OrgID != sub.orgID {
+				continue
+			}
+			select {
+			case sub.events <- event:
+			default:
+				h.log.Warn("dropping slow event stream subscriber",
+					slog.Int64("seq", event.Seq),
+				)
+				h.removeLocked(sub, ErrSlowSubscriber)
+			}
+		}
+	}
+	return true
+}
+
+// Subscribe 購読を開始する
+//
+// コンテキストの組織（tenant.OrgID）で発生したイベントのみを配信する。
+// lastSeq を指定した場合、それより後で購読開始時点までのイベントを Subscription.Replay で再送できる。
+// 追従の開始位置が決まるまで（Run の初回のポーリングまで）待機する。
+func (h *Hub) Subscribe(ctx context.Context, lastSeq *int64) (*Subscription, error) {
+	select {
+	case <-h.ready:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+
+	h.mu.Lock()
+	if h.closed {
+		h.mu.Unlock()
+		return nil, ErrHubClosed
+	}
+	sub := &Subscription{
+		hub:        h,
+		orgID:      tenant.OrgID(ctx),
+		events:     make(chan outbox.Event, h.bufferSize),
+		replayFrom: h.cursor,
+		replayTo:   h.cursor,
+	}
+	if lastSeq != nil {
+		if *lastSeq < h.cursor {
+			sub.replayFrom = *lastSeq
+		} else {
+			// 他のレプリカの方が追従が進んでいた場合は、既に受け取ったイベントを送らない
+			sub.after = *lastSeq
+		}
+	}
+	h.subscribers[sub] = struct{}{}
+	h.mu.Unlock()
+
+	if sub.replayFrom < sub.replayTo {
+		minSeq, _, err := h.repo.Bounds(ctx)
+		if err != nil {
+			sub.Close()
+			return nil, fmt.Errorf("failed to get outbox bounds: %w", err)
+		}
+		// 配信済みイベントが削除されている場合は取りこぼしを再送できない
+		sub.truncated = minSeq == 0 || sub.replayFrom+1 < minSeq
+	}
+	return sub, nil
+}
+
+// removeLocked 購読を取り除き、受信チャネルを閉じる（h.mu を保持して呼び出す）
+func (h *Hub) removeLocked(sub *Subscription, reason error) {
+	if _, ok := h.subscribers[sub]; !ok {
+		return
+	}
+	delete(h.subscribers, sub)
+	sub.err = reason
+	close(sub.events)
+}
+
+// close すべての購読を終了し、以降の購読を拒否する
+func (h *Hub) close() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.closed = true
+	for sub := range h.subscribers {
+		h.removeLocked(sub, ErrHubClosed)
+	}
+}
+
+// Subscription Hub への購読
+type Subscription struct {
+	hub    *Hub
+	orgID  string
+	events chan outbox.Event
+	err    error
+
+	// after 以下の seq のイベントは配信しない
+	after      int64
+	replayFrom int64
+	replayTo   int64
+	truncated  bool
+}
+
+// Events 購読開始後に outbox に追加されたイベントを受け取るチャネル
+//
+// 購読が終了するとチャネルは閉じられ、理由は Err で取得できる。
+func (s *Subscription) Events() <-chan outbox.Event {
+	return s.events
+}
+
+// Err Events が閉じられた理由（ErrSlowSubscriber または ErrHubClosed）
+func (s *Subscription) Err() error {
+	return s.err
+}
+
+// Truncated 再送すべきイベントの一部が outbox から削除済みかどうか
+func (s *Subscription) Truncated() bool {
+	return s.truncated
+}
+
+// Replay Subscribe で指定した seq より後で購読開始時点までの購読者の組織のイベントを seq 順に fn に渡す
+//
+// Events を受信する前に呼び出すこと。fn がエラーを返した場合はそのエラーを返す。
+func (s *Subscription) Replay(ctx context.Context, fn func(outbox.Event) error) error {
+	for s.replayFrom < s.replayTo {
+		events, err := s.hub.repo.ListAfter(ctx, s.replayFrom, s.hub.batchSize)
+		if err != nil {
+			return fmt.Errorf("failed to list outbox events: %w", err)
+		}
+		if len(events) == 0 {
+			break
+		}
+		for _, event := range events {
+			if event.Seq > s.replayTo {
+				s.replayFrom = s.replayTo
+				return nil
+			}
+			if event.OrgID == s.orgID {
+				if err := fn(event); err != nil {
+					return err
+				}
+			}
+			s.replayFrom = event.Seq
+		}
+	}
+	s.replayFrom = s.replayTo
+	return nil
+}
+
+// Close 購読を終了する
+func (s *Subscription) Close() {
+	s.hub.mu.Lock()
+	defer s.hub.mu.Unlock()
+	s.hub.removeLocked(s, nil)
+}
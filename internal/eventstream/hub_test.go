@@ -0,0 +1,355 @@
+package eventstream
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/example/go-react-cqrs-template/internal/outbox"
+	"github.com/example/go-react-cqrs-template/internal/tenant"
+)
+
+// fakeRepository 任意の seq（欠番を含む）のイベントを返す Repository
+type fakeRepository struct {
+	mu     sync.Mutex
+	events []outbox.Event
+}
+
+func (r *fakeRepository) add(seqs ...int64) {
+	r.addInOrg(tenant.DefaultOrgID, seqs...)
+}
+
+// addInOrg 組織 orgID で発生したイベントを追加する
+func (r *fakeRepository) addInOrg(orgID string, seqs ...int64) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, seq := range seqs {
+		r.events = append(r.events, outbox.Event{Seq: seq, OrgID: orgID, AggregateType: "user", Type: "UserCreated"})
+	}
+	// 欠番を後から埋めた場合も seq 順に並べる
+	for i := len(r.events) - 1; i > 0 && r.events[i].Seq < r.events[i-1].Seq; i-- {
+		r.events[i], r.events[i-1] = r.events[i-1], r.events[i]
+	}
+}
+
+func (r *fakeRepository) ListAfter(_ context.Context, afterSeq int64, limit int) ([]outbox.Event, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var result []outbox.Event
+	for _, e := range r.events {
+		if e.Seq > afterSeq && len(result) < limit {
+			result = append(result, e)
+		}
+	}
+	return result, nil
+}
+
+func (r *fakeRepository) Bounds(context.Context) (int64, int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if len(r.events) == 0 {
+		return 0, 0, nil
+	}
+	return r.events[0].Seq, r.events[len(r.events)-1].Seq, nil
+}
+
+func newTestHub(t *testing.T, repo Repository, opts ...Option) *Hub {
+	t.Helper()
+	h := NewHub(repo, slog.New(slog.NewTextHandler(io.Discard, nil)), opts...)
+	// 初回のポーリングで追従の開始位置を決める
+	if err := h.Poll(context.Background()); err != nil {
+		t.Fatalf("Poll() unexpected error: %v", err)
+	}
+	return h
+}
+
+// received チャネルに届いているイベントの seq を取り出す
+func received(sub *Subscription) []int64 {
+	var seqs []int64
+	for {
+		select {
+		case e, ok := <-sub.Events():
+			if !ok {
+				return seqs
+			}
+			seqs = append(seqs, e.Seq)
+		default:
+			return seqs
+		}
+	}
+}
+
+func equalSeqs(a, b []int64) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func TestHub_BroadcastsNewEventsOnly(t *testing.T) {
+	ctx := context.Background()
+	repo := &fakeRepository{}
+	repo.add(1, 2)
+	h := newTestHub(t, repo)
+
+	sub, err := h.Subscribe(ctx, nil)
+	if err != nil {
+		t.Fatalf("Subscribe() unexpected error: %v", err)
+	}
+	defer sub.Close()
+
+	repo.add(3, 4)
+	if err := h.Poll(ctx); err != nil {
+		t.Fatalf("Poll() unexpected error: %v", err)
+	}
+
+	if got := received(sub); !equalSeqs(got, []int64{3, 4}) {
+		t.Errorf("received = %v, want [3 4]", got)
+	}
+}
+
+func TestHub_DeliversEventsOfSubscriberOrganizationOnly(t *testing.T) {
+	const otherOrgID = "01ARZ3NDEKTSV4RRFFQ69G5FAV"
+	repo := &fakeRepository{}
+	repo.add(1)
+	repo.addInOrg(otherOrgID, 2)
+	h := newTestHub(t, repo)
+
+	ctx := tenant.WithOrgID(context.Background(), otherOrgID)
+	lastSeq := int64(0)
+	sub, err := h.Subscribe(ctx, &lastSeq)
+	if err != nil {
+		t.Fatalf("Subscribe() unexpected error: %v", err)
+	}
+	defer sub.Close()
+
+	var replayed []int64
+	if err := sub.Replay(ctx, func(e outbox.Event) error {
+		replayed = append(replayed, e.Seq)
+		return nil
+	}); err != nil {
+		t.Fatalf("Replay() unexpected error: %v", err)
+	}
+	if !equalSeqs(replayed, []int64{2}) {
+		t.Errorf("replayed = %v, want [2]", replayed)
+	}
+
+	repo.add(3)
+	repo.addInOrg(otherOrgID, 4)
+	if err := h.Poll(ctx); err != nil {
+		t.Fatalf("Poll() unexpected error: %v", err)
+	}
+	if got := received(sub); !equalSeqs(got, []int64{4}) {
+		t.Errorf("received = %v, want [4]", got)
+	}
+}
+
+func TestHub_WaitsForSequenceGap(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	repo := &fakeRepository{}
+	repo.add(1)
+	h := newTestHub(t, repo, WithGapTimeout(3*time.Second), withClock(func() time.Time { return now }))
+
+	sub, err := h.Subscribe(ctx, nil)
+	if err != nil {
+		t.Fatalf("Subscribe() unexpected error: %v", err)
+	}
+	defer sub.Close()
+
+	// seq 2 のトランザクションがまだコミットされていない
+	repo.add(3)
+	if err := h.Poll(ctx); err != nil {
+		t.Fatalf("Poll() unexpected error: %v", err)
+	}
+	if got := received(sub); len(got) != 0 {
+		t.Fatalf("received = %v before gap is resolved, want none", got)
+	}
+
+	// 待機中にコミットされれば seq 順に配信する
+	repo.add(2)
+	if err := h.Poll(ctx); err != nil {
+		t.Fatalf("Poll() unexpected error: %v", err)
+	}
+	if got := received(sub); !equalSeqs(got, []int64{2, 3}) {
+		t.Fatalf("received = %v, want [2 3]", got)
+	}
+
+	// ロールバックされた欠番は待機時間を過ぎたら読み飛ばす
+	repo.add(5)
+	if err := h.Poll(ctx); err != nil {
+		t.Fatalf("Poll() unexpected error: %v", err)
+	}
+	now = now.Add(2 * time.Second)
+	if err := h.Poll(ctx); err != nil {
+		t.Fatalf("Poll() unexpected error: %v", err)
+	}
+	if got := received(sub); len(got) != 0 {
+		t.Fatalf("received = %v within gap timeout, want none", got)
+	}
+	now = now.Add(2 * time.Second)
+	if err := h.Poll(ctx); err != nil {
+		t.Fatalf("Poll() unexpected error: %v", err)
+	}
+	if got := received(sub); !equalSeqs(got, []int64{5}) {
+		t.Errorf("received = %v after gap timeout, want [5]", got)
+	}
+}
+
+func TestHub_ReplaysFromLastSeq(t *testing.T) {
+	ctx := context.Background()
+	repo := &fakeRepository{}
+	repo.add(1, 2, 3)
+	h := newTestHub(t, repo, WithBatchSize(2))
+
+	lastSeq := int64(1)
+	sub, err := h.Subscribe(ctx, &lastSeq)
+	if err != nil {
+		t.Fatalf("Subscribe() unexpected error: %v", err)
+	}
+	defer sub.Close()
+	if sub.Truncated() {
+		t.Error("Truncated() = true, want false")
+	}
+
+	// 購読開始後のイベントは Replay ではなく Events で届く
+	repo.add(4)
+	if err := h.Poll(ctx); err != nil {
+		t.Fatalf("Poll() unexpected error: %v", err)
+	}
+
+	var replayed []int64
+	err = sub.Replay(ctx, func(e outbox.Event) error {
+		replayed = append(replayed, e.Seq)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Replay() unexpected error: %v", err)
+	}
+	if !equalSeqs(replayed, []int64{2, 3}) {
+		t.Errorf("replayed = %v, want [2 3]", replayed)
+	}
+	if got := received(sub); !equalSeqs(got, []int64{4}) {
+		t.Errorf("received = %v, want [4]", got)
+	}
+}
+
+func TestHub_ReportsTruncatedReplay(t *testing.T) {
+	ctx := context.Background()
+	repo := &fakeRepository{}
+	// seq 1〜4 は保持期間を過ぎて削除済み
+	repo.add(5, 6)
+	h := newTestHub(t, repo)
+
+	lastSeq := int64(2)
+	sub, err := h.Subscribe(ctx, &lastSeq)
+	if err != nil {
+		t.Fatalf("Subscribe() unexpected error: %v", err)
+	}
+	defer sub.Close()
+
+	if !sub.Truncated() {
+		t.Error("Truncated() = false, want true")
+	}
+}
+
+func TestHub_SkipsEventsAlreadySeenOnAnotherReplica(t *testing.T) {
+	ctx := context.Background()
+	repo := &fakeRepository{}
+	repo.add(1)
+	h := newTestHub(t, repo)
+
+	// 他のレプリカで seq 2 まで受け取ったクライアントが、追従の遅いこのレプリカに再接続した
+	lastSeq := int64(2)
+	sub, err := h.Subscribe(ctx, &lastSeq)
+	if err != nil {
+		t.Fatalf("Subscribe() unexpected error: %v", err)
+	}
+	defer sub.Close()
+
+	repo.add(2, 3)
+	if err := h.Poll(ctx); err != nil {
+		t.Fatalf("Poll() unexpected error: %v", err)
+	}
+	if got := received(sub); !equalSeqs(got, []int64{3}) {
+		t.Errorf("received = %v, want [3]", got)
+	}
+}
+
+func TestHub_DropsSlowSubscriber(t *testing.T) {
+	ctx := context.Background()
+	repo := &fakeRepository{}
+	h := newTestHub(t, repo, WithBufferSize(1))
+
+	sub, err := h.Subscribe(ctx, nil)
+	if err != nil {
+		t.Fatalf("Subscribe() unexpected error: %v", err)
+	}
+
+	repo.add(1, 2)
+	if err := h.Poll(ctx); err != nil {
+		t.Fatalf("Poll() unexpected error: %v", err)
+	}
+
+	if got := received(sub); !equalSeqs(got, []int64{1}) {
+		t.Errorf("received = %v, want [1]", got)
+	}
+	if _, ok := <-sub.Events(); ok {
+		t.Fatal("Events() is still open, want closed")
+	}
+	if !errors.Is(sub.Err(), ErrSlowSubscriber) {
+		t.Errorf("Err() = %v, want %v", sub.Err(), ErrSlowSubscriber)
+	}
+	// 切断済みの購読の Close は何もしない
+	sub.Close()
+}
+
+func TestHub_RunWakesOnNotifyAndClosesSubscriptions(t *testing.T) {
+	repo := &fakeRepository{}
+	h := NewHub(repo, slog.New(slog.NewTextHandler(io.Discard, nil)), WithPollInterval(time.Hour))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	wake := make(chan struct{}, 1)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		h.Run(ctx, wake)
+	}()
+
+	sub, err := h.Subscribe(ctx, nil)
+	if err != nil {
+		t.Fatalf("Subscribe() unexpected error: %v", err)
+	}
+
+	repo.add(1)
+	wake <- struct{}{}
+	select {
+	case e := <-sub.Events():
+		if e.Seq != 1 {
+			t.Errorf("event seq = %d, want 1", e.Seq)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("event was not delivered after wake")
+	}
+
+	cancel()
+	<-done
+	if _, ok := <-sub.Events(); ok {
+		t.Fatal("Events() is still open after Run returned")
+	}
+	if !errors.Is(sub.Err(), ErrHubClosed) {
+		t.Errorf("Err() = %v, want %v", sub.Err(), ErrHubClosed)
+	}
+	if _, err := h.Subscribe(context.Background(), nil); !errors.Is(err, ErrHubClosed) {
+		t.Errorf("Subscribe() after stop error = %v, want %v", err, ErrHubClosed)
+	}
+}
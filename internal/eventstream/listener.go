@@ -0,0 +1,56 @@
+package eventstream
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+// listenerPingInterval 通知のない間も切断を検知するための疎通確認の間隔
+const listenerPingInterval = time.Minute
+
+// Listen PostgreSQL の channel を LISTEN し、通知を受けるたびに返り値のチャネルへ送る
+//
+// 返り値は Hub.Run の wake に渡す。連続した通知はまとめて一回の送信になる。再接続時にも送信するため、
+// 切断中に取りこぼしたコミットも Hub の追従で回収される。ctx がキャンセルされると LISTEN を終了する。
+func Listen(ctx context.Context, dsn, channel string, log *slog.Logger) (<-chan struct{}, error) {
+	listener := pq.NewListener(dsn, time.Second, time.Minute, func(ev pq.ListenerEventType, err error) {
+		if err != nil {
+			log.Warn("event stream listener connection problem",
+				slog.String("channel", channel),
+				slog.String("error", err.Error()),
+			)
+		}
+	})
+	if err := listener.Listen(channel); err != nil {
+		listener.Close()
+		return nil, fmt.Errorf("failed to listen on %s: %w", channel, err)
+	}
+
+	wake := make(chan struct{}, 1)
+	go func() {
+		defer listener.Close()
+		ping := time.NewTicker(listenerPingInterval)
+		defer ping.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-listener.Notify:
+				// 再接続時は nil が届く。どちらの場合も Hub に追従させる
+				select {
+				case wake <- struct{}{}:
+				default:
+				}
+			case <-ping.C:
+				if err := listener.Ping(); err != nil {
+					log.Warn("event stream listener ping failed", slog.String("error", err.Error()))
+				}
+			}
+		}
+	}()
+	return wake, nil
+}
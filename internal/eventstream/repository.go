@@ -0,0 +1,70 @@
+package eventstream
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/example/go-react-cqrs-template/internal/infrastructure"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure/dao"
+	"github.com/example/go-react-cqrs-template/internal/outbox"
+	"github.com/example/go-react-cqrs-template/internal/tenant"
+)
+
+// SQLRepository PostgreSQL の outbox テーブルを読み出す Repository の実装
+//
+// すべての組織のイベントを読み出し（tenant.WithAllOrgs）、購読者の組織への絞り込みは Hub が行う。
+type SQLRepository struct {
+	db *sql.DB
+}
+
+// NewSQLRepository SQLRepositoryのコンストラクタ
+func NewSQLRepository(db *sql.DB) *SQLRepository {
+	return &SQLRepository{db: db}
+}
+
+// ListAfter afterSeq より大きい seq のイベントを seq 順に取得する（配信状態は問わない）
+func (r *SQLRepository) ListAfter(ctx context.Context, afterSeq int64, limit int) ([]outbox.Event, error) {
+	var rows []dao.Outbox
+	err := infrastructure.RunReadOnly(tenant.WithAllOrgs(ctx), r.db, func(tx infrastructure.DBTX) error {
+		var err error
+		rows, err = dao.New(tx).ListOutboxEventsAfter(ctx, dao.ListOutboxEventsAfterParams{
+			Seq:   afterSeq,
+			Limit: int32(limit),
+		})
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list outbox events: %w", err)
+	}
+
+	events := make([]outbox.Event, 0, len(rows))
+	for _, row := range rows {
+		events = append(events, outbox.Event{
+			Seq:           row.Seq,
+			ID:            row.ID,
+			OrgID:         row.OrgID,
+			AggregateType: row.AggregateType,
+			AggregateID:   row.AggregateID,
+			Type:          row.EventType,
+			Payload:       row.Payload,
+			OccurredAt:    row.OccurredAt,
+			Attempts:      int(row.Attempts),
+		})
+	}
+	return events, nil
+}
+
+// Bounds outbox に残っているイベントの最小・最大の seq を取得する
+func (r *SQLRepository) Bounds(ctx context.Context) (int64, int64, error) {
+	var row dao.GetOutboxSeqBoundsRow
+	err := infrastructure.RunReadOnly(tenant.WithAllOrgs(ctx), r.db, func(tx infrastructure.DBTX) error {
+		var err error
+		row, err = dao.New(tx).GetOutboxSeqBounds(ctx)
+		return err
+	})
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to get outbox bounds: %w", err)
+	}
+	return row.MinSeq, row.MaxSeq, nil
+}
@@ -0,0 +1,76 @@
+package eventstream_test
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/example/go-react-cqrs-template/internal/command"
+	"github.com/example/go-react-cqrs-template/internal/eventstream"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure"
+	"github.com/example/go-react-cqrs-template/internal/outbox"
+	"github.com/example/go-react-cqrs-template/internal/queryservice"
+	"github.com/example/go-react-cqrs-template/internal/testutil/dbtest"
+	"github.com/example/go-react-cqrs-template/internal/usecase"
+)
+
+func TestSQLRepository_CommitNotifiesHub(t *testing.T) {
+	db := dbtest.New(t)
+	cfg, _ := dbtest.Config()
+	log := slog.New(slog.NewTextHandler(io.Discard, nil))
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	wake, err := eventstream.Listen(ctx, cfg.DSN(), outbox.NotifyChannel, log)
+	if err != nil {
+		t.Fatalf("Listen() unexpected error: %v", err)
+	}
+	// ポーリングでは追従しないため、イベントが届けば NOTIFY で起こされたことになる
+	hub := eventstream.NewHub(eventstream.NewSQLRepository(db), log, eventstream.WithPollInterval(time.Hour))
+	go hub.Run(ctx, wake)
+
+	sub, err := hub.Subscribe(ctx, nil)
+	if err != nil {
+		t.Fatalf("Subscribe() unexpected error: %v", err)
+	}
+	defer sub.Close()
+
+	tm := infrastructure.NewTransactionManager(db)
+	keyring := dbtest.Keyring(t)
+	query := queryservice.NewUserQueryService(db, keyring)
+	if _, err := usecase.NewCreateUserUsecase(query, command.NewUserRepository(keyring), tm).Execute(ctx, "John Doe", "john@example.com"); err != nil {
+		t.Fatalf("CreateUser unexpected error: %v", err)
+	}
+
+	var created outbox.Event
+	select {
+	case created = <-sub.Events():
+		if created.Type != "UserCreated" {
+			t.Errorf("event type = %q, want UserCreated", created.Type)
+		}
+	case <-time.After(10 * time.Second):
+		t.Fatal("event was not delivered after commit")
+	}
+
+	// 受け取った直前の seq から再接続すると outbox から再送される
+	lastSeq := created.Seq - 1
+	resumed, err := hub.Subscribe(ctx, &lastSeq)
+	if err != nil {
+		t.Fatalf("Subscribe() unexpected error: %v", err)
+	}
+	defer resumed.Close()
+
+	var replayed []outbox.Event
+	err = resumed.Replay(ctx, func(e outbox.Event) error {
+		replayed = append(replayed, e)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Replay() unexpected error: %v", err)
+	}
+	if len(replayed) != 1 || replayed[0].ID != created.ID {
+		t.Errorf("replayed = %+v, want [%s]", replayed, created.ID)
+	}
+}
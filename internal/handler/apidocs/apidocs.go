@@ -0,0 +1,108 @@
+// Package apidocs はOpenAPI仕様の配信とオフラインで動作するAPIエクスプローラーを提供する
+package apidocs
+
+import (
+	"context"
+	"embed"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"net/http"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"gopkg.in/yaml.v3"
+)
+
+//go:embed explorer
+var explorerFS embed.FS
+
+// Handler はOpenAPI仕様とAPIエクスプローラーを配信する
+type Handler struct {
+	doc      *openapi3.T
+	basePath string
+}
+
+// NewHandler は新しい Handler を作成する
+//
+// basePath はAPIのマウント先（例: /api/v1）で、配信する仕様の servers に使用する。
+func NewHandler(openapiSpec []byte, basePath string) (*Handler, error) {
+	loader := openapi3.NewLoader()
+	doc, err := loader.LoadFromData(openapiSpec)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load OpenAPI spec: %w", err)
+	}
+	if err := doc.Validate(context.Background()); err != nil {
+		return nil, fmt.Errorf("invalid OpenAPI spec: %w", err)
+	}
+	return &Handler{doc: doc, basePath: basePath}, nil
+}
+
+// ServeYAML はOpenAPI仕様をYAMLで返す
+func (h *Handler) ServeYAML(w http.ResponseWriter, r *http.Request) {
+	body, err := yaml.Marshal(h.docForRequest(r))
+	if err != nil {
+		http.Error(w, "failed to encode OpenAPI spec", http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/yaml")
+	w.Write(body)
+}
+
+// ServeJSON はOpenAPI仕様をJSONで返す
+func (h *Handler) ServeJSON(w http.ResponseWriter, r *http.Request) {
+	body, err := json.Marshal(h.docForRequest(r))
+	if err != nil {
+		http.Error(w, "failed to encode OpenAPI spec", http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(body)
+}
+
+// Explorer はAPIエクスプローラーの静的ファイルを配信する http.Handler を返す
+//
+// prefix はエクスプローラーのマウント先（例: /docs）。アセットはすべてバイナリに埋め込まれており、外部CDNには依存しない。
+func (h *Handler) Explorer(prefix string) http.Handler {
+	sub, err := fs.Sub(explorerFS, "explorer")
+	if err != nil {
+		// 埋め込みディレクトリは必ず存在する
+		panic(err)
+	}
+	files := http.StripPrefix(prefix, http.FileServer(http.FS(sub)))
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// 相対パスのアセットを解決できるよう末尾スラッシュに揃える
+		if r.URL.Path == prefix {
+			http.Redirect(w, r, prefix+"/", http.StatusMovedPermanently)
+			return
+		}
+		w.Header().Set("Content-Security-Policy", "default-src 'self'; style-src 'self'; script-src 'self'")
+		files.ServeHTTP(w, r)
+	})
+}
+
+// docForRequest はサーバーURLをリクエスト元のホストに書き換えた仕様のコピーを返す
+func (h *Handler) docForRequest(r *http.Request) *openapi3.T {
+	doc := *h.doc
+	doc.Servers = openapi3.Servers{
+		{
+			URL:         requestScheme(r) + "://" + r.Host + h.basePath,
+			Description: "This server",
+		},
+	}
+	return &doc
+}
+
+// requestScheme はリクエストのスキームを判定する（リバースプロキシ経由の場合は X-Forwarded-Proto を優先）
+func requestScheme(r *http.Request) string {
+	if proto := r.Header.Get("X-Forwarded-Proto"); proto != "" {
+		switch p := strings.ToLower(strings.TrimSpace(strings.Split(proto, ",")[0])); p {
+		case "http", "https":
+			return p
+		}
+	}
+	if r.TLS != nil {
+		return "https"
+	}
+	return "http"
+}
@@ -0,0 +1,150 @@
+package apidocs
+
+import (
+	"crypto/tls"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"gopkg.in/yaml.v3"
+
+	openapispec "github.com/example/go-react-cqrs-template/openapi"
+)
+
+func newTestHandler(t *testing.T) *Handler {
+	t.Helper()
+	h, err := NewHandler(openapispec.Spec, "/api/v1")
+	if err != nil {
+		t.Fatalf("failed to create handler: %v", err)
+	}
+	return h
+}
+
+type specServers struct {
+	Servers []struct {
+		URL string `json:"url" yaml:"url"`
+	} `json:"servers" yaml:"servers"`
+	Paths map[string]any `json:"paths" yaml:"paths"`
+}
+
+func TestHandler_ServeJSON(t *testing.T) {
+	h := newTestHandler(t)
+
+	tests := []struct {
+		name    string
+		host    string
+		proto   string
+		tls     bool
+		wantURL string
+	}{
+		{name: "plain http", host: "api.example.com:8080", wantURL: "http://api.example.com:8080/api/v1"},
+		{name: "forwarded https", host: "api.example.com", proto: "https", wantURL: "https://api.example.com/api/v1"},
+		{name: "invalid forwarded proto", host: "api.example.com", proto: "javascript", wantURL: "http://api.example.com/api/v1"},
+		{name: "tls", host: "api.example.com", tls: true, wantURL: "https://api.example.com/api/v1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/openapi.json", nil)
+			req.Host = tt.host
+			if tt.proto != "" {
+				req.Header.Set("X-Forwarded-Proto", tt.proto)
+			}
+			if tt.tls {
+				req.TLS = &tls.ConnectionState{}
+			}
+			rec := httptest.NewRecorder()
+			h.ServeJSON(rec, req)
+
+			if rec.Code != http.StatusOK {
+				t.Fatalf("expected status %d, got %d", http.StatusOK, rec.Code)
+			}
+			if ct := rec.Header().Get("Content-Type"); ct != "application/json" {
+				t.Errorf("Content-Type = %q, want application/json", ct)
+			}
+			var spec specServers
+			if err := json.Unmarshal(rec.Body.Bytes(), &spec); err != nil {
+				t.Fatalf("failed to decode spec: %v", err)
+			}
+			if len(spec.Servers) != 1 || spec.Servers[0].URL != tt.wantURL {
+				t.Errorf("servers = %+v, want %s", spec.Servers, tt.wantURL)
+			}
+			if _, ok := spec.Paths["/users"]; !ok {
+				t.Error("expected /users path in spec")
+			}
+		})
+	}
+}
+
+func TestHandler_ServeYAML(t *testing.T) {
+	h := newTestHandler(t)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/openapi.yaml", nil)
+	req.Host = "docs.example.com"
+	rec := httptest.NewRecorder()
+	h.ServeYAML(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, rec.Code)
+	}
+	var spec specServers
+	if err := yaml.Unmarshal(rec.Body.Bytes(), &spec); err != nil {
+		t.Fatalf("failed to decode spec: %v", err)
+	}
+	if len(spec.Servers) != 1 || spec.Servers[0].URL != "http://docs.example.com/api/v1" {
+		t.Errorf("servers = %+v", spec.Servers)
+	}
+
+	// 埋め込みの仕様自体は書き換えられない
+	if len(h.doc.Servers) != 1 || h.doc.Servers[0].URL != "http://localhost:8080/api/v1" {
+		t.Errorf("original servers modified: %+v", h.doc.Servers)
+	}
+}
+
+func TestHandler_Explorer(t *testing.T) {
+	h := newTestHandler(t)
+	explorer := h.Explorer("/docs")
+
+	tests := []struct {
+		path       string
+		wantStatus int
+		wantBody   string
+	}{
+		{path: "/docs", wantStatus: http.StatusMovedPermanently},
+		{path: "/docs/", wantStatus: http.StatusOK, wantBody: "explorer.js"},
+		{path: "/docs/explorer.js", wantStatus: http.StatusOK, wantBody: "openapi.json"},
+		{path: "/docs/explorer.css", wantStatus: http.StatusOK},
+		{path: "/docs/missing.js", wantStatus: http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, tt.path, nil)
+			rec := httptest.NewRecorder()
+			explorer.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantStatus {
+				t.Errorf("expected status %d, got %d", tt.wantStatus, rec.Code)
+			}
+			if tt.wantBody != "" && !strings.Contains(rec.Body.String(), tt.wantBody) {
+				t.Errorf("expected body to contain %q", tt.wantBody)
+			}
+		})
+	}
+}
+
+func TestExplorer_NoExternalAssets(t *testing.T) {
+	for _, name := range []string{"explorer/index.html", "explorer/explorer.js", "explorer/explorer.css"} {
+		b, err := explorerFS.ReadFile(name)
+		if err != nil {
+			t.Fatalf("failed to read %s: %v", name, err)
+		}
+		for _, s := range []string{"http://", "https://", "//cdn"} {
+			if strings.Contains(string(b), s) {
+				t.Errorf("%s references external resource %q", name, s)
+			}
+		}
+	}
+}
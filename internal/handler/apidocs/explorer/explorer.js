@@ -0,0 +1,189 @@
+// オフラインで動作する最小限のAPIエクスプローラー
+// /api/v1/openapi.json を読み込み、各オペレーションのフォームを生成してリクエストを送信する
+;(function () {
+  'use strict'
+
+  var SPEC_URL = '../api/v1/openapi.json'
+  var METHODS = ['get', 'post', 'put', 'patch', 'delete']
+
+  function el(tag, attrs, children) {
+    var node = document.createElement(tag)
+    Object.keys(attrs || {}).forEach(function (key) {
+      if (key === 'text') {
+        node.textContent = attrs[key]
+      } else {
+        node.setAttribute(key, attrs[key])
+      }
+    })
+    ;(children || []).forEach(function (child) {
+      node.appendChild(child)
+    })
+    return node
+  }
+
+  // $ref を解決する（#/components/... のみ対応）
+  function resolve(spec, schema) {
+    if (!schema || !schema.$ref) return schema
+    return schema.$ref
+      .replace(/^#\//, '')
+      .split('/')
+      .reduce(function (node, key) {
+        return node && node[key]
+      }, spec)
+  }
+
+  // スキーマからリクエストボディのサンプルを生成する
+  function example(spec, schema, depth) {
+    schema = resolve(spec, schema) || {}
+    if (depth > 5) return null
+    if (schema.example !== undefined) return schema.example
+    if (schema.default !== undefined) return schema.default
+    switch (schema.type) {
+      case 'object': {
+        var obj = {}
+        Object.keys(schema.properties || {}).forEach(function (key) {
+          obj[key] = example(spec, schema.properties[key], depth + 1)
+        })
+        return obj
+      }
+      case 'array':
+        return [example(spec, schema.items, depth + 1)]
+      case 'integer':
+      case 'number':
+        return schema.minimum || 0
+      case 'boolean':
+        return false
+      default:
+        if (schema.format === 'email') return 'user@example.com'
+        if (schema.format === 'date-time') return new Date().toISOString()
+        return 'string'
+    }
+  }
+
+  function renderOperation(spec, baseURL, path, method, op) {
+    var params = (op.parameters || []).map(function (p) {
+      return resolve(spec, p)
+    })
+    var jsonBody =
+      op.requestBody &&
+      op.requestBody.content &&
+      op.requestBody.content['application/json']
+
+    var form = el('form')
+    var inputs = {}
+    params.forEach(function (p) {
+      var input = el('input', { name: p.name, placeholder: (p.schema && p.schema.default) || '' })
+      inputs[p.name] = { param: p, input: input }
+      form.appendChild(
+        el('label', {}, [
+          document.createTextNode(p.name + ' '),
+          el('span', { class: 'hint', text: '(' + p.in + (p.required ? ', required' : '') + ')' }),
+          input,
+        ]),
+      )
+    })
+
+    var body
+    if (jsonBody) {
+      body = el('textarea', { name: 'body' })
+      body.value = JSON.stringify(example(spec, jsonBody.schema, 0), null, 2)
+      form.appendChild(el('label', { text: 'Request body (application/json)' }, [body]))
+    }
+
+    var output = el('pre', { class: 'response', hidden: 'hidden' })
+    form.appendChild(el('button', { type: 'submit', text: 'Send' }))
+    form.appendChild(output)
+
+    form.addEventListener('submit', function (event) {
+      event.preventDefault()
+      var url = path
+      var query = new URLSearchParams()
+      Object.keys(inputs).forEach(function (name) {
+        var entry = inputs[name]
+        var value = entry.input.value
+        if (value === '') return
+        if (entry.param.in === 'path') {
+          url = url.replace('{' + name + '}', encodeURIComponent(value))
+        } else if (entry.param.in === 'query') {
+          query.append(name, value)
+        }
+      })
+      var qs = query.toString()
+      var init = { method: method.toUpperCase(), headers: { Accept: 'application/json' } }
+      if (body) {
+        init.headers['Content-Type'] = 'application/json'
+        init.body = body.value
+      }
+
+      output.hidden = false
+      output.textContent = 'Sending…'
+      fetch(baseURL + url + (qs ? '?' + qs : ''), init)
+        .then(function (res) {
+          return res.text().then(function (text) {
+            var headers = []
+            res.headers.forEach(function (value, key) {
+              headers.push(key + ': ' + value)
+            })
+            try {
+              text = JSON.stringify(JSON.parse(text), null, 2)
+            } catch (e) {
+              // JSON以外はそのまま表示
+            }
+            output.textContent =
+              res.status + ' ' + res.statusText + '\n' + headers.join('\n') + '\n\n' + text
+          })
+        })
+        .catch(function (err) {
+          output.textContent = String(err)
+        })
+    })
+
+    return el('details', { class: 'operation' }, [
+      el('summary', {}, [
+        el('span', { class: 'method ' + method, text: method }),
+        el('span', { class: 'path', text: path }),
+        el('span', { class: 'description', text: op.summary || op.description || '' }),
+      ]),
+      form,
+    ])
+  }
+
+  function render(spec) {
+    var baseURL = (spec.servers && spec.servers[0] && spec.servers[0].url) || ''
+    document.getElementById('title').textContent = spec.info.title + ' ' + spec.info.version
+    document.getElementById('server').textContent = baseURL
+
+    var groups = {}
+    Object.keys(spec.paths || {}).forEach(function (path) {
+      var item = spec.paths[path]
+      METHODS.forEach(function (method) {
+        if (!item[method]) return
+        var tag = (item[method].tags && item[method].tags[0]) || 'default'
+        ;(groups[tag] = groups[tag] || []).push(
+          renderOperation(spec, baseURL, path, method, item[method]),
+        )
+      })
+    })
+
+    var main = document.getElementById('operations')
+    main.textContent = ''
+    Object.keys(groups)
+      .sort()
+      .forEach(function (tag) {
+        main.appendChild(el('h2', { text: tag }))
+        groups[tag].forEach(function (node) {
+          main.appendChild(node)
+        })
+      })
+  }
+
+  fetch(SPEC_URL)
+    .then(function (res) {
+      if (!res.ok) throw new Error('failed to load ' + SPEC_URL + ': ' + res.status)
+      return res.json()
+    })
+    .then(render)
+    .catch(function (err) {
+      document.getElementById('operations').textContent = String(err)
+    })
+})()
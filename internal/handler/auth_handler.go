@@ -0,0 +1,195 @@
+package handler
+
+import (
+	"encoding/json"
+	"log/slog"
+	"net"
+	"net/http"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/usecase"
+	"github.com/example/go-react-cqrs-template/pkg/generated/openapi"
+)
+
+// AuthHandler ログイン・パスワード変更・メールアドレスの確認・パスワードのリセットのHTTPハンドラー
+type AuthHandler struct {
+	login                    *usecase.LoginUsecase
+	changePassword           *usecase.ChangePasswordUsecase
+	requestEmailVerification *usecase.RequestEmailVerificationUsecase
+	verifyEmail              *usecase.VerifyEmailUsecase
+	requestPasswordReset     *usecase.RequestPasswordResetUsecase
+	resetPassword            *usecase.ResetPasswordUsecase
+	logger                   *slog.Logger
+}
+
+// NewAuthHandler AuthHandlerのコンストラクタ
+func NewAuthHandler(
+	login *usecase.LoginUsecase,
+	changePassword *usecase.ChangePasswordUsecase,
+	requestEmailVerification *usecase.RequestEmailVerificationUsecase,
+	verifyEmail *usecase.VerifyEmailUsecase,
+	requestPasswordReset *usecase.RequestPasswordResetUsecase,
+	resetPassword *usecase.ResetPasswordUsecase,
+	logger *slog.Logger,
+) *AuthHandler {
+	return &AuthHandler{
+		login:                    login,
+		changePassword:           changePassword,
+		requestEmailVerification: requestEmailVerification,
+		verifyEmail:              verifyEmail,
+		requestPasswordReset:     requestPasswordReset,
+		resetPassword:            resetPassword,
+		logger:                   logger,
+	}
+}
+
+// AuthLogin メールアドレスとパスワードでログイン（OpenAPI ServerInterface実装）
+//
+// ログインのたびにセッションを作成し、端末名・接続元IPアドレス・User-Agent を記録する。
+// 二要素認証が有効なユーザーにはセッションを作成せず、ログインの2段階目のトークンを 202 で返す。
+func (h *AuthHandler) AuthLogin(w http.ResponseWriter, r *http.Request) {
+	var req openapi.LoginRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, http.StatusBadRequest, "リクエストの形式が不正です")
+		return
+	}
+
+	client := domain.SessionClient{
+		IPAddress: remoteIP(r),
+		UserAgent: r.UserAgent(),
+	}
+	if req.Device != nil {
+		client.Device = *req.Device
+	}
+
+	result, err := h.login.Execute(r.Context(), string(req.Email), req.Password, client)
+	if err != nil {
+		HandleError(w, err, h.logger)
+		return
+	}
+
+	// トークンをキャッシュさせない
+	w.Header().Set("Cache-Control", "no-store")
+	if result.MFAToken != "" {
+		// 二要素認証が有効な場合は確認コードの入力を求める
+		respondJSON(w, http.StatusAccepted, openapi.MFAChallenge{
+			MfaToken:  result.MFAToken,
+			ExpiresAt: result.MFAExpiresAt,
+		})
+		return
+	}
+	respondJSON(w, http.StatusOK, toLoginResponse(result))
+}
+
+// UserPasswordsChangePassword ユーザーのパスワードを設定・変更（OpenAPI ServerInterface実装）
+//
+// 本人としてログインしたリクエストのみ受け付ける（未ログインは 401、本人以外は 403）。
+func (h *AuthHandler) UserPasswordsChangePassword(w http.ResponseWriter, r *http.Request, userId string) {
+	if !authorizeSelf(w, r, h.logger, userId, "change the password", "他のユーザーのパスワードは変更できません") {
+		return
+	}
+
+	var req openapi.ChangePasswordRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, http.StatusBadRequest, "リクエストの形式が不正です")
+		return
+	}
+	var currentPassword string
+	if req.CurrentPassword != nil {
+		currentPassword = *req.CurrentPassword
+	}
+
+	if err := h.changePassword.Execute(r.Context(), userId, currentPassword, req.NewPassword); err != nil {
+		HandleError(w, err, h.logger)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// UserEmailVerificationRequestEmailVerification メールアドレスの確認のメールを送信（OpenAPI ServerInterface実装）
+//
+// 本人としてログインしたリクエストのみ受け付ける（未ログインは 401、本人以外は 403）。
+func (h *AuthHandler) UserEmailVerificationRequestEmailVerification(w http.ResponseWriter, r *http.Request, userId string) {
+	if !authorizeSelf(w, r, h.logger, userId, "request email verification", "他のユーザーのメールアドレスの確認は依頼できません") {
+		return
+	}
+
+	if err := h.requestEmailVerification.Execute(r.Context(), userId); err != nil {
+		HandleError(w, err, h.logger)
+		return
+	}
+
+	w.WriteHeader(http.StatusAccepted)
+}
+
+// AuthConfirmEmailVerification メールで送ったトークンでメールアドレスを確認（OpenAPI ServerInterface実装）
+func (h *AuthHandler) AuthConfirmEmailVerification(w http.ResponseWriter, r *http.Request) {
+	var req openapi.ConfirmEmailVerificationRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, http.StatusBadRequest, "リクエストの形式が不正です")
+		return
+	}
+
+	user, err := h.verifyEmail.Execute(r.Context(), req.Token)
+	if err != nil {
+		HandleError(w, err, h.logger)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, toUserResponse(user))
+}
+
+// AuthRequestPasswordReset パスワードのリセットのメールを送信（OpenAPI ServerInterface実装）
+//
+// ユーザーの有無を推測されないよう、メールアドレスに関わらず 202 を返す。
+func (h *AuthHandler) AuthRequestPasswordReset(w http.ResponseWriter, r *http.Request) {
+	var req openapi.PasswordResetRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, http.StatusBadRequest, "リクエストの形式が不正です")
+		return
+	}
+
+	if err := h.requestPasswordReset.Execute(r.Context(), string(req.Email)); err != nil {
+		HandleError(w, err, h.logger)
+		return
+	}
+
+	w.WriteHeader(http.StatusAccepted)
+}
+
+// AuthConfirmPasswordReset メールで送ったトークンでパスワードをリセット（OpenAPI ServerInterface実装）
+func (h *AuthHandler) AuthConfirmPasswordReset(w http.ResponseWriter, r *http.Request) {
+	var req openapi.ConfirmPasswordResetRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, http.StatusBadRequest, "リクエストの形式が不正です")
+		return
+	}
+
+	if err := h.resetPassword.Execute(r.Context(), req.Token, req.NewPassword); err != nil {
+		HandleError(w, err, h.logger)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// toLoginResponse ログインの結果をレスポンスに変換
+func toLoginResponse(result *usecase.LoginResult) openapi.LoginResponse {
+	return openapi.LoginResponse{
+		Token:     result.Token,
+		TokenType: "Bearer",
+		ExpiresAt: result.ExpiresAt,
+		SessionId: result.Session.ID,
+		User:      toUserResponse(result.User),
+	}
+}
+
+// remoteIP リクエストの接続元IPアドレス（RemoteAddr のホスト部分）を返す
+func remoteIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
@@ -0,0 +1,197 @@
+package handler_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/example/go-react-cqrs-template/internal/auth"
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure/memory"
+	"github.com/example/go-react-cqrs-template/internal/mail"
+	"github.com/example/go-react-cqrs-template/internal/tenant"
+	"github.com/example/go-react-cqrs-template/internal/testutil/mailtest"
+	"github.com/example/go-react-cqrs-template/pkg/generated/openapi"
+)
+
+// testPasswordHasher テスト用に負荷を下げたパスワードのハッシュ化
+var testPasswordHasher = auth.NewPasswordHasher(auth.Argon2Params{Memory: 64, Iterations: 1, Parallelism: 1, SaltLength: 16, KeyLength: 32})
+
+// testSessions テスト用のセッショントークンの発行
+var testSessions = func() *auth.TokenIssuer {
+	sessions, err := auth.NewTokenIssuer([]byte("0123456789abcdef0123456789abcdef"))
+	if err != nil {
+		panic(err)
+	}
+	return sessions
+}()
+
+// testTOTP テスト用の二要素認証の確認コードの照合
+var testTOTP = auth.NewTOTP("Example")
+
+// mustNewCredential テスト用のパスワード認証情報を作成する
+func mustNewCredential(t *testing.T, user *domain.User, password string) *domain.Credential {
+	t.Helper()
+	hash, err := testPasswordHasher.Hash(password)
+	if err != nil {
+		t.Fatalf("Hash() unexpected error: %v", err)
+	}
+	return domain.NewCredential(user.ID, hash)
+}
+
+// mustIssueToken テスト用にユーザーのセッションを作成し、そのセッションのトークンを発行する
+func mustIssueToken(t *testing.T, store *memory.Store, user *domain.User) string {
+	t.Helper()
+	now := time.Now()
+	session := domain.NewSession(user.ID, domain.SessionClient{}, domain.DefaultSessionPolicy, now)
+	store.SeedSessions(session)
+	token, err := testSessions.Issue(user.ID, tenant.DefaultOrgID, session.ID, now, session.ExpiresAt)
+	if err != nil {
+		t.Fatalf("Issue() unexpected error: %v", err)
+	}
+	return token
+}
+
+// postJSON JSONボディ付きのPOSTリクエストを送信する（token が空でない場合は Bearer で送る）
+func postJSON(t *testing.T, router http.Handler, path, token, body string) *httptest.ResponseRecorder {
+	t.Helper()
+	req := httptest.NewRequest(http.MethodPost, path, bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", "application/json")
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestAuthHandler_LoginAndLockout(t *testing.T) {
+	user, err := domain.NewUser("John Doe", "john@example.com")
+	if err != nil {
+		t.Fatalf("Failed to create user: %v", err)
+	}
+	store := memory.NewStore()
+	store.Seed(user)
+	store.SeedCredentials(mustNewCredential(t, user, "correct horse battery"))
+	router := newTestRouter(t, store)
+
+	// ログインしてトークンを取得
+	rec := postJSON(t, router, "/auth/login", "", `{"email":"john@example.com","password":"correct horse battery"}`)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("login status = %d, want %d: %s", rec.Code, http.StatusOK, rec.Body.String())
+	}
+	if got := rec.Header().Get("Cache-Control"); got != "no-store" {
+		t.Errorf("Cache-Control = %q, want no-store", got)
+	}
+	var login openapi.LoginResponse
+	if err := json.NewDecoder(rec.Body).Decode(&login); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if login.User.Id != user.ID || login.TokenType != "Bearer" {
+		t.Errorf("login = %+v, want user %s with Bearer token", login, user.ID)
+	}
+
+	// トークンで認証したユーザーは他のユーザーのパスワードを変更できない
+	rec = postJSON(t, router, "/users/01ARZ3NDEKTSV4RRFFQ69G5FAV/password", login.Token, `{"newPassword":"another battery staple"}`)
+	if rec.Code != http.StatusForbidden {
+		t.Errorf("change other's password status = %d, want %d", rec.Code, http.StatusForbidden)
+	}
+	// 改ざんされたトークンは 401
+	rec = postJSON(t, router, "/users/"+user.ID+"/password", login.Token+"x", `{"newPassword":"another battery staple"}`)
+	if rec.Code != http.StatusUnauthorized {
+		t.Errorf("tampered token status = %d, want %d", rec.Code, http.StatusUnauthorized)
+	}
+
+	// 連続した失敗でロックされ、正しいパスワードでもログインできなくなる
+	for i := 0; i < domain.DefaultLockoutPolicy.MaxAttempts; i++ {
+		rec = postJSON(t, router, "/auth/login", "", `{"email":"john@example.com","password":"wrong password"}`)
+		if rec.Code != http.StatusUnauthorized {
+			t.Fatalf("failed login #%d status = %d, want %d", i+1, rec.Code, http.StatusUnauthorized)
+		}
+	}
+	rec = postJSON(t, router, "/auth/login", "", `{"email":"john@example.com","password":"correct horse battery"}`)
+	if rec.Code != http.StatusUnauthorized {
+		t.Errorf("login while locked status = %d, want %d", rec.Code, http.StatusUnauthorized)
+	}
+	if cred := store.Credential(user.ID); cred == nil || cred.LockedUntil.IsZero() {
+		t.Errorf("credential = %+v, want locked", cred)
+	}
+}
+
+func TestAuthHandler_EmailVerificationAndPasswordReset(t *testing.T) {
+	user, err := domain.NewUser("John Doe", "john@example.com")
+	if err != nil {
+		t.Fatalf("Failed to create user: %v", err)
+	}
+	store := memory.NewStore()
+	store.Seed(user)
+	mailDir := t.TempDir()
+	mailer, err := mail.NewFileMailer(mailDir, "no-reply@example.com")
+	if err != nil {
+		t.Fatalf("NewFileMailer() unexpected error: %v", err)
+	}
+	router := newTestRouterWithMailer(t, store, mailer)
+
+	// 未ログインでは確認のメールを送れない
+	if rec := postJSON(t, router, "/users/"+user.ID+"/email-verification", "", ""); rec.Code != http.StatusUnauthorized {
+		t.Errorf("request verification without login status = %d, want %d", rec.Code, http.StatusUnauthorized)
+	}
+
+	// 確認のメールを送り、リンクのトークンで確認する
+	sessionToken := mustIssueToken(t, store, user)
+	rec := postJSON(t, router, "/users/"+user.ID+"/email-verification", sessionToken, "")
+	if rec.Code != http.StatusAccepted {
+		t.Fatalf("request verification status = %d, want %d: %s", rec.Code, http.StatusAccepted, rec.Body.String())
+	}
+	msg := mailtest.Last(t, mailDir)
+	if msg.To != user.Email {
+		t.Errorf("verification mail To = %q, want %q", msg.To, user.Email)
+	}
+	confirm := `{"token":"` + mailtest.LinkToken(t, msg) + `"}`
+	rec = postJSON(t, router, "/auth/email-verification:confirm", "", confirm)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("confirm verification status = %d, want %d: %s", rec.Code, http.StatusOK, rec.Body.String())
+	}
+	var verified openapi.User
+	if err := json.NewDecoder(rec.Body).Decode(&verified); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if verified.EmailVerifiedAt == nil {
+		t.Errorf("emailVerifiedAt = nil, want timestamp")
+	}
+	// 使用済みのトークンと確認済みのユーザーへの再送
+	if rec = postJSON(t, router, "/auth/email-verification:confirm", "", confirm); rec.Code != http.StatusBadRequest {
+		t.Errorf("reused token status = %d, want %d", rec.Code, http.StatusBadRequest)
+	}
+	if rec = postJSON(t, router, "/users/"+user.ID+"/email-verification", sessionToken, ""); rec.Code != http.StatusConflict {
+		t.Errorf("request for verified user status = %d, want %d", rec.Code, http.StatusConflict)
+	}
+
+	// 登録されていないメールアドレスでも同じ 202 を返し、メールは送らない
+	sent := len(mailtest.Messages(t, mailDir))
+	rec = postJSON(t, router, "/auth/password-reset", "", `{"email":"unknown@example.com"}`)
+	if rec.Code != http.StatusAccepted {
+		t.Fatalf("request reset for unknown email status = %d, want %d", rec.Code, http.StatusAccepted)
+	}
+	if got := len(mailtest.Messages(t, mailDir)); got != sent {
+		t.Errorf("messages = %d, want %d", got, sent)
+	}
+
+	// リセットしたパスワードでログインできる
+	rec = postJSON(t, router, "/auth/password-reset", "", `{"email":"john@example.com"}`)
+	if rec.Code != http.StatusAccepted {
+		t.Fatalf("request reset status = %d, want %d: %s", rec.Code, http.StatusAccepted, rec.Body.String())
+	}
+	token := mailtest.LinkToken(t, mailtest.Last(t, mailDir))
+	rec = postJSON(t, router, "/auth/password-reset:confirm", "", `{"token":"`+token+`","newPassword":"brand new password"}`)
+	if rec.Code != http.StatusNoContent {
+		t.Fatalf("confirm reset status = %d, want %d: %s", rec.Code, http.StatusNoContent, rec.Body.String())
+	}
+	rec = postJSON(t, router, "/auth/login", "", `{"email":"john@example.com","password":"brand new password"}`)
+	if rec.Code != http.StatusOK {
+		t.Errorf("login with reset password status = %d, want %d: %s", rec.Code, http.StatusOK, rec.Body.String())
+	}
+}
@@ -0,0 +1,78 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/example/go-react-cqrs-template/internal/auth"
+	apperrors "github.com/example/go-react-cqrs-template/internal/pkg/errors"
+	"github.com/example/go-react-cqrs-template/internal/tenant"
+	"github.com/example/go-react-cqrs-template/internal/usecase"
+)
+
+// AuthMiddleware Authorization ヘッダーのセッショントークンを検証し、認証済みのユーザーをコンテキストに設定するミドルウェア
+//
+// auth.TokenPrefix で始まる Bearer トークンのみを扱い、ヘッダーのないリクエストや他の Bearer トークン
+// （SCIM のトークンなど）はそのまま通す。署名を検証したうえで、トークンのセッションが取り消されておらず
+// 有効期限内であることを sessions で確認する。検証できたトークンのユーザーIDを auth.WithUserID で、
+// セッションIDを auth.WithSessionID で、組織を tenant.WithPrincipalOrgID で設定するため、
+// TenantMiddleware より前に適用する。トークンが不正、またはセッションが取り消し済み・期限切れの場合は 401 を返す。
+func AuthMiddleware(tokens *auth.TokenIssuer, sessions *usecase.AuthenticateSessionUsecase, logger *slog.Logger) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+			if !ok || !strings.HasPrefix(token, auth.TokenPrefix) {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			claims, err := tokens.Parse(token, time.Now())
+			if err != nil {
+				userMessage := "認証トークンが不正です"
+				if errors.Is(err, auth.ErrTokenExpired) {
+					userMessage = "認証トークンの有効期限が切れています。再度ログインしてください"
+				}
+				HandleError(w, apperrors.Unauthorized(err.Error(), userMessage), logger)
+				return
+			}
+
+			// セッションはトークンの組織で検索する（リクエストで指定された組織ではない）
+			if _, err := sessions.Execute(tenant.WithOrgID(r.Context(), claims.OrgID), claims.UserID, claims.SessionID); err != nil {
+				HandleError(w, err, logger)
+				return
+			}
+
+			ctx := auth.WithUserID(r.Context(), claims.UserID)
+			ctx = auth.WithSessionID(ctx, claims.SessionID)
+			ctx = tenant.WithPrincipalOrgID(ctx, claims.OrgID)
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+// authorizeSelf ログイン中のユーザーが userID 本人か確認する
+//
+// 未ログインの場合は 401、本人以外の場合は message の 403 を書き込み false を返す。
+// action はログに記録する操作の説明（"revoke sessions" など）。
+func authorizeSelf(w http.ResponseWriter, r *http.Request, logger *slog.Logger, userID, action, message string) bool {
+	principal, ok := auth.UserID(r.Context())
+	if !ok {
+		HandleError(w, apperrors.Unauthorized(
+			fmt.Sprintf("unauthenticated request cannot %s of user %s", action, userID),
+			"ログインしてください",
+		), logger)
+		return false
+	}
+	if principal != userID {
+		HandleError(w, apperrors.Forbidden(
+			fmt.Sprintf("user %s cannot %s of user %s", principal, action, userID),
+			message,
+		), logger)
+		return false
+	}
+	return true
+}
@@ -0,0 +1,88 @@
+package handler
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/example/go-react-cqrs-template/internal/tenant"
+)
+
+// DefaultCacheControl ユーザーのレスポンスの Cache-Control の既定値
+//
+// 個人情報を含むため共有キャッシュには保存させず、ブラウザには毎回 ETag で再検証させる。
+const DefaultCacheControl = "private, no-cache"
+
+// cacheVary キャッシュ可能なレスポンスの Vary
+//
+// レスポンスはプリンシパルと組織ごとに異なるため、Cache-Control の設定によらず常に付ける。
+var cacheVary = "Authorization, " + tenant.Header
+
+// conditionalResponse キャッシュ可能なレスポンスの検証子
+type conditionalResponse struct {
+	// lastModified 最終更新日時（ゼロ値の場合は Last-Modified を返さない）
+	lastModified time.Time
+	// useModifiedSince If-Modified-Since で 304 を返してよいか
+	// （削除のように lastModified が進まない変更がある表現では false にする）
+	useModifiedSince bool
+}
+
+// respondCacheable body を ETag・Last-Modified・Cache-Control・Vary 付きで返す
+//
+// ETag はレスポンスボディのハッシュから求める強い検証子で、If-None-Match のいずれかと一致すれば 304 を返す。
+// If-None-Match がない場合は If-Modified-Since と Last-Modified（秒精度）を比較する（RFC 9110 13.2.2）。
+func (h *UserHandler) respondCacheable(w http.ResponseWriter, ifNoneMatch, ifModifiedSince *string, body any, cond conditionalResponse) {
+	data, err := json.Marshal(body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	data = append(data, '\n')
+	sum := sha256.Sum256(data)
+	etag := `"` + hex.EncodeToString(sum[:16]) + `"`
+
+	header := w.Header()
+	header.Set("ETag", etag)
+	header.Set("Cache-Control", h.cacheControl)
+	header.Add("Vary", cacheVary)
+	if !cond.lastModified.IsZero() {
+		header.Set("Last-Modified", cond.lastModified.UTC().Format(http.TimeFormat))
+	}
+
+	if notModified(etag, ifNoneMatch, ifModifiedSince, cond) {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+	header.Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	w.Write(data)
+}
+
+// notModified 条件付きリクエストの条件から 304 を返すべきかを判定する
+func notModified(etag string, ifNoneMatch, ifModifiedSince *string, cond conditionalResponse) bool {
+	if ifNoneMatch != nil {
+		return etagMatches(*ifNoneMatch, etag)
+	}
+	if ifModifiedSince == nil || !cond.useModifiedSince || cond.lastModified.IsZero() {
+		return false
+	}
+	since, err := http.ParseTime(*ifModifiedSince)
+	if err != nil {
+		return false
+	}
+	return !cond.lastModified.Truncate(time.Second).After(since)
+}
+
+// etagMatches If-None-Match のいずれかのエンティティタグが etag と弱い比較で一致するか
+func etagMatches(ifNoneMatch, etag string) bool {
+	for _, tag := range strings.Split(ifNoneMatch, ",") {
+		tag = strings.TrimSpace(tag)
+		if tag == "*" || strings.TrimPrefix(tag, "W/") == etag {
+			return true
+		}
+	}
+	return false
+}
@@ -65,6 +65,15 @@ func ToAppError(err error) *apperrors.AppError {
 		)
 	}
 
+	// AuthenticationError の場合
+	var authErr *domain.AuthenticationError
+	if errors.As(err, &authErr) {
+		return apperrors.Unauthorized(
+			authErr.Message,
+			authErr.UserMessage,
+		)
+	}
+
 	// DomainError の場合（基底型）
 	var domainErr *domain.DomainError
 	if errors.As(err, &domainErr) {
@@ -75,6 +84,8 @@ func ToAppError(err error) *apperrors.AppError {
 			return apperrors.NotFound("resource", domainErr.UserMessage)
 		case domain.ErrCodeConflict:
 			return apperrors.Conflict(domainErr.Message, domainErr.UserMessage)
+		case domain.ErrCodeUnauthenticated:
+			return apperrors.Unauthorized(domainErr.Message, domainErr.UserMessage)
 		default:
 			return apperrors.Internal(err, domainErr.UserMessage)
 		}
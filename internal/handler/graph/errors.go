@@ -0,0 +1,58 @@
+package graph
+
+import (
+	"net/http"
+
+	"github.com/example/go-react-cqrs-template/internal/handler"
+	apperrors "github.com/example/go-react-cqrs-template/internal/pkg/errors"
+	"github.com/example/go-react-cqrs-template/internal/pkg/logger"
+)
+
+// resolverError GraphQL のレスポンスの errors に含めるエラー
+//
+// message は REST API の Error.message と同じユーザー向けメッセージとし、
+// extensions.code で種類を判別できるようにする。
+type resolverError struct {
+	appErr *apperrors.AppError
+}
+
+func (e *resolverError) Error() string {
+	return e.appErr.UserMessage()
+}
+
+func (e *resolverError) Unwrap() error {
+	return e.appErr
+}
+
+// Extensions graphql-go が errors[].extensions に出力する
+func (e *resolverError) Extensions() map[string]any {
+	return map[string]any{
+		"code":   errorCode(e.appErr.StatusCode()),
+		"status": e.appErr.StatusCode(),
+	}
+}
+
+// errorCode HTTP ステータスを GraphQL のエラーコードに変換する
+func errorCode(status int) string {
+	switch status {
+	case http.StatusBadRequest:
+		return "BAD_USER_INPUT"
+	case http.StatusUnauthorized:
+		return "UNAUTHENTICATED"
+	case http.StatusNotFound:
+		return "NOT_FOUND"
+	case http.StatusConflict:
+		return "CONFLICT"
+	default:
+		return "INTERNAL_SERVER_ERROR"
+	}
+}
+
+// error ドメインエラーを REST API と同じ規則で変換し、ログに出力する
+func (r *resolver) error(err error) error {
+	appErr := handler.ToAppError(err)
+	if r.logger != nil {
+		logger.LogError(r.logger, appErr, "graphql resolver error")
+	}
+	return &resolverError{appErr: appErr}
+}
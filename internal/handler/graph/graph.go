@@ -0,0 +1,108 @@
+// Package graph はユーザーの読み取りモデルに対する GraphQL エンドポイント（/graphql）を提供する
+//
+// クエリはユーザーと監査ログ（user_logs）を Relay 形式のコネクションで返し、ミューテーションは
+// REST API と同じユースケースに委譲する。一覧のユーザーごとのログはリクエスト単位のローダーで
+// まとめて取得するため、ユーザーの件数に関わらずログのクエリの数は一定になる。
+package graph
+
+import (
+	"context"
+	_ "embed"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+
+	graphql "github.com/graph-gophers/graphql-go"
+
+	"github.com/example/go-react-cqrs-template/internal/usecase"
+)
+
+//go:embed schema.graphql
+var schemaSDL string
+
+const (
+	// maxBodyBytes リクエストボディの上限
+	maxBodyBytes = 1 << 20
+	// maxDepth クエリのネストの上限（深いクエリによる過負荷を防ぐ）
+	maxDepth = 10
+)
+
+// Handler GraphQL のリクエストを処理するハンドラー
+type Handler struct {
+	schema   *graphql.Schema
+	resolver *resolver
+}
+
+// NewHandler Handlerのコンストラクタ
+func NewHandler(
+	createUser *usecase.CreateUserUsecase,
+	findUser *usecase.FindUserUsecase,
+	listUsers *usecase.ListUsersUsecase,
+	patchUser *usecase.PatchUserUsecase,
+	deleteUser *usecase.DeleteUserUsecase,
+	listUserLogs *usecase.ListUserLogsUsecase,
+	logger *slog.Logger,
+) (*Handler, error) {
+	r := &resolver{
+		createUser:   createUser,
+		findUser:     findUser,
+		listUsers:    listUsers,
+		patchUser:    patchUser,
+		deleteUser:   deleteUser,
+		listUserLogs: listUserLogs,
+		logger:       logger,
+	}
+	schema, err := graphql.ParseSchema(schemaSDL, r,
+		graphql.UseStringDescriptions(),
+		graphql.MaxDepth(maxDepth),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &Handler{schema: schema, resolver: r}, nil
+}
+
+// request GraphQL over HTTP のリクエストボディ
+type request struct {
+	Query         string         `json:"query"`
+	OperationName string         `json:"operationName"`
+	Variables     map[string]any `json:"variables"`
+}
+
+// ServeHTTP POST /graphql
+//
+// 実行時のエラーはステータス 200 のレスポンスの errors に含める（GraphQL over HTTP の慣例）。
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var req request
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxBodyBytes)).Decode(&req); err != nil {
+		http.Error(w, "invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	// ローダーはリクエスト単位（別のリクエストとは結果を共有しない）
+	ctx := withUserLogLoader(r.Context(), newUserLogLoader(h.resolver.listUserLogs))
+	response := h.schema.Exec(ctx, req.Query, req.OperationName, req.Variables)
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(response)
+}
+
+type userLogLoaderKey struct{}
+
+func withUserLogLoader(ctx context.Context, l *userLogLoader) context.Context {
+	return context.WithValue(ctx, userLogLoaderKey{}, l)
+}
+
+// userLogLoaderFrom リクエストのローダーを取得する（ない場合は呼び出しごとに取得する）
+func userLogLoaderFrom(ctx context.Context, listUserLogs *usecase.ListUserLogsUsecase) *userLogLoader {
+	if l, ok := ctx.Value(userLogLoaderKey{}).(*userLogLoader); ok {
+		return l
+	}
+	return newUserLogLoader(listUserLogs)
+}
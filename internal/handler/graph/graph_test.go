@@ -0,0 +1,310 @@
+package graph_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/handler/graph"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure/memory"
+	"github.com/example/go-react-cqrs-template/internal/usecase"
+)
+
+// countingLogQuery ユーザーログのクエリの回数を数える
+type countingLogQuery struct {
+	*memory.Store
+	finds, counts atomic.Int32
+}
+
+func (q *countingLogQuery) FindLogsByUserIDs(ctx context.Context, userIDs []string, limit, offset int) (map[string][]*domain.UserLog, error) {
+	q.finds.Add(1)
+	return q.Store.FindLogsByUserIDs(ctx, userIDs, limit, offset)
+}
+
+func (q *countingLogQuery) CountLogsByUserIDs(ctx context.Context, userIDs []string) (map[string]int, error) {
+	q.counts.Add(1)
+	return q.Store.CountLogsByUserIDs(ctx, userIDs)
+}
+
+func newTestHandler(t *testing.T, store *memory.Store) (http.Handler, *countingLogQuery) {
+	t.Helper()
+	logQuery := &countingLogQuery{Store: store}
+	h, err := graph.NewHandler(
+		usecase.NewCreateUserUsecase(store, store, store),
+		usecase.NewFindUserUsecase(store),
+		usecase.NewListUsersUsecase(store),
+		usecase.NewPatchUserUsecase(store, store, store),
+		usecase.NewDeleteUserUsecase(store, store, store),
+		usecase.NewListUserLogsUsecase(logQuery),
+		slog.New(slog.NewTextHandler(io.Discard, nil)),
+	)
+	if err != nil {
+		t.Fatalf("NewHandler() error = %v", err)
+	}
+	return h, logQuery
+}
+
+type response struct {
+	Data   json.RawMessage `json:"data"`
+	Errors []struct {
+		Message    string         `json:"message"`
+		Extensions map[string]any `json:"extensions"`
+	} `json:"errors"`
+}
+
+func exec(t *testing.T, h http.Handler, query string, variables map[string]any) response {
+	t.Helper()
+	body, _ := json.Marshal(map[string]any{"query": query, "variables": variables})
+	req := httptest.NewRequest(http.MethodPost, "/graphql", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d (body: %s)", rec.Code, http.StatusOK, rec.Body.String())
+	}
+	var res response
+	if err := json.NewDecoder(rec.Body).Decode(&res); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	return res
+}
+
+// createUsers ユースケース経由でユーザーを作成する（user_logs も記録される）
+func createUsers(t *testing.T, store *memory.Store, n int) []*domain.User {
+	t.Helper()
+	create := usecase.NewCreateUserUsecase(store, store, store)
+	users := make([]*domain.User, n)
+	for i := range users {
+		user, err := create.Execute(context.Background(), fmt.Sprintf("User %d", i), fmt.Sprintf("user%d@example.com", i))
+		if err != nil {
+			t.Fatalf("create user: %v", err)
+		}
+		users[i] = user
+	}
+	return users
+}
+
+func TestHandler_UsersWithLogs_BatchesLogQueries(t *testing.T) {
+	store := memory.NewStore()
+	createUsers(t, store, 5)
+	h, logQuery := newTestHandler(t, store)
+
+	res := exec(t, h, `{
+		users(first: 10) {
+			totalCount
+			edges { node { id name logs(first: 5) { totalCount edges { node { action } } } } }
+		}
+	}`, nil)
+	if len(res.Errors) > 0 {
+		t.Fatalf("errors = %+v", res.Errors)
+	}
+
+	var data struct {
+		Users struct {
+			TotalCount int
+			Edges      []struct {
+				Node struct {
+					ID   string
+					Logs struct {
+						TotalCount int
+						Edges      []struct{ Node struct{ Action string } }
+					}
+				}
+			}
+		}
+	}
+	if err := json.Unmarshal(res.Data, &data); err != nil {
+		t.Fatalf("failed to decode data: %v", err)
+	}
+	if data.Users.TotalCount != 5 || len(data.Users.Edges) != 5 {
+		t.Fatalf("users = %+v, want 5", data.Users)
+	}
+	for _, e := range data.Users.Edges {
+		logs := e.Node.Logs
+		if logs.TotalCount != 1 || len(logs.Edges) != 1 || logs.Edges[0].Node.Action != "CREATED" {
+			t.Errorf("logs of %s = %+v, want one CREATED", e.Node.ID, logs)
+		}
+	}
+
+	// ユーザーの件数に関わらずログのクエリは一度ずつ
+	if finds, counts := logQuery.finds.Load(), logQuery.counts.Load(); finds != 1 || counts != 1 {
+		t.Errorf("log queries = %d finds, %d counts, want 1 each", finds, counts)
+	}
+}
+
+func TestHandler_UsersPagination(t *testing.T) {
+	store := memory.NewStore()
+	createUsers(t, store, 3)
+	h, _ := newTestHandler(t, store)
+
+	const query = `query($after: String) {
+		users(first: 2, after: $after) {
+			edges { cursor node { name } }
+			pageInfo { hasNextPage hasPreviousPage endCursor }
+		}
+	}`
+	type page struct {
+		Users struct {
+			Edges    []struct{ Node struct{ Name string } }
+			PageInfo struct {
+				HasNextPage     bool
+				HasPreviousPage bool
+				EndCursor       *string
+			}
+		}
+	}
+
+	var first page
+	if err := json.Unmarshal(exec(t, h, query, nil).Data, &first); err != nil {
+		t.Fatalf("failed to decode data: %v", err)
+	}
+	if len(first.Users.Edges) != 2 || !first.Users.PageInfo.HasNextPage || first.Users.PageInfo.HasPreviousPage {
+		t.Fatalf("first page = %+v", first.Users)
+	}
+
+	var second page
+	if err := json.Unmarshal(exec(t, h, query, map[string]any{"after": *first.Users.PageInfo.EndCursor}).Data, &second); err != nil {
+		t.Fatalf("failed to decode data: %v", err)
+	}
+	if len(second.Users.Edges) != 1 || second.Users.PageInfo.HasNextPage || !second.Users.PageInfo.HasPreviousPage {
+		t.Fatalf("second page = %+v", second.Users)
+	}
+	// 新しい順のため最後は最初に作成したユーザー
+	if second.Users.Edges[0].Node.Name != "User 0" {
+		t.Errorf("last user = %s, want User 0", second.Users.Edges[0].Node.Name)
+	}
+
+	res := exec(t, h, query, map[string]any{"after": "invalid"})
+	if len(res.Errors) != 1 || res.Errors[0].Extensions["code"] != "BAD_USER_INPUT" {
+		t.Errorf("invalid cursor errors = %+v", res.Errors)
+	}
+}
+
+func TestHandler_User(t *testing.T) {
+	store := memory.NewStore()
+	users := createUsers(t, store, 1)
+	h, _ := newTestHandler(t, store)
+
+	res := exec(t, h, `query($id: ID!) { user(id: $id) { id email createdAt } }`, map[string]any{"id": users[0].ID})
+	var data struct {
+		User *struct{ ID, Email, CreatedAt string }
+	}
+	if err := json.Unmarshal(res.Data, &data); err != nil {
+		t.Fatalf("failed to decode data: %v", err)
+	}
+	if data.User == nil || data.User.ID != users[0].ID || data.User.Email != "user0@example.com" || data.User.CreatedAt == "" {
+		t.Errorf("user = %+v", data.User)
+	}
+
+	res = exec(t, h, `{ user(id: "01ARZ3NDEKTSV4RRFFQ69G5FAV") { id } }`, nil)
+	if string(res.Data) != `{"user":null}` || len(res.Errors) != 0 {
+		t.Errorf("missing user = %s, %+v, want null without errors", res.Data, res.Errors)
+	}
+}
+
+func TestHandler_Mutations(t *testing.T) {
+	store := memory.NewStore()
+	h, _ := newTestHandler(t, store)
+
+	res := exec(t, h, `mutation { createUser(input: {name: "Alice", email: "alice@example.com"}) { id name } }`, nil)
+	var created struct{ CreateUser struct{ ID, Name string } }
+	if err := json.Unmarshal(res.Data, &created); err != nil || len(res.Errors) > 0 {
+		t.Fatalf("createUser = %s, %+v", res.Data, res.Errors)
+	}
+	id := created.CreateUser.ID
+
+	res = exec(t, h, `mutation($id: ID!) { updateUser(id: $id, input: {name: "Alice Smith"}) { name email } }`, map[string]any{"id": id})
+	var updated struct{ UpdateUser struct{ Name, Email string } }
+	if err := json.Unmarshal(res.Data, &updated); err != nil || len(res.Errors) > 0 {
+		t.Fatalf("updateUser = %s, %+v", res.Data, res.Errors)
+	}
+	if updated.UpdateUser.Name != "Alice Smith" || updated.UpdateUser.Email != "alice@example.com" {
+		t.Errorf("updated = %+v", updated.UpdateUser)
+	}
+
+	res = exec(t, h, `mutation($id: ID!) { deleteUser(id: $id) }`, map[string]any{"id": id})
+	if len(res.Errors) > 0 {
+		t.Fatalf("deleteUser errors = %+v", res.Errors)
+	}
+
+	// ユースケース経由のため REST API と同じく user_logs とイベントが記録される
+	logs := store.UserLogs(id)
+	if len(logs) != 2 || logs[0].Action != domain.UserLogActionCreated || logs[1].Action != domain.UserLogActionDeleted {
+		t.Errorf("user logs = %v, want created and deleted", logs)
+	}
+	if events := store.UserEvents(id); len(events) != 3 {
+		t.Errorf("user events = %d, want 3", len(events))
+	}
+}
+
+func TestHandler_MutationErrors(t *testing.T) {
+	store := memory.NewStore()
+	createUsers(t, store, 1)
+	h, _ := newTestHandler(t, store)
+
+	tests := []struct {
+		name     string
+		query    string
+		wantCode string
+	}{
+		{
+			name:     "duplicate email",
+			query:    `mutation { createUser(input: {name: "Dup", email: "user0@example.com"}) { id } }`,
+			wantCode: "CONFLICT",
+		},
+		{
+			name:     "invalid email",
+			query:    `mutation { createUser(input: {name: "Bad", email: "not-an-email"}) { id } }`,
+			wantCode: "BAD_USER_INPUT",
+		},
+		{
+			name:     "empty name",
+			query:    `mutation { createUser(input: {name: "", email: "new@example.com"}) { id } }`,
+			wantCode: "BAD_USER_INPUT",
+		},
+		{
+			name:     "update missing user",
+			query:    `mutation { updateUser(id: "01ARZ3NDEKTSV4RRFFQ69G5FAV", input: {name: "X"}) { id } }`,
+			wantCode: "NOT_FOUND",
+		},
+		{
+			name:     "delete missing user",
+			query:    `mutation { deleteUser(id: "01ARZ3NDEKTSV4RRFFQ69G5FAV") }`,
+			wantCode: "NOT_FOUND",
+		},
+		{
+			name:     "first out of range",
+			query:    `{ users(first: 101) { totalCount } }`,
+			wantCode: "BAD_USER_INPUT",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res := exec(t, h, tt.query, nil)
+			if len(res.Errors) != 1 {
+				t.Fatalf("errors = %+v, want one", res.Errors)
+			}
+			if got := res.Errors[0].Extensions["code"]; got != tt.wantCode {
+				t.Errorf("code = %v, want %s (message: %s)", got, tt.wantCode, res.Errors[0].Message)
+			}
+		})
+	}
+}
+
+func TestHandler_RejectsGet(t *testing.T) {
+	h, _ := newTestHandler(t, memory.NewStore())
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/graphql?query={users{totalCount}}", nil))
+	if rec.Code != http.StatusMethodNotAllowed {
+		t.Errorf("status = %d, want %d", rec.Code, http.StatusMethodNotAllowed)
+	}
+}
@@ -0,0 +1,88 @@
+package graph
+
+import (
+	"context"
+	"sync"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/usecase"
+)
+
+// logPage ログのページ（ユーザーごとに同じ範囲を取得するため、バッチはページ単位）
+type logPage struct {
+	limit, offset int
+}
+
+// logBatch 一度のクエリで取得するユーザーのログ
+type logBatch struct {
+	done   chan struct{}
+	logs   map[string][]*domain.UserLog
+	totals map[string]int
+	err    error
+}
+
+// userLogLoader ユーザーごとのログをまとめて取得する DataLoader
+//
+// 一覧で解決したユーザーを expect で登録しておき、そのうち一人目のログが要求された時点で
+// 登録済みのユーザー全員分を一度に取得する。他のユーザーのログは同じバッチの結果を待つ。
+// 時間窓で要求を集める方式と異なり、フィールドの解決順序に関わらずクエリの数が決まる。
+type userLogLoader struct {
+	listUserLogs *usecase.ListUserLogsUsecase
+
+	mu       sync.Mutex
+	expected []string
+	batches  map[logPage]map[string]*logBatch
+}
+
+func newUserLogLoader(listUserLogs *usecase.ListUserLogsUsecase) *userLogLoader {
+	return &userLogLoader{
+		listUserLogs: listUserLogs,
+		batches:      make(map[logPage]map[string]*logBatch),
+	}
+}
+
+// expect 後でログを要求されるユーザーを登録する
+func (l *userLogLoader) expect(userIDs ...string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.expected = append(l.expected, userIDs...)
+}
+
+// load ユーザーのログ（新しい順）と総数を取得する
+func (l *userLogLoader) load(ctx context.Context, userID string, page logPage) ([]*domain.UserLog, int, error) {
+	l.mu.Lock()
+	byUser, ok := l.batches[page]
+	if !ok {
+		byUser = make(map[string]*logBatch)
+		l.batches[page] = byUser
+	}
+	batch, ok := byUser[userID]
+	if !ok {
+		// まだ取得していない登録済みのユーザーをまとめる
+		batch = &logBatch{done: make(chan struct{})}
+		keys := []string{userID}
+		byUser[userID] = batch
+		for _, id := range l.expected {
+			if _, ok := byUser[id]; !ok {
+				byUser[id] = batch
+				keys = append(keys, id)
+			}
+		}
+		l.mu.Unlock()
+
+		batch.logs, batch.totals, batch.err = l.listUserLogs.Execute(ctx, keys, page.limit, page.offset)
+		close(batch.done)
+	} else {
+		l.mu.Unlock()
+	}
+
+	select {
+	case <-batch.done:
+	case <-ctx.Done():
+		return nil, 0, ctx.Err()
+	}
+	if batch.err != nil {
+		return nil, 0, batch.err
+	}
+	return batch.logs[userID], batch.totals[userID], nil
+}
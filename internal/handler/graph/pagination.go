@@ -0,0 +1,94 @@
+package graph
+
+import (
+	"encoding/base64"
+	"strconv"
+	"strings"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+)
+
+const (
+	// maxFirst first の上限（REST API の limit と同じ）
+	maxFirst = 100
+	// cursorPrefix カーソルの中身（一覧の先頭からの位置）の接頭辞
+	cursorPrefix = "offset:"
+)
+
+// pageArgs コネクションのページネーション引数（first / after）
+type pageArgs struct {
+	First int32
+	After *string
+}
+
+// page 引数を取得範囲に変換する（after のカーソルの次の要素から first 件）
+func (a pageArgs) page() (logPage, error) {
+	if a.First < 0 || a.First > maxFirst {
+		return logPage{}, domain.NewValidationError("first",
+			"first must be between 0 and 100",
+			"first は0以上100以下で指定してください",
+		)
+	}
+	page := logPage{limit: int(a.First)}
+	if a.After != nil {
+		offset, ok := decodeCursor(*a.After)
+		if !ok {
+			return logPage{}, domain.NewValidationError("after",
+				"invalid cursor: "+*a.After,
+				"カーソルの形式が正しくありません",
+			)
+		}
+		page.offset = offset + 1
+	}
+	return page, nil
+}
+
+// encodeCursor 一覧の位置を不透明なカーソルに変換する
+func encodeCursor(offset int) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(cursorPrefix + strconv.Itoa(offset)))
+}
+
+// decodeCursor カーソルを一覧の位置に戻す
+func decodeCursor(cursor string) (int, bool) {
+	b, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return 0, false
+	}
+	s, ok := strings.CutPrefix(string(b), cursorPrefix)
+	if !ok {
+		return 0, false
+	}
+	offset, err := strconv.Atoi(s)
+	if err != nil || offset < 0 {
+		return 0, false
+	}
+	return offset, true
+}
+
+// pageInfo Relay の PageInfo
+type pageInfo struct {
+	offset, count, total int
+}
+
+func newPageInfo(offset, count, total int) *pageInfo {
+	return &pageInfo{offset: offset, count: count, total: total}
+}
+
+func (p *pageInfo) HasNextPage() bool     { return p.offset+p.count < p.total }
+func (p *pageInfo) HasPreviousPage() bool { return p.offset > 0 }
+
+func (p *pageInfo) StartCursor() *string {
+	if p.count == 0 {
+		return nil
+	}
+	c := encodeCursor(p.offset)
+	return &c
+}
+
+func (p *pageInfo) EndCursor() *string {
+	if p.count == 0 {
+		return nil
+	}
+	c := encodeCursor(p.offset + p.count - 1)
+	return &c
+}
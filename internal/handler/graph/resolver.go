@@ -0,0 +1,207 @@
+package graph
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+
+	graphql "github.com/graph-gophers/graphql-go"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/handler"
+	"github.com/example/go-react-cqrs-template/internal/usecase"
+)
+
+// resolver Query と Mutation のルートリゾルバー
+type resolver struct {
+	createUser   *usecase.CreateUserUsecase
+	findUser     *usecase.FindUserUsecase
+	listUsers    *usecase.ListUsersUsecase
+	patchUser    *usecase.PatchUserUsecase
+	deleteUser   *usecase.DeleteUserUsecase
+	listUserLogs *usecase.ListUserLogsUsecase
+	logger       *slog.Logger
+}
+
+// --- Query ---
+
+// User Query.user
+func (r *resolver) User(ctx context.Context, args struct{ ID graphql.ID }) (*userResolver, error) {
+	user, err := r.findUser.Execute(ctx, string(args.ID))
+	var notFound *domain.NotFoundError
+	if errors.As(err, &notFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, r.error(err)
+	}
+	return r.newUser(user), nil
+}
+
+// Users Query.users
+func (r *resolver) Users(ctx context.Context, args pageArgs) (*userConnection, error) {
+	page, err := args.page()
+	if err != nil {
+		return nil, r.error(err)
+	}
+	users, total, err := r.listUsers.Execute(ctx, "", page.limit, page.offset)
+	if err != nil {
+		return nil, r.error(err)
+	}
+
+	ids := make([]string, len(users))
+	for i, u := range users {
+		ids[i] = u.ID
+	}
+	userLogLoaderFrom(ctx, r.listUserLogs).expect(ids...)
+
+	conn := &userConnection{edges: make([]*userEdge, 0, len(users)), pageInfo: newPageInfo(page.offset, len(users), total)}
+	for i, u := range users {
+		conn.edges = append(conn.edges, &userEdge{cursor: encodeCursor(page.offset + i), node: r.newUser(u)})
+	}
+	return conn, nil
+}
+
+// --- Mutation ---
+
+type createUserInput struct {
+	Name  string
+	Email string
+}
+
+type updateUserInput struct {
+	Name  *string
+	Email *string
+}
+
+// CreateUser Mutation.createUser
+func (r *resolver) CreateUser(ctx context.Context, args struct{ Input createUserInput }) (*userResolver, error) {
+	if err := handler.ValidateUserInput(&args.Input.Name, &args.Input.Email); err != nil {
+		return nil, r.error(err)
+	}
+	user, err := r.createUser.Execute(ctx, args.Input.Name, args.Input.Email)
+	if err != nil {
+		return nil, r.error(err)
+	}
+	return r.newUser(user), nil
+}
+
+// UpdateUser Mutation.updateUser
+func (r *resolver) UpdateUser(ctx context.Context, args struct {
+	ID    graphql.ID
+	Input updateUserInput
+}) (*userResolver, error) {
+	if err := handler.ValidateUserInput(args.Input.Name, args.Input.Email); err != nil {
+		return nil, r.error(err)
+	}
+	user, err := r.patchUser.Execute(ctx, string(args.ID), usecase.SetUserFields(args.Input.Name, args.Input.Email))
+	if err != nil {
+		return nil, r.error(err)
+	}
+	return r.newUser(user), nil
+}
+
+// DeleteUser Mutation.deleteUser
+func (r *resolver) DeleteUser(ctx context.Context, args struct{ ID graphql.ID }) (graphql.ID, error) {
+	if err := r.deleteUser.Execute(ctx, string(args.ID)); err != nil {
+		return "", r.error(err)
+	}
+	return args.ID, nil
+}
+
+// --- User / UserLog ---
+
+type userResolver struct {
+	user     *domain.User
+	resolver *resolver
+}
+
+func (r *resolver) newUser(user *domain.User) *userResolver {
+	return &userResolver{user: user, resolver: r}
+}
+
+func (u *userResolver) ID() graphql.ID          { return graphql.ID(u.user.ID) }
+func (u *userResolver) Name() string            { return u.user.Name }
+func (u *userResolver) Email() string           { return u.user.Email }
+func (u *userResolver) Status() string          { return strings.ToUpper(string(u.user.Status)) }
+func (u *userResolver) CreatedAt() graphql.Time { return graphql.Time{Time: u.user.CreatedAt} }
+func (u *userResolver) UpdatedAt() graphql.Time { return graphql.Time{Time: u.user.UpdatedAt} }
+
+// EmailVerifiedAt User.emailVerifiedAt（未確認の場合は null）
+func (u *userResolver) EmailVerifiedAt() *graphql.Time {
+	if !u.user.IsEmailVerified() {
+		return nil
+	}
+	return &graphql.Time{Time: u.user.EmailVerifiedAt}
+}
+
+// Logs User.logs（同じリクエストで一覧に含まれるユーザーのログはまとめて取得する）
+func (u *userResolver) Logs(ctx context.Context, args pageArgs) (*userLogConnection, error) {
+	page, err := args.page()
+	if err != nil {
+		return nil, u.resolver.error(err)
+	}
+	logs, total, err := userLogLoaderFrom(ctx, u.resolver.listUserLogs).load(ctx, u.user.ID, page)
+	if err != nil {
+		return nil, u.resolver.error(err)
+	}
+
+	conn := &userLogConnection{edges: make([]*userLogEdge, 0, len(logs)), pageInfo: newPageInfo(page.offset, len(logs), total)}
+	for i, l := range logs {
+		conn.edges = append(conn.edges, &userLogEdge{cursor: encodeCursor(page.offset + i), node: &userLogResolver{log: l}})
+	}
+	return conn, nil
+}
+
+type userLogResolver struct {
+	log *domain.UserLog
+}
+
+func (l *userLogResolver) ID() graphql.ID          { return graphql.ID(l.log.ID) }
+func (l *userLogResolver) Action() string          { return strings.ToUpper(string(l.log.Action)) }
+func (l *userLogResolver) CreatedAt() graphql.Time { return graphql.Time{Time: l.log.CreatedAt} }
+
+// Reason UserLog.reason（理由のない操作では null）
+func (l *userLogResolver) Reason() *string {
+	if l.log.Reason == "" {
+		return nil
+	}
+	return &l.log.Reason
+}
+
+// --- Connection ---
+
+type userConnection struct {
+	edges    []*userEdge
+	pageInfo *pageInfo
+}
+
+func (c *userConnection) Edges() []*userEdge  { return c.edges }
+func (c *userConnection) PageInfo() *pageInfo { return c.pageInfo }
+func (c *userConnection) TotalCount() int32   { return int32(c.pageInfo.total) }
+
+type userEdge struct {
+	cursor string
+	node   *userResolver
+}
+
+func (e *userEdge) Cursor() string      { return e.cursor }
+func (e *userEdge) Node() *userResolver { return e.node }
+
+type userLogConnection struct {
+	edges    []*userLogEdge
+	pageInfo *pageInfo
+}
+
+func (c *userLogConnection) Edges() []*userLogEdge { return c.edges }
+func (c *userLogConnection) PageInfo() *pageInfo   { return c.pageInfo }
+func (c *userLogConnection) TotalCount() int32     { return int32(c.pageInfo.total) }
+
+type userLogEdge struct {
+	cursor string
+	node   *userLogResolver
+}
+
+func (e *userLogEdge) Cursor() string         { return e.cursor }
+func (e *userLogEdge) Node() *userLogResolver { return e.node }
@@ -0,0 +1,173 @@
+package handler
+
+import (
+	"encoding/json"
+	"log/slog"
+	"net/http"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/usecase"
+	"github.com/example/go-react-cqrs-template/pkg/generated/openapi"
+)
+
+// GroupHandler グループとメンバーシップのHTTPハンドラー
+type GroupHandler struct {
+	createGroup        *usecase.CreateGroupUsecase
+	findGroup          *usecase.FindGroupUsecase
+	addGroupMembers    *usecase.AddGroupMembersUsecase
+	removeGroupMembers *usecase.RemoveGroupMembersUsecase
+	listGroupMembers   *usecase.ListGroupMembersUsecase
+	listUserGroups     *usecase.ListUserGroupsUsecase
+	logger             *slog.Logger
+}
+
+// NewGroupHandler GroupHandlerのコンストラクタ
+func NewGroupHandler(
+	createGroup *usecase.CreateGroupUsecase,
+	findGroup *usecase.FindGroupUsecase,
+	addGroupMembers *usecase.AddGroupMembersUsecase,
+	removeGroupMembers *usecase.RemoveGroupMembersUsecase,
+	listGroupMembers *usecase.ListGroupMembersUsecase,
+	listUserGroups *usecase.ListUserGroupsUsecase,
+	logger *slog.Logger,
+) *GroupHandler {
+	return &GroupHandler{
+		createGroup:        createGroup,
+		findGroup:          findGroup,
+		addGroupMembers:    addGroupMembers,
+		removeGroupMembers: removeGroupMembers,
+		listGroupMembers:   listGroupMembers,
+		listUserGroups:     listUserGroups,
+		logger:             logger,
+	}
+}
+
+// GroupsCreateGroup グループを作成（OpenAPI ServerInterface実装）
+func (h *GroupHandler) GroupsCreateGroup(w http.ResponseWriter, r *http.Request) {
+	var req openapi.CreateGroupRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, http.StatusBadRequest, "リクエストの形式が不正です")
+		return
+	}
+
+	group, err := h.createGroup.Execute(r.Context(), req.Name)
+	if err != nil {
+		HandleError(w, err, h.logger)
+		return
+	}
+
+	respondJSON(w, http.StatusCreated, toGroupResponse(group))
+}
+
+// GroupsGetGroup グループを取得（OpenAPI ServerInterface実装）
+func (h *GroupHandler) GroupsGetGroup(w http.ResponseWriter, r *http.Request, groupId string) {
+	group, err := h.findGroup.Execute(r.Context(), groupId)
+	if err != nil {
+		HandleError(w, err, h.logger)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, toGroupResponse(group))
+}
+
+// GroupsListGroupMembers グループのメンバー一覧を取得（OpenAPI ServerInterface実装）
+func (h *GroupHandler) GroupsListGroupMembers(w http.ResponseWriter, r *http.Request, groupId string, params openapi.GroupsListGroupMembersParams) {
+	limit, offset := pagination(params.Limit, params.Offset)
+
+	members, total, err := h.listGroupMembers.Execute(r.Context(), groupId, limit, offset)
+	if err != nil {
+		HandleError(w, err, h.logger)
+		return
+	}
+
+	responses := make([]openapi.GroupMember, 0, len(members))
+	for _, m := range members {
+		responses = append(responses, openapi.GroupMember{
+			UserId:   m.UserID,
+			Role:     openapi.GroupRole(m.Role),
+			JoinedAt: m.CreatedAt,
+		})
+	}
+
+	respondJSON(w, http.StatusOK, openapi.GroupMemberList{
+		Members: responses,
+		Total:   int32(total),
+	})
+}
+
+// GroupsAddGroupMembers ユーザーをまとめてグループに追加（OpenAPI ServerInterface実装）
+func (h *GroupHandler) GroupsAddGroupMembers(w http.ResponseWriter, r *http.Request, groupId string) {
+	var req openapi.AddGroupMembersRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, http.StatusBadRequest, "リクエストの形式が不正です")
+		return
+	}
+
+	members := make([]usecase.GroupMemberInput, len(req.Members))
+	for i, m := range req.Members {
+		role := domain.GroupRoleMember
+		if m.Role != nil {
+			role = domain.GroupRole(*m.Role)
+		}
+		members[i] = usecase.GroupMemberInput{UserID: m.UserId, Role: role}
+	}
+
+	if err := h.addGroupMembers.Execute(r.Context(), groupId, members); err != nil {
+		HandleError(w, err, h.logger)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// GroupsRemoveGroupMembers ユーザーをまとめてグループから外す（OpenAPI ServerInterface実装）
+func (h *GroupHandler) GroupsRemoveGroupMembers(w http.ResponseWriter, r *http.Request, groupId string) {
+	var req openapi.RemoveGroupMembersRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, http.StatusBadRequest, "リクエストの形式が不正です")
+		return
+	}
+	for _, id := range req.UserIds {
+		if err := ValidateUserID(id); err != nil {
+			HandleError(w, err, h.logger)
+			return
+		}
+	}
+
+	if err := h.removeGroupMembers.Execute(r.Context(), groupId, req.UserIds); err != nil {
+		HandleError(w, err, h.logger)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// UserGroupsListUserGroups ユーザーの所属グループ一覧を取得（OpenAPI ServerInterface実装）
+func (h *GroupHandler) UserGroupsListUserGroups(w http.ResponseWriter, r *http.Request, userId string) {
+	memberships, err := h.listUserGroups.Execute(r.Context(), userId)
+	if err != nil {
+		HandleError(w, err, h.logger)
+		return
+	}
+
+	groups := make([]openapi.UserGroup, 0, len(memberships))
+	for _, m := range memberships {
+		groups = append(groups, openapi.UserGroup{
+			Group:    toGroupResponse(m.Group),
+			Role:     openapi.GroupRole(m.Role),
+			JoinedAt: m.JoinedAt,
+		})
+	}
+
+	respondJSON(w, http.StatusOK, openapi.UserGroupList{Groups: groups})
+}
+
+// toGroupResponse ドメインモデルをレスポンスに変換
+func toGroupResponse(group *domain.Group) openapi.Group {
+	return openapi.Group{
+		Id:        group.ID,
+		Name:      group.Name,
+		CreatedAt: group.CreatedAt,
+		UpdatedAt: group.UpdatedAt,
+	}
+}
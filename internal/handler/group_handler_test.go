@@ -0,0 +1,110 @@
+package handler_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure/memory"
+	"github.com/example/go-react-cqrs-template/pkg/generated/openapi"
+)
+
+// TestGroupHandler_ResponsesMatchSpec グループのエンドポイントのレスポンスが openapi.yaml に準拠していることを検証する
+func TestGroupHandler_ResponsesMatchSpec(t *testing.T) {
+	user, err := domain.NewUser("John Doe", "john@example.com")
+	if err != nil {
+		t.Fatalf("Failed to create user: %v", err)
+	}
+	group, err := domain.NewGroup("Engineering")
+	if err != nil {
+		t.Fatalf("Failed to create group: %v", err)
+	}
+	member, err := domain.NewGroupMember(group.ID, user.ID, domain.GroupRoleOwner)
+	if err != nil {
+		t.Fatalf("Failed to create group member: %v", err)
+	}
+
+	const missingID = "01ARZ3NDEKTSV4RRFFQ69G5FAV"
+	members := "/groups/" + group.ID + "/members"
+	tests := []struct {
+		name       string
+		method     string
+		path       string
+		body       string
+		wantStatus int
+	}{
+		{name: "create group", method: http.MethodPost, path: "/groups", body: `{"name":"Sales"}`, wantStatus: http.StatusCreated},
+		{name: "create duplicate group", method: http.MethodPost, path: "/groups", body: `{"name":"Engineering"}`, wantStatus: http.StatusConflict},
+		{name: "create group without name", method: http.MethodPost, path: "/groups", body: `{"name":""}`, wantStatus: http.StatusBadRequest},
+		{name: "get group", method: http.MethodGet, path: "/groups/" + group.ID, wantStatus: http.StatusOK},
+		{name: "get missing group", method: http.MethodGet, path: "/groups/" + missingID, wantStatus: http.StatusNotFound},
+		{name: "list group members", method: http.MethodGet, path: members + "?limit=10&offset=0", wantStatus: http.StatusOK},
+		{name: "list members of missing group", method: http.MethodGet, path: "/groups/" + missingID + "/members", wantStatus: http.StatusNotFound},
+		{name: "add group members", method: http.MethodPost, path: members + ":add", body: `{"members":[{"userId":"` + user.ID + `","role":"member"}]}`, wantStatus: http.StatusNoContent},
+		{name: "add missing user", method: http.MethodPost, path: members + ":add", body: `{"members":[{"userId":"` + missingID + `"}]}`, wantStatus: http.StatusNotFound},
+		{name: "add with unknown role", method: http.MethodPost, path: members + ":add", body: `{"members":[{"userId":"` + user.ID + `","role":"admin"}]}`, wantStatus: http.StatusBadRequest},
+		{name: "add without members", method: http.MethodPost, path: members + ":add", body: `{"members":[]}`, wantStatus: http.StatusBadRequest},
+		{name: "remove group members", method: http.MethodPost, path: members + ":remove", body: `{"userIds":["` + user.ID + `"]}`, wantStatus: http.StatusNoContent},
+		{name: "remove with invalid user id", method: http.MethodPost, path: members + ":remove", body: `{"userIds":["invalid"]}`, wantStatus: http.StatusBadRequest},
+		{name: "remove from missing group", method: http.MethodPost, path: "/groups/" + missingID + "/members:remove", body: `{"userIds":["` + user.ID + `"]}`, wantStatus: http.StatusNotFound},
+		{name: "list user groups", method: http.MethodGet, path: "/users/" + user.ID + "/groups", wantStatus: http.StatusOK},
+		{name: "list groups of missing user", method: http.MethodGet, path: "/users/" + missingID + "/groups", wantStatus: http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := memory.NewStore()
+			store.Seed(user)
+			store.SeedGroups(group)
+			store.SeedGroupMembers(member)
+			router := newTestRouter(t, store)
+
+			req := httptest.NewRequest(tt.method, tt.path, bytes.NewBufferString(tt.body))
+			if tt.body != "" {
+				req.Header.Set("Content-Type", "application/json")
+			}
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantStatus {
+				t.Errorf("status = %d, want %d (body: %s)", rec.Code, tt.wantStatus, rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestGroupHandler_ListUserGroups(t *testing.T) {
+	user, err := domain.NewUser("John Doe", "john@example.com")
+	if err != nil {
+		t.Fatalf("Failed to create user: %v", err)
+	}
+	group, err := domain.NewGroup("Engineering")
+	if err != nil {
+		t.Fatalf("Failed to create group: %v", err)
+	}
+	store := memory.NewStore()
+	store.Seed(user)
+	store.SeedGroups(group)
+	router := newTestRouter(t, store)
+
+	req := httptest.NewRequest(http.MethodPost, "/groups/"+group.ID+"/members:add", bytes.NewBufferString(`{"members":[{"userId":"`+user.ID+`"}]}`))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+	if rec.Code != http.StatusNoContent {
+		t.Fatalf("add members status = %d, want %d (body: %s)", rec.Code, http.StatusNoContent, rec.Body.String())
+	}
+
+	// ロールを省略した場合は member として参加する
+	rec = getWithHeaders(router, "/users/"+user.ID+"/groups", nil)
+	var list openapi.UserGroupList
+	if err := json.NewDecoder(rec.Body).Decode(&list); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if len(list.Groups) != 1 || list.Groups[0].Group.Id != group.ID || list.Groups[0].Role != openapi.GroupRole(domain.GroupRoleMember) {
+		t.Errorf("user groups = %+v, want %s as member", list, group.ID)
+	}
+}
@@ -0,0 +1,150 @@
+package handler
+
+import (
+	"encoding/json"
+	"log/slog"
+	"net/http"
+
+	"github.com/example/go-react-cqrs-template/internal/auth"
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/usecase"
+	"github.com/example/go-react-cqrs-template/pkg/generated/openapi"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// InvitationHandler 招待のHTTPハンドラー
+type InvitationHandler struct {
+	createInvitation *usecase.CreateInvitationUsecase
+	listInvitations  *usecase.ListInvitationsUsecase
+	resendInvitation *usecase.ResendInvitationUsecase
+	revokeInvitation *usecase.RevokeInvitationUsecase
+	acceptInvitation *usecase.AcceptInvitationUsecase
+	logger           *slog.Logger
+}
+
+// NewInvitationHandler InvitationHandlerのコンストラクタ
+func NewInvitationHandler(
+	createInvitation *usecase.CreateInvitationUsecase,
+	listInvitations *usecase.ListInvitationsUsecase,
+	resendInvitation *usecase.ResendInvitationUsecase,
+	revokeInvitation *usecase.RevokeInvitationUsecase,
+	acceptInvitation *usecase.AcceptInvitationUsecase,
+	logger *slog.Logger,
+) *InvitationHandler {
+	return &InvitationHandler{
+		createInvitation: createInvitation,
+		listInvitations:  listInvitations,
+		resendInvitation: resendInvitation,
+		revokeInvitation: revokeInvitation,
+		acceptInvitation: acceptInvitation,
+		logger:           logger,
+	}
+}
+
+// InvitationsCreateInvitation メールアドレスでユーザーを招待（OpenAPI ServerInterface実装）
+//
+// ログイン済みのリクエストでは、ログイン中のユーザーを招待者として記録する。
+func (h *InvitationHandler) InvitationsCreateInvitation(w http.ResponseWriter, r *http.Request) {
+	var req openapi.CreateInvitationRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, http.StatusBadRequest, "リクエストの形式が不正です")
+		return
+	}
+	var name string
+	if req.Name != nil {
+		name = *req.Name
+	}
+	invitedBy, _ := auth.UserID(r.Context())
+
+	inv, err := h.createInvitation.Execute(r.Context(), string(req.Email), name, invitedBy)
+	if err != nil {
+		HandleError(w, err, h.logger)
+		return
+	}
+
+	respondJSON(w, http.StatusCreated, toInvitationResponse(inv))
+}
+
+// InvitationsListInvitations 承諾待ちの招待一覧を取得（OpenAPI ServerInterface実装）
+func (h *InvitationHandler) InvitationsListInvitations(w http.ResponseWriter, r *http.Request, params openapi.InvitationsListInvitationsParams) {
+	limit, offset := pagination(params.Limit, params.Offset)
+
+	invs, total, err := h.listInvitations.Execute(r.Context(), limit, offset)
+	if err != nil {
+		HandleError(w, err, h.logger)
+		return
+	}
+
+	responses := make([]openapi.Invitation, 0, len(invs))
+	for _, inv := range invs {
+		responses = append(responses, toInvitationResponse(inv))
+	}
+
+	respondJSON(w, http.StatusOK, openapi.InvitationList{
+		Invitations: responses,
+		Total:       int32(total),
+	})
+}
+
+// InvitationsResendInvitation 招待を再送（OpenAPI ServerInterface実装）
+func (h *InvitationHandler) InvitationsResendInvitation(w http.ResponseWriter, r *http.Request, invitationId string) {
+	inv, err := h.resendInvitation.Execute(r.Context(), invitationId)
+	if err != nil {
+		HandleError(w, err, h.logger)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, toInvitationResponse(inv))
+}
+
+// InvitationsRevokeInvitation 招待を取り消し（OpenAPI ServerInterface実装）
+func (h *InvitationHandler) InvitationsRevokeInvitation(w http.ResponseWriter, r *http.Request, invitationId string) {
+	if err := h.revokeInvitation.Execute(r.Context(), invitationId); err != nil {
+		HandleError(w, err, h.logger)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// InvitationsAcceptInvitation メールで送ったトークンで招待を承諾（OpenAPI ServerInterface実装）
+func (h *InvitationHandler) InvitationsAcceptInvitation(w http.ResponseWriter, r *http.Request) {
+	var req openapi.AcceptInvitationRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, http.StatusBadRequest, "リクエストの形式が不正です")
+		return
+	}
+	var name string
+	if req.Name != nil {
+		name = *req.Name
+	}
+
+	user, err := h.acceptInvitation.Execute(r.Context(), req.Token, name, req.Password)
+	if err != nil {
+		HandleError(w, err, h.logger)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, toUserResponse(user))
+}
+
+// toInvitationResponse ドメインモデルをレスポンスに変換
+func toInvitationResponse(inv *domain.Invitation) openapi.Invitation {
+	response := openapi.Invitation{
+		Id:        inv.ID,
+		UserId:    inv.UserID,
+		Email:     openapi_types.Email(inv.Email),
+		Status:    openapi.InvitationStatus(inv.Status),
+		ExpiresAt: inv.ExpiresAt,
+		CreatedAt: inv.CreatedAt,
+	}
+	if inv.InvitedBy != "" {
+		invitedBy := inv.InvitedBy
+		response.InvitedBy = &invitedBy
+	}
+	if !inv.AcceptedAt.IsZero() {
+		acceptedAt := inv.AcceptedAt
+		response.AcceptedAt = &acceptedAt
+	}
+	return response
+}
@@ -0,0 +1,125 @@
+package handler_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure/memory"
+	"github.com/example/go-react-cqrs-template/internal/mail"
+	"github.com/example/go-react-cqrs-template/internal/testutil/mailtest"
+	"github.com/example/go-react-cqrs-template/pkg/generated/openapi"
+)
+
+func TestInvitationHandler_InviteAndAccept(t *testing.T) {
+	admin, err := domain.NewUser("Admin", "admin@example.com")
+	if err != nil {
+		t.Fatalf("Failed to create user: %v", err)
+	}
+	store := memory.NewStore()
+	store.Seed(admin)
+	mailDir := t.TempDir()
+	mailer, err := mail.NewFileMailer(mailDir, "no-reply@example.com")
+	if err != nil {
+		t.Fatalf("NewFileMailer() unexpected error: %v", err)
+	}
+	router := newTestRouterWithMailer(t, store, mailer)
+	adminToken := mustIssueToken(t, store, admin)
+
+	// ログイン中のユーザーを招待者として記録する
+	rec := postJSON(t, router, "/invitations", adminToken, `{"email":"john@example.com"}`)
+	if rec.Code != http.StatusCreated {
+		t.Fatalf("create invitation status = %d, want %d: %s", rec.Code, http.StatusCreated, rec.Body.String())
+	}
+	var inv openapi.Invitation
+	if err := json.NewDecoder(rec.Body).Decode(&inv); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if inv.Status != openapi.InvitationStatusPending || inv.InvitedBy == nil || *inv.InvitedBy != admin.ID {
+		t.Errorf("invitation = %+v, want pending invitation by %s", inv, admin.ID)
+	}
+	if rec = postJSON(t, router, "/invitations", adminToken, `{"email":"john@example.com"}`); rec.Code != http.StatusConflict {
+		t.Errorf("duplicate invitation status = %d, want %d", rec.Code, http.StatusConflict)
+	}
+
+	// 承諾待ちの招待の一覧
+	req := httptest.NewRequest(http.MethodGet, "/invitations?limit=10&offset=0", nil)
+	rec = httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("list invitations status = %d, want %d: %s", rec.Code, http.StatusOK, rec.Body.String())
+	}
+	var list openapi.InvitationList
+	if err := json.NewDecoder(rec.Body).Decode(&list); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if list.Total != 1 || len(list.Invitations) != 1 || list.Invitations[0].Id != inv.Id {
+		t.Errorf("list = %+v, want the created invitation", list)
+	}
+
+	// 再送すると以前のリンクは使えなくなる
+	oldToken := mailtest.LinkToken(t, mailtest.Last(t, mailDir))
+	rec = postJSON(t, router, "/invitations/"+inv.Id+":resend", adminToken, "")
+	if rec.Code != http.StatusOK {
+		t.Fatalf("resend invitation status = %d, want %d: %s", rec.Code, http.StatusOK, rec.Body.String())
+	}
+	token := mailtest.LinkToken(t, mailtest.Last(t, mailDir))
+	rec = postJSON(t, router, "/invitations:accept", "", `{"token":"`+oldToken+`","password":"correct horse battery"}`)
+	if rec.Code != http.StatusBadRequest {
+		t.Errorf("accept with old token status = %d, want %d", rec.Code, http.StatusBadRequest)
+	}
+
+	// 承諾すると名前とパスワードを設定した利用中のユーザーになり、ログインできる
+	rec = postJSON(t, router, "/invitations:accept", "", `{"token":"`+token+`","name":"John Doe","password":"correct horse battery"}`)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("accept invitation status = %d, want %d: %s", rec.Code, http.StatusOK, rec.Body.String())
+	}
+	var user openapi.User
+	if err := json.NewDecoder(rec.Body).Decode(&user); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if user.Id != inv.UserId || user.Name != "John Doe" || user.Status != openapi.Active || user.EmailVerifiedAt == nil {
+		t.Errorf("user = %+v, want active verified John Doe", user)
+	}
+	rec = postJSON(t, router, "/auth/login", "", `{"email":"john@example.com","password":"correct horse battery"}`)
+	if rec.Code != http.StatusOK {
+		t.Errorf("login as invited user status = %d, want %d: %s", rec.Code, http.StatusOK, rec.Body.String())
+	}
+
+	// 承諾済みの招待は再送・取り消しできない
+	if rec = postJSON(t, router, "/invitations/"+inv.Id+":resend", adminToken, ""); rec.Code != http.StatusConflict {
+		t.Errorf("resend accepted invitation status = %d, want %d", rec.Code, http.StatusConflict)
+	}
+	if rec = postJSON(t, router, "/invitations/"+inv.Id+":revoke", adminToken, ""); rec.Code != http.StatusConflict {
+		t.Errorf("revoke accepted invitation status = %d, want %d", rec.Code, http.StatusConflict)
+	}
+}
+
+func TestInvitationHandler_Revoke(t *testing.T) {
+	store := memory.NewStore()
+	router := newTestRouter(t, store)
+
+	rec := postJSON(t, router, "/invitations", "", `{"email":"john@example.com","name":"John Doe"}`)
+	if rec.Code != http.StatusCreated {
+		t.Fatalf("create invitation status = %d, want %d: %s", rec.Code, http.StatusCreated, rec.Body.String())
+	}
+	var inv openapi.Invitation
+	if err := json.NewDecoder(rec.Body).Decode(&inv); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if inv.InvitedBy != nil {
+		t.Errorf("invitedBy = %q, want absent without authentication", *inv.InvitedBy)
+	}
+
+	if rec = postJSON(t, router, "/invitations/"+inv.Id+":revoke", "", ""); rec.Code != http.StatusNoContent {
+		t.Fatalf("revoke invitation status = %d, want %d: %s", rec.Code, http.StatusNoContent, rec.Body.String())
+	}
+	if rec = postJSON(t, router, "/invitations/01ARZ3NDEKTSV4RRFFQ69G5FAV:revoke", "", ""); rec.Code != http.StatusNotFound {
+		t.Errorf("revoke missing invitation status = %d, want %d", rec.Code, http.StatusNotFound)
+	}
+	if logs := store.UserLogs(inv.UserId); len(logs) != 2 || logs[1].Action != domain.UserLogActionInvitationRevoked {
+		t.Errorf("UserLogs = %+v, want invited and invitation_revoked", logs)
+	}
+}
@@ -0,0 +1,140 @@
+package handler
+
+import (
+	"encoding/json"
+	"log/slog"
+	"net/http"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/usecase"
+	"github.com/example/go-react-cqrs-template/pkg/generated/openapi"
+)
+
+// MFAHandler TOTP の二要素認証の登録・有効化・無効化とログインの2段階目のHTTPハンドラー
+type MFAHandler struct {
+	enrollTOTP     *usecase.EnrollTOTPUsecase
+	confirmTOTP    *usecase.ConfirmTOTPUsecase
+	disableTOTP    *usecase.DisableTOTPUsecase
+	verifyMFALogin *usecase.VerifyMFALoginUsecase
+	logger         *slog.Logger
+}
+
+// NewMFAHandler MFAHandlerのコンストラクタ
+func NewMFAHandler(
+	enrollTOTP *usecase.EnrollTOTPUsecase,
+	confirmTOTP *usecase.ConfirmTOTPUsecase,
+	disableTOTP *usecase.DisableTOTPUsecase,
+	verifyMFALogin *usecase.VerifyMFALoginUsecase,
+	logger *slog.Logger,
+) *MFAHandler {
+	return &MFAHandler{
+		enrollTOTP:     enrollTOTP,
+		confirmTOTP:    confirmTOTP,
+		disableTOTP:    disableTOTP,
+		verifyMFALogin: verifyMFALogin,
+		logger:         logger,
+	}
+}
+
+// UserMFAEnrollTOTP 現在のパスワードで TOTP の二要素認証の登録を開始（OpenAPI ServerInterface実装）
+//
+// 認証アプリに登録する秘密鍵・otpauth URI・QR コードの PNG 画像を返す。本人としてログインしたリクエストのみ受け付ける。
+func (h *MFAHandler) UserMFAEnrollTOTP(w http.ResponseWriter, r *http.Request, userId string) {
+	if !authorizeSelf(w, r, h.logger, userId, "enroll mfa", "他のユーザーの二要素認証は登録できません") {
+		return
+	}
+
+	var req openapi.EnrollTOTPRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, http.StatusBadRequest, "リクエストの形式が不正です")
+		return
+	}
+
+	enrollment, err := h.enrollTOTP.Execute(r.Context(), userId, req.Password)
+	if err != nil {
+		HandleError(w, err, h.logger)
+		return
+	}
+
+	// 秘密鍵をキャッシュさせない
+	w.Header().Set("Cache-Control", "no-store")
+	respondJSON(w, http.StatusOK, openapi.TOTPEnrollment{
+		Secret:     enrollment.Secret,
+		OtpauthUri: enrollment.URI,
+		QrCodePng:  enrollment.QRCode,
+	})
+}
+
+// UserMFAVerifyTOTP 認証アプリの確認コードで TOTP の二要素認証を有効化（OpenAPI ServerInterface実装）
+//
+// 有効化するとリカバリーコードを返す（再表示はできない）。
+func (h *MFAHandler) UserMFAVerifyTOTP(w http.ResponseWriter, r *http.Request, userId string) {
+	if !authorizeSelf(w, r, h.logger, userId, "enable mfa", "他のユーザーの二要素認証は有効にできません") {
+		return
+	}
+
+	var req openapi.TOTPCodeRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, http.StatusBadRequest, "リクエストの形式が不正です")
+		return
+	}
+
+	codes, err := h.confirmTOTP.Execute(r.Context(), userId, req.Code)
+	if err != nil {
+		HandleError(w, err, h.logger)
+		return
+	}
+
+	// リカバリーコードをキャッシュさせない
+	w.Header().Set("Cache-Control", "no-store")
+	respondJSON(w, http.StatusOK, openapi.RecoveryCodeList{RecoveryCodes: codes})
+}
+
+// UserMFADisableTOTP 確認コードまたはリカバリーコードで TOTP の二要素認証を無効化（OpenAPI ServerInterface実装）
+func (h *MFAHandler) UserMFADisableTOTP(w http.ResponseWriter, r *http.Request, userId string) {
+	if !authorizeSelf(w, r, h.logger, userId, "disable mfa", "他のユーザーの二要素認証は無効にできません") {
+		return
+	}
+
+	var req openapi.TOTPCodeRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, http.StatusBadRequest, "リクエストの形式が不正です")
+		return
+	}
+
+	if err := h.disableTOTP.Execute(r.Context(), userId, req.Code); err != nil {
+		HandleError(w, err, h.logger)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// AuthVerifyMFA ログインの2段階目のトークンと確認コードでログインを完了（OpenAPI ServerInterface実装）
+//
+// ログインと同じくセッションを作成し、端末名・接続元IPアドレス・User-Agent を記録する。
+func (h *MFAHandler) AuthVerifyMFA(w http.ResponseWriter, r *http.Request) {
+	var req openapi.VerifyMFALoginRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, http.StatusBadRequest, "リクエストの形式が不正です")
+		return
+	}
+
+	client := domain.SessionClient{
+		IPAddress: remoteIP(r),
+		UserAgent: r.UserAgent(),
+	}
+	if req.Device != nil {
+		client.Device = *req.Device
+	}
+
+	result, err := h.verifyMFALogin.Execute(r.Context(), req.MfaToken, req.Code, client)
+	if err != nil {
+		HandleError(w, err, h.logger)
+		return
+	}
+
+	// トークンをキャッシュさせない
+	w.Header().Set("Cache-Control", "no-store")
+	respondJSON(w, http.StatusOK, toLoginResponse(result))
+}
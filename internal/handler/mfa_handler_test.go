@@ -0,0 +1,122 @@
+package handler_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/pquerna/otp"
+	"github.com/pquerna/otp/totp"
+
+	"github.com/example/go-react-cqrs-template/internal/auth"
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure/memory"
+	"github.com/example/go-react-cqrs-template/pkg/generated/openapi"
+)
+
+// totpCode テスト用に秘密鍵 secret の時刻 at の確認コードを生成する（認証アプリの代わり）
+func totpCode(t *testing.T, secret string, at time.Time) string {
+	t.Helper()
+	code, err := totp.GenerateCodeCustom(secret, at, totp.ValidateOpts{Period: auth.TOTPPeriod, Digits: otp.DigitsSix, Algorithm: otp.AlgorithmSHA1})
+	if err != nil {
+		t.Fatalf("GenerateCodeCustom() unexpected error: %v", err)
+	}
+	return code
+}
+
+func TestMFAHandler_EnrollAndLogin(t *testing.T) {
+	user, err := domain.NewUser("John Doe", "john@example.com")
+	if err != nil {
+		t.Fatalf("Failed to create user: %v", err)
+	}
+	store := memory.NewStore()
+	store.Seed(user)
+	store.SeedCredentials(mustNewCredential(t, user, "correct horse battery"))
+	router := newTestRouter(t, store)
+	totpPath := "/users/" + user.ID + "/mfa/totp"
+
+	// 未ログインでは登録できない
+	if rec := postJSON(t, router, totpPath, "", `{"password":"correct horse battery"}`); rec.Code != http.StatusUnauthorized {
+		t.Errorf("enroll without login status = %d, want %d", rec.Code, http.StatusUnauthorized)
+	}
+
+	// ログイン中に現在のパスワードで登録を開始し、秘密鍵と QR コードを受け取る
+	token := mustIssueToken(t, store, user)
+	rec := postJSON(t, router, totpPath, token, `{"password":"correct horse battery"}`)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("enroll status = %d, want %d: %s", rec.Code, http.StatusOK, rec.Body.String())
+	}
+	if got := rec.Header().Get("Cache-Control"); got != "no-store" {
+		t.Errorf("Cache-Control = %q, want no-store", got)
+	}
+	var enrollment openapi.TOTPEnrollment
+	if err := json.NewDecoder(rec.Body).Decode(&enrollment); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if !bytes.HasPrefix(enrollment.QrCodePng, []byte("\x89PNG")) {
+		t.Error("qrCodePng is not a PNG image")
+	}
+
+	// 誤った確認コードでは有効にならない
+	if rec = postJSON(t, router, totpPath+":verify", token, `{"code":"000000x"}`); rec.Code != http.StatusUnauthorized {
+		t.Errorf("verify with wrong code status = %d, want %d", rec.Code, http.StatusUnauthorized)
+	}
+	rec = postJSON(t, router, totpPath+":verify", token, `{"code":"`+totpCode(t, enrollment.Secret, time.Now().Add(-time.Duration(auth.TOTPPeriod)*time.Second))+`"}`)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("verify status = %d, want %d: %s", rec.Code, http.StatusOK, rec.Body.String())
+	}
+	var recovery openapi.RecoveryCodeList
+	if err := json.NewDecoder(rec.Body).Decode(&recovery); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if len(recovery.RecoveryCodes) != domain.RecoveryCodeCount {
+		t.Fatalf("recovery codes = %d, want %d", len(recovery.RecoveryCodes), domain.RecoveryCodeCount)
+	}
+
+	// パスワードの照合後は 202 で2段階目のトークンを返す
+	sessions := len(store.Sessions(user.ID))
+	rec = postJSON(t, router, "/auth/login", "", `{"email":"john@example.com","password":"correct horse battery"}`)
+	if rec.Code != http.StatusAccepted {
+		t.Fatalf("login status = %d, want %d: %s", rec.Code, http.StatusAccepted, rec.Body.String())
+	}
+	var challenge openapi.MFAChallenge
+	if err := json.NewDecoder(rec.Body).Decode(&challenge); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if len(store.Sessions(user.ID)) != sessions {
+		t.Error("session created before the second step")
+	}
+
+	rec = postJSON(t, router, "/auth/mfa:verify", "", `{"mfaToken":"`+challenge.MfaToken+`","code":"`+totpCode(t, enrollment.Secret, time.Now())+`","device":"laptop"}`)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("mfa verify status = %d, want %d: %s", rec.Code, http.StatusOK, rec.Body.String())
+	}
+	var loggedIn openapi.LoginResponse
+	if err := json.NewDecoder(rec.Body).Decode(&loggedIn); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if loggedIn.User.Id != user.ID {
+		t.Errorf("user = %s, want %s", loggedIn.User.Id, user.ID)
+	}
+
+	// 他のユーザーの二要素認証は操作できない
+	other, err := domain.NewUser("Jane Doe", "jane@example.com")
+	if err != nil {
+		t.Fatalf("Failed to create user: %v", err)
+	}
+	store.Seed(other)
+	if rec = postJSON(t, router, "/users/"+other.ID+"/mfa/totp", loggedIn.Token, `{"password":"correct horse battery"}`); rec.Code != http.StatusForbidden {
+		t.Errorf("enroll other's mfa status = %d, want %d", rec.Code, http.StatusForbidden)
+	}
+
+	// リカバリーコードで無効化すると、パスワードのみでログインできる
+	if rec = postJSON(t, router, totpPath+":disable", loggedIn.Token, `{"code":"`+recovery.RecoveryCodes[0]+`"}`); rec.Code != http.StatusNoContent {
+		t.Fatalf("disable status = %d, want %d: %s", rec.Code, http.StatusNoContent, rec.Body.String())
+	}
+	if rec = postJSON(t, router, totpPath+":disable", loggedIn.Token, `{"code":"`+recovery.RecoveryCodes[1]+`"}`); rec.Code != http.StatusNotFound {
+		t.Errorf("disable again status = %d, want %d", rec.Code, http.StatusNotFound)
+	}
+	login(t, router, "phone")
+}
@@ -0,0 +1,126 @@
+package handler
+
+import (
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/usecase"
+	"github.com/example/go-react-cqrs-template/pkg/generated/openapi"
+)
+
+// oidcStateCookie ログインを開始したブラウザに state のハッシュを保存する Cookie の名前
+const oidcStateCookie = "oidc_state"
+
+// OIDCHandler OpenID Connect の IdP でのログイン（シングルサインオン）のHTTPハンドラー
+type OIDCHandler struct {
+	start    *usecase.StartOIDCLoginUsecase
+	complete *usecase.CompleteOIDCLoginUsecase
+	logger   *slog.Logger
+}
+
+// NewOIDCHandler OIDCHandlerのコンストラクタ
+func NewOIDCHandler(
+	start *usecase.StartOIDCLoginUsecase,
+	complete *usecase.CompleteOIDCLoginUsecase,
+	logger *slog.Logger,
+) *OIDCHandler {
+	return &OIDCHandler{
+		start:    start,
+		complete: complete,
+		logger:   logger,
+	}
+}
+
+// OIDCLoginAuthorize IdP でのログインを開始（OpenAPI ServerInterface実装）
+//
+// ブラウザで開く IdP の認可エンドポイントの URL を返す。IdP はログイン後に認可コードと state を付けて
+// リダイレクト先のページに戻し、ページはそれらを callback に送る。state のハッシュを HttpOnly の Cookie に保存し、
+// callback は同じブラウザからのリクエストのみ受け付ける。
+func (h *OIDCHandler) OIDCLoginAuthorize(w http.ResponseWriter, r *http.Request, provider string) {
+	authz, err := h.start.Execute(r.Context(), provider)
+	if err != nil {
+		HandleError(w, err, h.logger)
+		return
+	}
+
+	http.SetCookie(w, &http.Cookie{
+		Name:     oidcStateCookie,
+		Value:    authz.StateHash,
+		Path:     "/",
+		Expires:  authz.ExpiresAt,
+		MaxAge:   int(time.Until(authz.ExpiresAt).Seconds()),
+		Secure:   isHTTPS(r),
+		HttpOnly: true,
+		SameSite: http.SameSiteLaxMode,
+	})
+
+	// state を含む URL をキャッシュさせない
+	w.Header().Set("Cache-Control", "no-store")
+	respondJSON(w, http.StatusOK, openapi.OIDCAuthorization{
+		AuthorizationUrl: authz.URL,
+		ExpiresAt:        authz.ExpiresAt,
+	})
+}
+
+// OIDCLoginCallback IdP から受け取った認可コードと state でログイン（OpenAPI ServerInterface実装）
+//
+// パスワードでのログインと同じくセッションを作成し、二要素認証が有効なユーザーにはログインの2段階目の
+// トークンを 202 で返す。authorize で保存した Cookie の state のハッシュと一致しない場合は 400 を返す。
+// Cookie は成否に関わらず削除する（state は一度限りのため）。
+func (h *OIDCHandler) OIDCLoginCallback(w http.ResponseWriter, r *http.Request, provider string) {
+	var req openapi.OIDCCallbackRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, http.StatusBadRequest, "リクエストの形式が不正です")
+		return
+	}
+
+	var stateHash string
+	if cookie, err := r.Cookie(oidcStateCookie); err == nil {
+		stateHash = cookie.Value
+	}
+	http.SetCookie(w, &http.Cookie{
+		Name:     oidcStateCookie,
+		Path:     "/",
+		MaxAge:   -1,
+		Secure:   isHTTPS(r),
+		HttpOnly: true,
+		SameSite: http.SameSiteLaxMode,
+	})
+
+	client := domain.SessionClient{
+		IPAddress: remoteIP(r),
+		UserAgent: r.UserAgent(),
+	}
+	if req.Device != nil {
+		client.Device = *req.Device
+	}
+
+	result, err := h.complete.Execute(r.Context(), provider, req.Code, req.State, stateHash, client)
+	if err != nil {
+		HandleError(w, err, h.logger)
+		return
+	}
+
+	// トークンをキャッシュさせない
+	w.Header().Set("Cache-Control", "no-store")
+	if result.MFAToken != "" {
+		respondJSON(w, http.StatusAccepted, openapi.MFAChallenge{
+			MfaToken:  result.MFAToken,
+			ExpiresAt: result.MFAExpiresAt,
+		})
+		return
+	}
+	respondJSON(w, http.StatusOK, toLoginResponse(result))
+}
+
+// isHTTPS リクエストが HTTPS か判定する（リバースプロキシ経由の場合は X-Forwarded-Proto を優先）
+func isHTTPS(r *http.Request) bool {
+	if proto := r.Header.Get("X-Forwarded-Proto"); proto != "" {
+		return strings.EqualFold(strings.TrimSpace(strings.Split(proto, ",")[0]), "https")
+	}
+	return r.TLS != nil
+}
@@ -0,0 +1,93 @@
+package handler_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/example/go-react-cqrs-template/internal/auth"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure/memory"
+	"github.com/example/go-react-cqrs-template/internal/testutil/oidctest"
+	"github.com/example/go-react-cqrs-template/internal/usecase"
+	"github.com/example/go-react-cqrs-template/pkg/generated/openapi"
+)
+
+func TestOIDCHandler_Login(t *testing.T) {
+	idp := oidctest.New(t, "example-client", "example-secret")
+	idp.SetUser(oidctest.User{Subject: "emp-001", Email: "jane@example.com", EmailVerified: true, Name: "Jane Roe"})
+	store := memory.NewStore()
+	router := newTestRouterWithOIDC(t, store, map[string]usecase.OIDCProvider{
+		"company": auth.NewOIDCProvider(auth.OIDCConfig{
+			Issuer:       idp.Issuer(),
+			ClientID:     idp.ClientID,
+			ClientSecret: idp.ClientSecret,
+			RedirectURL:  "http://localhost:5173/login/callback",
+		}),
+	})
+
+	if rec := postJSON(t, router, "/auth/oidc/unknown:authorize", "", ``); rec.Code != http.StatusNotFound {
+		t.Errorf("authorize unknown provider status = %d, want %d", rec.Code, http.StatusNotFound)
+	}
+
+	rec := postJSON(t, router, "/auth/oidc/company:authorize", "", ``)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("authorize status = %d, want %d: %s", rec.Code, http.StatusOK, rec.Body.String())
+	}
+	if got := rec.Header().Get("Cache-Control"); got != "no-store" {
+		t.Errorf("Cache-Control = %q, want no-store", got)
+	}
+	cookies := rec.Result().Cookies()
+	if len(cookies) != 1 || !cookies[0].HttpOnly || cookies[0].Value == "" {
+		t.Fatalf("authorize cookies = %+v, want one HttpOnly state cookie", cookies)
+	}
+	var authz openapi.OIDCAuthorization
+	if err := json.NewDecoder(rec.Body).Decode(&authz); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	code, state := idp.Authorize(t, authz.AuthorizationUrl)
+
+	// Cookie のない（ログインを開始していない）ブラウザからのコールバックは受け付けない（ログイン CSRF）
+	rec = postOIDCCallback(router, `{"code":"`+code+`","state":"`+state+`"}`, nil)
+	if rec.Code != http.StatusBadRequest {
+		t.Errorf("callback without state cookie status = %d, want %d", rec.Code, http.StatusBadRequest)
+	}
+
+	// IdP のユーザーを作成してログインする
+	rec = postOIDCCallback(router, `{"code":"`+code+`","state":"`+state+`","device":"Laptop"}`, cookies)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("callback status = %d, want %d: %s", rec.Code, http.StatusOK, rec.Body.String())
+	}
+	if got := rec.Result().Cookies(); len(got) != 1 || got[0].MaxAge >= 0 {
+		t.Errorf("callback cookies = %+v, want the state cookie deleted", got)
+	}
+	var login openapi.LoginResponse
+	if err := json.NewDecoder(rec.Body).Decode(&login); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if login.User.Email != "jane@example.com" || login.Token == "" {
+		t.Errorf("login = %+v, want session of jane@example.com", login)
+	}
+	if rec = getWithHeaders(router, "/users/"+login.User.Id, map[string]string{"Authorization": "Bearer " + login.Token}); rec.Code != http.StatusOK {
+		t.Errorf("get user with the session status = %d, want %d", rec.Code, http.StatusOK)
+	}
+
+	// state は一度限り
+	rec = postOIDCCallback(router, `{"code":"`+code+`","state":"`+state+`"}`, cookies)
+	if rec.Code != http.StatusBadRequest {
+		t.Errorf("callback with used state status = %d, want %d", rec.Code, http.StatusBadRequest)
+	}
+}
+
+// postOIDCCallback IdP "company" のコールバックに cookies を付けて body を送る
+func postOIDCCallback(router http.Handler, body string, cookies []*http.Cookie) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, "/auth/oidc/company:callback", bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", "application/json")
+	for _, c := range cookies {
+		req.AddCookie(c)
+	}
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+	return rec
+}
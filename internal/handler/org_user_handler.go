@@ -0,0 +1,86 @@
+package handler
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/example/go-react-cqrs-template/internal/tenant"
+	"github.com/example/go-react-cqrs-template/internal/usecase"
+	"github.com/example/go-react-cqrs-template/pkg/generated/openapi"
+)
+
+// OrgUserHandler 組織のユーザー（/orgs/{orgId}/users）のHTTPハンドラー
+//
+// パスの組織をテナントとしてコンテキストに設定し、UserHandler に委譲する。
+type OrgUserHandler struct {
+	users            *UserHandler
+	findOrganization *usecase.FindOrganizationUsecase
+	logger           *slog.Logger
+}
+
+// NewOrgUserHandler OrgUserHandlerのコンストラクタ
+func NewOrgUserHandler(users *UserHandler, findOrganization *usecase.FindOrganizationUsecase, logger *slog.Logger) *OrgUserHandler {
+	return &OrgUserHandler{
+		users:            users,
+		findOrganization: findOrganization,
+		logger:           logger,
+	}
+}
+
+// OrgUsersListOrgUsers 組織のユーザー一覧を取得（OpenAPI ServerInterface実装）
+func (h *OrgUserHandler) OrgUsersListOrgUsers(w http.ResponseWriter, r *http.Request, orgId string, params openapi.OrgUsersListOrgUsersParams) {
+	if r, ok := h.scope(w, r, orgId); ok {
+		h.users.UsersListUsers(w, r, openapi.UsersListUsersParams(params))
+	}
+}
+
+// OrgUsersCreateOrgUser 組織にユーザーを作成（OpenAPI ServerInterface実装）
+func (h *OrgUserHandler) OrgUsersCreateOrgUser(w http.ResponseWriter, r *http.Request, orgId string) {
+	if r, ok := h.scope(w, r, orgId); ok {
+		h.users.UsersCreateUser(w, r)
+	}
+}
+
+// OrgUsersGetOrgUser 組織のユーザーを取得（OpenAPI ServerInterface実装）
+func (h *OrgUserHandler) OrgUsersGetOrgUser(w http.ResponseWriter, r *http.Request, orgId string, userId string, params openapi.OrgUsersGetOrgUserParams) {
+	if r, ok := h.scope(w, r, orgId); ok {
+		h.users.UsersGetUser(w, r, userId, openapi.UsersGetUserParams(params))
+	}
+}
+
+// OrgUsersUpdateOrgUser 組織のユーザーを置き換える（OpenAPI ServerInterface実装）
+func (h *OrgUserHandler) OrgUsersUpdateOrgUser(w http.ResponseWriter, r *http.Request, orgId string, userId string) {
+	if r, ok := h.scope(w, r, orgId); ok {
+		h.users.UsersUpdateUser(w, r, userId)
+	}
+}
+
+// OrgUsersPatchOrgUser 組織のユーザーを部分更新（OpenAPI ServerInterface実装）
+func (h *OrgUserHandler) OrgUsersPatchOrgUser(w http.ResponseWriter, r *http.Request, orgId string, userId string) {
+	if r, ok := h.scope(w, r, orgId); ok {
+		h.users.UsersPatchUser(w, r, userId)
+	}
+}
+
+// OrgUsersDeleteOrgUser 組織のユーザーを削除（OpenAPI ServerInterface実装）
+func (h *OrgUserHandler) OrgUsersDeleteOrgUser(w http.ResponseWriter, r *http.Request, orgId string, userId string) {
+	if r, ok := h.scope(w, r, orgId); ok {
+		h.users.UsersDeleteUser(w, r, userId)
+	}
+}
+
+// scope パスの組織をテナントに設定したリクエストを返す
+//
+// プリンシパルが対象にできない組織の場合は 403、存在しない組織の場合は 404 を書き込んで ok=false を返す。
+func (h *OrgUserHandler) scope(w http.ResponseWriter, r *http.Request, orgID string) (_ *http.Request, ok bool) {
+	ctx := r.Context()
+	if err := authorizeOrg(ctx, orgID); err != nil {
+		HandleError(w, err, h.logger)
+		return nil, false
+	}
+	if _, err := h.findOrganization.Execute(ctx, orgID); err != nil {
+		HandleError(w, err, h.logger)
+		return nil, false
+	}
+	return r.WithContext(tenant.WithOrgID(ctx, orgID)), true
+}
@@ -0,0 +1,93 @@
+package handler
+
+import (
+	"encoding/json"
+	"log/slog"
+	"net/http"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/usecase"
+	"github.com/example/go-react-cqrs-template/pkg/generated/openapi"
+)
+
+// OrganizationHandler 組織（テナント）のHTTPハンドラー
+type OrganizationHandler struct {
+	createOrganization *usecase.CreateOrganizationUsecase
+	findOrganization   *usecase.FindOrganizationUsecase
+	listOrganizations  *usecase.ListOrganizationsUsecase
+	logger             *slog.Logger
+}
+
+// NewOrganizationHandler OrganizationHandlerのコンストラクタ
+func NewOrganizationHandler(
+	createOrganization *usecase.CreateOrganizationUsecase,
+	findOrganization *usecase.FindOrganizationUsecase,
+	listOrganizations *usecase.ListOrganizationsUsecase,
+	logger *slog.Logger,
+) *OrganizationHandler {
+	return &OrganizationHandler{
+		createOrganization: createOrganization,
+		findOrganization:   findOrganization,
+		listOrganizations:  listOrganizations,
+		logger:             logger,
+	}
+}
+
+// OrganizationsCreateOrganization 組織を作成（OpenAPI ServerInterface実装）
+func (h *OrganizationHandler) OrganizationsCreateOrganization(w http.ResponseWriter, r *http.Request) {
+	var req openapi.CreateOrganizationRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, http.StatusBadRequest, "リクエストの形式が不正です")
+		return
+	}
+
+	org, err := h.createOrganization.Execute(r.Context(), req.Name)
+	if err != nil {
+		HandleError(w, err, h.logger)
+		return
+	}
+
+	respondJSON(w, http.StatusCreated, toOrganizationResponse(org))
+}
+
+// OrganizationsGetOrganization 組織を取得（OpenAPI ServerInterface実装）
+func (h *OrganizationHandler) OrganizationsGetOrganization(w http.ResponseWriter, r *http.Request, orgId string) {
+	org, err := h.findOrganization.Execute(r.Context(), orgId)
+	if err != nil {
+		HandleError(w, err, h.logger)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, toOrganizationResponse(org))
+}
+
+// OrganizationsListOrganizations 組織一覧を取得（OpenAPI ServerInterface実装）
+func (h *OrganizationHandler) OrganizationsListOrganizations(w http.ResponseWriter, r *http.Request, params openapi.OrganizationsListOrganizationsParams) {
+	limit, offset := pagination(params.Limit, params.Offset)
+
+	orgs, total, err := h.listOrganizations.Execute(r.Context(), limit, offset)
+	if err != nil {
+		HandleError(w, err, h.logger)
+		return
+	}
+
+	responses := make([]openapi.Organization, 0, len(orgs))
+	for _, org := range orgs {
+		responses = append(responses, toOrganizationResponse(org))
+	}
+
+	respondJSON(w, http.StatusOK, openapi.OrganizationList{
+		Organizations: responses,
+		Total:         int32(total),
+	})
+}
+
+// toOrganizationResponse ドメインモデルをレスポンスに変換
+func toOrganizationResponse(org *domain.Organization) openapi.Organization {
+	return openapi.Organization{
+		Id:        org.ID,
+		Name:      org.Name,
+		CreatedAt: org.CreatedAt,
+		UpdatedAt: org.UpdatedAt,
+	}
+}
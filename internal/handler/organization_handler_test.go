@@ -0,0 +1,211 @@
+package handler_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure/memory"
+	"github.com/example/go-react-cqrs-template/internal/tenant"
+	"github.com/example/go-react-cqrs-template/pkg/generated/openapi"
+)
+
+// mustNewOrganization テスト用の組織を作成する
+func mustNewOrganization(t *testing.T, name string) *domain.Organization {
+	t.Helper()
+	org, err := domain.NewOrganization(name)
+	if err != nil {
+		t.Fatalf("Failed to create organization: %v", err)
+	}
+	return org
+}
+
+// withPrincipal 認証ミドルウェアの代わりにプリンシパルの組織 orgID を設定する
+func withPrincipal(router http.Handler, orgID string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		router.ServeHTTP(w, r.WithContext(tenant.WithPrincipalOrgID(r.Context(), orgID)))
+	})
+}
+
+// TestOrganizationHandler_ResponsesMatchSpec 組織と組織のユーザーのレスポンスが openapi.yaml に準拠していることを検証する
+func TestOrganizationHandler_ResponsesMatchSpec(t *testing.T) {
+	org := mustNewOrganization(t, "Acme")
+	existing, err := domain.NewUser("John Doe", "john@example.com")
+	if err != nil {
+		t.Fatalf("Failed to create user: %v", err)
+	}
+
+	users := "/orgs/" + org.ID + "/users"
+	tests := []struct {
+		name        string
+		method      string
+		path        string
+		contentType string
+		body        string
+		principal   string
+		wantStatus  int
+	}{
+		{name: "list organizations", method: http.MethodGet, path: "/orgs", wantStatus: http.StatusOK},
+		{name: "get organization", method: http.MethodGet, path: "/orgs/" + org.ID, wantStatus: http.StatusOK},
+		{name: "get missing organization", method: http.MethodGet, path: "/orgs/01ARZ3NDEKTSV4RRFFQ69G5FAV", wantStatus: http.StatusNotFound},
+		{name: "create organization", method: http.MethodPost, path: "/orgs", body: `{"name":"Globex"}`, wantStatus: http.StatusCreated},
+		{name: "create organization without name", method: http.MethodPost, path: "/orgs", body: `{"name":""}`, wantStatus: http.StatusBadRequest},
+		{name: "list org users", principal: org.ID, method: http.MethodGet, path: users, wantStatus: http.StatusOK},
+		{name: "list users of missing organization", method: http.MethodGet, path: "/orgs/01ARZ3NDEKTSV4RRFFQ69G5FAV/users", principal: "01ARZ3NDEKTSV4RRFFQ69G5FAV", wantStatus: http.StatusNotFound},
+		{name: "get org user", principal: org.ID, method: http.MethodGet, path: users + "/" + existing.ID, wantStatus: http.StatusOK},
+		{name: "create org user", principal: org.ID, method: http.MethodPost, path: users, body: `{"name":"Jane","email":"jane@example.com"}`, wantStatus: http.StatusCreated},
+		{name: "create duplicate org user", principal: org.ID, method: http.MethodPost, path: users, body: `{"name":"Jane","email":"john@example.com"}`, wantStatus: http.StatusConflict},
+		{name: "replace org user", principal: org.ID, method: http.MethodPut, path: users + "/" + existing.ID, body: `{"name":"John Smith","email":"smith@example.com"}`, wantStatus: http.StatusNoContent},
+		{name: "patch org user", principal: org.ID, method: http.MethodPatch, path: users + "/" + existing.ID, contentType: "application/merge-patch+json", body: `{"name":"John Smith"}`, wantStatus: http.StatusOK},
+		{name: "delete org user", principal: org.ID, method: http.MethodDelete, path: users + "/" + existing.ID, wantStatus: http.StatusNoContent},
+		{name: "list org users without login", method: http.MethodGet, path: users, wantStatus: http.StatusUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := memory.NewStore()
+			store.SeedOrganizations(org)
+			store.SeedInOrg(org.ID, existing)
+			router := newTestRouter(t, store)
+			if tt.principal != "" {
+				router = withPrincipal(router, tt.principal)
+			}
+
+			req := httptest.NewRequest(tt.method, tt.path, bytes.NewBufferString(tt.body))
+			if tt.body != "" {
+				contentType := tt.contentType
+				if contentType == "" {
+					contentType = "application/json"
+				}
+				req.Header.Set("Content-Type", contentType)
+			}
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantStatus {
+				t.Errorf("status = %d, want %d (body: %s)", rec.Code, tt.wantStatus, rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestOrgUserHandler_TenantIsolation(t *testing.T) {
+	org := mustNewOrganization(t, "Acme")
+	inDefault, err := domain.NewUser("John Doe", "john@example.com")
+	if err != nil {
+		t.Fatalf("Failed to create user: %v", err)
+	}
+	inOrg, err := domain.NewUser("John Doe", "john@example.com")
+	if err != nil {
+		t.Fatalf("Failed to create user: %v", err)
+	}
+	store := memory.NewStore()
+	store.SeedOrganizations(org)
+	store.Seed(inDefault)
+	store.SeedInOrg(org.ID, inOrg)
+	router := withPrincipal(newTestRouter(t, store), org.ID)
+
+	// 同じメールアドレスのユーザーが組織ごとに存在し、他の組織のユーザーは見えない
+	rec := getWithHeaders(router, "/orgs/"+org.ID+"/users", nil)
+	var list openapi.UserList
+	if err := json.NewDecoder(rec.Body).Decode(&list); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if list.Total != 1 || list.Users[0].Id != inOrg.ID {
+		t.Errorf("org users = %+v, want only %s", list, inOrg.ID)
+	}
+	if rec := getWithHeaders(router, "/orgs/"+org.ID+"/users/"+inDefault.ID, nil); rec.Code != http.StatusNotFound {
+		t.Errorf("GET user of another organization status = %d, want %d", rec.Code, http.StatusNotFound)
+	}
+
+	// /users はプリンシパルの組織、未認証の場合は既定の組織を対象にする
+	if rec := getWithHeaders(router, "/users/"+inOrg.ID, map[string]string{tenant.Header: org.ID}); rec.Code != http.StatusOK {
+		t.Errorf("GET /users with %s status = %d, want %d", tenant.Header, rec.Code, http.StatusOK)
+	}
+	if rec := getWithHeaders(newTestRouter(t, store), "/users/"+inOrg.ID, nil); rec.Code != http.StatusNotFound {
+		t.Errorf("GET /users without login status = %d, want %d", rec.Code, http.StatusNotFound)
+	}
+}
+
+func TestTenantMiddleware(t *testing.T) {
+	org := mustNewOrganization(t, "Acme")
+	other := mustNewOrganization(t, "Globex")
+
+	tests := []struct {
+		name      string
+		principal string
+		path      string
+		header    string
+		want      int
+	}{
+		{name: "default organization", path: "/users", want: http.StatusOK},
+		{name: "header of default organization", path: "/users", header: tenant.DefaultOrgID, want: http.StatusOK},
+		{name: "header without login", path: "/users", header: org.ID, want: http.StatusUnauthorized},
+		{name: "path without login", path: "/orgs/" + org.ID + "/users", want: http.StatusUnauthorized},
+		{name: "invalid header", path: "/users", header: "acme", want: http.StatusBadRequest},
+		{name: "unknown organization", principal: "01ARZ3NDEKTSV4RRFFQ69G5FAV", path: "/users", want: http.StatusNotFound},
+		{name: "principal", principal: org.ID, path: "/users", want: http.StatusOK},
+		{name: "header of principal organization", principal: org.ID, path: "/users", header: org.ID, want: http.StatusOK},
+		{name: "header of another organization", principal: org.ID, path: "/users", header: other.ID, want: http.StatusForbidden},
+		{name: "path of another organization", principal: org.ID, path: "/orgs/" + other.ID + "/users", want: http.StatusForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := memory.NewStore()
+			store.SeedOrganizations(org, other)
+			router := newTestRouter(t, store)
+			if tt.principal != "" {
+				router = withPrincipal(router, tt.principal)
+			}
+
+			header := map[string]string{}
+			if tt.header != "" {
+				header[tenant.Header] = tt.header
+			}
+			if rec := getWithHeaders(router, tt.path, header); rec.Code != tt.want {
+				t.Errorf("status = %d, want %d (body: %s)", rec.Code, tt.want, rec.Body.String())
+			}
+		})
+	}
+}
+
+// TestTenantMiddleware_SignIn ログインは未認証でも X-Org-ID の組織を対象にする
+func TestTenantMiddleware_SignIn(t *testing.T) {
+	org := mustNewOrganization(t, "Acme")
+	user, err := domain.NewUser("John Doe", "john@example.com")
+	if err != nil {
+		t.Fatalf("Failed to create user: %v", err)
+	}
+	store := memory.NewStore()
+	store.SeedOrganizations(org)
+	store.SeedInOrg(org.ID, user)
+	store.SeedCredentials(mustNewCredential(t, user, "correct horse battery"))
+	router := newTestRouter(t, store)
+
+	req := httptest.NewRequest(http.MethodPost, "/auth/login", bytes.NewBufferString(`{"email":"john@example.com","password":"correct horse battery"}`))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(tenant.Header, org.ID)
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("login with %s status = %d, want %d (body: %s)", tenant.Header, rec.Code, http.StatusOK, rec.Body.String())
+	}
+	var login openapi.LoginResponse
+	if err := json.NewDecoder(rec.Body).Decode(&login); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+
+	// 発行されたトークンの組織は X-Org-ID の組織になる
+	claims, err := testSessions.Parse(login.Token, time.Now())
+	if err != nil {
+		t.Fatalf("Parse() unexpected error: %v", err)
+	}
+	if claims.OrgID != org.ID {
+		t.Errorf("token org = %s, want %s", claims.OrgID, org.ID)
+	}
+}
@@ -0,0 +1,44 @@
+package rpc
+
+import (
+	"errors"
+	"log/slog"
+
+	"connectrpc.com/connect"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/handler"
+	"github.com/example/go-react-cqrs-template/internal/pkg/logger"
+)
+
+// domainCodes domain.ErrorCode と gRPC のステータスコードの対応
+var domainCodes = map[domain.ErrorCode]connect.Code{
+	domain.ErrCodeValidation:      connect.CodeInvalidArgument,
+	domain.ErrCodeNotFound:        connect.CodeNotFound,
+	domain.ErrCodeConflict:        connect.CodeAlreadyExists,
+	domain.ErrCodeUnauthenticated: connect.CodeUnauthenticated,
+}
+
+// toConnectError エラーを gRPC のステータスに変換し、ログに出力する
+//
+// メッセージは REST API の Error.message と同じユーザー向けメッセージとする。
+// ドメインエラー以外は内部エラー（詳細はログのみに出力する）。
+func toConnectError(err error, log *slog.Logger) error {
+	var connectErr *connect.Error
+	if errors.As(err, &connectErr) {
+		return connectErr
+	}
+
+	appErr := handler.ToAppError(err)
+	if log != nil {
+		logger.LogError(log, appErr, "rpc request error")
+	}
+
+	code := connect.CodeInternal
+	if domainErr := domain.AsDomainError(err); domainErr != nil {
+		if c, ok := domainCodes[domainErr.Code]; ok {
+			code = c
+		}
+	}
+	return connect.NewError(code, errors.New(appErr.UserMessage()))
+}
@@ -0,0 +1,33 @@
+// Package rpc は UserService（proto/user/v1/user.proto）を Connect で提供する
+//
+// Connect・gRPC・gRPC-Web の各プロトコルを REST API と同じポートで受け付ける（gRPC には HTTP/2 が必要で、
+// TLS なしの場合は h2c で接続する）。各 RPC は REST API と同じユースケースを通り、domain.DomainError の
+// コードは gRPC の標準のステータスコードに変換する。ローカルでのデバッグ用にサーバーリフレクションも提供する。
+package rpc
+
+import (
+	"net/http"
+
+	"connectrpc.com/connect"
+	"connectrpc.com/grpcreflect"
+
+	"github.com/example/go-react-cqrs-template/pkg/generated/proto/user/v1/userv1connect"
+)
+
+// Handlers ルーターにマウントするパスとハンドラーの組を返す
+//
+// UserService に加えて、grpcurl や Postman から使うサーバーリフレクション（v1 / v1alpha）を含む。
+func Handlers(svc *UserService, opts ...connect.HandlerOption) map[string]http.Handler {
+	handlers := make(map[string]http.Handler)
+
+	path, h := userv1connect.NewUserServiceHandler(svc, opts...)
+	handlers[path] = h
+
+	reflector := grpcreflect.NewStaticReflector(userv1connect.UserServiceName)
+	path, h = grpcreflect.NewHandlerV1(reflector, opts...)
+	handlers[path] = h
+	path, h = grpcreflect.NewHandlerV1Alpha(reflector, opts...)
+	handlers[path] = h
+
+	return handlers
+}
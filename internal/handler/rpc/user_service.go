@@ -0,0 +1,230 @@
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/example/go-react-cqrs-template/internal/command"
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/eventstream"
+	"github.com/example/go-react-cqrs-template/internal/handler"
+	"github.com/example/go-react-cqrs-template/internal/outbox"
+	"github.com/example/go-react-cqrs-template/internal/usecase"
+	userv1 "github.com/example/go-react-cqrs-template/pkg/generated/proto/user/v1"
+	"github.com/example/go-react-cqrs-template/pkg/generated/proto/user/v1/userv1connect"
+)
+
+const (
+	// defaultLimit / maxLimit ListUsers の件数のデフォルトと上限（openapi.yaml の limit と同じ）
+	defaultLimit = 10
+	maxLimit     = 100
+)
+
+// UserService userv1connect.UserServiceHandler の実装
+type UserService struct {
+	createUser *usecase.CreateUserUsecase
+	findUser   *usecase.FindUserUsecase
+	listUsers  *usecase.ListUsersUsecase
+	patchUser  *usecase.PatchUserUsecase
+	deleteUser *usecase.DeleteUserUsecase
+	userEvents *eventstream.Hub
+	logger     *slog.Logger
+}
+
+var _ userv1connect.UserServiceHandler = (*UserService)(nil)
+
+// NewUserService UserServiceのコンストラクタ
+func NewUserService(
+	createUser *usecase.CreateUserUsecase,
+	findUser *usecase.FindUserUsecase,
+	listUsers *usecase.ListUsersUsecase,
+	patchUser *usecase.PatchUserUsecase,
+	deleteUser *usecase.DeleteUserUsecase,
+	userEvents *eventstream.Hub,
+	logger *slog.Logger,
+) *UserService {
+	return &UserService{
+		createUser: createUser,
+		findUser:   findUser,
+		listUsers:  listUsers,
+		patchUser:  patchUser,
+		deleteUser: deleteUser,
+		userEvents: userEvents,
+		logger:     logger,
+	}
+}
+
+// ListUsers ユーザー一覧を取得
+func (s *UserService) ListUsers(ctx context.Context, req *connect.Request[userv1.ListUsersRequest]) (*connect.Response[userv1.ListUsersResponse], error) {
+	limit, offset := int(req.Msg.GetLimit()), int(req.Msg.GetOffset())
+	if limit == 0 {
+		limit = defaultLimit
+	}
+	if limit < 1 || limit > maxLimit || offset < 0 {
+		return nil, toConnectError(domain.NewValidationError("limit",
+			"limit must be between 1 and 100 and offset must not be negative",
+			"limit は1以上100以下、offset は0以上で指定してください",
+		), s.logger)
+	}
+
+	users, total, err := s.listUsers.Execute(ctx, "", limit, offset)
+	if err != nil {
+		return nil, toConnectError(err, s.logger)
+	}
+	res := &userv1.ListUsersResponse{
+		Users: make([]*userv1.User, len(users)),
+		Total: int32(total),
+	}
+	for i, u := range users {
+		res.Users[i] = toProtoUser(u)
+	}
+	return connect.NewResponse(res), nil
+}
+
+// CreateUser ユーザーを作成
+func (s *UserService) CreateUser(ctx context.Context, req *connect.Request[userv1.CreateUserRequest]) (*connect.Response[userv1.CreateUserResponse], error) {
+	name, email := req.Msg.GetName(), req.Msg.GetEmail()
+	if err := handler.ValidateUserInput(&name, &email); err != nil {
+		return nil, toConnectError(err, s.logger)
+	}
+
+	user, err := s.createUser.Execute(ctx, name, email)
+	if err != nil {
+		return nil, toConnectError(err, s.logger)
+	}
+	return connect.NewResponse(&userv1.CreateUserResponse{User: toProtoUser(user)}), nil
+}
+
+// GetUser ユーザーを取得
+func (s *UserService) GetUser(ctx context.Context, req *connect.Request[userv1.GetUserRequest]) (*connect.Response[userv1.GetUserResponse], error) {
+	if err := handler.ValidateUserID(req.Msg.GetId()); err != nil {
+		return nil, toConnectError(err, s.logger)
+	}
+
+	user, err := s.findUser.Execute(ctx, req.Msg.GetId())
+	if err != nil {
+		return nil, toConnectError(err, s.logger)
+	}
+	return connect.NewResponse(&userv1.GetUserResponse{User: toProtoUser(user)}), nil
+}
+
+// UpdateUser ユーザーを更新し、更新後のユーザーを返す
+func (s *UserService) UpdateUser(ctx context.Context, req *connect.Request[userv1.UpdateUserRequest]) (*connect.Response[userv1.UpdateUserResponse], error) {
+	msg := req.Msg
+	if err := handler.ValidateUserID(msg.GetId()); err != nil {
+		return nil, toConnectError(err, s.logger)
+	}
+	if err := handler.ValidateUserInput(msg.Name, msg.Email); err != nil {
+		return nil, toConnectError(err, s.logger)
+	}
+
+	user, err := s.patchUser.Execute(ctx, msg.GetId(), usecase.SetUserFields(msg.Name, msg.Email))
+	if err != nil {
+		return nil, toConnectError(err, s.logger)
+	}
+	return connect.NewResponse(&userv1.UpdateUserResponse{User: toProtoUser(user)}), nil
+}
+
+// DeleteUser ユーザーを削除
+func (s *UserService) DeleteUser(ctx context.Context, req *connect.Request[userv1.DeleteUserRequest]) (*connect.Response[userv1.DeleteUserResponse], error) {
+	if err := handler.ValidateUserID(req.Msg.GetId()); err != nil {
+		return nil, toConnectError(err, s.logger)
+	}
+
+	if err := s.deleteUser.Execute(ctx, req.Msg.GetId()); err != nil {
+		return nil, toConnectError(err, s.logger)
+	}
+	return connect.NewResponse(&userv1.DeleteUserResponse{}), nil
+}
+
+// WatchUsers ユーザーの変更を配信（/users:watch と同じ変更ストリーム）
+//
+// last_seq を指定すると、それ以降の変更を再送してから新しい変更の配信を続ける。ストリームが
+// 切断された場合（配信が追いつかない、サーバーの停止）は Unavailable を返すため、クライアントは
+// 最後に受け取った seq を指定して再接続する。
+func (s *UserService) WatchUsers(ctx context.Context, req *connect.Request[userv1.WatchUsersRequest], stream *connect.ServerStream[userv1.WatchUsersResponse]) error {
+	sub, err := s.userEvents.Subscribe(ctx, req.Msg.LastSeq)
+	if err != nil {
+		if ctx.Err() != nil {
+			return connect.NewError(connect.CodeCanceled, ctx.Err())
+		}
+		s.logger.Error("failed to subscribe user changes", slog.String("error", err.Error()))
+		return connect.NewError(connect.CodeUnavailable, errors.New("変更の配信を開始できません"))
+	}
+	defer sub.Close()
+
+	if sub.Truncated() {
+		reset := &userv1.WatchUsersResponse{Event: &userv1.WatchUsersResponse_Reset_{Reset_: &userv1.Reset{}}}
+		if err := stream.Send(reset); err != nil {
+			return err
+		}
+	}
+	send := func(event outbox.Event) error {
+		change, err := toProtoUserChange(event)
+		if err != nil || change == nil {
+			return err
+		}
+		return stream.Send(&userv1.WatchUsersResponse{Event: &userv1.WatchUsersResponse_Change{Change: change}})
+	}
+	if err := sub.Replay(ctx, send); err != nil {
+		if ctx.Err() == nil {
+			s.logger.Warn("failed to replay user changes", slog.String("error", err.Error()))
+		}
+		return err
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event, ok := <-sub.Events():
+			if !ok {
+				if errors.Is(sub.Err(), eventstream.ErrSlowSubscriber) {
+					s.logger.Warn("user change stream closed for slow client")
+				}
+				return connect.NewError(connect.CodeUnavailable, errors.New("変更の配信が終了しました。last_seq を指定して再接続してください"))
+			}
+			if err := send(event); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// toProtoUser domain.User を userv1.User に変換する
+func toProtoUser(u *domain.User) *userv1.User {
+	return &userv1.User{
+		Id:         u.ID,
+		Name:       u.Name,
+		Email:      u.Email,
+		CreateTime: timestamppb.New(u.CreatedAt),
+		UpdateTime: timestamppb.New(u.UpdatedAt),
+	}
+}
+
+// toProtoUserChange outbox のイベントを userv1.UserChange に変換する（他の集約のイベントは nil）
+func toProtoUserChange(event outbox.Event) (*userv1.UserChange, error) {
+	if event.AggregateType != domain.UserAggregateType {
+		return nil, nil
+	}
+	var payload command.UserEventPayload
+	if err := json.Unmarshal(event.Payload, &payload); err != nil {
+		return nil, err
+	}
+	return &userv1.UserChange{
+		Seq:       event.Seq,
+		Id:        event.ID,
+		Type:      event.Type,
+		OccurTime: timestamppb.New(event.OccurredAt),
+		User: &userv1.User{
+			Id:         payload.ID,
+			CreateTime: timestamppb.New(payload.CreatedAt),
+			UpdateTime: timestamppb.New(payload.UpdatedAt),
+		},
+	}, nil
+}
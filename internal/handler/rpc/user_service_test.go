@@ -0,0 +1,278 @@
+package rpc_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"connectrpc.com/grpcreflect"
+	"github.com/go-chi/chi/v5"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/eventstream"
+	"github.com/example/go-react-cqrs-template/internal/handler/rpc"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure/memory"
+	"github.com/example/go-react-cqrs-template/internal/pkg/logger"
+	"github.com/example/go-react-cqrs-template/internal/usecase"
+	userv1 "github.com/example/go-react-cqrs-template/pkg/generated/proto/user/v1"
+	"github.com/example/go-react-cqrs-template/pkg/generated/proto/user/v1/userv1connect"
+)
+
+// startServer 本番と同じく chi のルーターにマウントし、HTTP/1.1 と h2c を受け付けるテストサーバーを起動する
+func startServer(t *testing.T, store *memory.Store) *httptest.Server {
+	t.Helper()
+	log := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	hub := eventstream.NewHub(store, log, eventstream.WithPollInterval(10*time.Millisecond))
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		hub.Run(ctx, nil)
+	}()
+
+	svc := rpc.NewUserService(
+		usecase.NewCreateUserUsecase(store, store, store),
+		usecase.NewFindUserUsecase(store),
+		usecase.NewListUsersUsecase(store),
+		usecase.NewPatchUserUsecase(store, store, store),
+		usecase.NewDeleteUserUsecase(store, store, store),
+		hub,
+		log,
+	)
+	r := chi.NewRouter()
+	r.Use(logger.Middleware)
+	for path, h := range rpc.Handlers(svc) {
+		r.Mount(path, h)
+	}
+
+	srv := httptest.NewUnstartedServer(r)
+	srv.Config.Protocols = new(http.Protocols)
+	srv.Config.Protocols.SetHTTP1(true)
+	srv.Config.Protocols.SetUnencryptedHTTP2(true)
+	srv.Start()
+	t.Cleanup(func() {
+		cancel()
+		<-done
+		srv.Close()
+	})
+	return srv
+}
+
+// clients 各プロトコルのクライアント（gRPC は h2c で接続する）
+func clients(srv *httptest.Server) map[string]userv1connect.UserServiceClient {
+	h2c := &http.Client{Transport: &http.Transport{Protocols: new(http.Protocols)}}
+	h2c.Transport.(*http.Transport).Protocols.SetUnencryptedHTTP2(true)
+	return map[string]userv1connect.UserServiceClient{
+		"connect":  userv1connect.NewUserServiceClient(srv.Client(), srv.URL),
+		"grpc":     userv1connect.NewUserServiceClient(h2c, srv.URL, connect.WithGRPC()),
+		"grpc-web": userv1connect.NewUserServiceClient(srv.Client(), srv.URL, connect.WithGRPCWeb()),
+	}
+}
+
+func TestUserService_CRUD(t *testing.T) {
+	for protocol, client := range clients(startServer(t, memory.NewStore())) {
+		t.Run(protocol, func(t *testing.T) {
+			ctx := context.Background()
+			email := protocol + "@example.com"
+
+			created, err := client.CreateUser(ctx, connect.NewRequest(&userv1.CreateUserRequest{Name: "Alice", Email: email}))
+			if err != nil {
+				t.Fatalf("CreateUser() error = %v", err)
+			}
+			id := created.Msg.GetUser().GetId()
+
+			got, err := client.GetUser(ctx, connect.NewRequest(&userv1.GetUserRequest{Id: id}))
+			if err != nil {
+				t.Fatalf("GetUser() error = %v", err)
+			}
+			if got.Msg.GetUser().GetEmail() != email || got.Msg.GetUser().GetCreateTime() == nil {
+				t.Errorf("GetUser() = %v", got.Msg.GetUser())
+			}
+
+			name := "Alice Smith"
+			updated, err := client.UpdateUser(ctx, connect.NewRequest(&userv1.UpdateUserRequest{Id: id, Name: &name}))
+			if err != nil {
+				t.Fatalf("UpdateUser() error = %v", err)
+			}
+			if updated.Msg.GetUser().GetName() != name || updated.Msg.GetUser().GetEmail() != email {
+				t.Errorf("UpdateUser() = %v", updated.Msg.GetUser())
+			}
+
+			list, err := client.ListUsers(ctx, connect.NewRequest(&userv1.ListUsersRequest{}))
+			if err != nil {
+				t.Fatalf("ListUsers() error = %v", err)
+			}
+			if list.Msg.GetTotal() < 1 || len(list.Msg.GetUsers()) != int(list.Msg.GetTotal()) {
+				t.Errorf("ListUsers() = %v", list.Msg)
+			}
+
+			if _, err := client.DeleteUser(ctx, connect.NewRequest(&userv1.DeleteUserRequest{Id: id})); err != nil {
+				t.Fatalf("DeleteUser() error = %v", err)
+			}
+			_, err = client.GetUser(ctx, connect.NewRequest(&userv1.GetUserRequest{Id: id}))
+			if connect.CodeOf(err) != connect.CodeNotFound {
+				t.Errorf("GetUser() after delete code = %v, want %v", connect.CodeOf(err), connect.CodeNotFound)
+			}
+		})
+	}
+}
+
+func TestUserService_ErrorCodes(t *testing.T) {
+	store := memory.NewStore()
+	existing, err := domain.NewUser("John", "john@example.com")
+	if err != nil {
+		t.Fatalf("Failed to create user: %v", err)
+	}
+	store.Seed(existing)
+	client := clients(startServer(t, store))["grpc"]
+	ctx := context.Background()
+	email := "bad"
+
+	tests := []struct {
+		name     string
+		call     func() error
+		wantCode connect.Code
+	}{
+		{
+			name: "duplicate email",
+			call: func() error {
+				_, err := client.CreateUser(ctx, connect.NewRequest(&userv1.CreateUserRequest{Name: "Dup", Email: "john@example.com"}))
+				return err
+			},
+			wantCode: connect.CodeAlreadyExists,
+		},
+		{
+			name: "missing name",
+			call: func() error {
+				_, err := client.CreateUser(ctx, connect.NewRequest(&userv1.CreateUserRequest{Email: "new@example.com"}))
+				return err
+			},
+			wantCode: connect.CodeInvalidArgument,
+		},
+		{
+			name: "invalid email",
+			call: func() error {
+				_, err := client.UpdateUser(ctx, connect.NewRequest(&userv1.UpdateUserRequest{Id: existing.ID, Email: &email}))
+				return err
+			},
+			wantCode: connect.CodeInvalidArgument,
+		},
+		{
+			name: "invalid id",
+			call: func() error {
+				_, err := client.GetUser(ctx, connect.NewRequest(&userv1.GetUserRequest{Id: "not-a-ulid"}))
+				return err
+			},
+			wantCode: connect.CodeInvalidArgument,
+		},
+		{
+			name: "missing user",
+			call: func() error {
+				_, err := client.DeleteUser(ctx, connect.NewRequest(&userv1.DeleteUserRequest{Id: "01ARZ3NDEKTSV4RRFFQ69G5FAV"}))
+				return err
+			},
+			wantCode: connect.CodeNotFound,
+		},
+		{
+			name: "limit out of range",
+			call: func() error {
+				_, err := client.ListUsers(ctx, connect.NewRequest(&userv1.ListUsersRequest{Limit: 101}))
+				return err
+			},
+			wantCode: connect.CodeInvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.call()
+			var connectErr *connect.Error
+			if !errors.As(err, &connectErr) {
+				t.Fatalf("error = %v, want connect error", err)
+			}
+			if connectErr.Code() != tt.wantCode {
+				t.Errorf("code = %v, want %v (message: %s)", connectErr.Code(), tt.wantCode, connectErr.Message())
+			}
+		})
+	}
+}
+
+func TestUserService_WatchUsers(t *testing.T) {
+	store := memory.NewStore()
+	srv := startServer(t, store)
+	client := clients(srv)["grpc"]
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	created, err := client.CreateUser(ctx, connect.NewRequest(&userv1.CreateUserRequest{Name: "Alice", Email: "alice@example.com"}))
+	if err != nil {
+		t.Fatalf("CreateUser() error = %v", err)
+	}
+
+	// seq 0 から再送を受け、続けて新しい変更を受け取る
+	var lastSeq int64
+	stream, err := client.WatchUsers(ctx, connect.NewRequest(&userv1.WatchUsersRequest{LastSeq: &lastSeq}))
+	if err != nil {
+		t.Fatalf("WatchUsers() error = %v", err)
+	}
+	defer stream.Close()
+
+	receive := func() *userv1.UserChange {
+		t.Helper()
+		if !stream.Receive() {
+			t.Fatalf("stream ended: %v", stream.Err())
+		}
+		change := stream.Msg().GetChange()
+		if change == nil {
+			t.Fatalf("message = %v, want change", stream.Msg())
+		}
+		return change
+	}
+
+	replayed := receive()
+	if replayed.GetType() != string(domain.UserEventTypeCreated) || replayed.GetUser().GetId() != created.Msg.GetUser().GetId() || replayed.GetSeq() != 1 {
+		t.Errorf("replayed = %v", replayed)
+	}
+
+	if _, err := client.DeleteUser(ctx, connect.NewRequest(&userv1.DeleteUserRequest{Id: created.Msg.GetUser().GetId()})); err != nil {
+		t.Fatalf("DeleteUser() error = %v", err)
+	}
+	live := receive()
+	if live.GetType() != string(domain.UserEventTypeDeleted) || live.GetUser().GetId() != created.Msg.GetUser().GetId() || live.GetUser().GetEmail() != "" || live.GetSeq() != 2 {
+		t.Errorf("live = %v", live)
+	}
+}
+
+func TestHandlers_Reflection(t *testing.T) {
+	srv := startServer(t, memory.NewStore())
+	h2c := &http.Client{Transport: &http.Transport{Protocols: new(http.Protocols)}}
+	h2c.Transport.(*http.Transport).Protocols.SetUnencryptedHTTP2(true)
+
+	// grpcurl などと同じく gRPC でサービス一覧とスキーマを取得する
+	stream := grpcreflect.NewClient(h2c, srv.URL, connect.WithGRPC()).NewStream(context.Background())
+	defer stream.Close()
+
+	services, err := stream.ListServices()
+	if err != nil {
+		t.Fatalf("ListServices() error = %v", err)
+	}
+	if len(services) != 1 || services[0] != userv1connect.UserServiceName {
+		t.Errorf("ListServices() = %v, want [%s]", services, userv1connect.UserServiceName)
+	}
+
+	files, err := stream.FileContainingSymbol(userv1connect.UserServiceName)
+	if err != nil {
+		t.Fatalf("FileContainingSymbol() error = %v", err)
+	}
+	if len(files) == 0 || files[0].GetName() != "user/v1/user.proto" {
+		t.Errorf("FileContainingSymbol() = %v", files)
+	}
+}
@@ -0,0 +1,83 @@
+package scim
+
+import (
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/example/go-react-cqrs-template/internal/handler"
+	"github.com/example/go-react-cqrs-template/internal/pkg/logger"
+)
+
+// Error SCIM のエラーレスポンス（RFC 7644 3.12）
+type Error struct {
+	Status int
+	// ScimType は 400 / 409 などで詳細な種類を表す（例: invalidFilter, uniqueness）
+	ScimType string
+	Detail   string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("scim: %d %s: %s", e.Status, e.ScimType, e.Detail)
+}
+
+func errInvalidSyntax(detail string) *Error {
+	return &Error{Status: http.StatusBadRequest, ScimType: "invalidSyntax", Detail: detail}
+}
+
+func errInvalidFilter(detail string) *Error {
+	return &Error{Status: http.StatusBadRequest, ScimType: "invalidFilter", Detail: detail}
+}
+
+func errInvalidPath(detail string) *Error {
+	return &Error{Status: http.StatusBadRequest, ScimType: "invalidPath", Detail: detail}
+}
+
+func errInvalidValue(detail string) *Error {
+	return &Error{Status: http.StatusBadRequest, ScimType: "invalidValue", Detail: detail}
+}
+
+func errMutability(detail string) *Error {
+	return &Error{Status: http.StatusBadRequest, ScimType: "mutability", Detail: detail}
+}
+
+// errDeactivationUnsupported active=false による無効化は理由を記録できないため受け付けない
+func errDeactivationUnsupported() *Error {
+	return errMutability("deactivating users (active=false) is not supported; use POST /users/{userId}:deactivate with a reason instead")
+}
+
+// errorResponse SCIM のエラーレスポンスのボディ
+type errorResponse struct {
+	Schemas  []string `json:"schemas"`
+	Status   string   `json:"status"`
+	ScimType string   `json:"scimType,omitempty"`
+	Detail   string   `json:"detail,omitempty"`
+}
+
+// writeError エラーを SCIM のエラーレスポンスとして書き込む
+//
+// ドメインエラーは REST API と同じステータスに変換し、競合は scimType=uniqueness とする。
+func writeError(w http.ResponseWriter, err error, log *slog.Logger) {
+	scimErr, ok := err.(*Error)
+	if !ok {
+		appErr := handler.ToAppError(err)
+		if log != nil {
+			logger.LogError(log, appErr, "scim request error")
+		}
+		scimErr = &Error{Status: appErr.StatusCode(), Detail: appErr.UserMessage()}
+		switch appErr.StatusCode() {
+		case http.StatusBadRequest:
+			scimErr.ScimType = "invalidValue"
+		case http.StatusConflict:
+			scimErr.ScimType = "uniqueness"
+		}
+	}
+
+	writeJSON(w, scimErr.Status, errorResponse{
+		Schemas:  []string{SchemaError},
+		Status:   strconv.Itoa(scimErr.Status),
+		ScimType: scimErr.ScimType,
+		Detail:   scimErr.Detail,
+	})
+}
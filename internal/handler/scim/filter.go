@@ -0,0 +1,449 @@
+package scim
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// attributeSource フィルターの評価対象（属性パスに対応する値の一覧を返す）
+//
+// パスは小文字に正規化され、スキーマ URN の接頭辞は取り除かれている。
+type attributeSource interface {
+	values(path string) []any
+}
+
+// filter 解析済みの SCIM フィルター式（RFC 7644 3.4.2.2）
+type filter interface {
+	match(src attributeSource) bool
+}
+
+type logicalFilter struct {
+	and         bool
+	left, right filter
+}
+
+func (f logicalFilter) match(src attributeSource) bool {
+	if f.and {
+		return f.left.match(src) && f.right.match(src)
+	}
+	return f.left.match(src) || f.right.match(src)
+}
+
+type notFilter struct {
+	inner filter
+}
+
+func (f notFilter) match(src attributeSource) bool {
+	return !f.inner.match(src)
+}
+
+type presentFilter struct {
+	path string
+}
+
+func (f presentFilter) match(src attributeSource) bool {
+	for _, v := range src.values(f.path) {
+		if s, ok := v.(string); !ok || s != "" {
+			return true
+		}
+	}
+	return false
+}
+
+type compareFilter struct {
+	path  string
+	op    string
+	value any
+}
+
+func (f compareFilter) match(src attributeSource) bool {
+	for _, v := range src.values(f.path) {
+		// 複合属性（emails など）は value サブ属性で比較する
+		values := []any{v}
+		if elem, ok := v.(attributeSource); ok {
+			values = elem.values("value")
+		}
+		for _, value := range values {
+			if compare(value, f.op, f.value, caseExactAttributes[f.path]) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// valuePathFilter 複数値属性の要素に対するフィルター（例: emails[type eq "work"]）
+type valuePathFilter struct {
+	path  string
+	inner filter
+}
+
+func (f valuePathFilter) match(src attributeSource) bool {
+	for _, v := range src.values(f.path) {
+		if elem, ok := v.(attributeSource); ok && f.inner.match(elem) {
+			return true
+		}
+	}
+	return false
+}
+
+// caseExactAttributes 大文字と小文字を区別して比較する属性（それ以外の文字列は区別しない）
+var caseExactAttributes = map[string]bool{
+	"id": true,
+}
+
+// compare 属性値と比較値を演算子で比較する
+func compare(attr any, op string, value any, caseExact bool) bool {
+	switch a := attr.(type) {
+	case string:
+		v, ok := value.(string)
+		if !ok {
+			return false
+		}
+		if !caseExact {
+			a, v = strings.ToLower(a), strings.ToLower(v)
+		}
+		switch op {
+		case "eq":
+			return a == v
+		case "ne":
+			return a != v
+		case "co":
+			return strings.Contains(a, v)
+		case "sw":
+			return strings.HasPrefix(a, v)
+		case "ew":
+			return strings.HasSuffix(a, v)
+		case "gt":
+			return a > v
+		case "ge":
+			return a >= v
+		case "lt":
+			return a < v
+		case "le":
+			return a <= v
+		}
+	case bool:
+		v, ok := value.(bool)
+		if !ok {
+			return false
+		}
+		switch op {
+		case "eq":
+			return a == v
+		case "ne":
+			return a != v
+		}
+	case time.Time:
+		s, ok := value.(string)
+		if !ok {
+			return false
+		}
+		v, err := time.Parse(time.RFC3339, s)
+		if err != nil {
+			return false
+		}
+		switch op {
+		case "eq":
+			return a.Equal(v)
+		case "ne":
+			return !a.Equal(v)
+		case "gt":
+			return a.After(v)
+		case "ge":
+			return !a.Before(v)
+		case "lt":
+			return a.Before(v)
+		case "le":
+			return !a.After(v)
+		}
+	}
+	return false
+}
+
+// compareOperators 比較演算子
+var compareOperators = map[string]bool{
+	"eq": true, "ne": true, "co": true, "sw": true, "ew": true,
+	"gt": true, "ge": true, "lt": true, "le": true,
+}
+
+// parseFilter SCIM のフィルター式を解析する
+//
+// attributes は指定できる属性パス（小文字）。それ以外の属性は invalidFilter になる。
+func parseFilter(expr string, attributes map[string]bool) (filter, error) {
+	tokens, err := tokenize(expr)
+	if err != nil {
+		return nil, err
+	}
+	p := &filterParser{tokens: tokens, attributes: attributes}
+	f, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if !p.done() {
+		return nil, fmt.Errorf("unexpected %q", p.peek().text)
+	}
+	return f, nil
+}
+
+type tokenKind int
+
+const (
+	tokenWord tokenKind = iota
+	tokenString
+	tokenOpenParen
+	tokenCloseParen
+	tokenOpenBracket
+	tokenCloseBracket
+)
+
+type token struct {
+	kind tokenKind
+	text string
+}
+
+// tokenize フィルター式を字句に分割する
+func tokenize(expr string) ([]token, error) {
+	var tokens []token
+	runes := []rune(expr)
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case r == '(':
+			tokens = append(tokens, token{kind: tokenOpenParen, text: "("})
+			i++
+		case r == ')':
+			tokens = append(tokens, token{kind: tokenCloseParen, text: ")"})
+			i++
+		case r == '[':
+			tokens = append(tokens, token{kind: tokenOpenBracket, text: "["})
+			i++
+		case r == ']':
+			tokens = append(tokens, token{kind: tokenCloseBracket, text: "]"})
+			i++
+		case r == '"':
+			j := i + 1
+			for ; j < len(runes) && runes[j] != '"'; j++ {
+				if runes[j] == '\\' {
+					j++
+				}
+			}
+			if j >= len(runes) {
+				return nil, fmt.Errorf("unterminated string")
+			}
+			var s string
+			if err := json.Unmarshal([]byte(string(runes[i:j+1])), &s); err != nil {
+				return nil, fmt.Errorf("invalid string %s", string(runes[i:j+1]))
+			}
+			tokens = append(tokens, token{kind: tokenString, text: s})
+			i = j + 1
+		default:
+			j := i
+			for ; j < len(runes) && !unicode.IsSpace(runes[j]) && !strings.ContainsRune("()[]\"", runes[j]); j++ {
+			}
+			tokens = append(tokens, token{kind: tokenWord, text: string(runes[i:j])})
+			i = j
+		}
+	}
+	return tokens, nil
+}
+
+// filterParser 演算子の優先順位（not > and > or）に従う再帰下降パーサー
+type filterParser struct {
+	tokens     []token
+	pos        int
+	attributes map[string]bool
+	// prefix は valuePath の内側で属性パスに付ける親属性（例: "emails."）
+	prefix string
+}
+
+func (p *filterParser) done() bool {
+	return p.pos >= len(p.tokens)
+}
+
+func (p *filterParser) peek() token {
+	return p.tokens[p.pos]
+}
+
+func (p *filterParser) next() (token, error) {
+	if p.done() {
+		return token{}, fmt.Errorf("unexpected end of filter")
+	}
+	t := p.tokens[p.pos]
+	p.pos++
+	return t, nil
+}
+
+func (p *filterParser) peekKeyword(keyword string) bool {
+	return !p.done() && p.peek().kind == tokenWord && strings.EqualFold(p.peek().text, keyword)
+}
+
+func (p *filterParser) parseOr() (filter, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.peekKeyword("or") {
+		p.pos++
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = logicalFilter{left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *filterParser) parseAnd() (filter, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for p.peekKeyword("and") {
+		p.pos++
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		left = logicalFilter{and: true, left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *filterParser) parseUnary() (filter, error) {
+	if p.peekKeyword("not") {
+		p.pos++
+		if p.done() || p.peek().kind != tokenOpenParen {
+			return nil, fmt.Errorf(`"not" must be followed by "("`)
+		}
+		inner, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return notFilter{inner: inner}, nil
+	}
+
+	t, err := p.next()
+	if err != nil {
+		return nil, err
+	}
+	switch t.kind {
+	case tokenOpenParen:
+		inner, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if t, err := p.next(); err != nil || t.kind != tokenCloseParen {
+			return nil, fmt.Errorf(`missing ")"`)
+		}
+		return inner, nil
+	case tokenWord:
+		return p.parseAttributeExpression(t.text)
+	default:
+		return nil, fmt.Errorf("unexpected %q", t.text)
+	}
+}
+
+// parseAttributeExpression 属性パスに続く pr / 比較 / [valFilter] を解析する
+func (p *filterParser) parseAttributeExpression(rawPath string) (filter, error) {
+	path := p.prefix + normalizePath(rawPath)
+
+	if !p.done() && p.peek().kind == tokenOpenBracket {
+		if p.prefix != "" {
+			return nil, fmt.Errorf("nested value filters are not supported")
+		}
+		p.pos++
+		inner := &filterParser{tokens: p.tokens, pos: p.pos, attributes: p.attributes, prefix: path + "."}
+		f, err := inner.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		p.pos = inner.pos
+		if t, err := p.next(); err != nil || t.kind != tokenCloseBracket {
+			return nil, fmt.Errorf(`missing "]"`)
+		}
+		return valuePathFilter{path: path, inner: stripPrefix(f, path+".")}, nil
+	}
+
+	if !p.attributes[path] {
+		return nil, fmt.Errorf("unsupported attribute %q", rawPath)
+	}
+
+	t, err := p.next()
+	if err != nil {
+		return nil, err
+	}
+	op := strings.ToLower(t.text)
+	if t.kind != tokenWord {
+		return nil, fmt.Errorf("expected operator after %q", rawPath)
+	}
+	if op == "pr" {
+		return presentFilter{path: path}, nil
+	}
+	if !compareOperators[op] {
+		return nil, fmt.Errorf("unsupported operator %q", t.text)
+	}
+
+	v, err := p.next()
+	if err != nil {
+		return nil, err
+	}
+	value, err := compareValue(v)
+	if err != nil {
+		return nil, err
+	}
+	return compareFilter{path: path, op: op, value: value}, nil
+}
+
+// compareValue 比較値（文字列・真偽値・null・数値）を解析する
+func compareValue(t token) (any, error) {
+	if t.kind == tokenString {
+		return t.text, nil
+	}
+	if t.kind != tokenWord {
+		return nil, fmt.Errorf("unexpected %q", t.text)
+	}
+	switch strings.ToLower(t.text) {
+	case "true":
+		return true, nil
+	case "false":
+		return false, nil
+	case "null":
+		return nil, nil
+	}
+	n, err := strconv.ParseFloat(t.text, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid value %q", t.text)
+	}
+	return n, nil
+}
+
+// stripPrefix valuePath の内側のフィルターを要素に対するパスに書き換える
+func stripPrefix(f filter, prefix string) filter {
+	switch f := f.(type) {
+	case logicalFilter:
+		return logicalFilter{and: f.and, left: stripPrefix(f.left, prefix), right: stripPrefix(f.right, prefix)}
+	case notFilter:
+		return notFilter{inner: stripPrefix(f.inner, prefix)}
+	case presentFilter:
+		return presentFilter{path: strings.TrimPrefix(f.path, prefix)}
+	case compareFilter:
+		return compareFilter{path: strings.TrimPrefix(f.path, prefix), op: f.op, value: f.value}
+	}
+	return f
+}
+
+// normalizePath 属性パスを小文字にし、コアスキーマの URN 接頭辞を取り除く
+func normalizePath(path string) string {
+	path = strings.ToLower(path)
+	if rest, ok := strings.CutPrefix(path, strings.ToLower(SchemaUser)+":"); ok {
+		return rest
+	}
+	return path
+}
@@ -0,0 +1,76 @@
+package scim
+
+import (
+	"testing"
+	"time"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+)
+
+func TestParseFilter_Match(t *testing.T) {
+	user := &domain.User{
+		ID:        "01ARZ3NDEKTSV4RRFFQ69G5FAV",
+		Name:      "Alice Smith",
+		Email:     "alice@example.com",
+		Status:    domain.UserStatusActive,
+		CreatedAt: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		UpdatedAt: time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	tests := []struct {
+		expr string
+		want bool
+	}{
+		{expr: `userName eq "alice@example.com"`, want: true},
+		{expr: `userName eq "ALICE@example.com"`, want: true},
+		{expr: `userName eq "bob@example.com"`, want: false},
+		{expr: `emails.value co "@example.com"`, want: true},
+		{expr: `emails co "@example.com"`, want: true},
+		{expr: `emails[type eq "work" and value sw "alice"]`, want: true},
+		{expr: `emails[type eq "home"]`, want: false},
+		{expr: `displayName sw "Alice" and not (userName ew ".org")`, want: true},
+		{expr: `displayName eq "Bob" or name.formatted eq "Alice Smith"`, want: true},
+		{expr: `userName eq "x" or userName eq "y" and active eq true`, want: false},
+		{expr: `active eq true`, want: true},
+		{expr: `active eq false`, want: false},
+		{expr: `id eq "01arz3ndektsv4rrffq69g5fav"`, want: false},
+		{expr: `meta.lastModified gt "2024-03-01T00:00:00Z"`, want: true},
+		{expr: `meta.created ge "2024-03-01T00:00:00Z"`, want: false},
+		{expr: `urn:ietf:params:scim:schemas:core:2.0:User:userName pr`, want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			f, err := parseFilter(tt.expr, filterAttributes)
+			if err != nil {
+				t.Fatalf("parseFilter() error = %v", err)
+			}
+			if got := f.match(userSource{user: user}); got != tt.want {
+				t.Errorf("match() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseFilter_Invalid(t *testing.T) {
+	tests := []string{
+		``,
+		`userName`,
+		`userName eq`,
+		`userName xx "a"`,
+		`password eq "secret"`,
+		`(userName eq "a"`,
+		`userName eq "a`,
+		`emails[type eq "work"`,
+		`not userName eq "a"`,
+		`userName eq "a" extra`,
+	}
+
+	for _, expr := range tests {
+		t.Run(expr, func(t *testing.T) {
+			if _, err := parseFilter(expr, filterAttributes); err == nil {
+				t.Errorf("parseFilter(%q) expected error", expr)
+			}
+		})
+	}
+}
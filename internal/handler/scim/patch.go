@@ -0,0 +1,179 @@
+package scim
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// patchRequest SCIM の PATCH リクエスト（RFC 7644 3.5.2）
+type patchRequest struct {
+	Schemas    []string         `json:"schemas"`
+	Operations []patchOperation `json:"Operations"`
+}
+
+type patchOperation struct {
+	Op    string          `json:"op"`
+	Path  string          `json:"path"`
+	Value json.RawMessage `json:"value"`
+}
+
+// apply PATCH の操作を順に userFields に適用する
+//
+// name.givenName / name.familyName は保存されないため、現在の名前を最初の空白で分割したものを置き換えて
+// 名前を組み立て直す。externalId は保存しないため無視する。
+func (req patchRequest) apply(fields userFields) (userFields, error) {
+	if len(req.Operations) == 0 {
+		return fields, errInvalidValue("Operations is required")
+	}
+	for _, op := range req.Operations {
+		var err error
+		switch strings.ToLower(op.Op) {
+		case "add", "replace":
+			if op.Path == "" {
+				err = fields.replaceAttributes(op.Value)
+			} else {
+				err = fields.replacePath(op.Path, op.Value)
+			}
+		case "remove":
+			err = removePath(op.Path)
+		default:
+			err = errInvalidSyntax(fmt.Sprintf("unsupported op %q", op.Op))
+		}
+		if err != nil {
+			return fields, err
+		}
+	}
+	return fields, nil
+}
+
+// replaceAttributes path のない add / replace（value は属性名をキーにしたオブジェクト）
+func (f *userFields) replaceAttributes(raw json.RawMessage) error {
+	var attrs map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &attrs); err != nil {
+		return errInvalidSyntax("value must be an object when path is omitted")
+	}
+	for key, value := range attrs {
+		if err := f.replacePath(key, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// replacePath 属性パスの値を置き換える
+func (f *userFields) replacePath(rawPath string, raw json.RawMessage) error {
+	path := normalizePath(rawPath)
+	switch {
+	case path == "username":
+		return f.replaceEmail(raw)
+	case path == "displayname", path == "name.formatted":
+		return f.replaceName(raw)
+	case path == "name.givenname", path == "name.familyname":
+		var s string
+		if err := json.Unmarshal(raw, &s); err != nil {
+			return errInvalidValue(rawPath + " must be a string")
+		}
+		given, family, _ := strings.Cut(f.name, " ")
+		if path == "name.givenname" {
+			given = s
+		} else {
+			family = s
+		}
+		f.name = strings.TrimSpace(given + " " + family)
+		if f.name == "" {
+			return errMutability("name is required")
+		}
+		return nil
+	case path == "name":
+		var name userName
+		if err := json.Unmarshal(raw, &name); err != nil {
+			return errInvalidValue("name must be an object")
+		}
+		if name.Formatted == "" {
+			name.Formatted = strings.TrimSpace(name.GivenName + " " + name.FamilyName)
+		}
+		if name.Formatted == "" {
+			return errMutability("name is required")
+		}
+		f.name = name.Formatted
+		return nil
+	case path == "emails":
+		var emails []userEmail
+		if err := json.Unmarshal(raw, &emails); err != nil || len(emails) == 0 {
+			return errInvalidValue("emails must be a non-empty array")
+		}
+		email := emails[0].Value
+		for _, e := range emails {
+			if e.Primary {
+				email = e.Value
+			}
+		}
+		return f.setEmail(email)
+	case path == "emails.value", strings.HasPrefix(path, "emails[") && strings.HasSuffix(path, "].value"):
+		// 保存できるメールアドレスは一つのため、要素の絞り込みに関わらず置き換える
+		if strings.HasPrefix(path, "emails[") {
+			if _, err := parseFilter(strings.TrimSuffix(path, ".value"), filterAttributes); err != nil {
+				return errInvalidPath(fmt.Sprintf("invalid path %q: %v", rawPath, err))
+			}
+		}
+		return f.replaceEmail(raw)
+	case path == "active":
+		var v any
+		if err := json.Unmarshal(raw, &v); err != nil {
+			return errInvalidValue("active must be a boolean")
+		}
+		active, ok := parseBool(v)
+		if !ok {
+			return errInvalidValue("active must be a boolean")
+		}
+		if !active {
+			return errDeactivationUnsupported()
+		}
+		return nil
+	case path == "externalid":
+		return nil
+	}
+	return errInvalidPath(fmt.Sprintf("unsupported path %q", rawPath))
+}
+
+func (f *userFields) replaceEmail(raw json.RawMessage) error {
+	var s string
+	if err := json.Unmarshal(raw, &s); err != nil {
+		return errInvalidValue("userName must be a string")
+	}
+	return f.setEmail(s)
+}
+
+func (f *userFields) setEmail(email string) error {
+	if err := validateEmail(email); err != nil {
+		return err
+	}
+	f.email = email
+	return nil
+}
+
+func (f *userFields) replaceName(raw json.RawMessage) error {
+	var s string
+	if err := json.Unmarshal(raw, &s); err != nil {
+		return errInvalidValue("displayName must be a string")
+	}
+	if s == "" {
+		return errMutability("name is required")
+	}
+	f.name = s
+	return nil
+}
+
+// removePath remove 操作（必須の属性は削除できない）
+func removePath(rawPath string) error {
+	switch normalizePath(rawPath) {
+	case "":
+		return errInvalidPath("path is required for remove")
+	case "externalid":
+		return nil
+	case "username", "displayname", "name", "name.formatted", "name.givenname", "name.familyname", "emails", "emails.value":
+		return errMutability(fmt.Sprintf("%s is required and cannot be removed", rawPath))
+	}
+	return errInvalidPath(fmt.Sprintf("unsupported path %q", rawPath))
+}
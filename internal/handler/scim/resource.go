@@ -0,0 +1,216 @@
+package scim
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/mail"
+	"strings"
+	"time"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+)
+
+// userResource SCIM の User リソース（RFC 7643 4.1）
+//
+// userName と emails はどちらも domain.User の Email、displayName と name.formatted は Name に対応する。
+type userResource struct {
+	Schemas     []string    `json:"schemas"`
+	ID          string      `json:"id,omitempty"`
+	ExternalID  string      `json:"externalId,omitempty"`
+	UserName    string      `json:"userName"`
+	Name        *userName   `json:"name,omitempty"`
+	DisplayName string      `json:"displayName,omitempty"`
+	Emails      []userEmail `json:"emails,omitempty"`
+	Active      *flexBool   `json:"active,omitempty"`
+	Meta        *meta       `json:"meta,omitempty"`
+}
+
+type userName struct {
+	Formatted  string `json:"formatted,omitempty"`
+	GivenName  string `json:"givenName,omitempty"`
+	FamilyName string `json:"familyName,omitempty"`
+}
+
+type userEmail struct {
+	Value   string `json:"value"`
+	Type    string `json:"type,omitempty"`
+	Primary bool   `json:"primary,omitempty"`
+}
+
+type meta struct {
+	ResourceType string    `json:"resourceType"`
+	Created      time.Time `json:"created"`
+	LastModified time.Time `json:"lastModified"`
+	Location     string    `json:"location"`
+}
+
+// flexBool 真偽値（Entra ID が送る "True" / "False" の文字列も受け付ける）
+type flexBool bool
+
+func (b *flexBool) UnmarshalJSON(data []byte) error {
+	var v any
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	parsed, ok := parseBool(v)
+	if !ok {
+		return fmt.Errorf("invalid boolean %s", data)
+	}
+	*b = flexBool(parsed)
+	return nil
+}
+
+// parseBool JSON の真偽値または "true" / "false" の文字列を解析する
+func parseBool(v any) (bool, bool) {
+	switch v := v.(type) {
+	case bool:
+		return v, true
+	case string:
+		switch strings.ToLower(v) {
+		case "true":
+			return true, true
+		case "false":
+			return false, true
+		}
+	}
+	return false, false
+}
+
+// toUserResource domain.User を SCIM の User リソースに変換する
+func toUserResource(r *http.Request, user *domain.User) userResource {
+	active := flexBool(user.Status == domain.UserStatusActive)
+	return userResource{
+		Schemas:     []string{SchemaUser},
+		ID:          user.ID,
+		UserName:    user.Email,
+		Name:        &userName{Formatted: user.Name},
+		DisplayName: user.Name,
+		Emails:      []userEmail{{Value: user.Email, Type: "work", Primary: true}},
+		Active:      &active,
+		Meta: &meta{
+			ResourceType: "User",
+			Created:      user.CreatedAt,
+			LastModified: user.UpdatedAt,
+			Location:     baseURL(r) + "/Users/" + user.ID,
+		},
+	}
+}
+
+// userFields User リソースのうち domain.User に保存する属性
+type userFields struct {
+	name  string
+	email string
+}
+
+// fields リクエストの User リソースから名前とメールアドレスを取り出す
+//
+// メールアドレスは userName を使い、userName がない場合は主（または先頭）の emails を使う。
+// 名前は displayName、name.formatted、givenName と familyName の順に使い、どれもない場合は userName を使う。
+func (u userResource) fields() (userFields, error) {
+	email := u.UserName
+	if email == "" && len(u.Emails) > 0 {
+		email = u.Emails[0].Value
+		for _, e := range u.Emails {
+			if e.Primary {
+				email = e.Value
+			}
+		}
+	}
+	if email == "" {
+		return userFields{}, errInvalidValue("userName is required")
+	}
+	if err := validateEmail(email); err != nil {
+		return userFields{}, err
+	}
+
+	name := u.DisplayName
+	if name == "" && u.Name != nil {
+		name = u.Name.Formatted
+		if name == "" {
+			name = strings.TrimSpace(u.Name.GivenName + " " + u.Name.FamilyName)
+		}
+	}
+	if name == "" {
+		name = email
+	}
+
+	if u.Active != nil && !bool(*u.Active) {
+		return userFields{}, errDeactivationUnsupported()
+	}
+	return userFields{name: name, email: email}, nil
+}
+
+// validateEmail userName（メールアドレス）の形式を検証する
+func validateEmail(email string) error {
+	addr, err := mail.ParseAddress(email)
+	if err != nil || addr.Address != email {
+		return errInvalidValue(fmt.Sprintf("userName %q is not a valid email address", email))
+	}
+	return nil
+}
+
+// filterAttributes フィルターで指定できる属性パス
+var filterAttributes = map[string]bool{
+	"id":                true,
+	"username":          true,
+	"displayname":       true,
+	"name.formatted":    true,
+	"emails":            true,
+	"emails.value":      true,
+	"emails.type":       true,
+	"emails.primary":    true,
+	"active":            true,
+	"meta.created":      true,
+	"meta.lastmodified": true,
+	"meta.resourcetype": true,
+}
+
+// userSource domain.User をフィルターの評価対象として扱う
+type userSource struct {
+	user *domain.User
+}
+
+func (s userSource) values(path string) []any {
+	switch path {
+	case "id":
+		return []any{s.user.ID}
+	case "username", "emails.value":
+		return []any{s.user.Email}
+	case "displayname", "name.formatted":
+		return []any{s.user.Name}
+	case "emails":
+		// emails[...] の要素、または "emails eq" の比較対象（value）
+		return []any{emailSource{email: s.user.Email}}
+	case "emails.type":
+		return []any{"work"}
+	case "emails.primary":
+		return []any{true}
+	case "active":
+		return []any{s.user.Status == domain.UserStatusActive}
+	case "meta.created":
+		return []any{s.user.CreatedAt}
+	case "meta.lastmodified":
+		return []any{s.user.UpdatedAt}
+	case "meta.resourcetype":
+		return []any{"User"}
+	}
+	return nil
+}
+
+// emailSource emails の要素
+type emailSource struct {
+	email string
+}
+
+func (s emailSource) values(path string) []any {
+	switch path {
+	case "value":
+		return []any{s.email}
+	case "type":
+		return []any{"work"}
+	case "primary":
+		return []any{true}
+	}
+	return nil
+}
@@ -0,0 +1,412 @@
+// Package scim は SCIM 2.0（RFC 7643 / RFC 7644）の Users エンドポイントを提供する
+//
+// Okta や Entra ID などの IdP からのプロビジョニングを受け付け、既存のユーザーのユースケースに
+// 委譲する（メールアドレスの一意性や user_logs の記録は REST API と同じになる）。
+// SCIM の User と domain.User の対応は以下の通り:
+//   - userName / emails: Email（一つのみ保存する。emails は主の要素を使う）
+//   - displayName / name: Name（name.givenName と name.familyName は空白で連結する）
+//   - active: Status が active の場合に true。ステータスは REST API の :suspend / :deactivate などで
+//     理由と共に変更するため、active=false の書き込みは mutability エラーにする
+//   - externalId: 保存しない
+//
+// リクエストは Authorization: Bearer <token> で認証する。トークンは組織ごとに発行し、
+// リクエストの対象の組織はトークンの組織とする（X-Org-ID ヘッダーは使わない）。
+package scim
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/tenant"
+	"github.com/example/go-react-cqrs-template/internal/usecase"
+)
+
+// BasePath SCIM のエンドポイントをマウントするパス
+const BasePath = "/scim/v2"
+
+// SCIM のスキーマ URN
+const (
+	SchemaUser                  = "urn:ietf:params:scim:schemas:core:2.0:User"
+	SchemaListResponse          = "urn:ietf:params:scim:api:messages:2.0:ListResponse"
+	SchemaPatchOp               = "urn:ietf:params:scim:api:messages:2.0:PatchOp"
+	SchemaError                 = "urn:ietf:params:scim:api:messages:2.0:Error"
+	SchemaServiceProviderConfig = "urn:ietf:params:scim:schemas:core:2.0:ServiceProviderConfig"
+)
+
+const (
+	// contentType SCIM のメディアタイプ
+	contentType = "application/scim+json"
+	// defaultCount / maxCount 一覧取得の件数のデフォルトと上限
+	defaultCount = 100
+	maxCount     = 100
+	// scanBatchSize フィルターを評価するためにユーザーを読み出す単位
+	scanBatchSize = 100
+)
+
+// Handler SCIM の Users エンドポイントのハンドラー
+type Handler struct {
+	createUser      *usecase.CreateUserUsecase
+	findUser        *usecase.FindUserUsecase
+	findUserByEmail *usecase.FindUserByEmailUsecase
+	listUsers       *usecase.ListUsersUsecase
+	updateUser      *usecase.UpdateUserUsecase
+	patchUser       *usecase.PatchUserUsecase
+	deleteUser      *usecase.DeleteUserUsecase
+	tokens          map[string]string
+	logger          *slog.Logger
+}
+
+// NewHandler Handlerのコンストラクタ
+//
+// tokens は組織IDごとの IdP に設定する Bearer トークン。
+func NewHandler(
+	createUser *usecase.CreateUserUsecase,
+	findUser *usecase.FindUserUsecase,
+	findUserByEmail *usecase.FindUserByEmailUsecase,
+	listUsers *usecase.ListUsersUsecase,
+	updateUser *usecase.UpdateUserUsecase,
+	patchUser *usecase.PatchUserUsecase,
+	deleteUser *usecase.DeleteUserUsecase,
+	tokens map[string]string,
+	logger *slog.Logger,
+) *Handler {
+	return &Handler{
+		createUser:      createUser,
+		findUser:        findUser,
+		findUserByEmail: findUserByEmail,
+		listUsers:       listUsers,
+		updateUser:      updateUser,
+		patchUser:       patchUser,
+		deleteUser:      deleteUser,
+		tokens:          tokens,
+		logger:          logger,
+	}
+}
+
+// Routes BasePath にマウントするルーターを返す
+func (h *Handler) Routes() http.Handler {
+	r := chi.NewRouter()
+	r.Use(h.authenticate)
+	r.Get("/ServiceProviderConfig", h.serviceProviderConfig)
+	r.Get("/Users", h.list)
+	r.Post("/Users", h.create)
+	r.Get("/Users/{id}", h.get)
+	r.Put("/Users/{id}", h.replace)
+	r.Patch("/Users/{id}", h.patch)
+	r.Delete("/Users/{id}", h.delete)
+	return r
+}
+
+// authenticate Bearer トークンを検証し、トークンの組織を対象のテナントにする
+func (h *Handler) authenticate(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+		orgID := ""
+		if ok && token != "" {
+			// 一致したトークンで処理時間が変わらないよう、すべてのトークンと比較する
+			for id, t := range h.tokens {
+				if subtle.ConstantTimeCompare([]byte(token), []byte(t)) == 1 {
+					orgID = id
+				}
+			}
+		}
+		if orgID == "" {
+			w.Header().Set("WWW-Authenticate", `Bearer realm="scim"`)
+			writeError(w, &Error{Status: http.StatusUnauthorized, Detail: "invalid bearer token"}, nil)
+			return
+		}
+		next.ServeHTTP(w, r.WithContext(tenant.WithOrgID(r.Context(), orgID)))
+	})
+}
+
+// listResponse SCIM の ListResponse
+type listResponse struct {
+	Schemas      []string       `json:"schemas"`
+	TotalResults int            `json:"totalResults"`
+	StartIndex   int            `json:"startIndex"`
+	ItemsPerPage int            `json:"itemsPerPage"`
+	Resources    []userResource `json:"Resources"`
+}
+
+// list GET /Users（filter / startIndex / count）
+func (h *Handler) list(w http.ResponseWriter, r *http.Request) {
+	startIndex, count, err := pagination(r)
+	if err != nil {
+		writeError(w, err, h.logger)
+		return
+	}
+
+	var users []*domain.User
+	var total int
+	if expr := r.URL.Query().Get("filter"); expr != "" {
+		f, err := parseFilter(expr, filterAttributes)
+		if err != nil {
+			writeError(w, errInvalidFilter(err.Error()), h.logger)
+			return
+		}
+		matched, err := h.search(r, f)
+		if err != nil {
+			writeError(w, err, h.logger)
+			return
+		}
+		total = len(matched)
+		users = matched[min(startIndex-1, total):min(startIndex-1+count, total)]
+	} else if count > 0 {
+		users, total, err = h.listUsers.Execute(r.Context(), "", count, startIndex-1)
+	} else {
+		// count=0 は件数のみを返す
+		_, total, err = h.listUsers.Execute(r.Context(), "", 1, 0)
+	}
+	if err != nil {
+		writeError(w, err, h.logger)
+		return
+	}
+
+	resources := make([]userResource, 0, len(users))
+	for _, user := range users {
+		resources = append(resources, toUserResource(r, user))
+	}
+	writeJSON(w, http.StatusOK, listResponse{
+		Schemas:      []string{SchemaListResponse},
+		TotalResults: total,
+		StartIndex:   startIndex,
+		ItemsPerPage: len(resources),
+		Resources:    resources,
+	})
+}
+
+// search フィルターに一致するユーザーを取得する
+//
+// IdP が存在確認に使う userName / emails / id の eq は一件の取得で済ませ、それ以外は
+// 全ユーザーを順に読み出して評価する。
+func (h *Handler) search(r *http.Request, f filter) ([]*domain.User, error) {
+	if users, ok, err := h.lookup(r, f); ok || err != nil {
+		return users, err
+	}
+
+	var matched []*domain.User
+	for offset := 0; ; offset += scanBatchSize {
+		users, _, err := h.listUsers.Execute(r.Context(), "", scanBatchSize, offset)
+		if err != nil {
+			return nil, err
+		}
+		for _, user := range users {
+			if f.match(userSource{user: user}) {
+				matched = append(matched, user)
+			}
+		}
+		if len(users) < scanBatchSize {
+			return matched, nil
+		}
+	}
+}
+
+// lookup 一件の取得で評価できるフィルター（userName / emails / id の eq）を評価する
+//
+// 評価できないフィルターの場合は ok=false を返す。
+func (h *Handler) lookup(r *http.Request, f filter) (users []*domain.User, ok bool, err error) {
+	c, isCompare := f.(compareFilter)
+	value, isString := c.value.(string)
+	if !isCompare || c.op != "eq" || !isString {
+		return nil, false, nil
+	}
+
+	var user *domain.User
+	switch c.path {
+	case "username", "emails", "emails.value":
+		user, err = h.findUserByEmail.Execute(r.Context(), value)
+	case "id":
+		user, err = h.findUser.Execute(r.Context(), value)
+	default:
+		return nil, false, nil
+	}
+	var notFound *domain.NotFoundError
+	if errors.As(err, &notFound) {
+		// メールアドレスは完全一致で検索されるため、大文字を含む場合は全件の評価に任せる
+		return nil, value == strings.ToLower(value) || c.path == "id", nil
+	}
+	if err != nil {
+		return nil, true, err
+	}
+	// 取得した後もフィルターで評価する（大文字と小文字の扱いをそろえるため）
+	if !f.match(userSource{user: user}) {
+		return nil, true, nil
+	}
+	return []*domain.User{user}, true, nil
+}
+
+// pagination startIndex（1 始まり）と count を解析する
+func pagination(r *http.Request) (int, int, error) {
+	startIndex, count := 1, defaultCount
+	query := r.URL.Query()
+	if s := query.Get("startIndex"); s != "" {
+		n, err := strconv.Atoi(s)
+		if err != nil {
+			return 0, 0, errInvalidValue("startIndex must be an integer")
+		}
+		// 1 未満は 1 として扱う（RFC 7644 3.4.2.4）
+		startIndex = max(n, 1)
+	}
+	if s := query.Get("count"); s != "" {
+		n, err := strconv.Atoi(s)
+		if err != nil {
+			return 0, 0, errInvalidValue("count must be an integer")
+		}
+		count = min(max(n, 0), maxCount)
+	}
+	return startIndex, count, nil
+}
+
+// get GET /Users/{id}
+func (h *Handler) get(w http.ResponseWriter, r *http.Request) {
+	user, err := h.findUser.Execute(r.Context(), chi.URLParam(r, "id"))
+	if err != nil {
+		writeError(w, err, h.logger)
+		return
+	}
+	writeJSON(w, http.StatusOK, toUserResource(r, user))
+}
+
+// create POST /Users
+func (h *Handler) create(w http.ResponseWriter, r *http.Request) {
+	var req userResource
+	if err := decode(r, &req); err != nil {
+		writeError(w, err, h.logger)
+		return
+	}
+	fields, err := req.fields()
+	if err != nil {
+		writeError(w, err, h.logger)
+		return
+	}
+
+	user, err := h.createUser.Execute(r.Context(), fields.name, fields.email)
+	if err != nil {
+		writeError(w, err, h.logger)
+		return
+	}
+	resource := toUserResource(r, user)
+	w.Header().Set("Location", resource.Meta.Location)
+	writeJSON(w, http.StatusCreated, resource)
+}
+
+// replace PUT /Users/{id}（リソース全体の置き換え）
+func (h *Handler) replace(w http.ResponseWriter, r *http.Request) {
+	var req userResource
+	if err := decode(r, &req); err != nil {
+		writeError(w, err, h.logger)
+		return
+	}
+	fields, err := req.fields()
+	if err != nil {
+		writeError(w, err, h.logger)
+		return
+	}
+	h.update(w, r, chi.URLParam(r, "id"), fields)
+}
+
+// patch PATCH /Users/{id}
+func (h *Handler) patch(w http.ResponseWriter, r *http.Request) {
+	var req patchRequest
+	if err := decode(r, &req); err != nil {
+		writeError(w, err, h.logger)
+		return
+	}
+
+	// 現在の値への適用は行ロック下で行う（変更がない場合は更新イベントを記録しない）
+	user, err := h.patchUser.Execute(r.Context(), chi.URLParam(r, "id"), func(name, email string) (string, string, error) {
+		fields, err := req.apply(userFields{name: name, email: email})
+		return fields.name, fields.email, err
+	})
+	if err != nil {
+		writeError(w, err, h.logger)
+		return
+	}
+	writeJSON(w, http.StatusOK, toUserResource(r, user))
+}
+
+// update ユーザーを更新し、更新後のリソースを返す
+func (h *Handler) update(w http.ResponseWriter, r *http.Request, id string, fields userFields) {
+	if err := h.updateUser.Execute(r.Context(), id, fields.name, fields.email); err != nil {
+		writeError(w, err, h.logger)
+		return
+	}
+	user, err := h.findUser.Execute(r.Context(), id)
+	if err != nil {
+		writeError(w, err, h.logger)
+		return
+	}
+	writeJSON(w, http.StatusOK, toUserResource(r, user))
+}
+
+// delete DELETE /Users/{id}
+func (h *Handler) delete(w http.ResponseWriter, r *http.Request) {
+	if err := h.deleteUser.Execute(r.Context(), chi.URLParam(r, "id")); err != nil {
+		writeError(w, err, h.logger)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// serviceProviderConfig GET /ServiceProviderConfig（対応している機能を IdP に伝える）
+func (h *Handler) serviceProviderConfig(w http.ResponseWriter, r *http.Request) {
+	supported := func(v bool) map[string]bool { return map[string]bool{"supported": v} }
+	writeJSON(w, http.StatusOK, map[string]any{
+		"schemas":        []string{SchemaServiceProviderConfig},
+		"patch":          supported(true),
+		"bulk":           map[string]any{"supported": false, "maxOperations": 0, "maxPayloadSize": 0},
+		"filter":         map[string]any{"supported": true, "maxResults": maxCount},
+		"changePassword": supported(false),
+		"sort":           supported(false),
+		"etag":           supported(false),
+		"authenticationSchemes": []map[string]any{{
+			"type":        "oauthbearertoken",
+			"name":        "OAuth Bearer Token",
+			"description": "Authentication with a static bearer token",
+			"primary":     true,
+		}},
+		"meta": map[string]string{
+			"resourceType": "ServiceProviderConfig",
+			"location":     baseURL(r) + "/ServiceProviderConfig",
+		},
+	})
+}
+
+// decode リクエストボディを JSON として読み込む
+func decode(r *http.Request, v any) error {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		return errInvalidSyntax(fmt.Sprintf("invalid request body: %v", err))
+	}
+	return nil
+}
+
+// writeJSON SCIM のメディアタイプでレスポンスを書き込む
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", contentType)
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+// baseURL リクエスト先の SCIM のベース URL（リバースプロキシ経由の場合は X-Forwarded-Proto を優先）
+func baseURL(r *http.Request) string {
+	scheme := "http"
+	if r.TLS != nil {
+		scheme = "https"
+	}
+	if proto := r.Header.Get("X-Forwarded-Proto"); proto != "" {
+		switch p := strings.ToLower(strings.TrimSpace(strings.Split(proto, ",")[0])); p {
+		case "http", "https":
+			scheme = p
+		}
+	}
+	return scheme + "://" + r.Host + BasePath
+}
@@ -0,0 +1,382 @@
+package scim_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/handler/scim"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure/memory"
+	"github.com/example/go-react-cqrs-template/internal/tenant"
+	"github.com/example/go-react-cqrs-template/internal/usecase"
+)
+
+const (
+	// testToken 既定の組織の Bearer トークン
+	testToken = "scim-secret"
+	// testOrgID / testOrgToken 既定以外の組織とその Bearer トークン
+	testOrgID    = "01ARZ3NDEKTSV4RRFFQ69G5FAV"
+	testOrgToken = "scim-acme-secret"
+)
+
+// newTestRouter インメモリストアを使う SCIM のルーターを作成する
+func newTestRouter(t *testing.T, store *memory.Store) http.Handler {
+	t.Helper()
+	h := scim.NewHandler(
+		usecase.NewCreateUserUsecase(store, store, store),
+		usecase.NewFindUserUsecase(store),
+		usecase.NewFindUserByEmailUsecase(store),
+		usecase.NewListUsersUsecase(store),
+		usecase.NewUpdateUserUsecase(store, store, store),
+		usecase.NewPatchUserUsecase(store, store, store),
+		usecase.NewDeleteUserUsecase(store, store, store),
+		map[string]string{tenant.DefaultOrgID: testToken, testOrgID: testOrgToken},
+		slog.New(slog.NewTextHandler(io.Discard, nil)),
+	)
+	r := chi.NewRouter()
+	r.Mount(scim.BasePath, h.Routes())
+	return r
+}
+
+func do(t *testing.T, router http.Handler, method, path, body string) *httptest.ResponseRecorder {
+	t.Helper()
+	return doWithToken(t, router, testToken, method, path, body)
+}
+
+func doWithToken(t *testing.T, router http.Handler, token, method, path, body string) *httptest.ResponseRecorder {
+	t.Helper()
+	req := httptest.NewRequest(method, scim.BasePath+path, bytes.NewBufferString(body))
+	req.Header.Set("Authorization", "Bearer "+token)
+	if body != "" {
+		req.Header.Set("Content-Type", "application/scim+json")
+	}
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+	return rec
+}
+
+func decode(t *testing.T, rec *httptest.ResponseRecorder) map[string]any {
+	t.Helper()
+	var got map[string]any
+	if err := json.NewDecoder(rec.Body).Decode(&got); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	return got
+}
+
+func seedUser(t *testing.T, store *memory.Store, name, email string) *domain.User {
+	t.Helper()
+	user, err := domain.NewUser(name, email)
+	if err != nil {
+		t.Fatalf("Failed to create user: %v", err)
+	}
+	store.Seed(user)
+	return user
+}
+
+func TestHandler_Unauthorized(t *testing.T) {
+	router := newTestRouter(t, memory.NewStore())
+
+	req := httptest.NewRequest(http.MethodGet, scim.BasePath+"/Users", nil)
+	req.Header.Set("Authorization", "Bearer wrong")
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusUnauthorized {
+		t.Fatalf("status = %d, want %d", rec.Code, http.StatusUnauthorized)
+	}
+	got := decode(t, rec)
+	if got["schemas"].([]any)[0] != scim.SchemaError || got["status"] != "401" {
+		t.Errorf("error response = %v", got)
+	}
+}
+
+// TestHandler_TenantFromToken リクエストの対象はトークンの組織になる
+func TestHandler_TenantFromToken(t *testing.T) {
+	store := memory.NewStore()
+	inDefault := seedUser(t, store, "John Doe", "john@example.com")
+	inOrg, err := domain.NewUser("Jane Doe", "jane@example.com")
+	if err != nil {
+		t.Fatalf("Failed to create user: %v", err)
+	}
+	store.SeedInOrg(testOrgID, inOrg)
+	router := newTestRouter(t, store)
+
+	tests := []struct {
+		name  string
+		token string
+		id    string
+		want  int
+	}{
+		{name: "default organization", token: testToken, id: inDefault.ID, want: http.StatusOK},
+		{name: "user of another organization", token: testToken, id: inOrg.ID, want: http.StatusNotFound},
+		{name: "organization of token", token: testOrgToken, id: inOrg.ID, want: http.StatusOK},
+		{name: "default organization with token of another organization", token: testOrgToken, id: inDefault.ID, want: http.StatusNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if rec := doWithToken(t, router, tt.token, http.MethodGet, "/Users/"+tt.id, ""); rec.Code != tt.want {
+				t.Errorf("status = %d, want %d (body: %s)", rec.Code, tt.want, rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestHandler_CreateUser(t *testing.T) {
+	store := memory.NewStore()
+	router := newTestRouter(t, store)
+
+	rec := do(t, router, http.MethodPost, "/Users", `{
+		"schemas": ["urn:ietf:params:scim:schemas:core:2.0:User"],
+		"userName": "alice@example.com",
+		"name": {"givenName": "Alice", "familyName": "Smith"},
+		"emails": [{"value": "alice@example.com", "primary": true}],
+		"active": "True"
+	}`)
+
+	if rec.Code != http.StatusCreated {
+		t.Fatalf("status = %d, want %d (body: %s)", rec.Code, http.StatusCreated, rec.Body.String())
+	}
+	if ct := rec.Header().Get("Content-Type"); ct != "application/scim+json" {
+		t.Errorf("Content-Type = %q", ct)
+	}
+	got := decode(t, rec)
+	id, _ := got["id"].(string)
+	if got["userName"] != "alice@example.com" || got["displayName"] != "Alice Smith" {
+		t.Errorf("response = %v", got)
+	}
+	if loc := rec.Header().Get("Location"); loc != "http://example.com/scim/v2/Users/"+id {
+		t.Errorf("Location = %q", loc)
+	}
+	if logs := store.UserLogs(id); len(logs) != 1 || logs[0].Action != domain.UserLogActionCreated {
+		t.Errorf("user logs = %v, want one create log", logs)
+	}
+
+	// 同じメールアドレスは REST API と同じく一意性違反になる
+	rec = do(t, router, http.MethodPost, "/Users", `{"userName": "alice@example.com"}`)
+	if rec.Code != http.StatusConflict {
+		t.Fatalf("duplicate status = %d, want %d", rec.Code, http.StatusConflict)
+	}
+	if got := decode(t, rec); got["scimType"] != "uniqueness" {
+		t.Errorf("duplicate response = %v", got)
+	}
+}
+
+func TestHandler_CreateUser_Invalid(t *testing.T) {
+	tests := []struct {
+		name         string
+		body         string
+		wantScimType string
+	}{
+		{name: "malformed json", body: `{`, wantScimType: "invalidSyntax"},
+		{name: "missing userName", body: `{"displayName": "Alice"}`, wantScimType: "invalidValue"},
+		{name: "invalid email", body: `{"userName": "alice"}`, wantScimType: "invalidValue"},
+		{name: "inactive", body: `{"userName": "alice@example.com", "active": false}`, wantScimType: "mutability"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router := newTestRouter(t, memory.NewStore())
+			rec := do(t, router, http.MethodPost, "/Users", tt.body)
+			if rec.Code != http.StatusBadRequest {
+				t.Fatalf("status = %d, want %d", rec.Code, http.StatusBadRequest)
+			}
+			if got := decode(t, rec); got["scimType"] != tt.wantScimType {
+				t.Errorf("scimType = %v, want %s", got["scimType"], tt.wantScimType)
+			}
+		})
+	}
+}
+
+func TestHandler_ListUsers(t *testing.T) {
+	store := memory.NewStore()
+	alice := seedUser(t, store, "Alice", "alice@example.com")
+	seedUser(t, store, "Bob", "bob@example.org")
+	seedUser(t, store, "Carol", "carol@example.com")
+
+	tests := []struct {
+		name      string
+		query     string
+		wantTotal float64
+		wantItems int
+	}{
+		{name: "all", query: "", wantTotal: 3, wantItems: 3},
+		{name: "paged", query: "?startIndex=2&count=1", wantTotal: 3, wantItems: 1},
+		{name: "count zero", query: "?count=0", wantTotal: 3, wantItems: 0},
+		{name: "userName eq", query: `?filter=userName+eq+"alice@example.com"`, wantTotal: 1, wantItems: 1},
+		{name: "userName eq mixed case", query: `?filter=userName+eq+"Alice@Example.com"`, wantTotal: 1, wantItems: 1},
+		{name: "userName eq missing", query: `?filter=userName+eq+"dave@example.com"`, wantTotal: 0, wantItems: 0},
+		{name: "id eq", query: `?filter=id+eq+"` + alice.ID + `"`, wantTotal: 1, wantItems: 1},
+		{name: "emails.value co", query: `?filter=emails.value+co+"example.com"`, wantTotal: 2, wantItems: 2},
+		{name: "filter paged", query: `?filter=emails.value+co+"example.com"&startIndex=2`, wantTotal: 2, wantItems: 1},
+	}
+
+	router := newTestRouter(t, store)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := do(t, router, http.MethodGet, "/Users"+tt.query, "")
+			if rec.Code != http.StatusOK {
+				t.Fatalf("status = %d, want %d (body: %s)", rec.Code, http.StatusOK, rec.Body.String())
+			}
+			got := decode(t, rec)
+			if got["schemas"].([]any)[0] != scim.SchemaListResponse {
+				t.Errorf("schemas = %v", got["schemas"])
+			}
+			if got["totalResults"] != tt.wantTotal {
+				t.Errorf("totalResults = %v, want %v", got["totalResults"], tt.wantTotal)
+			}
+			if items := got["Resources"].([]any); len(items) != tt.wantItems || got["itemsPerPage"] != float64(tt.wantItems) {
+				t.Errorf("Resources = %d items (itemsPerPage %v), want %d", len(items), got["itemsPerPage"], tt.wantItems)
+			}
+		})
+	}
+
+	rec := do(t, router, http.MethodGet, `/Users?filter=password+eq+"x"`, "")
+	if rec.Code != http.StatusBadRequest || decode(t, rec)["scimType"] != "invalidFilter" {
+		t.Errorf("invalid filter status = %d, want 400 invalidFilter", rec.Code)
+	}
+}
+
+func TestHandler_GetUser(t *testing.T) {
+	store := memory.NewStore()
+	alice := seedUser(t, store, "Alice", "alice@example.com")
+	router := newTestRouter(t, store)
+
+	rec := do(t, router, http.MethodGet, "/Users/"+alice.ID, "")
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d", rec.Code, http.StatusOK)
+	}
+	if got := decode(t, rec); got["id"] != alice.ID || got["userName"] != alice.Email {
+		t.Errorf("response = %v", got)
+	}
+
+	rec = do(t, router, http.MethodGet, "/Users/01ARZ3NDEKTSV4RRFFQ69G5FAV", "")
+	if rec.Code != http.StatusNotFound || decode(t, rec)["status"] != "404" {
+		t.Errorf("missing user status = %d, want 404", rec.Code)
+	}
+}
+
+func TestHandler_ReplaceUser(t *testing.T) {
+	store := memory.NewStore()
+	alice := seedUser(t, store, "Alice", "alice@example.com")
+	router := newTestRouter(t, store)
+
+	rec := do(t, router, http.MethodPut, "/Users/"+alice.ID, `{
+		"schemas": ["urn:ietf:params:scim:schemas:core:2.0:User"],
+		"userName": "alice.smith@example.com",
+		"displayName": "Alice Smith"
+	}`)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d (body: %s)", rec.Code, http.StatusOK, rec.Body.String())
+	}
+	got := decode(t, rec)
+	if got["userName"] != "alice.smith@example.com" || got["displayName"] != "Alice Smith" {
+		t.Errorf("response = %v", got)
+	}
+	if events := store.UserEvents(alice.ID); len(events) != 1 || events[0].Type != domain.UserEventTypeUpdated {
+		t.Errorf("user events = %v, want one UserUpdated event", events)
+	}
+}
+
+func TestHandler_PatchUser(t *testing.T) {
+	tests := []struct {
+		name       string
+		body       string
+		wantStatus int
+		wantName   string
+		wantEmail  string
+		wantEvents int
+	}{
+		{
+			name:       "replace with path",
+			body:       `{"schemas":["urn:ietf:params:scim:api:messages:2.0:PatchOp"],"Operations":[{"op":"replace","path":"displayName","value":"Alice Smith"}]}`,
+			wantStatus: http.StatusOK, wantName: "Alice Smith", wantEmail: "alice@example.com", wantEvents: 1,
+		},
+		{
+			name:       "replace without path",
+			body:       `{"Operations":[{"op":"Replace","value":{"userName":"alice2@example.com","name.familyName":"Jones"}}]}`,
+			wantStatus: http.StatusOK, wantName: "Alice Jones", wantEmail: "alice2@example.com", wantEvents: 1,
+		},
+		{
+			name:       "replace email by value filter",
+			body:       `{"Operations":[{"op":"replace","path":"emails[type eq \"work\"].value","value":"alice3@example.com"}]}`,
+			wantStatus: http.StatusOK, wantName: "Alice", wantEmail: "alice3@example.com", wantEvents: 1,
+		},
+		{
+			name:       "no change",
+			body:       `{"Operations":[{"op":"replace","path":"active","value":true},{"op":"add","path":"externalId","value":"x"}]}`,
+			wantStatus: http.StatusOK, wantName: "Alice", wantEmail: "alice@example.com", wantEvents: 0,
+		},
+		{
+			name:       "deactivate",
+			body:       `{"Operations":[{"op":"replace","path":"active","value":"False"}]}`,
+			wantStatus: http.StatusBadRequest, wantName: "Alice", wantEmail: "alice@example.com", wantEvents: 0,
+		},
+		{
+			name:       "remove required",
+			body:       `{"Operations":[{"op":"remove","path":"userName"}]}`,
+			wantStatus: http.StatusBadRequest, wantName: "Alice", wantEmail: "alice@example.com", wantEvents: 0,
+		},
+		{
+			name:       "unsupported path",
+			body:       `{"Operations":[{"op":"replace","path":"nickName","value":"Al"}]}`,
+			wantStatus: http.StatusBadRequest, wantName: "Alice", wantEmail: "alice@example.com", wantEvents: 0,
+		},
+		{
+			name:       "duplicate email",
+			body:       `{"Operations":[{"op":"replace","path":"userName","value":"bob@example.com"}]}`,
+			wantStatus: http.StatusConflict, wantName: "Alice", wantEmail: "alice@example.com", wantEvents: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := memory.NewStore()
+			alice := seedUser(t, store, "Alice", "alice@example.com")
+			seedUser(t, store, "Bob", "bob@example.com")
+			router := newTestRouter(t, store)
+
+			rec := do(t, router, http.MethodPatch, "/Users/"+alice.ID, tt.body)
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d (body: %s)", rec.Code, tt.wantStatus, rec.Body.String())
+			}
+
+			got, err := store.FindByID(t.Context(), alice.ID)
+			if err != nil {
+				t.Fatalf("FindByID() error = %v", err)
+			}
+			if got.Name != tt.wantName || got.Email != tt.wantEmail {
+				t.Errorf("user = (%q, %q), want (%q, %q)", got.Name, got.Email, tt.wantName, tt.wantEmail)
+			}
+			if events := store.UserEvents(alice.ID); len(events) != tt.wantEvents {
+				t.Errorf("user events = %d, want %d", len(events), tt.wantEvents)
+			}
+		})
+	}
+}
+
+func TestHandler_DeleteUser(t *testing.T) {
+	store := memory.NewStore()
+	alice := seedUser(t, store, "Alice", "alice@example.com")
+	router := newTestRouter(t, store)
+
+	rec := do(t, router, http.MethodDelete, "/Users/"+alice.ID, "")
+	if rec.Code != http.StatusNoContent {
+		t.Fatalf("status = %d, want %d", rec.Code, http.StatusNoContent)
+	}
+	if logs := store.UserLogs(alice.ID); len(logs) != 1 || logs[0].Action != domain.UserLogActionDeleted {
+		t.Errorf("user logs = %v, want one delete log", logs)
+	}
+
+	rec = do(t, router, http.MethodGet, "/Users/"+alice.ID, "")
+	if rec.Code != http.StatusNotFound {
+		t.Errorf("status after delete = %d, want %d", rec.Code, http.StatusNotFound)
+	}
+}
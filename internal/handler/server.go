@@ -0,0 +1,52 @@
+package handler
+
+import (
+	"github.com/example/go-react-cqrs-template/pkg/generated/openapi"
+)
+
+// コンパイル時に ServerInterface の実装を検証
+var _ openapi.ServerInterface = (*Server)(nil)
+
+// Server リソースごとのハンドラーをまとめて OpenAPI生成のServerInterfaceを実装する
+type Server struct {
+	*UserHandler
+	*WebhookHandler
+	*UserStreamHandler
+	*OrganizationHandler
+	*OrgUserHandler
+	*GroupHandler
+	*AuthHandler
+	*InvitationHandler
+	*SessionHandler
+	*MFAHandler
+	*OIDCHandler
+}
+
+// NewServer Serverのコンストラクタ
+func NewServer(
+	userHandler *UserHandler,
+	webhookHandler *WebhookHandler,
+	userStreamHandler *UserStreamHandler,
+	organizationHandler *OrganizationHandler,
+	orgUserHandler *OrgUserHandler,
+	groupHandler *GroupHandler,
+	authHandler *AuthHandler,
+	invitationHandler *InvitationHandler,
+	sessionHandler *SessionHandler,
+	mfaHandler *MFAHandler,
+	oidcHandler *OIDCHandler,
+) *Server {
+	return &Server{
+		UserHandler:         userHandler,
+		WebhookHandler:      webhookHandler,
+		UserStreamHandler:   userStreamHandler,
+		OrganizationHandler: organizationHandler,
+		OrgUserHandler:      orgUserHandler,
+		GroupHandler:        groupHandler,
+		AuthHandler:         authHandler,
+		InvitationHandler:   invitationHandler,
+		SessionHandler:      sessionHandler,
+		MFAHandler:          mfaHandler,
+		OIDCHandler:         oidcHandler,
+	}
+}
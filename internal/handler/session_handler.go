@@ -0,0 +1,113 @@
+package handler
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/example/go-react-cqrs-template/internal/auth"
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/usecase"
+	"github.com/example/go-react-cqrs-template/pkg/generated/openapi"
+)
+
+// SessionHandler ユーザーのセッションの一覧・取り消しのHTTPハンドラー
+type SessionHandler struct {
+	listSessions      *usecase.ListSessionsUsecase
+	revokeSession     *usecase.RevokeSessionUsecase
+	revokeAllSessions *usecase.RevokeAllSessionsUsecase
+	logger            *slog.Logger
+}
+
+// NewSessionHandler SessionHandlerのコンストラクタ
+func NewSessionHandler(
+	listSessions *usecase.ListSessionsUsecase,
+	revokeSession *usecase.RevokeSessionUsecase,
+	revokeAllSessions *usecase.RevokeAllSessionsUsecase,
+	logger *slog.Logger,
+) *SessionHandler {
+	return &SessionHandler{
+		listSessions:      listSessions,
+		revokeSession:     revokeSession,
+		revokeAllSessions: revokeAllSessions,
+		logger:            logger,
+	}
+}
+
+// UserSessionsListSessions ユーザーの有効なセッション一覧を取得（OpenAPI ServerInterface実装）
+//
+// 本人としてログインしたリクエストのみ受け付ける（未ログインは 401、本人以外は 403）。
+func (h *SessionHandler) UserSessionsListSessions(w http.ResponseWriter, r *http.Request, userId string) {
+	if !authorizeSelf(w, r, h.logger, userId, "list sessions", "他のユーザーのセッションは取得できません") {
+		return
+	}
+
+	sessions, err := h.listSessions.Execute(r.Context(), userId)
+	if err != nil {
+		HandleError(w, err, h.logger)
+		return
+	}
+
+	current, _ := auth.SessionID(r.Context())
+	responses := make([]openapi.Session, 0, len(sessions))
+	for _, s := range sessions {
+		responses = append(responses, toSessionResponse(s, current))
+	}
+
+	respondJSON(w, http.StatusOK, openapi.SessionList{Sessions: responses})
+}
+
+// UserSessionsRevokeSession ユーザーのセッションを取り消し（OpenAPI ServerInterface実装）
+//
+// 本人としてログインしたリクエストのみ受け付ける（未ログインは 401、本人以外は 403）。
+func (h *SessionHandler) UserSessionsRevokeSession(w http.ResponseWriter, r *http.Request, userId string, sessionId string) {
+	if !authorizeSelf(w, r, h.logger, userId, "revoke sessions", "他のユーザーのセッションは取り消せません") {
+		return
+	}
+
+	if err := h.revokeSession.Execute(r.Context(), userId, sessionId); err != nil {
+		HandleError(w, err, h.logger)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// UserSessionsRevokeAllSessions ユーザーのすべてのセッションを取り消し（OpenAPI ServerInterface実装）
+//
+// リクエストに使用したセッションも取り消す（すべての端末からログアウトする）。
+func (h *SessionHandler) UserSessionsRevokeAllSessions(w http.ResponseWriter, r *http.Request, userId string) {
+	if !authorizeSelf(w, r, h.logger, userId, "revoke sessions", "他のユーザーのセッションは取り消せません") {
+		return
+	}
+
+	if err := h.revokeAllSessions.Execute(r.Context(), userId); err != nil {
+		HandleError(w, err, h.logger)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// toSessionResponse ドメインモデルをレスポンスに変換（currentSessionID はリクエストの認証に使用したセッション）
+func toSessionResponse(s *domain.Session, currentSessionID string) openapi.Session {
+	response := openapi.Session{
+		Id:         s.ID,
+		CreatedAt:  s.CreatedAt,
+		LastSeenAt: s.LastSeenAt,
+		ExpiresAt:  s.ExpiresAt,
+		Current:    s.ID == currentSessionID,
+	}
+	if s.Device != "" {
+		device := s.Device
+		response.Device = &device
+	}
+	if s.IPAddress != "" {
+		ipAddress := s.IPAddress
+		response.IpAddress = &ipAddress
+	}
+	if s.UserAgent != "" {
+		userAgent := s.UserAgent
+		response.UserAgent = &userAgent
+	}
+	return response
+}
@@ -0,0 +1,103 @@
+package handler_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure/memory"
+	"github.com/example/go-react-cqrs-template/pkg/generated/openapi"
+)
+
+// login テスト用に端末名を指定してログインする
+func login(t *testing.T, router http.Handler, device string) openapi.LoginResponse {
+	t.Helper()
+	rec := postJSON(t, router, "/auth/login", "", `{"email":"john@example.com","password":"correct horse battery","device":"`+device+`"}`)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("login status = %d, want %d: %s", rec.Code, http.StatusOK, rec.Body.String())
+	}
+	var resp openapi.LoginResponse
+	if err := json.NewDecoder(rec.Body).Decode(&resp); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	return resp
+}
+
+func TestSessionHandler_ListAndRevoke(t *testing.T) {
+	user, err := domain.NewUser("John Doe", "john@example.com")
+	if err != nil {
+		t.Fatalf("Failed to create user: %v", err)
+	}
+	other, err := domain.NewUser("Jane Doe", "jane@example.com")
+	if err != nil {
+		t.Fatalf("Failed to create user: %v", err)
+	}
+	store := memory.NewStore()
+	store.Seed(user, other)
+	store.SeedCredentials(mustNewCredential(t, user, "correct horse battery"))
+	router := newTestRouter(t, store)
+
+	laptop := login(t, router, "laptop")
+	phone := login(t, router, "phone")
+	sessionsPath := "/users/" + user.ID + "/sessions"
+
+	// ログインごとのセッションを一覧し、リクエストに使用したセッションを current とする
+	rec := getWithHeaders(router, sessionsPath, map[string]string{"Authorization": "Bearer " + laptop.Token})
+	if rec.Code != http.StatusOK {
+		t.Fatalf("list sessions status = %d, want %d: %s", rec.Code, http.StatusOK, rec.Body.String())
+	}
+	var list openapi.SessionList
+	if err := json.NewDecoder(rec.Body).Decode(&list); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if len(list.Sessions) != 2 {
+		t.Fatalf("sessions = %d, want 2", len(list.Sessions))
+	}
+	for _, s := range list.Sessions {
+		if s.Current != (s.Id == laptop.SessionId) {
+			t.Errorf("session %s current = %v, want %v", s.Id, s.Current, s.Id == laptop.SessionId)
+		}
+		if s.Id == laptop.SessionId && (s.Device == nil || *s.Device != "laptop" || s.IpAddress == nil || *s.IpAddress != "192.0.2.1") {
+			t.Errorf("session = %+v, want device laptop from 192.0.2.1", s)
+		}
+	}
+
+	// 未ログインでは一覧・取り消しできず、他のユーザーのセッションは操作できない
+	if rec = getWithHeaders(router, sessionsPath, nil); rec.Code != http.StatusUnauthorized {
+		t.Errorf("list sessions without login status = %d, want %d", rec.Code, http.StatusUnauthorized)
+	}
+	if rec = postJSON(t, router, sessionsPath+":revoke", "", ""); rec.Code != http.StatusUnauthorized {
+		t.Errorf("revoke sessions without login status = %d, want %d", rec.Code, http.StatusUnauthorized)
+	}
+	if rec = getWithHeaders(router, "/users/"+other.ID+"/sessions", map[string]string{"Authorization": "Bearer " + laptop.Token}); rec.Code != http.StatusForbidden {
+		t.Errorf("list other's sessions status = %d, want %d", rec.Code, http.StatusForbidden)
+	}
+	if rec = postJSON(t, router, "/users/"+other.ID+"/sessions:revoke", laptop.Token, ""); rec.Code != http.StatusForbidden {
+		t.Errorf("revoke other's sessions status = %d, want %d", rec.Code, http.StatusForbidden)
+	}
+
+	// 取り消したセッションのトークンは認証に使用できない
+	if rec = postJSON(t, router, sessionsPath+"/"+phone.SessionId+":revoke", laptop.Token, ""); rec.Code != http.StatusNoContent {
+		t.Fatalf("revoke session status = %d, want %d: %s", rec.Code, http.StatusNoContent, rec.Body.String())
+	}
+	if rec = getWithHeaders(router, sessionsPath, map[string]string{"Authorization": "Bearer " + phone.Token}); rec.Code != http.StatusUnauthorized {
+		t.Errorf("revoked token status = %d, want %d", rec.Code, http.StatusUnauthorized)
+	}
+	if rec = postJSON(t, router, sessionsPath+"/01ARZ3NDEKTSV4RRFFQ69G5FAV:revoke", laptop.Token, ""); rec.Code != http.StatusNotFound {
+		t.Errorf("revoke unknown session status = %d, want %d", rec.Code, http.StatusNotFound)
+	}
+
+	// すべてのセッションを取り消すとリクエストに使用したトークンも無効になる
+	if rec = postJSON(t, router, sessionsPath+":revoke", laptop.Token, ""); rec.Code != http.StatusNoContent {
+		t.Fatalf("revoke all sessions status = %d, want %d: %s", rec.Code, http.StatusNoContent, rec.Body.String())
+	}
+	if rec = getWithHeaders(router, sessionsPath, map[string]string{"Authorization": "Bearer " + laptop.Token}); rec.Code != http.StatusUnauthorized {
+		t.Errorf("token after revoking all status = %d, want %d", rec.Code, http.StatusUnauthorized)
+	}
+	for _, s := range store.Sessions(user.ID) {
+		if !s.IsRevoked() {
+			t.Errorf("session %s is not revoked", s.ID)
+		}
+	}
+}
@@ -0,0 +1,104 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"regexp"
+	"strings"
+
+	apperrors "github.com/example/go-react-cqrs-template/internal/pkg/errors"
+	"github.com/example/go-react-cqrs-template/internal/tenant"
+	"github.com/example/go-react-cqrs-template/internal/usecase"
+)
+
+// orgIDPattern 組織ID（ULID）の形式
+var orgIDPattern = regexp.MustCompile(`^[0-9A-HJKMNP-TV-Z]{26}$`)
+
+// TenantMiddleware リクエストの対象テナント（組織）を解決してコンテキストに設定するミドルウェア
+//
+// 認証済みのプリンシパルが所属する組織（tenant.PrincipalOrgID）、X-Org-ID ヘッダー、
+// 既定の組織（tenant.DefaultOrgID）の順に解決する。ヘッダーの形式が不正な場合は 400、
+// プリンシパルの組織と異なる場合は 403、存在しない組織の場合は 404 を返す。
+//
+// 未認証のリクエストは既定の組織以外を指定できない（401）。ただし signInPaths のいずれかで始まるパス
+// （ログインやメールのトークンの確認など、組織内の資格情報で本人を確認するエンドポイント）は、
+// 未認証でもヘッダーで組織を指定できる。
+func TenantMiddleware(findOrganization *usecase.FindOrganizationUsecase, logger *slog.Logger, signInPaths ...string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := r.Context()
+			orgID := tenant.DefaultOrgID
+			if principalOrgID, ok := tenant.PrincipalOrgID(ctx); ok {
+				orgID = principalOrgID
+			}
+			if header := r.Header.Get(tenant.Header); header != "" {
+				if !orgIDPattern.MatchString(header) {
+					HandleError(w, apperrors.BadRequest(
+						fmt.Sprintf("invalid %s header: %q", tenant.Header, header),
+						"組織IDの形式が不正です",
+					), logger)
+					return
+				}
+				if !hasAnyPrefix(r.URL.Path, signInPaths) {
+					if err := authorizeOrg(ctx, header); err != nil {
+						HandleError(w, err, logger)
+						return
+					}
+				} else if principalOrgID, ok := tenant.PrincipalOrgID(ctx); ok && principalOrgID != header {
+					HandleError(w, forbiddenOrg(principalOrgID, header), logger)
+					return
+				}
+				orgID = header
+			}
+
+			// 既定の組織は db/seed で必ず作成されるため確認しない
+			if orgID != tenant.DefaultOrgID {
+				if _, err := findOrganization.Execute(ctx, orgID); err != nil {
+					HandleError(w, err, logger)
+					return
+				}
+			}
+			next.ServeHTTP(w, r.WithContext(tenant.WithOrgID(ctx, orgID)))
+		})
+	}
+}
+
+// authorizeOrg 認証済みのプリンシパルが組織 orgID を対象にできるか検証する
+//
+// プリンシパルの組織以外は 403、未認証の場合は既定の組織以外を 401 にする。
+func authorizeOrg(ctx context.Context, orgID string) error {
+	principalOrgID, ok := tenant.PrincipalOrgID(ctx)
+	if !ok {
+		if orgID == tenant.DefaultOrgID {
+			return nil
+		}
+		return apperrors.Unauthorized(
+			fmt.Sprintf("unauthenticated request cannot access organization %s", orgID),
+			"組織を指定するにはログインしてください",
+		)
+	}
+	if principalOrgID != orgID {
+		return forbiddenOrg(principalOrgID, orgID)
+	}
+	return nil
+}
+
+// forbiddenOrg プリンシパルの組織 principalOrgID 以外の組織 orgID を対象にしたエラー
+func forbiddenOrg(principalOrgID, orgID string) error {
+	return apperrors.Forbidden(
+		fmt.Sprintf("principal of organization %s cannot access organization %s", principalOrgID, orgID),
+		"この組織にアクセスする権限がありません",
+	)
+}
+
+// hasAnyPrefix path が prefixes のいずれかで始まるか
+func hasAnyPrefix(path string, prefixes []string) bool {
+	for _, prefix := range prefixes {
+		if strings.HasPrefix(path, prefix) {
+			return true
+		}
+	}
+	return false
+}
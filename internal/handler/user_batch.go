@@ -0,0 +1,111 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/pkg/logger"
+	"github.com/example/go-react-cqrs-template/internal/usecase"
+	"github.com/example/go-react-cqrs-template/pkg/generated/openapi"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+const (
+	// batchCodeAborted atomic モードで他の操作の失敗により取り消された操作のエラーコード
+	batchCodeAborted = "ABORTED"
+	// batchCodeInternal ドメインエラー以外で失敗した操作のエラーコード
+	batchCodeInternal = "INTERNAL_ERROR"
+)
+
+// batchSuccessStatus 成功した操作の結果のステータス（単体の API と同じ）
+var batchSuccessStatus = map[usecase.BatchMethod]int32{
+	usecase.BatchMethodCreate: http.StatusCreated,
+	usecase.BatchMethodUpdate: http.StatusOK,
+	usecase.BatchMethodDelete: http.StatusNoContent,
+}
+
+// UsersBatchUsers ユーザーを一括で作成・更新・削除（OpenAPI ServerInterface実装）
+//
+// 操作ごとの成否は結果の status / code で返すため、一部が失敗しても 200 を返す。
+func (h *UserHandler) UsersBatchUsers(w http.ResponseWriter, r *http.Request) {
+	var req openapi.BatchUsersRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, http.StatusBadRequest, "リクエストの形式が不正です")
+		return
+	}
+
+	ops := make([]usecase.BatchOperation, len(req.Operations))
+	for i, op := range req.Operations {
+		ops[i] = usecase.BatchOperation{
+			Method: usecase.BatchMethod(op.Method),
+			Name:   op.Name,
+		}
+		if op.Id != nil {
+			ops[i].ID = *op.Id
+		}
+		if op.Email != nil {
+			email := string(*op.Email)
+			ops[i].Email = &email
+		}
+	}
+	atomic := req.Atomic != nil && *req.Atomic
+
+	results, err := h.batchUsers.Execute(r.Context(), ops, atomic)
+	if err != nil {
+		HandleError(w, err, h.logger)
+		return
+	}
+
+	response := openapi.BatchUsersResponse{
+		Results: make([]openapi.BatchUserResult, len(results)),
+	}
+	for i, result := range results {
+		response.Results[i] = h.toBatchUserResult(ops[i].Method, result)
+	}
+	respondJSON(w, http.StatusOK, response)
+}
+
+// toBatchUserResult 操作の結果をレスポンスに変換する（失敗はエラーレスポンスと同じくログに出力する）
+func (h *UserHandler) toBatchUserResult(method usecase.BatchMethod, result usecase.BatchResult) openapi.BatchUserResult {
+	if result.Err == nil {
+		res := openapi.BatchUserResult{Status: batchSuccessStatus[method]}
+		if result.User != nil {
+			user := toUserResponse(result.User)
+			res.User = &user
+		}
+		return res
+	}
+
+	if errors.Is(result.Err, usecase.ErrBatchAborted) {
+		code, message := batchCodeAborted, "他の操作が失敗したため取り消されました"
+		return openapi.BatchUserResult{Status: http.StatusFailedDependency, Code: &code, Message: &message}
+	}
+
+	appErr := ToAppError(result.Err)
+	logger.LogError(h.logger, appErr, "batch operation error")
+	code := batchCodeInternal
+	if domainErr := domain.AsDomainError(result.Err); domainErr != nil {
+		code = string(domainErr.Code)
+	}
+	message := appErr.UserMessage()
+	return openapi.BatchUserResult{Status: int32(appErr.StatusCode()), Code: &code, Message: &message}
+}
+
+// toUserResponse domain.User をレスポンスの User に変換する
+func toUserResponse(user *domain.User) openapi.User {
+	response := openapi.User{
+		Id:        user.ID,
+		Name:      user.Name,
+		Email:     openapi_types.Email(user.Email),
+		Status:    openapi.UserStatus(user.Status),
+		CreatedAt: user.CreatedAt,
+		UpdatedAt: user.UpdatedAt,
+	}
+	if user.IsEmailVerified() {
+		verifiedAt := user.EmailVerifiedAt
+		response.EmailVerifiedAt = &verifiedAt
+	}
+	return response
+}
@@ -2,25 +2,44 @@ package handler
 
 import (
 	"encoding/json"
+	"errors"
+	"io"
 	"log/slog"
 	"net/http"
+	"time"
 
+	"github.com/example/go-react-cqrs-template/internal/domain"
 	"github.com/example/go-react-cqrs-template/internal/usecase"
 	"github.com/example/go-react-cqrs-template/pkg/generated/openapi"
-	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
-// コンパイル時に ServerInterface の実装を検証
-var _ openapi.ServerInterface = (*UserHandler)(nil)
-
 // UserHandler HTTPハンドラー（OpenAPI生成のServerInterfaceを実装）
 type UserHandler struct {
 	createUser *usecase.CreateUserUsecase
 	findUser   *usecase.FindUserUsecase
 	listUsers  *usecase.ListUsersUsecase
 	updateUser *usecase.UpdateUserUsecase
+	patchUser  *usecase.PatchUserUsecase
 	deleteUser *usecase.DeleteUserUsecase
-	logger     *slog.Logger
+	batchUsers *usecase.BatchUsersUsecase
+	// changeUserStatus 一時停止・再開・無効化
+	changeUserStatus *usecase.ChangeUserStatusUsecase
+	logger           *slog.Logger
+
+	// cacheControl ユーザーの取得・一覧のレスポンスの Cache-Control
+	cacheControl string
+}
+
+// UserHandlerOption はUserHandlerの設定を変更する
+type UserHandlerOption func(*UserHandler)
+
+// WithCacheControl ユーザーの取得・一覧のレスポンスの Cache-Control を設定する（既定は DefaultCacheControl）
+func WithCacheControl(value string) UserHandlerOption {
+	return func(h *UserHandler) {
+		if value != "" {
+			h.cacheControl = value
+		}
+	}
 }
 
 // NewUserHandler UserHandlerのコンストラクタ
@@ -29,17 +48,29 @@ func NewUserHandler(
 	findUser *usecase.FindUserUsecase,
 	listUsers *usecase.ListUsersUsecase,
 	updateUser *usecase.UpdateUserUsecase,
+	patchUser *usecase.PatchUserUsecase,
 	deleteUser *usecase.DeleteUserUsecase,
+	batchUsers *usecase.BatchUsersUsecase,
+	changeUserStatus *usecase.ChangeUserStatusUsecase,
 	logger *slog.Logger,
+	opts ...UserHandlerOption,
 ) *UserHandler {
-	return &UserHandler{
-		createUser: createUser,
-		findUser:   findUser,
-		listUsers:  listUsers,
-		updateUser: updateUser,
-		deleteUser: deleteUser,
-		logger:     logger,
+	h := &UserHandler{
+		createUser:       createUser,
+		findUser:         findUser,
+		listUsers:        listUsers,
+		updateUser:       updateUser,
+		patchUser:        patchUser,
+		deleteUser:       deleteUser,
+		batchUsers:       batchUsers,
+		changeUserStatus: changeUserStatus,
+		logger:           logger,
+		cacheControl:     DefaultCacheControl,
+	}
+	for _, opt := range opts {
+		opt(h)
 	}
+	return h
 }
 
 // UsersCreateUser ユーザーを作成（OpenAPI ServerInterface実装）
@@ -50,7 +81,7 @@ func (h *UserHandler) UsersCreateUser(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if err := h.createUser.Execute(r.Context(), req.Name, string(req.Email)); err != nil {
+	if _, err := h.createUser.Execute(r.Context(), req.Name, string(req.Email)); err != nil {
 		HandleError(w, err, h.logger)
 		return
 	}
@@ -59,25 +90,24 @@ func (h *UserHandler) UsersCreateUser(w http.ResponseWriter, r *http.Request) {
 }
 
 // UsersGetUser ユーザーを取得（OpenAPI ServerInterface実装）
-func (h *UserHandler) UsersGetUser(w http.ResponseWriter, r *http.Request, userId string) {
+//
+// If-None-Match / If-Modified-Since が現在のユーザーと一致する場合は 304 を返す。
+func (h *UserHandler) UsersGetUser(w http.ResponseWriter, r *http.Request, userId string, params openapi.UsersGetUserParams) {
 	user, err := h.findUser.Execute(r.Context(), userId)
 	if err != nil {
 		HandleError(w, err, h.logger)
 		return
 	}
 
-	response := openapi.User{
-		Id:        user.ID,
-		Name:      user.Name,
-		Email:     openapi_types.Email(user.Email),
-		CreatedAt: user.CreatedAt,
-		UpdatedAt: user.UpdatedAt,
-	}
-
-	respondJSON(w, http.StatusOK, response)
+	h.respondCacheable(w, params.IfNoneMatch, params.IfModifiedSince, toUserResponse(user), conditionalResponse{
+		lastModified:     user.UpdatedAt,
+		useModifiedSince: true,
+	})
 }
 
 // UsersListUsers ユーザー一覧を取得（OpenAPI ServerInterface実装）
+//
+// If-None-Match がページの内容と総数の ETag と一致する場合は 304 を返す。
 func (h *UserHandler) UsersListUsers(w http.ResponseWriter, r *http.Request, params openapi.UsersListUsersParams) {
 	// デフォルト値の設定
 	limit := 10
@@ -93,21 +123,24 @@ func (h *UserHandler) UsersListUsers(w http.ResponseWriter, r *http.Request, par
 		offset = int(*params.Offset)
 	}
 
-	users, total, err := h.listUsers.Execute(r.Context(), limit, offset)
+	var status domain.UserStatus
+	if params.Status != nil {
+		status = domain.UserStatus(*params.Status)
+	}
+
+	users, total, err := h.listUsers.Execute(r.Context(), status, limit, offset)
 	if err != nil {
 		HandleError(w, err, h.logger)
 		return
 	}
 
 	userResponses := make([]openapi.User, 0, len(users))
+	var lastModified time.Time
 	for _, user := range users {
-		userResponses = append(userResponses, openapi.User{
-			Id:        user.ID,
-			Name:      user.Name,
-			Email:     openapi_types.Email(user.Email),
-			CreatedAt: user.CreatedAt,
-			UpdatedAt: user.UpdatedAt,
-		})
+		userResponses = append(userResponses, toUserResponse(user))
+		if user.UpdatedAt.After(lastModified) {
+			lastModified = user.UpdatedAt
+		}
 	}
 
 	response := openapi.UserList{
@@ -115,10 +148,13 @@ func (h *UserHandler) UsersListUsers(w http.ResponseWriter, r *http.Request, par
 		Total: int32(total),
 	}
 
-	respondJSON(w, http.StatusOK, response)
+	// ETag はページの内容と総数から求める。削除では Last-Modified が進まないため If-Modified-Since は使わない
+	h.respondCacheable(w, params.IfNoneMatch, nil, response, conditionalResponse{
+		lastModified: lastModified,
+	})
 }
 
-// UsersUpdateUser ユーザーを更新（OpenAPI ServerInterface実装）
+// UsersUpdateUser ユーザーを置き換える（OpenAPI ServerInterface実装）
 func (h *UserHandler) UsersUpdateUser(w http.ResponseWriter, r *http.Request, userId string) {
 	var req openapi.UpdateUserRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
@@ -126,23 +162,41 @@ func (h *UserHandler) UsersUpdateUser(w http.ResponseWriter, r *http.Request, us
 		return
 	}
 
-	// 更新値の取得（オプショナルなので既存値を保持）
-	name := ""
-	email := ""
+	// PUT は全体の置き換えのため、名前・メールアドレスとも必須
+	if err := h.updateUser.Execute(r.Context(), userId, req.Name, string(req.Email)); err != nil {
+		HandleError(w, err, h.logger)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
 
-	if req.Name != nil {
-		name = *req.Name
+// UsersPatchUser ユーザーを部分更新し、更新後のユーザーを返す（OpenAPI ServerInterface実装）
+//
+// JSON Merge Patch（application/merge-patch+json）と JSON Patch（application/json-patch+json）に対応する。
+func (h *UserHandler) UsersPatchUser(w http.ResponseWriter, r *http.Request, userId string) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "リクエストの形式が不正です")
+		return
 	}
-	if req.Email != nil {
-		email = string(*req.Email)
+	patch, err := newUserPatch(r.Header.Get("Content-Type"), body)
+	if errors.Is(err, errUnsupportedPatchType) {
+		respondError(w, http.StatusUnsupportedMediaType, "Content-Type は application/merge-patch+json または application/json-patch+json を指定してください")
+		return
+	}
+	if err != nil {
+		HandleError(w, err, h.logger)
+		return
 	}
 
-	if err := h.updateUser.Execute(r.Context(), userId, name, email); err != nil {
+	user, err := h.patchUser.Execute(r.Context(), userId, patch)
+	if err != nil {
 		HandleError(w, err, h.logger)
 		return
 	}
 
-	w.WriteHeader(http.StatusNoContent)
+	respondJSON(w, http.StatusOK, toUserResponse(user))
 }
 
 // UsersDeleteUser ユーザーを削除（OpenAPI ServerInterface実装）
@@ -155,6 +209,38 @@ func (h *UserHandler) UsersDeleteUser(w http.ResponseWriter, r *http.Request, us
 	w.WriteHeader(http.StatusNoContent)
 }
 
+// UsersSuspendUser ユーザーを一時停止し、更新後のユーザーを返す（OpenAPI ServerInterface実装）
+func (h *UserHandler) UsersSuspendUser(w http.ResponseWriter, r *http.Request, userId string) {
+	h.changeStatus(w, r, userId, domain.UserLogActionSuspended)
+}
+
+// UsersReactivateUser ユーザーを再開し、更新後のユーザーを返す（OpenAPI ServerInterface実装）
+func (h *UserHandler) UsersReactivateUser(w http.ResponseWriter, r *http.Request, userId string) {
+	h.changeStatus(w, r, userId, domain.UserLogActionReactivated)
+}
+
+// UsersDeactivateUser ユーザーを無効化し、更新後のユーザーを返す（OpenAPI ServerInterface実装）
+func (h *UserHandler) UsersDeactivateUser(w http.ResponseWriter, r *http.Request, userId string) {
+	h.changeStatus(w, r, userId, domain.UserLogActionDeactivated)
+}
+
+// changeStatus リクエストの理由でユーザーのステータスを変更する
+func (h *UserHandler) changeStatus(w http.ResponseWriter, r *http.Request, userId string, action domain.UserLogAction) {
+	var req openapi.ChangeUserStatusRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, http.StatusBadRequest, "リクエストの形式が不正です")
+		return
+	}
+
+	user, err := h.changeUserStatus.Execute(r.Context(), userId, action, req.Reason)
+	if err != nil {
+		HandleError(w, err, h.logger)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, toUserResponse(user))
+}
+
 // respondJSON JSONレスポンスを返す
 func respondJSON(w http.ResponseWriter, status int, data interface{}) {
 	w.Header().Set("Content-Type", "application/json")
@@ -0,0 +1,654 @@
+package handler_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/eventstream"
+	"github.com/example/go-react-cqrs-template/internal/handler"
+	"github.com/example/go-react-cqrs-template/internal/handler/validation"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure/memory"
+	"github.com/example/go-react-cqrs-template/internal/mail"
+	"github.com/example/go-react-cqrs-template/internal/tenant"
+	"github.com/example/go-react-cqrs-template/internal/usecase"
+	openapispec "github.com/example/go-react-cqrs-template/openapi"
+	"github.com/example/go-react-cqrs-template/pkg/generated/openapi"
+)
+
+// newTestRouter インメモリストアとレスポンスバリデーション（strict）付きのルーターを作成する
+func newTestRouter(t *testing.T, store *memory.Store) http.Handler {
+	t.Helper()
+	hub := eventstream.NewHub(store, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	return newTestRouterWithHub(t, store, hub, 0)
+}
+
+// newTestRouterWithMailer メールの送信方法を指定してルーターを作成する
+func newTestRouterWithMailer(t *testing.T, store *memory.Store, mailer mail.Mailer) http.Handler {
+	t.Helper()
+	log := slog.New(slog.NewTextHandler(io.Discard, nil))
+	return newTestRouterWith(t, store, eventstream.NewHub(store, log), 0, mailer, nil)
+}
+
+// newTestRouterWithHub 変更ストリームの配信元とハートビート間隔を指定してルーターを作成する
+func newTestRouterWithHub(t *testing.T, store *memory.Store, hub *eventstream.Hub, heartbeat time.Duration) http.Handler {
+	t.Helper()
+	mailer := mail.NewLogMailer(slog.New(slog.NewTextHandler(io.Discard, nil)))
+	return newTestRouterWith(t, store, hub, heartbeat, mailer, nil)
+}
+
+// newTestRouterWithOIDC シングルサインオンの IdP を指定してルーターを作成する（ユーザーは自動作成する）
+func newTestRouterWithOIDC(t *testing.T, store *memory.Store, providers map[string]usecase.OIDCProvider) http.Handler {
+	t.Helper()
+	log := slog.New(slog.NewTextHandler(io.Discard, nil))
+	return newTestRouterWith(t, store, eventstream.NewHub(store, log), 0, mail.NewLogMailer(log), providers)
+}
+
+// newTestRouterWith 変更ストリーム・メールの送信方法・シングルサインオンの IdP を指定してルーターを作成する
+func newTestRouterWith(t *testing.T, store *memory.Store, hub *eventstream.Hub, heartbeat time.Duration, mailer mail.Mailer, providers map[string]usecase.OIDCProvider) http.Handler {
+	t.Helper()
+	log := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	userHandler := handler.NewUserHandler(
+		usecase.NewCreateUserUsecase(store, store, store),
+		usecase.NewFindUserUsecase(store),
+		usecase.NewListUsersUsecase(store),
+		usecase.NewUpdateUserUsecase(store, store, store),
+		usecase.NewPatchUserUsecase(store, store, store),
+		usecase.NewDeleteUserUsecase(store, store, store),
+		usecase.NewBatchUsersUsecase(store, store),
+		usecase.NewChangeUserStatusUsecase(store, store, store),
+		log,
+	)
+	webhookHandler := handler.NewWebhookHandler(
+		usecase.NewCreateWebhookSubscriptionUsecase(store, store),
+		usecase.NewFindWebhookSubscriptionUsecase(store),
+		usecase.NewListWebhookSubscriptionsUsecase(store),
+		usecase.NewUpdateWebhookSubscriptionUsecase(store, store),
+		usecase.NewDeleteWebhookSubscriptionUsecase(store, store),
+		usecase.NewListWebhookDeliveriesUsecase(store),
+		log,
+	)
+	findOrganization := usecase.NewFindOrganizationUsecase(store)
+	organizationHandler := handler.NewOrganizationHandler(
+		usecase.NewCreateOrganizationUsecase(store, store),
+		findOrganization,
+		usecase.NewListOrganizationsUsecase(store),
+		log,
+	)
+	orgUserHandler := handler.NewOrgUserHandler(userHandler, findOrganization, log)
+	groupHandler := handler.NewGroupHandler(
+		usecase.NewCreateGroupUsecase(store, store),
+		usecase.NewFindGroupUsecase(store),
+		usecase.NewAddGroupMembersUsecase(store, store, store),
+		usecase.NewRemoveGroupMembersUsecase(store, store, store),
+		usecase.NewListGroupMembersUsecase(store),
+		usecase.NewListUserGroupsUsecase(store, store),
+		log,
+	)
+	authHandler := handler.NewAuthHandler(
+		usecase.NewLoginUsecase(store, store, store, store, store, store, testPasswordHasher, testSessions, store, domain.DefaultLockoutPolicy, domain.DefaultSessionPolicy, domain.DefaultMFAPolicy),
+		usecase.NewChangePasswordUsecase(store, store, testPasswordHasher, store, domain.DefaultLockoutPolicy),
+		usecase.NewRequestEmailVerificationUsecase(store, store, mailer, store, usecase.DefaultUserTokenConfig),
+		usecase.NewVerifyEmailUsecase(store, store, store),
+		usecase.NewRequestPasswordResetUsecase(store, store, mailer, store, usecase.DefaultUserTokenConfig),
+		usecase.NewResetPasswordUsecase(store, store, store, store, testPasswordHasher, store),
+		log,
+	)
+	invitationHandler := handler.NewInvitationHandler(
+		usecase.NewCreateInvitationUsecase(store, store, mailer, store, usecase.DefaultUserTokenConfig),
+		usecase.NewListInvitationsUsecase(store),
+		usecase.NewResendInvitationUsecase(store, store, mailer, store, usecase.DefaultUserTokenConfig),
+		usecase.NewRevokeInvitationUsecase(store, store, store),
+		usecase.NewAcceptInvitationUsecase(store, store, store, testPasswordHasher, store),
+		log,
+	)
+
+	sessionHandler := handler.NewSessionHandler(
+		usecase.NewListSessionsUsecase(store, store, domain.DefaultSessionPolicy),
+		usecase.NewRevokeSessionUsecase(store, store, store),
+		usecase.NewRevokeAllSessionsUsecase(store, store, store),
+		log,
+	)
+	mfaHandler := handler.NewMFAHandler(
+		usecase.NewEnrollTOTPUsecase(store, store, store, testPasswordHasher, testTOTP, store, domain.DefaultLockoutPolicy),
+		usecase.NewConfirmTOTPUsecase(store, store, store, testTOTP, store, domain.DefaultLockoutPolicy),
+		usecase.NewDisableTOTPUsecase(store, store, store, store, testTOTP, store, domain.DefaultLockoutPolicy, domain.DefaultMFAPolicy),
+		usecase.NewVerifyMFALoginUsecase(store, store, store, store, store, testTOTP, testSessions, store, domain.DefaultLockoutPolicy, domain.DefaultSessionPolicy),
+		log,
+	)
+	oidcConfig := usecase.OIDCConfig{StateTTL: usecase.DefaultOIDCConfig.StateTTL, AutoProvision: true}
+	oidcHandler := handler.NewOIDCHandler(
+		usecase.NewStartOIDCLoginUsecase(store, providers, store, oidcConfig),
+		usecase.NewCompleteOIDCLoginUsecase(store, store, store, store, store, store, store, usecase.NewCreateUserUsecase(store, store, store), providers, testSessions, store, oidcConfig, domain.DefaultSessionPolicy, domain.DefaultMFAPolicy),
+		log,
+	)
+
+	validationMiddleware, err := validation.NewMiddleware(
+		openapispec.Spec,
+		validation.WithResponseValidation(validation.ResponseModeStrict),
+	)
+	if err != nil {
+		t.Fatalf("failed to create validation middleware: %v", err)
+	}
+
+	r := chi.NewRouter()
+	r.Use(handler.AuthMiddleware(testSessions, usecase.NewAuthenticateSessionUsecase(store, store, store, domain.DefaultSessionPolicy), log))
+	r.Use(handler.TenantMiddleware(findOrganization, log, "/auth/", "/invitations:accept"))
+	r.Use(validationMiddleware.Handler)
+	userStreamHandler := handler.NewUserStreamHandler(hub, heartbeat, log)
+	openapi.HandlerFromMux(handler.NewServer(userHandler, webhookHandler, userStreamHandler, organizationHandler, orgUserHandler, groupHandler, authHandler, invitationHandler, sessionHandler, mfaHandler, oidcHandler), r)
+	return r
+}
+
+// TestUserHandler_ResponsesMatchSpec 各エンドポイントのレスポンスが openapi.yaml に準拠していることを検証する
+func TestUserHandler_ResponsesMatchSpec(t *testing.T) {
+	existing, err := domain.NewUser("John Doe", "john@example.com")
+	if err != nil {
+		t.Fatalf("Failed to create user: %v", err)
+	}
+
+	tests := []struct {
+		name        string
+		method      string
+		path        string
+		contentType string
+		body        string
+		login       bool
+		wantStatus  int
+	}{
+		{name: "list users", method: http.MethodGet, path: "/users", wantStatus: http.StatusOK},
+		{name: "get user", method: http.MethodGet, path: "/users/" + existing.ID, wantStatus: http.StatusOK},
+		{name: "get missing user", method: http.MethodGet, path: "/users/01ARZ3NDEKTSV4RRFFQ69G5FAV", wantStatus: http.StatusNotFound},
+		{name: "create user", method: http.MethodPost, path: "/users", body: `{"name":"Jane","email":"jane@example.com"}`, wantStatus: http.StatusCreated},
+		{name: "create duplicate", method: http.MethodPost, path: "/users", body: `{"name":"Jane","email":"john@example.com"}`, wantStatus: http.StatusConflict},
+		{name: "replace user", method: http.MethodPut, path: "/users/" + existing.ID, body: `{"name":"John Smith","email":"smith@example.com"}`, wantStatus: http.StatusNoContent},
+		{name: "replace user without email", method: http.MethodPut, path: "/users/" + existing.ID, body: `{"name":"John Smith"}`, wantStatus: http.StatusBadRequest},
+		{name: "merge patch user", method: http.MethodPatch, path: "/users/" + existing.ID, contentType: "application/merge-patch+json", body: `{"name":"John Smith"}`, wantStatus: http.StatusOK},
+		{name: "json patch user", method: http.MethodPatch, path: "/users/" + existing.ID, contentType: "application/json-patch+json", body: `[{"op":"replace","path":"/email","value":"smith@example.com"}]`, wantStatus: http.StatusOK},
+		{name: "json patch test failed", method: http.MethodPatch, path: "/users/" + existing.ID, contentType: "application/json-patch+json", body: `[{"op":"test","path":"/name","value":"Someone"}]`, wantStatus: http.StatusConflict},
+		{name: "patch missing user", method: http.MethodPatch, path: "/users/01ARZ3NDEKTSV4RRFFQ69G5FAV", contentType: "application/merge-patch+json", body: `{"name":"X"}`, wantStatus: http.StatusNotFound},
+		{name: "delete user", method: http.MethodDelete, path: "/users/" + existing.ID, wantStatus: http.StatusNoContent},
+		{name: "list users by status", method: http.MethodGet, path: "/users?status=suspended", wantStatus: http.StatusOK},
+		{name: "list users by unknown status", method: http.MethodGet, path: "/users?status=archived", wantStatus: http.StatusBadRequest},
+		{name: "suspend user", method: http.MethodPost, path: "/users/" + existing.ID + ":suspend", body: `{"reason":"policy violation"}`, wantStatus: http.StatusOK},
+		{name: "suspend without reason", method: http.MethodPost, path: "/users/" + existing.ID + ":suspend", body: `{"reason":""}`, wantStatus: http.StatusBadRequest},
+		{name: "suspend missing user", method: http.MethodPost, path: "/users/01ARZ3NDEKTSV4RRFFQ69G5FAV:suspend", body: `{"reason":"x"}`, wantStatus: http.StatusNotFound},
+		{name: "reactivate active user", method: http.MethodPost, path: "/users/" + existing.ID + ":reactivate", body: `{"reason":"x"}`, wantStatus: http.StatusBadRequest},
+		{name: "deactivate user", method: http.MethodPost, path: "/users/" + existing.ID + ":deactivate", body: `{"reason":"left the company"}`, wantStatus: http.StatusOK},
+		{name: "set password", method: http.MethodPost, path: "/users/" + existing.ID + "/password", body: `{"newPassword":"correct horse battery"}`, login: true, wantStatus: http.StatusNoContent},
+		{name: "set short password", method: http.MethodPost, path: "/users/" + existing.ID + "/password", body: `{"newPassword":"short"}`, login: true, wantStatus: http.StatusBadRequest},
+		{name: "set password same as email", method: http.MethodPost, path: "/users/" + existing.ID + "/password", body: `{"newPassword":"JOHN@example.com"}`, login: true, wantStatus: http.StatusBadRequest},
+		{name: "set password without login", method: http.MethodPost, path: "/users/" + existing.ID + "/password", body: `{"newPassword":"correct horse battery"}`, wantStatus: http.StatusUnauthorized},
+		{name: "set password of other user", method: http.MethodPost, path: "/users/01ARZ3NDEKTSV4RRFFQ69G5FAV/password", body: `{"newPassword":"correct horse battery"}`, login: true, wantStatus: http.StatusForbidden},
+		{name: "login without password", method: http.MethodPost, path: "/auth/login", body: `{"email":"john@example.com","password":"correct horse battery"}`, wantStatus: http.StatusUnauthorized},
+		{name: "login unknown user", method: http.MethodPost, path: "/auth/login", body: `{"email":"nobody@example.com","password":"correct horse battery"}`, wantStatus: http.StatusUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := memory.NewStore()
+			store.Seed(existing)
+			router := newTestRouter(t, store)
+
+			req := httptest.NewRequest(tt.method, tt.path, bytes.NewBufferString(tt.body))
+			if tt.body != "" {
+				contentType := tt.contentType
+				if contentType == "" {
+					contentType = "application/json"
+				}
+				req.Header.Set("Content-Type", contentType)
+			}
+			if tt.login {
+				req.Header.Set("Authorization", "Bearer "+mustIssueToken(t, store, existing))
+			}
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantStatus {
+				t.Errorf("status = %d, want %d (body: %s)", rec.Code, tt.wantStatus, rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestUserHandler_PatchUser(t *testing.T) {
+	existing, err := domain.NewUser("John Doe", "john@example.com")
+	if err != nil {
+		t.Fatalf("Failed to create user: %v", err)
+	}
+	other, err := domain.NewUser("Jane Doe", "jane@example.com")
+	if err != nil {
+		t.Fatalf("Failed to create user: %v", err)
+	}
+
+	tests := []struct {
+		name        string
+		contentType string
+		body        string
+		wantStatus  int
+		wantName    string
+		wantEmail   string
+	}{
+		{
+			name:        "merge patch keeps omitted fields",
+			contentType: "application/merge-patch+json",
+			body:        `{"email":"smith@example.com"}`,
+			wantStatus:  http.StatusOK,
+			wantName:    "John Doe",
+			wantEmail:   "smith@example.com",
+		},
+		{
+			name:        "merge patch null clears a required field",
+			contentType: "application/merge-patch+json",
+			body:        `{"name":null}`,
+			wantStatus:  http.StatusBadRequest,
+		},
+		{
+			name:        "merge patch of a read-only field",
+			contentType: "application/merge-patch+json",
+			body:        `{"id":"01ARZ3NDEKTSV4RRFFQ69G5FAV"}`,
+			wantStatus:  http.StatusBadRequest,
+		},
+		{
+			name:        "merge patch with an invalid email",
+			contentType: "application/merge-patch+json",
+			body:        `{"email":"not-an-email"}`,
+			wantStatus:  http.StatusBadRequest,
+		},
+		{
+			name:        "merge patch to a taken email",
+			contentType: "application/merge-patch+json",
+			body:        `{"email":"jane@example.com"}`,
+			wantStatus:  http.StatusConflict,
+		},
+		{
+			name:        "json patch applies operations in order",
+			contentType: "application/json-patch+json",
+			body:        `[{"op":"test","path":"/name","value":"John Doe"},{"op":"copy","from":"/name","path":"/email"},{"op":"replace","path":"/email","value":"doe@example.com"}]`,
+			wantStatus:  http.StatusOK,
+			wantName:    "John Doe",
+			wantEmail:   "doe@example.com",
+		},
+		{
+			name:        "json patch remove of a required field",
+			contentType: "application/json-patch+json",
+			body:        `[{"op":"remove","path":"/email"}]`,
+			wantStatus:  http.StatusBadRequest,
+		},
+		{
+			name:        "json patch of a missing path",
+			contentType: "application/json-patch+json",
+			body:        `[{"op":"replace","path":"/profile/bio","value":"hi"}]`,
+			wantStatus:  http.StatusBadRequest,
+		},
+		{
+			name:        "json patch with a merge patch document",
+			contentType: "application/json-patch+json",
+			body:        `{"name":"John Smith"}`,
+			wantStatus:  http.StatusBadRequest,
+		},
+		{
+			name:        "plain json is not a patch",
+			contentType: "application/json",
+			body:        `{"name":"John Smith"}`,
+			wantStatus:  http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := memory.NewStore()
+			store.Seed(existing, other)
+			router := newTestRouter(t, store)
+
+			req := httptest.NewRequest(http.MethodPatch, "/users/"+existing.ID, bytes.NewBufferString(tt.body))
+			req.Header.Set("Content-Type", tt.contentType)
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d (body: %s)", rec.Code, tt.wantStatus, rec.Body.String())
+			}
+			if tt.wantStatus != http.StatusOK {
+				// 失敗した部分更新は何も変更しない
+				assertStoredUser(t, store, existing.ID, existing.Name, existing.Email)
+				return
+			}
+
+			var got openapi.User
+			if err := json.NewDecoder(rec.Body).Decode(&got); err != nil {
+				t.Fatalf("failed to decode response: %v", err)
+			}
+			if got.Name != tt.wantName || string(got.Email) != tt.wantEmail {
+				t.Errorf("response = %s <%s>, want %s <%s>", got.Name, got.Email, tt.wantName, tt.wantEmail)
+			}
+			assertStoredUser(t, store, existing.ID, tt.wantName, tt.wantEmail)
+		})
+	}
+}
+
+// assertStoredUser ストアのユーザーの名前とメールアドレスを検証する
+func assertStoredUser(t *testing.T, store *memory.Store, id, wantName, wantEmail string) {
+	t.Helper()
+	got, err := store.FindByID(context.Background(), id)
+	if err != nil || got == nil {
+		t.Fatalf("FindByID() = %v, %v", got, err)
+	}
+	if got.Name != wantName || got.Email != wantEmail {
+		t.Errorf("stored user = %s <%s>, want %s <%s>", got.Name, got.Email, wantName, wantEmail)
+	}
+}
+
+func TestUserHandler_GetUser(t *testing.T) {
+	existing, err := domain.NewUser("John Doe", "john@example.com")
+	if err != nil {
+		t.Fatalf("Failed to create user: %v", err)
+	}
+	store := memory.NewStore()
+	store.Seed(existing)
+	router := newTestRouter(t, store)
+
+	req := httptest.NewRequest(http.MethodGet, "/users/"+existing.ID, nil)
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+
+	var got openapi.User
+	if err := json.NewDecoder(rec.Body).Decode(&got); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if got.Id != existing.ID || got.Name != existing.Name || string(got.Email) != existing.Email {
+		t.Errorf("response = %+v, want %+v", got, existing)
+	}
+}
+
+// getWithHeaders 条件付きリクエストのヘッダーを付けて GET する
+func getWithHeaders(router http.Handler, path string, header map[string]string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodGet, path, nil)
+	for k, v := range header {
+		req.Header.Set(k, v)
+	}
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestUserHandler_GetUser_Conditional(t *testing.T) {
+	existing, err := domain.NewUser("John Doe", "john@example.com")
+	if err != nil {
+		t.Fatalf("Failed to create user: %v", err)
+	}
+	store := memory.NewStore()
+	store.Seed(existing)
+	router := newTestRouter(t, store)
+	path := "/users/" + existing.ID
+
+	first := getWithHeaders(router, path, nil)
+	etag := first.Header().Get("ETag")
+	if first.Code != http.StatusOK || etag == "" || strings.HasPrefix(etag, "W/") {
+		t.Fatalf("status = %d, ETag = %q, want 200 with a strong ETag", first.Code, etag)
+	}
+	if got := first.Header().Get("Cache-Control"); got != handler.DefaultCacheControl {
+		t.Errorf("Cache-Control = %q, want %q", got, handler.DefaultCacheControl)
+	}
+	if got := first.Header().Get("Vary"); got != "Authorization, "+tenant.Header {
+		t.Errorf("Vary = %q, want Authorization, %s", got, tenant.Header)
+	}
+	lastModified := first.Header().Get("Last-Modified")
+	if lastModified != existing.UpdatedAt.UTC().Format(http.TimeFormat) {
+		t.Errorf("Last-Modified = %q, want updatedAt", lastModified)
+	}
+
+	tests := []struct {
+		name       string
+		header     map[string]string
+		wantStatus int
+	}{
+		{name: "matching etag", header: map[string]string{"If-None-Match": etag}, wantStatus: http.StatusNotModified},
+		{name: "one of etags matches", header: map[string]string{"If-None-Match": `"other", W/` + etag}, wantStatus: http.StatusNotModified},
+		{name: "wildcard", header: map[string]string{"If-None-Match": "*"}, wantStatus: http.StatusNotModified},
+		{name: "stale etag", header: map[string]string{"If-None-Match": `"stale"`}, wantStatus: http.StatusOK},
+		{name: "not modified since", header: map[string]string{"If-Modified-Since": lastModified}, wantStatus: http.StatusNotModified},
+		{name: "modified since", header: map[string]string{"If-Modified-Since": existing.UpdatedAt.Add(-time.Hour).UTC().Format(http.TimeFormat)}, wantStatus: http.StatusOK},
+		// If-None-Match がある場合は If-Modified-Since を無視する
+		{name: "etag takes precedence", header: map[string]string{"If-None-Match": `"stale"`, "If-Modified-Since": lastModified}, wantStatus: http.StatusOK},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := getWithHeaders(router, path, tt.header)
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d", rec.Code, tt.wantStatus)
+			}
+			if got := rec.Header().Get("ETag"); got != etag {
+				t.Errorf("ETag = %q, want %q", got, etag)
+			}
+			if tt.wantStatus == http.StatusNotModified && rec.Body.Len() != 0 {
+				t.Errorf("304 body = %q, want empty", rec.Body.String())
+			}
+		})
+	}
+
+	// 更新すると ETag が変わる
+	update := httptest.NewRequest(http.MethodPut, path, bytes.NewBufferString(`{"name":"John Smith","email":"john@example.com"}`))
+	update.Header.Set("Content-Type", "application/json")
+	router.ServeHTTP(httptest.NewRecorder(), update)
+	rec := getWithHeaders(router, path, map[string]string{"If-None-Match": etag})
+	if rec.Code != http.StatusOK || rec.Header().Get("ETag") == etag {
+		t.Errorf("after update: status = %d, ETag = %q, want 200 with a new ETag", rec.Code, rec.Header().Get("ETag"))
+	}
+}
+
+func TestUserHandler_ListUsers_Conditional(t *testing.T) {
+	existing, err := domain.NewUser("John Doe", "john@example.com")
+	if err != nil {
+		t.Fatalf("Failed to create user: %v", err)
+	}
+	store := memory.NewStore()
+	store.Seed(existing)
+	router := newTestRouter(t, store)
+
+	first := getWithHeaders(router, "/users", nil)
+	etag := first.Header().Get("ETag")
+	if first.Code != http.StatusOK || etag == "" {
+		t.Fatalf("status = %d, ETag = %q, want 200 with ETag", first.Code, etag)
+	}
+	if got := first.Header().Get("Last-Modified"); got != existing.UpdatedAt.UTC().Format(http.TimeFormat) {
+		t.Errorf("Last-Modified = %q, want newest updatedAt", got)
+	}
+
+	if rec := getWithHeaders(router, "/users", map[string]string{"If-None-Match": etag}); rec.Code != http.StatusNotModified {
+		t.Errorf("matching etag: status = %d, want 304", rec.Code)
+	}
+	// 一覧は If-Modified-Since では 304 にしない
+	if rec := getWithHeaders(router, "/users", map[string]string{"If-Modified-Since": first.Header().Get("Last-Modified")}); rec.Code != http.StatusOK {
+		t.Errorf("If-Modified-Since: status = %d, want 200", rec.Code)
+	}
+	// ユーザーが増えるとページの内容と総数が変わり、ETag も変わる
+	other, err := domain.NewUser("Jane Doe", "jane@example.com")
+	if err != nil {
+		t.Fatalf("Failed to create user: %v", err)
+	}
+	store.Seed(other)
+	if rec := getWithHeaders(router, "/users", map[string]string{"If-None-Match": etag}); rec.Code != http.StatusOK {
+		t.Errorf("after create: status = %d, want 200", rec.Code)
+	}
+}
+
+func TestUserHandler_ChangeStatus(t *testing.T) {
+	existing, err := domain.NewUser("John Doe", "john@example.com")
+	if err != nil {
+		t.Fatalf("Failed to create user: %v", err)
+	}
+	other, err := domain.NewUser("Jane Doe", "jane@example.com")
+	if err != nil {
+		t.Fatalf("Failed to create user: %v", err)
+	}
+	store := memory.NewStore()
+	store.Seed(existing, other)
+	router := newTestRouter(t, store)
+
+	changeStatus := func(op, reason string) openapi.User {
+		t.Helper()
+		req := httptest.NewRequest(http.MethodPost, "/users/"+existing.ID+":"+op, bytes.NewBufferString(`{"reason":"`+reason+`"}`))
+		req.Header.Set("Content-Type", "application/json")
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, req)
+		if rec.Code != http.StatusOK {
+			t.Fatalf("%s status = %d, want %d (body: %s)", op, rec.Code, http.StatusOK, rec.Body.String())
+		}
+		var user openapi.User
+		if err := json.NewDecoder(rec.Body).Decode(&user); err != nil {
+			t.Fatalf("failed to decode response: %v", err)
+		}
+		return user
+	}
+
+	if got := changeStatus("suspend", "policy violation"); got.Status != openapi.Suspended {
+		t.Errorf("suspend: status = %s, want %s", got.Status, openapi.Suspended)
+	}
+
+	// 一覧はステータスで絞り込める
+	rec := getWithHeaders(router, "/users?status=suspended", nil)
+	var list openapi.UserList
+	if err := json.NewDecoder(rec.Body).Decode(&list); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if list.Total != 1 || len(list.Users) != 1 || list.Users[0].Id != existing.ID {
+		t.Errorf("list suspended = %+v, want only %s", list, existing.ID)
+	}
+
+	if got := changeStatus("reactivate", "resolved"); got.Status != openapi.Active {
+		t.Errorf("reactivate: status = %s, want %s", got.Status, openapi.Active)
+	}
+
+	// 理由はステータス変更のログに記録される
+	logs := store.UserLogs(existing.ID)
+	if len(logs) != 2 || logs[0].Reason != "policy violation" || logs[1].Reason != "resolved" {
+		t.Errorf("UserLogs = %+v, want suspended and reactivated with reasons", logs)
+	}
+}
+
+func TestUserHandler_CacheControl(t *testing.T) {
+	existing, err := domain.NewUser("John Doe", "john@example.com")
+	if err != nil {
+		t.Fatalf("Failed to create user: %v", err)
+	}
+	store := memory.NewStore()
+	store.Seed(existing)
+	userHandler := handler.NewUserHandler(
+		usecase.NewCreateUserUsecase(store, store, store),
+		usecase.NewFindUserUsecase(store),
+		usecase.NewListUsersUsecase(store),
+		usecase.NewUpdateUserUsecase(store, store, store),
+		usecase.NewPatchUserUsecase(store, store, store),
+		usecase.NewDeleteUserUsecase(store, store, store),
+		usecase.NewBatchUsersUsecase(store, store),
+		usecase.NewChangeUserStatusUsecase(store, store, store),
+		slog.New(slog.NewTextHandler(io.Discard, nil)),
+		handler.WithCacheControl("public, max-age=60"),
+	)
+
+	rec := httptest.NewRecorder()
+	userHandler.UsersGetUser(rec, httptest.NewRequest(http.MethodGet, "/users/"+existing.ID, nil), existing.ID, openapi.UsersGetUserParams{})
+	if got := rec.Header().Get("Cache-Control"); got != "public, max-age=60" {
+		t.Errorf("Cache-Control = %q, want %q", got, "public, max-age=60")
+	}
+}
+
+func TestUserHandler_BatchUsers(t *testing.T) {
+	existing, err := domain.NewUser("John Doe", "john@example.com")
+	if err != nil {
+		t.Fatalf("Failed to create user: %v", err)
+	}
+	operations := `[
+		{"method":"create","name":"Alice","email":"alice@example.com"},
+		{"method":"update","id":"` + existing.ID + `","name":"John Smith"},
+		{"method":"delete","id":"01ARZ3NDEKTSV4RRFFQ69G5FAV"}
+	]`
+
+	tests := []struct {
+		name       string
+		body       string
+		wantStatus int
+		wantItems  []int
+		wantCodes  []string
+		wantName   string
+	}{
+		{
+			name:       "per-item mode",
+			body:       `{"operations":` + operations + `}`,
+			wantStatus: http.StatusOK,
+			wantItems:  []int{http.StatusCreated, http.StatusOK, http.StatusNotFound},
+			wantCodes:  []string{"", "", "NOT_FOUND"},
+			wantName:   "John Smith",
+		},
+		{
+			name:       "atomic mode rolls back",
+			body:       `{"atomic":true,"operations":` + operations + `}`,
+			wantStatus: http.StatusOK,
+			wantItems:  []int{http.StatusFailedDependency, http.StatusFailedDependency, http.StatusNotFound},
+			wantCodes:  []string{"ABORTED", "ABORTED", "NOT_FOUND"},
+			wantName:   "John Doe",
+		},
+		{
+			name:       "unknown method",
+			body:       `{"operations":[{"method":"archive","id":"` + existing.ID + `"}]}`,
+			wantStatus: http.StatusBadRequest,
+			wantName:   "John Doe",
+		},
+		{
+			name:       "no operations",
+			body:       `{"operations":[]}`,
+			wantStatus: http.StatusBadRequest,
+			wantName:   "John Doe",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := memory.NewStore()
+			store.Seed(existing)
+			router := newTestRouter(t, store)
+
+			req := httptest.NewRequest(http.MethodPost, "/users:batch", bytes.NewBufferString(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d (body: %s)", rec.Code, tt.wantStatus, rec.Body.String())
+			}
+			assertStoredUser(t, store, existing.ID, tt.wantName, existing.Email)
+			if tt.wantStatus != http.StatusOK {
+				return
+			}
+
+			var got openapi.BatchUsersResponse
+			if err := json.NewDecoder(rec.Body).Decode(&got); err != nil {
+				t.Fatalf("failed to decode response: %v", err)
+			}
+			if len(got.Results) != len(tt.wantItems) {
+				t.Fatalf("len(results) = %d, want %d", len(got.Results), len(tt.wantItems))
+			}
+			for i, result := range got.Results {
+				code := ""
+				if result.Code != nil {
+					code = *result.Code
+				}
+				if int(result.Status) != tt.wantItems[i] || code != tt.wantCodes[i] {
+					t.Errorf("results[%d] = %d %s, want %d %s", i, result.Status, code, tt.wantItems[i], tt.wantCodes[i])
+				}
+			}
+		})
+	}
+}
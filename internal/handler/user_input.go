@@ -0,0 +1,46 @@
+package handler
+
+import (
+	"net/mail"
+	"regexp"
+	"unicode/utf8"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+)
+
+// maxUserNameLength 名前の最大文字数（openapi.yaml の CreateUserRequest と同じ）
+const maxUserNameLength = 100
+
+// userIDPattern ユーザーID（ULID）の形式（openapi.yaml の userId パラメータと同じ）
+var userIDPattern = regexp.MustCompile(`^[0-9A-HJKMNP-TV-Z]{26}$`)
+
+// ValidateUserInput 名前とメールアドレスを openapi.yaml の CreateUserRequest / UpdateUserRequest と同じ規則で検証する
+//
+// OpenAPI のバリデーションミドルウェアを通らない GraphQL や gRPC のリクエストに使用する（nil は未指定）。
+func ValidateUserInput(name, email *string) error {
+	if name != nil {
+		if *name == "" {
+			return domain.ErrNameRequired()
+		}
+		if utf8.RuneCountInString(*name) > maxUserNameLength {
+			return domain.NewValidationError("name", "name is too long", "名前は100文字以内で入力してください")
+		}
+	}
+	if email != nil {
+		if *email == "" {
+			return domain.ErrEmailRequired()
+		}
+		if addr, err := mail.ParseAddress(*email); err != nil || addr.Address != *email {
+			return domain.NewValidationError("email", "invalid email: "+*email, "メールアドレスの形式が正しくありません")
+		}
+	}
+	return nil
+}
+
+// ValidateUserID ユーザーIDが ULID の形式であることを検証する
+func ValidateUserID(id string) error {
+	if !userIDPattern.MatchString(id) {
+		return domain.NewValidationError("id", "invalid user id: "+id, "ユーザーIDの形式が正しくありません")
+	}
+	return nil
+}
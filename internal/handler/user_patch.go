@@ -0,0 +1,95 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"mime"
+
+	jsonpatch "github.com/evanphx/json-patch/v5"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/usecase"
+)
+
+const (
+	// contentTypeMergePatch JSON Merge Patch（RFC 7396）のメディアタイプ
+	contentTypeMergePatch = "application/merge-patch+json"
+	// contentTypeJSONPatch JSON Patch（RFC 6902）のメディアタイプ
+	contentTypeJSONPatch = "application/json-patch+json"
+)
+
+// errUnsupportedPatchType PATCH の Content-Type が対応していない形式
+var errUnsupportedPatchType = errors.New("unsupported patch content type")
+
+// userDocument パッチを適用するユーザーの JSON 表現（変更できる項目のみ）
+type userDocument struct {
+	Name  *string `json:"name"`
+	Email *string `json:"email"`
+}
+
+// newUserPatch Content-Type に応じてリクエストボディを usecase.UserPatch に変換する
+//
+// パッチはユーザーの現在の値（{"name", "email"}）に適用し、適用後の値を ValidateUserInput と同じ規則で
+// 検証する。JSON Merge Patch の null は項目の削除のため、必須項目では検証エラーになる。
+func newUserPatch(contentType string, body []byte) (usecase.UserPatch, error) {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return nil, errUnsupportedPatchType
+	}
+
+	var apply func(doc []byte) ([]byte, error)
+	switch mediaType {
+	case contentTypeMergePatch:
+		// オブジェクト以外のパッチは全体の置き換えになり、適用後の検証でエラーになる
+		if !json.Valid(body) {
+			return nil, errInvalidPatch("malformed JSON")
+		}
+		apply = func(doc []byte) ([]byte, error) { return jsonpatch.MergePatch(doc, body) }
+	case contentTypeJSONPatch:
+		patch, err := jsonpatch.DecodePatch(body)
+		if err != nil {
+			return nil, errInvalidPatch(err.Error())
+		}
+		apply = patch.Apply
+	default:
+		return nil, errUnsupportedPatchType
+	}
+
+	return func(name, email string) (string, string, error) {
+		doc, err := json.Marshal(userDocument{Name: &name, Email: &email})
+		if err != nil {
+			return "", "", err
+		}
+		patched, err := apply(doc)
+		if err != nil {
+			if errors.Is(err, jsonpatch.ErrTestFailed) {
+				return "", "", domain.NewConflictError("user", err.Error(), "ユーザーの現在の値がパッチの test と一致しません")
+			}
+			return "", "", errInvalidPatch(err.Error())
+		}
+
+		var result userDocument
+		dec := json.NewDecoder(bytes.NewReader(patched))
+		dec.DisallowUnknownFields()
+		if err := dec.Decode(&result); err != nil {
+			return "", "", domain.NewValidationError("patch", "invalid patched user: "+err.Error(), "変更できない項目、または不正な値が含まれています")
+		}
+		newName, newEmail := "", ""
+		if result.Name != nil {
+			newName = *result.Name
+		}
+		if result.Email != nil {
+			newEmail = *result.Email
+		}
+		if err := ValidateUserInput(&newName, &newEmail); err != nil {
+			return "", "", err
+		}
+		return newName, newEmail, nil
+	}, nil
+}
+
+// errInvalidPatch パッチ自体が不正な場合のエラー
+func errInvalidPatch(message string) *domain.ValidationError {
+	return domain.NewValidationError("patch", "invalid patch: "+message, "パッチの形式が不正です")
+}
@@ -0,0 +1,150 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/eventstream"
+	"github.com/example/go-react-cqrs-template/internal/outbox"
+	"github.com/example/go-react-cqrs-template/pkg/generated/openapi"
+)
+
+const (
+	// sseRetry 切断時にブラウザの EventSource が再接続するまでの待ち時間
+	sseRetry = 3 * time.Second
+	// defaultHeartbeat ハートビート間隔のデフォルト
+	defaultHeartbeat = 15 * time.Second
+)
+
+// UserStreamHandler ユーザーの変更を Server-Sent Events で配信するハンドラー
+type UserStreamHandler struct {
+	hub       *eventstream.Hub
+	heartbeat time.Duration
+	logger    *slog.Logger
+}
+
+// NewUserStreamHandler UserStreamHandlerのコンストラクタ
+//
+// heartbeat の間隔で変更がない間もコメント行を送り、プロキシによる切断を防ぐ（0 以下の場合は 15 秒）。
+func NewUserStreamHandler(hub *eventstream.Hub, heartbeat time.Duration, logger *slog.Logger) *UserStreamHandler {
+	if heartbeat <= 0 {
+		heartbeat = defaultHeartbeat
+	}
+	return &UserStreamHandler{
+		hub:       hub,
+		heartbeat: heartbeat,
+		logger:    logger,
+	}
+}
+
+// userChangeEvent SSE の data に載せる UserChangeEvent（openapi.yaml の UserChangeEvent スキーマ）
+type userChangeEvent struct {
+	ID         string          `json:"id"`
+	Type       string          `json:"type"`
+	OccurredAt time.Time       `json:"occurredAt"`
+	User       json.RawMessage `json:"user"`
+}
+
+// UsersWatchUsers ユーザーの変更を配信（OpenAPI ServerInterface実装）
+//
+// Last-Event-ID（outbox の seq）を指定すると、それ以降の変更を再送してから新しい変更の配信を続ける。
+func (h *UserStreamHandler) UsersWatchUsers(w http.ResponseWriter, r *http.Request, params openapi.UsersWatchUsersParams) {
+	ctx := r.Context()
+
+	var lastSeq *int64
+	if params.LastEventID != nil {
+		seq, err := strconv.ParseInt(*params.LastEventID, 10, 64)
+		if err != nil {
+			respondError(w, http.StatusBadRequest, "Last-Event-ID が不正です")
+			return
+		}
+		lastSeq = &seq
+	}
+
+	sub, err := h.hub.Subscribe(ctx, lastSeq)
+	if err != nil {
+		if ctx.Err() != nil {
+			return
+		}
+		h.logger.Error("failed to subscribe user changes", slog.String("error", err.Error()))
+		respondError(w, http.StatusServiceUnavailable, "変更の配信を開始できません")
+		return
+	}
+	defer sub.Close()
+
+	rc := http.NewResponseController(w)
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	// nginx などのリバースプロキシでのバッファリングを無効にする
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+
+	fmt.Fprintf(w, "retry: %d\n\n", sseRetry.Milliseconds())
+	if sub.Truncated() {
+		fmt.Fprint(w, "event: reset\ndata: {}\n\n")
+	}
+	err = sub.Replay(ctx, func(event outbox.Event) error {
+		return writeUserChangeEvent(w, event)
+	})
+	if err == nil {
+		err = rc.Flush()
+	}
+	if err != nil {
+		if ctx.Err() == nil {
+			h.logger.Warn("failed to replay user changes", slog.String("error", err.Error()))
+		}
+		return
+	}
+
+	ticker := time.NewTicker(h.heartbeat)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case event, ok := <-sub.Events():
+			if !ok {
+				// クライアントは Last-Event-ID 付きで再接続して続きを受け取る
+				if errors.Is(sub.Err(), eventstream.ErrSlowSubscriber) {
+					h.logger.Warn("user change stream closed for slow client")
+				}
+				return
+			}
+			err = writeUserChangeEvent(w, event)
+		case <-ticker.C:
+			_, err = fmt.Fprint(w, ": heartbeat\n\n")
+		}
+		if err == nil {
+			err = rc.Flush()
+		}
+		if err != nil {
+			return
+		}
+	}
+}
+
+// writeUserChangeEvent ユーザーのイベントを SSE の形式で書き込む（他の集約のイベントは読み飛ばす）
+func writeUserChangeEvent(w io.Writer, event outbox.Event) error {
+	if event.AggregateType != domain.UserAggregateType {
+		return nil
+	}
+
+	data, err := json.Marshal(userChangeEvent{
+		ID:         event.ID,
+		Type:       event.Type,
+		OccurredAt: event.OccurredAt,
+		User:       event.Payload,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to marshal user change event: %w", err)
+	}
+	_, err = fmt.Fprintf(w, "id: %d\nevent: %s\ndata: %s\n\n", event.Seq, event.Type, data)
+	return err
+}
@@ -0,0 +1,218 @@
+package handler_test
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/example/go-react-cqrs-template/internal/eventstream"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure/memory"
+)
+
+// sseMessage 受信した Server-Sent Event（コメント行は comment に入る）
+type sseMessage struct {
+	id      string
+	event   string
+	data    string
+	comment string
+}
+
+// startStreamServer 変更ストリームを配信するテストサーバーと、稼働中の Hub を起動する
+func startStreamServer(t *testing.T, store *memory.Store, heartbeat time.Duration) *httptest.Server {
+	t.Helper()
+	ctx, cancel := context.WithCancel(context.Background())
+	hub := eventstream.NewHub(store, slog.New(slog.NewTextHandler(io.Discard, nil)), eventstream.WithPollInterval(10*time.Millisecond))
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		hub.Run(ctx, nil)
+	}()
+
+	srv := httptest.NewServer(newTestRouterWithHub(t, store, hub, heartbeat))
+	t.Cleanup(func() {
+		// Hub の停止でストリームを終わらせてからサーバーを閉じる
+		cancel()
+		<-done
+		srv.Close()
+	})
+	return srv
+}
+
+// openStream /users:watch に接続し、受信したメッセージを順に返すチャネルを返す
+func openStream(t *testing.T, srv *httptest.Server, lastEventID string) <-chan sseMessage {
+	t.Helper()
+	req, err := http.NewRequest(http.MethodGet, srv.URL+"/users:watch", nil)
+	if err != nil {
+		t.Fatalf("failed to create request: %v", err)
+	}
+	if lastEventID != "" {
+		req.Header.Set("Last-Event-ID", lastEventID)
+	}
+	resp, err := srv.Client().Do(req)
+	if err != nil {
+		t.Fatalf("failed to open stream: %v", err)
+	}
+	t.Cleanup(func() { resp.Body.Close() })
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		t.Fatalf("status = %d, want %d (body: %s)", resp.StatusCode, http.StatusOK, body)
+	}
+	if ct := resp.Header.Get("Content-Type"); ct != "text/event-stream" {
+		t.Fatalf("Content-Type = %q, want text/event-stream", ct)
+	}
+
+	messages := make(chan sseMessage, 16)
+	go func() {
+		defer close(messages)
+		scanner := bufio.NewScanner(resp.Body)
+		var msg sseMessage
+		for scanner.Scan() {
+			line := scanner.Text()
+			switch {
+			case line == "":
+				if msg != (sseMessage{}) {
+					messages <- msg
+				}
+				msg = sseMessage{}
+			case strings.HasPrefix(line, ":"):
+				msg.comment = strings.TrimSpace(line[1:])
+			case strings.HasPrefix(line, "id: "):
+				msg.id = line[len("id: "):]
+			case strings.HasPrefix(line, "event: "):
+				msg.event = line[len("event: "):]
+			case strings.HasPrefix(line, "data: "):
+				msg.data = line[len("data: "):]
+			}
+		}
+	}()
+	return messages
+}
+
+// nextEvent コメント行と retry を読み飛ばして次のイベントを待つ
+func nextEvent(t *testing.T, messages <-chan sseMessage) sseMessage {
+	t.Helper()
+	timeout := time.After(5 * time.Second)
+	for {
+		select {
+		case msg, ok := <-messages:
+			if !ok {
+				t.Fatal("stream closed before the next event")
+			}
+			if msg.event != "" {
+				return msg
+			}
+		case <-timeout:
+			t.Fatal("timed out waiting for the next event")
+		}
+	}
+}
+
+// postUser API でユーザーを作成する
+// postUser ユーザーを作成し、そのIDを返す
+func postUser(t *testing.T, srv *httptest.Server, store *memory.Store, name, email string) string {
+	t.Helper()
+	body, _ := json.Marshal(map[string]string{"name": name, "email": email})
+	resp, err := srv.Client().Post(srv.URL+"/users", "application/json", bytes.NewReader(body))
+	if err != nil {
+		t.Fatalf("failed to create user: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusCreated {
+		t.Fatalf("create user status = %d, want %d", resp.StatusCode, http.StatusCreated)
+	}
+	user, err := store.FindByEmail(context.Background(), email)
+	if err != nil || user == nil {
+		t.Fatalf("FindByEmail() = %v, %v", user, err)
+	}
+	return user.ID
+}
+
+func TestUserStreamHandler_StreamsChanges(t *testing.T) {
+	store := memory.NewStore()
+	srv := startStreamServer(t, store, 0)
+	messages := openStream(t, srv, "")
+
+	postUser(t, srv, store, "Jane Doe", "jane@example.com")
+
+	msg := nextEvent(t, messages)
+	if msg.id != "1" || msg.event != "UserCreated" {
+		t.Fatalf("event = %+v, want id 1 UserCreated", msg)
+	}
+	var data struct {
+		ID   string `json:"id"`
+		Type string `json:"type"`
+		User struct {
+			ID     string `json:"id"`
+			Status string `json:"status"`
+		} `json:"user"`
+	}
+	if err := json.Unmarshal([]byte(msg.data), &data); err != nil {
+		t.Fatalf("failed to decode data %q: %v", msg.data, err)
+	}
+	if data.Type != "UserCreated" || data.ID == "" || data.User.ID == "" || data.User.Status != "active" {
+		t.Errorf("data = %+v, want UserCreated for an active user", data)
+	}
+	if strings.Contains(msg.data, "jane@example.com") {
+		t.Errorf("data = %s, want no email", msg.data)
+	}
+}
+
+func TestUserStreamHandler_ResumesFromLastEventID(t *testing.T) {
+	store := memory.NewStore()
+	srv := startStreamServer(t, store, 0)
+
+	postUser(t, srv, store, "User One", "one@example.com")
+	two := postUser(t, srv, store, "User Two", "two@example.com")
+	// Hub が既存のイベントに追いついてから接続する
+	time.Sleep(50 * time.Millisecond)
+
+	messages := openStream(t, srv, "1")
+	if msg := nextEvent(t, messages); msg.id != "2" || !strings.Contains(msg.data, two) {
+		t.Fatalf("replayed event = %+v, want id 2 for %s", msg, two)
+	}
+
+	three := postUser(t, srv, store, "User Three", "three@example.com")
+	if msg := nextEvent(t, messages); msg.id != "3" || !strings.Contains(msg.data, three) {
+		t.Fatalf("live event = %+v, want id 3 for %s", msg, three)
+	}
+}
+
+func TestUserStreamHandler_SendsHeartbeats(t *testing.T) {
+	store := memory.NewStore()
+	srv := startStreamServer(t, store, 10*time.Millisecond)
+	messages := openStream(t, srv, "")
+
+	timeout := time.After(5 * time.Second)
+	for {
+		select {
+		case msg := <-messages:
+			if msg.comment == "heartbeat" {
+				return
+			}
+		case <-timeout:
+			t.Fatal("no heartbeat received")
+		}
+	}
+}
+
+func TestUserStreamHandler_RejectsInvalidLastEventID(t *testing.T) {
+	router := newTestRouter(t, memory.NewStore())
+
+	req := httptest.NewRequest(http.MethodGet, "/users:watch", nil)
+	req.Header.Set("Last-Event-ID", "abc")
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusBadRequest {
+		t.Errorf("status = %d, want %d (body: %s)", rec.Code, http.StatusBadRequest, rec.Body.String())
+	}
+}
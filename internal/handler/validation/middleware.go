@@ -36,13 +36,48 @@ type ValidationField struct {
 	Message string `json:"message"`
 }
 
+// ResponseMode はレスポンスバリデーションの動作モード
+type ResponseMode string
+
+const (
+	// ResponseModeOff はレスポンスをバリデートしない（デフォルト）
+	ResponseModeOff ResponseMode = "off"
+	// ResponseModeWarn は仕様違反を警告ログに記録し、レスポンスはそのまま返す
+	ResponseModeWarn ResponseMode = "warn"
+	// ResponseModeStrict は仕様違反を 500 エラーに置き換える（テスト・CI向け）
+	ResponseModeStrict ResponseMode = "strict"
+)
+
+// ParseResponseMode は文字列から ResponseMode を取得する（空文字は off）
+func ParseResponseMode(s string) (ResponseMode, error) {
+	switch mode := ResponseMode(strings.ToLower(s)); mode {
+	case "", ResponseModeOff:
+		return ResponseModeOff, nil
+	case ResponseModeWarn, ResponseModeStrict:
+		return mode, nil
+	default:
+		return "", fmt.Errorf("unknown response validation mode: %q", s)
+	}
+}
+
+// Option はミドルウェアの設定を変更する
+type Option func(*Middleware)
+
+// WithResponseValidation はレスポンスバリデーションのモードを設定する
+func WithResponseValidation(mode ResponseMode) Option {
+	return func(m *Middleware) {
+		m.responseMode = mode
+	}
+}
+
 // Middleware はOpenAPI定義に基づいてリクエストをバリデートするミドルウェアを作成する
 type Middleware struct {
-	router routers.Router
+	router       routers.Router
+	responseMode ResponseMode
 }
 
 // NewMiddleware は新しいバリデーションミドルウェアを作成する
-func NewMiddleware(openapiSpec []byte) (*Middleware, error) {
+func NewMiddleware(openapiSpec []byte, opts ...Option) (*Middleware, error) {
 	loader := openapi3.NewLoader()
 	doc, err := loader.LoadFromData(openapiSpec)
 	if err != nil {
@@ -63,7 +98,14 @@ func NewMiddleware(openapiSpec []byte) (*Middleware, error) {
 		return nil, fmt.Errorf("failed to create router: %w", err)
 	}
 
-	return &Middleware{router: router}, nil
+	m := &Middleware{
+		router:       router,
+		responseMode: ResponseModeOff,
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m, nil
 }
 
 // Handler はHTTPミドルウェアとして機能する
@@ -105,10 +147,32 @@ func (m *Middleware) Handler(next http.Handler) http.Handler {
 			r.Body = io.NopCloser(bytes.NewBuffer(bodyBytes))
 		}
 
-		next.ServeHTTP(w, r)
+		// ストリーミングのレスポンスはバッファリングすると配信されないため検証しない
+		if m.responseMode == ResponseModeOff || isEventStream(route.Operation) {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		// レスポンスをバッファリングしてからバリデートする
+		buffered := newBufferedResponseWriter()
+		next.ServeHTTP(buffered, r)
+		m.validateResponse(w, r, requestValidationInput, buffered)
 	})
 }
 
+// isEventStream は操作が Server-Sent Events（text/event-stream）を返すかどうかを判定する
+func isEventStream(op *openapi3.Operation) bool {
+	if op == nil || op.Responses == nil {
+		return false
+	}
+	for _, resp := range op.Responses.Map() {
+		if resp.Value != nil && resp.Value.Content.Get("text/event-stream") != nil {
+			return true
+		}
+	}
+	return false
+}
+
 // handleValidationError はバリデーションエラーをHTTPレスポンスに変換する
 func handleValidationError(w http.ResponseWriter, err error) {
 	validationErr := ValidationError{
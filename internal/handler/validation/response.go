@@ -0,0 +1,103 @@
+package validation
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+
+	"github.com/getkin/kin-openapi/openapi3filter"
+
+	"github.com/example/go-react-cqrs-template/internal/pkg/logger"
+)
+
+// validateResponse はバッファしたレスポンスをOpenAPI定義に照らして検証し、クライアントへ書き出す
+func (m *Middleware) validateResponse(
+	w http.ResponseWriter,
+	r *http.Request,
+	requestInput *openapi3filter.RequestValidationInput,
+	buffered *bufferedResponseWriter,
+) {
+	responseInput := &openapi3filter.ResponseValidationInput{
+		RequestValidationInput: requestInput,
+		Status:                 buffered.status,
+		Header:                 buffered.header,
+		Body:                   io.NopCloser(bytes.NewReader(buffered.body.Bytes())),
+		Options: &openapi3filter.Options{
+			MultiError:            true,
+			IncludeResponseStatus: true,
+		},
+	}
+
+	err := openapi3filter.ValidateResponse(r.Context(), responseInput)
+	if err == nil {
+		buffered.flush(w)
+		return
+	}
+
+	attrs := []any{
+		slog.String("method", r.Method),
+		slog.String("path", r.URL.Path),
+		slog.Int("status", buffered.status),
+		slog.String("error", err.Error()),
+	}
+
+	if m.responseMode == ResponseModeStrict {
+		logger.FromContext(r.Context()).Error("response does not match OpenAPI spec", attrs...)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusInternalServerError)
+		json.NewEncoder(w).Encode(ValidationError{
+			Message: "レスポンスがAPI仕様に違反しています",
+			Code:    "RESPONSE_VALIDATION_ERROR",
+		})
+		return
+	}
+
+	logger.FromContext(r.Context()).Warn("response does not match OpenAPI spec", attrs...)
+	buffered.flush(w)
+}
+
+// bufferedResponseWriter はレスポンスを検証するまで保持する http.ResponseWriter
+type bufferedResponseWriter struct {
+	header      http.Header
+	status      int
+	wroteHeader bool
+	body        bytes.Buffer
+}
+
+func newBufferedResponseWriter() *bufferedResponseWriter {
+	return &bufferedResponseWriter{
+		header: make(http.Header),
+		status: http.StatusOK,
+	}
+}
+
+// Header はバッファ用のヘッダーを返す
+func (b *bufferedResponseWriter) Header() http.Header {
+	return b.header
+}
+
+// WriteHeader はステータスコードを記録する
+func (b *bufferedResponseWriter) WriteHeader(status int) {
+	if b.wroteHeader {
+		return
+	}
+	b.status = status
+	b.wroteHeader = true
+}
+
+// Write はボディをバッファに書き込む
+func (b *bufferedResponseWriter) Write(p []byte) (int, error) {
+	b.wroteHeader = true
+	return b.body.Write(p)
+}
+
+// flush はバッファした内容を実際のレスポンスに書き出す
+func (b *bufferedResponseWriter) flush(w http.ResponseWriter) {
+	for key, values := range b.header {
+		w.Header()[key] = values
+	}
+	w.WriteHeader(b.status)
+	w.Write(b.body.Bytes())
+}
@@ -0,0 +1,142 @@
+package validation
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+var testResponseSpec = []byte(`
+openapi: 3.0.0
+info:
+  title: Test API
+  version: 1.0.0
+paths:
+  /users/{userId}:
+    get:
+      operationId: getUser
+      parameters:
+        - name: userId
+          in: path
+          required: true
+          schema:
+            type: string
+      responses:
+        '200':
+          description: OK
+          content:
+            application/json:
+              schema:
+                type: object
+                required:
+                  - id
+                  - name
+                properties:
+                  id:
+                    type: string
+                  name:
+                    type: string
+                    minLength: 1
+`)
+
+func TestParseResponseMode(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    ResponseMode
+		wantErr bool
+	}{
+		{in: "", want: ResponseModeOff},
+		{in: "off", want: ResponseModeOff},
+		{in: "WARN", want: ResponseModeWarn},
+		{in: "strict", want: ResponseModeStrict},
+		{in: "loud", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, err := ParseResponseMode(tt.in)
+			if tt.wantErr {
+				if err == nil {
+					t.Error("expected error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("ParseResponseMode(%q) = %v, want %v", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMiddleware_ResponseValidation(t *testing.T) {
+	validBody := `{"id": "1", "name": "John"}`
+	invalidBody := `{"id": "1"}`
+
+	tests := []struct {
+		name       string
+		mode       ResponseMode
+		body       string
+		wantStatus int
+		wantBody   string
+	}{
+		{name: "off passes invalid response", mode: ResponseModeOff, body: invalidBody, wantStatus: http.StatusOK, wantBody: invalidBody},
+		{name: "warn passes invalid response", mode: ResponseModeWarn, body: invalidBody, wantStatus: http.StatusOK, wantBody: invalidBody},
+		{name: "strict passes valid response", mode: ResponseModeStrict, body: validBody, wantStatus: http.StatusOK, wantBody: validBody},
+		{name: "strict rejects invalid response", mode: ResponseModeStrict, body: invalidBody, wantStatus: http.StatusInternalServerError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			middleware, err := NewMiddleware(testResponseSpec, WithResponseValidation(tt.mode))
+			if err != nil {
+				t.Fatalf("failed to create middleware: %v", err)
+			}
+
+			handler := middleware.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.Header().Set("X-Custom", "kept")
+				w.WriteHeader(http.StatusOK)
+				w.Write([]byte(tt.body))
+			}))
+
+			req := httptest.NewRequest(http.MethodGet, "/users/1", nil)
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantStatus {
+				t.Errorf("expected status %d, got %d", tt.wantStatus, rec.Code)
+			}
+			if tt.wantBody != "" {
+				if rec.Body.String() != tt.wantBody {
+					t.Errorf("expected body %q, got %q", tt.wantBody, rec.Body.String())
+				}
+				if rec.Header().Get("X-Custom") != "kept" {
+					t.Error("expected handler headers to be preserved")
+				}
+			}
+		})
+	}
+}
+
+func TestMiddleware_ResponseValidation_UndefinedStatus(t *testing.T) {
+	middleware, err := NewMiddleware(testResponseSpec, WithResponseValidation(ResponseModeStrict))
+	if err != nil {
+		t.Fatalf("failed to create middleware: %v", err)
+	}
+
+	// 仕様に定義されていないステータスコード
+	handler := middleware.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusTeapot)
+	}))
+
+	req := httptest.NewRequest(http.MethodGet, "/users/1", nil)
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusInternalServerError {
+		t.Errorf("expected status %d, got %d", http.StatusInternalServerError, rec.Code)
+	}
+}
@@ -0,0 +1,233 @@
+package handler
+
+import (
+	"encoding/json"
+	"log/slog"
+	"net/http"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/usecase"
+	"github.com/example/go-react-cqrs-template/pkg/generated/openapi"
+)
+
+// WebhookHandler Webhook購読のHTTPハンドラー
+type WebhookHandler struct {
+	createSubscription *usecase.CreateWebhookSubscriptionUsecase
+	findSubscription   *usecase.FindWebhookSubscriptionUsecase
+	listSubscriptions  *usecase.ListWebhookSubscriptionsUsecase
+	updateSubscription *usecase.UpdateWebhookSubscriptionUsecase
+	deleteSubscription *usecase.DeleteWebhookSubscriptionUsecase
+	listDeliveries     *usecase.ListWebhookDeliveriesUsecase
+	logger             *slog.Logger
+}
+
+// NewWebhookHandler WebhookHandlerのコンストラクタ
+func NewWebhookHandler(
+	createSubscription *usecase.CreateWebhookSubscriptionUsecase,
+	findSubscription *usecase.FindWebhookSubscriptionUsecase,
+	listSubscriptions *usecase.ListWebhookSubscriptionsUsecase,
+	updateSubscription *usecase.UpdateWebhookSubscriptionUsecase,
+	deleteSubscription *usecase.DeleteWebhookSubscriptionUsecase,
+	listDeliveries *usecase.ListWebhookDeliveriesUsecase,
+	logger *slog.Logger,
+) *WebhookHandler {
+	return &WebhookHandler{
+		createSubscription: createSubscription,
+		findSubscription:   findSubscription,
+		listSubscriptions:  listSubscriptions,
+		updateSubscription: updateSubscription,
+		deleteSubscription: deleteSubscription,
+		listDeliveries:     listDeliveries,
+		logger:             logger,
+	}
+}
+
+// WebhookSubscriptionsCreateWebhookSubscription Webhook購読を作成（OpenAPI ServerInterface実装）
+func (h *WebhookHandler) WebhookSubscriptionsCreateWebhookSubscription(w http.ResponseWriter, r *http.Request) {
+	var req openapi.CreateWebhookSubscriptionRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, http.StatusBadRequest, "リクエストの形式が不正です")
+		return
+	}
+
+	active := true
+	if req.Active != nil {
+		active = *req.Active
+	}
+
+	sub, err := h.createSubscription.Execute(r.Context(), req.Url, req.Secret, toDomainEventTypes(req.Events), active)
+	if err != nil {
+		HandleError(w, err, h.logger)
+		return
+	}
+
+	respondJSON(w, http.StatusCreated, toWebhookSubscriptionResponse(sub))
+}
+
+// WebhookSubscriptionsGetWebhookSubscription Webhook購読を取得（OpenAPI ServerInterface実装）
+func (h *WebhookHandler) WebhookSubscriptionsGetWebhookSubscription(w http.ResponseWriter, r *http.Request, subscriptionId string) {
+	sub, err := h.findSubscription.Execute(r.Context(), subscriptionId)
+	if err != nil {
+		HandleError(w, err, h.logger)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, toWebhookSubscriptionResponse(sub))
+}
+
+// WebhookSubscriptionsListWebhookSubscriptions Webhook購読一覧を取得（OpenAPI ServerInterface実装）
+func (h *WebhookHandler) WebhookSubscriptionsListWebhookSubscriptions(w http.ResponseWriter, r *http.Request, params openapi.WebhookSubscriptionsListWebhookSubscriptionsParams) {
+	limit, offset := pagination(params.Limit, params.Offset)
+
+	subs, total, err := h.listSubscriptions.Execute(r.Context(), limit, offset)
+	if err != nil {
+		HandleError(w, err, h.logger)
+		return
+	}
+
+	responses := make([]openapi.WebhookSubscription, 0, len(subs))
+	for _, sub := range subs {
+		responses = append(responses, toWebhookSubscriptionResponse(sub))
+	}
+
+	respondJSON(w, http.StatusOK, openapi.WebhookSubscriptionList{
+		Subscriptions: responses,
+		Total:         int32(total),
+	})
+}
+
+// WebhookSubscriptionsUpdateWebhookSubscription Webhook購読を更新（OpenAPI ServerInterface実装）
+func (h *WebhookHandler) WebhookSubscriptionsUpdateWebhookSubscription(w http.ResponseWriter, r *http.Request, subscriptionId string) {
+	var req openapi.UpdateWebhookSubscriptionRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, http.StatusBadRequest, "リクエストの形式が不正です")
+		return
+	}
+
+	// 更新値の取得（オプショナルなので既存値を保持）
+	url := ""
+	secret := ""
+	var eventTypes []domain.UserEventType
+
+	if req.Url != nil {
+		url = *req.Url
+	}
+	if req.Secret != nil {
+		secret = *req.Secret
+	}
+	if req.Events != nil {
+		eventTypes = toDomainEventTypes(*req.Events)
+	}
+
+	sub, err := h.updateSubscription.Execute(r.Context(), subscriptionId, url, secret, eventTypes, req.Active)
+	if err != nil {
+		HandleError(w, err, h.logger)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, toWebhookSubscriptionResponse(sub))
+}
+
+// WebhookSubscriptionsDeleteWebhookSubscription Webhook購読を削除（OpenAPI ServerInterface実装）
+func (h *WebhookHandler) WebhookSubscriptionsDeleteWebhookSubscription(w http.ResponseWriter, r *http.Request, subscriptionId string) {
+	if err := h.deleteSubscription.Execute(r.Context(), subscriptionId); err != nil {
+		HandleError(w, err, h.logger)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// WebhookSubscriptionsListWebhookDeliveries Webhook購読の配信ログを取得（OpenAPI ServerInterface実装）
+func (h *WebhookHandler) WebhookSubscriptionsListWebhookDeliveries(w http.ResponseWriter, r *http.Request, subscriptionId string, params openapi.WebhookSubscriptionsListWebhookDeliveriesParams) {
+	limit, offset := pagination(params.Limit, params.Offset)
+
+	var status domain.WebhookDeliveryStatus
+	if params.Status != nil {
+		status = domain.WebhookDeliveryStatus(*params.Status)
+	}
+
+	deliveries, total, err := h.listDeliveries.Execute(r.Context(), subscriptionId, status, limit, offset)
+	if err != nil {
+		HandleError(w, err, h.logger)
+		return
+	}
+
+	responses := make([]openapi.WebhookDelivery, 0, len(deliveries))
+	for _, d := range deliveries {
+		responses = append(responses, toWebhookDeliveryResponse(d))
+	}
+
+	respondJSON(w, http.StatusOK, openapi.WebhookDeliveryList{
+		Deliveries: responses,
+		Total:      int32(total),
+	})
+}
+
+// pagination limit / offset クエリパラメーターにデフォルト値を適用する
+func pagination(limitParam, offsetParam *int32) (limit, offset int) {
+	limit = 10
+	if limitParam != nil && *limitParam > 0 && *limitParam <= 100 {
+		limit = int(*limitParam)
+	}
+	if offsetParam != nil && *offsetParam >= 0 {
+		offset = int(*offsetParam)
+	}
+	return limit, offset
+}
+
+// toDomainEventTypes API のイベント種別をドメインのイベント種別に変換
+func toDomainEventTypes(events []openapi.WebhookEventType) []domain.UserEventType {
+	result := make([]domain.UserEventType, len(events))
+	for i, e := range events {
+		result[i] = domain.UserEventType(e)
+	}
+	return result
+}
+
+// toWebhookSubscriptionResponse domain.WebhookSubscriptionをAPIレスポンスに変換（シークレットは返さない）
+func toWebhookSubscriptionResponse(sub *domain.WebhookSubscription) openapi.WebhookSubscription {
+	events := make([]openapi.WebhookEventType, len(sub.EventTypes))
+	for i, t := range sub.EventTypes {
+		events[i] = openapi.WebhookEventType(t)
+	}
+	return openapi.WebhookSubscription{
+		Id:        sub.ID,
+		Url:       sub.URL,
+		Events:    events,
+		Active:    sub.Active,
+		CreatedAt: sub.CreatedAt,
+		UpdatedAt: sub.UpdatedAt,
+	}
+}
+
+// toWebhookDeliveryResponse domain.WebhookDeliveryをAPIレスポンスに変換
+func toWebhookDeliveryResponse(d *domain.WebhookDelivery) openapi.WebhookDelivery {
+	response := openapi.WebhookDelivery{
+		Id:             d.ID,
+		SubscriptionId: d.SubscriptionID,
+		EventId:        d.EventID,
+		EventType:      openapi.WebhookEventType(d.EventType),
+		Status:         openapi.WebhookDeliveryStatus(d.Status),
+		Attempts:       int32(d.Attempts),
+		CreatedAt:      d.CreatedAt,
+		UpdatedAt:      d.UpdatedAt,
+	}
+	if d.LastStatusCode != 0 {
+		code := int32(d.LastStatusCode)
+		response.LastStatusCode = &code
+	}
+	if d.LastError != "" {
+		lastError := d.LastError
+		response.LastError = &lastError
+	}
+	if d.Status == domain.WebhookDeliveryStatusPending {
+		nextAttemptAt := d.NextAttemptAt
+		response.NextAttemptAt = &nextAttemptAt
+	}
+	if !d.DeliveredAt.IsZero() {
+		deliveredAt := d.DeliveredAt
+		response.DeliveredAt = &deliveredAt
+	}
+	return response
+}
@@ -0,0 +1,129 @@
+package handler_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure/memory"
+	"github.com/example/go-react-cqrs-template/pkg/generated/openapi"
+)
+
+// TestWebhookHandler_ResponsesMatchSpec 各エンドポイントのレスポンスが openapi.yaml に準拠していることを検証する
+func TestWebhookHandler_ResponsesMatchSpec(t *testing.T) {
+	existing, err := domain.NewWebhookSubscription("https://example.com/hooks", "0123456789abcdef",
+		[]domain.UserEventType{domain.UserEventTypeCreated}, true)
+	if err != nil {
+		t.Fatalf("Failed to create subscription: %v", err)
+	}
+	now := time.Now()
+	pending := domain.NewWebhookDelivery(existing.ID, "01ARZ3NDEKTSV4RRFFQ69G5FAV", domain.UserEventTypeCreated, []byte(`{}`), now)
+	pending.Fail(http.StatusInternalServerError, "unexpected status 500", now, now.Add(time.Minute), 10)
+	succeeded := domain.NewWebhookDelivery(existing.ID, "01ARZ3NDEKTSV4RRFFQ69G5FAW", domain.UserEventTypeCreated, []byte(`{}`), now)
+	succeeded.Succeed(http.StatusNoContent, now)
+
+	base := "/webhook-subscriptions"
+	tests := []struct {
+		name       string
+		method     string
+		path       string
+		body       string
+		wantStatus int
+	}{
+		{name: "list subscriptions", method: http.MethodGet, path: base, wantStatus: http.StatusOK},
+		{name: "get subscription", method: http.MethodGet, path: base + "/" + existing.ID, wantStatus: http.StatusOK},
+		{name: "get missing subscription", method: http.MethodGet, path: base + "/01ARZ3NDEKTSV4RRFFQ69G5FAV", wantStatus: http.StatusNotFound},
+		{name: "create subscription", method: http.MethodPost, path: base, body: `{"url":"https://example.com/other","secret":"fedcba9876543210","events":["UserDeleted"]}`, wantStatus: http.StatusCreated},
+		{name: "create with ftp url", method: http.MethodPost, path: base, body: `{"url":"ftp://example.com/hooks","secret":"fedcba9876543210","events":["UserDeleted"]}`, wantStatus: http.StatusBadRequest},
+		{name: "create without events", method: http.MethodPost, path: base, body: `{"url":"https://example.com/other","secret":"fedcba9876543210","events":[]}`, wantStatus: http.StatusBadRequest},
+		{name: "create with short secret", method: http.MethodPost, path: base, body: `{"url":"https://example.com/other","secret":"short","events":["UserCreated"]}`, wantStatus: http.StatusBadRequest},
+		{name: "update subscription", method: http.MethodPut, path: base + "/" + existing.ID, body: `{"active":false}`, wantStatus: http.StatusOK},
+		{name: "delete subscription", method: http.MethodDelete, path: base + "/" + existing.ID, wantStatus: http.StatusNoContent},
+		{name: "list deliveries", method: http.MethodGet, path: base + "/" + existing.ID + "/deliveries", wantStatus: http.StatusOK},
+		{name: "list dead deliveries", method: http.MethodGet, path: base + "/" + existing.ID + "/deliveries?status=dead", wantStatus: http.StatusOK},
+		{name: "list deliveries with unknown status", method: http.MethodGet, path: base + "/" + existing.ID + "/deliveries?status=unknown", wantStatus: http.StatusBadRequest},
+		{name: "list deliveries of missing subscription", method: http.MethodGet, path: base + "/01ARZ3NDEKTSV4RRFFQ69G5FAV/deliveries", wantStatus: http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := memory.NewStore()
+			store.SeedWebhookSubscriptions(existing)
+			store.SeedWebhookDeliveries(pending, succeeded)
+			router := newTestRouter(t, store)
+
+			req := httptest.NewRequest(tt.method, tt.path, bytes.NewBufferString(tt.body))
+			if tt.body != "" {
+				req.Header.Set("Content-Type", "application/json")
+			}
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantStatus {
+				t.Errorf("status = %d, want %d (body: %s)", rec.Code, tt.wantStatus, rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestWebhookHandler_ListWebhookDeliveries(t *testing.T) {
+	sub, err := domain.NewWebhookSubscription("https://example.com/hooks", "0123456789abcdef",
+		[]domain.UserEventType{domain.UserEventTypeCreated}, true)
+	if err != nil {
+		t.Fatalf("Failed to create subscription: %v", err)
+	}
+	now := time.Now()
+	dead := domain.NewWebhookDelivery(sub.ID, "01ARZ3NDEKTSV4RRFFQ69G5FAV", domain.UserEventTypeCreated, []byte(`{}`), now)
+	dead.Fail(http.StatusGone, "unexpected status 410", now, now, 1)
+	succeeded := domain.NewWebhookDelivery(sub.ID, "01ARZ3NDEKTSV4RRFFQ69G5FAW", domain.UserEventTypeCreated, []byte(`{}`), now)
+	succeeded.Succeed(http.StatusOK, now)
+
+	store := memory.NewStore()
+	store.SeedWebhookSubscriptions(sub)
+	store.SeedWebhookDeliveries(dead, succeeded)
+	router := newTestRouter(t, store)
+
+	req := httptest.NewRequest(http.MethodGet, "/webhook-subscriptions/"+sub.ID+"/deliveries?status=dead", nil)
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+
+	var got openapi.WebhookDeliveryList
+	if err := json.NewDecoder(rec.Body).Decode(&got); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if got.Total != 1 || len(got.Deliveries) != 1 {
+		t.Fatalf("response = %+v, want one dead delivery", got)
+	}
+	d := got.Deliveries[0]
+	if d.Id != dead.ID || d.Status != openapi.WebhookDeliveryStatusDead || d.LastStatusCode == nil || *d.LastStatusCode != http.StatusGone || d.NextAttemptAt != nil {
+		t.Errorf("delivery = %+v, want dead delivery with status code 410 and no next attempt", d)
+	}
+}
+
+func TestWebhookHandler_SecretIsNotReturned(t *testing.T) {
+	router := newTestRouter(t, memory.NewStore())
+
+	body := `{"url":"https://example.com/hooks","secret":"0123456789abcdef","events":["UserCreated"]}`
+	req := httptest.NewRequest(http.MethodPost, "/webhook-subscriptions", bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusCreated {
+		t.Fatalf("status = %d, want %d (body: %s)", rec.Code, http.StatusCreated, rec.Body.String())
+	}
+	if bytes.Contains(rec.Body.Bytes(), []byte("0123456789abcdef")) {
+		t.Errorf("response leaks the secret: %s", rec.Body.String())
+	}
+	var got openapi.WebhookSubscription
+	if err := json.NewDecoder(rec.Body).Decode(&got); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if !got.Active || len(got.Events) != 1 || got.Events[0] != openapi.UserCreated {
+		t.Errorf("response = %+v, want active subscription to UserCreated", got)
+	}
+}
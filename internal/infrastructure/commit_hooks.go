@@ -0,0 +1,40 @@
+package infrastructure
+
+import "context"
+
+// commitHooksKey context に CommitHooks を保持するためのキー
+type commitHooksKey struct{}
+
+// CommitHooks トランザクションのコミット後に実行する処理
+//
+// TransactionManager の実装が NewCommitHooks で context に関連付け、コミットに成功した場合のみ Run を呼び出す。
+// ロールバックした場合は登録された処理を破棄する。
+type CommitHooks struct {
+	fns []func()
+}
+
+// NewCommitHooks CommitHooks を作成し、それを保持する context を返す
+func NewCommitHooks(ctx context.Context) (context.Context, *CommitHooks) {
+	hooks := &CommitHooks{}
+	return context.WithValue(ctx, commitHooksKey{}, hooks), hooks
+}
+
+// Run 登録された処理を登録順に実行する
+func (h *CommitHooks) Run() {
+	for _, fn := range h.fns {
+		fn()
+	}
+	h.fns = nil
+}
+
+// AfterCommit ctx のトランザクションがコミットされた後に fn を実行するよう登録する
+//
+// トランザクション外（RunInTransaction に渡された context 以外）で呼び出した場合は直ちに実行する。
+func AfterCommit(ctx context.Context, fn func()) {
+	hooks, ok := ctx.Value(commitHooksKey{}).(*CommitHooks)
+	if !ok {
+		fn()
+		return
+	}
+	hooks.fns = append(hooks.fns, fn)
+}
@@ -5,20 +5,182 @@
 package dao
 
 import (
+	"database/sql"
+	"encoding/json"
 	"time"
 )
 
-type User struct {
+type Group struct {
 	ID        string    `db:"id" json:"id"`
+	OrgID     string    `db:"org_id" json:"org_id"`
 	Name      string    `db:"name" json:"name"`
-	Email     string    `db:"email" json:"email"`
 	CreatedAt time.Time `db:"created_at" json:"created_at"`
 	UpdatedAt time.Time `db:"updated_at" json:"updated_at"`
 }
 
-type UserLog struct {
-	ID        string    `db:"id" json:"id"`
+type GroupMember struct {
+	GroupID   string    `db:"group_id" json:"group_id"`
 	UserID    string    `db:"user_id" json:"user_id"`
-	Action    string    `db:"action" json:"action"`
+	OrgID     string    `db:"org_id" json:"org_id"`
+	Role      string    `db:"role" json:"role"`
+	CreatedAt time.Time `db:"created_at" json:"created_at"`
+	UpdatedAt time.Time `db:"updated_at" json:"updated_at"`
+}
+
+type Invitation struct {
+	ID              string         `db:"id" json:"id"`
+	OrgID           string         `db:"org_id" json:"org_id"`
+	UserID          string         `db:"user_id" json:"user_id"`
+	EmailCiphertext []byte         `db:"email_ciphertext" json:"email_ciphertext"`
+	PiiKeyID        string         `db:"pii_key_id" json:"pii_key_id"`
+	PiiDataKey      []byte         `db:"pii_data_key" json:"pii_data_key"`
+	InvitedBy       sql.NullString `db:"invited_by" json:"invited_by"`
+	Status          string         `db:"status" json:"status"`
+	TokenHash       string         `db:"token_hash" json:"token_hash"`
+	ExpiresAt       time.Time      `db:"expires_at" json:"expires_at"`
+	AcceptedAt      sql.NullTime   `db:"accepted_at" json:"accepted_at"`
+	CreatedAt       time.Time      `db:"created_at" json:"created_at"`
+	UpdatedAt       time.Time      `db:"updated_at" json:"updated_at"`
+}
+
+type OidcLoginState struct {
+	ID           string       `db:"id" json:"id"`
+	OrgID        string       `db:"org_id" json:"org_id"`
+	Provider     string       `db:"provider" json:"provider"`
+	StateHash    string       `db:"state_hash" json:"state_hash"`
+	Nonce        string       `db:"nonce" json:"nonce"`
+	CodeVerifier string       `db:"code_verifier" json:"code_verifier"`
+	ExpiresAt    time.Time    `db:"expires_at" json:"expires_at"`
+	UsedAt       sql.NullTime `db:"used_at" json:"used_at"`
+	CreatedAt    time.Time    `db:"created_at" json:"created_at"`
+}
+
+type Organization struct {
+	ID        string    `db:"id" json:"id"`
+	Name      string    `db:"name" json:"name"`
 	CreatedAt time.Time `db:"created_at" json:"created_at"`
+	UpdatedAt time.Time `db:"updated_at" json:"updated_at"`
+}
+
+type Outbox struct {
+	Seq           int64           `db:"seq" json:"seq"`
+	ID            string          `db:"id" json:"id"`
+	OrgID         string          `db:"org_id" json:"org_id"`
+	AggregateType string          `db:"aggregate_type" json:"aggregate_type"`
+	AggregateID   string          `db:"aggregate_id" json:"aggregate_id"`
+	EventType     string          `db:"event_type" json:"event_type"`
+	Payload       json.RawMessage `db:"payload" json:"payload"`
+	OccurredAt    time.Time       `db:"occurred_at" json:"occurred_at"`
+	PublishedAt   sql.NullTime    `db:"published_at" json:"published_at"`
+	Attempts      int32           `db:"attempts" json:"attempts"`
+	LastError     sql.NullString  `db:"last_error" json:"last_error"`
+	NextAttemptAt time.Time       `db:"next_attempt_at" json:"next_attempt_at"`
+}
+
+type Session struct {
+	ID         string       `db:"id" json:"id"`
+	OrgID      string       `db:"org_id" json:"org_id"`
+	UserID     string       `db:"user_id" json:"user_id"`
+	Device     string       `db:"device" json:"device"`
+	IpAddress  string       `db:"ip_address" json:"ip_address"`
+	UserAgent  string       `db:"user_agent" json:"user_agent"`
+	CreatedAt  time.Time    `db:"created_at" json:"created_at"`
+	LastSeenAt time.Time    `db:"last_seen_at" json:"last_seen_at"`
+	ExpiresAt  time.Time    `db:"expires_at" json:"expires_at"`
+	RevokedAt  sql.NullTime `db:"revoked_at" json:"revoked_at"`
+}
+
+type User struct {
+	ID              string       `db:"id" json:"id"`
+	OrgID           string       `db:"org_id" json:"org_id"`
+	NameCiphertext  []byte       `db:"name_ciphertext" json:"name_ciphertext"`
+	EmailCiphertext []byte       `db:"email_ciphertext" json:"email_ciphertext"`
+	EmailIndex      []byte       `db:"email_index" json:"email_index"`
+	PiiKeyID        string       `db:"pii_key_id" json:"pii_key_id"`
+	PiiDataKey      []byte       `db:"pii_data_key" json:"pii_data_key"`
+	Status          string       `db:"status" json:"status"`
+	EmailVerifiedAt sql.NullTime `db:"email_verified_at" json:"email_verified_at"`
+	CreatedAt       time.Time    `db:"created_at" json:"created_at"`
+	UpdatedAt       time.Time    `db:"updated_at" json:"updated_at"`
+}
+
+type UserCredential struct {
+	UserID            string       `db:"user_id" json:"user_id"`
+	OrgID             string       `db:"org_id" json:"org_id"`
+	PasswordHash      string       `db:"password_hash" json:"password_hash"`
+	FailedAttempts    int32        `db:"failed_attempts" json:"failed_attempts"`
+	LockedUntil       sql.NullTime `db:"locked_until" json:"locked_until"`
+	PasswordChangedAt time.Time    `db:"password_changed_at" json:"password_changed_at"`
+	CreatedAt         time.Time    `db:"created_at" json:"created_at"`
+	UpdatedAt         time.Time    `db:"updated_at" json:"updated_at"`
+}
+
+type UserExternalIdentity struct {
+	OrgID       string       `db:"org_id" json:"org_id"`
+	Provider    string       `db:"provider" json:"provider"`
+	Subject     string       `db:"subject" json:"subject"`
+	UserID      string       `db:"user_id" json:"user_id"`
+	LastLoginAt sql.NullTime `db:"last_login_at" json:"last_login_at"`
+	CreatedAt   time.Time    `db:"created_at" json:"created_at"`
+}
+
+type UserLog struct {
+	ID        string         `db:"id" json:"id"`
+	OrgID     string         `db:"org_id" json:"org_id"`
+	UserID    string         `db:"user_id" json:"user_id"`
+	Action    string         `db:"action" json:"action"`
+	Reason    sql.NullString `db:"reason" json:"reason"`
+	CreatedAt time.Time      `db:"created_at" json:"created_at"`
+}
+
+type UserToken struct {
+	ID              string       `db:"id" json:"id"`
+	OrgID           string       `db:"org_id" json:"org_id"`
+	UserID          string       `db:"user_id" json:"user_id"`
+	Purpose         string       `db:"purpose" json:"purpose"`
+	TokenHash       string       `db:"token_hash" json:"token_hash"`
+	EmailCiphertext []byte       `db:"email_ciphertext" json:"email_ciphertext"`
+	PiiKeyID        string       `db:"pii_key_id" json:"pii_key_id"`
+	PiiDataKey      []byte       `db:"pii_data_key" json:"pii_data_key"`
+	ExpiresAt       time.Time    `db:"expires_at" json:"expires_at"`
+	UsedAt          sql.NullTime `db:"used_at" json:"used_at"`
+	CreatedAt       time.Time    `db:"created_at" json:"created_at"`
+}
+
+type UserTotpCredential struct {
+	UserID             string       `db:"user_id" json:"user_id"`
+	OrgID              string       `db:"org_id" json:"org_id"`
+	Secret             string       `db:"secret" json:"secret"`
+	ConfirmedAt        sql.NullTime `db:"confirmed_at" json:"confirmed_at"`
+	LastUsedStep       int64        `db:"last_used_step" json:"last_used_step"`
+	RecoveryCodeHashes []string     `db:"recovery_code_hashes" json:"recovery_code_hashes"`
+	CreatedAt          time.Time    `db:"created_at" json:"created_at"`
+	UpdatedAt          time.Time    `db:"updated_at" json:"updated_at"`
+}
+
+type WebhookDelivery struct {
+	ID             string          `db:"id" json:"id"`
+	SubscriptionID string          `db:"subscription_id" json:"subscription_id"`
+	EventID        string          `db:"event_id" json:"event_id"`
+	EventType      string          `db:"event_type" json:"event_type"`
+	Payload        json.RawMessage `db:"payload" json:"payload"`
+	Status         string          `db:"status" json:"status"`
+	Attempts       int32           `db:"attempts" json:"attempts"`
+	LastStatusCode sql.NullInt32   `db:"last_status_code" json:"last_status_code"`
+	LastError      sql.NullString  `db:"last_error" json:"last_error"`
+	NextAttemptAt  time.Time       `db:"next_attempt_at" json:"next_attempt_at"`
+	DeliveredAt    sql.NullTime    `db:"delivered_at" json:"delivered_at"`
+	CreatedAt      time.Time       `db:"created_at" json:"created_at"`
+	UpdatedAt      time.Time       `db:"updated_at" json:"updated_at"`
+}
+
+type WebhookSubscription struct {
+	ID         string    `db:"id" json:"id"`
+	OrgID      string    `db:"org_id" json:"org_id"`
+	Url        string    `db:"url" json:"url"`
+	Secret     string    `db:"secret" json:"secret"`
+	EventTypes []string  `db:"event_types" json:"event_types"`
+	Active     bool      `db:"active" json:"active"`
+	CreatedAt  time.Time `db:"created_at" json:"created_at"`
+	UpdatedAt  time.Time `db:"updated_at" json:"updated_at"`
 }
@@ -6,22 +6,111 @@ package dao
 
 import (
 	"context"
+	"database/sql"
 )
 
 type Querier interface {
-	CountUserLogsByUserID(ctx context.Context, userID string) (int64, error)
-	CountUsers(ctx context.Context) (int64, error)
+	// 集約ごとに最も古い未配信イベントのみを行ロック付きで取得する（同一集約内の配信順序を保証）
+	// リレーはシステム全体で一つのため組織をまたいで取得する
+	ClaimOutboxEvents(ctx context.Context, arg ClaimOutboxEventsParams) ([]Outbox, error)
+	// 配信時刻に達した配信を購読の宛先と共に取得し、配信時刻を lease_until に進めてリースする（ワーカーの処理のため組織をまたぐ）
+	ClaimWebhookDeliveries(ctx context.Context, arg ClaimWebhookDeliveriesParams) ([]ClaimWebhookDeliveriesRow, error)
+	CountGroupMembers(ctx context.Context, arg CountGroupMembersParams) (int64, error)
+	CountOrganizations(ctx context.Context) (int64, error)
+	CountPendingInvitations(ctx context.Context, arg CountPendingInvitationsParams) (int64, error)
+	CountUserLogsByUserID(ctx context.Context, arg CountUserLogsByUserIDParams) (int64, error)
+	CountUserLogsByUserIDs(ctx context.Context, arg CountUserLogsByUserIDsParams) ([]CountUserLogsByUserIDsRow, error)
+	CountUsers(ctx context.Context, arg CountUsersParams) (int64, error)
+	CountWebhookDeliveries(ctx context.Context, arg CountWebhookDeliveriesParams) (int64, error)
+	CountWebhookSubscriptions(ctx context.Context, orgID string) (int64, error)
+	CreateOutboxEvent(ctx context.Context, arg CreateOutboxEventParams) error
 	CreateUser(ctx context.Context, arg CreateUserParams) error
 	CreateUserLog(ctx context.Context, arg CreateUserLogParams) error
-	DeleteUser(ctx context.Context, id string) error
-	GetUserByEmail(ctx context.Context, email string) (User, error)
-	GetUserByEmailForUpdate(ctx context.Context, email string) (User, error)
-	GetUserByID(ctx context.Context, id string) (User, error)
-	GetUserByIDForUpdate(ctx context.Context, id string) (User, error)
+	// 同じイベントの再配信（outbox の at-least-once）では配信を重複して作成しない
+	CreateWebhookDelivery(ctx context.Context, arg CreateWebhookDeliveryParams) (int64, error)
+	DeleteGroupMember(ctx context.Context, arg DeleteGroupMemberParams) (int64, error)
+	DeletePublishedOutboxEvents(ctx context.Context, publishedAt sql.NullTime) (int64, error)
+	DeleteUser(ctx context.Context, arg DeleteUserParams) error
+	DeleteUserTOTPCredential(ctx context.Context, arg DeleteUserTOTPCredentialParams) error
+	DeleteWebhookSubscription(ctx context.Context, arg DeleteWebhookSubscriptionParams) error
+	GetGroupByID(ctx context.Context, arg GetGroupByIDParams) (Group, error)
+	GetGroupByIDForUpdate(ctx context.Context, arg GetGroupByIDForUpdateParams) (Group, error)
+	GetGroupByNameForUpdate(ctx context.Context, arg GetGroupByNameForUpdateParams) (Group, error)
+	GetGroupMember(ctx context.Context, arg GetGroupMemberParams) (GroupMember, error)
+	GetInvitationByIDForUpdate(ctx context.Context, arg GetInvitationByIDForUpdateParams) (Invitation, error)
+	GetInvitationByTokenHashForUpdate(ctx context.Context, arg GetInvitationByTokenHashForUpdateParams) (Invitation, error)
+	GetOIDCLoginStateByHashForUpdate(ctx context.Context, arg GetOIDCLoginStateByHashForUpdateParams) (OidcLoginState, error)
+	GetOrganizationByID(ctx context.Context, id string) (Organization, error)
+	GetOutboxSeqBounds(ctx context.Context) (GetOutboxSeqBoundsRow, error)
+	GetSessionByID(ctx context.Context, arg GetSessionByIDParams) (Session, error)
+	GetSessionByIDForUpdate(ctx context.Context, arg GetSessionByIDForUpdateParams) (Session, error)
+	GetUserByEmail(ctx context.Context, arg GetUserByEmailParams) (User, error)
+	GetUserByEmailForUpdate(ctx context.Context, arg GetUserByEmailForUpdateParams) (User, error)
+	GetUserByID(ctx context.Context, arg GetUserByIDParams) (User, error)
+	GetUserByIDForUpdate(ctx context.Context, arg GetUserByIDForUpdateParams) (User, error)
+	GetUserCredentialForUpdate(ctx context.Context, arg GetUserCredentialForUpdateParams) (UserCredential, error)
+	GetUserExternalIdentityForUpdate(ctx context.Context, arg GetUserExternalIdentityForUpdateParams) (UserExternalIdentity, error)
 	GetUserLogsByUserID(ctx context.Context, arg GetUserLogsByUserIDParams) ([]UserLog, error)
+	// 複数ユーザーのログをまとめて取得する（ユーザーごとに新しい順で row_offset / row_limit を適用する）
+	GetUserLogsByUserIDs(ctx context.Context, arg GetUserLogsByUserIDsParams) ([]GetUserLogsByUserIDsRow, error)
+	GetUserTOTPCredentialForUpdate(ctx context.Context, arg GetUserTOTPCredentialForUpdateParams) (UserTotpCredential, error)
+	GetUserTokenByHashForUpdate(ctx context.Context, arg GetUserTokenByHashForUpdateParams) (UserToken, error)
+	GetWebhookSubscriptionByID(ctx context.Context, arg GetWebhookSubscriptionByIDParams) (WebhookSubscription, error)
+	GetWebhookSubscriptionByIDForUpdate(ctx context.Context, arg GetWebhookSubscriptionByIDForUpdateParams) (WebhookSubscription, error)
+	// 取り消されておらず有効期限内のユーザーのセッションを最近使用した順に取得する
+	ListActiveSessionsByUserID(ctx context.Context, arg ListActiveSessionsByUserIDParams) ([]Session, error)
+	ListActiveWebhookSubscriptionsByEventType(ctx context.Context, arg ListActiveWebhookSubscriptionsByEventTypeParams) ([]WebhookSubscription, error)
+	// 有効期限を過ぎた承諾待ちの招待を取得する（他のトランザクションが処理中の招待は飛ばす）
+	ListExpiredInvitationsForUpdate(ctx context.Context, arg ListExpiredInvitationsForUpdateParams) ([]Invitation, error)
+	ListGroupMembers(ctx context.Context, arg ListGroupMembersParams) ([]GroupMember, error)
+	// ユーザーが所属するグループをグループ名の順にロールと共に取得する
+	ListGroupsByUserID(ctx context.Context, arg ListGroupsByUserIDParams) ([]ListGroupsByUserIDRow, error)
+	// 主鍵以外の鍵で暗号化したデータキーを取得する（他のトランザクションがロック中の行は飛ばす）
+	ListInvitationDataKeysForUpdate(ctx context.Context, arg ListInvitationDataKeysForUpdateParams) ([]ListInvitationDataKeysForUpdateRow, error)
+	ListOrganizations(ctx context.Context, arg ListOrganizationsParams) ([]Organization, error)
+	// 変更ストリームの追従と Last-Event-ID からの再送に使用する（配信状態に関わらず seq 順）
+	// 組織をまたいで取得し、購読者ごとに org_id で絞り込む
+	ListOutboxEventsAfter(ctx context.Context, arg ListOutboxEventsAfterParams) ([]Outbox, error)
+	ListOutboxEventsByAggregate(ctx context.Context, arg ListOutboxEventsByAggregateParams) ([]Outbox, error)
+	// 承諾待ちで有効期限内の招待を新しい順に取得する
+	ListPendingInvitations(ctx context.Context, arg ListPendingInvitationsParams) ([]Invitation, error)
+	// 取り消されておらずログインからの有効期限内のユーザーのセッションを取得する（無操作で失効したセッションも含む）
+	ListUnexpiredSessionsByUserIDForUpdate(ctx context.Context, arg ListUnexpiredSessionsByUserIDForUpdateParams) ([]Session, error)
+	// 主鍵以外の鍵で暗号化したデータキーを取得する（他のトランザクションがロック中の行は飛ばす）
+	ListUserDataKeysForUpdate(ctx context.Context, arg ListUserDataKeysForUpdateParams) ([]ListUserDataKeysForUpdateRow, error)
+	// 主鍵以外の鍵で暗号化したデータキーを取得する（他のトランザクションがロック中の行は飛ばす）
+	ListUserTokenDataKeysForUpdate(ctx context.Context, arg ListUserTokenDataKeysForUpdateParams) ([]ListUserTokenDataKeysForUpdateRow, error)
 	ListUsers(ctx context.Context, arg ListUsersParams) ([]User, error)
+	ListWebhookDeliveries(ctx context.Context, arg ListWebhookDeliveriesParams) ([]WebhookDelivery, error)
+	ListWebhookSubscriptions(ctx context.Context, arg ListWebhookSubscriptionsParams) ([]WebhookSubscription, error)
+	MarkOutboxEventFailed(ctx context.Context, arg MarkOutboxEventFailedParams) error
+	MarkOutboxEventPublished(ctx context.Context, arg MarkOutboxEventPublishedParams) error
+	// NOTIFY はトランザクションのコミット時に配送され、ロールバック時は破棄される
+	NotifyOutbox(ctx context.Context, arg NotifyOutboxParams) error
+	// セッションの取り消しをコミット時に各レプリカの読み取りキャッシュへ通知する（ロールバック時は破棄される）
+	NotifySessionChanged(ctx context.Context, arg NotifySessionChangedParams) error
+	// ユーザーの変更をコミット時に各レプリカの読み取りキャッシュへ通知する（ロールバック時は破棄される）
+	NotifyUserChanged(ctx context.Context, arg NotifyUserChangedParams) error
+	// ユーザーの未使用のトークンを使用済みにする（新しいトークンの発行時に以前のトークンを無効化する）
+	RevokeUserTokens(ctx context.Context, arg RevokeUserTokensParams) error
+	UpdateInvitationDataKey(ctx context.Context, arg UpdateInvitationDataKeyParams) error
 	UpdateUser(ctx context.Context, arg UpdateUserParams) error
-	UpsertUser(ctx context.Context, arg UpsertUserParams) error
+	UpdateUserDataKey(ctx context.Context, arg UpdateUserDataKeyParams) error
+	UpdateUserTokenDataKey(ctx context.Context, arg UpdateUserTokenDataKeyParams) error
+	UpdateWebhookDelivery(ctx context.Context, arg UpdateWebhookDeliveryParams) error
+	UpsertGroup(ctx context.Context, arg UpsertGroupParams) error
+	UpsertGroupMember(ctx context.Context, arg UpsertGroupMemberParams) error
+	UpsertInvitation(ctx context.Context, arg UpsertInvitationParams) error
+	UpsertOIDCLoginState(ctx context.Context, arg UpsertOIDCLoginStateParams) error
+	UpsertOrganization(ctx context.Context, arg UpsertOrganizationParams) error
+	UpsertSession(ctx context.Context, arg UpsertSessionParams) error
+	// 他の組織の同じIDのユーザーは更新しない（影響行数が 0 になる）
+	UpsertUser(ctx context.Context, arg UpsertUserParams) (int64, error)
+	UpsertUserCredential(ctx context.Context, arg UpsertUserCredentialParams) error
+	UpsertUserExternalIdentity(ctx context.Context, arg UpsertUserExternalIdentityParams) error
+	UpsertUserTOTPCredential(ctx context.Context, arg UpsertUserTOTPCredentialParams) error
+	UpsertUserToken(ctx context.Context, arg UpsertUserTokenParams) error
+	UpsertWebhookSubscription(ctx context.Context, arg UpsertWebhookSubscriptionParams) error
 }
 
 var _ Querier = (*Queries)(nil)
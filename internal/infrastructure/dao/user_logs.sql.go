@@ -7,58 +7,116 @@ package dao
 
 import (
 	"context"
+	"database/sql"
 	"time"
+
+	"github.com/lib/pq"
 )
 
 const countUserLogsByUserID = `-- name: CountUserLogsByUserID :one
-SELECT COUNT(*) FROM user_logs WHERE user_id = $1
+SELECT COUNT(*) FROM user_logs WHERE org_id = $1 AND user_id = $2
 `
 
-func (q *Queries) CountUserLogsByUserID(ctx context.Context, userID string) (int64, error) {
-	row := q.db.QueryRowContext(ctx, countUserLogsByUserID, userID)
+type CountUserLogsByUserIDParams struct {
+	OrgID  string `db:"org_id" json:"org_id"`
+	UserID string `db:"user_id" json:"user_id"`
+}
+
+func (q *Queries) CountUserLogsByUserID(ctx context.Context, arg CountUserLogsByUserIDParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countUserLogsByUserID, arg.OrgID, arg.UserID)
 	var count int64
 	err := row.Scan(&count)
 	return count, err
 }
 
+const countUserLogsByUserIDs = `-- name: CountUserLogsByUserIDs :many
+SELECT user_id, COUNT(*) AS count
+FROM user_logs
+WHERE org_id = $1 AND user_id = ANY($2::TEXT[])
+GROUP BY user_id
+`
+
+type CountUserLogsByUserIDsParams struct {
+	OrgID   string   `db:"org_id" json:"org_id"`
+	UserIds []string `db:"user_ids" json:"user_ids"`
+}
+
+type CountUserLogsByUserIDsRow struct {
+	UserID string `db:"user_id" json:"user_id"`
+	Count  int64  `db:"count" json:"count"`
+}
+
+func (q *Queries) CountUserLogsByUserIDs(ctx context.Context, arg CountUserLogsByUserIDsParams) ([]CountUserLogsByUserIDsRow, error) {
+	rows, err := q.db.QueryContext(ctx, countUserLogsByUserIDs, arg.OrgID, pq.Array(arg.UserIds))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []CountUserLogsByUserIDsRow{}
+	for rows.Next() {
+		var i CountUserLogsByUserIDsRow
+		if err := rows.Scan(&i.UserID, &i.Count); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const createUserLog = `-- name: CreateUserLog :exec
-INSERT INTO user_logs (id, user_id, action, created_at)
-VALUES ($1, $2, $3, $4)
+INSERT INTO user_logs (id, org_id, user_id, action, reason, created_at)
+VALUES ($1, $2, $3, $4, $5, $6)
 `
 
 type CreateUserLogParams struct {
-	ID        string    `db:"id" json:"id"`
-	UserID    string    `db:"user_id" json:"user_id"`
-	Action    string    `db:"action" json:"action"`
-	CreatedAt time.Time `db:"created_at" json:"created_at"`
+	ID        string         `db:"id" json:"id"`
+	OrgID     string         `db:"org_id" json:"org_id"`
+	UserID    string         `db:"user_id" json:"user_id"`
+	Action    string         `db:"action" json:"action"`
+	Reason    sql.NullString `db:"reason" json:"reason"`
+	CreatedAt time.Time      `db:"created_at" json:"created_at"`
 }
 
 func (q *Queries) CreateUserLog(ctx context.Context, arg CreateUserLogParams) error {
 	_, err := q.db.ExecContext(ctx, createUserLog,
 		arg.ID,
+		arg.OrgID,
 		arg.UserID,
 		arg.Action,
+		arg.Reason,
 		arg.CreatedAt,
 	)
 	return err
 }
 
 const getUserLogsByUserID = `-- name: GetUserLogsByUserID :many
-SELECT id, user_id, action, created_at
+SELECT id, org_id, user_id, action, reason, created_at
 FROM user_logs
-WHERE user_id = $1
+WHERE org_id = $1 AND user_id = $2
 ORDER BY created_at DESC
-LIMIT $2 OFFSET $3
+LIMIT $3 OFFSET $4
 `
 
 type GetUserLogsByUserIDParams struct {
+	OrgID  string `db:"org_id" json:"org_id"`
 	UserID string `db:"user_id" json:"user_id"`
 	Limit  int32  `db:"limit" json:"limit"`
 	Offset int32  `db:"offset" json:"offset"`
 }
 
 func (q *Queries) GetUserLogsByUserID(ctx context.Context, arg GetUserLogsByUserIDParams) ([]UserLog, error) {
-	rows, err := q.db.QueryContext(ctx, getUserLogsByUserID, arg.UserID, arg.Limit, arg.Offset)
+	rows, err := q.db.QueryContext(ctx, getUserLogsByUserID,
+		arg.OrgID,
+		arg.UserID,
+		arg.Limit,
+		arg.Offset,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -66,10 +124,74 @@ func (q *Queries) GetUserLogsByUserID(ctx context.Context, arg GetUserLogsByUser
 	items := []UserLog{}
 	for rows.Next() {
 		var i UserLog
+		if err := rows.Scan(
+			&i.ID,
+			&i.OrgID,
+			&i.UserID,
+			&i.Action,
+			&i.Reason,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getUserLogsByUserIDs = `-- name: GetUserLogsByUserIDs :many
+SELECT id, user_id, action, reason, created_at
+FROM (
+    SELECT id, user_id, action, reason, created_at,
+           ROW_NUMBER() OVER (PARTITION BY user_id ORDER BY created_at DESC, id DESC) AS rn
+    FROM user_logs
+    WHERE org_id = $1 AND user_id = ANY($2::TEXT[])
+) ranked
+WHERE rn > $3::INT AND rn <= $3::INT + $4::INT
+ORDER BY user_id, rn
+`
+
+type GetUserLogsByUserIDsParams struct {
+	OrgID     string   `db:"org_id" json:"org_id"`
+	UserIds   []string `db:"user_ids" json:"user_ids"`
+	RowOffset int32    `db:"row_offset" json:"row_offset"`
+	RowLimit  int32    `db:"row_limit" json:"row_limit"`
+}
+
+type GetUserLogsByUserIDsRow struct {
+	ID        string         `db:"id" json:"id"`
+	UserID    string         `db:"user_id" json:"user_id"`
+	Action    string         `db:"action" json:"action"`
+	Reason    sql.NullString `db:"reason" json:"reason"`
+	CreatedAt time.Time      `db:"created_at" json:"created_at"`
+}
+
+// 複数ユーザーのログをまとめて取得する（ユーザーごとに新しい順で row_offset / row_limit を適用する）
+func (q *Queries) GetUserLogsByUserIDs(ctx context.Context, arg GetUserLogsByUserIDsParams) ([]GetUserLogsByUserIDsRow, error) {
+	rows, err := q.db.QueryContext(ctx, getUserLogsByUserIDs,
+		arg.OrgID,
+		pq.Array(arg.UserIds),
+		arg.RowOffset,
+		arg.RowLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetUserLogsByUserIDsRow{}
+	for rows.Next() {
+		var i GetUserLogsByUserIDsRow
 		if err := rows.Scan(
 			&i.ID,
 			&i.UserID,
 			&i.Action,
+			&i.Reason,
 			&i.CreatedAt,
 		); err != nil {
 			return nil, err
@@ -7,38 +7,54 @@ package dao
 
 import (
 	"context"
+	"database/sql"
 	"time"
 )
 
 const countUsers = `-- name: CountUsers :one
 SELECT COUNT(*) FROM users
+WHERE org_id = $1
+  AND ($2::text IS NULL OR status = $2)
 `
 
-func (q *Queries) CountUsers(ctx context.Context) (int64, error) {
-	row := q.db.QueryRowContext(ctx, countUsers)
+type CountUsersParams struct {
+	OrgID  string         `db:"org_id" json:"org_id"`
+	Status sql.NullString `db:"status" json:"status"`
+}
+
+func (q *Queries) CountUsers(ctx context.Context, arg CountUsersParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countUsers, arg.OrgID, arg.Status)
 	var count int64
 	err := row.Scan(&count)
 	return count, err
 }
 
 const createUser = `-- name: CreateUser :exec
-INSERT INTO users (id, name, email, created_at, updated_at)
-VALUES ($1, $2, $3, $4, $5)
+INSERT INTO users (id, org_id, name_ciphertext, email_ciphertext, email_index, pii_key_id, pii_data_key, created_at, updated_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
 `
 
 type CreateUserParams struct {
-	ID        string    `db:"id" json:"id"`
-	Name      string    `db:"name" json:"name"`
-	Email     string    `db:"email" json:"email"`
-	CreatedAt time.Time `db:"created_at" json:"created_at"`
-	UpdatedAt time.Time `db:"updated_at" json:"updated_at"`
+	ID              string    `db:"id" json:"id"`
+	OrgID           string    `db:"org_id" json:"org_id"`
+	NameCiphertext  []byte    `db:"name_ciphertext" json:"name_ciphertext"`
+	EmailCiphertext []byte    `db:"email_ciphertext" json:"email_ciphertext"`
+	EmailIndex      []byte    `db:"email_index" json:"email_index"`
+	PiiKeyID        string    `db:"pii_key_id" json:"pii_key_id"`
+	PiiDataKey      []byte    `db:"pii_data_key" json:"pii_data_key"`
+	CreatedAt       time.Time `db:"created_at" json:"created_at"`
+	UpdatedAt       time.Time `db:"updated_at" json:"updated_at"`
 }
 
 func (q *Queries) CreateUser(ctx context.Context, arg CreateUserParams) error {
 	_, err := q.db.ExecContext(ctx, createUser,
 		arg.ID,
-		arg.Name,
-		arg.Email,
+		arg.OrgID,
+		arg.NameCiphertext,
+		arg.EmailCiphertext,
+		arg.EmailIndex,
+		arg.PiiKeyID,
+		arg.PiiDataKey,
 		arg.CreatedAt,
 		arg.UpdatedAt,
 	)
@@ -46,27 +62,43 @@ func (q *Queries) CreateUser(ctx context.Context, arg CreateUserParams) error {
 }
 
 const deleteUser = `-- name: DeleteUser :exec
-DELETE FROM users WHERE id = $1
+DELETE FROM users WHERE org_id = $1 AND id = $2
 `
 
-func (q *Queries) DeleteUser(ctx context.Context, id string) error {
-	_, err := q.db.ExecContext(ctx, deleteUser, id)
+type DeleteUserParams struct {
+	OrgID string `db:"org_id" json:"org_id"`
+	ID    string `db:"id" json:"id"`
+}
+
+func (q *Queries) DeleteUser(ctx context.Context, arg DeleteUserParams) error {
+	_, err := q.db.ExecContext(ctx, deleteUser, arg.OrgID, arg.ID)
 	return err
 }
 
 const getUserByEmail = `-- name: GetUserByEmail :one
-SELECT id, name, email, created_at, updated_at
+SELECT id, org_id, name_ciphertext, email_ciphertext, email_index, pii_key_id, pii_data_key, status, email_verified_at, created_at, updated_at
 FROM users
-WHERE email = $1
+WHERE org_id = $1 AND email_index = $2
 `
 
-func (q *Queries) GetUserByEmail(ctx context.Context, email string) (User, error) {
-	row := q.db.QueryRowContext(ctx, getUserByEmail, email)
+type GetUserByEmailParams struct {
+	OrgID      string `db:"org_id" json:"org_id"`
+	EmailIndex []byte `db:"email_index" json:"email_index"`
+}
+
+func (q *Queries) GetUserByEmail(ctx context.Context, arg GetUserByEmailParams) (User, error) {
+	row := q.db.QueryRowContext(ctx, getUserByEmail, arg.OrgID, arg.EmailIndex)
 	var i User
 	err := row.Scan(
 		&i.ID,
-		&i.Name,
-		&i.Email,
+		&i.OrgID,
+		&i.NameCiphertext,
+		&i.EmailCiphertext,
+		&i.EmailIndex,
+		&i.PiiKeyID,
+		&i.PiiDataKey,
+		&i.Status,
+		&i.EmailVerifiedAt,
 		&i.CreatedAt,
 		&i.UpdatedAt,
 	)
@@ -74,19 +106,30 @@ func (q *Queries) GetUserByEmail(ctx context.Context, email string) (User, error
 }
 
 const getUserByEmailForUpdate = `-- name: GetUserByEmailForUpdate :one
-SELECT id, name, email, created_at, updated_at
+SELECT id, org_id, name_ciphertext, email_ciphertext, email_index, pii_key_id, pii_data_key, status, email_verified_at, created_at, updated_at
 FROM users
-WHERE email = $1
+WHERE org_id = $1 AND email_index = $2
 FOR UPDATE
 `
 
-func (q *Queries) GetUserByEmailForUpdate(ctx context.Context, email string) (User, error) {
-	row := q.db.QueryRowContext(ctx, getUserByEmailForUpdate, email)
+type GetUserByEmailForUpdateParams struct {
+	OrgID      string `db:"org_id" json:"org_id"`
+	EmailIndex []byte `db:"email_index" json:"email_index"`
+}
+
+func (q *Queries) GetUserByEmailForUpdate(ctx context.Context, arg GetUserByEmailForUpdateParams) (User, error) {
+	row := q.db.QueryRowContext(ctx, getUserByEmailForUpdate, arg.OrgID, arg.EmailIndex)
 	var i User
 	err := row.Scan(
 		&i.ID,
-		&i.Name,
-		&i.Email,
+		&i.OrgID,
+		&i.NameCiphertext,
+		&i.EmailCiphertext,
+		&i.EmailIndex,
+		&i.PiiKeyID,
+		&i.PiiDataKey,
+		&i.Status,
+		&i.EmailVerifiedAt,
 		&i.CreatedAt,
 		&i.UpdatedAt,
 	)
@@ -94,18 +137,29 @@ func (q *Queries) GetUserByEmailForUpdate(ctx context.Context, email string) (Us
 }
 
 const getUserByID = `-- name: GetUserByID :one
-SELECT id, name, email, created_at, updated_at
+SELECT id, org_id, name_ciphertext, email_ciphertext, email_index, pii_key_id, pii_data_key, status, email_verified_at, created_at, updated_at
 FROM users
-WHERE id = $1
+WHERE org_id = $1 AND id = $2
 `
 
-func (q *Queries) GetUserByID(ctx context.Context, id string) (User, error) {
-	row := q.db.QueryRowContext(ctx, getUserByID, id)
+type GetUserByIDParams struct {
+	OrgID string `db:"org_id" json:"org_id"`
+	ID    string `db:"id" json:"id"`
+}
+
+func (q *Queries) GetUserByID(ctx context.Context, arg GetUserByIDParams) (User, error) {
+	row := q.db.QueryRowContext(ctx, getUserByID, arg.OrgID, arg.ID)
 	var i User
 	err := row.Scan(
 		&i.ID,
-		&i.Name,
-		&i.Email,
+		&i.OrgID,
+		&i.NameCiphertext,
+		&i.EmailCiphertext,
+		&i.EmailIndex,
+		&i.PiiKeyID,
+		&i.PiiDataKey,
+		&i.Status,
+		&i.EmailVerifiedAt,
 		&i.CreatedAt,
 		&i.UpdatedAt,
 	)
@@ -113,39 +167,105 @@ func (q *Queries) GetUserByID(ctx context.Context, id string) (User, error) {
 }
 
 const getUserByIDForUpdate = `-- name: GetUserByIDForUpdate :one
-SELECT id, name, email, created_at, updated_at
+SELECT id, org_id, name_ciphertext, email_ciphertext, email_index, pii_key_id, pii_data_key, status, email_verified_at, created_at, updated_at
 FROM users
-WHERE id = $1
+WHERE org_id = $1 AND id = $2
 FOR UPDATE
 `
 
-func (q *Queries) GetUserByIDForUpdate(ctx context.Context, id string) (User, error) {
-	row := q.db.QueryRowContext(ctx, getUserByIDForUpdate, id)
+type GetUserByIDForUpdateParams struct {
+	OrgID string `db:"org_id" json:"org_id"`
+	ID    string `db:"id" json:"id"`
+}
+
+func (q *Queries) GetUserByIDForUpdate(ctx context.Context, arg GetUserByIDForUpdateParams) (User, error) {
+	row := q.db.QueryRowContext(ctx, getUserByIDForUpdate, arg.OrgID, arg.ID)
 	var i User
 	err := row.Scan(
 		&i.ID,
-		&i.Name,
-		&i.Email,
+		&i.OrgID,
+		&i.NameCiphertext,
+		&i.EmailCiphertext,
+		&i.EmailIndex,
+		&i.PiiKeyID,
+		&i.PiiDataKey,
+		&i.Status,
+		&i.EmailVerifiedAt,
 		&i.CreatedAt,
 		&i.UpdatedAt,
 	)
 	return i, err
 }
 
+const listUserDataKeysForUpdate = `-- name: ListUserDataKeysForUpdate :many
+-- 主鍵以外の鍵で暗号化したデータキーを取得する（他のトランザクションがロック中の行は飛ばす）
+SELECT id, pii_key_id, pii_data_key
+FROM users
+WHERE org_id = $1 AND pii_key_id <> $2
+ORDER BY id
+LIMIT $3
+FOR UPDATE SKIP LOCKED
+`
+
+type ListUserDataKeysForUpdateParams struct {
+	OrgID    string `db:"org_id" json:"org_id"`
+	PiiKeyID string `db:"pii_key_id" json:"pii_key_id"`
+	Limit    int32  `db:"limit" json:"limit"`
+}
+
+type ListUserDataKeysForUpdateRow struct {
+	ID         string `db:"id" json:"id"`
+	PiiKeyID   string `db:"pii_key_id" json:"pii_key_id"`
+	PiiDataKey []byte `db:"pii_data_key" json:"pii_data_key"`
+}
+
+// 主鍵以外の鍵で暗号化したデータキーを取得する（他のトランザクションがロック中の行は飛ばす）
+func (q *Queries) ListUserDataKeysForUpdate(ctx context.Context, arg ListUserDataKeysForUpdateParams) ([]ListUserDataKeysForUpdateRow, error) {
+	rows, err := q.db.QueryContext(ctx, listUserDataKeysForUpdate, arg.OrgID, arg.PiiKeyID, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListUserDataKeysForUpdateRow{}
+	for rows.Next() {
+		var i ListUserDataKeysForUpdateRow
+		if err := rows.Scan(&i.ID, &i.PiiKeyID, &i.PiiDataKey); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listUsers = `-- name: ListUsers :many
-SELECT id, name, email, created_at, updated_at
+SELECT id, org_id, name_ciphertext, email_ciphertext, email_index, pii_key_id, pii_data_key, status, email_verified_at, created_at, updated_at
 FROM users
+WHERE org_id = $1
+  AND ($2::text IS NULL OR status = $2)
 ORDER BY created_at DESC
-LIMIT $1 OFFSET $2
+LIMIT $3 OFFSET $4
 `
 
 type ListUsersParams struct {
-	Limit  int32 `db:"limit" json:"limit"`
-	Offset int32 `db:"offset" json:"offset"`
+	OrgID  string         `db:"org_id" json:"org_id"`
+	Status sql.NullString `db:"status" json:"status"`
+	Limit  int32          `db:"limit" json:"limit"`
+	Offset int32          `db:"offset" json:"offset"`
 }
 
 func (q *Queries) ListUsers(ctx context.Context, arg ListUsersParams) ([]User, error) {
-	rows, err := q.db.QueryContext(ctx, listUsers, arg.Limit, arg.Offset)
+	rows, err := q.db.QueryContext(ctx, listUsers,
+		arg.OrgID,
+		arg.Status,
+		arg.Limit,
+		arg.Offset,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -155,8 +275,14 @@ func (q *Queries) ListUsers(ctx context.Context, arg ListUsersParams) ([]User, e
 		var i User
 		if err := rows.Scan(
 			&i.ID,
-			&i.Name,
-			&i.Email,
+			&i.OrgID,
+			&i.NameCiphertext,
+			&i.EmailCiphertext,
+			&i.EmailIndex,
+			&i.PiiKeyID,
+			&i.PiiDataKey,
+			&i.Status,
+			&i.EmailVerifiedAt,
 			&i.CreatedAt,
 			&i.UpdatedAt,
 		); err != nil {
@@ -173,53 +299,121 @@ func (q *Queries) ListUsers(ctx context.Context, arg ListUsersParams) ([]User, e
 	return items, nil
 }
 
+const notifyUserChanged = `-- name: NotifyUserChanged :exec
+SELECT pg_notify($1::TEXT, $2::TEXT)
+`
+
+type NotifyUserChangedParams struct {
+	Channel string `db:"channel" json:"channel"`
+	UserID  string `db:"user_id" json:"user_id"`
+}
+
+// ユーザーの変更をコミット時に各レプリカの読み取りキャッシュへ通知する（ロールバック時は破棄される）
+func (q *Queries) NotifyUserChanged(ctx context.Context, arg NotifyUserChangedParams) error {
+	_, err := q.db.ExecContext(ctx, notifyUserChanged, arg.Channel, arg.UserID)
+	return err
+}
+
 const updateUser = `-- name: UpdateUser :exec
 UPDATE users
-SET name = $1, email = $2, updated_at = $3
-WHERE id = $4
+SET name_ciphertext = $1, email_ciphertext = $2, email_index = $3, pii_key_id = $4, pii_data_key = $5, updated_at = $6
+WHERE org_id = $7 AND id = $8
 `
 
 type UpdateUserParams struct {
-	Name      string    `db:"name" json:"name"`
-	Email     string    `db:"email" json:"email"`
-	UpdatedAt time.Time `db:"updated_at" json:"updated_at"`
-	ID        string    `db:"id" json:"id"`
+	NameCiphertext  []byte    `db:"name_ciphertext" json:"name_ciphertext"`
+	EmailCiphertext []byte    `db:"email_ciphertext" json:"email_ciphertext"`
+	EmailIndex      []byte    `db:"email_index" json:"email_index"`
+	PiiKeyID        string    `db:"pii_key_id" json:"pii_key_id"`
+	PiiDataKey      []byte    `db:"pii_data_key" json:"pii_data_key"`
+	UpdatedAt       time.Time `db:"updated_at" json:"updated_at"`
+	OrgID           string    `db:"org_id" json:"org_id"`
+	ID              string    `db:"id" json:"id"`
 }
 
 func (q *Queries) UpdateUser(ctx context.Context, arg UpdateUserParams) error {
 	_, err := q.db.ExecContext(ctx, updateUser,
-		arg.Name,
-		arg.Email,
+		arg.NameCiphertext,
+		arg.EmailCiphertext,
+		arg.EmailIndex,
+		arg.PiiKeyID,
+		arg.PiiDataKey,
 		arg.UpdatedAt,
+		arg.OrgID,
+		arg.ID,
+	)
+	return err
+}
+
+const updateUserDataKey = `-- name: UpdateUserDataKey :exec
+UPDATE users
+SET pii_key_id = $1, pii_data_key = $2
+WHERE org_id = $3 AND id = $4
+`
+
+type UpdateUserDataKeyParams struct {
+	PiiKeyID   string `db:"pii_key_id" json:"pii_key_id"`
+	PiiDataKey []byte `db:"pii_data_key" json:"pii_data_key"`
+	OrgID      string `db:"org_id" json:"org_id"`
+	ID         string `db:"id" json:"id"`
+}
+
+func (q *Queries) UpdateUserDataKey(ctx context.Context, arg UpdateUserDataKeyParams) error {
+	_, err := q.db.ExecContext(ctx, updateUserDataKey,
+		arg.PiiKeyID,
+		arg.PiiDataKey,
+		arg.OrgID,
 		arg.ID,
 	)
 	return err
 }
 
-const upsertUser = `-- name: UpsertUser :exec
-INSERT INTO users (id, name, email, created_at, updated_at)
-VALUES ($1, $2, $3, $4, $5)
+const upsertUser = `-- name: UpsertUser :execrows
+INSERT INTO users (id, org_id, name_ciphertext, email_ciphertext, email_index, pii_key_id, pii_data_key, status, email_verified_at, created_at, updated_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
 ON CONFLICT (id) DO UPDATE SET
-    name = EXCLUDED.name,
-    email = EXCLUDED.email,
+    name_ciphertext = EXCLUDED.name_ciphertext,
+    email_ciphertext = EXCLUDED.email_ciphertext,
+    email_index = EXCLUDED.email_index,
+    pii_key_id = EXCLUDED.pii_key_id,
+    pii_data_key = EXCLUDED.pii_data_key,
+    status = EXCLUDED.status,
+    email_verified_at = EXCLUDED.email_verified_at,
     updated_at = EXCLUDED.updated_at
+WHERE users.org_id = EXCLUDED.org_id
 `
 
 type UpsertUserParams struct {
-	ID        string    `db:"id" json:"id"`
-	Name      string    `db:"name" json:"name"`
-	Email     string    `db:"email" json:"email"`
-	CreatedAt time.Time `db:"created_at" json:"created_at"`
-	UpdatedAt time.Time `db:"updated_at" json:"updated_at"`
+	ID              string       `db:"id" json:"id"`
+	OrgID           string       `db:"org_id" json:"org_id"`
+	NameCiphertext  []byte       `db:"name_ciphertext" json:"name_ciphertext"`
+	EmailCiphertext []byte       `db:"email_ciphertext" json:"email_ciphertext"`
+	EmailIndex      []byte       `db:"email_index" json:"email_index"`
+	PiiKeyID        string       `db:"pii_key_id" json:"pii_key_id"`
+	PiiDataKey      []byte       `db:"pii_data_key" json:"pii_data_key"`
+	Status          string       `db:"status" json:"status"`
+	EmailVerifiedAt sql.NullTime `db:"email_verified_at" json:"email_verified_at"`
+	CreatedAt       time.Time    `db:"created_at" json:"created_at"`
+	UpdatedAt       time.Time    `db:"updated_at" json:"updated_at"`
 }
 
-func (q *Queries) UpsertUser(ctx context.Context, arg UpsertUserParams) error {
-	_, err := q.db.ExecContext(ctx, upsertUser,
+// 他の組織の同じIDのユーザーは更新しない（影響行数が 0 になる）
+func (q *Queries) UpsertUser(ctx context.Context, arg UpsertUserParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, upsertUser,
 		arg.ID,
-		arg.Name,
-		arg.Email,
+		arg.OrgID,
+		arg.NameCiphertext,
+		arg.EmailCiphertext,
+		arg.EmailIndex,
+		arg.PiiKeyID,
+		arg.PiiDataKey,
+		arg.Status,
+		arg.EmailVerifiedAt,
 		arg.CreatedAt,
 		arg.UpdatedAt,
 	)
-	return err
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
 }
@@ -7,6 +7,8 @@ import (
 	"time"
 
 	_ "github.com/lib/pq"
+
+	"github.com/example/go-react-cqrs-template/internal/tenant"
 )
 
 // DBTX は *sql.DB と *sql.Tx の共通インターフェース
@@ -25,16 +27,26 @@ type Config struct {
 	Password string
 	DBName   string
 	SSLMode  string
+	// SearchPath は接続ごとの search_path（空の場合はサーバーのデフォルト）
+	SearchPath string
 }
 
-// NewDB データベース接続を作成
-func NewDB(cfg Config) (*sql.DB, error) {
+// DSN lib/pq の接続文字列を返す
+func (cfg Config) DSN() string {
 	dsn := fmt.Sprintf(
 		"host=%s port=%d user=%s password=%s dbname=%s sslmode=%s",
 		cfg.Host, cfg.Port, cfg.User, cfg.Password, cfg.DBName, cfg.SSLMode,
 	)
+	if cfg.SearchPath != "" {
+		// lib/pq は未知のパラメータを実行時パラメータとしてサーバーに渡す
+		dsn += " search_path=" + cfg.SearchPath
+	}
+	return dsn
+}
 
-	db, err := sql.Open("postgres", dsn)
+// NewDB データベース接続を作成
+func NewDB(cfg Config) (*sql.DB, error) {
+	db, err := sql.Open("postgres", cfg.DSN())
 	if err != nil {
 		return nil, fmt.Errorf("failed to open database: %w", err)
 	}
@@ -63,12 +75,24 @@ func NewTransactionManager(db *sql.DB) *TransactionManager {
 }
 
 // RunInTransaction トランザクション内で処理を実行
+//
+// fn の中で AfterCommit に登録した処理は、コミットに成功した後に実行する。
+// 行レベルセキュリティ（db/rls）が参照する app.org_id をトランザクションの間だけ設定する（SetTenant）。
 func (tm *TransactionManager) RunInTransaction(ctx context.Context, fn func(ctx context.Context, tx DBTX) error) error {
 	tx, err := tm.db.BeginTx(ctx, nil)
 	if err != nil {
 		return fmt.Errorf("failed to begin transaction: %w", err)
 	}
 
+	if err := SetTenant(ctx, tx); err != nil {
+		if rbErr := tx.Rollback(); rbErr != nil {
+			return fmt.Errorf("failed to rollback: %v (original error: %w)", rbErr, err)
+		}
+		return err
+	}
+
+	ctx, hooks := NewCommitHooks(ctx)
+
 	if err := fn(ctx, tx); err != nil {
 		if rbErr := tx.Rollback(); rbErr != nil {
 			return fmt.Errorf("failed to rollback: %v (original error: %w)", rbErr, err)
@@ -80,5 +104,50 @@ func (tm *TransactionManager) RunInTransaction(ctx context.Context, fn func(ctx
 		return fmt.Errorf("failed to commit transaction: %w", err)
 	}
 
+	hooks.Run()
+	return nil
+}
+
+// RunReadOnly 読み取り専用のトランザクション内で読み取りを実行
+//
+// RunInTransaction と同じく app.org_id を設定するため、行レベルセキュリティ（db/rls）を適用しても
+// コンテキストのテナントの行を読み取れる。クエリサービスなどトランザクション外の読み取りに使う。
+func RunReadOnly(ctx context.Context, db *sql.DB, fn func(tx DBTX) error) error {
+	tx, err := db.BeginTx(ctx, &sql.TxOptions{ReadOnly: true})
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if err := SetTenant(ctx, tx); err != nil {
+		return err
+	}
+	if err := fn(tx); err != nil {
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+// AllOrgsSetting 組織をまたぐシステム処理（tenant.WithAllOrgs）の app.org_id
+const AllOrgsSetting = "*"
+
+// SetTenant 行レベルセキュリティ（db/rls）が参照する app.org_id をトランザクション tx の間だけ設定する
+//
+// コンテキストのテナント（tenant.FromContext）の組織ID、組織をまたぐシステム処理（tenant.WithAllOrgs）の場合は
+// AllOrgsSetting を設定する。どちらでもない場合は設定せず、行レベルセキュリティを適用していれば行を返さない。
+func SetTenant(ctx context.Context, tx DBTX) error {
+	orgID, ok := tenant.FromContext(ctx)
+	if !ok && tenant.AllOrgs(ctx) {
+		orgID, ok = AllOrgsSetting, true
+	}
+	if !ok {
+		return nil
+	}
+	if _, err := tx.ExecContext(ctx, "SELECT set_config('app.org_id', $1, true)", orgID); err != nil {
+		return fmt.Errorf("failed to set tenant: %w", err)
+	}
 	return nil
 }
@@ -0,0 +1,290 @@
+package infrastructure_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/example/go-react-cqrs-template/internal/infrastructure"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure/dao"
+	"github.com/example/go-react-cqrs-template/internal/tenant"
+	"github.com/example/go-react-cqrs-template/internal/testutil/dbtest"
+)
+
+// newUserParams ユーザーの作成パラメータ（DAO のテストでは暗号化せず、メールアドレスをそのままインデックスにする）
+func newUserParams(id, email string) dao.CreateUserParams {
+	now := time.Now().UTC().Truncate(time.Microsecond)
+	return dao.CreateUserParams{
+		ID:              id,
+		OrgID:           tenant.DefaultOrgID,
+		NameCiphertext:  []byte("John Doe"),
+		EmailCiphertext: []byte(email),
+		EmailIndex:      []byte(email),
+		PiiKeyID:        "test",
+		PiiDataKey:      []byte("data-key"),
+		CreatedAt:       now,
+		UpdatedAt:       now,
+	}
+}
+
+func TestTransactionManager_Commit(t *testing.T) {
+	db := dbtest.New(t)
+	ctx := context.Background()
+	tm := infrastructure.NewTransactionManager(db)
+	committed := false
+
+	err := tm.RunInTransaction(ctx, func(ctx context.Context, tx infrastructure.DBTX) error {
+		infrastructure.AfterCommit(ctx, func() { committed = true })
+		if committed {
+			t.Error("AfterCommit hook ran before commit")
+		}
+		return dao.New(tx).CreateUser(ctx, newUserParams("01ARZ3NDEKTSV4RRFFQ69G5FAV", "john@example.com"))
+	})
+	if err != nil {
+		t.Fatalf("RunInTransaction() unexpected error: %v", err)
+	}
+	if !committed {
+		t.Error("AfterCommit hook did not run after commit")
+	}
+
+	got, err := dao.New(db).GetUserByID(ctx, dao.GetUserByIDParams{OrgID: tenant.DefaultOrgID, ID: "01ARZ3NDEKTSV4RRFFQ69G5FAV"})
+	if err != nil {
+		t.Fatalf("GetUserByID() unexpected error: %v", err)
+	}
+	if string(got.EmailIndex) != "john@example.com" {
+		t.Errorf("EmailIndex = %s, want %v", got.EmailIndex, "john@example.com")
+	}
+}
+
+func TestTransactionManager_Rollback(t *testing.T) {
+	db := dbtest.New(t)
+	ctx := context.Background()
+	tm := infrastructure.NewTransactionManager(db)
+	errBoom := errors.New("boom")
+
+	err := tm.RunInTransaction(ctx, func(ctx context.Context, tx infrastructure.DBTX) error {
+		infrastructure.AfterCommit(ctx, func() { t.Error("AfterCommit hook ran after rollback") })
+		if err := dao.New(tx).CreateUser(ctx, newUserParams("01ARZ3NDEKTSV4RRFFQ69G5FAV", "john@example.com")); err != nil {
+			return err
+		}
+		return errBoom
+	})
+	if !errors.Is(err, errBoom) {
+		t.Fatalf("RunInTransaction() error = %v, want %v", err, errBoom)
+	}
+
+	_, err = dao.New(db).GetUserByID(ctx, dao.GetUserByIDParams{OrgID: tenant.DefaultOrgID, ID: "01ARZ3NDEKTSV4RRFFQ69G5FAV"})
+	if !errors.Is(err, sql.ErrNoRows) {
+		t.Errorf("GetUserByID() error = %v, want %v", err, sql.ErrNoRows)
+	}
+}
+
+func TestAfterCommit_OutsideTransaction(t *testing.T) {
+	ran := false
+	infrastructure.AfterCommit(context.Background(), func() { ran = true })
+	if !ran {
+		t.Error("AfterCommit outside a transaction should run immediately")
+	}
+}
+
+func TestDAO_UniqueEmail(t *testing.T) {
+	db := dbtest.New(t)
+	ctx := context.Background()
+	queries := dao.New(db)
+
+	if err := queries.CreateUser(ctx, newUserParams("01ARZ3NDEKTSV4RRFFQ69G5FAV", "john@example.com")); err != nil {
+		t.Fatalf("CreateUser() unexpected error: %v", err)
+	}
+	if err := queries.CreateUser(ctx, newUserParams("01BX5ZZKBKACTAV9WEVGEMMVRZ", "john@example.com")); err == nil {
+		t.Error("CreateUser() with duplicate email should fail")
+	}
+}
+
+func TestDAO_UniqueEmailPerOrganization(t *testing.T) {
+	db := dbtest.New(t)
+	ctx := context.Background()
+	queries := dao.New(db)
+	now := time.Now().UTC().Truncate(time.Microsecond)
+
+	const otherOrgID = "01HZZZZZZZZZZZZZZZZZZZZZZZ"
+	if err := queries.UpsertOrganization(ctx, dao.UpsertOrganizationParams{
+		ID:        otherOrgID,
+		Name:      "Other",
+		CreatedAt: now,
+		UpdatedAt: now,
+	}); err != nil {
+		t.Fatalf("UpsertOrganization() unexpected error: %v", err)
+	}
+
+	if err := queries.CreateUser(ctx, newUserParams("01ARZ3NDEKTSV4RRFFQ69G5FAV", "john@example.com")); err != nil {
+		t.Fatalf("CreateUser() unexpected error: %v", err)
+	}
+	other := newUserParams("01BX5ZZKBKACTAV9WEVGEMMVRZ", "john@example.com")
+	other.OrgID = otherOrgID
+	if err := queries.CreateUser(ctx, other); err != nil {
+		t.Fatalf("CreateUser() with the same email in another organization unexpected error: %v", err)
+	}
+
+	if _, err := queries.GetUserByID(ctx, dao.GetUserByIDParams{OrgID: tenant.DefaultOrgID, ID: other.ID}); !errors.Is(err, sql.ErrNoRows) {
+		t.Errorf("GetUserByID() of another organization error = %v, want %v", err, sql.ErrNoRows)
+	}
+	count, err := queries.CountUsers(ctx, dao.CountUsersParams{OrgID: otherOrgID})
+	if err != nil {
+		t.Fatalf("CountUsers() unexpected error: %v", err)
+	}
+	if count != 1 {
+		t.Errorf("CountUsers() = %d, want 1", count)
+	}
+}
+
+func TestDAO_ListUsersByStatus(t *testing.T) {
+	db := dbtest.New(t)
+	ctx := context.Background()
+	queries := dao.New(db)
+
+	active := newUserParams("01ARZ3NDEKTSV4RRFFQ69G5FAV", "john@example.com")
+	if err := queries.CreateUser(ctx, active); err != nil {
+		t.Fatalf("CreateUser() unexpected error: %v", err)
+	}
+	suspended := newUserParams("01BX5ZZKBKACTAV9WEVGEMMVRZ", "jane@example.com")
+	if _, err := queries.UpsertUser(ctx, dao.UpsertUserParams{
+		ID:              suspended.ID,
+		OrgID:           suspended.OrgID,
+		NameCiphertext:  suspended.NameCiphertext,
+		EmailCiphertext: suspended.EmailCiphertext,
+		EmailIndex:      suspended.EmailIndex,
+		PiiKeyID:        suspended.PiiKeyID,
+		PiiDataKey:      suspended.PiiDataKey,
+		Status:          "suspended",
+		CreatedAt:       suspended.CreatedAt,
+		UpdatedAt:       suspended.UpdatedAt,
+	}); err != nil {
+		t.Fatalf("UpsertUser() unexpected error: %v", err)
+	}
+
+	// status を省略した場合は既定の active になる
+	got, err := queries.GetUserByID(ctx, dao.GetUserByIDParams{OrgID: tenant.DefaultOrgID, ID: active.ID})
+	if err != nil {
+		t.Fatalf("GetUserByID() unexpected error: %v", err)
+	}
+	if got.Status != "active" {
+		t.Errorf("GetUserByID().Status = %s, want active", got.Status)
+	}
+
+	users, err := queries.ListUsers(ctx, dao.ListUsersParams{
+		OrgID:  tenant.DefaultOrgID,
+		Status: sql.NullString{String: "suspended", Valid: true},
+		Limit:  10,
+	})
+	if err != nil {
+		t.Fatalf("ListUsers() unexpected error: %v", err)
+	}
+	if len(users) != 1 || users[0].ID != suspended.ID {
+		t.Errorf("ListUsers(suspended) = %+v, want only %s", users, suspended.ID)
+	}
+	count, err := queries.CountUsers(ctx, dao.CountUsersParams{OrgID: tenant.DefaultOrgID})
+	if err != nil {
+		t.Fatalf("CountUsers() unexpected error: %v", err)
+	}
+	if count != 2 {
+		t.Errorf("CountUsers() without status = %d, want 2", count)
+	}
+}
+
+func TestDAO_GroupMembers(t *testing.T) {
+	db := dbtest.New(t)
+	ctx := context.Background()
+	queries := dao.New(db)
+	now := time.Now().UTC().Truncate(time.Microsecond)
+
+	user := newUserParams("01ARZ3NDEKTSV4RRFFQ69G5FAV", "john@example.com")
+	if err := queries.CreateUser(ctx, user); err != nil {
+		t.Fatalf("CreateUser() unexpected error: %v", err)
+	}
+	const groupID = "01BX5ZZKBKACTAV9WEVGEMMVRZ"
+	if err := queries.UpsertGroup(ctx, dao.UpsertGroupParams{
+		ID:        groupID,
+		OrgID:     tenant.DefaultOrgID,
+		Name:      "Engineering",
+		CreatedAt: now,
+		UpdatedAt: now,
+	}); err != nil {
+		t.Fatalf("UpsertGroup() unexpected error: %v", err)
+	}
+	if err := queries.UpsertGroupMember(ctx, dao.UpsertGroupMemberParams{
+		GroupID:   groupID,
+		UserID:    user.ID,
+		OrgID:     tenant.DefaultOrgID,
+		Role:      "owner",
+		CreatedAt: now,
+		UpdatedAt: now,
+	}); err != nil {
+		t.Fatalf("UpsertGroupMember() unexpected error: %v", err)
+	}
+
+	groups, err := queries.ListGroupsByUserID(ctx, dao.ListGroupsByUserIDParams{OrgID: tenant.DefaultOrgID, UserID: user.ID})
+	if err != nil {
+		t.Fatalf("ListGroupsByUserID() unexpected error: %v", err)
+	}
+	if len(groups) != 1 || groups[0].ID != groupID || groups[0].Role != "owner" {
+		t.Errorf("ListGroupsByUserID() = %+v, want %s as owner", groups, groupID)
+	}
+
+	// ユーザーを削除するとメンバーシップも削除される
+	if err := queries.DeleteUser(ctx, dao.DeleteUserParams{OrgID: tenant.DefaultOrgID, ID: user.ID}); err != nil {
+		t.Fatalf("DeleteUser() unexpected error: %v", err)
+	}
+	count, err := queries.CountGroupMembers(ctx, dao.CountGroupMembersParams{OrgID: tenant.DefaultOrgID, GroupID: groupID})
+	if err != nil {
+		t.Fatalf("CountGroupMembers() unexpected error: %v", err)
+	}
+	if count != 0 {
+		t.Errorf("CountGroupMembers() = %d, want 0", count)
+	}
+}
+
+func TestDAO_UserLogs(t *testing.T) {
+	db := dbtest.New(t)
+	ctx := context.Background()
+	queries := dao.New(db)
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	for i, action := range []string{"created", "deleted"} {
+		err := queries.CreateUserLog(ctx, dao.CreateUserLogParams{
+			ID:        []string{"01ARZ3NDEKTSV4RRFFQ69G5FA1", "01ARZ3NDEKTSV4RRFFQ69G5FA2"}[i],
+			OrgID:     tenant.DefaultOrgID,
+			UserID:    "01ARZ3NDEKTSV4RRFFQ69G5FAV",
+			Action:    action,
+			CreatedAt: base.Add(time.Duration(i) * time.Minute),
+		})
+		if err != nil {
+			t.Fatalf("CreateUserLog() unexpected error: %v", err)
+		}
+	}
+
+	logs, err := queries.GetUserLogsByUserID(ctx, dao.GetUserLogsByUserIDParams{
+		OrgID:  tenant.DefaultOrgID,
+		UserID: "01ARZ3NDEKTSV4RRFFQ69G5FAV",
+		Limit:  10,
+		Offset: 0,
+	})
+	if err != nil {
+		t.Fatalf("GetUserLogsByUserID() unexpected error: %v", err)
+	}
+	if len(logs) != 2 || logs[0].Action != "deleted" || logs[1].Action != "created" {
+		t.Errorf("GetUserLogsByUserID() = %+v, want newest first", logs)
+	}
+
+	count, err := queries.CountUserLogsByUserID(ctx, dao.CountUserLogsByUserIDParams{
+		OrgID:  tenant.DefaultOrgID,
+		UserID: "01ARZ3NDEKTSV4RRFFQ69G5FAV",
+	})
+	if err != nil {
+		t.Fatalf("CountUserLogsByUserID() unexpected error: %v", err)
+	}
+	if count != 2 {
+		t.Errorf("CountUserLogsByUserID() = %d, want 2", count)
+	}
+}
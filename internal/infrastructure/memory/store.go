@@ -0,0 +1,2086 @@
+// Package memory はテスト用のインメモリストアを提供する
+//
+// Store は usecase.UserQueryRepository / usecase.UserCommandRepository /
+// usecase.WebhookQueryRepository / usecase.WebhookCommandRepository /
+// usecase.OrganizationQueryRepository / usecase.OrganizationCommandRepository /
+// usecase.GroupQueryRepository / usecase.GroupCommandRepository /
+// usecase.CredentialCommandRepository / usecase.TOTPCredentialCommandRepository /
+// usecase.UserTokenCommandRepository /
+// usecase.ExternalIdentityCommandRepository / usecase.OIDCLoginStateCommandRepository /
+// usecase.InvitationQueryRepository / usecase.InvitationCommandRepository /
+// usecase.SessionQueryRepository / usecase.SessionCommandRepository /
+// usecase.UserReencryptionRepository /
+// usecase.TransactionManager を一つの型で実装し、PostgreSQL を使わずに
+// ユースケースを検証できるようにする。コミット済みのイベントは eventstream.Repository として
+// 記録順に 1 から seq を振って読み出せる。ユーザー・グループとWebhook購読はコンテキストのテナント
+// （tenant.OrgID）ごとに分離し、メールアドレスの一意性も組織ごとに判定する。
+// 個人情報は暗号化せず、ユーザーごとにデータキーを暗号化した鍵のIDだけを記録して鍵のローテーションを再現する。
+// トランザクションは以下の性質を持つ:
+//   - 分離性: コミット前の書き込みは他のトランザクションやクエリから見えない
+//   - ロールバック: fn がエラーを返した場合、書き込みはすべて破棄される
+//   - 行ロック: *ForUpdate 系の取得はトランザクション終了までキーをロックする
+package memory
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/example/go-react-cqrs-template/internal/command"
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/eventstream"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure"
+	"github.com/example/go-react-cqrs-template/internal/outbox"
+	"github.com/example/go-react-cqrs-template/internal/tenant"
+	"github.com/example/go-react-cqrs-template/internal/usecase"
+)
+
+// コンパイル時にインターフェースの実装を検証
+var (
+	_ usecase.UserQueryRepository               = (*Store)(nil)
+	_ usecase.UserCommandRepository             = (*Store)(nil)
+	_ usecase.WebhookQueryRepository            = (*Store)(nil)
+	_ usecase.WebhookCommandRepository          = (*Store)(nil)
+	_ usecase.OrganizationQueryRepository       = (*Store)(nil)
+	_ usecase.OrganizationCommandRepository     = (*Store)(nil)
+	_ usecase.GroupQueryRepository              = (*Store)(nil)
+	_ usecase.GroupCommandRepository            = (*Store)(nil)
+	_ usecase.CredentialCommandRepository       = (*Store)(nil)
+	_ usecase.TOTPCredentialCommandRepository   = (*Store)(nil)
+	_ usecase.UserTokenCommandRepository        = (*Store)(nil)
+	_ usecase.ExternalIdentityCommandRepository = (*Store)(nil)
+	_ usecase.OIDCLoginStateCommandRepository   = (*Store)(nil)
+	_ usecase.InvitationQueryRepository         = (*Store)(nil)
+	_ usecase.InvitationCommandRepository       = (*Store)(nil)
+	_ usecase.SessionQueryRepository            = (*Store)(nil)
+	_ usecase.SessionCommandRepository          = (*Store)(nil)
+	_ usecase.UserReencryptionRepository        = (*Store)(nil)
+	_ usecase.TransactionManager                = (*Store)(nil)
+	_ eventstream.Repository                    = (*Store)(nil)
+)
+
+// ErrNotSupported はインメモリトランザクションで SQL を実行しようとした場合のエラー
+var ErrNotSupported = errors.New("memory: raw SQL is not supported")
+
+// ErrUniqueViolation はコミット時に一意制約に違反した場合のエラー
+var ErrUniqueViolation = errors.New("memory: unique constraint violation")
+
+// Store インメモリのユーザーストア
+type Store struct {
+	mu     sync.Mutex
+	users  map[string]domain.User
+	logs   []domain.UserLog
+	events []domain.UserEvent
+	locks  map[string]*rowLock
+
+	subscriptions map[string]domain.WebhookSubscription
+	deliveries    []domain.WebhookDelivery
+
+	organizations map[string]domain.Organization
+	// userOrg / subscriptionOrg は ID から所属する組織を引く（削除後もイベントの組織を引けるよう残す）
+	userOrg         map[string]string
+	subscriptionOrg map[string]string
+
+	// groupOrg はグループIDから所属する組織を引く（メンバーシップの組織はグループの組織）
+	groups       map[string]domain.Group
+	groupOrg     map[string]string
+	groupMembers map[groupMemberKey]domain.GroupMember
+
+	// credentials はユーザーIDごとの認証情報（組織はユーザーの組織）
+	credentials map[string]domain.Credential
+	// totpCredentials はユーザーIDごとの TOTP の二要素認証（組織はユーザーの組織）
+	totpCredentials map[string]domain.TOTPCredential
+	// userTokens はIDごとのメールで送るトークン（組織はユーザーの組織）
+	userTokens map[string]domain.UserToken
+	// invitations はIDごとの招待（組織は招待したユーザーの組織）
+	invitations map[string]domain.Invitation
+	// sessions はIDごとのセッション（組織はユーザーの組織）
+	sessions map[string]domain.Session
+	// externalIdentities は外部の IdP のユーザーとの紐付け
+	externalIdentities map[externalIdentityKey]domain.ExternalIdentity
+	// oidcStates はIDごとの認可リクエストの状態（oidcStateOrg で所属する組織を引く）
+	oidcStates   map[string]domain.OIDCLoginState
+	oidcStateOrg map[string]string
+
+	// piiKeyID は主鍵のID、userKeyIDs はユーザーIDごとのデータキーを暗号化した鍵のID
+	piiKeyID   string
+	userKeyIDs map[string]string
+}
+
+// initialPIIKeyID ストアの作成時の主鍵のID
+const initialPIIKeyID = "initial"
+
+// groupMemberKey メンバーシップの主キー
+type groupMemberKey struct{ groupID, userID string }
+
+// externalIdentityKey 外部の IdP のユーザーとの紐付けの主キー
+type externalIdentityKey struct{ orgID, provider, subject string }
+
+// rowLock 行ロックの保持者と解放通知
+type rowLock struct {
+	owner    *Tx
+	released chan struct{}
+}
+
+// NewStore Storeのコンストラクタ
+//
+// db/seed と同じく、既定の組織（tenant.DefaultOrgID）を登録した状態で作成する。
+func NewStore() *Store {
+	return &Store{
+		users:         make(map[string]domain.User),
+		locks:         make(map[string]*rowLock),
+		subscriptions: make(map[string]domain.WebhookSubscription),
+		organizations: map[string]domain.Organization{
+			tenant.DefaultOrgID: {ID: tenant.DefaultOrgID, Name: "Default"},
+		},
+		userOrg:         make(map[string]string),
+		subscriptionOrg: make(map[string]string),
+		groups:          make(map[string]domain.Group),
+		groupOrg:        make(map[string]string),
+		groupMembers:    make(map[groupMemberKey]domain.GroupMember),
+		credentials:     make(map[string]domain.Credential),
+		totpCredentials: make(map[string]domain.TOTPCredential),
+		userTokens:      make(map[string]domain.UserToken),
+		invitations:     make(map[string]domain.Invitation),
+		sessions:        make(map[string]domain.Session),
+
+		externalIdentities: make(map[externalIdentityKey]domain.ExternalIdentity),
+		oidcStates:         make(map[string]domain.OIDCLoginState),
+		oidcStateOrg:       make(map[string]string),
+
+		piiKeyID:   initialPIIKeyID,
+		userKeyIDs: make(map[string]string),
+	}
+}
+
+// Seed トランザクションを介さずに既定の組織へユーザーを登録する（テストの前提データ用）
+func (s *Store) Seed(users ...*domain.User) {
+	s.SeedInOrg(tenant.DefaultOrgID, users...)
+}
+
+// SeedInOrg トランザクションを介さずに組織 orgID へユーザーを登録する（テストの前提データ用）
+func (s *Store) SeedInOrg(orgID string, users ...*domain.User) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, u := range users {
+		s.users[u.ID] = u.Snapshot()
+		s.userOrg[u.ID] = orgID
+		s.userKeyIDs[u.ID] = s.piiKeyID
+	}
+}
+
+// SeedOrganizations トランザクションを介さずに組織を登録する（テストの前提データ用）
+func (s *Store) SeedOrganizations(orgs ...*domain.Organization) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, o := range orgs {
+		s.organizations[o.ID] = *o
+	}
+}
+
+// SeedGroups トランザクションを介さずに既定の組織へグループを登録する（テストの前提データ用）
+func (s *Store) SeedGroups(groups ...*domain.Group) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, g := range groups {
+		s.groups[g.ID] = *g
+		s.groupOrg[g.ID] = tenant.DefaultOrgID
+	}
+}
+
+// SeedGroupMembers トランザクションを介さずにメンバーシップを登録する（テストの前提データ用）
+func (s *Store) SeedGroupMembers(members ...*domain.GroupMember) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, m := range members {
+		s.groupMembers[groupMemberKey{m.GroupID, m.UserID}] = *m
+	}
+}
+
+// SeedCredentials トランザクションを介さずに認証情報を登録する（テストの前提データ用）
+func (s *Store) SeedCredentials(creds ...*domain.Credential) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, c := range creds {
+		s.credentials[c.UserID] = *c
+	}
+}
+
+// Credential コミット済みの認証情報を取得する（ない場合は nil）
+func (s *Store) Credential(userID string) *domain.Credential {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	c, ok := s.credentials[userID]
+	if !ok {
+		return nil
+	}
+	return &c
+}
+
+// SeedTOTPCredentials トランザクションを介さずに TOTP の二要素認証を登録する（テストの前提データ用）
+func (s *Store) SeedTOTPCredentials(creds ...*domain.TOTPCredential) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, c := range creds {
+		s.totpCredentials[c.UserID] = *c
+	}
+}
+
+// TOTPCredential コミット済みの TOTP の二要素認証を取得する（ない場合は nil）
+func (s *Store) TOTPCredential(userID string) *domain.TOTPCredential {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	c, ok := s.totpCredentials[userID]
+	if !ok {
+		return nil
+	}
+	return &c
+}
+
+// UserTokens コミット済みのユーザーのトークンを取得する（作成順）
+func (s *Store) UserTokens(userID string, purpose domain.UserTokenPurpose) []*domain.UserToken {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var result []*domain.UserToken
+	for _, t := range s.userTokens {
+		if t.UserID == userID && t.Purpose == purpose {
+			copied := t
+			result = append(result, &copied)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].ID < result[j].ID })
+	return result
+}
+
+// SeedInvitations トランザクションを介さずに招待を登録する（組織は招待したユーザーの組織）
+func (s *Store) SeedInvitations(invs ...*domain.Invitation) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, inv := range invs {
+		s.invitations[inv.ID] = *inv
+	}
+}
+
+// Invitations コミット済みのユーザーへの招待を取得する（作成順）
+func (s *Store) Invitations(userID string) []*domain.Invitation {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var result []*domain.Invitation
+	for _, inv := range s.invitations {
+		if inv.UserID == userID {
+			copied := inv
+			result = append(result, &copied)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].ID < result[j].ID })
+	return result
+}
+
+// SeedSessions トランザクションを介さずにセッションを登録する（組織はユーザーの組織）
+func (s *Store) SeedSessions(sessions ...*domain.Session) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, sess := range sessions {
+		s.sessions[sess.ID] = *sess
+	}
+}
+
+// Sessions コミット済みのユーザーのセッションを取得する（作成順）
+func (s *Store) Sessions(userID string) []*domain.Session {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var result []*domain.Session
+	for _, sess := range s.sessions {
+		if sess.UserID == userID {
+			copied := sess
+			result = append(result, &copied)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].ID < result[j].ID })
+	return result
+}
+
+// SeedExternalIdentities トランザクションを介さずに外部の IdP のユーザーとの紐付けを登録する（組織はユーザーの組織）
+func (s *Store) SeedExternalIdentities(identities ...*domain.ExternalIdentity) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, i := range identities {
+		s.externalIdentities[externalIdentityKey{s.userOrg[i.UserID], i.Provider, i.Subject}] = *i
+	}
+}
+
+// ExternalIdentities コミット済みのユーザーの外部の IdP のユーザーとの紐付けを取得する（作成順）
+func (s *Store) ExternalIdentities(userID string) []*domain.ExternalIdentity {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var result []*domain.ExternalIdentity
+	for _, i := range s.externalIdentities {
+		if i.UserID == userID {
+			copied := i
+			result = append(result, &copied)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].CreatedAt.Before(result[j].CreatedAt) })
+	return result
+}
+
+// RotatePIIKey 主鍵を keyID に切り替える（以降に保存・再暗号化するユーザーは keyID で暗号化したものとして扱う）
+func (s *Store) RotatePIIKey(keyID string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.piiKeyID = keyID
+}
+
+// PIIKeyID コミット済みのユーザーのデータキーを暗号化した鍵のIDを取得する
+func (s *Store) PIIKeyID(userID string) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.userKeyIDs[userID]
+}
+
+// UserLogs コミット済みのユーザーログを取得する（記録順）
+func (s *Store) UserLogs(userID string) []*domain.UserLog {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var result []*domain.UserLog
+	for i := range s.logs {
+		if s.logs[i].UserID == userID {
+			l := s.logs[i]
+			result = append(result, &l)
+		}
+	}
+	return result
+}
+
+// UserEvents コミット済みのドメインイベント（outbox）を取得する（記録順）
+func (s *Store) UserEvents(userID string) []domain.UserEvent {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var result []domain.UserEvent
+	for _, e := range s.events {
+		if e.User.ID == userID {
+			result = append(result, e)
+		}
+	}
+	return result
+}
+
+// ListAfter コミット済みのイベントを outbox と同じ形で取得する（seq は記録順の 1 始まり）
+func (s *Store) ListAfter(_ context.Context, afterSeq int64, limit int) ([]outbox.Event, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var result []outbox.Event
+	for i := max(afterSeq, 0); i < int64(len(s.events)) && len(result) < limit; i++ {
+		e := s.events[i]
+		payload, err := json.Marshal(command.NewUserEventPayload(e.User))
+		if err != nil {
+			return nil, err
+		}
+		orgID, ok := s.userOrg[e.User.ID]
+		if !ok {
+			orgID = tenant.DefaultOrgID
+		}
+		result = append(result, outbox.Event{
+			Seq:           i + 1,
+			ID:            e.ID,
+			OrgID:         orgID,
+			AggregateType: domain.UserAggregateType,
+			AggregateID:   e.User.ID,
+			Type:          string(e.Type),
+			Payload:       payload,
+			OccurredAt:    e.OccurredAt,
+		})
+	}
+	return result, nil
+}
+
+// Bounds コミット済みのイベントの最小・最大の seq を取得する
+func (s *Store) Bounds(context.Context) (int64, int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.events) == 0 {
+		return 0, 0, nil
+	}
+	return 1, int64(len(s.events)), nil
+}
+
+// SeedWebhookSubscriptions トランザクションを介さずに既定の組織へWebhook購読を登録する（テストの前提データ用）
+func (s *Store) SeedWebhookSubscriptions(subs ...*domain.WebhookSubscription) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, sub := range subs {
+		s.subscriptions[sub.ID] = copySubscription(sub)
+		s.subscriptionOrg[sub.ID] = tenant.DefaultOrgID
+	}
+}
+
+// SeedWebhookDeliveries トランザクションを介さずにWebhook配信を登録する（テストの前提データ用）
+func (s *Store) SeedWebhookDeliveries(deliveries ...*domain.WebhookDelivery) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, d := range deliveries {
+		s.deliveries = append(s.deliveries, *d)
+	}
+}
+
+// --- TransactionManager ---
+
+// Tx インメモリトランザクション
+//
+// infrastructure.DBTX を満たすが、SQL の実行には対応しない。
+type Tx struct {
+	store   *Store
+	users   map[string]*domain.User // nil は削除を表す
+	logs    []domain.UserLog
+	events  []domain.UserEvent
+	lockKey []string
+	done    bool
+
+	subscriptions map[string]*domain.WebhookSubscription // nil は削除を表す
+	organizations map[string]*domain.Organization
+
+	// userOrg / subscriptionOrg はトランザクション内で保存した行の組織
+	userOrg         map[string]string
+	subscriptionOrg map[string]string
+
+	groups       map[string]*domain.Group
+	groupOrg     map[string]string
+	groupMembers map[groupMemberKey]*domain.GroupMember // nil は削除を表す
+
+	credentials     map[string]*domain.Credential
+	totpCredentials map[string]*domain.TOTPCredential // nil は削除を表す
+	userTokens      map[string]*domain.UserToken
+	invitations     map[string]*domain.Invitation
+	sessions        map[string]*domain.Session
+
+	externalIdentities map[externalIdentityKey]*domain.ExternalIdentity
+	oidcStates         map[string]*domain.OIDCLoginState
+	oidcStateOrg       map[string]string
+
+	// userKeyIDs はトランザクション内で保存・再暗号化したユーザーのデータキーを暗号化した鍵のID
+	userKeyIDs map[string]string
+}
+
+// ExecContext SQL の実行は未対応
+func (t *Tx) ExecContext(context.Context, string, ...any) (sql.Result, error) {
+	return nil, ErrNotSupported
+}
+
+// PrepareContext SQL の実行は未対応
+func (t *Tx) PrepareContext(context.Context, string) (*sql.Stmt, error) {
+	return nil, ErrNotSupported
+}
+
+// QueryContext SQL の実行は未対応
+func (t *Tx) QueryContext(context.Context, string, ...any) (*sql.Rows, error) {
+	return nil, ErrNotSupported
+}
+
+// QueryRowContext SQL の実行は未対応（Scan で ErrNotSupported を返す行を返す）
+func (t *Tx) QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row {
+	return unsupportedDB.QueryRowContext(ctx, query, args...)
+}
+
+// unsupportedDB 接続が常に ErrNotSupported で失敗するデータベース
+//
+// *sql.Row はパッケージ外でエラーを設定して作れないため、接続に失敗した行を QueryRowContext で返すのに使う。
+var unsupportedDB = sql.OpenDB(unsupportedConnector{})
+
+// unsupportedConnector 接続が常に ErrNotSupported で失敗する driver.Connector
+type unsupportedConnector struct{}
+
+// Connect 接続は未対応
+func (unsupportedConnector) Connect(context.Context) (driver.Conn, error) {
+	return nil, ErrNotSupported
+}
+
+// Driver 接続が常に失敗するドライバーを返す
+func (unsupportedConnector) Driver() driver.Driver {
+	return unsupportedDriver{}
+}
+
+// unsupportedDriver 接続が常に ErrNotSupported で失敗する driver.Driver
+type unsupportedDriver struct{}
+
+// Open 接続は未対応
+func (unsupportedDriver) Open(string) (driver.Conn, error) {
+	return nil, ErrNotSupported
+}
+
+// RunInTransaction トランザクション内で処理を実行
+func (s *Store) RunInTransaction(ctx context.Context, fn func(ctx context.Context, tx infrastructure.DBTX) error) error {
+	tx := &Tx{
+		store:           s,
+		users:           make(map[string]*domain.User),
+		subscriptions:   make(map[string]*domain.WebhookSubscription),
+		organizations:   make(map[string]*domain.Organization),
+		userOrg:         make(map[string]string),
+		subscriptionOrg: make(map[string]string),
+		groups:          make(map[string]*domain.Group),
+		groupOrg:        make(map[string]string),
+		groupMembers:    make(map[groupMemberKey]*domain.GroupMember),
+		credentials:     make(map[string]*domain.Credential),
+		totpCredentials: make(map[string]*domain.TOTPCredential),
+		userTokens:      make(map[string]*domain.UserToken),
+		invitations:     make(map[string]*domain.Invitation),
+		sessions:        make(map[string]*domain.Session),
+
+		externalIdentities: make(map[externalIdentityKey]*domain.ExternalIdentity),
+		oidcStates:         make(map[string]*domain.OIDCLoginState),
+		oidcStateOrg:       make(map[string]string),
+
+		userKeyIDs: make(map[string]string),
+	}
+
+	ctx, hooks := infrastructure.NewCommitHooks(ctx)
+	if err := fn(ctx, tx); err != nil {
+		s.rollback(tx)
+		return err
+	}
+
+	if err := s.commit(tx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	hooks.Run()
+	return nil
+}
+
+// commit トランザクションの書き込みを反映し、ロックを解放する
+func (s *Store) commit(tx *Tx) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	defer s.releaseLocked(tx)
+
+	if err := s.checkUniqueLocked(tx); err != nil {
+		return err
+	}
+
+	for id, o := range tx.organizations {
+		s.organizations[id] = *o
+	}
+	for id, g := range tx.groups {
+		s.groups[id] = *g
+		s.groupOrg[id] = tx.groupOrg[id]
+	}
+	for k, m := range tx.groupMembers {
+		if m == nil {
+			delete(s.groupMembers, k)
+			continue
+		}
+		s.groupMembers[k] = *m
+	}
+	for id, c := range tx.credentials {
+		s.credentials[id] = *c
+	}
+	for id, c := range tx.totpCredentials {
+		if c == nil {
+			delete(s.totpCredentials, id)
+			continue
+		}
+		s.totpCredentials[id] = *c
+	}
+	for id, t := range tx.userTokens {
+		s.userTokens[id] = *t
+	}
+	for id, inv := range tx.invitations {
+		s.invitations[id] = *inv
+	}
+	for id, sess := range tx.sessions {
+		s.sessions[id] = *sess
+	}
+	for k, i := range tx.externalIdentities {
+		s.externalIdentities[k] = *i
+	}
+	for id, st := range tx.oidcStates {
+		s.oidcStates[id] = *st
+		s.oidcStateOrg[id] = tx.oidcStateOrg[id]
+	}
+	for id, u := range tx.users {
+		if u == nil {
+			delete(s.users, id)
+			delete(s.credentials, id)
+			delete(s.totpCredentials, id)
+			s.deleteUserTokensLocked(id)
+			s.deleteInvitationsLocked(id)
+			s.deleteSessionsLocked(id)
+			s.deleteExternalIdentitiesLocked(id)
+			s.deleteGroupMembersLocked(id)
+			delete(s.userKeyIDs, id)
+			continue
+		}
+		s.users[id] = *u
+		s.userOrg[id] = tx.userOrg[id]
+	}
+	for id, keyID := range tx.userKeyIDs {
+		if _, ok := s.users[id]; ok {
+			s.userKeyIDs[id] = keyID
+		}
+	}
+	s.logs = append(s.logs, tx.logs...)
+	s.events = append(s.events, tx.events...)
+
+	for id, sub := range tx.subscriptions {
+		if sub == nil {
+			delete(s.subscriptions, id)
+			s.deleteDeliveriesLocked(id)
+			continue
+		}
+		s.subscriptions[id] = *sub
+		s.subscriptionOrg[id] = tx.subscriptionOrg[id]
+	}
+	return nil
+}
+
+// deleteDeliveriesLocked 購読の配信を削除する（ON DELETE CASCADE 相当、s.mu 保持中に呼ぶ）
+func (s *Store) deleteDeliveriesLocked(subscriptionID string) {
+	kept := s.deliveries[:0]
+	for _, d := range s.deliveries {
+		if d.SubscriptionID != subscriptionID {
+			kept = append(kept, d)
+		}
+	}
+	s.deliveries = kept
+}
+
+// deleteGroupMembersLocked ユーザーのメンバーシップを削除する（ON DELETE CASCADE 相当、s.mu 保持中に呼ぶ）
+func (s *Store) deleteGroupMembersLocked(userID string) {
+	for k := range s.groupMembers {
+		if k.userID == userID {
+			delete(s.groupMembers, k)
+		}
+	}
+}
+
+// rollback トランザクションの書き込みを破棄し、ロックを解放する
+func (s *Store) rollback(tx *Tx) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.releaseLocked(tx)
+}
+
+// checkUniqueLocked コミット後の状態で users (org_id, email) と groups (org_id, name) の一意制約を満たすか検証する
+func (s *Store) checkUniqueLocked(tx *Tx) error {
+	type nameKey struct{ orgID, name string }
+	names := make(map[nameKey]string, len(s.groups))
+	for id, g := range s.groups {
+		if _, touched := tx.groups[id]; !touched {
+			names[nameKey{s.groupOrg[id], g.Name}] = id
+		}
+	}
+	for id, g := range tx.groups {
+		k := nameKey{tx.groupOrg[id], g.Name}
+		if other, ok := names[k]; ok && other != id {
+			return fmt.Errorf("%w: groups (org_id, name) (%q, %q)", ErrUniqueViolation, k.orgID, g.Name)
+		}
+		names[k] = id
+	}
+
+	type key struct{ orgID, email string }
+	emails := make(map[key]string, len(s.users))
+	for id, u := range s.users {
+		if _, touched := tx.users[id]; touched {
+			continue
+		}
+		emails[key{s.userOrg[id], u.Email}] = id
+	}
+	for id, u := range tx.users {
+		if u == nil {
+			continue
+		}
+		k := key{tx.userOrg[id], u.Email}
+		if other, ok := emails[k]; ok && other != id {
+			return fmt.Errorf("%w: users (org_id, email) (%q, %q)", ErrUniqueViolation, k.orgID, u.Email)
+		}
+		emails[k] = id
+	}
+	return nil
+}
+
+// releaseLocked トランザクションが保持するロックをすべて解放する（s.mu 保持中に呼ぶ）
+func (s *Store) releaseLocked(tx *Tx) {
+	for _, key := range tx.lockKey {
+		if l, ok := s.locks[key]; ok && l.owner == tx {
+			close(l.released)
+			delete(s.locks, key)
+		}
+	}
+	tx.lockKey = nil
+	tx.done = true
+}
+
+// lock キーの行ロックを取得する。他のトランザクションが保持している場合は解放まで待つ
+func (s *Store) lock(ctx context.Context, tx *Tx, key string) error {
+	for {
+		s.mu.Lock()
+		l, ok := s.locks[key]
+		if !ok {
+			s.locks[key] = &rowLock{owner: tx, released: make(chan struct{})}
+			tx.lockKey = append(tx.lockKey, key)
+			s.mu.Unlock()
+			return nil
+		}
+		if l.owner == tx {
+			s.mu.Unlock()
+			return nil
+		}
+		released := l.released
+		s.mu.Unlock()
+
+		select {
+		case <-released:
+		case <-ctx.Done():
+			return fmt.Errorf("failed to acquire lock %s: %w", key, ctx.Err())
+		}
+	}
+}
+
+// txFrom DBTX をインメモリトランザクションに変換する
+func (s *Store) txFrom(dbtx infrastructure.DBTX) (*Tx, error) {
+	tx, ok := dbtx.(*Tx)
+	if !ok || tx.store != s {
+		return nil, errors.New("memory: transaction does not belong to this store")
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if tx.done {
+		return nil, errors.New("memory: transaction has already been committed or rolled back")
+	}
+	return tx, nil
+}
+
+// visibleLocked トランザクションから見える組織 orgID のユーザーを取得する（s.mu 保持中に呼ぶ）
+func (s *Store) visibleLocked(tx *Tx, orgID, id string) *domain.User {
+	if u, touched := tx.users[id]; touched {
+		if u == nil || tx.userOrg[id] != orgID {
+			return nil
+		}
+		copied := *u
+		return &copied
+	}
+	if u, ok := s.users[id]; ok && s.userOrg[id] == orgID {
+		return &u
+	}
+	return nil
+}
+
+// --- UserCommandRepository ---
+
+// Save ユーザーを保存（トランザクション内で使用）
+func (s *Store) Save(ctx context.Context, dbtx infrastructure.DBTX, user *domain.User) error {
+	tx, err := s.txFrom(dbtx)
+	if err != nil {
+		return err
+	}
+	orgID := tenant.OrgID(ctx)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if other, ok := s.userOrg[user.ID]; ok && other != orgID {
+		return fmt.Errorf("failed to save user: %s belongs to another organization than %s", user.ID, orgID)
+	}
+	copied := user.Snapshot()
+	tx.users[user.ID] = &copied
+	tx.userOrg[user.ID] = orgID
+	tx.userKeyIDs[user.ID] = s.piiKeyID
+	return nil
+}
+
+// Delete ユーザーを削除（トランザクション内で使用）
+func (s *Store) Delete(ctx context.Context, dbtx infrastructure.DBTX, id string) error {
+	user, err := s.FindByIDForUpdate(ctx, dbtx, id)
+	if err != nil {
+		return err
+	}
+	if user == nil {
+		return fmt.Errorf("user not found: %s", id)
+	}
+	tx, err := s.txFrom(dbtx)
+	if err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	tx.users[id] = nil
+	return nil
+}
+
+// FindByIDForUpdate IDでユーザーを検索しロックを取得（トランザクション内で使用）
+func (s *Store) FindByIDForUpdate(ctx context.Context, dbtx infrastructure.DBTX, id string) (*domain.User, error) {
+	tx, err := s.txFrom(dbtx)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.lock(ctx, tx, "users:id:"+id); err != nil {
+		return nil, err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.visibleLocked(tx, tenant.OrgID(ctx), id), nil
+}
+
+// FindByEmailForUpdate メールアドレスでユーザーを検索しロックを取得（トランザクション内で使用）
+//
+// 実在しない行に対しても email 単位でロックを取るため、PostgreSQL より保守的に直列化される。
+func (s *Store) FindByEmailForUpdate(ctx context.Context, dbtx infrastructure.DBTX, email string) (*domain.User, error) {
+	tx, err := s.txFrom(dbtx)
+	if err != nil {
+		return nil, err
+	}
+	orgID := tenant.OrgID(ctx)
+	if err := s.lock(ctx, tx, "users:email:"+orgID+":"+email); err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	id := ""
+	for _, u := range tx.users {
+		if u != nil && u.Email == email && tx.userOrg[u.ID] == orgID {
+			id = u.ID
+			break
+		}
+	}
+	if id == "" {
+		for _, u := range s.users {
+			if u.Email != email || s.userOrg[u.ID] != orgID {
+				continue
+			}
+			if _, touched := tx.users[u.ID]; touched {
+				continue
+			}
+			id = u.ID
+			break
+		}
+	}
+	s.mu.Unlock()
+
+	if id == "" {
+		return nil, nil
+	}
+	// 該当行の行ロックも取得する
+	return s.FindByIDForUpdate(ctx, dbtx, id)
+}
+
+// SaveUserLog ユーザーログを保存（トランザクション内で使用）
+func (s *Store) SaveUserLog(_ context.Context, dbtx infrastructure.DBTX, log *domain.UserLog) error {
+	tx, err := s.txFrom(dbtx)
+	if err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	tx.logs = append(tx.logs, *log)
+	return nil
+}
+
+// SaveEvents ドメインイベントを outbox に保存（トランザクション内で使用）
+func (s *Store) SaveEvents(_ context.Context, dbtx infrastructure.DBTX, events []domain.UserEvent) error {
+	tx, err := s.txFrom(dbtx)
+	if err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	tx.events = append(tx.events, events...)
+	return nil
+}
+
+// --- UserReencryptionRepository ---
+
+// ReencryptUsers 主鍵以外の鍵で暗号化したユーザーを最大 limit 件ロックして主鍵で暗号化し直す（トランザクション内で使用）
+//
+// ロック中のユーザーは飛ばさずに解放を待つため、PostgreSQL（SKIP LOCKED）より保守的に直列化される。
+func (s *Store) ReencryptUsers(ctx context.Context, dbtx infrastructure.DBTX, limit int) (int, error) {
+	tx, err := s.txFrom(dbtx)
+	if err != nil {
+		return 0, err
+	}
+	orgID := tenant.OrgID(ctx)
+	s.mu.Lock()
+	var candidates []string
+	for id := range s.users {
+		if s.userOrg[id] == orgID && s.userKeyIDs[id] != s.piiKeyID {
+			candidates = append(candidates, id)
+		}
+	}
+	s.mu.Unlock()
+	sort.Strings(candidates)
+
+	n := 0
+	for _, id := range candidates {
+		if n == limit {
+			break
+		}
+		user, err := s.FindByIDForUpdate(ctx, dbtx, id)
+		if err != nil {
+			return n, err
+		}
+		s.mu.Lock()
+		// ロックを待つ間に他のトランザクションが削除・再暗号化したユーザーは除く
+		if user != nil && s.userKeyIDs[id] != s.piiKeyID {
+			tx.userKeyIDs[id] = s.piiKeyID
+			n++
+		}
+		s.mu.Unlock()
+	}
+	return n, nil
+}
+
+// --- UserQueryRepository ---
+
+// FindByID IDでユーザーを検索
+func (s *Store) FindByID(ctx context.Context, id string) (*domain.User, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if u, ok := s.users[id]; ok && s.userOrg[id] == tenant.OrgID(ctx) {
+		return &u, nil
+	}
+	return nil, nil
+}
+
+// FindByEmail メールアドレスでユーザーを検索
+func (s *Store) FindByEmail(ctx context.Context, email string) (*domain.User, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	orgID := tenant.OrgID(ctx)
+	for id, u := range s.users {
+		if u.Email == email && s.userOrg[id] == orgID {
+			return &u, nil
+		}
+	}
+	return nil, nil
+}
+
+// FindAll ユーザーを取得（created_at 降順、ページネーション対応、status が空の場合は全ステータス）
+func (s *Store) FindAll(ctx context.Context, status domain.UserStatus, limit, offset int) ([]*domain.User, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	users := s.matchUsersLocked(tenant.OrgID(ctx), status)
+	sort.Slice(users, func(i, j int) bool {
+		if users[i].CreatedAt.Equal(users[j].CreatedAt) {
+			return users[i].ID > users[j].ID
+		}
+		return users[i].CreatedAt.After(users[j].CreatedAt)
+	})
+
+	if offset >= len(users) {
+		return []*domain.User{}, nil
+	}
+	end := offset + limit
+	if end > len(users) {
+		end = len(users)
+	}
+	return users[offset:end], nil
+}
+
+// Count ユーザーの総数を取得（status が空の場合は全ステータス）
+func (s *Store) Count(ctx context.Context, status domain.UserStatus) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.matchUsersLocked(tenant.OrgID(ctx), status)), nil
+}
+
+// matchUsersLocked 組織 orgID の条件に一致するユーザーのコピーを返す（s.mu 保持中に呼ぶ）
+func (s *Store) matchUsersLocked(orgID string, status domain.UserStatus) []*domain.User {
+	users := make([]*domain.User, 0, len(s.users))
+	for id, u := range s.users {
+		if s.userOrg[id] != orgID || (status != "" && u.Status != status) {
+			continue
+		}
+		users = append(users, &u)
+	}
+	return users
+}
+
+// --- UserLogQueryRepository ---
+
+// FindLogsByUserIDs 複数ユーザーのログを取得（ユーザーごとに新しい順でページネーション）
+func (s *Store) FindLogsByUserIDs(ctx context.Context, userIDs []string, limit, offset int) (map[string][]*domain.UserLog, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	orgID := tenant.OrgID(ctx)
+	result := make(map[string][]*domain.UserLog, len(userIDs))
+	for _, id := range userIDs {
+		if s.userOrg[id] != orgID {
+			continue
+		}
+		var logs []*domain.UserLog
+		// 記録順の逆（新しい順）
+		for i := len(s.logs) - 1; i >= 0; i-- {
+			if s.logs[i].UserID == id {
+				l := s.logs[i]
+				logs = append(logs, &l)
+			}
+		}
+		if offset < len(logs) {
+			result[id] = logs[offset:min(offset+limit, len(logs))]
+		}
+	}
+	return result, nil
+}
+
+// CountLogsByUserIDs 複数ユーザーのログ件数を取得（ログのないユーザーは含まない）
+func (s *Store) CountLogsByUserIDs(ctx context.Context, userIDs []string) (map[string]int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	orgID := tenant.OrgID(ctx)
+	result := make(map[string]int, len(userIDs))
+	for _, id := range userIDs {
+		if s.userOrg[id] != orgID {
+			continue
+		}
+		for i := range s.logs {
+			if s.logs[i].UserID == id {
+				result[id]++
+			}
+		}
+	}
+	return result, nil
+}
+
+// --- WebhookCommandRepository ---
+
+// SaveSubscription Webhook購読を保存（トランザクション内で使用）
+func (s *Store) SaveSubscription(ctx context.Context, dbtx infrastructure.DBTX, sub *domain.WebhookSubscription) error {
+	tx, err := s.txFrom(dbtx)
+	if err != nil {
+		return err
+	}
+	orgID := tenant.OrgID(ctx)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if other, ok := s.subscriptionOrg[sub.ID]; ok && other != orgID {
+		return fmt.Errorf("failed to save webhook subscription: %s belongs to another organization than %s", sub.ID, orgID)
+	}
+	copied := copySubscription(sub)
+	tx.subscriptions[sub.ID] = &copied
+	tx.subscriptionOrg[sub.ID] = orgID
+	return nil
+}
+
+// DeleteSubscription Webhook購読を削除（トランザクション内で使用）
+func (s *Store) DeleteSubscription(ctx context.Context, dbtx infrastructure.DBTX, id string) error {
+	tx, err := s.txFrom(dbtx)
+	if err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	owner, ok := tx.subscriptionOrg[id]
+	if !ok {
+		owner = s.subscriptionOrg[id]
+	}
+	if owner != tenant.OrgID(ctx) {
+		return nil
+	}
+	tx.subscriptions[id] = nil
+	return nil
+}
+
+// FindSubscriptionByIDForUpdate IDでWebhook購読を検索しロックを取得（トランザクション内で使用）
+func (s *Store) FindSubscriptionByIDForUpdate(ctx context.Context, dbtx infrastructure.DBTX, id string) (*domain.WebhookSubscription, error) {
+	tx, err := s.txFrom(dbtx)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.lock(ctx, tx, "webhook_subscriptions:id:"+id); err != nil {
+		return nil, err
+	}
+	orgID := tenant.OrgID(ctx)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if sub, touched := tx.subscriptions[id]; touched {
+		if sub == nil || tx.subscriptionOrg[id] != orgID {
+			return nil, nil
+		}
+		copied := copySubscription(sub)
+		return &copied, nil
+	}
+	if sub, ok := s.subscriptions[id]; ok && s.subscriptionOrg[id] == orgID {
+		copied := copySubscription(&sub)
+		return &copied, nil
+	}
+	return nil, nil
+}
+
+// --- WebhookQueryRepository ---
+
+// FindSubscriptionByID IDでWebhook購読を検索
+func (s *Store) FindSubscriptionByID(ctx context.Context, id string) (*domain.WebhookSubscription, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if sub, ok := s.subscriptions[id]; ok && s.subscriptionOrg[id] == tenant.OrgID(ctx) {
+		copied := copySubscription(&sub)
+		return &copied, nil
+	}
+	return nil, nil
+}
+
+// FindAllSubscriptions すべてのWebhook購読を取得（created_at 降順、ページネーション対応）
+func (s *Store) FindAllSubscriptions(ctx context.Context, limit, offset int) ([]*domain.WebhookSubscription, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	orgID := tenant.OrgID(ctx)
+	subs := make([]*domain.WebhookSubscription, 0, len(s.subscriptions))
+	for id, sub := range s.subscriptions {
+		if s.subscriptionOrg[id] != orgID {
+			continue
+		}
+		copied := copySubscription(&sub)
+		subs = append(subs, &copied)
+	}
+	sort.Slice(subs, func(i, j int) bool {
+		if subs[i].CreatedAt.Equal(subs[j].CreatedAt) {
+			return subs[i].ID > subs[j].ID
+		}
+		return subs[i].CreatedAt.After(subs[j].CreatedAt)
+	})
+	return paginate(subs, limit, offset), nil
+}
+
+// CountSubscriptions Webhook購読の総数を取得
+func (s *Store) CountSubscriptions(ctx context.Context) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	orgID := tenant.OrgID(ctx)
+	count := 0
+	for id := range s.subscriptions {
+		if s.subscriptionOrg[id] == orgID {
+			count++
+		}
+	}
+	return count, nil
+}
+
+// FindDeliveries 購読の配信ログを取得（created_at 降順、status が空の場合は全状態）
+func (s *Store) FindDeliveries(ctx context.Context, subscriptionID string, status domain.WebhookDeliveryStatus, limit, offset int) ([]*domain.WebhookDelivery, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	deliveries := s.matchDeliveriesLocked(tenant.OrgID(ctx), subscriptionID, status)
+	sort.Slice(deliveries, func(i, j int) bool {
+		if deliveries[i].CreatedAt.Equal(deliveries[j].CreatedAt) {
+			return deliveries[i].ID > deliveries[j].ID
+		}
+		return deliveries[i].CreatedAt.After(deliveries[j].CreatedAt)
+	})
+	return paginate(deliveries, limit, offset), nil
+}
+
+// CountDeliveries 購読の配信ログの件数を取得（status が空の場合は全状態）
+func (s *Store) CountDeliveries(ctx context.Context, subscriptionID string, status domain.WebhookDeliveryStatus) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.matchDeliveriesLocked(tenant.OrgID(ctx), subscriptionID, status)), nil
+}
+
+// matchDeliveriesLocked 組織 orgID の購読の条件に一致する配信のコピーを返す（s.mu 保持中に呼ぶ）
+func (s *Store) matchDeliveriesLocked(orgID, subscriptionID string, status domain.WebhookDeliveryStatus) []*domain.WebhookDelivery {
+	if s.subscriptionOrg[subscriptionID] != orgID {
+		return nil
+	}
+	var result []*domain.WebhookDelivery
+	for _, d := range s.deliveries {
+		if d.SubscriptionID != subscriptionID || (status != "" && d.Status != status) {
+			continue
+		}
+		copied := d
+		result = append(result, &copied)
+	}
+	return result
+}
+
+// --- OrganizationCommandRepository ---
+
+// SaveOrganization 組織を保存（トランザクション内で使用）
+func (s *Store) SaveOrganization(_ context.Context, dbtx infrastructure.DBTX, org *domain.Organization) error {
+	tx, err := s.txFrom(dbtx)
+	if err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	copied := *org
+	tx.organizations[org.ID] = &copied
+	return nil
+}
+
+// --- OrganizationQueryRepository ---
+
+// FindOrganizationByID IDで組織を検索
+func (s *Store) FindOrganizationByID(_ context.Context, id string) (*domain.Organization, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if o, ok := s.organizations[id]; ok {
+		return &o, nil
+	}
+	return nil, nil
+}
+
+// FindAllOrganizations すべての組織を取得（created_at 降順、ページネーション対応）
+func (s *Store) FindAllOrganizations(_ context.Context, limit, offset int) ([]*domain.Organization, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	orgs := make([]*domain.Organization, 0, len(s.organizations))
+	for _, o := range s.organizations {
+		orgs = append(orgs, &o)
+	}
+	sort.Slice(orgs, func(i, j int) bool {
+		if orgs[i].CreatedAt.Equal(orgs[j].CreatedAt) {
+			return orgs[i].ID > orgs[j].ID
+		}
+		return orgs[i].CreatedAt.After(orgs[j].CreatedAt)
+	})
+	return paginate(orgs, limit, offset), nil
+}
+
+// CountOrganizations 組織の総数を取得
+func (s *Store) CountOrganizations(context.Context) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.organizations), nil
+}
+
+// --- GroupCommandRepository ---
+
+// SaveGroup グループを保存（トランザクション内で使用）
+func (s *Store) SaveGroup(ctx context.Context, dbtx infrastructure.DBTX, group *domain.Group) error {
+	tx, err := s.txFrom(dbtx)
+	if err != nil {
+		return err
+	}
+	orgID := tenant.OrgID(ctx)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if other, ok := s.groupOrg[group.ID]; ok && other != orgID {
+		return fmt.Errorf("failed to save group: %s belongs to another organization than %s", group.ID, orgID)
+	}
+	copied := *group
+	tx.groups[group.ID] = &copied
+	tx.groupOrg[group.ID] = orgID
+	return nil
+}
+
+// FindGroupByIDForUpdate IDでグループを検索しロックを取得（トランザクション内で使用）
+func (s *Store) FindGroupByIDForUpdate(ctx context.Context, dbtx infrastructure.DBTX, id string) (*domain.Group, error) {
+	tx, err := s.txFrom(dbtx)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.lock(ctx, tx, "groups:id:"+id); err != nil {
+		return nil, err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.visibleGroupLocked(tx, tenant.OrgID(ctx), id), nil
+}
+
+// FindGroupByNameForUpdate グループ名でグループを検索しロックを取得（トランザクション内で使用）
+//
+// 実在しない行に対しても name 単位でロックを取るため、PostgreSQL より保守的に直列化される。
+func (s *Store) FindGroupByNameForUpdate(ctx context.Context, dbtx infrastructure.DBTX, name string) (*domain.Group, error) {
+	tx, err := s.txFrom(dbtx)
+	if err != nil {
+		return nil, err
+	}
+	orgID := tenant.OrgID(ctx)
+	if err := s.lock(ctx, tx, "groups:name:"+orgID+":"+name); err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	id := ""
+	for gid, g := range tx.groups {
+		if g.Name == name && tx.groupOrg[gid] == orgID {
+			id = gid
+			break
+		}
+	}
+	if id == "" {
+		for gid, g := range s.groups {
+			if _, touched := tx.groups[gid]; touched || g.Name != name || s.groupOrg[gid] != orgID {
+				continue
+			}
+			id = gid
+			break
+		}
+	}
+	s.mu.Unlock()
+
+	if id == "" {
+		return nil, nil
+	}
+	// 該当行の行ロックも取得する
+	return s.FindGroupByIDForUpdate(ctx, dbtx, id)
+}
+
+// FindGroupMember メンバーシップを検索（トランザクション内で使用）
+func (s *Store) FindGroupMember(ctx context.Context, dbtx infrastructure.DBTX, groupID, userID string) (*domain.GroupMember, error) {
+	tx, err := s.txFrom(dbtx)
+	if err != nil {
+		return nil, err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.visibleGroupLocked(tx, tenant.OrgID(ctx), groupID) == nil {
+		return nil, nil
+	}
+	k := groupMemberKey{groupID, userID}
+	if m, touched := tx.groupMembers[k]; touched {
+		if m == nil {
+			return nil, nil
+		}
+		copied := *m
+		return &copied, nil
+	}
+	if m, ok := s.groupMembers[k]; ok {
+		return &m, nil
+	}
+	return nil, nil
+}
+
+// SaveGroupMember メンバーシップを保存（トランザクション内で使用）
+func (s *Store) SaveGroupMember(ctx context.Context, dbtx infrastructure.DBTX, member *domain.GroupMember) error {
+	tx, err := s.txFrom(dbtx)
+	if err != nil {
+		return err
+	}
+	orgID := tenant.OrgID(ctx)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.visibleGroupLocked(tx, orgID, member.GroupID) == nil {
+		return fmt.Errorf("failed to save group member: group %s not found in organization %s", member.GroupID, orgID)
+	}
+	copied := *member
+	tx.groupMembers[groupMemberKey{member.GroupID, member.UserID}] = &copied
+	return nil
+}
+
+// DeleteGroupMember メンバーシップを削除（トランザクション内で使用）
+func (s *Store) DeleteGroupMember(ctx context.Context, dbtx infrastructure.DBTX, groupID, userID string) (bool, error) {
+	existing, err := s.FindGroupMember(ctx, dbtx, groupID, userID)
+	if err != nil || existing == nil {
+		return false, err
+	}
+	tx, err := s.txFrom(dbtx)
+	if err != nil {
+		return false, err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	tx.groupMembers[groupMemberKey{groupID, userID}] = nil
+	return true, nil
+}
+
+// visibleGroupLocked トランザクションから見える組織 orgID のグループを取得する（s.mu 保持中に呼ぶ）
+func (s *Store) visibleGroupLocked(tx *Tx, orgID, id string) *domain.Group {
+	if g, touched := tx.groups[id]; touched {
+		if tx.groupOrg[id] != orgID {
+			return nil
+		}
+		copied := *g
+		return &copied
+	}
+	if g, ok := s.groups[id]; ok && s.groupOrg[id] == orgID {
+		return &g
+	}
+	return nil
+}
+
+// --- CredentialCommandRepository ---
+
+// FindCredentialForUpdate ユーザーIDで認証情報を検索しロックを取得（トランザクション内で使用）
+func (s *Store) FindCredentialForUpdate(ctx context.Context, dbtx infrastructure.DBTX, userID string) (*domain.Credential, error) {
+	tx, err := s.txFrom(dbtx)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.lock(ctx, tx, "user_credentials:user_id:"+userID); err != nil {
+		return nil, err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.visibleLocked(tx, tenant.OrgID(ctx), userID) == nil {
+		return nil, nil
+	}
+	if c, touched := tx.credentials[userID]; touched {
+		copied := *c
+		return &copied, nil
+	}
+	if c, ok := s.credentials[userID]; ok {
+		return &c, nil
+	}
+	return nil, nil
+}
+
+// SaveCredential 認証情報を保存（トランザクション内で使用）
+func (s *Store) SaveCredential(ctx context.Context, dbtx infrastructure.DBTX, cred *domain.Credential) error {
+	tx, err := s.txFrom(dbtx)
+	if err != nil {
+		return err
+	}
+	orgID := tenant.OrgID(ctx)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.visibleLocked(tx, orgID, cred.UserID) == nil {
+		return fmt.Errorf("failed to save credential: user %s not found in organization %s", cred.UserID, orgID)
+	}
+	copied := *cred
+	tx.credentials[cred.UserID] = &copied
+	return nil
+}
+
+// --- TOTPCredentialCommandRepository ---
+
+// FindTOTPCredentialForUpdate ユーザーIDで TOTP の二要素認証を検索しロックを取得（トランザクション内で使用）
+func (s *Store) FindTOTPCredentialForUpdate(ctx context.Context, dbtx infrastructure.DBTX, userID string) (*domain.TOTPCredential, error) {
+	tx, err := s.txFrom(dbtx)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.lock(ctx, tx, "user_totp_credentials:user_id:"+userID); err != nil {
+		return nil, err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.visibleLocked(tx, tenant.OrgID(ctx), userID) == nil {
+		return nil, nil
+	}
+	if c, touched := tx.totpCredentials[userID]; touched {
+		if c == nil {
+			return nil, nil
+		}
+		copied := *c
+		copied.RecoveryCodeHashes = append([]string(nil), c.RecoveryCodeHashes...)
+		return &copied, nil
+	}
+	if c, ok := s.totpCredentials[userID]; ok {
+		c.RecoveryCodeHashes = append([]string(nil), c.RecoveryCodeHashes...)
+		return &c, nil
+	}
+	return nil, nil
+}
+
+// SaveTOTPCredential TOTP の二要素認証を保存（トランザクション内で使用）
+func (s *Store) SaveTOTPCredential(ctx context.Context, dbtx infrastructure.DBTX, cred *domain.TOTPCredential) error {
+	tx, err := s.txFrom(dbtx)
+	if err != nil {
+		return err
+	}
+	orgID := tenant.OrgID(ctx)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.visibleLocked(tx, orgID, cred.UserID) == nil {
+		return fmt.Errorf("failed to save totp credential: user %s not found in organization %s", cred.UserID, orgID)
+	}
+	copied := *cred
+	copied.RecoveryCodeHashes = append([]string(nil), cred.RecoveryCodeHashes...)
+	tx.totpCredentials[cred.UserID] = &copied
+	return nil
+}
+
+// DeleteTOTPCredential TOTP の二要素認証を削除（トランザクション内で使用）
+func (s *Store) DeleteTOTPCredential(ctx context.Context, dbtx infrastructure.DBTX, userID string) error {
+	tx, err := s.txFrom(dbtx)
+	if err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.visibleLocked(tx, tenant.OrgID(ctx), userID) == nil {
+		return nil
+	}
+	tx.totpCredentials[userID] = nil
+	return nil
+}
+
+// --- UserTokenCommandRepository ---
+
+// FindUserTokenForUpdate 用途とハッシュでトークンを検索しロックを取得（トランザクション内で使用）
+func (s *Store) FindUserTokenForUpdate(ctx context.Context, dbtx infrastructure.DBTX, purpose domain.UserTokenPurpose, tokenHash string) (*domain.UserToken, error) {
+	tx, err := s.txFrom(dbtx)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.lock(ctx, tx, "user_tokens:token_hash:"+tokenHash); err != nil {
+		return nil, err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, t := range s.userTokensLocked(tx) {
+		if t.Purpose == purpose && t.TokenHash == tokenHash && s.visibleLocked(tx, tenant.OrgID(ctx), t.UserID) != nil {
+			return t, nil
+		}
+	}
+	return nil, nil
+}
+
+// SaveUserToken トークンを保存（トランザクション内で使用）
+func (s *Store) SaveUserToken(ctx context.Context, dbtx infrastructure.DBTX, token *domain.UserToken) error {
+	tx, err := s.txFrom(dbtx)
+	if err != nil {
+		return err
+	}
+	orgID := tenant.OrgID(ctx)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.visibleLocked(tx, orgID, token.UserID) == nil {
+		return fmt.Errorf("failed to save user token: user %s not found in organization %s", token.UserID, orgID)
+	}
+	copied := *token
+	tx.userTokens[token.ID] = &copied
+	return nil
+}
+
+// RevokeUserTokens ユーザーの未使用のトークンを使用済みにする（トランザクション内で使用）
+func (s *Store) RevokeUserTokens(ctx context.Context, dbtx infrastructure.DBTX, userID string, purpose domain.UserTokenPurpose, now time.Time) error {
+	tx, err := s.txFrom(dbtx)
+	if err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.visibleLocked(tx, tenant.OrgID(ctx), userID) == nil {
+		return nil
+	}
+	for _, t := range s.userTokensLocked(tx) {
+		if t.UserID == userID && t.Purpose == purpose && t.UsedAt.IsZero() {
+			t.UsedAt = now
+			tx.userTokens[t.ID] = t
+		}
+	}
+	return nil
+}
+
+// userTokensLocked トランザクションから見えるトークンのコピーを返す（s.mu を保持して呼び出す）
+func (s *Store) userTokensLocked(tx *Tx) []*domain.UserToken {
+	var result []*domain.UserToken
+	for id, t := range s.userTokens {
+		if _, touched := tx.userTokens[id]; touched {
+			continue
+		}
+		copied := t
+		result = append(result, &copied)
+	}
+	for _, t := range tx.userTokens {
+		copied := *t
+		result = append(result, &copied)
+	}
+	return result
+}
+
+// deleteUserTokensLocked ユーザーのトークンを削除する（s.mu を保持して呼び出す）
+func (s *Store) deleteUserTokensLocked(userID string) {
+	for id, t := range s.userTokens {
+		if t.UserID == userID {
+			delete(s.userTokens, id)
+		}
+	}
+}
+
+// --- InvitationQueryRepository ---
+
+// FindPendingInvitations 承諾待ちで有効期限内の招待を新しい順に取得
+func (s *Store) FindPendingInvitations(ctx context.Context, now time.Time, limit, offset int) ([]*domain.Invitation, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	invs := s.matchPendingInvitationsLocked(tenant.OrgID(ctx), now)
+	sort.Slice(invs, func(i, j int) bool {
+		if invs[i].CreatedAt.Equal(invs[j].CreatedAt) {
+			return invs[i].ID > invs[j].ID
+		}
+		return invs[i].CreatedAt.After(invs[j].CreatedAt)
+	})
+	return paginate(invs, limit, offset), nil
+}
+
+// CountPendingInvitations 承諾待ちで有効期限内の招待の数を取得
+func (s *Store) CountPendingInvitations(ctx context.Context, now time.Time) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.matchPendingInvitationsLocked(tenant.OrgID(ctx), now)), nil
+}
+
+// matchPendingInvitationsLocked 組織 orgID の承諾待ちで有効期限内の招待のコピーを返す（s.mu 保持中に呼ぶ）
+func (s *Store) matchPendingInvitationsLocked(orgID string, now time.Time) []*domain.Invitation {
+	result := []*domain.Invitation{}
+	for _, inv := range s.invitations {
+		if _, ok := s.users[inv.UserID]; !ok || s.userOrg[inv.UserID] != orgID {
+			continue
+		}
+		if inv.Status == domain.InvitationStatusPending && now.Before(inv.ExpiresAt) {
+			copied := inv
+			result = append(result, &copied)
+		}
+	}
+	return result
+}
+
+// --- InvitationCommandRepository ---
+
+// FindInvitationByIDForUpdate IDで招待を検索しロックを取得（トランザクション内で使用）
+func (s *Store) FindInvitationByIDForUpdate(ctx context.Context, dbtx infrastructure.DBTX, id string) (*domain.Invitation, error) {
+	tx, err := s.txFrom(dbtx)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.lock(ctx, tx, "invitations:id:"+id); err != nil {
+		return nil, err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, inv := range s.invitationsLocked(tx) {
+		if inv.ID == id && s.visibleLocked(tx, tenant.OrgID(ctx), inv.UserID) != nil {
+			return inv, nil
+		}
+	}
+	return nil, nil
+}
+
+// FindInvitationByTokenForUpdate トークンのハッシュで招待を検索しロックを取得（トランザクション内で使用）
+//
+// トークンは再送で置き換わるため、IDでロックを取得してからハッシュを照合し直す。
+func (s *Store) FindInvitationByTokenForUpdate(ctx context.Context, dbtx infrastructure.DBTX, tokenHash string) (*domain.Invitation, error) {
+	tx, err := s.txFrom(dbtx)
+	if err != nil {
+		return nil, err
+	}
+	s.mu.Lock()
+	var id string
+	for _, inv := range s.invitationsLocked(tx) {
+		if inv.TokenHash == tokenHash {
+			id = inv.ID
+		}
+	}
+	s.mu.Unlock()
+	if id == "" {
+		return nil, nil
+	}
+
+	inv, err := s.FindInvitationByIDForUpdate(ctx, dbtx, id)
+	if err != nil || inv == nil || inv.TokenHash != tokenHash {
+		return nil, err
+	}
+	return inv, nil
+}
+
+// FindExpiredInvitationsForUpdate 有効期限を過ぎた承諾待ちの招待をロックして取得（トランザクション内で使用）
+//
+// SKIP LOCKED には対応せず、他のトランザクションのロックの解放を待つ。
+func (s *Store) FindExpiredInvitationsForUpdate(ctx context.Context, dbtx infrastructure.DBTX, now time.Time, limit int) ([]*domain.Invitation, error) {
+	tx, err := s.txFrom(dbtx)
+	if err != nil {
+		return nil, err
+	}
+	orgID := tenant.OrgID(ctx)
+	s.mu.Lock()
+	var candidates []*domain.Invitation
+	for _, inv := range s.invitationsLocked(tx) {
+		if inv.Status == domain.InvitationStatusPending && !now.Before(inv.ExpiresAt) && s.visibleLocked(tx, orgID, inv.UserID) != nil {
+			candidates = append(candidates, inv)
+		}
+	}
+	s.mu.Unlock()
+	sort.Slice(candidates, func(i, j int) bool {
+		if candidates[i].ExpiresAt.Equal(candidates[j].ExpiresAt) {
+			return candidates[i].ID < candidates[j].ID
+		}
+		return candidates[i].ExpiresAt.Before(candidates[j].ExpiresAt)
+	})
+
+	result := []*domain.Invitation{}
+	for _, c := range candidates {
+		if len(result) == limit {
+			break
+		}
+		inv, err := s.FindInvitationByIDForUpdate(ctx, dbtx, c.ID)
+		if err != nil {
+			return nil, err
+		}
+		// ロックを待つ間に他のトランザクションが処理した招待は除く
+		if inv != nil && inv.Status == domain.InvitationStatusPending && !now.Before(inv.ExpiresAt) {
+			result = append(result, inv)
+		}
+	}
+	return result, nil
+}
+
+// SaveInvitation 招待を保存（トランザクション内で使用）
+func (s *Store) SaveInvitation(ctx context.Context, dbtx infrastructure.DBTX, inv *domain.Invitation) error {
+	tx, err := s.txFrom(dbtx)
+	if err != nil {
+		return err
+	}
+	orgID := tenant.OrgID(ctx)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.visibleLocked(tx, orgID, inv.UserID) == nil {
+		return fmt.Errorf("failed to save invitation: user %s not found in organization %s", inv.UserID, orgID)
+	}
+	copied := *inv
+	tx.invitations[inv.ID] = &copied
+	return nil
+}
+
+// invitationsLocked トランザクションから見える招待のコピーを返す（s.mu を保持して呼び出す）
+func (s *Store) invitationsLocked(tx *Tx) []*domain.Invitation {
+	var result []*domain.Invitation
+	for id, inv := range s.invitations {
+		if _, touched := tx.invitations[id]; touched {
+			continue
+		}
+		copied := inv
+		result = append(result, &copied)
+	}
+	for _, inv := range tx.invitations {
+		copied := *inv
+		result = append(result, &copied)
+	}
+	return result
+}
+
+// deleteInvitationsLocked ユーザーへの招待を削除し、招待したユーザーの参照を外す（ON DELETE CASCADE / SET NULL 相当、s.mu を保持して呼び出す）
+func (s *Store) deleteInvitationsLocked(userID string) {
+	for id, inv := range s.invitations {
+		switch {
+		case inv.UserID == userID:
+			delete(s.invitations, id)
+		case inv.InvitedBy == userID:
+			inv.InvitedBy = ""
+			s.invitations[id] = inv
+		}
+	}
+}
+
+// --- SessionQueryRepository ---
+
+// FindSessionByID IDでセッションを検索
+func (s *Store) FindSessionByID(ctx context.Context, id string) (*domain.Session, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	sess, ok := s.sessions[id]
+	if !ok || s.userOrg[sess.UserID] != tenant.OrgID(ctx) {
+		return nil, nil
+	}
+	if _, ok := s.users[sess.UserID]; !ok {
+		return nil, nil
+	}
+	return &sess, nil
+}
+
+// FindActiveSessions 有効なユーザーのセッションを最近使用した順に取得
+func (s *Store) FindActiveSessions(ctx context.Context, userID string, now time.Time, policy domain.SessionPolicy) ([]*domain.Session, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.users[userID]; !ok || s.userOrg[userID] != tenant.OrgID(ctx) {
+		return []*domain.Session{}, nil
+	}
+	result := []*domain.Session{}
+	for _, sess := range s.sessions {
+		if sess.UserID == userID && sess.IsActive(now, policy) {
+			copied := sess
+			result = append(result, &copied)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].LastSeenAt.Equal(result[j].LastSeenAt) {
+			return result[i].ID > result[j].ID
+		}
+		return result[i].LastSeenAt.After(result[j].LastSeenAt)
+	})
+	return result, nil
+}
+
+// --- SessionCommandRepository ---
+
+// FindSessionByIDForUpdate IDでセッションを検索しロックを取得（トランザクション内で使用）
+func (s *Store) FindSessionByIDForUpdate(ctx context.Context, dbtx infrastructure.DBTX, id string) (*domain.Session, error) {
+	tx, err := s.txFrom(dbtx)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.lock(ctx, tx, "sessions:id:"+id); err != nil {
+		return nil, err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if sess := s.sessionLocked(tx, id); sess != nil && s.visibleLocked(tx, tenant.OrgID(ctx), sess.UserID) != nil {
+		return sess, nil
+	}
+	return nil, nil
+}
+
+// FindUnexpiredSessionsForUpdate 取り消されておらずログインからの有効期限内のユーザーのセッションをロックして取得（トランザクション内で使用）
+func (s *Store) FindUnexpiredSessionsForUpdate(ctx context.Context, dbtx infrastructure.DBTX, userID string, now time.Time) ([]*domain.Session, error) {
+	tx, err := s.txFrom(dbtx)
+	if err != nil {
+		return nil, err
+	}
+	s.mu.Lock()
+	var ids []string
+	for id := range s.sessions {
+		ids = append(ids, id)
+	}
+	for id := range tx.sessions {
+		if _, ok := s.sessions[id]; !ok {
+			ids = append(ids, id)
+		}
+	}
+	s.mu.Unlock()
+	sort.Strings(ids)
+
+	result := []*domain.Session{}
+	for _, id := range ids {
+		s.mu.Lock()
+		sess := s.sessionLocked(tx, id)
+		s.mu.Unlock()
+		if sess == nil || sess.UserID != userID || sess.IsRevoked() || !now.Before(sess.ExpiresAt) {
+			continue
+		}
+		locked, err := s.FindSessionByIDForUpdate(ctx, dbtx, id)
+		if err != nil {
+			return nil, err
+		}
+		// ロックを待つ間に他のトランザクションが取り消したセッションは除く
+		if locked != nil && !locked.IsRevoked() {
+			result = append(result, locked)
+		}
+	}
+	return result, nil
+}
+
+// SaveSession セッションを保存（トランザクション内で使用）
+func (s *Store) SaveSession(ctx context.Context, dbtx infrastructure.DBTX, session *domain.Session) error {
+	tx, err := s.txFrom(dbtx)
+	if err != nil {
+		return err
+	}
+	orgID := tenant.OrgID(ctx)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.visibleLocked(tx, orgID, session.UserID) == nil {
+		return fmt.Errorf("failed to save session: user %s not found in organization %s", session.UserID, orgID)
+	}
+	copied := *session
+	tx.sessions[session.ID] = &copied
+	return nil
+}
+
+// sessionLocked トランザクションから見えるセッションのコピーを返す（s.mu を保持して呼び出す）
+func (s *Store) sessionLocked(tx *Tx, id string) *domain.Session {
+	if sess, ok := tx.sessions[id]; ok {
+		copied := *sess
+		return &copied
+	}
+	if sess, ok := s.sessions[id]; ok {
+		return &sess
+	}
+	return nil
+}
+
+// deleteSessionsLocked ユーザーのセッションを削除する（ON DELETE CASCADE 相当、s.mu を保持して呼び出す）
+func (s *Store) deleteSessionsLocked(userID string) {
+	for id, sess := range s.sessions {
+		if sess.UserID == userID {
+			delete(s.sessions, id)
+		}
+	}
+}
+
+// --- ExternalIdentityCommandRepository ---
+
+// FindExternalIdentityForUpdate IdP の名前と subject で紐付けを検索しロックを取得（トランザクション内で使用）
+func (s *Store) FindExternalIdentityForUpdate(ctx context.Context, dbtx infrastructure.DBTX, provider, subject string) (*domain.ExternalIdentity, error) {
+	tx, err := s.txFrom(dbtx)
+	if err != nil {
+		return nil, err
+	}
+	orgID := tenant.OrgID(ctx)
+	if err := s.lock(ctx, tx, "user_external_identities:"+orgID+":"+provider+":"+subject); err != nil {
+		return nil, err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	k := externalIdentityKey{orgID, provider, subject}
+	if i, touched := tx.externalIdentities[k]; touched {
+		copied := *i
+		return &copied, nil
+	}
+	if i, ok := s.externalIdentities[k]; ok && s.visibleLocked(tx, orgID, i.UserID) != nil {
+		return &i, nil
+	}
+	return nil, nil
+}
+
+// SaveExternalIdentity 紐付けを保存（トランザクション内で使用）
+func (s *Store) SaveExternalIdentity(ctx context.Context, dbtx infrastructure.DBTX, identity *domain.ExternalIdentity) error {
+	tx, err := s.txFrom(dbtx)
+	if err != nil {
+		return err
+	}
+	orgID := tenant.OrgID(ctx)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.visibleLocked(tx, orgID, identity.UserID) == nil {
+		return fmt.Errorf("failed to save external identity: user %s not found in organization %s", identity.UserID, orgID)
+	}
+	copied := *identity
+	tx.externalIdentities[externalIdentityKey{orgID, identity.Provider, identity.Subject}] = &copied
+	return nil
+}
+
+// deleteExternalIdentitiesLocked ユーザーの紐付けを削除する（ON DELETE CASCADE 相当、s.mu を保持して呼び出す）
+func (s *Store) deleteExternalIdentitiesLocked(userID string) {
+	for k, i := range s.externalIdentities {
+		if i.UserID == userID {
+			delete(s.externalIdentities, k)
+		}
+	}
+}
+
+// --- OIDCLoginStateCommandRepository ---
+
+// FindOIDCLoginStateForUpdate IdP の名前と state のハッシュで認可リクエストの状態を検索しロックを取得（トランザクション内で使用）
+func (s *Store) FindOIDCLoginStateForUpdate(ctx context.Context, dbtx infrastructure.DBTX, provider, stateHash string) (*domain.OIDCLoginState, error) {
+	tx, err := s.txFrom(dbtx)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.lock(ctx, tx, "oidc_login_states:state_hash:"+stateHash); err != nil {
+		return nil, err
+	}
+	orgID := tenant.OrgID(ctx)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for id, st := range tx.oidcStates {
+		if st.Provider == provider && st.StateHash == stateHash && tx.oidcStateOrg[id] == orgID {
+			copied := *st
+			return &copied, nil
+		}
+	}
+	for id, st := range s.oidcStates {
+		if _, touched := tx.oidcStates[id]; touched {
+			continue
+		}
+		if st.Provider == provider && st.StateHash == stateHash && s.oidcStateOrg[id] == orgID {
+			return &st, nil
+		}
+	}
+	return nil, nil
+}
+
+// SaveOIDCLoginState 認可リクエストの状態を保存（トランザクション内で使用）
+func (s *Store) SaveOIDCLoginState(ctx context.Context, dbtx infrastructure.DBTX, state *domain.OIDCLoginState) error {
+	tx, err := s.txFrom(dbtx)
+	if err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	copied := *state
+	tx.oidcStates[state.ID] = &copied
+	tx.oidcStateOrg[state.ID] = tenant.OrgID(ctx)
+	return nil
+}
+
+// --- GroupQueryRepository ---
+
+// FindGroupByID IDでグループを検索
+func (s *Store) FindGroupByID(ctx context.Context, id string) (*domain.Group, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if g, ok := s.groups[id]; ok && s.groupOrg[id] == tenant.OrgID(ctx) {
+		return &g, nil
+	}
+	return nil, nil
+}
+
+// FindGroupMembers グループのメンバーを取得（created_at 昇順、ページネーション対応）
+func (s *Store) FindGroupMembers(ctx context.Context, groupID string, limit, offset int) ([]*domain.GroupMember, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	members := s.matchGroupMembersLocked(tenant.OrgID(ctx), groupID)
+	sort.Slice(members, func(i, j int) bool {
+		if members[i].CreatedAt.Equal(members[j].CreatedAt) {
+			return members[i].UserID < members[j].UserID
+		}
+		return members[i].CreatedAt.Before(members[j].CreatedAt)
+	})
+	return paginate(members, limit, offset), nil
+}
+
+// CountGroupMembers グループのメンバー数を取得
+func (s *Store) CountGroupMembers(ctx context.Context, groupID string) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.matchGroupMembersLocked(tenant.OrgID(ctx), groupID)), nil
+}
+
+// FindGroupsByUserID ユーザーが所属するグループをロールと共に取得（グループ名の昇順）
+func (s *Store) FindGroupsByUserID(ctx context.Context, userID string) ([]*domain.GroupMembership, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	orgID := tenant.OrgID(ctx)
+	result := []*domain.GroupMembership{}
+	for k, m := range s.groupMembers {
+		if k.userID != userID || s.groupOrg[k.groupID] != orgID {
+			continue
+		}
+		g := s.groups[k.groupID]
+		result = append(result, &domain.GroupMembership{Group: &g, Role: m.Role, JoinedAt: m.CreatedAt})
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Group.Name == result[j].Group.Name {
+			return result[i].Group.ID < result[j].Group.ID
+		}
+		return result[i].Group.Name < result[j].Group.Name
+	})
+	return result, nil
+}
+
+// matchGroupMembersLocked 組織 orgID のグループのメンバーシップのコピーを返す（s.mu 保持中に呼ぶ）
+func (s *Store) matchGroupMembersLocked(orgID, groupID string) []*domain.GroupMember {
+	if s.groupOrg[groupID] != orgID {
+		return nil
+	}
+	var result []*domain.GroupMember
+	for k, m := range s.groupMembers {
+		if k.groupID != groupID {
+			continue
+		}
+		copied := m
+		result = append(result, &copied)
+	}
+	return result
+}
+
+// copySubscription イベント種別のスライスを共有しないようにWebhook購読をコピーする
+func copySubscription(sub *domain.WebhookSubscription) domain.WebhookSubscription {
+	copied := *sub
+	copied.EventTypes = append([]domain.UserEventType(nil), sub.EventTypes...)
+	return copied
+}
+
+// paginate offset / limit でスライスを切り出す
+func paginate[T any](items []T, limit, offset int) []T {
+	if offset >= len(items) {
+		return []T{}
+	}
+	end := offset + limit
+	if end > len(items) {
+		end = len(items)
+	}
+	return items[offset:end]
+}
@@ -0,0 +1,294 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure"
+	"github.com/example/go-react-cqrs-template/internal/tenant"
+)
+
+func newTestUser(t *testing.T, name, email string) *domain.User {
+	t.Helper()
+	user, err := domain.NewUser(name, email)
+	if err != nil {
+		t.Fatalf("Failed to create user: %v", err)
+	}
+	return user
+}
+
+func TestStore_RollbackOnError(t *testing.T) {
+	ctx := context.Background()
+	store := NewStore()
+	user := newTestUser(t, "John Doe", "john@example.com")
+	errBoom := errors.New("boom")
+
+	err := store.RunInTransaction(ctx, func(ctx context.Context, tx infrastructure.DBTX) error {
+		if err := store.Save(ctx, tx, user); err != nil {
+			return err
+		}
+		if err := store.SaveUserLog(ctx, tx, domain.NewUserLog(user.ID, domain.UserLogActionCreated)); err != nil {
+			return err
+		}
+		infrastructure.AfterCommit(ctx, func() { t.Error("AfterCommit hook ran after rollback") })
+		return errBoom
+	})
+	if !errors.Is(err, errBoom) {
+		t.Fatalf("RunInTransaction() error = %v, want %v", err, errBoom)
+	}
+
+	if got, _ := store.FindByID(ctx, user.ID); got != nil {
+		t.Error("user should be rolled back")
+	}
+	if logs := store.UserLogs(user.ID); len(logs) != 0 {
+		t.Errorf("len(UserLogs) = %d, want 0", len(logs))
+	}
+}
+
+func TestTx_RawSQLIsNotSupported(t *testing.T) {
+	store := NewStore()
+	ctx := context.Background()
+
+	err := store.RunInTransaction(ctx, func(ctx context.Context, tx infrastructure.DBTX) error {
+		if _, err := tx.ExecContext(ctx, "SELECT 1"); !errors.Is(err, ErrNotSupported) {
+			t.Errorf("ExecContext() error = %v, want %v", err, ErrNotSupported)
+		}
+		var n int
+		if err := tx.QueryRowContext(ctx, "SELECT 1").Scan(&n); !errors.Is(err, ErrNotSupported) {
+			t.Errorf("QueryRowContext().Scan() error = %v, want %v", err, ErrNotSupported)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("RunInTransaction() unexpected error: %v", err)
+	}
+}
+
+func TestStore_AfterCommit(t *testing.T) {
+	ctx := context.Background()
+	store := NewStore()
+	user := newTestUser(t, "John Doe", "john@example.com")
+	var visible *domain.User
+
+	err := store.RunInTransaction(ctx, func(ctx context.Context, tx infrastructure.DBTX) error {
+		// コミット後に実行されるため、書き込みはクエリ側から見える
+		infrastructure.AfterCommit(ctx, func() { visible, _ = store.FindByID(ctx, user.ID) })
+		return store.Save(ctx, tx, user)
+	})
+	if err != nil {
+		t.Fatalf("RunInTransaction() unexpected error: %v", err)
+	}
+	if visible == nil {
+		t.Error("AfterCommit hook should run after the write is committed")
+	}
+}
+
+func TestStore_Isolation(t *testing.T) {
+	ctx := context.Background()
+	store := NewStore()
+	user := newTestUser(t, "John Doe", "john@example.com")
+
+	err := store.RunInTransaction(ctx, func(ctx context.Context, tx infrastructure.DBTX) error {
+		if err := store.Save(ctx, tx, user); err != nil {
+			return err
+		}
+
+		// 未コミットの書き込みはクエリ側からは見えない
+		if got, _ := store.FindByID(ctx, user.ID); got != nil {
+			t.Error("uncommitted user should not be visible outside the transaction")
+		}
+		// 同一トランザクション内からは見える
+		got, err := store.FindByIDForUpdate(ctx, tx, user.ID)
+		if err != nil {
+			return err
+		}
+		if got == nil {
+			t.Error("uncommitted user should be visible inside the transaction")
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("RunInTransaction() unexpected error: %v", err)
+	}
+
+	if got, _ := store.FindByID(ctx, user.ID); got == nil {
+		t.Error("committed user should be visible")
+	}
+}
+
+func TestStore_ReturnedUsersAreCopies(t *testing.T) {
+	ctx := context.Background()
+	store := NewStore()
+	user := newTestUser(t, "John Doe", "john@example.com")
+	store.Seed(user)
+
+	got, _ := store.FindByID(ctx, user.ID)
+	got.Name = "Mutated"
+
+	again, _ := store.FindByID(ctx, user.ID)
+	if again.Name != "John Doe" {
+		t.Errorf("Name = %v, want %v", again.Name, "John Doe")
+	}
+}
+
+func TestStore_UniqueEmailOnCommit(t *testing.T) {
+	ctx := context.Background()
+	store := NewStore()
+	store.Seed(newTestUser(t, "John Doe", "john@example.com"))
+
+	err := store.RunInTransaction(ctx, func(ctx context.Context, tx infrastructure.DBTX) error {
+		return store.Save(ctx, tx, newTestUser(t, "Other", "john@example.com"))
+	})
+	if !errors.Is(err, ErrUniqueViolation) {
+		t.Fatalf("RunInTransaction() error = %v, want %v", err, ErrUniqueViolation)
+	}
+	if count, _ := store.Count(ctx, ""); count != 1 {
+		t.Errorf("Count() = %d, want 1", count)
+	}
+}
+
+func TestStore_DeleteUserCascadesGroupMembers(t *testing.T) {
+	ctx := context.Background()
+	store := NewStore()
+	user := newTestUser(t, "John Doe", "john@example.com")
+	group, err := domain.NewGroup("Engineering")
+	if err != nil {
+		t.Fatalf("Failed to create group: %v", err)
+	}
+	member, err := domain.NewGroupMember(group.ID, user.ID, domain.GroupRoleMember)
+	if err != nil {
+		t.Fatalf("Failed to create group member: %v", err)
+	}
+	store.Seed(user)
+	store.SeedGroups(group)
+	store.SeedGroupMembers(member)
+
+	err = store.RunInTransaction(ctx, func(ctx context.Context, tx infrastructure.DBTX) error {
+		return store.Delete(ctx, tx, user.ID)
+	})
+	if err != nil {
+		t.Fatalf("RunInTransaction() unexpected error: %v", err)
+	}
+	if count, _ := store.CountGroupMembers(ctx, group.ID); count != 0 {
+		t.Errorf("CountGroupMembers() = %d, want 0", count)
+	}
+	if groups, _ := store.FindGroupsByUserID(ctx, user.ID); len(groups) != 0 {
+		t.Errorf("FindGroupsByUserID() = %d groups, want 0", len(groups))
+	}
+}
+
+func TestStore_TenantIsolation(t *testing.T) {
+	const otherOrgID = "01HZZZZZZZZZZZZZZZZZZZZZZZ"
+	store := NewStore()
+	user := newTestUser(t, "John Doe", "john@example.com")
+	store.Seed(user)
+	defaultCtx := context.Background()
+	otherCtx := tenant.WithOrgID(defaultCtx, otherOrgID)
+
+	if got, _ := store.FindByID(otherCtx, user.ID); got != nil {
+		t.Error("user of another organization should not be visible")
+	}
+	if count, _ := store.Count(otherCtx, ""); count != 0 {
+		t.Errorf("Count() = %d, want 0", count)
+	}
+
+	// メールアドレスの一意性は組織ごとに判定する
+	other := newTestUser(t, "Other", "john@example.com")
+	err := store.RunInTransaction(otherCtx, func(ctx context.Context, tx infrastructure.DBTX) error {
+		return store.Save(ctx, tx, other)
+	})
+	if err != nil {
+		t.Fatalf("RunInTransaction() unexpected error: %v", err)
+	}
+	if got, _ := store.FindByEmail(otherCtx, "john@example.com"); got == nil || got.ID != other.ID {
+		t.Errorf("FindByEmail() = %+v, want %s", got, other.ID)
+	}
+	if got, _ := store.FindByEmail(defaultCtx, "john@example.com"); got == nil || got.ID != user.ID {
+		t.Errorf("FindByEmail() = %+v, want %s", got, user.ID)
+	}
+
+	// 他の組織のユーザーは上書きできない
+	err = store.RunInTransaction(otherCtx, func(ctx context.Context, tx infrastructure.DBTX) error {
+		return store.Save(ctx, tx, user)
+	})
+	if err == nil {
+		t.Error("Save() of a user in another organization should fail")
+	}
+}
+
+func TestStore_RowLockBlocksUntilCommit(t *testing.T) {
+	ctx := context.Background()
+	store := NewStore()
+	user := newTestUser(t, "John Doe", "john@example.com")
+	store.Seed(user)
+
+	locked := make(chan struct{})
+	release := make(chan struct{})
+	firstDone := make(chan error, 1)
+
+	go func() {
+		firstDone <- store.RunInTransaction(ctx, func(ctx context.Context, tx infrastructure.DBTX) error {
+			u, err := store.FindByIDForUpdate(ctx, tx, user.ID)
+			if err != nil {
+				return err
+			}
+			close(locked)
+			<-release
+			if err := u.Update("Locked Writer", u.Email); err != nil {
+				return err
+			}
+			return store.Save(ctx, tx, u)
+		})
+	}()
+	<-locked
+
+	// ロック保持中は取得できずタイムアウトする
+	timeoutCtx, cancel := context.WithTimeout(ctx, 20*time.Millisecond)
+	defer cancel()
+	err := store.RunInTransaction(timeoutCtx, func(ctx context.Context, tx infrastructure.DBTX) error {
+		_, err := store.FindByIDForUpdate(ctx, tx, user.ID)
+		return err
+	})
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("second transaction error = %v, want %v", err, context.DeadlineExceeded)
+	}
+
+	// ロック解放後は先行トランザクションの結果が見える
+	close(release)
+	if err := <-firstDone; err != nil {
+		t.Fatalf("first transaction unexpected error: %v", err)
+	}
+	err = store.RunInTransaction(ctx, func(ctx context.Context, tx infrastructure.DBTX) error {
+		u, err := store.FindByIDForUpdate(ctx, tx, user.ID)
+		if err != nil {
+			return err
+		}
+		if u.Name != "Locked Writer" {
+			t.Errorf("Name = %v, want %v", u.Name, "Locked Writer")
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("third transaction unexpected error: %v", err)
+	}
+}
+
+func TestStore_TxUsedAfterCommit(t *testing.T) {
+	ctx := context.Background()
+	store := NewStore()
+
+	var leaked infrastructure.DBTX
+	if err := store.RunInTransaction(ctx, func(_ context.Context, tx infrastructure.DBTX) error {
+		leaked = tx
+		return nil
+	}); err != nil {
+		t.Fatalf("RunInTransaction() unexpected error: %v", err)
+	}
+
+	if err := store.Save(ctx, leaked, newTestUser(t, "John Doe", "john@example.com")); err == nil {
+		t.Error("Save() with finished transaction should fail")
+	}
+}
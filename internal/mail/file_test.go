@@ -0,0 +1,38 @@
+package mail
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestFileMailer_Send(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "mail")
+	m, err := NewFileMailer(dir, "no-reply@example.com")
+	if err != nil {
+		t.Fatalf("NewFileMailer() unexpected error: %v", err)
+	}
+
+	for _, to := range []string{"first@example.com", "second@example.com"} {
+		if err := m.Send(context.Background(), Message{To: to, Subject: "hello", Body: "body"}); err != nil {
+			t.Fatalf("Send() unexpected error: %v", err)
+		}
+	}
+
+	paths, err := filepath.Glob(filepath.Join(dir, "*.eml"))
+	if err != nil || len(paths) != 2 {
+		t.Fatalf("written files = %v, %v, want 2 files", paths, err)
+	}
+	// ファイル名の順が送信した順になる
+	for i, want := range []string{"To: first@example.com", "To: second@example.com"} {
+		data, err := os.ReadFile(paths[i])
+		if err != nil {
+			t.Fatalf("ReadFile() unexpected error: %v", err)
+		}
+		if !strings.Contains(string(data), want) {
+			t.Errorf("%s = %q, want to contain %q", paths[i], data, want)
+		}
+	}
+}
@@ -0,0 +1,31 @@
+package mail
+
+import (
+	"context"
+	"log/slog"
+)
+
+// LogMailer メールを送信せず、ログに出力する（ローカル開発用）
+//
+// 本文にはトークンなどの秘密を含むため、本番では使わないこと。
+type LogMailer struct {
+	logger *slog.Logger
+}
+
+// NewLogMailer LogMailerのコンストラクタ
+func NewLogMailer(logger *slog.Logger) *LogMailer {
+	return &LogMailer{logger: logger}
+}
+
+// Send メールをログに出力する
+func (m *LogMailer) Send(ctx context.Context, msg Message) error {
+	if err := msg.validate(); err != nil {
+		return err
+	}
+	m.logger.InfoContext(ctx, "mail not delivered (log mailer)",
+		slog.String("to", msg.To),
+		slog.String("subject", msg.Subject),
+		slog.String("body", msg.Body),
+	)
+	return nil
+}
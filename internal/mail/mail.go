@@ -0,0 +1,90 @@
+// Package mail はメールの送信を提供する
+//
+// 送信方法は Mailer として差し替えられる。本番は SMTPMailer、ローカル開発とテストは
+// 送信せずにファイルへ書き出す FileMailer かログに出力する LogMailer を使う。
+package mail
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"mime"
+	"mime/quotedprintable"
+	"net/mail"
+	"strings"
+	"time"
+)
+
+// ErrInvalidMessage はヘッダーに使えない値を含むメッセージのエラー
+var ErrInvalidMessage = errors.New("mail: invalid message")
+
+// Message 送信するメール（本文はプレーンテキスト）
+type Message struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+// Mailer メールを送信するインターフェース
+type Mailer interface {
+	Send(ctx context.Context, msg Message) error
+}
+
+// validate 宛先と件名を検証する（ヘッダーインジェクションを防ぐため改行を含む値は拒否する）
+func (m Message) validate() error {
+	if strings.ContainsAny(m.To, "\r\n") || strings.ContainsAny(m.Subject, "\r\n") {
+		return fmt.Errorf("%w: header contains a line break", ErrInvalidMessage)
+	}
+	if _, err := mail.ParseAddress(m.To); err != nil {
+		return fmt.Errorf("%w: invalid recipient %q: %v", ErrInvalidMessage, m.To, err)
+	}
+	return nil
+}
+
+// format RFC 5322 形式のメッセージに変換する
+//
+// 件名は RFC 2047 でエンコードし、本文は UTF-8 の quoted-printable とする。
+func format(from string, msg Message, now time.Time) ([]byte, error) {
+	if err := msg.validate(); err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	header := func(name, value string) {
+		fmt.Fprintf(&buf, "%s: %s\r\n", name, value)
+	}
+	header("From", from)
+	header("To", msg.To)
+	header("Subject", mime.QEncoding.Encode("utf-8", msg.Subject))
+	header("Date", now.Format(time.RFC1123Z))
+	header("Message-ID", messageID(from, now))
+	header("MIME-Version", "1.0")
+	header("Content-Type", "text/plain; charset=UTF-8")
+	header("Content-Transfer-Encoding", "quoted-printable")
+	buf.WriteString("\r\n")
+
+	w := quotedprintable.NewWriter(&buf)
+	if _, err := w.Write([]byte(strings.ReplaceAll(msg.Body, "\n", "\r\n"))); err != nil {
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// messageID 送信元のドメインで一意な Message-ID を作成する
+func messageID(from string, now time.Time) string {
+	domain := "localhost"
+	if addr, err := mail.ParseAddress(from); err == nil {
+		if at := strings.LastIndex(addr.Address, "@"); at >= 0 {
+			domain = addr.Address[at+1:]
+		}
+	}
+	b := make([]byte, 8)
+	_, _ = rand.Read(b)
+	return fmt.Sprintf("<%d.%s@%s>", now.UnixNano(), hex.EncodeToString(b), domain)
+}
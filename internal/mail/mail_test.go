@@ -0,0 +1,51 @@
+package mail
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestFormat(t *testing.T) {
+	now := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	msg := Message{
+		To:      "john@example.com",
+		Subject: "メールアドレスの確認",
+		Body:    "こんにちは\nhttps://example.com/verify-email?orgId=1&token=abc",
+	}
+
+	data, err := format("App <no-reply@example.com>", msg, now)
+	if err != nil {
+		t.Fatalf("format() unexpected error: %v", err)
+	}
+	got := string(data)
+	for _, want := range []string{
+		"From: App <no-reply@example.com>\r\n",
+		"To: john@example.com\r\n",
+		"Subject: =?utf-8?q?",
+		"Date: Thu, 02 Jan 2025 03:04:05 +0000\r\n",
+		"@example.com>\r\n",
+		"Content-Type: text/plain; charset=UTF-8\r\n",
+		"Content-Transfer-Encoding: quoted-printable\r\n",
+		"\r\n\r\n",
+		// = は quoted-printable でエンコードされる
+		"orgId=3D1&token=3Dabc",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("format() = %q, want to contain %q", got, want)
+		}
+	}
+}
+
+func TestFormat_RejectsInvalidHeaders(t *testing.T) {
+	for _, msg := range []Message{
+		{To: "john@example.com\r\nBcc: eve@example.com", Subject: "hello"},
+		{To: "john@example.com", Subject: "hello\nBcc: eve@example.com"},
+		{To: "not an address", Subject: "hello"},
+	} {
+		if _, err := format("no-reply@example.com", msg, time.Now()); !errors.Is(err, ErrInvalidMessage) {
+			t.Errorf("format(%+v) error = %v, want ErrInvalidMessage", msg, err)
+		}
+	}
+}
@@ -0,0 +1,102 @@
+package mail
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"strconv"
+	"time"
+)
+
+// SMTPConfig SMTP サーバーの設定
+type SMTPConfig struct {
+	Host string
+	Port int
+	// Username が空の場合は認証しない
+	Username string
+	Password string
+	// From は送信元のアドレス（"名前 <address>" 形式も可）
+	From string
+}
+
+// SMTPMailer SMTP サーバーを経由してメールを送信する
+//
+// サーバーが STARTTLS に対応している場合は暗号化してから認証・送信する。
+type SMTPMailer struct {
+	cfg SMTPConfig
+	// now はテストで時刻を固定するために差し替える
+	now func() time.Time
+}
+
+// NewSMTPMailer SMTPMailerのコンストラクタ
+func NewSMTPMailer(cfg SMTPConfig) (*SMTPMailer, error) {
+	if cfg.Host == "" {
+		return nil, fmt.Errorf("mail: SMTP host is required")
+	}
+	if _, err := mail.ParseAddress(cfg.From); err != nil {
+		return nil, fmt.Errorf("mail: invalid sender %q: %w", cfg.From, err)
+	}
+	return &SMTPMailer{cfg: cfg, now: time.Now}, nil
+}
+
+// Send メールを送信する（ctx のキャンセルと期限は接続全体に適用する）
+func (m *SMTPMailer) Send(ctx context.Context, msg Message) error {
+	data, err := format(m.cfg.From, msg, m.now())
+	if err != nil {
+		return err
+	}
+	from, _ := mail.ParseAddress(m.cfg.From)
+	to, _ := mail.ParseAddress(msg.To)
+
+	addr := net.JoinHostPort(m.cfg.Host, strconv.Itoa(m.cfg.Port))
+	var d net.Dialer
+	conn, err := d.DialContext(ctx, "tcp", addr)
+	if err != nil {
+		return fmt.Errorf("mail: failed to connect to %s: %w", addr, err)
+	}
+	defer conn.Close()
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+	}
+	// ctx のキャンセルで接続を閉じ、応答待ちのまま残らないようにする
+	stop := context.AfterFunc(ctx, func() { _ = conn.Close() })
+	defer stop()
+
+	c, err := smtp.NewClient(conn, m.cfg.Host)
+	if err != nil {
+		return fmt.Errorf("mail: failed to start SMTP session: %w", err)
+	}
+	defer c.Close()
+
+	if ok, _ := c.Extension("STARTTLS"); ok {
+		if err := c.StartTLS(&tls.Config{ServerName: m.cfg.Host}); err != nil {
+			return fmt.Errorf("mail: failed to start TLS: %w", err)
+		}
+	}
+	if m.cfg.Username != "" {
+		auth := smtp.PlainAuth("", m.cfg.Username, m.cfg.Password, m.cfg.Host)
+		if err := c.Auth(auth); err != nil {
+			return fmt.Errorf("mail: failed to authenticate: %w", err)
+		}
+	}
+	if err := c.Mail(from.Address); err != nil {
+		return fmt.Errorf("mail: MAIL FROM rejected: %w", err)
+	}
+	if err := c.Rcpt(to.Address); err != nil {
+		return fmt.Errorf("mail: RCPT TO rejected: %w", err)
+	}
+	w, err := c.Data()
+	if err != nil {
+		return fmt.Errorf("mail: DATA rejected: %w", err)
+	}
+	if _, err := w.Write(data); err != nil {
+		return fmt.Errorf("mail: failed to write message: %w", err)
+	}
+	if err := w.Close(); err != nil {
+		return fmt.Errorf("mail: message rejected: %w", err)
+	}
+	return c.Quit()
+}
@@ -0,0 +1,125 @@
+package mail
+
+import (
+	"bufio"
+	"context"
+	"net"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+// fakeSMTPServer コマンドと受け取ったメッセージを記録する最小限の SMTP サーバー（STARTTLS には対応しない）
+type fakeSMTPServer struct {
+	addr     string
+	commands chan string
+	data     chan string
+}
+
+func newFakeSMTPServer(t *testing.T) *fakeSMTPServer {
+	t.Helper()
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to listen: %v", err)
+	}
+	t.Cleanup(func() { ln.Close() })
+	s := &fakeSMTPServer{addr: ln.Addr().String(), commands: make(chan string, 16), data: make(chan string, 1)}
+
+	go func() {
+		conn, err := ln.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		r := bufio.NewReader(conn)
+		reply := func(line string) { _, _ = conn.Write([]byte(line + "\r\n")) }
+
+		reply("220 localhost ESMTP fake")
+		for {
+			line, err := r.ReadString('\n')
+			if err != nil {
+				return
+			}
+			cmd := strings.TrimRight(line, "\r\n")
+			s.commands <- cmd
+			switch verb := strings.ToUpper(strings.SplitN(cmd, " ", 2)[0]); verb {
+			case "EHLO":
+				reply("250-localhost")
+				reply("250 AUTH PLAIN")
+			case "AUTH":
+				reply("235 2.7.0 Authentication successful")
+			case "MAIL", "RCPT":
+				reply("250 OK")
+			case "DATA":
+				reply("354 End data with <CR><LF>.<CR><LF>")
+				var b strings.Builder
+				for {
+					l, err := r.ReadString('\n')
+					if err != nil {
+						return
+					}
+					if l == ".\r\n" {
+						break
+					}
+					b.WriteString(l)
+				}
+				s.data <- b.String()
+				reply("250 OK")
+			case "QUIT":
+				reply("221 Bye")
+				return
+			default:
+				reply("502 Command not implemented")
+			}
+		}
+	}()
+	return s
+}
+
+func TestSMTPMailer_Send(t *testing.T) {
+	srv := newFakeSMTPServer(t)
+	host, portStr, _ := net.SplitHostPort(srv.addr)
+	port, _ := strconv.Atoi(portStr)
+	m, err := NewSMTPMailer(SMTPConfig{
+		Host:     host,
+		Port:     port,
+		Username: "app",
+		Password: "secret",
+		From:     "App <no-reply@example.com>",
+	})
+	if err != nil {
+		t.Fatalf("NewSMTPMailer() unexpected error: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := m.Send(ctx, Message{To: "John <john@example.com>", Subject: "hello", Body: "body"}); err != nil {
+		t.Fatalf("Send() unexpected error: %v", err)
+	}
+
+	var commands []string
+	for len(srv.commands) > 0 {
+		commands = append(commands, <-srv.commands)
+	}
+	joined := strings.Join(commands, "\n")
+	for _, want := range []string{"AUTH PLAIN", "MAIL FROM:<no-reply@example.com>", "RCPT TO:<john@example.com>", "DATA", "QUIT"} {
+		if !strings.Contains(joined, want) {
+			t.Errorf("commands = %q, want to contain %q", commands, want)
+		}
+	}
+	if data := <-srv.data; !strings.Contains(data, "To: John <john@example.com>\r\n") {
+		t.Errorf("message = %q, want To header", data)
+	}
+}
+
+func TestNewSMTPMailer_InvalidConfig(t *testing.T) {
+	for _, cfg := range []SMTPConfig{
+		{Port: 587, From: "no-reply@example.com"},
+		{Host: "smtp.example.com", Port: 587, From: "not an address"},
+	} {
+		if _, err := NewSMTPMailer(cfg); err == nil {
+			t.Errorf("NewSMTPMailer(%+v) succeeded, want error", cfg)
+		}
+	}
+}
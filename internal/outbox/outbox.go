@@ -0,0 +1,71 @@
+// Package outbox はトランザクショナルアウトボックスのリレーを提供する
+//
+// ドメインイベントは状態変更と同じ RunInTransaction 内で outbox テーブルに書き込まれ
+// （command.SaveUserEvents）、Relay がポーリングして Publisher に配信する。配信は以下の性質を持つ:
+//   - at-least-once: 配信成功後、配信済みの記録前にプロセスが落ちた場合は再配信される
+//   - 集約ごとの順序保証: 同じ集約の未配信イベントは seq 順に一件ずつ配信され、
+//     先行イベントの配信が失敗している間は後続イベントを配信しない
+//
+// コンシューマーはイベントID（Event.ID）で重複を排除すること。
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/example/go-react-cqrs-template/internal/infrastructure"
+)
+
+// NotifyChannel outbox への書き込みをコミット時に通知する PostgreSQL の LISTEN/NOTIFY チャネル
+const NotifyChannel = "outbox_events"
+
+// Event outbox に保存された配信対象のイベント
+type Event struct {
+	// Seq は outbox への書き込み順の連番
+	Seq int64
+	// ID はイベントの一意なID（ULID）。コンシューマーでの重複排除に使用する
+	ID string
+	// OrgID はイベントが発生した組織のID
+	OrgID         string
+	AggregateType string
+	AggregateID   string
+	// Type はイベント種別（例: UserCreated）
+	Type       string
+	Payload    json.RawMessage
+	OccurredAt time.Time
+	// Attempts はこれまでに失敗した配信の回数
+	Attempts int
+}
+
+// Publisher イベントを外部システムに配信する
+//
+// エラーを返したイベントはバックオフ後に再配信される。
+type Publisher interface {
+	Publish(ctx context.Context, event Event) error
+}
+
+// PublisherFunc は関数を Publisher として扱うアダプター
+type PublisherFunc func(ctx context.Context, event Event) error
+
+// Publish イベントを配信する
+func (f PublisherFunc) Publish(ctx context.Context, event Event) error {
+	return f(ctx, event)
+}
+
+// Repository outbox テーブルの操作（トランザクション内で使用）
+type Repository interface {
+	// Claim は集約ごとに最も古い配信可能な未配信イベントを行ロック付きで取得する
+	Claim(ctx context.Context, tx infrastructure.DBTX, now time.Time, limit int) ([]Event, error)
+	// MarkPublished はイベントを配信済みにする
+	MarkPublished(ctx context.Context, tx infrastructure.DBTX, seq int64, publishedAt time.Time) error
+	// MarkFailed は配信失敗を記録し、次回の配信時刻を設定する
+	MarkFailed(ctx context.Context, tx infrastructure.DBTX, seq int64, lastError string, nextAttemptAt time.Time) error
+	// DeletePublished は publishedBefore より前に配信済みになったイベントを削除する
+	DeletePublished(ctx context.Context, tx infrastructure.DBTX, publishedBefore time.Time) (int64, error)
+}
+
+// TransactionManager トランザクション管理のインターフェース
+type TransactionManager interface {
+	RunInTransaction(ctx context.Context, fn func(ctx context.Context, tx infrastructure.DBTX) error) error
+}
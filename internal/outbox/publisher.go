@@ -0,0 +1,30 @@
+package outbox
+
+import (
+	"context"
+	"log/slog"
+)
+
+// LogPublisher イベントを構造化ログに出力する Publisher
+//
+// メッセージブローカーを接続するまでのデフォルト実装。
+type LogPublisher struct {
+	log *slog.Logger
+}
+
+// NewLogPublisher LogPublisherのコンストラクタ
+func NewLogPublisher(log *slog.Logger) *LogPublisher {
+	return &LogPublisher{log: log}
+}
+
+// Publish イベントをログに出力する
+func (p *LogPublisher) Publish(ctx context.Context, event Event) error {
+	p.log.InfoContext(ctx, "domain event published",
+		slog.String("event_id", event.ID),
+		slog.String("event_type", event.Type),
+		slog.String("aggregate_type", event.AggregateType),
+		slog.String("aggregate_id", event.AggregateID),
+		slog.Int64("seq", event.Seq),
+	)
+	return nil
+}
@@ -0,0 +1,218 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/example/go-react-cqrs-template/internal/infrastructure"
+	"github.com/example/go-react-cqrs-template/internal/tenant"
+)
+
+// Relay outbox の未配信イベントを Publisher に配信する
+type Relay struct {
+	repo      Repository
+	txManager TransactionManager
+	publisher Publisher
+	log       *slog.Logger
+
+	batchSize      int
+	pollInterval   time.Duration
+	initialBackoff time.Duration
+	maxBackoff     time.Duration
+	retention      time.Duration
+	purgeInterval  time.Duration
+	now            func() time.Time
+}
+
+// Option はリレーの設定を変更する
+type Option func(*Relay)
+
+// WithBatchSize 一度のポーリングで配信するイベント数の上限を設定する
+func WithBatchSize(n int) Option {
+	return func(r *Relay) {
+		if n > 0 {
+			r.batchSize = n
+		}
+	}
+}
+
+// WithPollInterval 未配信イベントがないときのポーリング間隔を設定する
+func WithPollInterval(d time.Duration) Option {
+	return func(r *Relay) {
+		if d > 0 {
+			r.pollInterval = d
+		}
+	}
+}
+
+// WithRetryBackoff 配信失敗時の再配信までの待ち時間（指数バックオフ）を設定する
+func WithRetryBackoff(initial, max time.Duration) Option {
+	return func(r *Relay) {
+		if initial > 0 && max >= initial {
+			r.initialBackoff = initial
+			r.maxBackoff = max
+		}
+	}
+}
+
+// WithRetention 配信済みイベントの保持期間を設定する（0 の場合は削除しない）
+func WithRetention(d time.Duration) Option {
+	return func(r *Relay) {
+		r.retention = d
+	}
+}
+
+// withClock テスト用に現在時刻の取得関数を差し替える
+func withClock(now func() time.Time) Option {
+	return func(r *Relay) {
+		r.now = now
+	}
+}
+
+// NewRelay Relayのコンストラクタ
+func NewRelay(repo Repository, txManager TransactionManager, publisher Publisher, log *slog.Logger, opts ...Option) *Relay {
+	r := &Relay{
+		repo:           repo,
+		txManager:      txManager,
+		publisher:      publisher,
+		log:            log,
+		batchSize:      100,
+		pollInterval:   time.Second,
+		initialBackoff: time.Second,
+		maxBackoff:     5 * time.Minute,
+		retention:      7 * 24 * time.Hour,
+		purgeInterval:  time.Hour,
+		now:            time.Now,
+	}
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
+// Run コンテキストがキャンセルされるまでイベントを配信し続ける
+func (r *Relay) Run(ctx context.Context) error {
+	r.log.Info("outbox relay started",
+		slog.Int("batch_size", r.batchSize),
+		slog.Duration("poll_interval", r.pollInterval),
+	)
+
+	lastPurge := time.Time{}
+	for {
+		if ctx.Err() != nil {
+			r.log.Info("outbox relay stopped")
+			return nil
+		}
+
+		if r.retention > 0 && r.now().Sub(lastPurge) >= r.purgeInterval {
+			if _, err := r.Purge(ctx); err != nil && ctx.Err() == nil {
+				r.log.Error("failed to purge outbox", slog.String("error", err.Error()))
+			}
+			lastPurge = r.now()
+		}
+
+		n, err := r.ProcessBatch(ctx)
+		if err != nil && ctx.Err() == nil {
+			r.log.Error("failed to process outbox batch", slog.String("error", err.Error()))
+		}
+
+		// バッチが埋まっている場合は待たずに次を処理する
+		if err == nil && n >= r.batchSize {
+			continue
+		}
+
+		timer := time.NewTimer(r.pollInterval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+		case <-timer.C:
+		}
+	}
+}
+
+// ProcessBatch 配信可能なイベントを一括で取得して配信し、処理したイベント数を返す
+//
+// イベントのロックは配信結果の記録までトランザクション内で保持する。
+// 配信後にコミットできなかった場合、そのイベントは次回再配信される（at-least-once）。
+// すべての組織のイベントを扱う（tenant.WithAllOrgs）。
+func (r *Relay) ProcessBatch(ctx context.Context) (int, error) {
+	processed := 0
+	err := r.txManager.RunInTransaction(tenant.WithAllOrgs(ctx), func(ctx context.Context, tx infrastructure.DBTX) error {
+		events, err := r.repo.Claim(ctx, tx, r.now(), r.batchSize)
+		if err != nil {
+			return err
+		}
+
+		for _, event := range events {
+			if err := r.deliver(ctx, tx, event); err != nil {
+				return err
+			}
+			processed++
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return processed, nil
+}
+
+// deliver イベントを配信し、結果を記録する
+func (r *Relay) deliver(ctx context.Context, tx infrastructure.DBTX, event Event) error {
+	publishErr := r.publisher.Publish(ctx, event)
+	if publishErr == nil {
+		return r.repo.MarkPublished(ctx, tx, event.Seq, r.now())
+	}
+
+	// シャットダウンによる中断は失敗として記録しない（ロールバックして次回再配信する）
+	if ctx.Err() != nil {
+		return errors.Join(ctx.Err(), publishErr)
+	}
+
+	attempts := event.Attempts + 1
+	nextAttemptAt := r.now().Add(r.backoff(attempts))
+	r.log.Warn("failed to publish outbox event",
+		slog.String("event_id", event.ID),
+		slog.String("event_type", event.Type),
+		slog.String("aggregate_id", event.AggregateID),
+		slog.Int("attempts", attempts),
+		slog.Time("next_attempt_at", nextAttemptAt),
+		slog.String("error", publishErr.Error()),
+	)
+	if err := r.repo.MarkFailed(ctx, tx, event.Seq, publishErr.Error(), nextAttemptAt); err != nil {
+		return fmt.Errorf("failed to record publish failure: %w", err)
+	}
+	return nil
+}
+
+// backoff attempts 回目の失敗後の待ち時間を計算する
+func (r *Relay) backoff(attempts int) time.Duration {
+	wait := r.initialBackoff
+	for i := 1; i < attempts; i++ {
+		wait *= 2
+		if wait >= r.maxBackoff {
+			return r.maxBackoff
+		}
+	}
+	return wait
+}
+
+// Purge 保持期間を過ぎた配信済みイベントを削除し、削除件数を返す
+func (r *Relay) Purge(ctx context.Context) (int64, error) {
+	var deleted int64
+	err := r.txManager.RunInTransaction(tenant.WithAllOrgs(ctx), func(ctx context.Context, tx infrastructure.DBTX) error {
+		n, err := r.repo.DeletePublished(ctx, tx, r.now().Add(-r.retention))
+		deleted = n
+		return err
+	})
+	if err != nil {
+		return 0, err
+	}
+	if deleted > 0 {
+		r.log.Info("purged published outbox events", slog.Int64("deleted", deleted))
+	}
+	return deleted, nil
+}
@@ -0,0 +1,334 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/example/go-react-cqrs-template/internal/infrastructure"
+)
+
+// fakeRow fakeRepository が保持する outbox の行
+type fakeRow struct {
+	event         Event
+	publishedAt   time.Time
+	lastError     string
+	nextAttemptAt time.Time
+}
+
+// fakeRepository ClaimOutboxEvents と同じ選択規則を持つインメモリの Repository
+type fakeRepository struct {
+	mu   sync.Mutex
+	rows []*fakeRow
+}
+
+func (f *fakeRepository) add(aggregateID, eventType string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	seq := int64(len(f.rows) + 1)
+	f.rows = append(f.rows, &fakeRow{event: Event{
+		Seq:           seq,
+		ID:            aggregateID + "-" + eventType,
+		AggregateType: "user",
+		AggregateID:   aggregateID,
+		Type:          eventType,
+	}})
+}
+
+func (f *fakeRepository) find(seq int64) *fakeRow {
+	for _, row := range f.rows {
+		if row.event.Seq == seq {
+			return row
+		}
+	}
+	return nil
+}
+
+func (f *fakeRepository) Claim(_ context.Context, _ infrastructure.DBTX, now time.Time, limit int) ([]Event, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	seen := make(map[string]bool)
+	var events []Event
+	for _, row := range f.rows {
+		if !row.publishedAt.IsZero() || seen[row.event.AggregateID] {
+			continue
+		}
+		// 集約の先頭の未配信イベントのみが対象
+		seen[row.event.AggregateID] = true
+		if row.nextAttemptAt.After(now) || len(events) >= limit {
+			continue
+		}
+		events = append(events, row.event)
+	}
+	return events, nil
+}
+
+func (f *fakeRepository) MarkPublished(_ context.Context, _ infrastructure.DBTX, seq int64, publishedAt time.Time) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.find(seq).publishedAt = publishedAt
+	return nil
+}
+
+func (f *fakeRepository) MarkFailed(_ context.Context, _ infrastructure.DBTX, seq int64, lastError string, nextAttemptAt time.Time) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	row := f.find(seq)
+	row.event.Attempts++
+	row.lastError = lastError
+	row.nextAttemptAt = nextAttemptAt
+	return nil
+}
+
+func (f *fakeRepository) DeletePublished(_ context.Context, _ infrastructure.DBTX, publishedBefore time.Time) (int64, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	var kept []*fakeRow
+	for _, row := range f.rows {
+		if !row.publishedAt.IsZero() && row.publishedAt.Before(publishedBefore) {
+			continue
+		}
+		kept = append(kept, row)
+	}
+	deleted := int64(len(f.rows) - len(kept))
+	f.rows = kept
+	return deleted, nil
+}
+
+// fakeTxManager fn をそのまま実行する TransactionManager
+type fakeTxManager struct{}
+
+func (fakeTxManager) RunInTransaction(ctx context.Context, fn func(ctx context.Context, tx infrastructure.DBTX) error) error {
+	return fn(ctx, nil)
+}
+
+// recordingPublisher 配信されたイベントを記録し、failures に含まれるIDの配信を一度だけ失敗させる
+type recordingPublisher struct {
+	mu        sync.Mutex
+	published []Event
+	failures  map[string]bool
+}
+
+func (p *recordingPublisher) Publish(_ context.Context, event Event) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.failures[event.ID] {
+		delete(p.failures, event.ID)
+		return errors.New("broker unavailable")
+	}
+	p.published = append(p.published, event)
+	return nil
+}
+
+func (p *recordingPublisher) ids() []string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	ids := make([]string, 0, len(p.published))
+	for _, e := range p.published {
+		ids = append(ids, e.ID)
+	}
+	return ids
+}
+
+// testClock テスト用の進められる時計
+type testClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+func (c *testClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *testClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+}
+
+func newTestRelay(repo Repository, publisher Publisher, clock *testClock, opts ...Option) *Relay {
+	log := slog.New(slog.NewTextHandler(io.Discard, nil))
+	opts = append([]Option{withClock(clock.Now), WithRetryBackoff(time.Second, 10*time.Second)}, opts...)
+	return NewRelay(repo, fakeTxManager{}, publisher, log, opts...)
+}
+
+// drain 配信可能なイベントがなくなるまで ProcessBatch を繰り返す
+func drain(t *testing.T, relay *Relay) {
+	t.Helper()
+	for i := 0; i < 100; i++ {
+		n, err := relay.ProcessBatch(context.Background())
+		if err != nil {
+			t.Fatalf("ProcessBatch() unexpected error: %v", err)
+		}
+		if n == 0 {
+			return
+		}
+	}
+	t.Fatal("ProcessBatch() did not drain the outbox")
+}
+
+func TestRelay_DeliversInOrderPerAggregate(t *testing.T) {
+	repo := &fakeRepository{}
+	repo.add("a", "UserCreated")
+	repo.add("b", "UserCreated")
+	repo.add("a", "UserUpdated")
+	repo.add("a", "UserDeleted")
+	repo.add("b", "UserUpdated")
+
+	publisher := &recordingPublisher{}
+	relay := newTestRelay(repo, publisher, &testClock{now: time.Now()})
+	drain(t, relay)
+
+	want := []string{
+		"a-UserCreated", "b-UserCreated",
+		"a-UserUpdated", "b-UserUpdated",
+		"a-UserDeleted",
+	}
+	got := publisher.ids()
+	if len(got) != len(want) {
+		t.Fatalf("published = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("published = %v, want %v", got, want)
+			break
+		}
+	}
+}
+
+func TestRelay_FailureBlocksOnlyThatAggregate(t *testing.T) {
+	repo := &fakeRepository{}
+	repo.add("a", "UserCreated")
+	repo.add("a", "UserUpdated")
+	repo.add("b", "UserCreated")
+
+	clock := &testClock{now: time.Now()}
+	publisher := &recordingPublisher{failures: map[string]bool{"a-UserCreated": true}}
+	relay := newTestRelay(repo, publisher, clock)
+
+	// a の先頭が失敗している間、a の後続は配信されないが b は配信される
+	drain(t, relay)
+	if got := publisher.ids(); len(got) != 1 || got[0] != "b-UserCreated" {
+		t.Fatalf("published = %v, want [b-UserCreated]", got)
+	}
+	failed := repo.find(1)
+	if failed.event.Attempts != 1 || failed.lastError == "" {
+		t.Errorf("failed row = %+v, want attempts=1 and last error", failed)
+	}
+	if want := clock.Now().Add(time.Second); !failed.nextAttemptAt.Equal(want) {
+		t.Errorf("nextAttemptAt = %v, want %v", failed.nextAttemptAt, want)
+	}
+
+	// バックオフ経過後に再配信され、a の順序が保たれる
+	clock.Advance(time.Second)
+	drain(t, relay)
+	want := []string{"b-UserCreated", "a-UserCreated", "a-UserUpdated"}
+	got := publisher.ids()
+	if len(got) != len(want) {
+		t.Fatalf("published = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("published = %v, want %v", got, want)
+			break
+		}
+	}
+}
+
+func TestRelay_CanceledPublishIsNotRecordedAsFailure(t *testing.T) {
+	repo := &fakeRepository{}
+	repo.add("a", "UserCreated")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	publisher := PublisherFunc(func(ctx context.Context, _ Event) error {
+		cancel()
+		return ctx.Err()
+	})
+	relay := newTestRelay(repo, publisher, &testClock{now: time.Now()})
+
+	if _, err := relay.ProcessBatch(ctx); !errors.Is(err, context.Canceled) {
+		t.Fatalf("ProcessBatch() error = %v, want context.Canceled", err)
+	}
+	if row := repo.find(1); row.event.Attempts != 0 || !row.publishedAt.IsZero() {
+		t.Errorf("row = %+v, want untouched", row)
+	}
+}
+
+func TestRelay_Backoff(t *testing.T) {
+	relay := newTestRelay(&fakeRepository{}, &recordingPublisher{}, &testClock{})
+
+	tests := []struct {
+		attempts int
+		want     time.Duration
+	}{
+		{attempts: 1, want: time.Second},
+		{attempts: 2, want: 2 * time.Second},
+		{attempts: 4, want: 8 * time.Second},
+		{attempts: 5, want: 10 * time.Second},
+		{attempts: 100, want: 10 * time.Second},
+	}
+	for _, tt := range tests {
+		if got := relay.backoff(tt.attempts); got != tt.want {
+			t.Errorf("backoff(%d) = %v, want %v", tt.attempts, got, tt.want)
+		}
+	}
+}
+
+func TestRelay_Purge(t *testing.T) {
+	repo := &fakeRepository{}
+	repo.add("a", "UserCreated")
+	repo.add("a", "UserUpdated")
+
+	clock := &testClock{now: time.Now()}
+	relay := newTestRelay(repo, &recordingPublisher{}, clock, WithRetention(time.Hour))
+	drain(t, relay)
+
+	clock.Advance(2 * time.Hour)
+	repo.add("b", "UserCreated")
+
+	deleted, err := relay.Purge(context.Background())
+	if err != nil {
+		t.Fatalf("Purge() unexpected error: %v", err)
+	}
+	if deleted != 2 || len(repo.rows) != 1 {
+		t.Errorf("Purge() deleted %d, remaining %d; want 2 deleted, 1 remaining", deleted, len(repo.rows))
+	}
+}
+
+func TestRelay_RunStopsOnCancel(t *testing.T) {
+	repo := &fakeRepository{}
+	repo.add("a", "UserCreated")
+
+	published := make(chan struct{}, 1)
+	publisher := PublisherFunc(func(context.Context, Event) error {
+		published <- struct{}{}
+		return nil
+	})
+	relay := newTestRelay(repo, publisher, &testClock{now: time.Now()}, WithPollInterval(time.Millisecond))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() { done <- relay.Run(ctx) }()
+
+	select {
+	case <-published:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Run() did not publish the event")
+	}
+	cancel()
+
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Errorf("Run() error = %v, want nil", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Run() did not stop after cancel")
+	}
+}
@@ -0,0 +1,88 @@
+package outbox
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/example/go-react-cqrs-template/internal/infrastructure"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure/dao"
+)
+
+// SQLRepository PostgreSQL の outbox テーブルを使う Repository の実装
+type SQLRepository struct{}
+
+// NewSQLRepository SQLRepositoryのコンストラクタ
+func NewSQLRepository() *SQLRepository {
+	return &SQLRepository{}
+}
+
+// Claim 集約ごとに最も古い配信可能な未配信イベントを取得する（FOR UPDATE SKIP LOCKED）
+//
+// 他のリレーがロック中のイベントはスキップし、その集約の後続イベントも返さないため、
+// 複数のリレーを同時に動かしても集約内の順序は保たれる。
+func (r *SQLRepository) Claim(ctx context.Context, tx infrastructure.DBTX, now time.Time, limit int) ([]Event, error) {
+	rows, err := dao.New(tx).ClaimOutboxEvents(ctx, dao.ClaimOutboxEventsParams{
+		NextAttemptAt: now,
+		Limit:         int32(limit),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim outbox events: %w", err)
+	}
+
+	events := make([]Event, 0, len(rows))
+	for _, row := range rows {
+		events = append(events, toEvent(row))
+	}
+	return events, nil
+}
+
+// MarkPublished イベントを配信済みにする
+func (r *SQLRepository) MarkPublished(ctx context.Context, tx infrastructure.DBTX, seq int64, publishedAt time.Time) error {
+	err := dao.New(tx).MarkOutboxEventPublished(ctx, dao.MarkOutboxEventPublishedParams{
+		PublishedAt: sql.NullTime{Time: publishedAt, Valid: true},
+		Seq:         seq,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to mark outbox event as published: %w", err)
+	}
+	return nil
+}
+
+// MarkFailed 配信失敗を記録する
+func (r *SQLRepository) MarkFailed(ctx context.Context, tx infrastructure.DBTX, seq int64, lastError string, nextAttemptAt time.Time) error {
+	err := dao.New(tx).MarkOutboxEventFailed(ctx, dao.MarkOutboxEventFailedParams{
+		LastError:     sql.NullString{String: lastError, Valid: true},
+		NextAttemptAt: nextAttemptAt,
+		Seq:           seq,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to mark outbox event as failed: %w", err)
+	}
+	return nil
+}
+
+// DeletePublished 配信済みの古いイベントを削除する
+func (r *SQLRepository) DeletePublished(ctx context.Context, tx infrastructure.DBTX, publishedBefore time.Time) (int64, error) {
+	n, err := dao.New(tx).DeletePublishedOutboxEvents(ctx, sql.NullTime{Time: publishedBefore, Valid: true})
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete published outbox events: %w", err)
+	}
+	return n, nil
+}
+
+// toEvent dao.Outbox を Event に変換
+func toEvent(row dao.Outbox) Event {
+	return Event{
+		Seq:           row.Seq,
+		ID:            row.ID,
+		OrgID:         row.OrgID,
+		AggregateType: row.AggregateType,
+		AggregateID:   row.AggregateID,
+		Type:          row.EventType,
+		Payload:       row.Payload,
+		OccurredAt:    row.OccurredAt,
+		Attempts:      int(row.Attempts),
+	}
+}
@@ -0,0 +1,157 @@
+package outbox_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/example/go-react-cqrs-template/internal/command"
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure"
+	"github.com/example/go-react-cqrs-template/internal/outbox"
+	"github.com/example/go-react-cqrs-template/internal/queryservice"
+	"github.com/example/go-react-cqrs-template/internal/testutil/dbtest"
+	"github.com/example/go-react-cqrs-template/internal/usecase"
+)
+
+func TestSQLRepository_UsecaseEventsAreRelayedInOrder(t *testing.T) {
+	db := dbtest.New(t)
+	ctx := context.Background()
+	tm := infrastructure.NewTransactionManager(db)
+	keyring := dbtest.Keyring(t)
+	query := queryservice.NewUserQueryService(db, keyring)
+	repo := command.NewUserRepository(keyring)
+
+	if _, err := usecase.NewCreateUserUsecase(query, repo, tm).Execute(ctx, "John Doe", "john@example.com"); err != nil {
+		t.Fatalf("CreateUser unexpected error: %v", err)
+	}
+	user, err := query.FindByEmail(ctx, "john@example.com")
+	if err != nil || user == nil {
+		t.Fatalf("FindByEmail() = %v, %v", user, err)
+	}
+	if err := usecase.NewUpdateUserUsecase(query, repo, tm).Execute(ctx, user.ID, "John Smith", ""); err != nil {
+		t.Fatalf("UpdateUser unexpected error: %v", err)
+	}
+	if err := usecase.NewDeleteUserUsecase(query, repo, tm).Execute(ctx, user.ID); err != nil {
+		t.Fatalf("DeleteUser unexpected error: %v", err)
+	}
+
+	var published []outbox.Event
+	relay := outbox.NewRelay(
+		outbox.NewSQLRepository(),
+		tm,
+		outbox.PublisherFunc(func(_ context.Context, e outbox.Event) error {
+			published = append(published, e)
+			return nil
+		}),
+		slog.New(slog.NewTextHandler(io.Discard, nil)),
+	)
+	for i := 0; i < 10; i++ {
+		n, err := relay.ProcessBatch(ctx)
+		if err != nil {
+			t.Fatalf("ProcessBatch() unexpected error: %v", err)
+		}
+		if n == 0 {
+			break
+		}
+	}
+
+	want := []domain.UserEventType{domain.UserEventTypeCreated, domain.UserEventTypeUpdated, domain.UserEventTypeDeleted}
+	if len(published) != len(want) {
+		t.Fatalf("published %d events, want %d", len(published), len(want))
+	}
+	for i, e := range published {
+		if e.Type != string(want[i]) || e.AggregateID != user.ID {
+			t.Errorf("published[%d] = %s/%s, want %s/%s", i, e.Type, e.AggregateID, want[i], user.ID)
+		}
+	}
+
+	var payload command.UserEventPayload
+	if err := json.Unmarshal(published[1].Payload, &payload); err != nil {
+		t.Fatalf("failed to decode payload: %v", err)
+	}
+	if payload.ID != user.ID || payload.Status != string(domain.UserStatusActive) {
+		t.Errorf("UserUpdated payload = %+v, want active user %s", payload, user.ID)
+	}
+	// 名前とメールアドレスは outbox に平文で保存しない
+	if strings.Contains(string(published[1].Payload), "john@example.com") || strings.Contains(string(published[1].Payload), "John Smith") {
+		t.Errorf("UserUpdated payload = %s, want no name or email", published[1].Payload)
+	}
+}
+
+func TestSQLRepository_RollbackDiscardsEvents(t *testing.T) {
+	db := dbtest.New(t)
+	ctx := context.Background()
+	tm := infrastructure.NewTransactionManager(db)
+
+	user, err := domain.NewUser("John Doe", "john@example.com")
+	if err != nil {
+		t.Fatalf("Failed to create user: %v", err)
+	}
+	_ = tm.RunInTransaction(ctx, func(ctx context.Context, tx infrastructure.DBTX) error {
+		if err := command.SaveUserEvents(ctx, tx, user.PullEvents()); err != nil {
+			t.Fatalf("SaveUserEvents() unexpected error: %v", err)
+		}
+		return context.Canceled
+	})
+
+	err = tm.RunInTransaction(ctx, func(ctx context.Context, tx infrastructure.DBTX) error {
+		events, err := outbox.NewSQLRepository().Claim(ctx, tx, time.Now(), 10)
+		if err != nil {
+			return err
+		}
+		if len(events) != 0 {
+			t.Errorf("Claim() returned %d events after rollback, want 0", len(events))
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Claim() unexpected error: %v", err)
+	}
+}
+
+func TestSQLRepository_ClaimSkipsLockedAggregates(t *testing.T) {
+	db := dbtest.New(t)
+	ctx := context.Background()
+	repo := outbox.NewSQLRepository()
+
+	user, err := domain.NewUser("John Doe", "john@example.com")
+	if err != nil {
+		t.Fatalf("Failed to create user: %v", err)
+	}
+	if err := user.Update("John Smith", user.Email); err != nil {
+		t.Fatalf("Update() unexpected error: %v", err)
+	}
+	if err := command.SaveUserEvents(ctx, db, user.PullEvents()); err != nil {
+		t.Fatalf("SaveUserEvents() unexpected error: %v", err)
+	}
+
+	// 一つ目のリレーが集約の先頭イベントをロックしている間
+	tx1, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		t.Fatalf("BeginTx() unexpected error: %v", err)
+	}
+	defer tx1.Rollback()
+	first, err := repo.Claim(ctx, tx1, time.Now(), 10)
+	if err != nil || len(first) != 1 || first[0].Type != string(domain.UserEventTypeCreated) {
+		t.Fatalf("Claim() tx1 = %+v, %v, want the UserCreated event", first, err)
+	}
+
+	// 二つ目のリレーは同じ集約の後続イベントを取得しない
+	tx2, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		t.Fatalf("BeginTx() unexpected error: %v", err)
+	}
+	defer tx2.Rollback()
+	second, err := repo.Claim(ctx, tx2, time.Now(), 10)
+	if err != nil {
+		t.Fatalf("Claim() tx2 unexpected error: %v", err)
+	}
+	if len(second) != 0 {
+		t.Errorf("Claim() tx2 = %+v, want no events while the aggregate head is locked", second)
+	}
+}
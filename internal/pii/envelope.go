@@ -0,0 +1,159 @@
+package pii
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strconv"
+)
+
+// ciphertextVersion 暗号文の形式のバージョン（暗号文の先頭の1バイト）
+const ciphertextVersion byte = 1
+
+// ErrDecrypt は暗号文の形式が不正な場合や、改ざん・別の行の暗号文との入れ替えを検出した場合のエラー
+var ErrDecrypt = errors.New("pii: failed to decrypt")
+
+// Sealed エンベロープ暗号化した値
+type Sealed struct {
+	// KeyID はデータキーを暗号化した鍵リングの鍵のID
+	KeyID string
+	// DataKey は鍵リングの鍵で暗号化したデータキー
+	DataKey []byte
+	// Values は Seal に渡した順の値の暗号文
+	Values [][]byte
+}
+
+// Seal values を新しいデータキーで暗号化し、データキーを主鍵で暗号化する
+//
+// aad（行のIDなど）は暗号文に結び付け、他の行の暗号文や値の順序を入れ替えた場合は Open で復号できない。
+func (k *Keyring) Seal(aad string, values ...string) (*Sealed, error) {
+	dataKey, err := randomBytes(KeySize)
+	if err != nil {
+		return nil, err
+	}
+	wrapped, err := encrypt(k.keys[k.primary], dataKey, dataKeyAAD(k.primary, aad))
+	if err != nil {
+		return nil, err
+	}
+	sealed := &Sealed{KeyID: k.primary, DataKey: wrapped, Values: make([][]byte, len(values))}
+	for i, v := range values {
+		if sealed.Values[i], err = encrypt(dataKey, []byte(v), valueAAD(aad, i)); err != nil {
+			return nil, err
+		}
+	}
+	return sealed, nil
+}
+
+// Open Seal で暗号化した値を復号する（aad は Seal と同じ値）
+func (k *Keyring) Open(aad string, sealed *Sealed) ([]string, error) {
+	dataKey, err := k.unwrap(aad, sealed)
+	if err != nil {
+		return nil, err
+	}
+	values := make([]string, len(sealed.Values))
+	for i, c := range sealed.Values {
+		plaintext, err := decrypt(dataKey, c, valueAAD(aad, i))
+		if err != nil {
+			return nil, fmt.Errorf("%w: value %d of %s", err, i, aad)
+		}
+		values[i] = string(plaintext)
+	}
+	return values, nil
+}
+
+// Rewrap データキーを主鍵で暗号化し直す（値の暗号文は変わらない）
+//
+// 既に主鍵で暗号化している場合は何もせず false を返す。
+func (k *Keyring) Rewrap(aad string, sealed *Sealed) (bool, error) {
+	if sealed.KeyID == k.primary {
+		return false, nil
+	}
+	dataKey, err := k.unwrap(aad, sealed)
+	if err != nil {
+		return false, err
+	}
+	wrapped, err := encrypt(k.keys[k.primary], dataKey, dataKeyAAD(k.primary, aad))
+	if err != nil {
+		return false, err
+	}
+	sealed.KeyID, sealed.DataKey = k.primary, wrapped
+	return true, nil
+}
+
+// BlindIndex 値のブラインドインデックス（HMAC-SHA256）を返す
+//
+// 同じ scope（組織のIDなど）と値からは常に同じインデックスになり、scope が異なると同じ値でも別のインデックスになる。
+func (k *Keyring) BlindIndex(scope, value string) []byte {
+	mac := hmac.New(sha256.New, k.indexKey)
+	mac.Write(binary.BigEndian.AppendUint32(nil, uint32(len(scope))))
+	mac.Write([]byte(scope))
+	mac.Write([]byte(value))
+	return mac.Sum(nil)
+}
+
+// unwrap 鍵リングの鍵で暗号化したデータキーを復号する
+func (k *Keyring) unwrap(aad string, sealed *Sealed) ([]byte, error) {
+	key, ok := k.keys[sealed.KeyID]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownKey, sealed.KeyID)
+	}
+	dataKey, err := decrypt(key, sealed.DataKey, dataKeyAAD(sealed.KeyID, aad))
+	if err != nil {
+		return nil, fmt.Errorf("%w: data key of %s", err, aad)
+	}
+	return dataKey, nil
+}
+
+// dataKeyAAD データキーの暗号化の追加認証データ
+func dataKeyAAD(keyID, aad string) []byte {
+	return []byte("pii/data-key\x00" + keyID + "\x00" + aad)
+}
+
+// valueAAD i 番目の値の暗号化の追加認証データ
+func valueAAD(aad string, i int) []byte {
+	return []byte("pii/value\x00" + aad + "\x00" + strconv.Itoa(i))
+}
+
+// encrypt AES-256-GCM で暗号化する（バージョン・ノンス・暗号文の順に連結する）
+func encrypt(key, plaintext, aad []byte) ([]byte, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	nonce, err := randomBytes(gcm.NonceSize())
+	if err != nil {
+		return nil, err
+	}
+	out := append([]byte{ciphertextVersion}, nonce...)
+	return gcm.Seal(out, nonce, plaintext, aad), nil
+}
+
+// decrypt encrypt で暗号化した値を復号する
+func decrypt(key, ciphertext, aad []byte) ([]byte, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(ciphertext) < 1+gcm.NonceSize()+gcm.Overhead() || ciphertext[0] != ciphertextVersion {
+		return nil, ErrDecrypt
+	}
+	nonce, body := ciphertext[1:1+gcm.NonceSize()], ciphertext[1+gcm.NonceSize():]
+	plaintext, err := gcm.Open(nil, nonce, body, aad)
+	if err != nil {
+		return nil, ErrDecrypt
+	}
+	return plaintext, nil
+}
+
+// newGCM AES-256-GCM
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
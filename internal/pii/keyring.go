@@ -0,0 +1,221 @@
+// Package pii は個人情報（ユーザーの名前・メールアドレス）の保存時の暗号化を提供する
+//
+// エンベロープ暗号化: 値は行ごとにランダムに生成したデータキー（AES-256-GCM）で暗号化し、データキーは
+// 鍵リングの主鍵で暗号化して値と共に保存する。鍵のローテーションでは新しい主鍵を鍵リングに追加し、
+// 古い鍵で暗号化したデータキーを Rewrap で主鍵で暗号化し直す（値そのものの再暗号化は不要）。
+// 古い鍵は、その鍵で暗号化したデータキーがなくなってから鍵リングから削除する。
+//
+// 暗号文のままでは検索できないため、メールアドレスは HMAC-SHA256 のブラインドインデックスで検索と一意性の検証を行う。
+package pii
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"time"
+)
+
+// KeySize 鍵リングの鍵・データキー・ブラインドインデックスの鍵の長さ（バイト）
+const KeySize = 32
+
+// ErrUnknownKey は暗号化に使った鍵が鍵リングにない場合のエラー
+var ErrUnknownKey = errors.New("pii: key is not in the keyring")
+
+// keyIDPattern 鍵のIDの形式
+var keyIDPattern = regexp.MustCompile(`^[A-Za-z0-9._-]{1,64}$`)
+
+// Keyring 個人情報の暗号化に使う鍵の集合
+//
+// 新しいデータキーは主鍵で暗号化し、復号には鍵リングのすべての鍵を使う。ブラインドインデックスの鍵は
+// ローテーションしない（変更するとすべてのインデックスを作り直す必要がある）。
+type Keyring struct {
+	primary  string
+	keys     map[string][]byte
+	indexKey []byte
+}
+
+// keyringFile 鍵リングのファイルの形式（鍵は base64）
+type keyringFile struct {
+	Primary  string            `json:"primary"`
+	Keys     map[string]string `json:"keys"`
+	IndexKey string            `json:"index_key"`
+}
+
+// NewKeyring Keyringのコンストラクタ
+//
+// keys は鍵のIDごとの KeySize バイトの鍵で、primary のIDを含む。indexKey は KeySize バイト。
+func NewKeyring(primary string, keys map[string][]byte, indexKey []byte) (*Keyring, error) {
+	if _, ok := keys[primary]; !ok {
+		return nil, fmt.Errorf("pii: primary key %q is not in the keyring", primary)
+	}
+	copied := make(map[string][]byte, len(keys))
+	for id, key := range keys {
+		if !keyIDPattern.MatchString(id) {
+			return nil, fmt.Errorf("pii: invalid key id %q", id)
+		}
+		if len(key) != KeySize {
+			return nil, fmt.Errorf("pii: key %q must be %d bytes", id, KeySize)
+		}
+		copied[id] = bytes.Clone(key)
+	}
+	if len(indexKey) != KeySize {
+		return nil, fmt.Errorf("pii: index key must be %d bytes", KeySize)
+	}
+	return &Keyring{primary: primary, keys: copied, indexKey: bytes.Clone(indexKey)}, nil
+}
+
+// GenerateKeyring ランダムな主鍵とブラインドインデックスの鍵で新しい鍵リングを作成する
+func GenerateKeyring() (*Keyring, error) {
+	id, key, err := generateKey()
+	if err != nil {
+		return nil, err
+	}
+	indexKey, err := randomBytes(KeySize)
+	if err != nil {
+		return nil, err
+	}
+	return NewKeyring(id, map[string][]byte{id: key}, indexKey)
+}
+
+// LoadKeyring 鍵リングのファイルを読み込む
+func LoadKeyring(path string) (*Keyring, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("pii: failed to read keyring: %w", err)
+	}
+	var f keyringFile
+	if err := json.Unmarshal(data, &f); err != nil {
+		return nil, fmt.Errorf("pii: failed to parse keyring %s: %w", path, err)
+	}
+	keys := make(map[string][]byte, len(f.Keys))
+	for id, encoded := range f.Keys {
+		key, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return nil, fmt.Errorf("pii: key %q in %s is not base64: %w", id, path, err)
+		}
+		keys[id] = key
+	}
+	indexKey, err := base64.StdEncoding.DecodeString(f.IndexKey)
+	if err != nil {
+		return nil, fmt.Errorf("pii: index key in %s is not base64: %w", path, err)
+	}
+	return NewKeyring(f.Primary, keys, indexKey)
+}
+
+// WriteFile 鍵リングをファイルに書き込む（所有者のみ読み書きできる権限で、書き込み途中のファイルを残さない）
+func (k *Keyring) WriteFile(path string) error {
+	f := keyringFile{
+		Primary:  k.primary,
+		Keys:     make(map[string]string, len(k.keys)),
+		IndexKey: base64.StdEncoding.EncodeToString(k.indexKey),
+	}
+	for id, key := range k.keys {
+		f.Keys[id] = base64.StdEncoding.EncodeToString(key)
+	}
+	data, err := json.MarshalIndent(f, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".keyring-*")
+	if err != nil {
+		return fmt.Errorf("pii: failed to write keyring: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+	if err := tmp.Chmod(0o600); err != nil {
+		tmp.Close()
+		return fmt.Errorf("pii: failed to write keyring: %w", err)
+	}
+	if _, err := tmp.Write(append(data, '\n')); err != nil {
+		tmp.Close()
+		return fmt.Errorf("pii: failed to write keyring: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("pii: failed to write keyring: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("pii: failed to write keyring: %w", err)
+	}
+	return nil
+}
+
+// Rotate 新しい主鍵を追加した鍵リングを返す（既存の鍵とブラインドインデックスの鍵は引き継ぐ）
+func (k *Keyring) Rotate() (*Keyring, error) {
+	id, key, err := generateKey()
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := k.keys[id]; ok {
+		return nil, fmt.Errorf("pii: key %q already exists", id)
+	}
+	keys := make(map[string][]byte, len(k.keys)+1)
+	for existing, key := range k.keys {
+		keys[existing] = key
+	}
+	keys[id] = key
+	return NewKeyring(id, keys, k.indexKey)
+}
+
+// Retire 鍵を削除した鍵リングを返す（主鍵は削除できない）
+//
+// 削除した鍵で暗号化したデータキーは復号できなくなるため、再暗号化が完了してから削除する。
+func (k *Keyring) Retire(id string) (*Keyring, error) {
+	if id == k.primary {
+		return nil, fmt.Errorf("pii: cannot retire the primary key %q", id)
+	}
+	if _, ok := k.keys[id]; !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownKey, id)
+	}
+	keys := make(map[string][]byte, len(k.keys)-1)
+	for existing, key := range k.keys {
+		if existing != id {
+			keys[existing] = key
+		}
+	}
+	return NewKeyring(k.primary, keys, k.indexKey)
+}
+
+// PrimaryKeyID 新しいデータキーの暗号化に使う主鍵のID
+func (k *Keyring) PrimaryKeyID() string {
+	return k.primary
+}
+
+// KeyIDs 鍵リングの鍵のID（昇順）
+func (k *Keyring) KeyIDs() []string {
+	ids := make([]string, 0, len(k.keys))
+	for id := range k.keys {
+		ids = append(ids, id)
+	}
+	slices.Sort(ids)
+	return ids
+}
+
+// generateKey 作成日とランダムな接尾辞のIDで新しい鍵を生成する
+func generateKey() (string, []byte, error) {
+	suffix, err := randomBytes(4)
+	if err != nil {
+		return "", nil, err
+	}
+	key, err := randomBytes(KeySize)
+	if err != nil {
+		return "", nil, err
+	}
+	return time.Now().UTC().Format("20060102") + "-" + hex.EncodeToString(suffix), key, nil
+}
+
+// randomBytes n バイトの乱数
+func randomBytes(n int) ([]byte, error) {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return nil, fmt.Errorf("pii: failed to generate random bytes: %w", err)
+	}
+	return b, nil
+}
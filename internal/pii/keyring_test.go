@@ -0,0 +1,167 @@
+package pii
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func mustGenerateKeyring(t *testing.T) *Keyring {
+	t.Helper()
+	keyring, err := GenerateKeyring()
+	if err != nil {
+		t.Fatalf("GenerateKeyring() unexpected error: %v", err)
+	}
+	return keyring
+}
+
+func TestKeyring_SealAndOpen(t *testing.T) {
+	keyring := mustGenerateKeyring(t)
+	sealed, err := keyring.Seal("user-1", "John Doe", "john@example.com")
+	if err != nil {
+		t.Fatalf("Seal() unexpected error: %v", err)
+	}
+	if sealed.KeyID != keyring.PrimaryKeyID() || len(sealed.Values) != 2 {
+		t.Fatalf("Seal() = %+v, want 2 values under %s", sealed, keyring.PrimaryKeyID())
+	}
+	if bytes.Contains(sealed.Values[1], []byte("john@example.com")) {
+		t.Error("Seal() ciphertext contains the plaintext")
+	}
+	got, err := keyring.Open("user-1", sealed)
+	if err != nil {
+		t.Fatalf("Open() unexpected error: %v", err)
+	}
+	if got[0] != "John Doe" || got[1] != "john@example.com" {
+		t.Errorf("Open() = %v, want [John Doe john@example.com]", got)
+	}
+
+	// 別の行の暗号文や値の入れ替え、改ざんは検出する
+	if _, err := keyring.Open("user-2", sealed); !errors.Is(err, ErrDecrypt) {
+		t.Errorf("Open() with other aad error = %v, want ErrDecrypt", err)
+	}
+	swapped := &Sealed{KeyID: sealed.KeyID, DataKey: sealed.DataKey, Values: [][]byte{sealed.Values[1], sealed.Values[0]}}
+	if _, err := keyring.Open("user-1", swapped); !errors.Is(err, ErrDecrypt) {
+		t.Errorf("Open() with swapped values error = %v, want ErrDecrypt", err)
+	}
+	tampered := bytes.Clone(sealed.Values[0])
+	tampered[len(tampered)-1] ^= 0xff
+	if _, err := keyring.Open("user-1", &Sealed{KeyID: sealed.KeyID, DataKey: sealed.DataKey, Values: [][]byte{tampered}}); !errors.Is(err, ErrDecrypt) {
+		t.Errorf("Open() with tampered value error = %v, want ErrDecrypt", err)
+	}
+	if _, err := mustGenerateKeyring(t).Open("user-1", sealed); !errors.Is(err, ErrUnknownKey) {
+		t.Errorf("Open() with other keyring error = %v, want ErrUnknownKey", err)
+	}
+}
+
+func TestKeyring_RotateAndRewrap(t *testing.T) {
+	old := mustGenerateKeyring(t)
+	sealed, err := old.Seal("user-1", "john@example.com")
+	if err != nil {
+		t.Fatalf("Seal() unexpected error: %v", err)
+	}
+	rotated, err := old.Rotate()
+	if err != nil {
+		t.Fatalf("Rotate() unexpected error: %v", err)
+	}
+	if rotated.PrimaryKeyID() == old.PrimaryKeyID() || len(rotated.KeyIDs()) != 2 {
+		t.Fatalf("Rotate() keys = %v, primary %s, want a new primary", rotated.KeyIDs(), rotated.PrimaryKeyID())
+	}
+	// ローテーション後も古い鍵で暗号化した値を復号でき、ブラインドインデックスは変わらない
+	if got, err := rotated.Open("user-1", sealed); err != nil || got[0] != "john@example.com" {
+		t.Fatalf("Open() after Rotate() = %v, %v", got, err)
+	}
+	if !bytes.Equal(rotated.BlindIndex("org", "john@example.com"), old.BlindIndex("org", "john@example.com")) {
+		t.Error("BlindIndex() changed after Rotate()")
+	}
+
+	value := bytes.Clone(sealed.Values[0])
+	changed, err := rotated.Rewrap("user-1", sealed)
+	if err != nil || !changed {
+		t.Fatalf("Rewrap() = %v, %v, want true", changed, err)
+	}
+	if sealed.KeyID != rotated.PrimaryKeyID() || !bytes.Equal(sealed.Values[0], value) {
+		t.Errorf("Rewrap() = %+v, want data key under %s with the same value", sealed, rotated.PrimaryKeyID())
+	}
+	if changed, err := rotated.Rewrap("user-1", sealed); err != nil || changed {
+		t.Errorf("Rewrap() again = %v, %v, want false", changed, err)
+	}
+
+	// 古い鍵を削除しても再暗号化した値は復号できる
+	retired, err := rotated.Retire(old.PrimaryKeyID())
+	if err != nil {
+		t.Fatalf("Retire() unexpected error: %v", err)
+	}
+	if got, err := retired.Open("user-1", sealed); err != nil || got[0] != "john@example.com" {
+		t.Errorf("Open() after Retire() = %v, %v", got, err)
+	}
+	if _, err := retired.Retire(retired.PrimaryKeyID()); err == nil {
+		t.Error("Retire() of the primary key expected error")
+	}
+}
+
+func TestKeyring_BlindIndex(t *testing.T) {
+	keyring := mustGenerateKeyring(t)
+	index := keyring.BlindIndex("org-1", "john@example.com")
+	if !bytes.Equal(index, keyring.BlindIndex("org-1", "john@example.com")) {
+		t.Error("BlindIndex() is not deterministic")
+	}
+	for _, tt := range []struct{ scope, value string }{
+		{"org-2", "john@example.com"},
+		{"org-1", "jane@example.com"},
+		// scope と値の境界をずらしても同じにならない
+		{"org-1j", "ohn@example.com"},
+	} {
+		if bytes.Equal(index, keyring.BlindIndex(tt.scope, tt.value)) {
+			t.Errorf("BlindIndex(%q, %q) equals BlindIndex(org-1, john@example.com)", tt.scope, tt.value)
+		}
+	}
+	if bytes.Equal(index, mustGenerateKeyring(t).BlindIndex("org-1", "john@example.com")) {
+		t.Error("BlindIndex() with other index key is the same")
+	}
+}
+
+func TestKeyring_File(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "keyring.json")
+	keyring := mustGenerateKeyring(t)
+	if err := keyring.WriteFile(path); err != nil {
+		t.Fatalf("WriteFile() unexpected error: %v", err)
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatalf("Stat() unexpected error: %v", err)
+	}
+	if perm := info.Mode().Perm(); perm != 0o600 {
+		t.Errorf("mode = %o, want 600", perm)
+	}
+
+	loaded, err := LoadKeyring(path)
+	if err != nil {
+		t.Fatalf("LoadKeyring() unexpected error: %v", err)
+	}
+	sealed, err := keyring.Seal("user-1", "John Doe")
+	if err != nil {
+		t.Fatalf("Seal() unexpected error: %v", err)
+	}
+	if got, err := loaded.Open("user-1", sealed); err != nil || got[0] != "John Doe" {
+		t.Errorf("Open() with loaded keyring = %v, %v", got, err)
+	}
+	if loaded.PrimaryKeyID() != keyring.PrimaryKeyID() {
+		t.Errorf("PrimaryKeyID() = %s, want %s", loaded.PrimaryKeyID(), keyring.PrimaryKeyID())
+	}
+
+	for name, content := range map[string]string{
+		"missing primary": `{"primary":"k1","keys":{},"index_key":""}`,
+		"short key":       `{"primary":"k1","keys":{"k1":"AAAA"},"index_key":"AAAA"}`,
+		"not json":        `primary=k1`,
+	} {
+		invalid := filepath.Join(t.TempDir(), "keyring.json")
+		if err := os.WriteFile(invalid, []byte(content), 0o600); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := LoadKeyring(invalid); err == nil {
+			t.Errorf("LoadKeyring() with %s expected error", name)
+		}
+	}
+}
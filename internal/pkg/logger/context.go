@@ -32,6 +32,23 @@ func GenerateRequestID() string {
 	return uuid.New().String()
 }
 
+// IsValidRequestID は外部から受け取ったリクエストIDがログに載せても安全な形式か判定します
+// 英数字と "-", "_", "." のみ、最大128文字まで許可します
+func IsValidRequestID(id string) bool {
+	if id == "" || len(id) > 128 {
+		return false
+	}
+	for _, c := range id {
+		switch {
+		case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z', c >= '0' && c <= '9':
+		case c == '-', c == '_', c == '.':
+		default:
+			return false
+		}
+	}
+	return true
+}
+
 // WithLogger はコンテキストにロガーを追加します
 func WithLogger(ctx context.Context, logger *slog.Logger) context.Context {
 	return context.WithValue(ctx, loggerKey, logger)
@@ -6,13 +6,21 @@ import (
 	"time"
 )
 
+// RequestIDHeader はリクエストIDを伝搬するHTTPヘッダー
+const RequestIDHeader = "X-Request-ID"
+
 // Middleware はHTTPリクエストにリクエストIDを付与し、ログを記録するミドルウェアです
+// 呼び出し元から有効な X-Request-ID が渡された場合はそれを引き継ぎます
 func Middleware(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		start := time.Now()
 
-		// リクエストIDを生成
-		requestID := GenerateRequestID()
+		// リクエストIDを引き継ぐか、なければ生成
+		requestID := r.Header.Get(RequestIDHeader)
+		if !IsValidRequestID(requestID) {
+			requestID = GenerateRequestID()
+		}
+		w.Header().Set(RequestIDHeader, requestID)
 
 		// コンテキストにリクエストIDを追加
 		ctx := WithRequestID(r.Context(), requestID)
@@ -58,3 +66,15 @@ func (rw *responseWriter) WriteHeader(code int) {
 	rw.statusCode = code
 	rw.ResponseWriter.WriteHeader(code)
 }
+
+// Flush 元の http.ResponseWriter が対応している場合はフラッシュする（gRPC などストリーミングのレスポンスに必要）
+func (rw *responseWriter) Flush() {
+	if f, ok := rw.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+// Unwrap 元の http.ResponseWriter を返す（http.ResponseController による Flush などに必要）
+func (rw *responseWriter) Unwrap() http.ResponseWriter {
+	return rw.ResponseWriter
+}
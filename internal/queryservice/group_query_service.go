@@ -0,0 +1,109 @@
+package queryservice
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure/dao"
+	"github.com/example/go-react-cqrs-template/internal/tenant"
+)
+
+// GroupQueryService グループ・メンバーシップの読み取り操作を担当
+type GroupQueryService struct {
+	db *sql.DB
+}
+
+// NewGroupQueryService GroupQueryServiceのコンストラクタ
+func NewGroupQueryService(db *sql.DB) *GroupQueryService {
+	return &GroupQueryService{db: db}
+}
+
+// FindGroupByID IDでグループを検索
+func (q *GroupQueryService) FindGroupByID(ctx context.Context, id string) (*domain.Group, error) {
+	g, err := read(ctx, q.db, func(queries *dao.Queries) (dao.Group, error) {
+		return queries.GetGroupByID(ctx, dao.GetGroupByIDParams{
+			OrgID: tenant.OrgID(ctx),
+			ID:    id,
+		})
+	})
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &domain.Group{
+		ID:        g.ID,
+		Name:      g.Name,
+		CreatedAt: g.CreatedAt,
+		UpdatedAt: g.UpdatedAt,
+	}, nil
+}
+
+// FindGroupMembers グループのメンバーを参加した順に取得（ページネーション対応）
+func (q *GroupQueryService) FindGroupMembers(ctx context.Context, groupID string, limit, offset int) ([]*domain.GroupMember, error) {
+	members, err := read(ctx, q.db, func(queries *dao.Queries) ([]dao.GroupMember, error) {
+		return queries.ListGroupMembers(ctx, dao.ListGroupMembersParams{
+			OrgID:   tenant.OrgID(ctx),
+			GroupID: groupID,
+			Limit:   int32(limit),
+			Offset:  int32(offset),
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*domain.GroupMember, len(members))
+	for i, m := range members {
+		result[i] = &domain.GroupMember{
+			GroupID:   m.GroupID,
+			UserID:    m.UserID,
+			Role:      domain.GroupRole(m.Role),
+			CreatedAt: m.CreatedAt,
+			UpdatedAt: m.UpdatedAt,
+		}
+	}
+	return result, nil
+}
+
+// CountGroupMembers グループのメンバー数を取得
+func (q *GroupQueryService) CountGroupMembers(ctx context.Context, groupID string) (int, error) {
+	count, err := read(ctx, q.db, func(queries *dao.Queries) (int64, error) {
+		return queries.CountGroupMembers(ctx, dao.CountGroupMembersParams{
+			OrgID:   tenant.OrgID(ctx),
+			GroupID: groupID,
+		})
+	})
+	if err != nil {
+		return 0, err
+	}
+	return int(count), nil
+}
+
+// FindGroupsByUserID ユーザーが所属するグループをロールと共にグループ名の順に取得
+func (q *GroupQueryService) FindGroupsByUserID(ctx context.Context, userID string) ([]*domain.GroupMembership, error) {
+	rows, err := read(ctx, q.db, func(queries *dao.Queries) ([]dao.ListGroupsByUserIDRow, error) {
+		return queries.ListGroupsByUserID(ctx, dao.ListGroupsByUserIDParams{
+			OrgID:  tenant.OrgID(ctx),
+			UserID: userID,
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*domain.GroupMembership, len(rows))
+	for i, r := range rows {
+		result[i] = &domain.GroupMembership{
+			Group: &domain.Group{
+				ID:        r.ID,
+				Name:      r.Name,
+				CreatedAt: r.CreatedAt,
+				UpdatedAt: r.UpdatedAt,
+			},
+			Role:     domain.GroupRole(r.Role),
+			JoinedAt: r.JoinedAt,
+		}
+	}
+	return result, nil
+}
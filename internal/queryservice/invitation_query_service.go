@@ -0,0 +1,84 @@
+package queryservice
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure/dao"
+	"github.com/example/go-react-cqrs-template/internal/pii"
+	"github.com/example/go-react-cqrs-template/internal/tenant"
+)
+
+// InvitationQueryService 招待の読み取り操作を担当
+type InvitationQueryService struct {
+	db      *sql.DB
+	keyring *pii.Keyring
+}
+
+// NewInvitationQueryService InvitationQueryServiceのコンストラクタ（keyring で招待先のメールアドレスを復号する）
+func NewInvitationQueryService(db *sql.DB, keyring *pii.Keyring) *InvitationQueryService {
+	return &InvitationQueryService{db: db, keyring: keyring}
+}
+
+// FindPendingInvitations 承諾待ちで有効期限内の招待を新しい順に取得（ページネーション対応）
+func (q *InvitationQueryService) FindPendingInvitations(ctx context.Context, now time.Time, limit, offset int) ([]*domain.Invitation, error) {
+	rows, err := read(ctx, q.db, func(queries *dao.Queries) ([]dao.Invitation, error) {
+		return queries.ListPendingInvitations(ctx, dao.ListPendingInvitationsParams{
+			OrgID:     tenant.OrgID(ctx),
+			ExpiresAt: now,
+			Limit:     int32(limit),
+			Offset:    int32(offset),
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*domain.Invitation, len(rows))
+	for i, inv := range rows {
+		if result[i], err = toDomainInvitation(q.keyring, inv); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+// CountPendingInvitations 承諾待ちで有効期限内の招待の数を取得
+func (q *InvitationQueryService) CountPendingInvitations(ctx context.Context, now time.Time) (int, error) {
+	count, err := read(ctx, q.db, func(queries *dao.Queries) (int64, error) {
+		return queries.CountPendingInvitations(ctx, dao.CountPendingInvitationsParams{
+			OrgID:     tenant.OrgID(ctx),
+			ExpiresAt: now,
+		})
+	})
+	if err != nil {
+		return 0, err
+	}
+	return int(count), nil
+}
+
+// toDomainInvitation dao.Invitationを復号してdomain.Invitationに変換
+func toDomainInvitation(keyring *pii.Keyring, inv dao.Invitation) (*domain.Invitation, error) {
+	values, err := keyring.Open(inv.ID, &pii.Sealed{
+		KeyID:   inv.PiiKeyID,
+		DataKey: inv.PiiDataKey,
+		Values:  [][]byte{inv.EmailCiphertext},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt invitation %s: %w", inv.ID, err)
+	}
+	return &domain.Invitation{
+		ID:         inv.ID,
+		UserID:     inv.UserID,
+		Email:      values[0],
+		InvitedBy:  inv.InvitedBy.String,
+		Status:     domain.InvitationStatus(inv.Status),
+		TokenHash:  inv.TokenHash,
+		ExpiresAt:  inv.ExpiresAt,
+		AcceptedAt: inv.AcceptedAt.Time,
+		CreatedAt:  inv.CreatedAt,
+		UpdatedAt:  inv.UpdatedAt,
+	}, nil
+}
@@ -0,0 +1,66 @@
+package queryservice
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure/dao"
+)
+
+// OrganizationQueryService 組織の読み取り操作を担当
+type OrganizationQueryService struct {
+	queries *dao.Queries
+}
+
+// NewOrganizationQueryService OrganizationQueryServiceのコンストラクタ
+func NewOrganizationQueryService(db *sql.DB) *OrganizationQueryService {
+	return &OrganizationQueryService{queries: dao.New(db)}
+}
+
+// FindOrganizationByID IDで組織を検索
+func (q *OrganizationQueryService) FindOrganizationByID(ctx context.Context, id string) (*domain.Organization, error) {
+	org, err := q.queries.GetOrganizationByID(ctx, id)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return toDomainOrganization(org), nil
+}
+
+// FindAllOrganizations すべての組織を取得（ページネーション対応）
+func (q *OrganizationQueryService) FindAllOrganizations(ctx context.Context, limit, offset int) ([]*domain.Organization, error) {
+	orgs, err := q.queries.ListOrganizations(ctx, dao.ListOrganizationsParams{
+		Limit:  int32(limit),
+		Offset: int32(offset),
+	})
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*domain.Organization, len(orgs))
+	for i, o := range orgs {
+		result[i] = toDomainOrganization(o)
+	}
+	return result, nil
+}
+
+// CountOrganizations 組織の総数を取得
+func (q *OrganizationQueryService) CountOrganizations(ctx context.Context) (int, error) {
+	count, err := q.queries.CountOrganizations(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return int(count), nil
+}
+
+// toDomainOrganization dao.Organizationをdomain.Organizationに変換
+func toDomainOrganization(o dao.Organization) *domain.Organization {
+	return &domain.Organization{
+		ID:        o.ID,
+		Name:      o.Name,
+		CreatedAt: o.CreatedAt,
+		UpdatedAt: o.UpdatedAt,
+	}
+}
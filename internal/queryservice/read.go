@@ -0,0 +1,23 @@
+package queryservice
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/example/go-react-cqrs-template/internal/infrastructure"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure/dao"
+)
+
+// read 読み取りを app.org_id を設定した読み取り専用のトランザクションで実行する
+//
+// 行レベルセキュリティ（db/rls）は app.org_id のないトランザクションに行を返さないため、
+// クエリサービスの読み取りはすべてこれを通す。
+func read[T any](ctx context.Context, db *sql.DB, fn func(queries *dao.Queries) (T, error)) (T, error) {
+	var result T
+	err := infrastructure.RunReadOnly(ctx, db, func(tx infrastructure.DBTX) error {
+		var err error
+		result, err = fn(dao.New(tx))
+		return err
+	})
+	return result, err
+}
@@ -0,0 +1,75 @@
+package queryservice
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure/dao"
+	"github.com/example/go-react-cqrs-template/internal/tenant"
+)
+
+// SessionQueryService セッションの読み取り操作を担当
+type SessionQueryService struct {
+	db *sql.DB
+}
+
+// NewSessionQueryService SessionQueryServiceのコンストラクタ
+func NewSessionQueryService(db *sql.DB) *SessionQueryService {
+	return &SessionQueryService{db: db}
+}
+
+// FindSessionByID IDでセッションを検索
+func (q *SessionQueryService) FindSessionByID(ctx context.Context, id string) (*domain.Session, error) {
+	s, err := read(ctx, q.db, func(queries *dao.Queries) (dao.Session, error) {
+		return queries.GetSessionByID(ctx, dao.GetSessionByIDParams{
+			OrgID: tenant.OrgID(ctx),
+			ID:    id,
+		})
+	})
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return toDomainSession(s), nil
+}
+
+// FindActiveSessions 有効なユーザーのセッションを最近使用した順に取得
+func (q *SessionQueryService) FindActiveSessions(ctx context.Context, userID string, now time.Time, policy domain.SessionPolicy) ([]*domain.Session, error) {
+	rows, err := read(ctx, q.db, func(queries *dao.Queries) ([]dao.Session, error) {
+		return queries.ListActiveSessionsByUserID(ctx, dao.ListActiveSessionsByUserIDParams{
+			OrgID:      tenant.OrgID(ctx),
+			UserID:     userID,
+			ExpiresAt:  now,
+			LastSeenAt: now.Add(-policy.IdleTimeout),
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*domain.Session, len(rows))
+	for i, s := range rows {
+		result[i] = toDomainSession(s)
+	}
+	return result, nil
+}
+
+// toDomainSession dao.Sessionをdomain.Sessionに変換
+func toDomainSession(s dao.Session) *domain.Session {
+	return &domain.Session{
+		ID:     s.ID,
+		UserID: s.UserID,
+		SessionClient: domain.SessionClient{
+			Device:    s.Device,
+			IPAddress: s.IpAddress,
+			UserAgent: s.UserAgent,
+		},
+		CreatedAt:  s.CreatedAt,
+		LastSeenAt: s.LastSeenAt,
+		ExpiresAt:  s.ExpiresAt,
+		RevokedAt:  s.RevokedAt.Time,
+	}
+}
@@ -0,0 +1,64 @@
+package queryservice
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure/dao"
+	"github.com/example/go-react-cqrs-template/internal/tenant"
+)
+
+// UserLogQueryService ユーザーログの読み取り操作を担当
+type UserLogQueryService struct {
+	db *sql.DB
+}
+
+// NewUserLogQueryService UserLogQueryServiceのコンストラクタ
+func NewUserLogQueryService(db *sql.DB) *UserLogQueryService {
+	return &UserLogQueryService{db: db}
+}
+
+// FindLogsByUserIDs 複数ユーザーのログを一度のクエリで取得（ユーザーごとに新しい順でページネーション）
+func (q *UserLogQueryService) FindLogsByUserIDs(ctx context.Context, userIDs []string, limit, offset int) (map[string][]*domain.UserLog, error) {
+	rows, err := read(ctx, q.db, func(queries *dao.Queries) ([]dao.GetUserLogsByUserIDsRow, error) {
+		return queries.GetUserLogsByUserIDs(ctx, dao.GetUserLogsByUserIDsParams{
+			OrgID:     tenant.OrgID(ctx),
+			UserIds:   userIDs,
+			RowOffset: int32(offset),
+			RowLimit:  int32(limit),
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	result := make(map[string][]*domain.UserLog, len(userIDs))
+	for _, r := range rows {
+		result[r.UserID] = append(result[r.UserID], &domain.UserLog{
+			ID:        r.ID,
+			UserID:    r.UserID,
+			Action:    domain.UserLogAction(r.Action),
+			Reason:    r.Reason.String,
+			CreatedAt: r.CreatedAt,
+		})
+	}
+	return result, nil
+}
+
+// CountLogsByUserIDs 複数ユーザーのログ件数を一度のクエリで取得（ログのないユーザーは含まない）
+func (q *UserLogQueryService) CountLogsByUserIDs(ctx context.Context, userIDs []string) (map[string]int, error) {
+	rows, err := read(ctx, q.db, func(queries *dao.Queries) ([]dao.CountUserLogsByUserIDsRow, error) {
+		return queries.CountUserLogsByUserIDs(ctx, dao.CountUserLogsByUserIDsParams{
+			OrgID:   tenant.OrgID(ctx),
+			UserIds: userIDs,
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	result := make(map[string]int, len(rows))
+	for _, r := range rows {
+		result[r.UserID] = int(r.Count)
+	}
+	return result, nil
+}
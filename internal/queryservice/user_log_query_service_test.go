@@ -0,0 +1,60 @@
+package queryservice_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure/dao"
+	"github.com/example/go-react-cqrs-template/internal/queryservice"
+	"github.com/example/go-react-cqrs-template/internal/tenant"
+	"github.com/example/go-react-cqrs-template/internal/testutil/dbtest"
+)
+
+func TestUserLogQueryService_FindLogsByUserIDs(t *testing.T) {
+	db := dbtest.New(t)
+	ctx := context.Background()
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	logs := []dao.CreateUserLogParams{
+		{ID: "01ARZ3NDEKTSV4RRFFQ69G5FB1", OrgID: tenant.DefaultOrgID, UserID: "01ARZ3NDEKTSV4RRFFQ69G5FA1", Action: "created", CreatedAt: base},
+		{ID: "01ARZ3NDEKTSV4RRFFQ69G5FB2", OrgID: tenant.DefaultOrgID, UserID: "01ARZ3NDEKTSV4RRFFQ69G5FA2", Action: "created", CreatedAt: base.Add(time.Hour)},
+		{ID: "01ARZ3NDEKTSV4RRFFQ69G5FB3", OrgID: tenant.DefaultOrgID, UserID: "01ARZ3NDEKTSV4RRFFQ69G5FA2", Action: "deleted", CreatedAt: base.Add(2 * time.Hour)},
+		{ID: "01ARZ3NDEKTSV4RRFFQ69G5FB4", OrgID: tenant.DefaultOrgID, UserID: "01ARZ3NDEKTSV4RRFFQ69G5FA3", Action: "created", CreatedAt: base},
+	}
+	for _, p := range logs {
+		if err := dao.New(db).CreateUserLog(ctx, p); err != nil {
+			t.Fatalf("CreateUserLog() unexpected error: %v", err)
+		}
+	}
+	qs := queryservice.NewUserLogQueryService(db)
+	ids := []string{"01ARZ3NDEKTSV4RRFFQ69G5FA1", "01ARZ3NDEKTSV4RRFFQ69G5FA2"}
+
+	got, err := qs.FindLogsByUserIDs(ctx, ids, 1, 0)
+	if err != nil {
+		t.Fatalf("FindLogsByUserIDs() unexpected error: %v", err)
+	}
+	if len(got) != 2 || len(got[ids[0]]) != 1 || len(got[ids[1]]) != 1 {
+		t.Fatalf("FindLogsByUserIDs() = %v, want one log per user", got)
+	}
+	// ユーザーごとに新しい順
+	if got[ids[1]][0].Action != domain.UserLogActionDeleted {
+		t.Errorf("newest log of %s = %s, want deleted", ids[1], got[ids[1]][0].Action)
+	}
+
+	next, err := qs.FindLogsByUserIDs(ctx, ids, 10, 1)
+	if err != nil {
+		t.Fatalf("FindLogsByUserIDs() unexpected error: %v", err)
+	}
+	if len(next[ids[0]]) != 0 || len(next[ids[1]]) != 1 || next[ids[1]][0].Action != domain.UserLogActionCreated {
+		t.Errorf("FindLogsByUserIDs() offset 1 = %v", next)
+	}
+
+	counts, err := qs.CountLogsByUserIDs(ctx, append(ids, "01ARZ3NDEKTSV4RRFFQ69G5FAV"))
+	if err != nil {
+		t.Fatalf("CountLogsByUserIDs() unexpected error: %v", err)
+	}
+	if len(counts) != 2 || counts[ids[0]] != 1 || counts[ids[1]] != 2 {
+		t.Errorf("CountLogsByUserIDs() = %v", counts)
+	}
+}
@@ -3,82 +3,125 @@ package queryservice
 import (
 	"context"
 	"database/sql"
+	"fmt"
 
 	"github.com/example/go-react-cqrs-template/internal/domain"
 	"github.com/example/go-react-cqrs-template/internal/infrastructure/dao"
+	"github.com/example/go-react-cqrs-template/internal/pii"
+	"github.com/example/go-react-cqrs-template/internal/tenant"
 )
 
-// UserQueryService ユーザー読み取り操作を担当
+// UserQueryService ユーザー読み取り操作を担当（コンテキストの組織のユーザーのみ）
 type UserQueryService struct {
-	queries *dao.Queries
+	db      *sql.DB
+	keyring *pii.Keyring
 }
 
-// NewUserQueryService UserQueryServiceのコンストラクタ
-func NewUserQueryService(db *sql.DB) *UserQueryService {
-	return &UserQueryService{queries: dao.New(db)}
+// NewUserQueryService UserQueryServiceのコンストラクタ（keyring で名前とメールアドレスを復号する）
+func NewUserQueryService(db *sql.DB, keyring *pii.Keyring) *UserQueryService {
+	return &UserQueryService{db: db, keyring: keyring}
 }
 
 // FindByID IDでユーザーを検索
 func (q *UserQueryService) FindByID(ctx context.Context, id string) (*domain.User, error) {
-	user, err := q.queries.GetUserByID(ctx, id)
+	user, err := read(ctx, q.db, func(queries *dao.Queries) (dao.User, error) {
+		return queries.GetUserByID(ctx, dao.GetUserByIDParams{
+			OrgID: tenant.OrgID(ctx),
+			ID:    id,
+		})
+	})
 	if err == sql.ErrNoRows {
 		return nil, nil
 	}
 	if err != nil {
 		return nil, err
 	}
-	return toDomainUser(user), nil
+	return toDomainUser(q.keyring, user)
 }
 
-// FindAll すべてのユーザーを取得（ページネーション対応）
-func (q *UserQueryService) FindAll(ctx context.Context, limit, offset int) ([]*domain.User, error) {
-	users, err := q.queries.ListUsers(ctx, dao.ListUsersParams{
-		Limit:  int32(limit),
-		Offset: int32(offset),
+// FindAll ユーザーを取得（ページネーション対応、status が空の場合は全ステータス）
+func (q *UserQueryService) FindAll(ctx context.Context, status domain.UserStatus, limit, offset int) ([]*domain.User, error) {
+	users, err := read(ctx, q.db, func(queries *dao.Queries) ([]dao.User, error) {
+		return queries.ListUsers(ctx, dao.ListUsersParams{
+			OrgID:  tenant.OrgID(ctx),
+			Status: toNullUserStatus(status),
+			Limit:  int32(limit),
+			Offset: int32(offset),
+		})
 	})
 	if err != nil {
 		return nil, err
 	}
-	return toDomainUsers(users), nil
+	return toDomainUsers(q.keyring, users)
 }
 
-// Count ユーザーの総数を取得
-func (q *UserQueryService) Count(ctx context.Context) (int, error) {
-	count, err := q.queries.CountUsers(ctx)
+// Count ユーザーの総数を取得（status が空の場合は全ステータス）
+func (q *UserQueryService) Count(ctx context.Context, status domain.UserStatus) (int, error) {
+	count, err := read(ctx, q.db, func(queries *dao.Queries) (int64, error) {
+		return queries.CountUsers(ctx, dao.CountUsersParams{
+			OrgID:  tenant.OrgID(ctx),
+			Status: toNullUserStatus(status),
+		})
+	})
 	if err != nil {
 		return 0, err
 	}
 	return int(count), nil
 }
 
-// FindByEmail メールアドレスでユーザーを検索
+// FindByEmail メールアドレスのブラインドインデックスでユーザーを検索
 func (q *UserQueryService) FindByEmail(ctx context.Context, email string) (*domain.User, error) {
-	user, err := q.queries.GetUserByEmail(ctx, email)
+	orgID := tenant.OrgID(ctx)
+	user, err := read(ctx, q.db, func(queries *dao.Queries) (dao.User, error) {
+		return queries.GetUserByEmail(ctx, dao.GetUserByEmailParams{
+			OrgID:      orgID,
+			EmailIndex: q.keyring.BlindIndex(orgID, email),
+		})
+	})
 	if err == sql.ErrNoRows {
 		return nil, nil
 	}
 	if err != nil {
 		return nil, err
 	}
-	return toDomainUser(user), nil
+	return toDomainUser(q.keyring, user)
 }
 
-// toDomainUser dao.Userをdomain.Userに変換
-func toDomainUser(u dao.User) *domain.User {
-	return &domain.User{
-		ID:        u.ID,
-		Name:      u.Name,
-		Email:     u.Email,
-		CreatedAt: u.CreatedAt,
-		UpdatedAt: u.UpdatedAt,
+// toDomainUser dao.Userを復号してdomain.Userに変換
+func toDomainUser(keyring *pii.Keyring, u dao.User) (*domain.User, error) {
+	values, err := keyring.Open(u.ID, &pii.Sealed{
+		KeyID:   u.PiiKeyID,
+		DataKey: u.PiiDataKey,
+		Values:  [][]byte{u.NameCiphertext, u.EmailCiphertext},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt user %s: %w", u.ID, err)
 	}
+	return &domain.User{
+		ID:              u.ID,
+		Name:            values[0],
+		Email:           values[1],
+		Status:          domain.UserStatus(u.Status),
+		EmailVerifiedAt: u.EmailVerifiedAt.Time,
+		CreatedAt:       u.CreatedAt,
+		UpdatedAt:       u.UpdatedAt,
+	}, nil
+}
+
+// toNullUserStatus ステータスの絞り込み条件を変換（空の場合は NULL）
+func toNullUserStatus(status domain.UserStatus) sql.NullString {
+	return sql.NullString{String: string(status), Valid: status != ""}
 }
 
-// toDomainUsers []dao.Userを[]*domain.Userに変換
-func toDomainUsers(users []dao.User) []*domain.User {
+// toDomainUsers []dao.Userを復号して[]*domain.Userに変換
+func toDomainUsers(keyring *pii.Keyring, users []dao.User) ([]*domain.User, error) {
 	result := make([]*domain.User, len(users))
 	for i, u := range users {
-		result[i] = toDomainUser(u)
+		user, err := toDomainUser(keyring, u)
+		if err != nil {
+			return nil, err
+		}
+		result[i] = user
 	}
-	return result
+	return result, nil
 }
@@ -0,0 +1,127 @@
+package queryservice_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/example/go-react-cqrs-template/internal/infrastructure/dao"
+	"github.com/example/go-react-cqrs-template/internal/queryservice"
+	"github.com/example/go-react-cqrs-template/internal/tenant"
+	"github.com/example/go-react-cqrs-template/internal/testutil/dbtest"
+)
+
+var seedUsers = []struct{ id, name, email string }{
+	{id: "01ARZ3NDEKTSV4RRFFQ69G5FA1", name: "Alice", email: "alice@example.com"},
+	{id: "01ARZ3NDEKTSV4RRFFQ69G5FA2", name: "Bob", email: "bob@example.com"},
+	{id: "01ARZ3NDEKTSV4RRFFQ69G5FA3", name: "Carol", email: "carol@example.com"},
+}
+
+func newSeededService(t *testing.T) *queryservice.UserQueryService {
+	t.Helper()
+	db := dbtest.New(t)
+	keyring := dbtest.Keyring(t)
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	for i, u := range seedUsers {
+		sealed, err := keyring.Seal(u.id, u.name, u.email)
+		if err != nil {
+			t.Fatalf("Seal() unexpected error: %v", err)
+		}
+		createdAt := base.Add(time.Duration(i) * time.Hour)
+		if err := dao.New(db).CreateUser(context.Background(), dao.CreateUserParams{
+			ID:              u.id,
+			OrgID:           tenant.DefaultOrgID,
+			NameCiphertext:  sealed.Values[0],
+			EmailCiphertext: sealed.Values[1],
+			EmailIndex:      keyring.BlindIndex(tenant.DefaultOrgID, u.email),
+			PiiKeyID:        sealed.KeyID,
+			PiiDataKey:      sealed.DataKey,
+			CreatedAt:       createdAt,
+			UpdatedAt:       createdAt,
+		}); err != nil {
+			t.Fatalf("CreateUser() unexpected error: %v", err)
+		}
+	}
+	return queryservice.NewUserQueryService(db, keyring)
+}
+
+func TestUserQueryService_FindByID(t *testing.T) {
+	qs := newSeededService(t)
+	ctx := context.Background()
+
+	got, err := qs.FindByID(ctx, "01ARZ3NDEKTSV4RRFFQ69G5FA2")
+	if err != nil {
+		t.Fatalf("FindByID() unexpected error: %v", err)
+	}
+	if got == nil || got.Name != "Bob" {
+		t.Errorf("FindByID() = %+v, want Bob", got)
+	}
+
+	missing, err := qs.FindByID(ctx, "01ARZ3NDEKTSV4RRFFQ69G5FAV")
+	if err != nil || missing != nil {
+		t.Errorf("FindByID() missing = %v, %v, want nil, nil", missing, err)
+	}
+}
+
+func TestUserQueryService_FindByEmail(t *testing.T) {
+	qs := newSeededService(t)
+	ctx := context.Background()
+
+	got, err := qs.FindByEmail(ctx, "carol@example.com")
+	if err != nil {
+		t.Fatalf("FindByEmail() unexpected error: %v", err)
+	}
+	if got == nil || got.ID != "01ARZ3NDEKTSV4RRFFQ69G5FA3" {
+		t.Errorf("FindByEmail() = %+v, want Carol", got)
+	}
+
+	missing, err := qs.FindByEmail(ctx, "nobody@example.com")
+	if err != nil || missing != nil {
+		t.Errorf("FindByEmail() missing = %v, %v, want nil, nil", missing, err)
+	}
+}
+
+func TestUserQueryService_FindAll(t *testing.T) {
+	qs := newSeededService(t)
+
+	tests := []struct {
+		name      string
+		limit     int
+		offset    int
+		wantNames []string
+	}{
+		{name: "newest first", limit: 10, offset: 0, wantNames: []string{"Carol", "Bob", "Alice"}},
+		{name: "limit", limit: 1, offset: 0, wantNames: []string{"Carol"}},
+		{name: "offset", limit: 10, offset: 2, wantNames: []string{"Alice"}},
+		{name: "offset beyond total", limit: 10, offset: 10, wantNames: []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := qs.FindAll(context.Background(), "", tt.limit, tt.offset)
+			if err != nil {
+				t.Fatalf("FindAll() unexpected error: %v", err)
+			}
+			if len(got) != len(tt.wantNames) {
+				t.Fatalf("len(FindAll()) = %d, want %d", len(got), len(tt.wantNames))
+			}
+			for i, name := range tt.wantNames {
+				if got[i].Name != name {
+					t.Errorf("FindAll()[%d].Name = %s, want %s", i, got[i].Name, name)
+				}
+			}
+		})
+	}
+}
+
+func TestUserQueryService_Count(t *testing.T) {
+	qs := newSeededService(t)
+
+	got, err := qs.Count(context.Background(), "")
+	if err != nil {
+		t.Fatalf("Count() unexpected error: %v", err)
+	}
+	if got != len(seedUsers) {
+		t.Errorf("Count() = %d, want %d", got, len(seedUsers))
+	}
+}
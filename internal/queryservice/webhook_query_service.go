@@ -0,0 +1,144 @@
+package queryservice
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure/dao"
+	"github.com/example/go-react-cqrs-template/internal/tenant"
+)
+
+// WebhookQueryService Webhook購読と配信ログの読み取り操作を担当
+type WebhookQueryService struct {
+	db *sql.DB
+}
+
+// NewWebhookQueryService WebhookQueryServiceのコンストラクタ
+func NewWebhookQueryService(db *sql.DB) *WebhookQueryService {
+	return &WebhookQueryService{db: db}
+}
+
+// FindSubscriptionByID IDでWebhook購読を検索
+func (q *WebhookQueryService) FindSubscriptionByID(ctx context.Context, id string) (*domain.WebhookSubscription, error) {
+	sub, err := read(ctx, q.db, func(queries *dao.Queries) (dao.WebhookSubscription, error) {
+		return queries.GetWebhookSubscriptionByID(ctx, dao.GetWebhookSubscriptionByIDParams{
+			OrgID: tenant.OrgID(ctx),
+			ID:    id,
+		})
+	})
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return toDomainWebhookSubscription(sub), nil
+}
+
+// FindAllSubscriptions すべてのWebhook購読を取得（ページネーション対応）
+func (q *WebhookQueryService) FindAllSubscriptions(ctx context.Context, limit, offset int) ([]*domain.WebhookSubscription, error) {
+	subs, err := read(ctx, q.db, func(queries *dao.Queries) ([]dao.WebhookSubscription, error) {
+		return queries.ListWebhookSubscriptions(ctx, dao.ListWebhookSubscriptionsParams{
+			OrgID:  tenant.OrgID(ctx),
+			Limit:  int32(limit),
+			Offset: int32(offset),
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*domain.WebhookSubscription, len(subs))
+	for i, s := range subs {
+		result[i] = toDomainWebhookSubscription(s)
+	}
+	return result, nil
+}
+
+// CountSubscriptions Webhook購読の総数を取得
+func (q *WebhookQueryService) CountSubscriptions(ctx context.Context) (int, error) {
+	count, err := read(ctx, q.db, func(queries *dao.Queries) (int64, error) {
+		return queries.CountWebhookSubscriptions(ctx, tenant.OrgID(ctx))
+	})
+	if err != nil {
+		return 0, err
+	}
+	return int(count), nil
+}
+
+// FindDeliveries 購読の配信ログを新しい順に取得（status が空の場合は全状態）
+func (q *WebhookQueryService) FindDeliveries(ctx context.Context, subscriptionID string, status domain.WebhookDeliveryStatus, limit, offset int) ([]*domain.WebhookDelivery, error) {
+	deliveries, err := read(ctx, q.db, func(queries *dao.Queries) ([]dao.WebhookDelivery, error) {
+		return queries.ListWebhookDeliveries(ctx, dao.ListWebhookDeliveriesParams{
+			SubscriptionID: subscriptionID,
+			OrgID:          tenant.OrgID(ctx),
+			Status:         toNullStatus(status),
+			Limit:          int32(limit),
+			Offset:         int32(offset),
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*domain.WebhookDelivery, len(deliveries))
+	for i, d := range deliveries {
+		result[i] = toDomainWebhookDelivery(d)
+	}
+	return result, nil
+}
+
+// CountDeliveries 購読の配信ログの件数を取得（status が空の場合は全状態）
+func (q *WebhookQueryService) CountDeliveries(ctx context.Context, subscriptionID string, status domain.WebhookDeliveryStatus) (int, error) {
+	count, err := read(ctx, q.db, func(queries *dao.Queries) (int64, error) {
+		return queries.CountWebhookDeliveries(ctx, dao.CountWebhookDeliveriesParams{
+			SubscriptionID: subscriptionID,
+			OrgID:          tenant.OrgID(ctx),
+			Status:         toNullStatus(status),
+		})
+	})
+	if err != nil {
+		return 0, err
+	}
+	return int(count), nil
+}
+
+// toNullStatus 配信状態の絞り込み条件を変換（空の場合は NULL）
+func toNullStatus(status domain.WebhookDeliveryStatus) sql.NullString {
+	return sql.NullString{String: string(status), Valid: status != ""}
+}
+
+// toDomainWebhookSubscription dao.WebhookSubscriptionをdomain.WebhookSubscriptionに変換
+func toDomainWebhookSubscription(s dao.WebhookSubscription) *domain.WebhookSubscription {
+	eventTypes := make([]domain.UserEventType, len(s.EventTypes))
+	for i, t := range s.EventTypes {
+		eventTypes[i] = domain.UserEventType(t)
+	}
+	return &domain.WebhookSubscription{
+		ID:         s.ID,
+		URL:        s.Url,
+		Secret:     s.Secret,
+		EventTypes: eventTypes,
+		Active:     s.Active,
+		CreatedAt:  s.CreatedAt,
+		UpdatedAt:  s.UpdatedAt,
+	}
+}
+
+// toDomainWebhookDelivery dao.WebhookDeliveryをdomain.WebhookDeliveryに変換
+func toDomainWebhookDelivery(d dao.WebhookDelivery) *domain.WebhookDelivery {
+	return &domain.WebhookDelivery{
+		ID:             d.ID,
+		SubscriptionID: d.SubscriptionID,
+		EventID:        d.EventID,
+		EventType:      domain.UserEventType(d.EventType),
+		Payload:        d.Payload,
+		Status:         domain.WebhookDeliveryStatus(d.Status),
+		Attempts:       int(d.Attempts),
+		LastStatusCode: int(d.LastStatusCode.Int32),
+		LastError:      d.LastError.String,
+		NextAttemptAt:  d.NextAttemptAt,
+		DeliveredAt:    d.DeliveredAt.Time,
+		CreatedAt:      d.CreatedAt,
+		UpdatedAt:      d.UpdatedAt,
+	}
+}
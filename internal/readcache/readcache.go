@@ -0,0 +1,172 @@
+// Package readcache はリポジトリの読み取りキャッシュ（usercache, sessioncache）に共通する仕組みを提供する
+//
+// Cache はキーで読み込む値を以下の性質でキャッシュする:
+//   - LRU で件数を制限し、エントリは TTL で失効する。存在しない値（nil）は NegativeTTL の間だけキャッシュする
+//   - 同じキーへの同時のキャッシュミスは1回の読み込みにまとめる
+//   - エントリは読み込んだスコープ（テナント）のもので、他のスコープからの読み取りにはヒットしない
+//   - 削除のたびに世代を進め、読み込み中に削除された場合は読み込んだ値をキャッシュしない
+//
+// どの読み取りをキャッシュするか、いつ削除するかとメトリクスの名前は利用する側のパッケージが決める。
+package readcache
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	lru "github.com/hashicorp/golang-lru/v2"
+	"github.com/prometheus/client_golang/prometheus"
+	"golang.org/x/sync/singleflight"
+)
+
+// entry キャッシュのエントリ（value が nil の場合は存在しない値）
+type entry[T any] struct {
+	scope     string
+	value     *T
+	expiresAt time.Time
+}
+
+// Config Cacheの設定
+type Config struct {
+	// Size はキャッシュするエントリ数の上限（正の値）
+	Size int
+	// TTL は存在する値のエントリの有効期間
+	TTL time.Duration
+	// NegativeTTL は存在しない値のエントリの有効期間（0 の場合はキャッシュしない）
+	NegativeTTL time.Duration
+	// Now は現在時刻の取得関数（nil の場合は time.Now）
+	Now func() time.Time
+
+	// Requests は読み取りの件数を result（hit または miss）のラベルで数える
+	Requests *prometheus.CounterVec
+	// Invalidations は削除の件数を数える
+	Invalidations prometheus.Counter
+}
+
+// Cache 値 T の読み取りキャッシュ
+type Cache[T any] struct {
+	config Config
+	clone  func(*T) T
+
+	entries *lru.Cache[string, entry[T]]
+	group   singleflight.Group
+
+	// generation は削除のたびに増える。読み込み中に削除された場合は、読み込んだ値をキャッシュしない
+	mu         sync.Mutex
+	generation uint64
+}
+
+// New Cacheのコンストラクタ
+//
+// clone は値の複製を返す。呼び出し元での変更がキャッシュに影響しないよう、出し入れのたびに複製する。
+func New[T any](config Config, clone func(*T) T) *Cache[T] {
+	if config.Now == nil {
+		config.Now = time.Now
+	}
+	c := &Cache[T]{config: config, clone: clone}
+	// Size は利用する側のオプションで正の値に限定しているため lru.New はエラーを返さない
+	c.entries, _ = lru.New[string, entry[T]](config.Size)
+	return c
+}
+
+// Get スコープ scope のキー key の値を返す（キャッシュにない場合のみ load で読み込む）
+//
+// 同じキーの同時の読み込みは1回にまとめる。読み込みは待っている全員で共有するため、
+// 呼び出し元の ctx がキャンセルされても中断せず、その呼び出し元だけが ctx.Err() を返す。
+//
+// 他のスコープの読み込みと重なった場合は、その結果を使わずに load で読み込む。
+func (c *Cache[T]) Get(ctx context.Context, scope, key string, load func(context.Context) (*T, error)) (*T, error) {
+	if value, ok := c.get(scope, key); ok {
+		c.config.Requests.WithLabelValues("hit").Inc()
+		return value, nil
+	}
+	c.config.Requests.WithLabelValues("miss").Inc()
+
+	ch := c.group.DoChan(key, func() (any, error) {
+		return c.load(context.WithoutCancel(ctx), scope, key, load)
+	})
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case res := <-ch:
+		if res.Err != nil {
+			return nil, res.Err
+		}
+		loaded := res.Val.(entry[T])
+		if loaded.scope != scope {
+			return load(ctx)
+		}
+		return c.copy(loaded.value), nil
+	}
+}
+
+// Invalidate キー key のエントリを削除する
+//
+// 実行中の読み込みの結果もキャッシュせず、以降の Get は改めて読み込む。
+func (c *Cache[T]) Invalidate(key string) {
+	c.mu.Lock()
+	c.generation++
+	c.entries.Remove(key)
+	c.mu.Unlock()
+	c.group.Forget(key)
+	c.config.Invalidations.Inc()
+}
+
+// Purge すべてのエントリを削除する
+func (c *Cache[T]) Purge() {
+	c.mu.Lock()
+	c.generation++
+	c.entries.Purge()
+	c.mu.Unlock()
+	c.config.Invalidations.Inc()
+}
+
+// Len 現在のエントリ数を返す
+func (c *Cache[T]) Len() int {
+	return c.entries.Len()
+}
+
+// get スコープ scope の有効なエントリを返す（ok が true で value が nil の場合は存在しない値）
+func (c *Cache[T]) get(scope, key string) (value *T, ok bool) {
+	e, ok := c.entries.Get(key)
+	if !ok || e.scope != scope {
+		return nil, false
+	}
+	if !c.config.Now().Before(e.expiresAt) {
+		c.entries.Remove(key)
+		return nil, false
+	}
+	return c.copy(e.value), true
+}
+
+// load load で読み込み、読み込み中に削除されていなければキャッシュする
+func (c *Cache[T]) load(ctx context.Context, scope, key string, load func(context.Context) (*T, error)) (entry[T], error) {
+	c.mu.Lock()
+	generation := c.generation
+	c.mu.Unlock()
+
+	value, err := load(ctx)
+	if err != nil {
+		return entry[T]{}, err
+	}
+
+	ttl := c.config.TTL
+	if value == nil {
+		ttl = c.config.NegativeTTL
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if ttl > 0 && c.generation == generation {
+		c.entries.Add(key, entry[T]{scope: scope, value: c.copy(value), expiresAt: c.config.Now().Add(ttl)})
+	}
+	return entry[T]{scope: scope, value: value}, nil
+}
+
+// copy 値を複製する（nil の場合は nil）
+func (c *Cache[T]) copy(value *T) *T {
+	if value == nil {
+		return nil
+	}
+	copied := c.clone(value)
+	return &copied
+}
@@ -0,0 +1,73 @@
+package readcache
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+type item struct {
+	name string
+}
+
+func newTestCache(negativeTTL time.Duration) *Cache[item] {
+	return New(Config{
+		Size:          10,
+		TTL:           time.Minute,
+		NegativeTTL:   negativeTTL,
+		Requests:      prometheus.NewCounterVec(prometheus.CounterOpts{Name: "test_requests_total"}, []string{"result"}),
+		Invalidations: prometheus.NewCounter(prometheus.CounterOpts{Name: "test_invalidations_total"}),
+	}, func(v *item) item { return *v })
+}
+
+func TestCache_Get_ReturnsCopies(t *testing.T) {
+	ctx := context.Background()
+	c := newTestCache(0)
+	load := func(context.Context) (*item, error) { return &item{name: "john"}, nil }
+
+	got, err := c.Get(ctx, "org", "1", load)
+	if err != nil {
+		t.Fatalf("Get() unexpected error: %v", err)
+	}
+	// 呼び出し元での変更はキャッシュに影響しない
+	got.name = "changed"
+	got, _ = c.Get(ctx, "org", "1", load)
+	if got.name != "john" {
+		t.Errorf("Get() after modification = %+v, want john", got)
+	}
+	if hits := testutil.ToFloat64(c.config.Requests.WithLabelValues("hit")); hits != 1 {
+		t.Errorf("hits = %v, want 1", hits)
+	}
+}
+
+func TestCache_Get_NegativeTTL(t *testing.T) {
+	ctx := context.Background()
+	for _, tt := range []struct {
+		name        string
+		negativeTTL time.Duration
+		wantLoads   int
+	}{
+		{name: "caches missing value", negativeTTL: time.Second, wantLoads: 1},
+		{name: "does not cache missing value", negativeTTL: 0, wantLoads: 2},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newTestCache(tt.negativeTTL)
+			loads := 0
+			load := func(context.Context) (*item, error) {
+				loads++
+				return nil, nil
+			}
+			for range 2 {
+				if got, err := c.Get(ctx, "org", "1", load); got != nil || err != nil {
+					t.Fatalf("Get() = %+v, %v, want nil", got, err)
+				}
+			}
+			if loads != tt.wantLoads {
+				t.Errorf("loads = %d, want %d", loads, tt.wantLoads)
+			}
+		})
+	}
+}
@@ -0,0 +1,63 @@
+package sessioncache
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+// listenerPingInterval 通知のない間も切断を検知するための疎通確認の間隔
+const listenerPingInterval = time.Minute
+
+// Listen PostgreSQL の channel を LISTEN し、通知されたセッション（ペイロードは "<組織ID>/<セッションID>"）のエントリを削除する
+//
+// 他のレプリカでコミットされた取り消しをキャッシュに反映するために使用する（channel は command.SessionChangedChannel）。
+// 再接続時は切断中の通知を取りこぼした可能性があるため、すべてのエントリを削除する。
+// ctx がキャンセルされると LISTEN を終了する。
+func (c *Cache) Listen(ctx context.Context, dsn, channel string, log *slog.Logger) error {
+	listener := pq.NewListener(dsn, time.Second, time.Minute, func(ev pq.ListenerEventType, err error) {
+		if err != nil {
+			log.Warn("session cache listener connection problem",
+				slog.String("channel", channel),
+				slog.String("error", err.Error()),
+			)
+		}
+	})
+	if err := listener.Listen(channel); err != nil {
+		listener.Close()
+		return fmt.Errorf("failed to listen on %s: %w", channel, err)
+	}
+
+	go func() {
+		defer listener.Close()
+		ping := time.NewTicker(listenerPingInterval)
+		defer ping.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case n := <-listener.Notify:
+				// 再接続時は nil が届く
+				if n == nil {
+					c.Purge()
+					continue
+				}
+				orgID, id, ok := strings.Cut(n.Extra, "/")
+				if !ok {
+					log.Warn("unexpected session cache notification", slog.String("payload", n.Extra))
+					continue
+				}
+				c.Invalidate(orgID, id)
+			case <-ping.C:
+				if err := listener.Ping(); err != nil {
+					log.Warn("session cache listener ping failed", slog.String("error", err.Error()))
+				}
+			}
+		}
+	}()
+	return nil
+}
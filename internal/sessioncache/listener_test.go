@@ -0,0 +1,70 @@
+package sessioncache
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/example/go-react-cqrs-template/internal/command"
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure"
+	"github.com/example/go-react-cqrs-template/internal/queryservice"
+	"github.com/example/go-react-cqrs-template/internal/testutil/dbtest"
+	"github.com/example/go-react-cqrs-template/internal/usecase"
+)
+
+func TestCache_Listen_InvalidatesOnRevoke(t *testing.T) {
+	db := dbtest.New(t)
+	cfg, _ := dbtest.Config()
+	log := slog.New(slog.NewTextHandler(io.Discard, nil))
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	c := New(queryservice.NewSessionQueryService(db), WithTTL(time.Hour))
+	if err := c.Listen(ctx, cfg.DSN(), command.SessionChangedChannel, log); err != nil {
+		t.Fatalf("Listen() unexpected error: %v", err)
+	}
+
+	keyring := dbtest.Keyring(t)
+	tm := infrastructure.NewTransactionManager(db)
+	user, err := usecase.NewCreateUserUsecase(queryservice.NewUserQueryService(db, keyring), command.NewUserRepository(keyring), tm).Execute(ctx, "John Doe", "john@example.com")
+	if err != nil {
+		t.Fatalf("CreateUser unexpected error: %v", err)
+	}
+	repo := command.NewSessionRepository()
+	session := domain.NewSession(user.ID, domain.SessionClient{}, domain.DefaultSessionPolicy, time.Now())
+	save := func() {
+		t.Helper()
+		err := tm.RunInTransaction(ctx, func(ctx context.Context, tx infrastructure.DBTX) error {
+			return repo.SaveSession(ctx, tx, session)
+		})
+		if err != nil {
+			t.Fatalf("SaveSession() unexpected error: %v", err)
+		}
+	}
+	save()
+	if _, err := c.FindSessionByID(ctx, session.ID); err != nil {
+		t.Fatalf("FindSessionByID() unexpected error: %v", err)
+	}
+
+	// CommandRepository を経由しない（他のレプリカでの）取り消しは NOTIFY でのみ無効化される
+	session.Revoke(time.Now())
+	save()
+
+	deadline := time.Now().Add(10 * time.Second)
+	for {
+		got, err := c.FindSessionByID(ctx, session.ID)
+		if err != nil {
+			t.Fatalf("FindSessionByID() unexpected error: %v", err)
+		}
+		if got.IsRevoked() {
+			return
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("cache entry was not invalidated after revocation")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
@@ -0,0 +1,35 @@
+package sessioncache
+
+import (
+	"context"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure"
+	"github.com/example/go-react-cqrs-template/internal/tenant"
+	"github.com/example/go-react-cqrs-template/internal/usecase"
+)
+
+// CommandRepository コマンド側のリポジトリをラップし、保存したセッションをコミット後に Cache から削除する
+// （usecase.SessionCommandRepository の実装）
+//
+// コミット前に削除すると、コミットまでの間の読み込みで変更前の値が再びキャッシュされるため、
+// infrastructure.AfterCommit でコミット後に削除する。ロールバックした場合は削除しない。
+type CommandRepository struct {
+	usecase.SessionCommandRepository
+	cache *Cache
+}
+
+// NewCommandRepository CommandRepositoryのコンストラクタ
+func NewCommandRepository(repo usecase.SessionCommandRepository, cache *Cache) *CommandRepository {
+	return &CommandRepository{SessionCommandRepository: repo, cache: cache}
+}
+
+// SaveSession セッションを保存し、コミット後にキャッシュから削除する
+func (r *CommandRepository) SaveSession(ctx context.Context, tx infrastructure.DBTX, session *domain.Session) error {
+	if err := r.SessionCommandRepository.SaveSession(ctx, tx, session); err != nil {
+		return err
+	}
+	orgID, id := tenant.OrgID(ctx), session.ID
+	infrastructure.AfterCommit(ctx, func() { r.cache.Invalidate(orgID, id) })
+	return nil
+}
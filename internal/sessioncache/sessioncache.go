@@ -0,0 +1,144 @@
+// Package sessioncache はセッションの読み取り（SessionQueryRepository.FindSessionByID）のインプロセスキャッシュを提供する
+//
+// 認証ミドルウェアはリクエストごとにセッションを読み込むため、データベースへの問い合わせを減らす。
+// Cache はクエリ側のリポジトリをラップするデコレーターで、以下の性質を持つ（LRU・TTL・世代の管理は readcache）:
+//   - LRU で件数を制限し、エントリは TTL で失効する。存在しないセッションはキャッシュしない
+//   - 同じセッションへの同時のキャッシュミスは1回の読み込みにまとめる
+//   - エントリは読み込んだテナント（tenant.OrgID）のもので、他の組織からの読み取りにはヒットしない
+//   - セッションを変更したトランザクションのコミット後に該当のエントリを削除する（CommandRepository）。
+//     他のレプリカでの取り消しは PostgreSQL の LISTEN/NOTIFY（command.SessionChangedChannel）で受け取る（Listen）
+//
+// LISTEN できない間の他のレプリカでの取り消しは、最大で TTL の間だけ遅れて反映される。
+// ヒット・ミスの件数は Prometheus のメトリクスとして公開する（Cache は prometheus.Collector を実装する）。
+package sessioncache
+
+import (
+	"context"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/readcache"
+	"github.com/example/go-react-cqrs-template/internal/tenant"
+	"github.com/example/go-react-cqrs-template/internal/usecase"
+)
+
+// Cache セッションの読み取りキャッシュ（usecase.SessionQueryRepository の実装）
+//
+// FindSessionByID 以外の読み取りはキャッシュせず、ラップしたリポジトリにそのまま委譲する。
+type Cache struct {
+	next usecase.SessionQueryRepository
+	// entries のキーは組織IDとセッションIDの組（key）
+	entries *readcache.Cache[domain.Session]
+
+	requests      *prometheus.CounterVec
+	invalidations prometheus.Counter
+	entriesGauge  prometheus.GaugeFunc
+}
+
+// Option はCacheの設定を変更する
+type Option func(*readcache.Config)
+
+// WithSize キャッシュするセッション数の上限を設定する
+func WithSize(n int) Option {
+	return func(c *readcache.Config) {
+		if n > 0 {
+			c.Size = n
+		}
+	}
+}
+
+// WithTTL エントリの有効期間を設定する（LISTEN できない間に他のレプリカでの取り消しが反映されるまでの最大の時間）
+func WithTTL(d time.Duration) Option {
+	return func(c *readcache.Config) {
+		if d > 0 {
+			c.TTL = d
+		}
+	}
+}
+
+// withClock テスト用に現在時刻の取得関数を差し替える
+func withClock(now func() time.Time) Option {
+	return func(c *readcache.Config) {
+		c.Now = now
+	}
+}
+
+// New Cacheのコンストラクタ
+func New(next usecase.SessionQueryRepository, opts ...Option) *Cache {
+	c := &Cache{
+		next: next,
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "session_cache_requests_total",
+			Help: "Number of session lookups by ID served by the cache, partitioned by result (hit or miss).",
+		}, []string{"result"}),
+		invalidations: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "session_cache_invalidations_total",
+			Help: "Number of session cache invalidations caused by committed changes.",
+		}),
+	}
+	// 存在しないセッションはキャッシュしない（NegativeTTL は 0）
+	config := readcache.Config{
+		Size:          10000,
+		TTL:           10 * time.Second,
+		Requests:      c.requests,
+		Invalidations: c.invalidations,
+	}
+	for _, opt := range opts {
+		opt(&config)
+	}
+	c.entries = readcache.New(config, func(s *domain.Session) domain.Session { return *s })
+	c.entriesGauge = prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+		Name: "session_cache_entries",
+		Help: "Number of entries currently held in the session cache.",
+	}, func() float64 { return float64(c.entries.Len()) })
+	return c
+}
+
+// FindSessionByID IDでセッションを検索（キャッシュにない場合のみラップしたリポジトリから読み込む）
+//
+// 同じセッションの同時の読み込みは1回にまとめる。読み込みは待っている全員で共有するため、
+// 呼び出し元の ctx がキャンセルされても中断せず、その呼び出し元だけが ctx.Err() を返す。
+func (c *Cache) FindSessionByID(ctx context.Context, id string) (*domain.Session, error) {
+	orgID := tenant.OrgID(ctx)
+	return c.entries.Get(ctx, orgID, key(orgID, id), func(ctx context.Context) (*domain.Session, error) {
+		return c.next.FindSessionByID(ctx, id)
+	})
+}
+
+// FindActiveSessions 有効なユーザーのセッションを取得（キャッシュしない）
+func (c *Cache) FindActiveSessions(ctx context.Context, userID string, now time.Time, policy domain.SessionPolicy) ([]*domain.Session, error) {
+	return c.next.FindActiveSessions(ctx, userID, now, policy)
+}
+
+// Invalidate 組織 orgID のセッションのエントリを削除する
+//
+// 実行中の読み込みの結果もキャッシュせず、以降の FindSessionByID は改めて読み込む。
+func (c *Cache) Invalidate(orgID, id string) {
+	c.entries.Invalidate(key(orgID, id))
+}
+
+// Purge すべてのエントリを削除する
+func (c *Cache) Purge() {
+	c.entries.Purge()
+}
+
+// Describe prometheus.Collector の実装
+func (c *Cache) Describe(ch chan<- *prometheus.Desc) {
+	c.requests.Describe(ch)
+	c.invalidations.Describe(ch)
+	c.entriesGauge.Describe(ch)
+}
+
+// Collect prometheus.Collector の実装
+func (c *Cache) Collect(ch chan<- prometheus.Metric) {
+	c.requests.Collect(ch)
+	c.invalidations.Collect(ch)
+	c.entriesGauge.Collect(ch)
+}
+
+// key エントリのキー
+func key(orgID, id string) string {
+	return orgID + "/" + id
+}
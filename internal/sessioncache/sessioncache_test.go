@@ -0,0 +1,139 @@
+package sessioncache
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure/memory"
+	"github.com/example/go-react-cqrs-template/internal/tenant"
+)
+
+// countingStore FindSessionByID の呼び出し回数を数える
+type countingStore struct {
+	*memory.Store
+	calls atomic.Int32
+}
+
+func (s *countingStore) FindSessionByID(ctx context.Context, id string) (*domain.Session, error) {
+	s.calls.Add(1)
+	return s.Store.FindSessionByID(ctx, id)
+}
+
+func newTestSession(t *testing.T, store *memory.Store) *domain.Session {
+	t.Helper()
+	user, err := domain.NewUser("John Doe", "john@example.com")
+	if err != nil {
+		t.Fatalf("Failed to create user: %v", err)
+	}
+	store.Seed(user)
+	session := domain.NewSession(user.ID, domain.SessionClient{}, domain.DefaultSessionPolicy, time.Now())
+	store.SeedSessions(session)
+	return session
+}
+
+func assertRequests(t *testing.T, c *Cache, wantHits, wantMisses float64) {
+	t.Helper()
+	if got := testutil.ToFloat64(c.requests.WithLabelValues("hit")); got != wantHits {
+		t.Errorf("hits = %v, want %v", got, wantHits)
+	}
+	if got := testutil.ToFloat64(c.requests.WithLabelValues("miss")); got != wantMisses {
+		t.Errorf("misses = %v, want %v", got, wantMisses)
+	}
+}
+
+func TestCache_FindSessionByID(t *testing.T) {
+	ctx := context.Background()
+	store := &countingStore{Store: memory.NewStore()}
+	session := newTestSession(t, store.Store)
+	now := time.Now()
+	c := New(store, WithTTL(10*time.Second), withClock(func() time.Time { return now }))
+
+	for range 3 {
+		got, err := c.FindSessionByID(ctx, session.ID)
+		if err != nil {
+			t.Fatalf("FindSessionByID() unexpected error: %v", err)
+		}
+		if got == nil || got.ID != session.ID {
+			t.Fatalf("FindSessionByID() = %+v, want %s", got, session.ID)
+		}
+		// 返した値を変更してもキャッシュには影響しない
+		got.RevokedAt = now
+	}
+	if calls := store.calls.Load(); calls != 1 {
+		t.Errorf("repository calls = %d, want 1", calls)
+	}
+	assertRequests(t, c, 2, 1)
+
+	// 他の組織からの読み取りにはヒットしない
+	if got, err := c.FindSessionByID(tenant.WithOrgID(ctx, "01BX5ZZKBKACTAV9WEVGEMMVRZ"), session.ID); err != nil || got != nil {
+		t.Errorf("FindSessionByID(other org) = %+v, %v, want nil", got, err)
+	}
+
+	// 存在しないセッションはキャッシュしない
+	missing := "01ARZ3NDEKTSV4RRFFQ69G5FAV"
+	c.FindSessionByID(ctx, missing)
+	c.FindSessionByID(ctx, missing)
+	if calls := store.calls.Load(); calls != 4 {
+		t.Errorf("repository calls after missing lookups = %d, want 4", calls)
+	}
+
+	now = now.Add(10 * time.Second)
+	c.FindSessionByID(ctx, session.ID)
+	if calls := store.calls.Load(); calls != 5 {
+		t.Errorf("repository calls after TTL = %d, want 5", calls)
+	}
+}
+
+func TestCommandRepository_InvalidatesAfterCommit(t *testing.T) {
+	ctx := context.Background()
+	store := memory.NewStore()
+	session := newTestSession(t, store)
+	c := New(store)
+	repo := NewCommandRepository(store, c)
+
+	if _, err := c.FindSessionByID(ctx, session.ID); err != nil {
+		t.Fatalf("FindSessionByID() unexpected error: %v", err)
+	}
+
+	revoke := func(ctx context.Context, tx infrastructure.DBTX) error {
+		s, err := repo.FindSessionByIDForUpdate(ctx, tx, session.ID)
+		if err != nil {
+			return err
+		}
+		s.Revoke(time.Now())
+		return repo.SaveSession(ctx, tx, s)
+	}
+
+	// ロールバックした変更では無効化しない
+	errBoom := errors.New("boom")
+	err := store.RunInTransaction(ctx, func(ctx context.Context, tx infrastructure.DBTX) error {
+		if err := revoke(ctx, tx); err != nil {
+			return err
+		}
+		return errBoom
+	})
+	if !errors.Is(err, errBoom) {
+		t.Fatalf("RunInTransaction() error = %v, want %v", err, errBoom)
+	}
+	if got := testutil.ToFloat64(c.invalidations); got != 0 {
+		t.Errorf("invalidations after rollback = %v, want 0", got)
+	}
+
+	if err := store.RunInTransaction(ctx, revoke); err != nil {
+		t.Fatalf("RunInTransaction() unexpected error: %v", err)
+	}
+	got, err := c.FindSessionByID(ctx, session.ID)
+	if err != nil {
+		t.Fatalf("FindSessionByID() unexpected error: %v", err)
+	}
+	if !got.IsRevoked() {
+		t.Errorf("FindSessionByID() after revoke = %+v, want revoked", got)
+	}
+}
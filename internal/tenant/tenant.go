@@ -0,0 +1,68 @@
+// Package tenant はリクエストの対象テナント（組織）をコンテキストで受け渡す
+//
+// テナントはハンドラーのミドルウェアが認証済みのプリンシパルまたは Header から解決して設定し、
+// クエリサービス・リポジトリは OrgID で取得した組織に絞り込んで読み書きする。
+// テナントを指定しないリクエストは DefaultOrgID の組織として扱う（単一テナントでの運用）。
+package tenant
+
+import "context"
+
+// DefaultOrgID テナントを指定しないリクエストの組織ID（db/seed で作成する）
+const DefaultOrgID = "00000000000000000000000000"
+
+// Header テナントを指定するリクエストヘッダー
+const Header = "X-Org-ID"
+
+type (
+	orgIDKey          struct{}
+	principalOrgIDKey struct{}
+	allOrgsKey        struct{}
+)
+
+// WithOrgID 対象テナントの組織IDを設定したコンテキストを返す
+func WithOrgID(ctx context.Context, orgID string) context.Context {
+	return context.WithValue(ctx, orgIDKey{}, orgID)
+}
+
+// FromContext 対象テナントの組織IDを取得する（設定されていない場合は ok=false）
+//
+// outbox のリレーなどテナントをまたぐシステム処理では設定されない（WithAllOrgs）。
+func FromContext(ctx context.Context) (orgID string, ok bool) {
+	orgID, ok = ctx.Value(orgIDKey{}).(string)
+	return orgID, ok
+}
+
+// OrgID 対象テナントの組織IDを取得する（設定されていない場合は DefaultOrgID）
+func OrgID(ctx context.Context) string {
+	if orgID, ok := FromContext(ctx); ok {
+		return orgID
+	}
+	return DefaultOrgID
+}
+
+// WithPrincipalOrgID 認証済みのプリンシパルが所属する組織IDを設定したコンテキストを返す
+//
+// 認証ミドルウェアが設定し、以降はこの組織以外をテナントとして指定できなくなる。
+func WithPrincipalOrgID(ctx context.Context, orgID string) context.Context {
+	return context.WithValue(ctx, principalOrgIDKey{}, orgID)
+}
+
+// PrincipalOrgID 認証済みのプリンシパルが所属する組織IDを取得する（組織に属さない場合は ok=false）
+func PrincipalOrgID(ctx context.Context) (orgID string, ok bool) {
+	orgID, ok = ctx.Value(principalOrgIDKey{}).(string)
+	return orgID, ok
+}
+
+// WithAllOrgs 組織をまたぐシステム処理であることを設定したコンテキストを返す
+//
+// outbox のリレーや Webhook のワーカーなど、すべての組織の行を扱う処理のみが設定する。
+// 行レベルセキュリティ（db/rls）は、対象テナントもこの設定もないトランザクションには行を返さない。
+func WithAllOrgs(ctx context.Context) context.Context {
+	return context.WithValue(ctx, allOrgsKey{}, true)
+}
+
+// AllOrgs 組織をまたぐシステム処理かどうか（WithAllOrgs）
+func AllOrgs(ctx context.Context) bool {
+	all, _ := ctx.Value(allOrgsKey{}).(bool)
+	return all
+}
@@ -0,0 +1,166 @@
+// Package dbtest はPostgreSQLを使う結合テストのためのヘルパーを提供する
+//
+// TEST_DB_HOST が設定されている場合のみ有効になり、テストごとに使い捨ての
+// スキーマを作成して db/schema/*.sql と db/seed/*.sql を適用する。未設定の場合はテストをスキップする。
+//
+//	TEST_DB_HOST=localhost TEST_DB_PORT=55432 go test ./...
+package dbtest
+
+import (
+	"crypto/rand"
+	"database/sql"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"testing"
+
+	"github.com/example/go-react-cqrs-template/internal/infrastructure"
+	"github.com/example/go-react-cqrs-template/internal/pii"
+)
+
+// Config はテスト用データベースの接続設定を環境変数から読み込む
+//
+// TEST_DB_HOST が未設定の場合は ok=false を返す。
+func Config() (cfg infrastructure.Config, ok bool) {
+	host := os.Getenv("TEST_DB_HOST")
+	if host == "" {
+		return infrastructure.Config{}, false
+	}
+
+	port := 5432
+	if p := os.Getenv("TEST_DB_PORT"); p != "" {
+		if v, err := strconv.Atoi(p); err == nil {
+			port = v
+		}
+	}
+
+	return infrastructure.Config{
+		Host:     host,
+		Port:     port,
+		User:     getEnv("TEST_DB_USER", "postgres"),
+		Password: getEnv("TEST_DB_PASSWORD", "postgres"),
+		DBName:   getEnv("TEST_DB_NAME", "app_db"),
+		SSLMode:  getEnv("TEST_DB_SSLMODE", "disable"),
+	}, true
+}
+
+// New はテスト専用のスキーマに接続した *sql.DB を返す
+//
+// スキーマはテスト終了時に削除される。データベースが設定されていない場合は t.Skip する。
+func New(t testing.TB) *sql.DB {
+	t.Helper()
+
+	cfg, ok := Config()
+	if !ok {
+		t.Skip("TEST_DB_HOST is not set; skipping database test")
+	}
+
+	admin, err := infrastructure.NewDB(cfg)
+	if err != nil {
+		t.Fatalf("failed to connect to test database: %v", err)
+	}
+
+	schema := "test_" + randomSuffix(t)
+	if _, err := admin.Exec("CREATE SCHEMA " + schema); err != nil {
+		admin.Close()
+		t.Fatalf("failed to create schema %s: %v", schema, err)
+	}
+
+	cfg.SearchPath = schema
+	db, err := infrastructure.NewDB(cfg)
+	if err != nil {
+		dropSchema(t, admin, schema)
+		admin.Close()
+		t.Fatalf("failed to connect to schema %s: %v", schema, err)
+	}
+
+	t.Cleanup(func() {
+		db.Close()
+		dropSchema(t, admin, schema)
+		admin.Close()
+	})
+
+	applySQLFiles(t, db, "schema")
+	applySQLFiles(t, db, "seed")
+	return db
+}
+
+// Keyring はユーザーの名前とメールアドレスの暗号化に使うテスト用の鍵リングを生成する
+func Keyring(t testing.TB) *pii.Keyring {
+	t.Helper()
+	keyring, err := pii.GenerateKeyring()
+	if err != nil {
+		t.Fatalf("failed to generate keyring: %v", err)
+	}
+	return keyring
+}
+
+// applySQLFiles は db/<dir>/*.sql をファイル名順に適用する
+func applySQLFiles(t testing.TB, db *sql.DB, dir string) {
+	t.Helper()
+
+	files, err := filepath.Glob(filepath.Join(moduleRoot(t), "db", dir, "*.sql"))
+	if err != nil {
+		t.Fatalf("failed to list %s files: %v", dir, err)
+	}
+	sort.Strings(files)
+
+	for _, file := range files {
+		ddl, err := os.ReadFile(file)
+		if err != nil {
+			t.Fatalf("failed to read %s: %v", file, err)
+		}
+		if _, err := db.Exec(string(ddl)); err != nil {
+			t.Fatalf("failed to apply %s: %v", filepath.Base(file), err)
+		}
+	}
+}
+
+// dropSchema はテスト用スキーマを削除する
+func dropSchema(t testing.TB, admin *sql.DB, schema string) {
+	t.Helper()
+	if _, err := admin.Exec("DROP SCHEMA IF EXISTS " + schema + " CASCADE"); err != nil {
+		t.Errorf("failed to drop schema %s: %v", schema, err)
+	}
+}
+
+// moduleRoot は go.mod のあるディレクトリを探索して返す
+func moduleRoot(t testing.TB) string {
+	t.Helper()
+
+	dir, err := os.Getwd()
+	if err != nil {
+		t.Fatalf("failed to get working directory: %v", err)
+	}
+	for {
+		if _, err := os.Stat(filepath.Join(dir, "go.mod")); err == nil {
+			return dir
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			t.Fatal("go.mod not found")
+		}
+		dir = parent
+	}
+}
+
+// randomSuffix はスキーマ名に使うランダムな文字列を生成する
+func randomSuffix(t testing.TB) string {
+	t.Helper()
+	b := make([]byte, 8)
+	if _, err := rand.Read(b); err != nil {
+		t.Fatalf("failed to generate schema name: %v", err)
+	}
+	return hex.EncodeToString(b)
+}
+
+// getEnv 環境変数を取得、なければデフォルト値を返す
+func getEnv(key, defaultValue string) string {
+	value := os.Getenv(key)
+	if value == "" {
+		return defaultValue
+	}
+	return value
+}
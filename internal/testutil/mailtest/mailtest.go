@@ -0,0 +1,95 @@
+// Package mailtest は mail.FileMailer が書き出したメールを読むテスト用のヘルパーを提供する
+package mailtest
+
+import (
+	"io"
+	"mime"
+	"mime/quotedprintable"
+	netmail "net/mail"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/example/go-react-cqrs-template/internal/mail"
+)
+
+// linkPattern メールの本文に含まれるリンク
+var linkPattern = regexp.MustCompile(`https?://\S+`)
+
+// Messages dir に書き出されたメールを送信した順に読み込む
+func Messages(t *testing.T, dir string) []mail.Message {
+	t.Helper()
+	paths, err := filepath.Glob(filepath.Join(dir, "*.eml"))
+	if err != nil {
+		t.Fatalf("failed to list mails: %v", err)
+	}
+	sort.Strings(paths)
+
+	messages := make([]mail.Message, 0, len(paths))
+	for _, path := range paths {
+		messages = append(messages, readMessage(t, path))
+	}
+	return messages
+}
+
+// Last dir に最後に書き出されたメールを読み込む（ない場合はテストを失敗させる）
+func Last(t *testing.T, dir string) mail.Message {
+	t.Helper()
+	messages := Messages(t, dir)
+	if len(messages) == 0 {
+		t.Fatalf("no mail in %s", dir)
+	}
+	return messages[len(messages)-1]
+}
+
+// LinkToken 本文のリンクのクエリパラメータ token を取り出す
+func LinkToken(t *testing.T, msg mail.Message) string {
+	t.Helper()
+	for _, link := range linkPattern.FindAllString(msg.Body, -1) {
+		u, err := url.Parse(link)
+		if err != nil {
+			continue
+		}
+		if token := u.Query().Get("token"); token != "" {
+			return token
+		}
+	}
+	t.Fatalf("no link with a token in mail body: %s", msg.Body)
+	return ""
+}
+
+// readMessage .eml ファイルを件名と本文をデコードした Message に変換する
+func readMessage(t *testing.T, path string) mail.Message {
+	t.Helper()
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatalf("failed to open %s: %v", path, err)
+	}
+	defer f.Close()
+
+	m, err := netmail.ReadMessage(f)
+	if err != nil {
+		t.Fatalf("failed to parse %s: %v", path, err)
+	}
+	subject, err := new(mime.WordDecoder).DecodeHeader(m.Header.Get("Subject"))
+	if err != nil {
+		t.Fatalf("failed to decode subject of %s: %v", path, err)
+	}
+	var body io.Reader = m.Body
+	if strings.EqualFold(m.Header.Get("Content-Transfer-Encoding"), "quoted-printable") {
+		body = quotedprintable.NewReader(body)
+	}
+	b, err := io.ReadAll(body)
+	if err != nil {
+		t.Fatalf("failed to read body of %s: %v", path, err)
+	}
+	return mail.Message{
+		To:      m.Header.Get("To"),
+		Subject: subject,
+		Body:    strings.ReplaceAll(string(b), "\r\n", "\n"),
+	}
+}
@@ -0,0 +1,131 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure"
+)
+
+// AcceptInvitationUsecase メールで送った招待のトークンによる招待の承諾ユースケース
+type AcceptInvitationUsecase struct {
+	userCommand       UserCommandRepository
+	credentialCommand CredentialCommandRepository
+	invitationCommand InvitationCommandRepository
+	hasher            PasswordHasher
+	txManager         TransactionManager
+}
+
+// NewAcceptInvitationUsecase AcceptInvitationUsecaseのコンストラクタ
+func NewAcceptInvitationUsecase(
+	userCommand UserCommandRepository,
+	credentialCommand CredentialCommandRepository,
+	invitationCommand InvitationCommandRepository,
+	hasher PasswordHasher,
+	txManager TransactionManager,
+) *AcceptInvitationUsecase {
+	return &AcceptInvitationUsecase{
+		userCommand:       userCommand,
+		credentialCommand: credentialCommand,
+		invitationCommand: invitationCommand,
+		hasher:            hasher,
+		txManager:         txManager,
+	}
+}
+
+// Execute 招待を承諾し、パスワードを設定して招待済みのユーザーを利用中にする
+//
+// name が空でない場合は名前も置き換える。招待のメールを受け取れたことをもってメールアドレスは確認済みとする。
+// トークンが無効な場合は ErrUserTokenInvalid、有効期限が切れている場合は ErrInvitationExpired を返す
+// （期限切れはユーザーログに記録してコミットする）。パスワードがポリシーを満たさない場合は招待を承諾済みにしない。
+func (u *AcceptInvitationUsecase) Execute(ctx context.Context, token, name, password string) (*domain.User, error) {
+	var (
+		user      *domain.User
+		acceptErr error
+	)
+	err := u.txManager.RunInTransaction(ctx, func(ctx context.Context, tx infrastructure.DBTX) error {
+		inv, err := u.invitationCommand.FindInvitationByTokenForUpdate(ctx, tx, domain.HashUserToken(token))
+		if err != nil {
+			return err
+		}
+		if inv == nil {
+			return domain.ErrUserTokenInvalid()
+		}
+
+		now := time.Now()
+		expired, err := expireInvitation(ctx, u.userCommand, u.invitationCommand, tx, inv, now)
+		if err != nil {
+			return err
+		}
+		if expired {
+			// 承諾は拒否するが、期限切れの記録はコミットする
+			acceptErr = domain.ErrInvitationExpired()
+			return nil
+		}
+
+		found, err := u.userCommand.FindByIDForUpdate(ctx, tx, inv.UserID)
+		if err != nil {
+			return err
+		}
+		// 招待後にメールアドレスが変わった・無効化されたユーザーの招待は使えない
+		if found == nil || found.Email != inv.Email || found.Status != domain.UserStatusInvited {
+			return domain.ErrUserTokenInvalid()
+		}
+		if err := domain.ValidatePassword(password, found.Email); err != nil {
+			return err
+		}
+		if err := inv.Accept(now); err != nil {
+			return err
+		}
+
+		if name != "" && name != found.Name {
+			if err := found.Update(name, found.Email); err != nil {
+				return err
+			}
+		}
+		if err := found.Activate(); err != nil {
+			return err
+		}
+		found.VerifyEmail(now)
+
+		hash, err := u.hasher.Hash(password)
+		if err != nil {
+			return err
+		}
+		cred, err := u.credentialCommand.FindCredentialForUpdate(ctx, tx, found.ID)
+		if err != nil {
+			return err
+		}
+		if cred == nil {
+			cred = domain.NewCredential(found.ID, hash)
+		} else {
+			cred.ChangePassword(hash)
+		}
+
+		if err := u.credentialCommand.SaveCredential(ctx, tx, cred); err != nil {
+			return err
+		}
+		if err := u.invitationCommand.SaveInvitation(ctx, tx, inv); err != nil {
+			return err
+		}
+		if err := u.userCommand.Save(ctx, tx, found); err != nil {
+			return err
+		}
+		if err := u.userCommand.SaveUserLog(ctx, tx, domain.NewUserLog(found.ID, domain.UserLogActionInvitationAccepted)); err != nil {
+			return err
+		}
+		if err := u.userCommand.SaveEvents(ctx, tx, found.PullEvents()); err != nil {
+			return err
+		}
+		user = found
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if acceptErr != nil {
+		return nil, acceptErr
+	}
+	return user, nil
+}
@@ -0,0 +1,162 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure/memory"
+	"github.com/example/go-react-cqrs-template/internal/usecase"
+)
+
+const invitedPassword = "correct horse battery staple"
+
+func TestAcceptInvitationUsecase_Execute(t *testing.T) {
+	ctx := context.Background()
+	store := memory.NewStore()
+	inv, token := createInvitation(t, store, "john@example.com")
+	hasher := newTestPasswordHasher()
+	uc := usecase.NewAcceptInvitationUsecase(store, store, store, hasher, store)
+
+	user, err := uc.Execute(ctx, token, "John Doe", invitedPassword)
+	if err != nil {
+		t.Fatalf("Execute() unexpected error: %v", err)
+	}
+	if user.ID != inv.UserID || user.Status != domain.UserStatusActive || user.Name != "John Doe" || !user.IsEmailVerified() {
+		t.Errorf("Execute() = %+v, want active verified user %s named John Doe", user, inv.UserID)
+	}
+	if invs := store.Invitations(user.ID); len(invs) != 1 || invs[0].Status != domain.InvitationStatusAccepted || invs[0].AcceptedAt.IsZero() {
+		t.Errorf("Invitations = %+v, want one accepted invitation", invs)
+	}
+	cred := store.Credential(user.ID)
+	if cred == nil {
+		t.Fatal("Credential() = nil, want password set")
+	}
+	if ok, err := hasher.Verify(cred.PasswordHash, invitedPassword); err != nil || !ok {
+		t.Errorf("Verify() = %v, %v, want password to match", ok, err)
+	}
+	assertUserLogActions(t, store, user.ID, domain.UserLogActionInvited, domain.UserLogActionInvitationAccepted)
+
+	// 招待は一度しか承諾できない
+	if _, err := uc.Execute(ctx, token, "", invitedPassword); !errors.As(err, new(*domain.ValidationError)) {
+		t.Errorf("Execute() twice error = %v, want validation error", err)
+	}
+}
+
+func TestAcceptInvitationUsecase_Expired(t *testing.T) {
+	ctx := context.Background()
+	store := memory.NewStore()
+	inv, token := createInvitation(t, store, "john@example.com")
+	expired := *inv
+	expired.ExpiresAt = time.Now().Add(-time.Minute)
+	store.SeedInvitations(&expired)
+	uc := usecase.NewAcceptInvitationUsecase(store, store, store, newTestPasswordHasher(), store)
+
+	_, err := uc.Execute(ctx, token, "", invitedPassword)
+
+	var validationErr *domain.ValidationError
+	if !errors.As(err, &validationErr) || validationErr.Field != "token" {
+		t.Fatalf("Execute() error = %v, want validation error on token", err)
+	}
+	// 承諾は拒否しても、期限切れはコミットする
+	if invs := store.Invitations(inv.UserID); len(invs) != 1 || invs[0].Status != domain.InvitationStatusExpired {
+		t.Errorf("Invitations = %+v, want one expired invitation", invs)
+	}
+	user, _ := store.FindByID(ctx, inv.UserID)
+	if user.Status != domain.UserStatusInvited {
+		t.Errorf("Status = %q, want %q", user.Status, domain.UserStatusInvited)
+	}
+	assertUserLogActions(t, store, inv.UserID, domain.UserLogActionInvited, domain.UserLogActionInvitationExpired)
+
+	// 期限切れを記録するのは一度だけ
+	if _, err := uc.Execute(ctx, token, "", invitedPassword); !errors.As(err, new(*domain.ValidationError)) {
+		t.Errorf("Execute() twice error = %v, want validation error", err)
+	}
+	assertUserLogActions(t, store, inv.UserID, domain.UserLogActionInvited, domain.UserLogActionInvitationExpired)
+}
+
+func TestAcceptInvitationUsecase_Rejected(t *testing.T) {
+	tests := []struct {
+		name     string
+		password string
+		setup    func(t *testing.T, store *memory.Store, inv *domain.Invitation, token string) string
+		wantErr  any
+	}{
+		{
+			name:     "unknown token",
+			password: invitedPassword,
+			setup: func(*testing.T, *memory.Store, *domain.Invitation, string) string {
+				return "unknown"
+			},
+			wantErr: new(*domain.ValidationError),
+		},
+		{
+			name:     "superseded by a resend",
+			password: invitedPassword,
+			setup: func(t *testing.T, store *memory.Store, inv *domain.Invitation, token string) string {
+				uc := usecase.NewResendInvitationUsecase(store, store, &recordingMailer{}, store, usecase.DefaultUserTokenConfig)
+				if _, err := uc.Execute(context.Background(), inv.ID); err != nil {
+					t.Fatalf("ResendInvitation() unexpected error: %v", err)
+				}
+				return token
+			},
+			wantErr: new(*domain.ValidationError),
+		},
+		{
+			name:     "revoked",
+			password: invitedPassword,
+			setup: func(t *testing.T, store *memory.Store, inv *domain.Invitation, token string) string {
+				if err := usecase.NewRevokeInvitationUsecase(store, store, store).Execute(context.Background(), inv.ID); err != nil {
+					t.Fatalf("RevokeInvitation() unexpected error: %v", err)
+				}
+				return token
+			},
+			wantErr: new(*domain.ValidationError),
+		},
+		{
+			name:     "email changed after the invitation",
+			password: invitedPassword,
+			setup: func(t *testing.T, store *memory.Store, inv *domain.Invitation, token string) string {
+				uc := usecase.NewUpdateUserUsecase(store, store, store)
+				if err := uc.Execute(context.Background(), inv.UserID, "John Doe", "new@example.com"); err != nil {
+					t.Fatalf("UpdateUser() unexpected error: %v", err)
+				}
+				return token
+			},
+			wantErr: new(*domain.ValidationError),
+		},
+		{
+			name:     "weak password",
+			password: "short",
+			setup: func(_ *testing.T, _ *memory.Store, _ *domain.Invitation, token string) string {
+				return token
+			},
+			wantErr: new(*domain.ValidationError),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := memory.NewStore()
+			inv, token := createInvitation(t, store, "john@example.com")
+			token = tt.setup(t, store, inv, token)
+			uc := usecase.NewAcceptInvitationUsecase(store, store, store, newTestPasswordHasher(), store)
+
+			_, err := uc.Execute(context.Background(), token, "", tt.password)
+
+			if !errors.As(err, tt.wantErr) {
+				t.Fatalf("Execute() error = %v, want %T", err, tt.wantErr)
+			}
+			if store.Credential(inv.UserID) != nil {
+				t.Error("Credential() != nil, want no password set")
+			}
+			for _, log := range store.UserLogs(inv.UserID) {
+				if log.Action == domain.UserLogActionInvitationAccepted {
+					t.Errorf("UserLogs contains %s, want none", log.Action)
+				}
+			}
+		})
+	}
+}
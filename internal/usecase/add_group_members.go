@@ -0,0 +1,136 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure"
+)
+
+// GroupMemberInput グループに追加するメンバーの指定
+type GroupMemberInput struct {
+	UserID string
+	Role   domain.GroupRole
+}
+
+// AddGroupMembersUsecase グループメンバー一括追加ユースケース
+type AddGroupMembersUsecase struct {
+	groupCommand GroupCommandRepository
+	userCommand  UserCommandRepository
+	txManager    TransactionManager
+}
+
+// NewAddGroupMembersUsecase AddGroupMembersUsecaseのコンストラクタ
+func NewAddGroupMembersUsecase(
+	groupCommand GroupCommandRepository,
+	userCommand UserCommandRepository,
+	txManager TransactionManager,
+) *AddGroupMembersUsecase {
+	return &AddGroupMembersUsecase{
+		groupCommand: groupCommand,
+		userCommand:  userCommand,
+		txManager:    txManager,
+	}
+}
+
+// Execute ユーザーをまとめてグループに追加する
+//
+// 既にメンバーのユーザーは指定したロールに変更する。追加はすべて成功するか、いずれも行われないかのどちらか。
+// 参加とロールの変更はそれぞれユーザーログに記録する。
+func (u *AddGroupMembersUsecase) Execute(ctx context.Context, groupID string, members []GroupMemberInput) error {
+	if err := validateGroupMemberInputs(members); err != nil {
+		return err
+	}
+
+	return u.txManager.RunInTransaction(ctx, func(ctx context.Context, tx infrastructure.DBTX) error {
+		// 同じグループへの変更を直列化するためグループを行ロック付きで取得
+		group, err := u.groupCommand.FindGroupByIDForUpdate(ctx, tx, groupID)
+		if err != nil {
+			return err
+		}
+		if group == nil {
+			return domain.ErrGroupNotFound(groupID)
+		}
+
+		for _, m := range members {
+			// 組織内のユーザーのみ追加できる
+			user, err := u.userCommand.FindByIDForUpdate(ctx, tx, m.UserID)
+			if err != nil {
+				return err
+			}
+			if user == nil {
+				return domain.ErrUserNotFound(m.UserID)
+			}
+
+			action, err := u.addMember(ctx, tx, group.ID, m)
+			if err != nil {
+				return err
+			}
+			if action == "" {
+				continue
+			}
+			if err := u.userCommand.SaveUserLog(ctx, tx, domain.NewUserLog(m.UserID, action)); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// addMember メンバーシップを作成または更新し、記録するログのアクションを返す（変更がない場合は空）
+func (u *AddGroupMembersUsecase) addMember(ctx context.Context, tx infrastructure.DBTX, groupID string, m GroupMemberInput) (domain.UserLogAction, error) {
+	existing, err := u.groupCommand.FindGroupMember(ctx, tx, groupID, m.UserID)
+	if err != nil {
+		return "", err
+	}
+
+	if existing == nil {
+		member, err := domain.NewGroupMember(groupID, m.UserID, m.Role)
+		if err != nil {
+			return "", err
+		}
+		if err := u.groupCommand.SaveGroupMember(ctx, tx, member); err != nil {
+			return "", err
+		}
+		return domain.UserLogActionGroupJoined, nil
+	}
+
+	changed, err := existing.ChangeRole(m.Role)
+	if err != nil || !changed {
+		return "", err
+	}
+	if err := u.groupCommand.SaveGroupMember(ctx, tx, existing); err != nil {
+		return "", err
+	}
+	return domain.UserLogActionGroupRoleChanged, nil
+}
+
+// validateGroupMemberInputs 追加するメンバーの件数・ロール・重複を検証
+func validateGroupMemberInputs(members []GroupMemberInput) error {
+	userIDs := make([]string, len(members))
+	for i, m := range members {
+		if !m.Role.Valid() {
+			return domain.ErrGroupRoleInvalid(string(m.Role))
+		}
+		userIDs[i] = m.UserID
+	}
+	return validateGroupMemberUserIDs(userIDs)
+}
+
+// validateGroupMemberUserIDs 指定したユーザーの件数と重複を検証
+func validateGroupMemberUserIDs(userIDs []string) error {
+	if len(userIDs) == 0 {
+		return domain.ErrGroupMembersRequired()
+	}
+	if len(userIDs) > domain.GroupMembersMaxBatch {
+		return domain.ErrGroupMembersTooMany()
+	}
+	seen := make(map[string]struct{}, len(userIDs))
+	for _, id := range userIDs {
+		if _, ok := seen[id]; ok {
+			return domain.ErrGroupMemberDuplicated(id)
+		}
+		seen[id] = struct{}{}
+	}
+	return nil
+}
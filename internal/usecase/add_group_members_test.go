@@ -0,0 +1,125 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure/memory"
+	"github.com/example/go-react-cqrs-template/internal/usecase"
+)
+
+func TestAddGroupMembersUsecase_Execute(t *testing.T) {
+	alice := mustNewUser(t, "Alice", "alice@example.com")
+	bob := mustNewUser(t, "Bob", "bob@example.com")
+	other := mustNewUser(t, "Other", "other@example.com")
+	group := mustNewGroup(t, "Engineering")
+	const otherOrgID = "01ARZ3NDEKTSV4RRFFQ69G5FAV"
+
+	tests := []struct {
+		name     string
+		groupID  string
+		members  []usecase.GroupMemberInput
+		wantErr  any
+		wantRole map[string]domain.GroupRole
+		wantLogs map[string][]domain.UserLogAction
+	}{
+		{
+			name:    "adds members and changes role of existing member",
+			groupID: group.ID,
+			members: []usecase.GroupMemberInput{
+				{UserID: alice.ID, Role: domain.GroupRoleOwner},
+				{UserID: bob.ID, Role: domain.GroupRoleMember},
+			},
+			wantRole: map[string]domain.GroupRole{alice.ID: domain.GroupRoleOwner, bob.ID: domain.GroupRoleMember},
+			wantLogs: map[string][]domain.UserLogAction{
+				alice.ID: {domain.UserLogActionGroupRoleChanged},
+				bob.ID:   {domain.UserLogActionGroupJoined},
+			},
+		},
+		{
+			name:     "same role is not logged",
+			groupID:  group.ID,
+			members:  []usecase.GroupMemberInput{{UserID: alice.ID, Role: domain.GroupRoleMember}},
+			wantRole: map[string]domain.GroupRole{alice.ID: domain.GroupRoleMember},
+		},
+		{
+			name:    "unknown group",
+			groupID: "01ARZ3NDEKTSV4RRFFQ69G5FAW",
+			members: []usecase.GroupMemberInput{{UserID: bob.ID, Role: domain.GroupRoleMember}},
+			wantErr: new(*domain.NotFoundError),
+		},
+		{
+			name:    "user of another organization rolls back all additions",
+			groupID: group.ID,
+			members: []usecase.GroupMemberInput{
+				{UserID: bob.ID, Role: domain.GroupRoleMember},
+				{UserID: other.ID, Role: domain.GroupRoleMember},
+			},
+			wantErr: new(*domain.NotFoundError),
+		},
+		{
+			name:    "invalid role",
+			groupID: group.ID,
+			members: []usecase.GroupMemberInput{{UserID: bob.ID, Role: "admin"}},
+			wantErr: new(*domain.ValidationError),
+		},
+		{
+			name:    "duplicate user",
+			groupID: group.ID,
+			members: []usecase.GroupMemberInput{
+				{UserID: bob.ID, Role: domain.GroupRoleMember},
+				{UserID: bob.ID, Role: domain.GroupRoleOwner},
+			},
+			wantErr: new(*domain.ValidationError),
+		},
+		{
+			name:    "no members",
+			groupID: group.ID,
+			wantErr: new(*domain.ValidationError),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			store := memory.NewStore()
+			store.Seed(alice, bob)
+			store.SeedInOrg(otherOrgID, other)
+			store.SeedGroups(group)
+			store.SeedGroupMembers(mustNewGroupMember(t, group, alice, domain.GroupRoleMember))
+			uc := usecase.NewAddGroupMembersUsecase(store, store, store)
+
+			err := uc.Execute(ctx, tt.groupID, tt.members)
+
+			if tt.wantErr != nil {
+				if err == nil || !errors.As(err, tt.wantErr) {
+					t.Fatalf("Execute() error = %v, want %T", err, tt.wantErr)
+				}
+				if count, _ := store.CountGroupMembers(ctx, group.ID); count != 1 {
+					t.Errorf("CountGroupMembers() = %d, want 1", count)
+				}
+				assertUserLogActions(t, store, bob.ID)
+				return
+			}
+			if err != nil {
+				t.Fatalf("Execute() unexpected error: %v", err)
+			}
+
+			members, _ := store.FindGroupMembers(ctx, group.ID, 100, 0)
+			got := make(map[string]domain.GroupRole, len(members))
+			for _, m := range members {
+				got[m.UserID] = m.Role
+			}
+			for userID, role := range tt.wantRole {
+				if got[userID] != role {
+					t.Errorf("role of %s = %q, want %q", userID, got[userID], role)
+				}
+			}
+			for _, user := range []*domain.User{alice, bob} {
+				assertUserLogActions(t, store, user.ID, tt.wantLogs[user.ID]...)
+			}
+		})
+	}
+}
@@ -0,0 +1,66 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure"
+)
+
+// PasswordHasher パスワードのハッシュ化と照合のインターフェース
+type PasswordHasher interface {
+	Hash(password string) (string, error)
+	// Verify パスワードがハッシュと一致するか照合する（一致しない場合は false、形式が不正な場合はエラー）
+	Verify(hash, password string) (bool, error)
+}
+
+// SessionIssuer ログインで作成したセッションのトークンを発行するインターフェース
+type SessionIssuer interface {
+	Issue(userID, orgID, sessionID string, now, expiresAt time.Time) (string, error)
+}
+
+// TOTPAuthenticator TOTP（時間ベースのワンタイムパスワード）の秘密鍵の生成と確認コードの照合のインターフェース
+type TOTPAuthenticator interface {
+	// GenerateSecret アカウント名 accountName の秘密鍵と、認証アプリに登録する otpauth URI を生成する
+	GenerateSecret(accountName string) (secret, uri string, err error)
+	// QRCode otpauth URI を QR コードの PNG 画像に描画する
+	QRCode(uri string) ([]byte, error)
+	// Verify 時刻 now の確認コードを照合し、一致した時間ステップを返す（一致しない場合は ok=false）
+	Verify(secret, code string, now time.Time) (step int64, ok bool)
+}
+
+// OIDCProvider OpenID Connect の IdP との認可コードフロー（PKCE）のインターフェース
+type OIDCProvider interface {
+	// AuthCodeURL state・nonce と code_verifier の S256 のチャレンジを付けた IdP の認可エンドポイントの URL を返す
+	AuthCodeURL(ctx context.Context, state, nonce, codeVerifier string) (string, error)
+	// Exchange 認可コードをトークンに交換し、検証した ID トークンのクレームを返す（nonce が一致しない場合もエラー）
+	Exchange(ctx context.Context, code, codeVerifier, nonce string) (*domain.ExternalIdentityClaims, error)
+}
+
+// recordPasswordFailure パスワードの照合の失敗を記録する（トランザクション内で使用）
+//
+// 連続した失敗が上限に達した場合はアカウントをロックし、ロックしたこともユーザーログに記録する。
+func recordPasswordFailure(
+	ctx context.Context,
+	userCommand UserCommandRepository,
+	credentialCommand CredentialCommandRepository,
+	tx infrastructure.DBTX,
+	cred *domain.Credential,
+	policy domain.LockoutPolicy,
+	now time.Time,
+) error {
+	locked := cred.RecordFailure(now, policy)
+	if err := credentialCommand.SaveCredential(ctx, tx, cred); err != nil {
+		return err
+	}
+	if err := userCommand.SaveUserLog(ctx, tx, domain.NewUserLog(cred.UserID, domain.UserLogActionLoginFailed)); err != nil {
+		return err
+	}
+	if locked {
+		if err := userCommand.SaveUserLog(ctx, tx, domain.NewUserLog(cred.UserID, domain.UserLogActionLockedOut)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
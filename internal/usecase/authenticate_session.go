@@ -0,0 +1,88 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure"
+)
+
+// AuthenticateSessionUsecase セッショントークンによる認証のたびにセッションを確認するユースケース
+type AuthenticateSessionUsecase struct {
+	sessionQuery   SessionQueryRepository
+	sessionCommand SessionCommandRepository
+	txManager      TransactionManager
+	policy         domain.SessionPolicy
+}
+
+// NewAuthenticateSessionUsecase AuthenticateSessionUsecaseのコンストラクタ
+//
+// sessionQuery にはキャッシュ（sessioncache.Cache）を渡すことを想定する。
+func NewAuthenticateSessionUsecase(
+	sessionQuery SessionQueryRepository,
+	sessionCommand SessionCommandRepository,
+	txManager TransactionManager,
+	policy domain.SessionPolicy,
+) *AuthenticateSessionUsecase {
+	return &AuthenticateSessionUsecase{
+		sessionQuery:   sessionQuery,
+		sessionCommand: sessionCommand,
+		txManager:      txManager,
+		policy:         policy,
+	}
+}
+
+// Execute ユーザー userID のセッションが取り消されておらず有効期限内か確認し、最終利用時刻を更新する
+//
+// 確認は読み取り（キャッシュ）で行い、最終利用時刻の更新が必要な場合（domain.SessionTouchInterval ごと）と
+// 無操作で失効しているように見える場合のみ、行ロックを取得して最新の状態で確認し直す。
+// セッションが見つからない場合は ErrSessionRevoked、失効している場合は ErrSessionExpired を返す。
+func (u *AuthenticateSessionUsecase) Execute(ctx context.Context, userID, sessionID string) (*domain.Session, error) {
+	now := time.Now()
+	session, err := u.sessionQuery.FindSessionByID(ctx, sessionID)
+	if err != nil {
+		return nil, err
+	}
+	if session == nil || session.UserID != userID {
+		return nil, domain.ErrSessionRevoked(sessionID)
+	}
+
+	err = session.Validate(now, u.policy)
+	switch {
+	case err == nil && !session.NeedsTouch(now):
+		return session, nil
+	case session.IsRevoked(), !now.Before(session.ExpiresAt):
+		return nil, err
+	}
+	return u.touch(ctx, userID, sessionID, now)
+}
+
+// touch 行ロックを取得してセッションを確認し直し、最終利用時刻を更新する
+func (u *AuthenticateSessionUsecase) touch(ctx context.Context, userID, sessionID string, now time.Time) (*domain.Session, error) {
+	var result *domain.Session
+	err := u.txManager.RunInTransaction(ctx, func(ctx context.Context, tx infrastructure.DBTX) error {
+		session, err := u.sessionCommand.FindSessionByIDForUpdate(ctx, tx, sessionID)
+		if err != nil {
+			return err
+		}
+		if session == nil || session.UserID != userID {
+			return domain.ErrSessionRevoked(sessionID)
+		}
+		if err := session.Validate(now, u.policy); err != nil {
+			return err
+		}
+		if session.NeedsTouch(now) {
+			session.Touch(now)
+			if err := u.sessionCommand.SaveSession(ctx, tx, session); err != nil {
+				return err
+			}
+		}
+		result = session
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
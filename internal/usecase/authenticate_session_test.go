@@ -0,0 +1,113 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure/memory"
+	"github.com/example/go-react-cqrs-template/internal/usecase"
+)
+
+func TestAuthenticateSessionUsecase_Execute(t *testing.T) {
+	user := mustNewUser(t, "John Doe", "john@example.com")
+	other := mustNewUser(t, "Jane Doe", "jane@example.com")
+	now := time.Now()
+	policy := domain.DefaultSessionPolicy
+
+	tests := []struct {
+		name      string
+		session   func() *domain.Session
+		userID    string
+		wantErr   any
+		wantTouch bool
+	}{
+		{
+			name:    "recently used",
+			session: func() *domain.Session { return newTestSession(user, now) },
+			userID:  user.ID,
+		},
+		{
+			name:      "last seen is updated",
+			session:   func() *domain.Session { return newTestSession(user, now.Add(-10*time.Minute)) },
+			userID:    user.ID,
+			wantTouch: true,
+		},
+		{
+			name:    "idle",
+			session: func() *domain.Session { return newTestSession(user, now.Add(-policy.IdleTimeout)) },
+			userID:  user.ID,
+			wantErr: new(*domain.AuthenticationError),
+		},
+		{
+			name: "past absolute timeout",
+			session: func() *domain.Session {
+				s := newTestSession(user, now.Add(-policy.AbsoluteTimeout))
+				s.Touch(now)
+				return s
+			},
+			userID:  user.ID,
+			wantErr: new(*domain.AuthenticationError),
+		},
+		{
+			name: "revoked",
+			session: func() *domain.Session {
+				s := newTestSession(user, now)
+				s.Revoke(now)
+				return s
+			},
+			userID:  user.ID,
+			wantErr: new(*domain.AuthenticationError),
+		},
+		{
+			name:    "session of another user",
+			session: func() *domain.Session { return newTestSession(other, now) },
+			userID:  user.ID,
+			wantErr: new(*domain.AuthenticationError),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			store := memory.NewStore()
+			store.Seed(user, other)
+			session := tt.session()
+			store.SeedSessions(session)
+			uc := usecase.NewAuthenticateSessionUsecase(store, store, store, policy)
+
+			got, err := uc.Execute(ctx, tt.userID, session.ID)
+
+			if tt.wantErr != nil {
+				if err == nil || !errors.As(err, tt.wantErr) {
+					t.Fatalf("Execute() error = %v, want %T", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Execute() unexpected error: %v", err)
+			}
+			if got.ID != session.ID {
+				t.Errorf("Execute().ID = %s, want %s", got.ID, session.ID)
+			}
+			stored := store.Sessions(session.UserID)[0]
+			if touched := stored.LastSeenAt.After(session.LastSeenAt); touched != tt.wantTouch {
+				t.Errorf("LastSeenAt = %v (was %v), want touched = %v", stored.LastSeenAt, session.LastSeenAt, tt.wantTouch)
+			}
+		})
+	}
+}
+
+func TestAuthenticateSessionUsecase_UnknownSession(t *testing.T) {
+	user := mustNewUser(t, "John Doe", "john@example.com")
+	store := memory.NewStore()
+	store.Seed(user)
+	uc := usecase.NewAuthenticateSessionUsecase(store, store, store, domain.DefaultSessionPolicy)
+
+	_, err := uc.Execute(context.Background(), user.ID, "01ARZ3NDEKTSV4RRFFQ69G5FAV")
+	if !errors.As(err, new(*domain.AuthenticationError)) {
+		t.Errorf("Execute() error = %v, want AuthenticationError", err)
+	}
+}
@@ -0,0 +1,197 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure"
+)
+
+// BatchMethod 一括操作の種類
+type BatchMethod string
+
+const (
+	BatchMethodCreate BatchMethod = "create"
+	BatchMethodUpdate BatchMethod = "update"
+	BatchMethodDelete BatchMethod = "delete"
+)
+
+// MaxBatchOperations 一度に受け付ける操作の最大件数
+const MaxBatchOperations = 100
+
+// ErrBatchAborted atomic モードで他の操作が失敗したため取り消された
+var ErrBatchAborted = errors.New("aborted because another operation in the batch failed")
+
+// errBatchItemFailed atomic モードでトランザクションをロールバックするための内部エラー
+var errBatchItemFailed = errors.New("batch item failed")
+
+// BatchOperation 一括操作の1件
+//
+// create では Name と Email が必須。update では指定した（nil でない）値だけを変更する。
+type BatchOperation struct {
+	Method BatchMethod
+	ID     string
+	Name   *string
+	Email  *string
+}
+
+// BatchResult 一括操作の1件の結果
+type BatchResult struct {
+	// User 作成・更新後のユーザー（delete と失敗時は nil）
+	User *domain.User
+	// Err 失敗した場合のエラー（他の操作の失敗で取り消された場合は ErrBatchAborted）
+	Err error
+}
+
+// BatchUsersUsecase ユーザー一括操作ユースケース
+type BatchUsersUsecase struct {
+	userCommand UserCommandRepository
+	txManager   TransactionManager
+}
+
+// NewBatchUsersUsecase BatchUsersUsecaseのコンストラクタ
+func NewBatchUsersUsecase(
+	userCommand UserCommandRepository,
+	txManager TransactionManager,
+) *BatchUsersUsecase {
+	return &BatchUsersUsecase{
+		userCommand: userCommand,
+		txManager:   txManager,
+	}
+}
+
+// Execute 操作を指定順に実行し、操作ごとの結果を返す
+//
+// atomic が true の場合はすべての操作を1つのトランザクションで実行し、1件でも失敗すれば全体をロールバックする
+// （失敗した操作以外の結果は ErrBatchAborted）。false の場合は操作ごとにトランザクションを分け、
+// 失敗した操作だけを結果のエラーとする。いずれの場合も更新・削除するユーザーの行ロックは ID 順に取得するため、
+// 並行する一括操作同士でデッドロックしない。返すエラーは操作の結果にできない失敗（コミットの失敗など）のみ。
+func (u *BatchUsersUsecase) Execute(ctx context.Context, ops []BatchOperation, atomic bool) ([]BatchResult, error) {
+	if len(ops) == 0 || len(ops) > MaxBatchOperations {
+		return nil, domain.NewValidationError("operations",
+			fmt.Sprintf("operations must contain 1 to %d items, got %d", MaxBatchOperations, len(ops)),
+			fmt.Sprintf("操作は1件以上%d件以下で指定してください", MaxBatchOperations),
+		)
+	}
+	if atomic {
+		return u.executeAtomic(ctx, ops)
+	}
+
+	results := make([]BatchResult, len(ops))
+	for i := range ops {
+		err := u.txManager.RunInTransaction(ctx, func(ctx context.Context, tx infrastructure.DBTX) error {
+			locked, err := u.lockUsers(ctx, tx, ops[i:i+1])
+			if err != nil {
+				return err
+			}
+			results[i].User, err = u.apply(ctx, tx, ops[i], locked)
+			return err
+		})
+		if err != nil {
+			results[i] = BatchResult{Err: err}
+		}
+	}
+	return results, nil
+}
+
+// executeAtomic すべての操作を1つのトランザクションで実行する
+func (u *BatchUsersUsecase) executeAtomic(ctx context.Context, ops []BatchOperation) ([]BatchResult, error) {
+	results := make([]BatchResult, len(ops))
+	failed := -1
+	err := u.txManager.RunInTransaction(ctx, func(ctx context.Context, tx infrastructure.DBTX) error {
+		locked, err := u.lockUsers(ctx, tx, ops)
+		if err != nil {
+			return err
+		}
+		for i, op := range ops {
+			user, err := u.apply(ctx, tx, op, locked)
+			if err != nil {
+				results[i].Err = err
+				failed = i
+				return errBatchItemFailed
+			}
+			results[i].User = user
+		}
+		return nil
+	})
+	if failed >= 0 {
+		for i := range results {
+			if i != failed {
+				results[i] = BatchResult{Err: ErrBatchAborted}
+			}
+		}
+		return results, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// lockUsers 更新・削除の対象ユーザーの行ロックを ID 順に取得する（存在しない ID は nil）
+func (u *BatchUsersUsecase) lockUsers(ctx context.Context, tx infrastructure.DBTX, ops []BatchOperation) (map[string]*domain.User, error) {
+	var ids []string
+	for _, op := range ops {
+		if op.Method != BatchMethodCreate && op.ID != "" {
+			ids = append(ids, op.ID)
+		}
+	}
+	slices.Sort(ids)
+	ids = slices.Compact(ids)
+
+	locked := make(map[string]*domain.User, len(ids))
+	for _, id := range ids {
+		user, err := u.userCommand.FindByIDForUpdate(ctx, tx, id)
+		if err != nil {
+			return nil, err
+		}
+		locked[id] = user
+	}
+	return locked, nil
+}
+
+// apply 操作を1件実行する（対象ユーザーは lockUsers でロック済み）
+func (u *BatchUsersUsecase) apply(ctx context.Context, tx infrastructure.DBTX, op BatchOperation, locked map[string]*domain.User) (*domain.User, error) {
+	if op.Method == BatchMethodCreate {
+		if op.Name == nil {
+			return nil, domain.ErrNameRequired()
+		}
+		if op.Email == nil {
+			return nil, domain.ErrEmailRequired()
+		}
+		return createUser(ctx, u.userCommand, tx, *op.Name, *op.Email)
+	}
+	if op.Method != BatchMethodUpdate && op.Method != BatchMethodDelete {
+		return nil, domain.NewValidationError("method", "unknown batch method: "+string(op.Method), "操作の種類が正しくありません")
+	}
+
+	if op.ID == "" {
+		return nil, domain.NewValidationError("id", "id is required", "ユーザーIDを指定してください")
+	}
+	user := locked[op.ID]
+	if user == nil {
+		return nil, domain.ErrUserNotFound(op.ID)
+	}
+
+	if op.Method == BatchMethodDelete {
+		if err := deleteUser(ctx, u.userCommand, tx, user); err != nil {
+			return nil, err
+		}
+		// 同じバッチの以降の操作からは削除済みとして扱う
+		locked[op.ID] = nil
+		return nil, nil
+	}
+
+	name, email, _ := SetUserFields(op.Name, op.Email)(user.Name, user.Email)
+	if name != user.Name || email != user.Email {
+		if err := replaceUser(ctx, u.userCommand, tx, user, name, email); err != nil {
+			return nil, err
+		}
+	}
+	// 同じユーザーへの以降の操作で結果が変わらないようにコピーを返す
+	snapshot := user.Snapshot()
+	return &snapshot, nil
+}
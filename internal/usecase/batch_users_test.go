@@ -0,0 +1,191 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure/memory"
+	"github.com/example/go-react-cqrs-template/internal/usecase"
+)
+
+// lockRecordingStore 行ロックを取得したユーザーIDの順序を記録する
+type lockRecordingStore struct {
+	*memory.Store
+	locked []string
+}
+
+func (s *lockRecordingStore) FindByIDForUpdate(ctx context.Context, tx infrastructure.DBTX, id string) (*domain.User, error) {
+	s.locked = append(s.locked, id)
+	return s.Store.FindByIDForUpdate(ctx, tx, id)
+}
+
+func strPtr(s string) *string { return &s }
+
+func TestBatchUsersUsecase_Execute_PerItem(t *testing.T) {
+	ctx := context.Background()
+	user := mustNewUser(t, "John Doe", "john@example.com")
+	other := mustNewUser(t, "Jane Doe", "jane@example.com")
+	store := memory.NewStore()
+	store.Seed(user, other)
+	uc := usecase.NewBatchUsersUsecase(store, store)
+
+	results, err := uc.Execute(ctx, []usecase.BatchOperation{
+		{Method: usecase.BatchMethodCreate, Name: strPtr("Alice"), Email: strPtr("alice@example.com")},
+		{Method: usecase.BatchMethodUpdate, ID: user.ID, Name: strPtr("John Smith")},
+		{Method: usecase.BatchMethodDelete, ID: "01ARZ3NDEKTSV4RRFFQ69G5FAV"},
+		{Method: usecase.BatchMethodCreate, Name: strPtr("Dup"), Email: strPtr("jane@example.com")},
+		{Method: usecase.BatchMethodDelete, ID: other.ID},
+	}, false)
+	if err != nil {
+		t.Fatalf("Execute() unexpected error: %v", err)
+	}
+	if len(results) != 5 {
+		t.Fatalf("len(results) = %d, want 5", len(results))
+	}
+
+	if results[0].Err != nil || results[0].User == nil || results[0].User.Email != "alice@example.com" {
+		t.Errorf("results[0] = %+v, want created user", results[0])
+	}
+	if results[1].Err != nil || results[1].User == nil || results[1].User.Name != "John Smith" || results[1].User.Email != "john@example.com" {
+		t.Errorf("results[1] = %+v, want updated user", results[1])
+	}
+	var notFoundErr *domain.NotFoundError
+	if !errors.As(results[2].Err, &notFoundErr) {
+		t.Errorf("results[2].Err = %v, want NotFoundError", results[2].Err)
+	}
+	var conflictErr *domain.ConflictError
+	if !errors.As(results[3].Err, &conflictErr) {
+		t.Errorf("results[3].Err = %v, want ConflictError", results[3].Err)
+	}
+	// 前の操作の失敗に関わらず以降の操作は実行される
+	if results[4].Err != nil || results[4].User != nil {
+		t.Errorf("results[4] = %+v, want deleted", results[4])
+	}
+
+	assertUserEventTypes(t, store, user.ID, domain.UserEventTypeUpdated)
+	assertUserLogActions(t, store, other.ID, domain.UserLogActionDeleted)
+	if got, _ := store.FindByID(ctx, other.ID); got != nil {
+		t.Errorf("deleted user still exists: %+v", got)
+	}
+}
+
+func TestBatchUsersUsecase_Execute_Atomic(t *testing.T) {
+	ctx := context.Background()
+	user := mustNewUser(t, "John Doe", "john@example.com")
+
+	t.Run("all succeed", func(t *testing.T) {
+		store := memory.NewStore()
+		store.Seed(user)
+		uc := usecase.NewBatchUsersUsecase(store, store)
+
+		results, err := uc.Execute(ctx, []usecase.BatchOperation{
+			{Method: usecase.BatchMethodUpdate, ID: user.ID, Email: strPtr("smith@example.com")},
+			// 変更前のメールアドレスは同じトランザクション内で解放されている
+			{Method: usecase.BatchMethodCreate, Name: strPtr("New John"), Email: strPtr("john@example.com")},
+			{Method: usecase.BatchMethodDelete, ID: user.ID},
+		}, true)
+		if err != nil {
+			t.Fatalf("Execute() unexpected error: %v", err)
+		}
+		for i, r := range results {
+			if r.Err != nil {
+				t.Errorf("results[%d].Err = %v", i, r.Err)
+			}
+		}
+		// 後続の削除に関わらず、更新の結果は更新時点の値
+		if results[0].User == nil || results[0].User.Email != "smith@example.com" {
+			t.Errorf("results[0].User = %+v, want updated user", results[0].User)
+		}
+		assertUserEventTypes(t, store, user.ID, domain.UserEventTypeUpdated, domain.UserEventTypeDeleted)
+		assertUserEventTypes(t, store, results[1].User.ID, domain.UserEventTypeCreated)
+	})
+
+	t.Run("one failure rolls back all", func(t *testing.T) {
+		store := memory.NewStore()
+		store.Seed(user)
+		uc := usecase.NewBatchUsersUsecase(store, store)
+
+		results, err := uc.Execute(ctx, []usecase.BatchOperation{
+			{Method: usecase.BatchMethodCreate, Name: strPtr("Alice"), Email: strPtr("alice@example.com")},
+			{Method: usecase.BatchMethodUpdate, ID: user.ID, Name: strPtr("John Smith")},
+			{Method: usecase.BatchMethodUpdate, ID: user.ID},
+			{Method: usecase.BatchMethodCreate, Name: strPtr("Alice Again"), Email: strPtr("alice@example.com")},
+			{Method: usecase.BatchMethodDelete, ID: user.ID},
+		}, true)
+		if err != nil {
+			t.Fatalf("Execute() unexpected error: %v", err)
+		}
+
+		var conflictErr *domain.ConflictError
+		if !errors.As(results[3].Err, &conflictErr) {
+			t.Errorf("results[3].Err = %v, want ConflictError", results[3].Err)
+		}
+		for _, i := range []int{0, 1, 2, 4} {
+			if !errors.Is(results[i].Err, usecase.ErrBatchAborted) || results[i].User != nil {
+				t.Errorf("results[%d] = %+v, want aborted", i, results[i])
+			}
+		}
+
+		users, _ := store.FindAll(ctx, "", 10, 0)
+		if len(users) != 1 || users[0].Name != "John Doe" {
+			t.Errorf("users = %+v, want only the unchanged seed", users)
+		}
+		assertUserEventTypes(t, store, user.ID)
+	})
+}
+
+func TestBatchUsersUsecase_Execute_LocksInIDOrder(t *testing.T) {
+	a := mustNewUser(t, "A", "a@example.com")
+	b := mustNewUser(t, "B", "b@example.com")
+	c := mustNewUser(t, "C", "c@example.com")
+	store := &lockRecordingStore{Store: memory.NewStore()}
+	store.Seed(a, b, c)
+	uc := usecase.NewBatchUsersUsecase(store, store)
+
+	_, err := uc.Execute(context.Background(), []usecase.BatchOperation{
+		{Method: usecase.BatchMethodDelete, ID: c.ID},
+		{Method: usecase.BatchMethodUpdate, ID: a.ID, Name: strPtr("A2")},
+		{Method: usecase.BatchMethodUpdate, ID: c.ID, Name: strPtr("C2")},
+		{Method: usecase.BatchMethodDelete, ID: b.ID},
+	}, true)
+	if err != nil {
+		t.Fatalf("Execute() unexpected error: %v", err)
+	}
+
+	want := []string{a.ID, b.ID, c.ID}
+	slices.Sort(want)
+	if !slices.Equal(store.locked, want) {
+		t.Errorf("lock order = %v, want %v", store.locked, want)
+	}
+}
+
+func TestBatchUsersUsecase_Execute_Validation(t *testing.T) {
+	store := memory.NewStore()
+	uc := usecase.NewBatchUsersUsecase(store, store)
+	var validationErr *domain.ValidationError
+
+	if _, err := uc.Execute(context.Background(), nil, false); !errors.As(err, &validationErr) {
+		t.Errorf("Execute(empty) error = %v, want ValidationError", err)
+	}
+	if _, err := uc.Execute(context.Background(), make([]usecase.BatchOperation, usecase.MaxBatchOperations+1), false); !errors.As(err, &validationErr) {
+		t.Errorf("Execute(too many) error = %v, want ValidationError", err)
+	}
+
+	results, err := uc.Execute(context.Background(), []usecase.BatchOperation{
+		{Method: usecase.BatchMethodCreate, Name: strPtr("No Email")},
+		{Method: usecase.BatchMethodUpdate, Name: strPtr("No ID")},
+		{Method: "archive", ID: "01ARZ3NDEKTSV4RRFFQ69G5FAV"},
+	}, false)
+	if err != nil {
+		t.Fatalf("Execute() unexpected error: %v", err)
+	}
+	for i, r := range results {
+		if !errors.As(r.Err, &validationErr) {
+			t.Errorf("results[%d].Err = %v, want ValidationError", i, r.Err)
+		}
+	}
+}
@@ -0,0 +1,101 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure"
+)
+
+// ChangePasswordUsecase パスワードの設定・変更ユースケース
+type ChangePasswordUsecase struct {
+	userCommand       UserCommandRepository
+	credentialCommand CredentialCommandRepository
+	hasher            PasswordHasher
+	txManager         TransactionManager
+	lockout           domain.LockoutPolicy
+}
+
+// NewChangePasswordUsecase ChangePasswordUsecaseのコンストラクタ
+func NewChangePasswordUsecase(
+	userCommand UserCommandRepository,
+	credentialCommand CredentialCommandRepository,
+	hasher PasswordHasher,
+	txManager TransactionManager,
+	lockout domain.LockoutPolicy,
+) *ChangePasswordUsecase {
+	return &ChangePasswordUsecase{
+		userCommand:       userCommand,
+		credentialCommand: credentialCommand,
+		hasher:            hasher,
+		txManager:         txManager,
+		lockout:           lockout,
+	}
+}
+
+// Execute ユーザーのパスワードを設定または変更する
+//
+// パスワードが設定済みの場合は現在のパスワード currentPassword が必須で、一致しない場合は
+// ログインと同じく失敗として記録して ErrInvalidCredentials を返す。ロック中は現在のパスワードを照合するが
+// 結果は使わず、一致しても一致しなくても ErrAccountLocked を返す。
+// 変更すると失敗回数とロックは解除される。
+func (u *ChangePasswordUsecase) Execute(ctx context.Context, userID, currentPassword, newPassword string) error {
+	var authErr error
+	err := u.txManager.RunInTransaction(ctx, func(ctx context.Context, tx infrastructure.DBTX) error {
+		user, err := u.userCommand.FindByIDForUpdate(ctx, tx, userID)
+		if err != nil {
+			return err
+		}
+		if user == nil {
+			return domain.ErrUserNotFound(userID)
+		}
+		if err := domain.ValidatePassword(newPassword, user.Email); err != nil {
+			return err
+		}
+
+		cred, err := u.credentialCommand.FindCredentialForUpdate(ctx, tx, userID)
+		if err != nil {
+			return err
+		}
+		action := domain.UserLogActionPasswordSet
+		if cred != nil {
+			now := time.Now()
+			if currentPassword == "" {
+				return domain.ErrCurrentPasswordRequired()
+			}
+			ok, err := u.hasher.Verify(cred.PasswordHash, currentPassword)
+			if err != nil {
+				return err
+			}
+			if cred.IsLocked(now) {
+				// ロック中はパスワードによらず同じ応答を返す
+				return domain.ErrAccountLocked(userID)
+			}
+			if !ok {
+				// 変更は拒否するが、失敗の記録はコミットする
+				authErr = domain.ErrInvalidCredentials()
+				return recordPasswordFailure(ctx, u.userCommand, u.credentialCommand, tx, cred, u.lockout, now)
+			}
+			action = domain.UserLogActionPasswordChanged
+		}
+
+		hash, err := u.hasher.Hash(newPassword)
+		if err != nil {
+			return err
+		}
+		if cred == nil {
+			cred = domain.NewCredential(userID, hash)
+		} else {
+			cred.ChangePassword(hash)
+		}
+		if err := u.credentialCommand.SaveCredential(ctx, tx, cred); err != nil {
+			return err
+		}
+		return u.userCommand.SaveUserLog(ctx, tx, domain.NewUserLog(userID, action))
+	})
+	if err != nil {
+		return err
+	}
+	return authErr
+}
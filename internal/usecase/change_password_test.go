@@ -0,0 +1,133 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure/memory"
+	"github.com/example/go-react-cqrs-template/internal/usecase"
+)
+
+func TestChangePasswordUsecase_Execute(t *testing.T) {
+	hasher := newTestPasswordHasher()
+	withPassword := mustNewUser(t, "John Doe", "john@example.com")
+	withoutPassword := mustNewUser(t, "Jane Doe", "jane@example.com")
+
+	tests := []struct {
+		name            string
+		userID          string
+		currentPassword string
+		newPassword     string
+		locked          bool
+		wantErr         any
+		wantLogs        []domain.UserLogAction
+		wantPassword    string
+	}{
+		{
+			name:         "set first password",
+			userID:       withoutPassword.ID,
+			newPassword:  "brand new password",
+			wantLogs:     []domain.UserLogAction{domain.UserLogActionPasswordSet},
+			wantPassword: "brand new password",
+		},
+		{
+			name:            "change password",
+			userID:          withPassword.ID,
+			currentPassword: "correct horse battery",
+			newPassword:     "brand new password",
+			wantLogs:        []domain.UserLogAction{domain.UserLogActionPasswordChanged},
+			wantPassword:    "brand new password",
+		},
+		{
+			name:         "current password required",
+			userID:       withPassword.ID,
+			newPassword:  "brand new password",
+			wantErr:      new(*domain.ValidationError),
+			wantPassword: "correct horse battery",
+		},
+		{
+			name:            "wrong current password is recorded",
+			userID:          withPassword.ID,
+			currentPassword: "wrong password!",
+			newPassword:     "brand new password",
+			wantErr:         new(*domain.AuthenticationError),
+			wantLogs:        []domain.UserLogAction{domain.UserLogActionLoginFailed},
+			wantPassword:    "correct horse battery",
+		},
+		{
+			name:            "locked account rejects the correct password",
+			userID:          withPassword.ID,
+			currentPassword: "correct horse battery",
+			newPassword:     "brand new password",
+			locked:          true,
+			wantErr:         new(*domain.AuthenticationError),
+			wantPassword:    "correct horse battery",
+		},
+		{
+			name:            "locked account does not record a wrong password",
+			userID:          withPassword.ID,
+			currentPassword: "wrong password!",
+			newPassword:     "brand new password",
+			locked:          true,
+			wantErr:         new(*domain.AuthenticationError),
+			wantPassword:    "correct horse battery",
+		},
+		{
+			name:            "too short",
+			userID:          withPassword.ID,
+			currentPassword: "correct horse battery",
+			newPassword:     "short",
+			wantErr:         new(*domain.ValidationError),
+			wantPassword:    "correct horse battery",
+		},
+		{
+			name:        "unknown user",
+			userID:      "01ARZ3NDEKTSV4RRFFQ69G5FAV",
+			newPassword: "brand new password",
+			wantErr:     new(*domain.NotFoundError),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			store := memory.NewStore()
+			store.Seed(withPassword, withoutPassword)
+			cred := mustNewCredential(t, hasher, withPassword, "correct horse battery")
+			if tt.locked {
+				cred.LockedUntil = time.Now().Add(time.Hour)
+			}
+			store.SeedCredentials(cred)
+			uc := usecase.NewChangePasswordUsecase(store, store, hasher, store, domain.DefaultLockoutPolicy)
+
+			err := uc.Execute(ctx, tt.userID, tt.currentPassword, tt.newPassword)
+
+			if tt.wantErr != nil {
+				if err == nil || !errors.As(err, tt.wantErr) {
+					t.Fatalf("Execute() error = %v, want %T", err, tt.wantErr)
+				}
+				// ロック中はパスワードによらず同じ応答
+				if tt.locked && err.Error() != domain.ErrAccountLocked(tt.userID).Error() {
+					t.Errorf("Execute() while locked error = %v, want ErrAccountLocked", err)
+				}
+			} else if err != nil {
+				t.Fatalf("Execute() unexpected error: %v", err)
+			}
+
+			assertUserLogActions(t, store, tt.userID, tt.wantLogs...)
+			if tt.wantPassword == "" {
+				return
+			}
+			cred = store.Credential(tt.userID)
+			if cred == nil {
+				t.Fatal("Credential() = nil, want stored credential")
+			}
+			if ok, _ := hasher.Verify(cred.PasswordHash, tt.wantPassword); !ok {
+				t.Errorf("stored password does not match %q", tt.wantPassword)
+			}
+		})
+	}
+}
@@ -0,0 +1,96 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure"
+)
+
+// ChangeUserStatusUsecase ユーザーのステータス変更（一時停止・再開・無効化）ユースケース
+type ChangeUserStatusUsecase struct {
+	userCommand    UserCommandRepository
+	sessionCommand SessionCommandRepository
+	txManager      TransactionManager
+}
+
+// NewChangeUserStatusUsecase ChangeUserStatusUsecaseのコンストラクタ
+func NewChangeUserStatusUsecase(
+	userCommand UserCommandRepository,
+	sessionCommand SessionCommandRepository,
+	txManager TransactionManager,
+) *ChangeUserStatusUsecase {
+	return &ChangeUserStatusUsecase{
+		userCommand:    userCommand,
+		sessionCommand: sessionCommand,
+		txManager:      txManager,
+	}
+}
+
+// Execute ユーザーのステータスを変更し、理由をユーザーログに記録する
+//
+// action は UserLogActionSuspended / UserLogActionReactivated / UserLogActionDeactivated のいずれか。
+// 現在のステータスから遷移できない場合は ValidationError を返す。
+// 一時停止・無効化した場合は、ユーザーのセッションをすべて取り消す。
+func (u *ChangeUserStatusUsecase) Execute(ctx context.Context, id string, action domain.UserLogAction, reason string) (*domain.User, error) {
+	var result *domain.User
+	err := u.txManager.RunInTransaction(ctx, func(ctx context.Context, tx infrastructure.DBTX) error {
+		// 行ロック付きでユーザーを取得
+		user, err := u.userCommand.FindByIDForUpdate(ctx, tx, id)
+		if err != nil {
+			return err
+		}
+		if user == nil {
+			return domain.ErrUserNotFound(id)
+		}
+
+		// ドメインモデルのステータス遷移
+		if err := changeUserStatus(user, action, reason); err != nil {
+			return err
+		}
+
+		// 永続化
+		if err := u.userCommand.Save(ctx, tx, user); err != nil {
+			return err
+		}
+
+		// ステータス変更ログを理由付きで保存
+		if err := u.userCommand.SaveUserLog(ctx, tx, domain.NewUserLogWithReason(user.ID, action, reason)); err != nil {
+			return err
+		}
+
+		// 利用できなくなったユーザーのセッションを取り消す
+		if action != domain.UserLogActionReactivated {
+			if err := revokeUserSessions(ctx, u.userCommand, u.sessionCommand, tx, user.ID, time.Now()); err != nil {
+				return err
+			}
+		}
+
+		// ドメインイベントを outbox に保存
+		if err := u.userCommand.SaveEvents(ctx, tx, user.PullEvents()); err != nil {
+			return err
+		}
+
+		result = user
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// changeUserStatus ログのアクションに対応するステータス遷移を行う
+func changeUserStatus(user *domain.User, action domain.UserLogAction, reason string) error {
+	switch action {
+	case domain.UserLogActionSuspended:
+		return user.Suspend(reason)
+	case domain.UserLogActionReactivated:
+		return user.Reactivate(reason)
+	case domain.UserLogActionDeactivated:
+		return user.Deactivate(reason)
+	}
+	return fmt.Errorf("unsupported user status action: %s", action)
+}
@@ -0,0 +1,149 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure/memory"
+	"github.com/example/go-react-cqrs-template/internal/usecase"
+)
+
+func TestChangeUserStatusUsecase_Execute(t *testing.T) {
+	active := mustNewUser(t, "John Doe", "john@example.com")
+	suspended := mustNewUser(t, "Jane Doe", "jane@example.com")
+	suspended.Status = domain.UserStatusSuspended
+
+	tests := []struct {
+		name       string
+		id         string
+		action     domain.UserLogAction
+		reason     string
+		wantErr    any
+		wantStatus domain.UserStatus
+	}{
+		{
+			name:       "suspend active user",
+			id:         active.ID,
+			action:     domain.UserLogActionSuspended,
+			reason:     "policy violation",
+			wantStatus: domain.UserStatusSuspended,
+		},
+		{
+			name:       "deactivate active user",
+			id:         active.ID,
+			action:     domain.UserLogActionDeactivated,
+			reason:     "left the company",
+			wantStatus: domain.UserStatusDeactivated,
+		},
+		{
+			name:       "reactivate suspended user",
+			id:         suspended.ID,
+			action:     domain.UserLogActionReactivated,
+			reason:     "resolved",
+			wantStatus: domain.UserStatusActive,
+		},
+		{
+			name:       "reactivate active user is rejected",
+			id:         active.ID,
+			action:     domain.UserLogActionReactivated,
+			reason:     "resolved",
+			wantErr:    new(*domain.ValidationError),
+			wantStatus: domain.UserStatusActive,
+		},
+		{
+			name:       "missing reason",
+			id:         active.ID,
+			action:     domain.UserLogActionSuspended,
+			wantErr:    new(*domain.ValidationError),
+			wantStatus: domain.UserStatusActive,
+		},
+		{
+			name:    "unknown user",
+			id:      "01ARZ3NDEKTSV4RRFFQ69G5FAV",
+			action:  domain.UserLogActionSuspended,
+			reason:  "policy violation",
+			wantErr: new(*domain.NotFoundError),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			store := memory.NewStore()
+			store.Seed(active, suspended)
+			uc := usecase.NewChangeUserStatusUsecase(store, store, store)
+
+			got, err := uc.Execute(ctx, tt.id, tt.action, tt.reason)
+
+			if tt.wantErr != nil {
+				if err == nil || !errors.As(err, tt.wantErr) {
+					t.Fatalf("Execute() error = %v, want %T", err, tt.wantErr)
+				}
+				assertUserLogActions(t, store, tt.id)
+				assertUserEventTypes(t, store, tt.id)
+			} else {
+				if err != nil {
+					t.Fatalf("Execute() unexpected error: %v", err)
+				}
+				if got.Status != tt.wantStatus {
+					t.Errorf("Execute().Status = %s, want %s", got.Status, tt.wantStatus)
+				}
+				assertUserLogActions(t, store, tt.id, tt.action)
+				if logs := store.UserLogs(tt.id); logs[0].Reason != tt.reason {
+					t.Errorf("UserLogs[0].Reason = %q, want %q", logs[0].Reason, tt.reason)
+				}
+				assertUserEventTypes(t, store, tt.id, domain.UserEventTypeUpdated)
+			}
+
+			if tt.wantStatus == "" {
+				return
+			}
+			stored, _ := store.FindByID(ctx, tt.id)
+			if stored.Status != tt.wantStatus {
+				t.Errorf("stored.Status = %s, want %s", stored.Status, tt.wantStatus)
+			}
+		})
+	}
+}
+
+func TestChangeUserStatusUsecase_RevokesSessions(t *testing.T) {
+	tests := []struct {
+		name        string
+		action      domain.UserLogAction
+		wantRevoked int
+		wantLogs    []domain.UserLogAction
+	}{
+		{
+			name:        "suspend",
+			action:      domain.UserLogActionSuspended,
+			wantRevoked: 1,
+			wantLogs:    []domain.UserLogAction{domain.UserLogActionSuspended, domain.UserLogActionAllSessionsRevoked},
+		},
+		{
+			name:        "deactivate",
+			action:      domain.UserLogActionDeactivated,
+			wantRevoked: 1,
+			wantLogs:    []domain.UserLogAction{domain.UserLogActionDeactivated, domain.UserLogActionAllSessionsRevoked},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			user := mustNewUser(t, "John Doe", "john@example.com")
+			store := memory.NewStore()
+			store.Seed(user)
+			store.SeedSessions(newTestSession(user, time.Now()))
+			uc := usecase.NewChangeUserStatusUsecase(store, store, store)
+
+			if _, err := uc.Execute(ctx, user.ID, tt.action, "left the company"); err != nil {
+				t.Fatalf("Execute() unexpected error: %v", err)
+			}
+			assertRevokedSessions(t, store, user.ID, tt.wantRevoked)
+			assertUserLogActions(t, store, user.ID, tt.wantLogs...)
+		})
+	}
+}
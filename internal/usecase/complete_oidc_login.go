@@ -0,0 +1,237 @@
+package usecase
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"time"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure"
+	"github.com/example/go-react-cqrs-template/internal/tenant"
+)
+
+// CompleteOIDCLoginUsecase OpenID Connect のログインの完了（IdP からのコールバック）ユースケース
+type CompleteOIDCLoginUsecase struct {
+	userCommand     UserCommandRepository
+	identityCommand ExternalIdentityCommandRepository
+	stateCommand    OIDCLoginStateCommandRepository
+	totpCommand     TOTPCredentialCommandRepository
+	tokenCommand    UserTokenCommandRepository
+	sessionCommand  SessionCommandRepository
+	groupQuery      GroupQueryRepository
+	createUser      *CreateUserUsecase
+	providers       map[string]OIDCProvider
+	sessions        SessionIssuer
+	txManager       TransactionManager
+	config          OIDCConfig
+	sessionPolicy   domain.SessionPolicy
+	mfaPolicy       domain.MFAPolicy
+}
+
+// NewCompleteOIDCLoginUsecase CompleteOIDCLoginUsecaseのコンストラクタ
+//
+// 紐付くユーザーがいない場合のユーザーの自動作成（config.AutoProvision）は createUser で行う。
+func NewCompleteOIDCLoginUsecase(
+	userCommand UserCommandRepository,
+	identityCommand ExternalIdentityCommandRepository,
+	stateCommand OIDCLoginStateCommandRepository,
+	totpCommand TOTPCredentialCommandRepository,
+	tokenCommand UserTokenCommandRepository,
+	sessionCommand SessionCommandRepository,
+	groupQuery GroupQueryRepository,
+	createUser *CreateUserUsecase,
+	providers map[string]OIDCProvider,
+	sessions SessionIssuer,
+	txManager TransactionManager,
+	config OIDCConfig,
+	sessionPolicy domain.SessionPolicy,
+	mfaPolicy domain.MFAPolicy,
+) *CompleteOIDCLoginUsecase {
+	return &CompleteOIDCLoginUsecase{
+		userCommand:     userCommand,
+		identityCommand: identityCommand,
+		stateCommand:    stateCommand,
+		totpCommand:     totpCommand,
+		tokenCommand:    tokenCommand,
+		sessionCommand:  sessionCommand,
+		groupQuery:      groupQuery,
+		createUser:      createUser,
+		providers:       providers,
+		sessions:        sessions,
+		txManager:       txManager,
+		config:          config,
+		sessionPolicy:   sessionPolicy,
+		mfaPolicy:       mfaPolicy,
+	}
+}
+
+// Execute IdP provider から受け取った認可コード code と state でログインし、client のセッションを作成してトークンを発行する
+//
+// stateHash はログインを開始したブラウザに保存した state のハッシュ（OIDCAuthorization.StateHash）で、
+// state と一致しない場合は ErrOIDCStateInvalid を返す（他人が開始したログインのコールバックを踏ませるログイン CSRF の防止）。
+// state は一度だけ使用でき、存在しない・使用済み・期限切れの場合は ErrOIDCStateInvalid を返す。認可コードの交換と
+// ID トークンの検証に失敗した場合は ErrOIDCLoginFailed を返す。ユーザーは以下の順に決める:
+//   - IdP のユーザー（provider と subject の組）に紐付くユーザー
+//   - IdP で確認済みのメールアドレスのユーザー（紐付けを作成する）
+//   - config.AutoProvision の場合は CreateUserUsecase で作成したユーザー（紐付けを作成する）
+//
+// いずれもいない場合は ErrExternalIdentityNotLinked を返す。パスワードでのログインと同じく、利用中でないユーザーは
+// ログインできず、TOTP の二要素認証が有効なユーザーはセッションの代わりにログインの2段階目のトークンを返す。
+func (u *CompleteOIDCLoginUsecase) Execute(ctx context.Context, provider, code, state, stateHash string, client domain.SessionClient) (*LoginResult, error) {
+	idp, err := findOIDCProvider(u.providers, provider)
+	if err != nil {
+		return nil, err
+	}
+	if subtle.ConstantTimeCompare([]byte(domain.HashUserToken(state)), []byte(stateHash)) != 1 {
+		return nil, domain.ErrOIDCStateInvalid()
+	}
+
+	// 認可コードの交換の成否に関わらず、state は使用済みにしてコミットする
+	var loginState *domain.OIDCLoginState
+	err = u.txManager.RunInTransaction(ctx, func(ctx context.Context, tx infrastructure.DBTX) error {
+		found, err := u.stateCommand.FindOIDCLoginStateForUpdate(ctx, tx, provider, domain.HashUserToken(state))
+		if err != nil {
+			return err
+		}
+		if found == nil {
+			return domain.ErrOIDCStateInvalid()
+		}
+		if err := found.Use(time.Now()); err != nil {
+			return err
+		}
+		loginState = found
+		return u.stateCommand.SaveOIDCLoginState(ctx, tx, found)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	claims, err := idp.Exchange(ctx, code, loginState.CodeVerifier, loginState.Nonce)
+	if err != nil {
+		return nil, domain.ErrOIDCLoginFailed(provider, err)
+	}
+
+	result, err := u.signIn(ctx, provider, claims, client)
+	if err != nil {
+		return nil, err
+	}
+	if result == nil && u.config.AutoProvision && claims.EmailVerified && claims.Email != "" {
+		// 作成したユーザーには次の signIn で紐付ける（同時に作成された場合も既存のユーザーとして紐付ける）
+		_, err := u.createUser.Execute(ctx, externalUserName(claims), claims.Email)
+		var conflictErr *domain.ConflictError
+		if err != nil && !errors.As(err, &conflictErr) {
+			return nil, err
+		}
+		if result, err = u.signIn(ctx, provider, claims, client); err != nil {
+			return nil, err
+		}
+	}
+	if result == nil {
+		return nil, domain.ErrExternalIdentityNotLinked(provider, claims.Subject)
+	}
+	return result, nil
+}
+
+// signIn IdP のクレームに紐付くユーザーでログインする（紐付くユーザーがいない場合は nil を返す）
+func (u *CompleteOIDCLoginUsecase) signIn(ctx context.Context, provider string, claims *domain.ExternalIdentityClaims, client domain.SessionClient) (*LoginResult, error) {
+	var (
+		user      *domain.User
+		session   *domain.Session
+		challenge *domain.UserToken
+		mfaToken  string
+	)
+	err := u.txManager.RunInTransaction(ctx, func(ctx context.Context, tx infrastructure.DBTX) error {
+		now := time.Now()
+		identity, err := u.identityCommand.FindExternalIdentityForUpdate(ctx, tx, provider, claims.Subject)
+		if err != nil {
+			return err
+		}
+		var found *domain.User
+		if identity != nil {
+			if found, err = u.userCommand.FindByIDForUpdate(ctx, tx, identity.UserID); err != nil {
+				return err
+			}
+		} else if claims.EmailVerified && claims.Email != "" {
+			// 紐付けがない場合は、IdP で確認済みのメールアドレスのユーザーに紐付ける
+			if found, err = u.userCommand.FindByEmailForUpdate(ctx, tx, claims.Email); err != nil {
+				return err
+			}
+			if found != nil {
+				identity = domain.NewExternalIdentity(provider, claims.Subject, found.ID, now)
+				if err := u.userCommand.SaveUserLog(ctx, tx, domain.NewUserLog(found.ID, domain.UserLogActionExternalIdentityLinked)); err != nil {
+					return err
+				}
+			}
+		}
+		if found == nil {
+			return nil
+		}
+		if found.Status != domain.UserStatusActive {
+			return domain.ErrUserNotActive(found.ID, found.Status)
+		}
+
+		// IdP で確認済みのメールアドレスと同じであれば、ユーザーのメールアドレスも確認済みにする
+		if claims.EmailVerified && claims.Email == found.Email && !found.IsEmailVerified() {
+			found.VerifyEmail(now)
+			if err := u.userCommand.Save(ctx, tx, found); err != nil {
+				return err
+			}
+			if err := u.userCommand.SaveUserLog(ctx, tx, domain.NewUserLog(found.ID, domain.UserLogActionEmailVerified)); err != nil {
+				return err
+			}
+			if err := u.userCommand.SaveEvents(ctx, tx, found.PullEvents()); err != nil {
+				return err
+			}
+		}
+		identity.RecordLogin(now)
+		if err := u.identityCommand.SaveExternalIdentity(ctx, tx, identity); err != nil {
+			return err
+		}
+
+		totpCred, err := u.totpCommand.FindTOTPCredentialForUpdate(ctx, tx, found.ID)
+		if err != nil {
+			return err
+		}
+		if totpCred != nil && totpCred.IsEnabled() {
+			challenge, mfaToken, err = issueUserToken(ctx, u.tokenCommand, tx, found, domain.UserTokenPurposeMFAChallenge, u.mfaPolicy.ChallengeTTL)
+			if err != nil {
+				return err
+			}
+			user = found
+			return nil
+		}
+		required, err := mfaRequired(ctx, u.groupQuery, u.mfaPolicy, found.ID)
+		if err != nil {
+			return err
+		}
+		if required {
+			return domain.ErrMFAEnrollmentRequired(found.ID)
+		}
+
+		if err := u.userCommand.SaveUserLog(ctx, tx, domain.NewUserLog(found.ID, domain.UserLogActionLoginSucceeded)); err != nil {
+			return err
+		}
+		created := domain.NewSession(found.ID, client, u.sessionPolicy, now)
+		if err := u.sessionCommand.SaveSession(ctx, tx, created); err != nil {
+			return err
+		}
+		user, session = found, created
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, nil
+	}
+	if challenge != nil {
+		return &LoginResult{User: user, MFAToken: mfaToken, MFAExpiresAt: challenge.ExpiresAt}, nil
+	}
+
+	token, err := u.sessions.Issue(user.ID, tenant.OrgID(ctx), session.ID, session.CreatedAt, session.ExpiresAt)
+	if err != nil {
+		return nil, err
+	}
+	return &LoginResult{User: user, Session: session, Token: token, ExpiresAt: session.ExpiresAt}, nil
+}
@@ -0,0 +1,103 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure"
+)
+
+// ConfirmTOTPUsecase 確認コードによる TOTP の二要素認証の有効化ユースケース
+type ConfirmTOTPUsecase struct {
+	userCommand       UserCommandRepository
+	credentialCommand CredentialCommandRepository
+	totpCommand       TOTPCredentialCommandRepository
+	totp              TOTPAuthenticator
+	txManager         TransactionManager
+	lockout           domain.LockoutPolicy
+}
+
+// NewConfirmTOTPUsecase ConfirmTOTPUsecaseのコンストラクタ
+func NewConfirmTOTPUsecase(
+	userCommand UserCommandRepository,
+	credentialCommand CredentialCommandRepository,
+	totpCommand TOTPCredentialCommandRepository,
+	totp TOTPAuthenticator,
+	txManager TransactionManager,
+	lockout domain.LockoutPolicy,
+) *ConfirmTOTPUsecase {
+	return &ConfirmTOTPUsecase{
+		userCommand:       userCommand,
+		credentialCommand: credentialCommand,
+		totpCommand:       totpCommand,
+		totp:              totp,
+		txManager:         txManager,
+		lockout:           lockout,
+	}
+}
+
+// Execute 登録中の TOTP の二要素認証を認証アプリの確認コード code で有効化し、リカバリーコードを返す
+//
+// 登録を開始していない場合は ErrMFANotEnrolled、既に有効な場合は ErrMFAAlreadyEnabled を返す。
+// 確認コードが一致しない場合はパスワードと同じく失敗として記録して ErrInvalidMFACode を返す
+// （ロック中は ErrAccountLocked）。リカバリーコードを平文で返すのはこのときのみ。
+func (u *ConfirmTOTPUsecase) Execute(ctx context.Context, userID, code string) ([]string, error) {
+	var (
+		recoveryCodes []string
+		authErr       error
+	)
+	err := u.txManager.RunInTransaction(ctx, func(ctx context.Context, tx infrastructure.DBTX) error {
+		user, err := u.userCommand.FindByIDForUpdate(ctx, tx, userID)
+		if err != nil {
+			return err
+		}
+		if user == nil {
+			return domain.ErrUserNotFound(userID)
+		}
+		totpCred, err := u.totpCommand.FindTOTPCredentialForUpdate(ctx, tx, userID)
+		if err != nil {
+			return err
+		}
+		if totpCred == nil {
+			return domain.ErrMFANotEnrolled(userID)
+		}
+		if totpCred.IsEnabled() {
+			return domain.ErrMFAAlreadyEnabled(userID)
+		}
+		cred, err := u.credentialCommand.FindCredentialForUpdate(ctx, tx, userID)
+		if err != nil {
+			return err
+		}
+
+		now := time.Now()
+		if cred != nil && cred.IsLocked(now) {
+			return domain.ErrAccountLocked(userID)
+		}
+		step, ok := u.totp.Verify(totpCred.Secret, code, now)
+		if !ok {
+			authErr = domain.ErrInvalidMFACode()
+			if cred == nil {
+				return nil
+			}
+			// 有効化は拒否するが、失敗の記録はコミットする
+			return recordPasswordFailure(ctx, u.userCommand, u.credentialCommand, tx, cred, u.lockout, now)
+		}
+
+		recoveryCodes, err = totpCred.Confirm(step, now)
+		if err != nil {
+			return err
+		}
+		if err := u.totpCommand.SaveTOTPCredential(ctx, tx, totpCred); err != nil {
+			return err
+		}
+		return u.userCommand.SaveUserLog(ctx, tx, domain.NewUserLog(userID, domain.UserLogActionMFAEnabled))
+	})
+	if err != nil {
+		return nil, err
+	}
+	if authErr != nil {
+		return nil, authErr
+	}
+	return recoveryCodes, nil
+}
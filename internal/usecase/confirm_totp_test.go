@@ -0,0 +1,88 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure/memory"
+	"github.com/example/go-react-cqrs-template/internal/usecase"
+)
+
+func TestConfirmTOTPUsecase_Execute(t *testing.T) {
+	hasher := newTestPasswordHasher()
+	user := mustNewUser(t, "John Doe", "john@example.com")
+	notEnrolled := mustNewUser(t, "Jane Doe", "jane@example.com")
+	secret, _, err := testTOTP.GenerateSecret(user.Email)
+	if err != nil {
+		t.Fatalf("GenerateSecret() unexpected error: %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		userID   string
+		code     func(t *testing.T) string
+		wantErr  any
+		wantLogs []domain.UserLogAction
+	}{
+		{
+			name:     "enable with current code",
+			userID:   user.ID,
+			code:     func(t *testing.T) string { return totpCode(t, secret, time.Now()) },
+			wantLogs: []domain.UserLogAction{domain.UserLogActionMFAEnabled},
+		},
+		{
+			name:     "wrong code is recorded",
+			userID:   user.ID,
+			code:     func(t *testing.T) string { return totpCode(t, secret, time.Now().Add(-10*time.Minute)) },
+			wantErr:  new(*domain.AuthenticationError),
+			wantLogs: []domain.UserLogAction{domain.UserLogActionLoginFailed},
+		},
+		{
+			name:    "not enrolled",
+			userID:  notEnrolled.ID,
+			code:    func(t *testing.T) string { return "123456" },
+			wantErr: new(*domain.NotFoundError),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			store := memory.NewStore()
+			store.Seed(user, notEnrolled)
+			store.SeedCredentials(mustNewCredential(t, hasher, user, "correct horse battery"))
+			store.SeedTOTPCredentials(domain.NewTOTPCredential(user.ID, secret, time.Now()))
+			uc := usecase.NewConfirmTOTPUsecase(store, store, store, testTOTP, store, domain.DefaultLockoutPolicy)
+
+			codes, err := uc.Execute(ctx, tt.userID, tt.code(t))
+
+			if tt.wantErr != nil {
+				if err == nil || !errors.As(err, tt.wantErr) {
+					t.Fatalf("Execute() error = %v, want %T", err, tt.wantErr)
+				}
+				if cred := store.TOTPCredential(tt.userID); cred != nil && cred.IsEnabled() {
+					t.Error("TOTP enabled after a failed confirmation")
+				}
+			} else {
+				if err != nil {
+					t.Fatalf("Execute() unexpected error: %v", err)
+				}
+				if len(codes) != domain.RecoveryCodeCount {
+					t.Errorf("len(recovery codes) = %d, want %d", len(codes), domain.RecoveryCodeCount)
+				}
+				cred := store.TOTPCredential(tt.userID)
+				if cred == nil || !cred.IsEnabled() || len(cred.RecoveryCodeHashes) != domain.RecoveryCodeCount {
+					t.Errorf("TOTPCredential = %+v, want enabled with recovery codes", cred)
+				}
+				// 有効化した後は再度確認できない
+				if _, err := uc.Execute(ctx, tt.userID, totpCode(t, secret, time.Now())); !errors.As(err, new(*domain.ConflictError)) {
+					t.Errorf("Execute() after enabling error = %v, want ConflictError", err)
+				}
+			}
+			assertUserLogActions(t, store, tt.userID, tt.wantLogs...)
+		})
+	}
+}
@@ -0,0 +1,50 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure"
+)
+
+// CreateGroupUsecase グループ作成ユースケース
+type CreateGroupUsecase struct {
+	groupCommand GroupCommandRepository
+	txManager    TransactionManager
+}
+
+// NewCreateGroupUsecase CreateGroupUsecaseのコンストラクタ
+func NewCreateGroupUsecase(
+	groupCommand GroupCommandRepository,
+	txManager TransactionManager,
+) *CreateGroupUsecase {
+	return &CreateGroupUsecase{
+		groupCommand: groupCommand,
+		txManager:    txManager,
+	}
+}
+
+// Execute グループを作成し、作成したグループを返す
+func (u *CreateGroupUsecase) Execute(ctx context.Context, name string) (*domain.Group, error) {
+	group, err := domain.NewGroup(name)
+	if err != nil {
+		return nil, err
+	}
+
+	err = u.txManager.RunInTransaction(ctx, func(ctx context.Context, tx infrastructure.DBTX) error {
+		// グループ名の重複チェック（ロック付き）
+		existing, err := u.groupCommand.FindGroupByNameForUpdate(ctx, tx, name)
+		if err != nil {
+			return err
+		}
+		if existing != nil {
+			return domain.ErrGroupNameAlreadyExists(name)
+		}
+
+		return u.groupCommand.SaveGroup(ctx, tx, group)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return group, nil
+}
@@ -0,0 +1,77 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure/memory"
+	"github.com/example/go-react-cqrs-template/internal/tenant"
+	"github.com/example/go-react-cqrs-template/internal/usecase"
+)
+
+func TestCreateGroupUsecase_Execute(t *testing.T) {
+	existing := mustNewGroup(t, "Engineering")
+
+	tests := []struct {
+		name      string
+		groupName string
+		orgID     string
+		wantErr   any
+	}{
+		{
+			name:      "creates group",
+			groupName: "Sales",
+		},
+		{
+			name:      "same name in another organization",
+			groupName: "Engineering",
+			orgID:     "01ARZ3NDEKTSV4RRFFQ69G5FAV",
+		},
+		{
+			name:      "duplicate name",
+			groupName: "Engineering",
+			wantErr:   new(*domain.ConflictError),
+		},
+		{
+			name:      "empty name",
+			groupName: "",
+			wantErr:   new(*domain.ValidationError),
+		},
+		{
+			name:      "too long name",
+			groupName: strings.Repeat("a", 101),
+			wantErr:   new(*domain.ValidationError),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.orgID != "" {
+				ctx = tenant.WithOrgID(ctx, tt.orgID)
+			}
+			store := memory.NewStore()
+			store.SeedGroups(existing)
+			uc := usecase.NewCreateGroupUsecase(store, store)
+
+			group, err := uc.Execute(ctx, tt.groupName)
+
+			if tt.wantErr != nil {
+				if err == nil || !errors.As(err, tt.wantErr) {
+					t.Fatalf("Execute() error = %v, want %T", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Execute() unexpected error: %v", err)
+			}
+			got, _ := store.FindGroupByID(ctx, group.ID)
+			if got == nil || got.Name != tt.groupName {
+				t.Errorf("FindGroupByID() = %+v, want %s", got, tt.groupName)
+			}
+		})
+	}
+}
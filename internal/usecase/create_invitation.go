@@ -0,0 +1,96 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure"
+	"github.com/example/go-react-cqrs-template/internal/mail"
+	"github.com/example/go-react-cqrs-template/internal/tenant"
+)
+
+// CreateInvitationUsecase メールアドレスでユーザーを招待するユースケース
+type CreateInvitationUsecase struct {
+	userCommand       UserCommandRepository
+	invitationCommand InvitationCommandRepository
+	mailer            mail.Mailer
+	txManager         TransactionManager
+	cfg               UserTokenConfig
+}
+
+// NewCreateInvitationUsecase CreateInvitationUsecaseのコンストラクタ
+func NewCreateInvitationUsecase(
+	userCommand UserCommandRepository,
+	invitationCommand InvitationCommandRepository,
+	mailer mail.Mailer,
+	txManager TransactionManager,
+	cfg UserTokenConfig,
+) *CreateInvitationUsecase {
+	return &CreateInvitationUsecase{
+		userCommand:       userCommand,
+		invitationCommand: invitationCommand,
+		mailer:            mailer,
+		txManager:         txManager,
+		cfg:               cfg,
+	}
+}
+
+// Execute 招待済み（invited）のユーザーと招待を作成し、招待のリンクをメールで送る
+//
+// name が空の場合はメールアドレスのローカル部を仮の名前とする。invitedBy は招待したユーザーのID（認証なしの場合は空）。
+// メールはコミット後に送るため、送信に失敗した場合は招待を再送する。
+func (u *CreateInvitationUsecase) Execute(ctx context.Context, email, name, invitedBy string) (*domain.Invitation, error) {
+	if name == "" {
+		name = defaultInviteeName(email)
+	}
+
+	var (
+		user   *domain.User
+		inv    *domain.Invitation
+		secret string
+	)
+	err := u.txManager.RunInTransaction(ctx, func(ctx context.Context, tx infrastructure.DBTX) error {
+		// メールアドレスの重複チェック（ロック付き）
+		existingUser, err := u.userCommand.FindByEmailForUpdate(ctx, tx, email)
+		if err != nil {
+			return err
+		}
+		if existingUser != nil {
+			return domain.ErrEmailAlreadyExists(email)
+		}
+
+		invited, err := domain.NewInvitedUser(name, email)
+		if err != nil {
+			return err
+		}
+		if err := u.userCommand.Save(ctx, tx, invited); err != nil {
+			return err
+		}
+		if err := u.userCommand.SaveUserLog(ctx, tx, domain.NewUserLog(invited.ID, domain.UserLogActionInvited)); err != nil {
+			return err
+		}
+		if err := u.userCommand.SaveEvents(ctx, tx, invited.PullEvents()); err != nil {
+			return err
+		}
+
+		created, s, err := domain.NewInvitation(invited, invitedBy, u.cfg.InvitationTTL)
+		if err != nil {
+			return err
+		}
+		if err := u.invitationCommand.SaveInvitation(ctx, tx, created); err != nil {
+			return err
+		}
+		user, inv, secret = invited, created, s
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	link := u.cfg.userTokenLink("/accept-invitation", tenant.OrgID(ctx), secret)
+	if err := u.mailer.Send(ctx, invitationMessage(user, link, inv.ExpiresAt)); err != nil {
+		return nil, fmt.Errorf("failed to send invitation email: %w", err)
+	}
+	return inv, nil
+}
@@ -0,0 +1,95 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure/memory"
+	"github.com/example/go-react-cqrs-template/internal/testutil/mailtest"
+	"github.com/example/go-react-cqrs-template/internal/usecase"
+)
+
+// createInvitation 招待を作成し、招待とリンクのトークンを返す
+func createInvitation(t *testing.T, store *memory.Store, email string) (*domain.Invitation, string) {
+	t.Helper()
+	mailer := &recordingMailer{}
+	uc := usecase.NewCreateInvitationUsecase(store, store, mailer, store, usecase.DefaultUserTokenConfig)
+	inv, err := uc.Execute(context.Background(), email, "", "")
+	if err != nil {
+		t.Fatalf("CreateInvitation() unexpected error: %v", err)
+	}
+	return inv, mailtest.LinkToken(t, mailer.sent()[0])
+}
+
+func TestCreateInvitationUsecase_Execute(t *testing.T) {
+	admin := mustNewUser(t, "Admin", "admin@example.com")
+
+	tests := []struct {
+		name     string
+		email    string
+		userName string
+		wantName string
+	}{
+		{name: "with name", email: "john@example.com", userName: "John Doe", wantName: "John Doe"},
+		{name: "name defaults to the local part of the email", email: "jane@example.com", wantName: "jane"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := memory.NewStore()
+			store.Seed(admin)
+			mailer := &recordingMailer{}
+			uc := usecase.NewCreateInvitationUsecase(store, store, mailer, store, usecase.DefaultUserTokenConfig)
+
+			inv, err := uc.Execute(context.Background(), tt.email, tt.userName, admin.ID)
+			if err != nil {
+				t.Fatalf("Execute() unexpected error: %v", err)
+			}
+			if inv.Status != domain.InvitationStatusPending || inv.Email != tt.email || inv.InvitedBy != admin.ID {
+				t.Errorf("Execute() = %+v, want pending invitation to %s by %s", inv, tt.email, admin.ID)
+			}
+			if got := inv.ExpiresAt.Sub(inv.CreatedAt); got != usecase.DefaultUserTokenConfig.InvitationTTL {
+				t.Errorf("ExpiresAt - CreatedAt = %v, want %v", got, usecase.DefaultUserTokenConfig.InvitationTTL)
+			}
+
+			user, err := store.FindByID(context.Background(), inv.UserID)
+			if err != nil || user == nil {
+				t.Fatalf("FindByID() = %v, %v, want invited user", user, err)
+			}
+			if user.Status != domain.UserStatusInvited || user.Name != tt.wantName || user.Email != tt.email {
+				t.Errorf("user = %+v, want invited user %q <%s>", user, tt.wantName, tt.email)
+			}
+			assertUserLogActions(t, store, user.ID, domain.UserLogActionInvited)
+			assertUserEventTypes(t, store, user.ID, domain.UserEventTypeCreated)
+
+			sent := mailer.sent()
+			if len(sent) != 1 || sent[0].To != tt.email || !strings.Contains(sent[0].Body, "/accept-invitation?") {
+				t.Fatalf("sent = %+v, want one invitation mail to %s", sent, tt.email)
+			}
+			if token := mailtest.LinkToken(t, sent[0]); domain.HashUserToken(token) != inv.TokenHash {
+				t.Errorf("link token does not match the invitation")
+			}
+		})
+	}
+}
+
+func TestCreateInvitationUsecase_EmailAlreadyExists(t *testing.T) {
+	existing := mustNewUser(t, "John Doe", "john@example.com")
+	store := memory.NewStore()
+	store.Seed(existing)
+	mailer := &recordingMailer{}
+	uc := usecase.NewCreateInvitationUsecase(store, store, mailer, store, usecase.DefaultUserTokenConfig)
+
+	_, err := uc.Execute(context.Background(), existing.Email, "", "")
+
+	if !errors.As(err, new(*domain.ConflictError)) {
+		t.Fatalf("Execute() error = %v, want ConflictError", err)
+	}
+	if sent := mailer.sent(); len(sent) != 0 {
+		t.Errorf("sent = %+v, want no mail", sent)
+	}
+	assertUserLogActions(t, store, existing.ID)
+}
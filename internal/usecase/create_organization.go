@@ -0,0 +1,41 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure"
+)
+
+// CreateOrganizationUsecase 組織作成ユースケース
+type CreateOrganizationUsecase struct {
+	orgCommand OrganizationCommandRepository
+	txManager  TransactionManager
+}
+
+// NewCreateOrganizationUsecase CreateOrganizationUsecaseのコンストラクタ
+func NewCreateOrganizationUsecase(
+	orgCommand OrganizationCommandRepository,
+	txManager TransactionManager,
+) *CreateOrganizationUsecase {
+	return &CreateOrganizationUsecase{
+		orgCommand: orgCommand,
+		txManager:  txManager,
+	}
+}
+
+// Execute 組織を作成し、作成した組織を返す
+func (u *CreateOrganizationUsecase) Execute(ctx context.Context, name string) (*domain.Organization, error) {
+	org, err := domain.NewOrganization(name)
+	if err != nil {
+		return nil, err
+	}
+
+	err = u.txManager.RunInTransaction(ctx, func(ctx context.Context, tx infrastructure.DBTX) error {
+		return u.orgCommand.SaveOrganization(ctx, tx, org)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return org, nil
+}
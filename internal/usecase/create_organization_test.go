@@ -0,0 +1,60 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure/memory"
+	"github.com/example/go-react-cqrs-template/internal/usecase"
+)
+
+func TestCreateOrganizationUsecase_Execute(t *testing.T) {
+	tests := []struct {
+		name    string
+		orgName string
+		wantErr bool
+	}{
+		{
+			name:    "creates organization",
+			orgName: "Acme",
+		},
+		{
+			name:    "empty name",
+			orgName: "",
+			wantErr: true,
+		},
+		{
+			name:    "too long name",
+			orgName: strings.Repeat("a", 256),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			store := memory.NewStore()
+			uc := usecase.NewCreateOrganizationUsecase(store, store)
+
+			org, err := uc.Execute(ctx, tt.orgName)
+
+			if tt.wantErr {
+				var validationErr *domain.ValidationError
+				if !errors.As(err, &validationErr) {
+					t.Fatalf("Execute() error = %v, want ValidationError", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Execute() unexpected error: %v", err)
+			}
+			got, _ := store.FindOrganizationByID(ctx, org.ID)
+			if got == nil || got.Name != tt.orgName {
+				t.Errorf("FindOrganizationByID() = %+v, want %s", got, tt.orgName)
+			}
+		})
+	}
+}
@@ -3,57 +3,75 @@ package usecase
 import (
 	"context"
 
-	"github.com/example/go-react-cqrs-template/internal/command"
 	"github.com/example/go-react-cqrs-template/internal/domain"
 	"github.com/example/go-react-cqrs-template/internal/infrastructure"
 )
 
 // CreateUserUsecase ユーザー作成ユースケース
 type CreateUserUsecase struct {
-	userQuery UserQueryRepository
-	txManager TransactionManager
+	userQuery   UserQueryRepository
+	userCommand UserCommandRepository
+	txManager   TransactionManager
 }
 
 // NewCreateUserUsecase CreateUserUsecaseのコンストラクタ
 func NewCreateUserUsecase(
 	userQuery UserQueryRepository,
+	userCommand UserCommandRepository,
 	txManager TransactionManager,
 ) *CreateUserUsecase {
 	return &CreateUserUsecase{
-		userQuery: userQuery,
-		txManager: txManager,
+		userQuery:   userQuery,
+		userCommand: userCommand,
+		txManager:   txManager,
 	}
 }
 
-// Execute ユーザーを作成
-func (u *CreateUserUsecase) Execute(ctx context.Context, name, email string) error {
-	return u.txManager.RunInTransaction(ctx, func(ctx context.Context, tx infrastructure.DBTX) error {
-		// メールアドレスの重複チェック（ロック付き）
-		existingUser, err := command.FindByEmailForUpdate(ctx, tx, email)
-		if err != nil {
-			return err
-		}
-		if existingUser != nil {
-			return domain.ErrEmailAlreadyExists(email)
-		}
-
-		// ドメインモデルの作成
-		user, err := domain.NewUser(name, email)
-		if err != nil {
-			return err
-		}
-
-		// 永続化
-		if err := command.Save(ctx, tx, user); err != nil {
-			return err
-		}
-
-		// ユーザー作成ログを保存
-		userLog := domain.NewUserLog(user.ID, domain.UserLogActionCreated)
-		if err := command.SaveUserLog(ctx, tx, userLog); err != nil {
-			return err
-		}
-
-		return nil
+// Execute ユーザーを作成し、作成したユーザーを返す
+func (u *CreateUserUsecase) Execute(ctx context.Context, name, email string) (*domain.User, error) {
+	var created *domain.User
+	err := u.txManager.RunInTransaction(ctx, func(ctx context.Context, tx infrastructure.DBTX) error {
+		user, err := createUser(ctx, u.userCommand, tx, name, email)
+		created = user
+		return err
 	})
+	if err != nil {
+		return nil, err
+	}
+	return created, nil
+}
+
+// createUser ユーザーを作成して永続化する（トランザクション内で使用）
+func createUser(ctx context.Context, userCommand UserCommandRepository, tx infrastructure.DBTX, name, email string) (*domain.User, error) {
+	// メールアドレスの重複チェック（ロック付き）
+	existingUser, err := userCommand.FindByEmailForUpdate(ctx, tx, email)
+	if err != nil {
+		return nil, err
+	}
+	if existingUser != nil {
+		return nil, domain.ErrEmailAlreadyExists(email)
+	}
+
+	// ドメインモデルの作成
+	user, err := domain.NewUser(name, email)
+	if err != nil {
+		return nil, err
+	}
+
+	// 永続化
+	if err := userCommand.Save(ctx, tx, user); err != nil {
+		return nil, err
+	}
+
+	// ユーザー作成ログを保存
+	userLog := domain.NewUserLog(user.ID, domain.UserLogActionCreated)
+	if err := userCommand.SaveUserLog(ctx, tx, userLog); err != nil {
+		return nil, err
+	}
+
+	// ドメインイベントを outbox に保存
+	if err := userCommand.SaveEvents(ctx, tx, user.PullEvents()); err != nil {
+		return nil, err
+	}
+	return user, nil
 }
@@ -0,0 +1,91 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure/memory"
+	"github.com/example/go-react-cqrs-template/internal/usecase"
+)
+
+func TestCreateUserUsecase_Execute(t *testing.T) {
+	existing := mustNewUser(t, "Existing", "existing@example.com")
+
+	tests := []struct {
+		name      string
+		userName  string
+		email     string
+		wantErr   any
+		wantCount int
+	}{
+		{
+			name:      "creates user",
+			userName:  "John Doe",
+			email:     "john@example.com",
+			wantCount: 2,
+		},
+		{
+			name:      "duplicate email",
+			userName:  "John Doe",
+			email:     "existing@example.com",
+			wantErr:   new(*domain.ConflictError),
+			wantCount: 1,
+		},
+		{
+			name:      "empty name",
+			userName:  "",
+			email:     "john@example.com",
+			wantErr:   new(*domain.ValidationError),
+			wantCount: 1,
+		},
+		{
+			name:      "empty email",
+			userName:  "John Doe",
+			email:     "",
+			wantErr:   new(*domain.ValidationError),
+			wantCount: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			store := memory.NewStore()
+			store.Seed(existing)
+			uc := usecase.NewCreateUserUsecase(store, store, store)
+
+			user, err := uc.Execute(ctx, tt.userName, tt.email)
+
+			if tt.wantErr != nil {
+				if err == nil || !errors.As(err, tt.wantErr) {
+					t.Fatalf("Execute() error = %v, want %T", err, tt.wantErr)
+				}
+			} else if err != nil {
+				t.Fatalf("Execute() unexpected error: %v", err)
+			}
+
+			count, _ := store.Count(ctx, "")
+			if count != tt.wantCount {
+				t.Errorf("Count() = %d, want %d", count, tt.wantCount)
+			}
+
+			if tt.wantErr != nil {
+				return
+			}
+			created, _ := store.FindByEmail(ctx, tt.email)
+			if created == nil {
+				t.Fatal("created user not found")
+			}
+			if user == nil || user.ID != created.ID {
+				t.Errorf("Execute() user = %+v, want ID %s", user, created.ID)
+			}
+			if created.Name != tt.userName {
+				t.Errorf("Name = %v, want %v", created.Name, tt.userName)
+			}
+			assertUserLogActions(t, store, created.ID, domain.UserLogActionCreated)
+			assertUserEventTypes(t, store, created.ID, domain.UserEventTypeCreated)
+		})
+	}
+}
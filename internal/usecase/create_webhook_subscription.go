@@ -0,0 +1,41 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure"
+)
+
+// CreateWebhookSubscriptionUsecase Webhook購読作成ユースケース
+type CreateWebhookSubscriptionUsecase struct {
+	webhookCommand WebhookCommandRepository
+	txManager      TransactionManager
+}
+
+// NewCreateWebhookSubscriptionUsecase CreateWebhookSubscriptionUsecaseのコンストラクタ
+func NewCreateWebhookSubscriptionUsecase(
+	webhookCommand WebhookCommandRepository,
+	txManager TransactionManager,
+) *CreateWebhookSubscriptionUsecase {
+	return &CreateWebhookSubscriptionUsecase{
+		webhookCommand: webhookCommand,
+		txManager:      txManager,
+	}
+}
+
+// Execute Webhook購読を作成し、作成した購読を返す
+func (u *CreateWebhookSubscriptionUsecase) Execute(ctx context.Context, url, secret string, eventTypes []domain.UserEventType, active bool) (*domain.WebhookSubscription, error) {
+	sub, err := domain.NewWebhookSubscription(url, secret, eventTypes, active)
+	if err != nil {
+		return nil, err
+	}
+
+	err = u.txManager.RunInTransaction(ctx, func(ctx context.Context, tx infrastructure.DBTX) error {
+		return u.webhookCommand.SaveSubscription(ctx, tx, sub)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return sub, nil
+}
@@ -0,0 +1,75 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure/memory"
+	"github.com/example/go-react-cqrs-template/internal/usecase"
+)
+
+func TestCreateWebhookSubscriptionUsecase_Execute(t *testing.T) {
+	tests := []struct {
+		name      string
+		url       string
+		secret    string
+		events    []domain.UserEventType
+		wantErr   any
+		wantCount int
+	}{
+		{
+			name:      "creates subscription",
+			url:       "https://example.com/hooks",
+			secret:    "0123456789abcdef",
+			events:    []domain.UserEventType{domain.UserEventTypeCreated, domain.UserEventTypeDeleted},
+			wantCount: 1,
+		},
+		{
+			name:    "invalid url",
+			url:     "example.com/hooks",
+			secret:  "0123456789abcdef",
+			events:  []domain.UserEventType{domain.UserEventTypeCreated},
+			wantErr: new(*domain.ValidationError),
+		},
+		{
+			name:    "short secret",
+			url:     "https://example.com/hooks",
+			secret:  "secret",
+			events:  []domain.UserEventType{domain.UserEventTypeCreated},
+			wantErr: new(*domain.ValidationError),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			store := memory.NewStore()
+			uc := usecase.NewCreateWebhookSubscriptionUsecase(store, store)
+
+			sub, err := uc.Execute(ctx, tt.url, tt.secret, tt.events, true)
+
+			if tt.wantErr != nil {
+				if err == nil || !errors.As(err, tt.wantErr) {
+					t.Fatalf("Execute() error = %v, want %T", err, tt.wantErr)
+				}
+			} else if err != nil {
+				t.Fatalf("Execute() unexpected error: %v", err)
+			}
+
+			count, _ := store.CountSubscriptions(ctx)
+			if count != tt.wantCount {
+				t.Errorf("CountSubscriptions() = %d, want %d", count, tt.wantCount)
+			}
+
+			if tt.wantErr != nil {
+				return
+			}
+			saved, _ := store.FindSubscriptionByID(ctx, sub.ID)
+			if saved == nil || saved.URL != tt.url || saved.Secret != tt.secret || len(saved.EventTypes) != len(tt.events) {
+				t.Errorf("saved subscription = %+v, want url %s with %v", saved, tt.url, tt.events)
+			}
+		})
+	}
+}
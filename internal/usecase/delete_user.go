@@ -3,25 +3,27 @@ package usecase
 import (
 	"context"
 
-	"github.com/example/go-react-cqrs-template/internal/command"
 	"github.com/example/go-react-cqrs-template/internal/domain"
 	"github.com/example/go-react-cqrs-template/internal/infrastructure"
 )
 
 // DeleteUserUsecase ユーザー削除ユースケース
 type DeleteUserUsecase struct {
-	userQuery UserQueryRepository
-	txManager TransactionManager
+	userQuery   UserQueryRepository
+	userCommand UserCommandRepository
+	txManager   TransactionManager
 }
 
 // NewDeleteUserUsecase DeleteUserUsecaseのコンストラクタ
 func NewDeleteUserUsecase(
 	userQuery UserQueryRepository,
+	userCommand UserCommandRepository,
 	txManager TransactionManager,
 ) *DeleteUserUsecase {
 	return &DeleteUserUsecase{
-		userQuery: userQuery,
-		txManager: txManager,
+		userQuery:   userQuery,
+		userCommand: userCommand,
+		txManager:   txManager,
 	}
 }
 
@@ -29,7 +31,7 @@ func NewDeleteUserUsecase(
 func (u *DeleteUserUsecase) Execute(ctx context.Context, id string) error {
 	return u.txManager.RunInTransaction(ctx, func(ctx context.Context, tx infrastructure.DBTX) error {
 		// 行ロック付きで存在確認
-		user, err := command.FindByIDForUpdate(ctx, tx, id)
+		user, err := u.userCommand.FindByIDForUpdate(ctx, tx, id)
 		if err != nil {
 			return err
 		}
@@ -37,13 +39,24 @@ func (u *DeleteUserUsecase) Execute(ctx context.Context, id string) error {
 			return domain.ErrUserNotFound(id)
 		}
 
-		// ユーザー削除ログを保存
-		userLog := domain.NewUserLog(id, domain.UserLogActionDeleted)
-		if err := command.SaveUserLog(ctx, tx, userLog); err != nil {
-			return err
-		}
-
-		// 削除
-		return command.Delete(ctx, tx, id)
+		return deleteUser(ctx, u.userCommand, tx, user)
 	})
 }
+
+// deleteUser 行ロック済みのユーザーを削除する
+func deleteUser(ctx context.Context, userCommand UserCommandRepository, tx infrastructure.DBTX, user *domain.User) error {
+	// ユーザー削除ログを保存
+	userLog := domain.NewUserLog(user.ID, domain.UserLogActionDeleted)
+	if err := userCommand.SaveUserLog(ctx, tx, userLog); err != nil {
+		return err
+	}
+
+	// 削除
+	user.Delete()
+	if err := userCommand.Delete(ctx, tx, user.ID); err != nil {
+		return err
+	}
+
+	// ドメインイベントを outbox に保存
+	return userCommand.SaveEvents(ctx, tx, user.PullEvents())
+}
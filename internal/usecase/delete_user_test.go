@@ -0,0 +1,63 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure/memory"
+	"github.com/example/go-react-cqrs-template/internal/usecase"
+)
+
+func TestDeleteUserUsecase_Execute(t *testing.T) {
+	user := mustNewUser(t, "John Doe", "john@example.com")
+
+	tests := []struct {
+		name    string
+		id      string
+		wantErr bool
+	}{
+		{
+			name: "deletes user",
+			id:   user.ID,
+		},
+		{
+			name:    "unknown user",
+			id:      "01ARZ3NDEKTSV4RRFFQ69G5FAV",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			store := memory.NewStore()
+			store.Seed(user)
+			uc := usecase.NewDeleteUserUsecase(store, store, store)
+
+			err := uc.Execute(ctx, tt.id)
+
+			if tt.wantErr {
+				var notFound *domain.NotFoundError
+				if !errors.As(err, &notFound) {
+					t.Fatalf("Execute() error = %v, want NotFoundError", err)
+				}
+				if got, _ := store.FindByID(ctx, user.ID); got == nil {
+					t.Error("existing user should not be deleted")
+				}
+				assertUserLogActions(t, store, tt.id)
+				assertUserEventTypes(t, store, tt.id)
+				return
+			}
+			if err != nil {
+				t.Fatalf("Execute() unexpected error: %v", err)
+			}
+			if got, _ := store.FindByID(ctx, tt.id); got != nil {
+				t.Error("user should be deleted")
+			}
+			assertUserLogActions(t, store, tt.id, domain.UserLogActionDeleted)
+			assertUserEventTypes(t, store, tt.id, domain.UserEventTypeDeleted)
+		})
+	}
+}
@@ -0,0 +1,41 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure"
+)
+
+// DeleteWebhookSubscriptionUsecase Webhook購読削除ユースケース
+type DeleteWebhookSubscriptionUsecase struct {
+	webhookCommand WebhookCommandRepository
+	txManager      TransactionManager
+}
+
+// NewDeleteWebhookSubscriptionUsecase DeleteWebhookSubscriptionUsecaseのコンストラクタ
+func NewDeleteWebhookSubscriptionUsecase(
+	webhookCommand WebhookCommandRepository,
+	txManager TransactionManager,
+) *DeleteWebhookSubscriptionUsecase {
+	return &DeleteWebhookSubscriptionUsecase{
+		webhookCommand: webhookCommand,
+		txManager:      txManager,
+	}
+}
+
+// Execute Webhook購読と配信ログを削除（未配信の配信も破棄される）
+func (u *DeleteWebhookSubscriptionUsecase) Execute(ctx context.Context, id string) error {
+	return u.txManager.RunInTransaction(ctx, func(ctx context.Context, tx infrastructure.DBTX) error {
+		// 行ロック付きで存在確認
+		sub, err := u.webhookCommand.FindSubscriptionByIDForUpdate(ctx, tx, id)
+		if err != nil {
+			return err
+		}
+		if sub == nil {
+			return domain.ErrWebhookSubscriptionNotFound(id)
+		}
+
+		return u.webhookCommand.DeleteSubscription(ctx, tx, id)
+	})
+}
@@ -0,0 +1,37 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure/memory"
+	"github.com/example/go-react-cqrs-template/internal/usecase"
+)
+
+func TestDeleteWebhookSubscriptionUsecase_Execute(t *testing.T) {
+	ctx := context.Background()
+	sub := mustNewWebhookSubscription(t, "https://example.com/hooks", domain.UserEventTypeCreated)
+	store := memory.NewStore()
+	store.SeedWebhookSubscriptions(sub)
+	store.SeedWebhookDeliveries(domain.NewWebhookDelivery(sub.ID, "01ARZ3NDEKTSV4RRFFQ69G5FAV", domain.UserEventTypeCreated, []byte(`{}`), time.Now()))
+	uc := usecase.NewDeleteWebhookSubscriptionUsecase(store, store)
+
+	if err := uc.Execute(ctx, sub.ID); err != nil {
+		t.Fatalf("Execute() unexpected error: %v", err)
+	}
+	if found, _ := store.FindSubscriptionByID(ctx, sub.ID); found != nil {
+		t.Errorf("subscription still exists: %+v", found)
+	}
+	if n, _ := store.CountDeliveries(ctx, sub.ID, ""); n != 0 {
+		t.Errorf("CountDeliveries() = %d, want deliveries deleted with the subscription", n)
+	}
+
+	// 削除済みの購読は NotFound
+	var notFound *domain.NotFoundError
+	if err := uc.Execute(ctx, sub.ID); !errors.As(err, &notFound) {
+		t.Errorf("Execute() second call error = %v, want NotFoundError", err)
+	}
+}
@@ -0,0 +1,109 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure"
+)
+
+// DisableTOTPUsecase TOTP の二要素認証の無効化ユースケース
+type DisableTOTPUsecase struct {
+	userCommand       UserCommandRepository
+	credentialCommand CredentialCommandRepository
+	totpCommand       TOTPCredentialCommandRepository
+	groupQuery        GroupQueryRepository
+	totp              TOTPAuthenticator
+	txManager         TransactionManager
+	lockout           domain.LockoutPolicy
+	mfaPolicy         domain.MFAPolicy
+}
+
+// NewDisableTOTPUsecase DisableTOTPUsecaseのコンストラクタ
+func NewDisableTOTPUsecase(
+	userCommand UserCommandRepository,
+	credentialCommand CredentialCommandRepository,
+	totpCommand TOTPCredentialCommandRepository,
+	groupQuery GroupQueryRepository,
+	totp TOTPAuthenticator,
+	txManager TransactionManager,
+	lockout domain.LockoutPolicy,
+	mfaPolicy domain.MFAPolicy,
+) *DisableTOTPUsecase {
+	return &DisableTOTPUsecase{
+		userCommand:       userCommand,
+		credentialCommand: credentialCommand,
+		totpCommand:       totpCommand,
+		groupQuery:        groupQuery,
+		totp:              totp,
+		txManager:         txManager,
+		lockout:           lockout,
+		mfaPolicy:         mfaPolicy,
+	}
+}
+
+// Execute 確認コードまたはリカバリーコード code を照合し、TOTP の二要素認証を無効化する
+//
+// 有効でない場合は ErrMFANotEnrolled、方針で二要素認証が必須のユーザー（管理者）の場合は
+// ErrMFARequired を返す。コードが一致しない場合はパスワードと同じく失敗として記録して
+// ErrInvalidMFACode を返す（ロック中は ErrAccountLocked）。無効化すると秘密鍵と未使用の
+// リカバリーコードは削除する。
+func (u *DisableTOTPUsecase) Execute(ctx context.Context, userID, code string) error {
+	var authErr error
+	err := u.txManager.RunInTransaction(ctx, func(ctx context.Context, tx infrastructure.DBTX) error {
+		user, err := u.userCommand.FindByIDForUpdate(ctx, tx, userID)
+		if err != nil {
+			return err
+		}
+		if user == nil {
+			return domain.ErrUserNotFound(userID)
+		}
+		totpCred, err := u.totpCommand.FindTOTPCredentialForUpdate(ctx, tx, userID)
+		if err != nil {
+			return err
+		}
+		if totpCred == nil || !totpCred.IsEnabled() {
+			return domain.ErrMFANotEnrolled(userID)
+		}
+		required, err := mfaRequired(ctx, u.groupQuery, u.mfaPolicy, userID)
+		if err != nil {
+			return err
+		}
+		if required {
+			return domain.ErrMFARequired(userID)
+		}
+		cred, err := u.credentialCommand.FindCredentialForUpdate(ctx, tx, userID)
+		if err != nil {
+			return err
+		}
+
+		now := time.Now()
+		if cred != nil && cred.IsLocked(now) {
+			return domain.ErrAccountLocked(userID)
+		}
+		recovery, ok := useMFACode(u.totp, totpCred, code, now)
+		if !ok {
+			authErr = domain.ErrInvalidMFACode()
+			if cred == nil {
+				return nil
+			}
+			// 無効化は拒否するが、失敗の記録はコミットする
+			return recordPasswordFailure(ctx, u.userCommand, u.credentialCommand, tx, cred, u.lockout, now)
+		}
+
+		if err := u.totpCommand.DeleteTOTPCredential(ctx, tx, userID); err != nil {
+			return err
+		}
+		if recovery {
+			if err := u.userCommand.SaveUserLog(ctx, tx, domain.NewUserLog(userID, domain.UserLogActionMFARecoveryCodeUsed)); err != nil {
+				return err
+			}
+		}
+		return u.userCommand.SaveUserLog(ctx, tx, domain.NewUserLog(userID, domain.UserLogActionMFADisabled))
+	})
+	if err != nil {
+		return err
+	}
+	return authErr
+}
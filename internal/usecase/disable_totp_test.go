@@ -0,0 +1,100 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure/memory"
+	"github.com/example/go-react-cqrs-template/internal/usecase"
+)
+
+func TestDisableTOTPUsecase_Execute(t *testing.T) {
+	hasher := newTestPasswordHasher()
+	user := mustNewUser(t, "John Doe", "john@example.com")
+	admin := mustNewUser(t, "Jane Doe", "jane@example.com")
+	notEnrolled := mustNewUser(t, "Bob Smith", "bob@example.com")
+	admins := mustNewGroup(t, "admins")
+	userCred, recoveryCodes := mustNewEnabledTOTPCredential(t, user)
+	adminCred, _ := mustNewEnabledTOTPCredential(t, admin)
+	policy := domain.DefaultMFAPolicy
+	policy.RequireForAdmins = true
+
+	tests := []struct {
+		name       string
+		userID     string
+		code       func(t *testing.T) string
+		wantErr    any
+		wantLogs   []domain.UserLogAction
+		wantExists bool
+	}{
+		{
+			name:     "disable with current code",
+			userID:   user.ID,
+			code:     func(t *testing.T) string { return totpCode(t, userCred.Secret, time.Now()) },
+			wantLogs: []domain.UserLogAction{domain.UserLogActionMFADisabled},
+		},
+		{
+			name:   "disable with recovery code",
+			userID: user.ID,
+			code:   func(*testing.T) string { return recoveryCodes[0] },
+			wantLogs: []domain.UserLogAction{
+				domain.UserLogActionMFARecoveryCodeUsed,
+				domain.UserLogActionMFADisabled,
+			},
+		},
+		{
+			name:       "wrong code is recorded",
+			userID:     user.ID,
+			code:       func(*testing.T) string { return "aaaa-bbbb-cccc-dddd" },
+			wantErr:    new(*domain.AuthenticationError),
+			wantLogs:   []domain.UserLogAction{domain.UserLogActionLoginFailed},
+			wantExists: true,
+		},
+		{
+			name:       "required for admins",
+			userID:     admin.ID,
+			code:       func(t *testing.T) string { return totpCode(t, adminCred.Secret, time.Now()) },
+			wantErr:    new(*domain.ConflictError),
+			wantExists: true,
+		},
+		{
+			name:    "not enrolled",
+			userID:  notEnrolled.ID,
+			code:    func(*testing.T) string { return "123456" },
+			wantErr: new(*domain.NotFoundError),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			store := memory.NewStore()
+			store.Seed(user, admin, notEnrolled)
+			store.SeedGroups(admins)
+			store.SeedGroupMembers(mustNewGroupMember(t, admins, admin, domain.GroupRoleMember))
+			store.SeedCredentials(
+				mustNewCredential(t, hasher, user, "correct horse battery"),
+				mustNewCredential(t, hasher, admin, "correct horse battery"),
+			)
+			store.SeedTOTPCredentials(userCred, adminCred)
+			uc := usecase.NewDisableTOTPUsecase(store, store, store, store, testTOTP, store, domain.DefaultLockoutPolicy, policy)
+
+			err := uc.Execute(ctx, tt.userID, tt.code(t))
+
+			if tt.wantErr != nil {
+				if err == nil || !errors.As(err, tt.wantErr) {
+					t.Fatalf("Execute() error = %v, want %T", err, tt.wantErr)
+				}
+			} else if err != nil {
+				t.Fatalf("Execute() unexpected error: %v", err)
+			}
+			if exists := store.TOTPCredential(tt.userID) != nil; exists != tt.wantExists {
+				t.Errorf("TOTPCredential exists = %v, want %v", exists, tt.wantExists)
+			}
+			assertUserLogActions(t, store, tt.userID, tt.wantLogs...)
+		})
+	}
+}
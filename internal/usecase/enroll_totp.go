@@ -0,0 +1,120 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure"
+)
+
+// EnrollTOTPUsecase TOTP の二要素認証の登録の開始ユースケース
+type EnrollTOTPUsecase struct {
+	userCommand       UserCommandRepository
+	credentialCommand CredentialCommandRepository
+	totpCommand       TOTPCredentialCommandRepository
+	hasher            PasswordHasher
+	totp              TOTPAuthenticator
+	txManager         TransactionManager
+	lockout           domain.LockoutPolicy
+}
+
+// NewEnrollTOTPUsecase EnrollTOTPUsecaseのコンストラクタ
+func NewEnrollTOTPUsecase(
+	userCommand UserCommandRepository,
+	credentialCommand CredentialCommandRepository,
+	totpCommand TOTPCredentialCommandRepository,
+	hasher PasswordHasher,
+	totp TOTPAuthenticator,
+	txManager TransactionManager,
+	lockout domain.LockoutPolicy,
+) *EnrollTOTPUsecase {
+	return &EnrollTOTPUsecase{
+		userCommand:       userCommand,
+		credentialCommand: credentialCommand,
+		totpCommand:       totpCommand,
+		hasher:            hasher,
+		totp:              totp,
+		txManager:         txManager,
+		lockout:           lockout,
+	}
+}
+
+// Execute 現在のパスワード password を照合し、秘密鍵を生成して TOTP の二要素認証の登録を開始する
+//
+// 認証アプリの確認コードで確認（ConfirmTOTPUsecase）するまでは有効にならない。登録中に再度実行すると
+// 秘密鍵を作り直す。パスワードが一致しない場合はログインと同じく失敗として記録して ErrInvalidCredentials を
+// 返す（ロック中は ErrAccountLocked）。既に有効な場合は ErrMFAAlreadyEnabled を返す。
+func (u *EnrollTOTPUsecase) Execute(ctx context.Context, userID, password string) (*TOTPEnrollment, error) {
+	if password == "" {
+		return nil, domain.ErrCurrentPasswordRequired()
+	}
+
+	var (
+		uri     string
+		secret  string
+		authErr error
+	)
+	err := u.txManager.RunInTransaction(ctx, func(ctx context.Context, tx infrastructure.DBTX) error {
+		user, err := u.userCommand.FindByIDForUpdate(ctx, tx, userID)
+		if err != nil {
+			return err
+		}
+		if user == nil {
+			return domain.ErrUserNotFound(userID)
+		}
+		cred, err := u.credentialCommand.FindCredentialForUpdate(ctx, tx, userID)
+		if err != nil {
+			return err
+		}
+		if cred == nil {
+			return domain.ErrInvalidCredentials()
+		}
+
+		now := time.Now()
+		if cred.IsLocked(now) {
+			return domain.ErrAccountLocked(userID)
+		}
+		ok, err := u.hasher.Verify(cred.PasswordHash, password)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			// 登録は拒否するが、失敗の記録はコミットする
+			authErr = domain.ErrInvalidCredentials()
+			return recordPasswordFailure(ctx, u.userCommand, u.credentialCommand, tx, cred, u.lockout, now)
+		}
+		if user.Status != domain.UserStatusActive {
+			return domain.ErrUserNotActive(userID, user.Status)
+		}
+
+		existing, err := u.totpCommand.FindTOTPCredentialForUpdate(ctx, tx, userID)
+		if err != nil {
+			return err
+		}
+		if existing != nil && existing.IsEnabled() {
+			return domain.ErrMFAAlreadyEnabled(userID)
+		}
+
+		secret, uri, err = u.totp.GenerateSecret(user.Email)
+		if err != nil {
+			return err
+		}
+		if err := u.totpCommand.SaveTOTPCredential(ctx, tx, domain.NewTOTPCredential(userID, secret, now)); err != nil {
+			return err
+		}
+		return u.userCommand.SaveUserLog(ctx, tx, domain.NewUserLog(userID, domain.UserLogActionMFAEnrollmentStarted))
+	})
+	if err != nil {
+		return nil, err
+	}
+	if authErr != nil {
+		return nil, authErr
+	}
+
+	qr, err := u.totp.QRCode(uri)
+	if err != nil {
+		return nil, err
+	}
+	return &TOTPEnrollment{Secret: secret, URI: uri, QRCode: qr}, nil
+}
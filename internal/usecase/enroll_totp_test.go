@@ -0,0 +1,120 @@
+package usecase_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure/memory"
+	"github.com/example/go-react-cqrs-template/internal/usecase"
+)
+
+func TestEnrollTOTPUsecase_Execute(t *testing.T) {
+	hasher := newTestPasswordHasher()
+	user := mustNewUser(t, "John Doe", "john@example.com")
+	enabled := mustNewUser(t, "Jane Doe", "jane@example.com")
+	enabledCred, _ := mustNewEnabledTOTPCredential(t, enabled)
+
+	tests := []struct {
+		name     string
+		userID   string
+		password string
+		wantErr  any
+		wantLogs []domain.UserLogAction
+	}{
+		{
+			name:     "start enrollment",
+			userID:   user.ID,
+			password: "correct horse battery",
+			wantLogs: []domain.UserLogAction{domain.UserLogActionMFAEnrollmentStarted},
+		},
+		{
+			name:    "password required",
+			userID:  user.ID,
+			wantErr: new(*domain.ValidationError),
+		},
+		{
+			name:     "wrong password is recorded",
+			userID:   user.ID,
+			password: "wrong password!",
+			wantErr:  new(*domain.AuthenticationError),
+			wantLogs: []domain.UserLogAction{domain.UserLogActionLoginFailed},
+		},
+		{
+			name:     "already enabled",
+			userID:   enabled.ID,
+			password: "correct horse battery",
+			wantErr:  new(*domain.ConflictError),
+		},
+		{
+			name:     "user not found",
+			userID:   "01ARZ3NDEKTSV4RRFFQ69G5FAV",
+			password: "correct horse battery",
+			wantErr:  new(*domain.NotFoundError),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			store := memory.NewStore()
+			store.Seed(user, enabled)
+			store.SeedCredentials(
+				mustNewCredential(t, hasher, user, "correct horse battery"),
+				mustNewCredential(t, hasher, enabled, "correct horse battery"),
+			)
+			store.SeedTOTPCredentials(enabledCred)
+			uc := usecase.NewEnrollTOTPUsecase(store, store, store, hasher, testTOTP, store, domain.DefaultLockoutPolicy)
+
+			got, err := uc.Execute(ctx, tt.userID, tt.password)
+
+			if tt.wantErr != nil {
+				if err == nil || !errors.As(err, tt.wantErr) {
+					t.Fatalf("Execute() error = %v, want %T", err, tt.wantErr)
+				}
+			} else {
+				if err != nil {
+					t.Fatalf("Execute() unexpected error: %v", err)
+				}
+				if got.Secret == "" || !strings.HasPrefix(got.URI, "otpauth://totp/") || !strings.Contains(got.URI, "secret="+got.Secret) {
+					t.Errorf("Execute() = %+v, want secret and otpauth uri", got)
+				}
+				if !bytes.HasPrefix(got.QRCode, []byte("\x89PNG")) {
+					t.Error("QRCode is not a PNG image")
+				}
+				// 確認コードで確認するまでは有効にならない
+				cred := store.TOTPCredential(tt.userID)
+				if cred == nil || cred.Secret != got.Secret || cred.IsEnabled() {
+					t.Errorf("TOTPCredential = %+v, want pending enrollment with the secret", cred)
+				}
+			}
+			assertUserLogActions(t, store, tt.userID, tt.wantLogs...)
+		})
+	}
+}
+
+func TestEnrollTOTPUsecase_Restart(t *testing.T) {
+	ctx := context.Background()
+	hasher := newTestPasswordHasher()
+	user := mustNewUser(t, "John Doe", "john@example.com")
+	store := memory.NewStore()
+	store.Seed(user)
+	store.SeedCredentials(mustNewCredential(t, hasher, user, "correct horse battery"))
+	uc := usecase.NewEnrollTOTPUsecase(store, store, store, hasher, testTOTP, store, domain.DefaultLockoutPolicy)
+
+	first, err := uc.Execute(ctx, user.ID, "correct horse battery")
+	if err != nil {
+		t.Fatalf("Execute() unexpected error: %v", err)
+	}
+	// 登録中に再度開始すると秘密鍵を作り直す
+	second, err := uc.Execute(ctx, user.ID, "correct horse battery")
+	if err != nil {
+		t.Fatalf("Execute() again unexpected error: %v", err)
+	}
+	if first.Secret == second.Secret || store.TOTPCredential(user.ID).Secret != second.Secret {
+		t.Error("restarting the enrollment did not replace the secret")
+	}
+}
@@ -0,0 +1,99 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/example/go-react-cqrs-template/internal/infrastructure"
+	"github.com/example/go-react-cqrs-template/internal/tenant"
+)
+
+const (
+	// expireInvitationsBatchSize 1トランザクションで期限切れにする招待の最大件数
+	expireInvitationsBatchSize = 100
+	// expireInvitationsOrgPageSize 組織を取得する際のページサイズ
+	expireInvitationsOrgPageSize = 100
+)
+
+// ExpireInvitationsUsecase 有効期限を過ぎた招待を期限切れにするユースケース（定期実行用）
+type ExpireInvitationsUsecase struct {
+	orgQuery          OrganizationQueryRepository
+	userCommand       UserCommandRepository
+	invitationCommand InvitationCommandRepository
+	txManager         TransactionManager
+}
+
+// NewExpireInvitationsUsecase ExpireInvitationsUsecaseのコンストラクタ
+func NewExpireInvitationsUsecase(
+	orgQuery OrganizationQueryRepository,
+	userCommand UserCommandRepository,
+	invitationCommand InvitationCommandRepository,
+	txManager TransactionManager,
+) *ExpireInvitationsUsecase {
+	return &ExpireInvitationsUsecase{
+		orgQuery:          orgQuery,
+		userCommand:       userCommand,
+		invitationCommand: invitationCommand,
+		txManager:         txManager,
+	}
+}
+
+// Execute すべての組織について有効期限を過ぎた承諾待ちの招待を期限切れにし、ユーザーログに記録する
+//
+// 期限切れにした件数を返す。他のトランザクションがロック中の招待は次回に回す。
+func (u *ExpireInvitationsUsecase) Execute(ctx context.Context) (int, error) {
+	total := 0
+	for offset := 0; ; offset += expireInvitationsOrgPageSize {
+		orgs, err := u.orgQuery.FindAllOrganizations(ctx, expireInvitationsOrgPageSize, offset)
+		if err != nil {
+			return total, err
+		}
+		for _, org := range orgs {
+			n, err := u.expireOrg(tenant.WithOrgID(ctx, org.ID))
+			total += n
+			if err != nil {
+				return total, err
+			}
+		}
+		if len(orgs) < expireInvitationsOrgPageSize {
+			return total, nil
+		}
+	}
+}
+
+// expireOrg コンテキストの組織の期限切れの招待をバッチごとに処理
+func (u *ExpireInvitationsUsecase) expireOrg(ctx context.Context) (int, error) {
+	total := 0
+	for {
+		var (
+			n    int
+			done bool
+		)
+		err := u.txManager.RunInTransaction(ctx, func(ctx context.Context, tx infrastructure.DBTX) error {
+			n = 0
+			now := time.Now()
+			invs, err := u.invitationCommand.FindExpiredInvitationsForUpdate(ctx, tx, now, expireInvitationsBatchSize)
+			if err != nil {
+				return err
+			}
+			for _, inv := range invs {
+				expired, err := expireInvitation(ctx, u.userCommand, u.invitationCommand, tx, inv, now)
+				if err != nil {
+					return err
+				}
+				if expired {
+					n++
+				}
+			}
+			done = len(invs) < expireInvitationsBatchSize
+			return nil
+		})
+		if err != nil {
+			return total, err
+		}
+		total += n
+		if done {
+			return total, nil
+		}
+	}
+}
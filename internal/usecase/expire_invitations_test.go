@@ -0,0 +1,62 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure/memory"
+	"github.com/example/go-react-cqrs-template/internal/tenant"
+	"github.com/example/go-react-cqrs-template/internal/usecase"
+)
+
+func TestExpireInvitationsUsecase_Execute(t *testing.T) {
+	store := memory.NewStore()
+	org, err := domain.NewOrganization("Acme")
+	if err != nil {
+		t.Fatalf("NewOrganization() unexpected error: %v", err)
+	}
+	store.SeedOrganizations(org)
+
+	// 既定の組織と別の組織に期限切れの招待を1件ずつ、既定の組織に有効な招待を1件
+	expiredDefault, _ := createInvitation(t, store, "john@example.com")
+	pending, _ := createInvitation(t, store, "jane@example.com")
+	var expiredOther *domain.Invitation
+	{
+		mailer := &recordingMailer{}
+		uc := usecase.NewCreateInvitationUsecase(store, store, mailer, store, usecase.DefaultUserTokenConfig)
+		expiredOther, err = uc.Execute(tenant.WithOrgID(context.Background(), org.ID), "john@example.com", "", "")
+		if err != nil {
+			t.Fatalf("CreateInvitation() unexpected error: %v", err)
+		}
+	}
+	for _, inv := range []*domain.Invitation{expiredDefault, expiredOther} {
+		expired := *inv
+		expired.ExpiresAt = time.Now().Add(-time.Minute)
+		store.SeedInvitations(&expired)
+	}
+	uc := usecase.NewExpireInvitationsUsecase(store, store, store, store)
+
+	n, err := uc.Execute(context.Background())
+	if err != nil {
+		t.Fatalf("Execute() unexpected error: %v", err)
+	}
+	if n != 2 {
+		t.Errorf("Execute() = %d, want 2", n)
+	}
+	for _, inv := range []*domain.Invitation{expiredDefault, expiredOther} {
+		if invs := store.Invitations(inv.UserID); len(invs) != 1 || invs[0].Status != domain.InvitationStatusExpired {
+			t.Errorf("Invitations(%s) = %+v, want one expired invitation", inv.UserID, invs)
+		}
+		assertUserLogActions(t, store, inv.UserID, domain.UserLogActionInvited, domain.UserLogActionInvitationExpired)
+	}
+	if invs := store.Invitations(pending.UserID); len(invs) != 1 || invs[0].Status != domain.InvitationStatusPending {
+		t.Errorf("Invitations(%s) = %+v, want one pending invitation", pending.UserID, invs)
+	}
+
+	// 期限切れにした招待は再び処理しない
+	if n, err := uc.Execute(context.Background()); err != nil || n != 0 {
+		t.Errorf("Execute() twice = %d, %v, want 0", n, err)
+	}
+}
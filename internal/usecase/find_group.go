@@ -0,0 +1,31 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+)
+
+// FindGroupUsecase グループ取得ユースケース
+type FindGroupUsecase struct {
+	groupQuery GroupQueryRepository
+}
+
+// NewFindGroupUsecase FindGroupUsecaseのコンストラクタ
+func NewFindGroupUsecase(groupQuery GroupQueryRepository) *FindGroupUsecase {
+	return &FindGroupUsecase{
+		groupQuery: groupQuery,
+	}
+}
+
+// Execute グループを取得
+func (u *FindGroupUsecase) Execute(ctx context.Context, id string) (*domain.Group, error) {
+	group, err := u.groupQuery.FindGroupByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if group == nil {
+		return nil, domain.ErrGroupNotFound(id)
+	}
+	return group, nil
+}
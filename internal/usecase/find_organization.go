@@ -0,0 +1,31 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+)
+
+// FindOrganizationUsecase 組織取得ユースケース
+type FindOrganizationUsecase struct {
+	orgQuery OrganizationQueryRepository
+}
+
+// NewFindOrganizationUsecase FindOrganizationUsecaseのコンストラクタ
+func NewFindOrganizationUsecase(orgQuery OrganizationQueryRepository) *FindOrganizationUsecase {
+	return &FindOrganizationUsecase{
+		orgQuery: orgQuery,
+	}
+}
+
+// Execute 組織を取得
+func (u *FindOrganizationUsecase) Execute(ctx context.Context, id string) (*domain.Organization, error) {
+	org, err := u.orgQuery.FindOrganizationByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if org == nil {
+		return nil, domain.ErrOrganizationNotFound(id)
+	}
+	return org, nil
+}
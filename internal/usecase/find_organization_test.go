@@ -0,0 +1,52 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure/memory"
+	"github.com/example/go-react-cqrs-template/internal/tenant"
+	"github.com/example/go-react-cqrs-template/internal/usecase"
+)
+
+func TestFindOrganizationUsecase_Execute(t *testing.T) {
+	tests := []struct {
+		name    string
+		id      string
+		wantErr bool
+	}{
+		{
+			name: "default organization",
+			id:   tenant.DefaultOrgID,
+		},
+		{
+			name:    "unknown organization",
+			id:      "01ARZ3NDEKTSV4RRFFQ69G5FAV",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc := usecase.NewFindOrganizationUsecase(memory.NewStore())
+
+			got, err := uc.Execute(context.Background(), tt.id)
+
+			if tt.wantErr {
+				var notFound *domain.NotFoundError
+				if !errors.As(err, &notFound) {
+					t.Fatalf("Execute() error = %v, want NotFoundError", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Execute() unexpected error: %v", err)
+			}
+			if got.ID != tt.id {
+				t.Errorf("ID = %v, want %v", got.ID, tt.id)
+			}
+		})
+	}
+}
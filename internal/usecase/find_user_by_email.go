@@ -0,0 +1,31 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+)
+
+// FindUserByEmailUsecase メールアドレスによるユーザー取得ユースケース
+type FindUserByEmailUsecase struct {
+	userQuery UserQueryRepository
+}
+
+// NewFindUserByEmailUsecase FindUserByEmailUsecaseのコンストラクタ
+func NewFindUserByEmailUsecase(userQuery UserQueryRepository) *FindUserByEmailUsecase {
+	return &FindUserByEmailUsecase{
+		userQuery: userQuery,
+	}
+}
+
+// Execute メールアドレスでユーザーを取得
+func (u *FindUserByEmailUsecase) Execute(ctx context.Context, email string) (*domain.User, error) {
+	user, err := u.userQuery.FindByEmail(ctx, email)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, domain.ErrUserNotFound(email)
+	}
+	return user, nil
+}
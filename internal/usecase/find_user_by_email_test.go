@@ -0,0 +1,31 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure/memory"
+	"github.com/example/go-react-cqrs-template/internal/usecase"
+)
+
+func TestFindUserByEmailUsecase_Execute(t *testing.T) {
+	user := mustNewUser(t, "John Doe", "john@example.com")
+	store := memory.NewStore()
+	store.Seed(user)
+	uc := usecase.NewFindUserByEmailUsecase(store)
+
+	got, err := uc.Execute(context.Background(), "john@example.com")
+	if err != nil {
+		t.Fatalf("Execute() unexpected error: %v", err)
+	}
+	if got.ID != user.ID {
+		t.Errorf("Execute() = %+v, want %+v", got, user)
+	}
+
+	var notFound *domain.NotFoundError
+	if _, err := uc.Execute(context.Background(), "jane@example.com"); !errors.As(err, &notFound) {
+		t.Errorf("Execute() error = %v, want NotFoundError", err)
+	}
+}
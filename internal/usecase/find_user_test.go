@@ -0,0 +1,55 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure/memory"
+	"github.com/example/go-react-cqrs-template/internal/usecase"
+)
+
+func TestFindUserUsecase_Execute(t *testing.T) {
+	user := mustNewUser(t, "John Doe", "john@example.com")
+
+	tests := []struct {
+		name    string
+		id      string
+		wantErr bool
+	}{
+		{
+			name: "existing user",
+			id:   user.ID,
+		},
+		{
+			name:    "unknown user",
+			id:      "01ARZ3NDEKTSV4RRFFQ69G5FAV",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := memory.NewStore()
+			store.Seed(user)
+			uc := usecase.NewFindUserUsecase(store)
+
+			got, err := uc.Execute(context.Background(), tt.id)
+
+			if tt.wantErr {
+				var notFound *domain.NotFoundError
+				if !errors.As(err, &notFound) {
+					t.Fatalf("Execute() error = %v, want NotFoundError", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Execute() unexpected error: %v", err)
+			}
+			if got.ID != user.ID || got.Email != user.Email {
+				t.Errorf("Execute() = %+v, want %+v", got, user)
+			}
+		})
+	}
+}
@@ -0,0 +1,31 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+)
+
+// FindWebhookSubscriptionUsecase Webhook購読取得ユースケース
+type FindWebhookSubscriptionUsecase struct {
+	webhookQuery WebhookQueryRepository
+}
+
+// NewFindWebhookSubscriptionUsecase FindWebhookSubscriptionUsecaseのコンストラクタ
+func NewFindWebhookSubscriptionUsecase(webhookQuery WebhookQueryRepository) *FindWebhookSubscriptionUsecase {
+	return &FindWebhookSubscriptionUsecase{
+		webhookQuery: webhookQuery,
+	}
+}
+
+// Execute Webhook購読を取得
+func (u *FindWebhookSubscriptionUsecase) Execute(ctx context.Context, id string) (*domain.WebhookSubscription, error) {
+	sub, err := u.webhookQuery.FindSubscriptionByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if sub == nil {
+		return nil, domain.ErrWebhookSubscriptionNotFound(id)
+	}
+	return sub, nil
+}
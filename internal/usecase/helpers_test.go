@@ -0,0 +1,175 @@
+package usecase_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/example/go-react-cqrs-template/internal/auth"
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure/memory"
+	"github.com/example/go-react-cqrs-template/internal/mail"
+	"github.com/pquerna/otp"
+	"github.com/pquerna/otp/totp"
+)
+
+// mustNewUser テスト用のユーザーを作成する
+func mustNewUser(t *testing.T, name, email string) *domain.User {
+	t.Helper()
+	user, err := domain.NewUser(name, email)
+	if err != nil {
+		t.Fatalf("Failed to create user: %v", err)
+	}
+	return user
+}
+
+// assertUserLogActions コミット済みのユーザーログのアクションを検証する
+func assertUserLogActions(t *testing.T, store *memory.Store, userID string, want ...domain.UserLogAction) {
+	t.Helper()
+	logs := store.UserLogs(userID)
+	if len(logs) != len(want) {
+		t.Fatalf("len(UserLogs) = %d, want %d", len(logs), len(want))
+	}
+	for i, action := range want {
+		if logs[i].Action != action {
+			t.Errorf("UserLogs[%d].Action = %s, want %s", i, logs[i].Action, action)
+		}
+	}
+}
+
+// assertUserEventTypes コミット済みのドメインイベント（outbox）の種別を検証する
+func assertUserEventTypes(t *testing.T, store *memory.Store, userID string, want ...domain.UserEventType) {
+	t.Helper()
+	events := store.UserEvents(userID)
+	if len(events) != len(want) {
+		t.Fatalf("len(UserEvents) = %d, want %d", len(events), len(want))
+	}
+	for i, eventType := range want {
+		if events[i].Type != eventType {
+			t.Errorf("UserEvents[%d].Type = %s, want %s", i, events[i].Type, eventType)
+		}
+	}
+}
+
+// mustNewWebhookSubscription テスト用のWebhook購読を作成する
+func mustNewWebhookSubscription(t *testing.T, url string, eventTypes ...domain.UserEventType) *domain.WebhookSubscription {
+	t.Helper()
+	sub, err := domain.NewWebhookSubscription(url, "0123456789abcdef", eventTypes, true)
+	if err != nil {
+		t.Fatalf("Failed to create webhook subscription: %v", err)
+	}
+	return sub
+}
+
+// mustNewGroup テスト用のグループを作成する
+func mustNewGroup(t *testing.T, name string) *domain.Group {
+	t.Helper()
+	group, err := domain.NewGroup(name)
+	if err != nil {
+		t.Fatalf("Failed to create group: %v", err)
+	}
+	return group
+}
+
+// mustNewGroupMember テスト用のメンバーシップを作成する
+func mustNewGroupMember(t *testing.T, group *domain.Group, user *domain.User, role domain.GroupRole) *domain.GroupMember {
+	t.Helper()
+	member, err := domain.NewGroupMember(group.ID, user.ID, role)
+	if err != nil {
+		t.Fatalf("Failed to create group member: %v", err)
+	}
+	return member
+}
+
+// newTestPasswordHasher テスト用に負荷を下げたパスワードハッシュ
+func newTestPasswordHasher() *auth.PasswordHasher {
+	return auth.NewPasswordHasher(auth.Argon2Params{Memory: 64, Iterations: 1, Parallelism: 1, SaltLength: 16, KeyLength: 32})
+}
+
+// mustNewCredential テスト用に password のハッシュを持つ認証情報を作成する
+func mustNewCredential(t *testing.T, hasher *auth.PasswordHasher, user *domain.User, password string) *domain.Credential {
+	t.Helper()
+	hash, err := hasher.Hash(password)
+	if err != nil {
+		t.Fatalf("Failed to hash password: %v", err)
+	}
+	return domain.NewCredential(user.ID, hash)
+}
+
+// testSessionClient テスト用のログインした端末の情報
+var testSessionClient = domain.SessionClient{Device: "Test laptop", IPAddress: "192.0.2.1", UserAgent: "go-test"}
+
+// newTestSession テスト用に時刻 now にログインしたユーザーのセッションを作成する
+func newTestSession(user *domain.User, now time.Time) *domain.Session {
+	return domain.NewSession(user.ID, testSessionClient, domain.DefaultSessionPolicy, now)
+}
+
+// assertRevokedSessions コミット済みのユーザーのセッションのうち取り消されたものの数を検証する
+func assertRevokedSessions(t *testing.T, store *memory.Store, userID string, want int) {
+	t.Helper()
+	got := 0
+	for _, s := range store.Sessions(userID) {
+		if s.IsRevoked() {
+			got++
+		}
+	}
+	if got != want {
+		t.Errorf("revoked sessions = %d, want %d", got, want)
+	}
+}
+
+// testTOTP テスト用の二要素認証の確認コードの照合
+var testTOTP = auth.NewTOTP("Example")
+
+// totpCode 秘密鍵 secret の時刻 at の確認コードを生成する（認証アプリの代わり）
+func totpCode(t *testing.T, secret string, at time.Time) string {
+	t.Helper()
+	code, err := totp.GenerateCodeCustom(secret, at, totp.ValidateOpts{Period: auth.TOTPPeriod, Digits: otp.DigitsSix, Algorithm: otp.AlgorithmSHA1})
+	if err != nil {
+		t.Fatalf("Failed to generate totp code: %v", err)
+	}
+	return code
+}
+
+// mustNewEnabledTOTPCredential テスト用に有効化済みの TOTP の二要素認証を作成し、リカバリーコードと共に返す
+//
+// 現在の時間ステップの確認コードは使用済みとしないよう、有効化は1分前に行ったものとする。
+func mustNewEnabledTOTPCredential(t *testing.T, user *domain.User) (*domain.TOTPCredential, []string) {
+	t.Helper()
+	secret, _, err := testTOTP.GenerateSecret(user.Email)
+	if err != nil {
+		t.Fatalf("Failed to generate totp secret: %v", err)
+	}
+	confirmedAt := time.Now().Add(-time.Minute)
+	cred := domain.NewTOTPCredential(user.ID, secret, confirmedAt)
+	codes, err := cred.Confirm(confirmedAt.Unix()/auth.TOTPPeriod, confirmedAt)
+	if err != nil {
+		t.Fatalf("Failed to confirm totp credential: %v", err)
+	}
+	return cred, codes
+}
+
+// recordingMailer 送信したメッセージを記録するテスト用の Mailer
+type recordingMailer struct {
+	mu       sync.Mutex
+	messages []mail.Message
+	err      error
+}
+
+func (m *recordingMailer) Send(_ context.Context, msg mail.Message) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.err != nil {
+		return m.err
+	}
+	m.messages = append(m.messages, msg)
+	return nil
+}
+
+// sent 送信したメッセージを返す
+func (m *recordingMailer) sent() []mail.Message {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]mail.Message(nil), m.messages...)
+}
@@ -0,0 +1,62 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure"
+	"github.com/example/go-react-cqrs-template/internal/mail"
+)
+
+// invitationMessage 招待のメール
+func invitationMessage(user *domain.User, link string, expiresAt time.Time) mail.Message {
+	return mail.Message{
+		To:      user.Email,
+		Subject: "アカウントへの招待",
+		Body: fmt.Sprintf(`%s さん
+
+アカウントに招待されました。以下のリンクを開いて、名前とパスワードを設定してください。
+
+%s
+
+このリンクは %s まで有効で、一度だけ使用できます。
+心当たりがない場合は、このメールを破棄してください。
+`, user.Name, link, expiresAt.Format(time.RFC3339)),
+	}
+}
+
+// defaultInviteeName 名前を指定せずに招待したユーザーの仮の名前（メールアドレスのローカル部）
+//
+// 招待されたユーザーは承諾時に名前を設定できる。
+func defaultInviteeName(email string) string {
+	if local, _, ok := strings.Cut(email, "@"); ok && local != "" {
+		return local
+	}
+	return email
+}
+
+// expireInvitation 有効期限を過ぎた承諾待ちの招待を期限切れにし、ユーザーログに記録する（トランザクション内で使用）
+//
+// 期限切れにした場合は true を返す。
+func expireInvitation(
+	ctx context.Context,
+	userCommand UserCommandRepository,
+	invitationCommand InvitationCommandRepository,
+	tx infrastructure.DBTX,
+	inv *domain.Invitation,
+	now time.Time,
+) (bool, error) {
+	if !inv.Expire(now) {
+		return false, nil
+	}
+	if err := invitationCommand.SaveInvitation(ctx, tx, inv); err != nil {
+		return false, err
+	}
+	if err := userCommand.SaveUserLog(ctx, tx, domain.NewUserLog(inv.UserID, domain.UserLogActionInvitationExpired)); err != nil {
+		return false, err
+	}
+	return true, nil
+}
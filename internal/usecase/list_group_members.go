@@ -0,0 +1,42 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+)
+
+// ListGroupMembersUsecase グループメンバー一覧取得ユースケース
+type ListGroupMembersUsecase struct {
+	groupQuery GroupQueryRepository
+}
+
+// NewListGroupMembersUsecase ListGroupMembersUsecaseのコンストラクタ
+func NewListGroupMembersUsecase(groupQuery GroupQueryRepository) *ListGroupMembersUsecase {
+	return &ListGroupMembersUsecase{
+		groupQuery: groupQuery,
+	}
+}
+
+// Execute グループのメンバーを参加した順に取得
+func (u *ListGroupMembersUsecase) Execute(ctx context.Context, groupID string, limit, offset int) ([]*domain.GroupMember, int, error) {
+	group, err := u.groupQuery.FindGroupByID(ctx, groupID)
+	if err != nil {
+		return nil, 0, err
+	}
+	if group == nil {
+		return nil, 0, domain.ErrGroupNotFound(groupID)
+	}
+
+	members, err := u.groupQuery.FindGroupMembers(ctx, groupID, limit, offset)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	total, err := u.groupQuery.CountGroupMembers(ctx, groupID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return members, total, nil
+}
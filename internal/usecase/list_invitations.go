@@ -0,0 +1,36 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+)
+
+// ListInvitationsUsecase 承諾待ちの招待一覧取得ユースケース
+type ListInvitationsUsecase struct {
+	invitationQuery InvitationQueryRepository
+}
+
+// NewListInvitationsUsecase ListInvitationsUsecaseのコンストラクタ
+func NewListInvitationsUsecase(invitationQuery InvitationQueryRepository) *ListInvitationsUsecase {
+	return &ListInvitationsUsecase{
+		invitationQuery: invitationQuery,
+	}
+}
+
+// Execute 承諾待ちで有効期限内の招待を新しい順に取得
+func (u *ListInvitationsUsecase) Execute(ctx context.Context, limit, offset int) ([]*domain.Invitation, int, error) {
+	now := time.Now()
+	invs, err := u.invitationQuery.FindPendingInvitations(ctx, now, limit, offset)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	total, err := u.invitationQuery.CountPendingInvitations(ctx, now)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return invs, total, nil
+}
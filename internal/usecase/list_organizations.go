@@ -0,0 +1,34 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+)
+
+// ListOrganizationsUsecase 組織一覧取得ユースケース
+type ListOrganizationsUsecase struct {
+	orgQuery OrganizationQueryRepository
+}
+
+// NewListOrganizationsUsecase ListOrganizationsUsecaseのコンストラクタ
+func NewListOrganizationsUsecase(orgQuery OrganizationQueryRepository) *ListOrganizationsUsecase {
+	return &ListOrganizationsUsecase{
+		orgQuery: orgQuery,
+	}
+}
+
+// Execute 組織一覧を取得
+func (u *ListOrganizationsUsecase) Execute(ctx context.Context, limit, offset int) ([]*domain.Organization, int, error) {
+	orgs, err := u.orgQuery.FindAllOrganizations(ctx, limit, offset)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	total, err := u.orgQuery.CountOrganizations(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return orgs, total, nil
+}
@@ -0,0 +1,40 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+)
+
+// ListSessionsUsecase ユーザーのセッション一覧取得ユースケース
+type ListSessionsUsecase struct {
+	userQuery    UserQueryRepository
+	sessionQuery SessionQueryRepository
+	policy       domain.SessionPolicy
+}
+
+// NewListSessionsUsecase ListSessionsUsecaseのコンストラクタ
+func NewListSessionsUsecase(
+	userQuery UserQueryRepository,
+	sessionQuery SessionQueryRepository,
+	policy domain.SessionPolicy,
+) *ListSessionsUsecase {
+	return &ListSessionsUsecase{
+		userQuery:    userQuery,
+		sessionQuery: sessionQuery,
+		policy:       policy,
+	}
+}
+
+// Execute ユーザーの有効なセッションを最近使用した順に取得（ユーザーが存在しない場合は ErrUserNotFound）
+func (u *ListSessionsUsecase) Execute(ctx context.Context, userID string) ([]*domain.Session, error) {
+	user, err := u.userQuery.FindByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, domain.ErrUserNotFound(userID)
+	}
+	return u.sessionQuery.FindActiveSessions(ctx, userID, time.Now(), u.policy)
+}
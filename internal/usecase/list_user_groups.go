@@ -0,0 +1,34 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+)
+
+// ListUserGroupsUsecase ユーザーの所属グループ一覧取得ユースケース
+type ListUserGroupsUsecase struct {
+	userQuery  UserQueryRepository
+	groupQuery GroupQueryRepository
+}
+
+// NewListUserGroupsUsecase ListUserGroupsUsecaseのコンストラクタ
+func NewListUserGroupsUsecase(userQuery UserQueryRepository, groupQuery GroupQueryRepository) *ListUserGroupsUsecase {
+	return &ListUserGroupsUsecase{
+		userQuery:  userQuery,
+		groupQuery: groupQuery,
+	}
+}
+
+// Execute ユーザーが所属するグループをロールと共にグループ名の順に取得
+func (u *ListUserGroupsUsecase) Execute(ctx context.Context, userID string) ([]*domain.GroupMembership, error) {
+	user, err := u.userQuery.FindByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, domain.ErrUserNotFound(userID)
+	}
+
+	return u.groupQuery.FindGroupsByUserID(ctx, userID)
+}
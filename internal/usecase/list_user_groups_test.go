@@ -0,0 +1,48 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure/memory"
+	"github.com/example/go-react-cqrs-template/internal/usecase"
+)
+
+func TestListUserGroupsUsecase_Execute(t *testing.T) {
+	alice := mustNewUser(t, "Alice", "alice@example.com")
+	sales := mustNewGroup(t, "Sales")
+	engineering := mustNewGroup(t, "Engineering")
+
+	ctx := context.Background()
+	store := memory.NewStore()
+	store.Seed(alice)
+	store.SeedGroups(sales, engineering)
+	store.SeedGroupMembers(
+		mustNewGroupMember(t, sales, alice, domain.GroupRoleMember),
+		mustNewGroupMember(t, engineering, alice, domain.GroupRoleOwner),
+	)
+	uc := usecase.NewListUserGroupsUsecase(store, store)
+
+	memberships, err := uc.Execute(ctx, alice.ID)
+	if err != nil {
+		t.Fatalf("Execute() unexpected error: %v", err)
+	}
+	if len(memberships) != 2 {
+		t.Fatalf("len(memberships) = %d, want 2", len(memberships))
+	}
+	// グループ名の順
+	if memberships[0].Group.ID != engineering.ID || memberships[0].Role != domain.GroupRoleOwner {
+		t.Errorf("memberships[0] = %+v, want Engineering as owner", memberships[0])
+	}
+	if memberships[1].Group.ID != sales.ID || memberships[1].Role != domain.GroupRoleMember {
+		t.Errorf("memberships[1] = %+v, want Sales as member", memberships[1])
+	}
+
+	_, err = uc.Execute(ctx, "01ARZ3NDEKTSV4RRFFQ69G5FAV")
+	var notFound *domain.NotFoundError
+	if !errors.As(err, &notFound) {
+		t.Errorf("Execute() error = %v, want NotFoundError", err)
+	}
+}
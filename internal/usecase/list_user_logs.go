@@ -0,0 +1,40 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+)
+
+// ListUserLogsUsecase ユーザーログ一覧取得ユースケース
+//
+// 一覧表示で N+1 クエリにならないよう、複数ユーザーのログをまとめて取得する。
+type ListUserLogsUsecase struct {
+	userLogQuery UserLogQueryRepository
+}
+
+// NewListUserLogsUsecase ListUserLogsUsecaseのコンストラクタ
+func NewListUserLogsUsecase(userLogQuery UserLogQueryRepository) *ListUserLogsUsecase {
+	return &ListUserLogsUsecase{
+		userLogQuery: userLogQuery,
+	}
+}
+
+// Execute ユーザーごとのログ（新しい順）と総数を取得
+func (u *ListUserLogsUsecase) Execute(ctx context.Context, userIDs []string, limit, offset int) (map[string][]*domain.UserLog, map[string]int, error) {
+	if len(userIDs) == 0 {
+		return map[string][]*domain.UserLog{}, map[string]int{}, nil
+	}
+
+	logs, err := u.userLogQuery.FindLogsByUserIDs(ctx, userIDs, limit, offset)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	totals, err := u.userLogQuery.CountLogsByUserIDs(ctx, userIDs)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return logs, totals, nil
+}
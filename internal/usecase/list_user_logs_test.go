@@ -0,0 +1,94 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure/memory"
+	"github.com/example/go-react-cqrs-template/internal/usecase"
+)
+
+func TestListUserLogsUsecase_Execute(t *testing.T) {
+	ctx := context.Background()
+	store := memory.NewStore()
+	create := usecase.NewCreateUserUsecase(store, store, store)
+	alice, err := create.Execute(ctx, "Alice", "alice@example.com")
+	if err != nil {
+		t.Fatalf("create alice: %v", err)
+	}
+	bob, err := create.Execute(ctx, "Bob", "bob@example.com")
+	if err != nil {
+		t.Fatalf("create bob: %v", err)
+	}
+	if err := usecase.NewDeleteUserUsecase(store, store, store).Execute(ctx, bob.ID); err != nil {
+		t.Fatalf("delete bob: %v", err)
+	}
+
+	uc := usecase.NewListUserLogsUsecase(store)
+
+	tests := []struct {
+		name        string
+		userIDs     []string
+		limit       int
+		offset      int
+		wantActions map[string][]domain.UserLogAction
+		wantTotals  map[string]int
+	}{
+		{
+			name:    "newest first per user",
+			userIDs: []string{alice.ID, bob.ID, "01ARZ3NDEKTSV4RRFFQ69G5FAV"},
+			limit:   10,
+			wantActions: map[string][]domain.UserLogAction{
+				alice.ID: {domain.UserLogActionCreated},
+				bob.ID:   {domain.UserLogActionDeleted, domain.UserLogActionCreated},
+			},
+			wantTotals: map[string]int{alice.ID: 1, bob.ID: 2},
+		},
+		{
+			name:    "limit and offset per user",
+			userIDs: []string{alice.ID, bob.ID},
+			limit:   1,
+			offset:  1,
+			wantActions: map[string][]domain.UserLogAction{
+				bob.ID: {domain.UserLogActionCreated},
+			},
+			wantTotals: map[string]int{alice.ID: 1, bob.ID: 2},
+		},
+		{
+			name:        "no users",
+			userIDs:     nil,
+			limit:       10,
+			wantActions: map[string][]domain.UserLogAction{},
+			wantTotals:  map[string]int{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			logs, totals, err := uc.Execute(ctx, tt.userIDs, tt.limit, tt.offset)
+			if err != nil {
+				t.Fatalf("Execute() unexpected error: %v", err)
+			}
+			if len(logs) != len(tt.wantActions) {
+				t.Errorf("len(logs) = %d, want %d", len(logs), len(tt.wantActions))
+			}
+			for userID, want := range tt.wantActions {
+				got := logs[userID]
+				if len(got) != len(want) {
+					t.Fatalf("len(logs[%s]) = %d, want %d", userID, len(got), len(want))
+				}
+				for i, action := range want {
+					if got[i].Action != action {
+						t.Errorf("logs[%s][%d].Action = %s, want %s", userID, i, got[i].Action, action)
+					}
+				}
+			}
+			for userID, want := range tt.wantTotals {
+				if totals[userID] != want {
+					t.Errorf("totals[%s] = %d, want %d", userID, totals[userID], want)
+				}
+			}
+		})
+	}
+}
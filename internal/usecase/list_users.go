@@ -18,14 +18,18 @@ func NewListUsersUsecase(userQuery UserQueryRepository) *ListUsersUsecase {
 	}
 }
 
-// Execute ユーザー一覧を取得
-func (u *ListUsersUsecase) Execute(ctx context.Context, limit, offset int) ([]*domain.User, int, error) {
-	users, err := u.userQuery.FindAll(ctx, limit, offset)
+// Execute ユーザー一覧を取得（status が空の場合は全ステータス）
+func (u *ListUsersUsecase) Execute(ctx context.Context, status domain.UserStatus, limit, offset int) ([]*domain.User, int, error) {
+	if status != "" && !status.Valid() {
+		return nil, 0, domain.ErrUserStatusInvalid(string(status))
+	}
+
+	users, err := u.userQuery.FindAll(ctx, status, limit, offset)
 	if err != nil {
 		return nil, 0, err
 	}
 
-	total, err := u.userQuery.Count(ctx)
+	total, err := u.userQuery.Count(ctx, status)
 	if err != nil {
 		return nil, 0, err
 	}
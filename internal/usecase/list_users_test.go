@@ -0,0 +1,125 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure/memory"
+	"github.com/example/go-react-cqrs-template/internal/usecase"
+)
+
+func TestListUsersUsecase_Execute(t *testing.T) {
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	users := make([]*domain.User, 3)
+	for i := range users {
+		users[i] = mustNewUser(t, "user", []string{"a@example.com", "b@example.com", "c@example.com"}[i])
+		users[i].CreatedAt = base.Add(time.Duration(i) * time.Hour)
+	}
+
+	tests := []struct {
+		name    string
+		limit   int
+		offset  int
+		wantIDs []string
+	}{
+		{
+			name:    "newest first",
+			limit:   10,
+			offset:  0,
+			wantIDs: []string{users[2].ID, users[1].ID, users[0].ID},
+		},
+		{
+			name:    "limit",
+			limit:   2,
+			offset:  0,
+			wantIDs: []string{users[2].ID, users[1].ID},
+		},
+		{
+			name:    "offset",
+			limit:   10,
+			offset:  2,
+			wantIDs: []string{users[0].ID},
+		},
+		{
+			name:    "offset beyond total",
+			limit:   10,
+			offset:  5,
+			wantIDs: []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := memory.NewStore()
+			store.Seed(users...)
+			uc := usecase.NewListUsersUsecase(store)
+
+			got, total, err := uc.Execute(context.Background(), "", tt.limit, tt.offset)
+			if err != nil {
+				t.Fatalf("Execute() unexpected error: %v", err)
+			}
+			if total != len(users) {
+				t.Errorf("total = %d, want %d", total, len(users))
+			}
+			if len(got) != len(tt.wantIDs) {
+				t.Fatalf("len(users) = %d, want %d", len(got), len(tt.wantIDs))
+			}
+			for i, id := range tt.wantIDs {
+				if got[i].ID != id {
+					t.Errorf("users[%d].ID = %s, want %s", i, got[i].ID, id)
+				}
+			}
+		})
+	}
+}
+
+func TestListUsersUsecase_Execute_StatusFilter(t *testing.T) {
+	active := mustNewUser(t, "John Doe", "john@example.com")
+	suspended := mustNewUser(t, "Jane Doe", "jane@example.com")
+	suspended.Status = domain.UserStatusSuspended
+	suspended.CreatedAt = active.CreatedAt.Add(time.Hour)
+
+	tests := []struct {
+		name    string
+		status  domain.UserStatus
+		wantIDs []string
+		wantErr bool
+	}{
+		{name: "all statuses", status: "", wantIDs: []string{suspended.ID, active.ID}},
+		{name: "suspended only", status: domain.UserStatusSuspended, wantIDs: []string{suspended.ID}},
+		{name: "no matches", status: domain.UserStatusInvited, wantIDs: []string{}},
+		{name: "unknown status", status: "archived", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := memory.NewStore()
+			store.Seed(active, suspended)
+			uc := usecase.NewListUsersUsecase(store)
+
+			got, total, err := uc.Execute(context.Background(), tt.status, 10, 0)
+
+			if tt.wantErr {
+				var validationErr *domain.ValidationError
+				if !errors.As(err, &validationErr) {
+					t.Fatalf("Execute() error = %v, want ValidationError", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Execute() unexpected error: %v", err)
+			}
+			if total != len(tt.wantIDs) || len(got) != len(tt.wantIDs) {
+				t.Fatalf("Execute() = %d users (total %d), want %d", len(got), total, len(tt.wantIDs))
+			}
+			for i, id := range tt.wantIDs {
+				if got[i].ID != id {
+					t.Errorf("users[%d].ID = %s, want %s", i, got[i].ID, id)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,42 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+)
+
+// ListWebhookDeliveriesUsecase Webhook配信ログ取得ユースケース
+type ListWebhookDeliveriesUsecase struct {
+	webhookQuery WebhookQueryRepository
+}
+
+// NewListWebhookDeliveriesUsecase ListWebhookDeliveriesUsecaseのコンストラクタ
+func NewListWebhookDeliveriesUsecase(webhookQuery WebhookQueryRepository) *ListWebhookDeliveriesUsecase {
+	return &ListWebhookDeliveriesUsecase{
+		webhookQuery: webhookQuery,
+	}
+}
+
+// Execute 購読の配信ログを新しい順に取得（status が空の場合は全状態）
+func (u *ListWebhookDeliveriesUsecase) Execute(ctx context.Context, subscriptionID string, status domain.WebhookDeliveryStatus, limit, offset int) ([]*domain.WebhookDelivery, int, error) {
+	sub, err := u.webhookQuery.FindSubscriptionByID(ctx, subscriptionID)
+	if err != nil {
+		return nil, 0, err
+	}
+	if sub == nil {
+		return nil, 0, domain.ErrWebhookSubscriptionNotFound(subscriptionID)
+	}
+
+	deliveries, err := u.webhookQuery.FindDeliveries(ctx, subscriptionID, status, limit, offset)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	total, err := u.webhookQuery.CountDeliveries(ctx, subscriptionID, status)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return deliveries, total, nil
+}
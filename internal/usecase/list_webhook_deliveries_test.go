@@ -0,0 +1,66 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure/memory"
+	"github.com/example/go-react-cqrs-template/internal/usecase"
+)
+
+func TestListWebhookDeliveriesUsecase_Execute(t *testing.T) {
+	sub := mustNewWebhookSubscription(t, "https://example.com/hooks", domain.UserEventTypeCreated)
+	other := mustNewWebhookSubscription(t, "https://example.com/other", domain.UserEventTypeCreated)
+
+	base := time.Now()
+	older := domain.NewWebhookDelivery(sub.ID, "01ARZ3NDEKTSV4RRFFQ69G5FAV", domain.UserEventTypeCreated, []byte(`{}`), base)
+	older.Fail(500, "unexpected status 500", base, base, 1)
+	newer := domain.NewWebhookDelivery(sub.ID, "01ARZ3NDEKTSV4RRFFQ69G5FAW", domain.UserEventTypeCreated, []byte(`{}`), base.Add(time.Second))
+	foreign := domain.NewWebhookDelivery(other.ID, "01ARZ3NDEKTSV4RRFFQ69G5FAX", domain.UserEventTypeCreated, []byte(`{}`), base)
+
+	tests := []struct {
+		name      string
+		id        string
+		status    domain.WebhookDeliveryStatus
+		wantIDs   []string
+		wantTotal int
+		wantErr   bool
+	}{
+		{name: "all deliveries newest first", id: sub.ID, wantIDs: []string{newer.ID, older.ID}, wantTotal: 2},
+		{name: "dead letters only", id: sub.ID, status: domain.WebhookDeliveryStatusDead, wantIDs: []string{older.ID}, wantTotal: 1},
+		{name: "unknown subscription", id: "01ARZ3NDEKTSV4RRFFQ69G5FAV", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := memory.NewStore()
+			store.SeedWebhookSubscriptions(sub, other)
+			store.SeedWebhookDeliveries(older, newer, foreign)
+			uc := usecase.NewListWebhookDeliveriesUsecase(store)
+
+			got, total, err := uc.Execute(context.Background(), tt.id, tt.status, 10, 0)
+
+			if tt.wantErr {
+				var notFound *domain.NotFoundError
+				if !errors.As(err, &notFound) {
+					t.Fatalf("Execute() error = %v, want NotFoundError", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Execute() unexpected error: %v", err)
+			}
+			if total != tt.wantTotal || len(got) != len(tt.wantIDs) {
+				t.Fatalf("Execute() = %d deliveries (total %d), want %d (total %d)", len(got), total, len(tt.wantIDs), tt.wantTotal)
+			}
+			for i, id := range tt.wantIDs {
+				if got[i].ID != id {
+					t.Errorf("deliveries[%d].ID = %s, want %s", i, got[i].ID, id)
+				}
+			}
+		})
+	}
+}
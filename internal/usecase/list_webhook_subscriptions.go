@@ -0,0 +1,34 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+)
+
+// ListWebhookSubscriptionsUsecase Webhook購読一覧取得ユースケース
+type ListWebhookSubscriptionsUsecase struct {
+	webhookQuery WebhookQueryRepository
+}
+
+// NewListWebhookSubscriptionsUsecase ListWebhookSubscriptionsUsecaseのコンストラクタ
+func NewListWebhookSubscriptionsUsecase(webhookQuery WebhookQueryRepository) *ListWebhookSubscriptionsUsecase {
+	return &ListWebhookSubscriptionsUsecase{
+		webhookQuery: webhookQuery,
+	}
+}
+
+// Execute Webhook購読一覧を取得
+func (u *ListWebhookSubscriptionsUsecase) Execute(ctx context.Context, limit, offset int) ([]*domain.WebhookSubscription, int, error) {
+	subs, err := u.webhookQuery.FindAllSubscriptions(ctx, limit, offset)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	total, err := u.webhookQuery.CountSubscriptions(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return subs, total, nil
+}
@@ -0,0 +1,196 @@
+package usecase
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure"
+	"github.com/example/go-react-cqrs-template/internal/tenant"
+)
+
+// dummyPassword ユーザーが存在しない場合に照合するダミーのハッシュの元になるパスワード
+const dummyPassword = "dummy-password-for-timing"
+
+// LoginResult ログインの結果
+//
+// 二要素認証が有効なユーザーの場合はセッションを作成せず、MFAToken と MFAExpiresAt のみを設定する
+// （確認コードを VerifyMFALoginUsecase で照合するとセッションを作成する）。
+type LoginResult struct {
+	User    *domain.User
+	Session *domain.Session
+	Token   string
+	// ExpiresAt はトークンの有効期限（セッションのログインからの有効期限）
+	ExpiresAt time.Time
+	// MFAToken はログインの2段階目のトークン（二要素認証が有効な場合のみ）
+	MFAToken string
+	// MFAExpiresAt は MFAToken の有効期限
+	MFAExpiresAt time.Time
+}
+
+// LoginUsecase メールアドレスとパスワードによるログインユースケース
+type LoginUsecase struct {
+	userCommand       UserCommandRepository
+	credentialCommand CredentialCommandRepository
+	totpCommand       TOTPCredentialCommandRepository
+	tokenCommand      UserTokenCommandRepository
+	sessionCommand    SessionCommandRepository
+	groupQuery        GroupQueryRepository
+	hasher            PasswordHasher
+	sessions          SessionIssuer
+	txManager         TransactionManager
+	lockout           domain.LockoutPolicy
+	sessionPolicy     domain.SessionPolicy
+	mfaPolicy         domain.MFAPolicy
+
+	dummyOnce sync.Once
+	dummyHash string
+}
+
+// NewLoginUsecase LoginUsecaseのコンストラクタ
+func NewLoginUsecase(
+	userCommand UserCommandRepository,
+	credentialCommand CredentialCommandRepository,
+	totpCommand TOTPCredentialCommandRepository,
+	tokenCommand UserTokenCommandRepository,
+	sessionCommand SessionCommandRepository,
+	groupQuery GroupQueryRepository,
+	hasher PasswordHasher,
+	sessions SessionIssuer,
+	txManager TransactionManager,
+	lockout domain.LockoutPolicy,
+	sessionPolicy domain.SessionPolicy,
+	mfaPolicy domain.MFAPolicy,
+) *LoginUsecase {
+	return &LoginUsecase{
+		userCommand:       userCommand,
+		credentialCommand: credentialCommand,
+		totpCommand:       totpCommand,
+		tokenCommand:      tokenCommand,
+		sessionCommand:    sessionCommand,
+		groupQuery:        groupQuery,
+		hasher:            hasher,
+		sessions:          sessions,
+		txManager:         txManager,
+		lockout:           lockout,
+		sessionPolicy:     sessionPolicy,
+		mfaPolicy:         mfaPolicy,
+	}
+}
+
+// Execute パスワードを照合し、成功した場合は client のセッションを作成してトークンを発行する
+//
+// ユーザーまたは認証情報が存在しない場合とパスワードが一致しない場合は、区別せずに
+// ErrInvalidCredentials を返す。失敗は認証情報の失敗回数とユーザーログに記録し、連続した失敗が
+// ロックの方針の上限に達するとアカウントをロックする。ロック中はパスワードを照合するが結果は使わず、
+// 一致しても一致しなくても ErrInvalidCredentials を返す（失敗も記録しない）。
+// パスワードが一致しても利用中でないユーザーはログインできない。
+//
+// TOTP の二要素認証が有効なユーザーはセッションを作成せず、ログインの2段階目のトークンを返す
+// （失敗回数も確認コードの照合に成功するまでリセットしない）。二要素認証の方針で必須のユーザー
+// （管理者）が有効にしていない場合は ErrMFAEnrollmentRequired を返す。
+func (u *LoginUsecase) Execute(ctx context.Context, email, password string, client domain.SessionClient) (*LoginResult, error) {
+	var (
+		user      *domain.User
+		session   *domain.Session
+		challenge *domain.UserToken
+		mfaToken  string
+		authErr   error
+	)
+	err := u.txManager.RunInTransaction(ctx, func(ctx context.Context, tx infrastructure.DBTX) error {
+		found, err := u.userCommand.FindByEmailForUpdate(ctx, tx, email)
+		if err != nil {
+			return err
+		}
+		var cred *domain.Credential
+		if found != nil {
+			cred, err = u.credentialCommand.FindCredentialForUpdate(ctx, tx, found.ID)
+			if err != nil {
+				return err
+			}
+		}
+		if cred == nil {
+			// ユーザーの有無を応答時間から推測されないよう、存在しない場合もハッシュを照合する
+			u.verifyDummy(password)
+			return domain.ErrInvalidCredentials()
+		}
+
+		now := time.Now()
+		ok, err := u.hasher.Verify(cred.PasswordHash, password)
+		if err != nil {
+			return err
+		}
+		if cred.IsLocked(now) {
+			// ロック中はパスワードによらず同じ応答を返す（照合の結果もロックの有無も応答から推測させない）
+			return domain.ErrInvalidCredentials()
+		}
+		if !ok {
+			// ログインは拒否するが、失敗の記録はコミットする
+			authErr = domain.ErrInvalidCredentials()
+			return recordPasswordFailure(ctx, u.userCommand, u.credentialCommand, tx, cred, u.lockout, now)
+		}
+		if found.Status != domain.UserStatusActive {
+			return domain.ErrUserNotActive(found.ID, found.Status)
+		}
+
+		totpCred, err := u.totpCommand.FindTOTPCredentialForUpdate(ctx, tx, found.ID)
+		if err != nil {
+			return err
+		}
+		if totpCred != nil && totpCred.IsEnabled() {
+			challenge, mfaToken, err = issueUserToken(ctx, u.tokenCommand, tx, found, domain.UserTokenPurposeMFAChallenge, u.mfaPolicy.ChallengeTTL)
+			if err != nil {
+				return err
+			}
+			user = found
+			return nil
+		}
+		required, err := mfaRequired(ctx, u.groupQuery, u.mfaPolicy, found.ID)
+		if err != nil {
+			return err
+		}
+		if required {
+			return domain.ErrMFAEnrollmentRequired(found.ID)
+		}
+
+		cred.RecordSuccess(now)
+		if err := u.credentialCommand.SaveCredential(ctx, tx, cred); err != nil {
+			return err
+		}
+		if err := u.userCommand.SaveUserLog(ctx, tx, domain.NewUserLog(found.ID, domain.UserLogActionLoginSucceeded)); err != nil {
+			return err
+		}
+		created := domain.NewSession(found.ID, client, u.sessionPolicy, now)
+		if err := u.sessionCommand.SaveSession(ctx, tx, created); err != nil {
+			return err
+		}
+		user, session = found, created
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if authErr != nil {
+		return nil, authErr
+	}
+	if challenge != nil {
+		return &LoginResult{User: user, MFAToken: mfaToken, MFAExpiresAt: challenge.ExpiresAt}, nil
+	}
+
+	token, err := u.sessions.Issue(user.ID, tenant.OrgID(ctx), session.ID, session.CreatedAt, session.ExpiresAt)
+	if err != nil {
+		return nil, err
+	}
+	return &LoginResult{User: user, Session: session, Token: token, ExpiresAt: session.ExpiresAt}, nil
+}
+
+// verifyDummy ダミーのハッシュとパスワードを照合する（結果は使わない）
+func (u *LoginUsecase) verifyDummy(password string) {
+	u.dummyOnce.Do(func() {
+		u.dummyHash, _ = u.hasher.Hash(dummyPassword)
+	})
+	if u.dummyHash != "" {
+		_, _ = u.hasher.Verify(u.dummyHash, password)
+	}
+}
@@ -0,0 +1,216 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/example/go-react-cqrs-template/internal/auth"
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure/memory"
+	"github.com/example/go-react-cqrs-template/internal/usecase"
+)
+
+func TestLoginUsecase_Execute(t *testing.T) {
+	hasher := newTestPasswordHasher()
+	active := mustNewUser(t, "John Doe", "john@example.com")
+	suspended := mustNewUser(t, "Jane Doe", "jane@example.com")
+	suspended.Status = domain.UserStatusSuspended
+	noPassword := mustNewUser(t, "Bob Smith", "bob@example.com")
+
+	tests := []struct {
+		name      string
+		email     string
+		password  string
+		userID    string
+		wantErr   any
+		wantLogs  []domain.UserLogAction
+		wantFails int
+	}{
+		{
+			name:     "success",
+			email:    "john@example.com",
+			password: "correct horse battery",
+			userID:   active.ID,
+			wantLogs: []domain.UserLogAction{domain.UserLogActionLoginSucceeded},
+		},
+		{
+			name:      "wrong password is recorded",
+			email:     "john@example.com",
+			password:  "wrong password!",
+			userID:    active.ID,
+			wantErr:   new(*domain.AuthenticationError),
+			wantLogs:  []domain.UserLogAction{domain.UserLogActionLoginFailed},
+			wantFails: 1,
+		},
+		{
+			name:     "suspended user is rejected",
+			email:    "jane@example.com",
+			password: "correct horse battery",
+			userID:   suspended.ID,
+			wantErr:  new(*domain.AuthenticationError),
+		},
+		{
+			name:     "user without password",
+			email:    "bob@example.com",
+			password: "correct horse battery",
+			userID:   noPassword.ID,
+			wantErr:  new(*domain.AuthenticationError),
+		},
+		{
+			name:     "unknown user",
+			email:    "nobody@example.com",
+			password: "correct horse battery",
+			wantErr:  new(*domain.AuthenticationError),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			store := memory.NewStore()
+			store.Seed(active, suspended, noPassword)
+			store.SeedCredentials(
+				mustNewCredential(t, hasher, active, "correct horse battery"),
+				mustNewCredential(t, hasher, suspended, "correct horse battery"),
+			)
+			sessions := mustNewTokenIssuer(t)
+			uc := usecase.NewLoginUsecase(store, store, store, store, store, store, hasher, sessions, store, domain.DefaultLockoutPolicy, domain.DefaultSessionPolicy, domain.DefaultMFAPolicy)
+
+			got, err := uc.Execute(ctx, tt.email, tt.password, testSessionClient)
+
+			if tt.wantErr != nil {
+				if err == nil || !errors.As(err, tt.wantErr) {
+					t.Fatalf("Execute() error = %v, want %T", err, tt.wantErr)
+				}
+			} else {
+				if err != nil {
+					t.Fatalf("Execute() unexpected error: %v", err)
+				}
+				if got.User.ID != tt.userID {
+					t.Errorf("Execute().User.ID = %s, want %s", got.User.ID, tt.userID)
+				}
+				claims, err := sessions.Parse(got.Token, time.Now())
+				if err != nil || claims.UserID != tt.userID || claims.SessionID != got.Session.ID {
+					t.Errorf("Parse(Execute().Token) = %+v, %v, want token for session %s", claims, err, got.Session.ID)
+				}
+				if claims != nil && claims.ExpiresAt != got.Session.ExpiresAt.Unix() {
+					t.Errorf("token expires at %d, want session expiry %v", claims.ExpiresAt, got.Session.ExpiresAt)
+				}
+			}
+			// 成功した場合のみセッションを作成する
+			if want := len(store.Sessions(tt.userID)); tt.wantErr == nil && want != 1 || tt.wantErr != nil && want != 0 {
+				t.Errorf("Sessions() = %d, want one session only on success", want)
+			}
+
+			if tt.userID == "" {
+				return
+			}
+			assertUserLogActions(t, store, tt.userID, tt.wantLogs...)
+			if cred := store.Credential(tt.userID); cred != nil && cred.FailedAttempts != tt.wantFails {
+				t.Errorf("FailedAttempts = %d, want %d", cred.FailedAttempts, tt.wantFails)
+			}
+		})
+	}
+}
+
+func TestLoginUsecase_Lockout(t *testing.T) {
+	ctx := context.Background()
+	hasher := newTestPasswordHasher()
+	user := mustNewUser(t, "John Doe", "john@example.com")
+	store := memory.NewStore()
+	store.Seed(user)
+	store.SeedCredentials(mustNewCredential(t, hasher, user, "correct horse battery"))
+	policy := domain.LockoutPolicy{MaxAttempts: 2, Duration: time.Hour}
+	uc := usecase.NewLoginUsecase(store, store, store, store, store, store, hasher, mustNewTokenIssuer(t), store, policy, domain.DefaultSessionPolicy, domain.DefaultMFAPolicy)
+
+	for i := 0; i < policy.MaxAttempts; i++ {
+		if _, err := uc.Execute(ctx, "john@example.com", "wrong password!", testSessionClient); err == nil {
+			t.Fatalf("Execute() #%d succeeded with a wrong password", i+1)
+		}
+	}
+
+	// ロック中はパスワードが一致してもしなくても同じ応答を返し、失敗も記録しない
+	_, wrongErr := uc.Execute(ctx, "john@example.com", "wrong password!", testSessionClient)
+	_, correctErr := uc.Execute(ctx, "john@example.com", "correct horse battery", testSessionClient)
+	var wrong, correct *domain.AuthenticationError
+	if !errors.As(wrongErr, &wrong) || !errors.As(correctErr, &correct) {
+		t.Fatalf("Execute() while locked errors = %v, %v, want AuthenticationError", wrongErr, correctErr)
+	}
+	if *wrong != *correct || correct.UserMessage != domain.ErrInvalidCredentials().UserMessage {
+		t.Errorf("Execute() while locked errors = %+v, %+v, want the same ErrInvalidCredentials", wrong, correct)
+	}
+	if sessions := store.Sessions(user.ID); len(sessions) != 0 {
+		t.Errorf("sessions = %d, want 0 while locked", len(sessions))
+	}
+	assertUserLogActions(t, store, user.ID,
+		domain.UserLogActionLoginFailed,
+		domain.UserLogActionLoginFailed,
+		domain.UserLogActionLockedOut,
+	)
+}
+
+// mustNewTokenIssuer テスト用のセッショントークン発行者を作成する
+func mustNewTokenIssuer(t *testing.T) *auth.TokenIssuer {
+	t.Helper()
+	issuer, err := auth.NewTokenIssuer([]byte("0123456789abcdef0123456789abcdef"))
+	if err != nil {
+		t.Fatalf("Failed to create token issuer: %v", err)
+	}
+	return issuer
+}
+
+func TestLoginUsecase_MFA(t *testing.T) {
+	ctx := context.Background()
+	hasher := newTestPasswordHasher()
+	enrolled := mustNewUser(t, "John Doe", "john@example.com")
+	admin := mustNewUser(t, "Jane Doe", "jane@example.com")
+	admins := mustNewGroup(t, "admins")
+	totpCred, _ := mustNewEnabledTOTPCredential(t, enrolled)
+
+	store := memory.NewStore()
+	store.Seed(enrolled, admin)
+	store.SeedGroups(admins)
+	store.SeedGroupMembers(mustNewGroupMember(t, admins, admin, domain.GroupRoleMember))
+	failed := mustNewCredential(t, hasher, enrolled, "correct horse battery")
+	failed.FailedAttempts = 1
+	store.SeedCredentials(failed, mustNewCredential(t, hasher, admin, "correct horse battery"))
+	store.SeedTOTPCredentials(totpCred)
+	policy := domain.DefaultMFAPolicy
+	policy.RequireForAdmins = true
+	uc := usecase.NewLoginUsecase(store, store, store, store, store, store, hasher, mustNewTokenIssuer(t), store, domain.DefaultLockoutPolicy, domain.DefaultSessionPolicy, policy)
+
+	// 二要素認証が有効な場合はセッションを作成せず、2段階目のトークンを返す
+	got, err := uc.Execute(ctx, "john@example.com", "correct horse battery", testSessionClient)
+	if err != nil {
+		t.Fatalf("Execute() unexpected error: %v", err)
+	}
+	if got.MFAToken == "" || got.Token != "" || got.Session != nil {
+		t.Errorf("Execute() = %+v, want mfa challenge only", got)
+	}
+	if want := time.Now().Add(policy.ChallengeTTL); got.MFAExpiresAt.After(want) || got.MFAExpiresAt.Before(want.Add(-time.Minute)) {
+		t.Errorf("MFAExpiresAt = %v, want about %v", got.MFAExpiresAt, want)
+	}
+	if tokens := store.UserTokens(enrolled.ID, domain.UserTokenPurposeMFAChallenge); len(tokens) != 1 || tokens[0].TokenHash != domain.HashUserToken(got.MFAToken) {
+		t.Errorf("mfa challenge tokens = %+v, want the issued token", tokens)
+	}
+	if len(store.Sessions(enrolled.ID)) != 0 {
+		t.Error("session created before the second step")
+	}
+	// 失敗回数は2段階目に成功するまでリセットしない
+	if cred := store.Credential(enrolled.ID); cred.FailedAttempts != 1 {
+		t.Errorf("FailedAttempts = %d, want 1", cred.FailedAttempts)
+	}
+	assertUserLogActions(t, store, enrolled.ID)
+
+	// 二要素認証が必須の管理者は登録するまでログインできない
+	_, err = uc.Execute(ctx, "jane@example.com", "correct horse battery", testSessionClient)
+	var authErr *domain.AuthenticationError
+	if !errors.As(err, &authErr) {
+		t.Fatalf("Execute() for admin without mfa error = %v, want AuthenticationError", err)
+	}
+	if len(store.Sessions(admin.ID)) != 0 {
+		t.Error("session created for admin without mfa")
+	}
+}
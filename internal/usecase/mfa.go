@@ -0,0 +1,44 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+)
+
+// TOTPEnrollment TOTP の二要素認証の登録の開始の結果
+type TOTPEnrollment struct {
+	// Secret は認証アプリに手入力するための秘密鍵（base32）
+	Secret string
+	// URI は認証アプリに登録する otpauth URI
+	URI string
+	// QRCode は URI を描画した QR コードの PNG 画像
+	QRCode []byte
+}
+
+// mfaRequired 二要素認証の方針でユーザーに二要素認証が必須かどうか
+func mfaRequired(ctx context.Context, groupQuery GroupQueryRepository, policy domain.MFAPolicy, userID string) (bool, error) {
+	if !policy.RequireForAdmins {
+		return false, nil
+	}
+	memberships, err := groupQuery.FindGroupsByUserID(ctx, userID)
+	if err != nil {
+		return false, err
+	}
+	return policy.IsRequired(memberships), nil
+}
+
+// useMFACode 確認コードまたはリカバリーコード code を照合し、使用済みにする
+//
+// 認証アプリの確認コードを先に照合し、一致しない場合はリカバリーコードとして照合する。
+// 一致したのがリカバリーコードの場合は recovery=true を返す。使用済みの確認コードは一致しない。
+func useMFACode(totp TOTPAuthenticator, cred *domain.TOTPCredential, code string, now time.Time) (recovery, ok bool) {
+	if step, ok := totp.Verify(cred.Secret, code, now); ok {
+		return false, cred.UseStep(step, now)
+	}
+	if cred.UseRecoveryCode(code, now) {
+		return true, true
+	}
+	return false, false
+}
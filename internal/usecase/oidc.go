@@ -0,0 +1,48 @@
+package usecase
+
+import (
+	"strings"
+	"time"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+)
+
+// OIDCConfig OpenID Connect のログインの設定
+type OIDCConfig struct {
+	// StateTTL は認可リクエストから IdP のコールバックまでの有効期間
+	StateTTL time.Duration
+	// AutoProvision は紐付くユーザーがいない場合に IdP で確認済みのメールアドレスでユーザーを作成するかどうか
+	AutoProvision bool
+}
+
+// DefaultOIDCConfig 既定の OpenID Connect のログインの設定（認可リクエストは10分間有効、ユーザーは自動作成しない）
+var DefaultOIDCConfig = OIDCConfig{StateTTL: 10 * time.Minute}
+
+// OIDCAuthorization OpenID Connect のログインの開始の結果
+type OIDCAuthorization struct {
+	// URL はブラウザをリダイレクトする IdP の認可エンドポイントの URL
+	URL string
+	// ExpiresAt は IdP からのコールバックを受け付ける期限
+	ExpiresAt time.Time
+	// StateHash は state の SHA-256（16進数）。ログインを開始したブラウザに Cookie で保存し、
+	// コールバックで CompleteOIDCLoginUsecase に渡す
+	StateHash string
+}
+
+// findOIDCProvider 名前 name の IdP を返す（設定されていない場合は ErrOIDCProviderNotFound）
+func findOIDCProvider(providers map[string]OIDCProvider, name string) (OIDCProvider, error) {
+	provider, ok := providers[name]
+	if !ok {
+		return nil, domain.ErrOIDCProviderNotFound(name)
+	}
+	return provider, nil
+}
+
+// externalUserName IdP のクレームから自動作成するユーザーの名前を決める（name がない場合はメールアドレスのローカル部）
+func externalUserName(claims *domain.ExternalIdentityClaims) string {
+	if name := strings.TrimSpace(claims.Name); name != "" {
+		return name
+	}
+	local, _, _ := strings.Cut(claims.Email, "@")
+	return local
+}
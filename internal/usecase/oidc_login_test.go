@@ -0,0 +1,238 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/example/go-react-cqrs-template/internal/auth"
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure/memory"
+	"github.com/example/go-react-cqrs-template/internal/testutil/oidctest"
+	"github.com/example/go-react-cqrs-template/internal/usecase"
+)
+
+// oidcTestEnv モック IdP に接続した OpenID Connect のログインのユースケース
+type oidcTestEnv struct {
+	idp      *oidctest.IdP
+	start    *usecase.StartOIDCLoginUsecase
+	complete *usecase.CompleteOIDCLoginUsecase
+}
+
+// newOIDCTestEnv モック IdP を起動し、IdP "company" でログインするユースケースを作成する
+func newOIDCTestEnv(t *testing.T, store *memory.Store, config usecase.OIDCConfig) *oidcTestEnv {
+	t.Helper()
+	idp := oidctest.New(t, "example-client", "example-secret")
+	providers := map[string]usecase.OIDCProvider{
+		"company": auth.NewOIDCProvider(auth.OIDCConfig{
+			Issuer:       idp.Issuer(),
+			ClientID:     idp.ClientID,
+			ClientSecret: idp.ClientSecret,
+			RedirectURL:  "http://localhost:5173/login/callback",
+		}),
+	}
+	createUser := usecase.NewCreateUserUsecase(store, store, store)
+	return &oidcTestEnv{
+		idp:   idp,
+		start: usecase.NewStartOIDCLoginUsecase(store, providers, store, config),
+		complete: usecase.NewCompleteOIDCLoginUsecase(store, store, store, store, store, store, store, createUser, providers,
+			mustNewTokenIssuer(t), store, config, domain.DefaultSessionPolicy, domain.DefaultMFAPolicy),
+	}
+}
+
+// authorize ログインを開始し、IdP で user としてログインして受け取った認可コードと state を返す
+func (e *oidcTestEnv) authorize(t *testing.T, user oidctest.User) (code, state string) {
+	t.Helper()
+	e.idp.SetUser(user)
+	authz, err := e.start.Execute(context.Background(), "company")
+	if err != nil {
+		t.Fatalf("start unexpected error: %v", err)
+	}
+	return e.idp.Authorize(t, authz.URL)
+}
+
+// login IdP で user としてログインし、コールバックでログインを完了する
+func (e *oidcTestEnv) login(t *testing.T, user oidctest.User) (*usecase.LoginResult, error) {
+	t.Helper()
+	code, state := e.authorize(t, user)
+	return e.complete.Execute(context.Background(), "company", code, state, domain.HashUserToken(state), testSessionClient)
+}
+
+func TestOIDCLogin_LinkByVerifiedEmail(t *testing.T) {
+	user := mustNewUser(t, "John Doe", "john@example.com")
+	store := memory.NewStore()
+	store.Seed(user)
+	env := newOIDCTestEnv(t, store, usecase.DefaultOIDCConfig)
+	employee := oidctest.User{Subject: "emp-001", Email: "john@example.com", EmailVerified: true, Name: "John Doe"}
+
+	got, err := env.login(t, employee)
+	if err != nil {
+		t.Fatalf("Execute() unexpected error: %v", err)
+	}
+	if got.User.ID != user.ID || got.Session == nil || got.Token == "" {
+		t.Fatalf("Execute() = %+v, want session of %s", got, user.ID)
+	}
+	identities := store.ExternalIdentities(user.ID)
+	if len(identities) != 1 || identities[0].Provider != "company" || identities[0].Subject != "emp-001" {
+		t.Fatalf("ExternalIdentities = %+v, want company/emp-001", identities)
+	}
+	if found, _ := store.FindByID(context.Background(), user.ID); !found.IsEmailVerified() {
+		t.Error("email is not verified, want verified by the IdP")
+	}
+
+	// 紐付け後は IdP のメールアドレスが変わっても同じユーザーとしてログインする
+	employee.Email, employee.EmailVerified = "j.doe@example.com", false
+	got, err = env.login(t, employee)
+	if err != nil {
+		t.Fatalf("Execute() after email change unexpected error: %v", err)
+	}
+	if got.User.ID != user.ID {
+		t.Errorf("User.ID = %s, want %s", got.User.ID, user.ID)
+	}
+	if identities := store.ExternalIdentities(user.ID); len(identities) != 1 || identities[0].LastLoginAt.IsZero() {
+		t.Errorf("ExternalIdentities = %+v, want one identity with last login recorded", identities)
+	}
+	assertUserLogActions(t, store, user.ID,
+		domain.UserLogActionExternalIdentityLinked,
+		domain.UserLogActionEmailVerified,
+		domain.UserLogActionLoginSucceeded,
+		domain.UserLogActionLoginSucceeded,
+	)
+}
+
+func TestOIDCLogin_NotLinked(t *testing.T) {
+	user := mustNewUser(t, "John Doe", "john@example.com")
+	store := memory.NewStore()
+	store.Seed(user)
+	env := newOIDCTestEnv(t, store, usecase.OIDCConfig{StateTTL: usecase.DefaultOIDCConfig.StateTTL, AutoProvision: true})
+
+	// IdP で確認されていないメールアドレスでは紐付けも作成もしない
+	_, err := env.login(t, oidctest.User{Subject: "emp-001", Email: "john@example.com"})
+	if !errors.As(err, new(*domain.AuthenticationError)) {
+		t.Fatalf("Execute() with unverified email error = %v, want AuthenticationError", err)
+	}
+	if identities := store.ExternalIdentities(user.ID); len(identities) != 0 {
+		t.Errorf("ExternalIdentities = %+v, want none", identities)
+	}
+
+	// 自動作成しない設定では、確認済みでも該当するユーザーがいなければログインできない
+	env = newOIDCTestEnv(t, store, usecase.DefaultOIDCConfig)
+	_, err = env.login(t, oidctest.User{Subject: "emp-002", Email: "new@example.com", EmailVerified: true})
+	if !errors.As(err, new(*domain.AuthenticationError)) {
+		t.Fatalf("Execute() without auto provisioning error = %v, want AuthenticationError", err)
+	}
+	if found, _ := store.FindByEmail(context.Background(), "new@example.com"); found != nil {
+		t.Errorf("FindByEmail() = %+v, want nil", found)
+	}
+}
+
+func TestOIDCLogin_AutoProvision(t *testing.T) {
+	store := memory.NewStore()
+	env := newOIDCTestEnv(t, store, usecase.OIDCConfig{StateTTL: usecase.DefaultOIDCConfig.StateTTL, AutoProvision: true})
+
+	got, err := env.login(t, oidctest.User{Subject: "emp-002", Email: "jane@example.com", EmailVerified: true, Name: "Jane Roe"})
+	if err != nil {
+		t.Fatalf("Execute() unexpected error: %v", err)
+	}
+	if got.User.Name != "Jane Roe" || got.User.Email != "jane@example.com" || got.User.Status != domain.UserStatusActive {
+		t.Errorf("User = %+v, want active Jane Roe", got.User)
+	}
+	if !got.User.IsEmailVerified() {
+		t.Error("email is not verified, want verified by the IdP")
+	}
+	if identities := store.ExternalIdentities(got.User.ID); len(identities) != 1 || identities[0].Subject != "emp-002" {
+		t.Errorf("ExternalIdentities = %+v, want emp-002", identities)
+	}
+	assertUserLogActions(t, store, got.User.ID,
+		domain.UserLogActionCreated,
+		domain.UserLogActionExternalIdentityLinked,
+		domain.UserLogActionEmailVerified,
+		domain.UserLogActionLoginSucceeded,
+	)
+	assertUserEventTypes(t, store, got.User.ID, domain.UserEventTypeCreated, domain.UserEventTypeUpdated)
+
+	// 名前のクレームがない場合はメールアドレスのローカル部を名前にする
+	got, err = env.login(t, oidctest.User{Subject: "emp-003", Email: "rick@example.com", EmailVerified: true})
+	if err != nil {
+		t.Fatalf("Execute() without name unexpected error: %v", err)
+	}
+	if got.User.Name != "rick" {
+		t.Errorf("User.Name = %q, want rick", got.User.Name)
+	}
+}
+
+func TestOIDCLogin_State(t *testing.T) {
+	ctx := context.Background()
+	user := mustNewUser(t, "John Doe", "john@example.com")
+	store := memory.NewStore()
+	store.Seed(user)
+	env := newOIDCTestEnv(t, store, usecase.DefaultOIDCConfig)
+	employee := oidctest.User{Subject: "emp-001", Email: "john@example.com", EmailVerified: true}
+
+	if _, err := env.start.Execute(ctx, "unknown"); !errors.As(err, new(*domain.NotFoundError)) {
+		t.Errorf("start with unknown provider error = %v, want NotFoundError", err)
+	}
+
+	code, state := env.authorize(t, employee)
+	if _, err := env.complete.Execute(ctx, "company", code, "forged-state", domain.HashUserToken(state), testSessionClient); !errors.As(err, new(*domain.ValidationError)) {
+		t.Errorf("Execute() with forged state error = %v, want ValidationError", err)
+	}
+	// 他のブラウザで開始したログインの state は使えない（ログイン CSRF）
+	for _, stateHash := range []string{"", domain.HashUserToken("other-state")} {
+		if _, err := env.complete.Execute(ctx, "company", code, state, stateHash, testSessionClient); !errors.As(err, new(*domain.ValidationError)) {
+			t.Errorf("Execute() with state hash %q error = %v, want ValidationError", stateHash, err)
+		}
+	}
+	if _, err := env.complete.Execute(ctx, "company", code, state, domain.HashUserToken(state), testSessionClient); err != nil {
+		t.Fatalf("Execute() unexpected error: %v", err)
+	}
+	// state は一度限り
+	if _, err := env.complete.Execute(ctx, "company", code, state, domain.HashUserToken(state), testSessionClient); !errors.As(err, new(*domain.ValidationError)) {
+		t.Errorf("Execute() with used state error = %v, want ValidationError", err)
+	}
+
+	// ID トークンの署名を検証できない場合はログインできない（state は使用済みになる）
+	code, state = env.authorize(t, employee)
+	env.idp.UseUntrustedKey()
+	if _, err := env.complete.Execute(ctx, "company", code, state, domain.HashUserToken(state), testSessionClient); !errors.As(err, new(*domain.AuthenticationError)) {
+		t.Errorf("Execute() with untrusted id token error = %v, want AuthenticationError", err)
+	}
+	if got := len(store.Sessions(user.ID)); got != 1 {
+		t.Errorf("sessions = %d, want 1", got)
+	}
+}
+
+func TestOIDCLogin_UserState(t *testing.T) {
+	suspended := mustNewUser(t, "John Doe", "john@example.com")
+	if err := suspended.Suspend("on leave"); err != nil {
+		t.Fatalf("Suspend() unexpected error: %v", err)
+	}
+	enrolled := mustNewUser(t, "Jane Roe", "jane@example.com")
+	totpCred, _ := mustNewEnabledTOTPCredential(t, enrolled)
+	store := memory.NewStore()
+	store.Seed(suspended, enrolled)
+	store.SeedTOTPCredentials(totpCred)
+	store.SeedExternalIdentities(domain.NewExternalIdentity("company", "emp-002", enrolled.ID, enrolled.CreatedAt))
+	env := newOIDCTestEnv(t, store, usecase.DefaultOIDCConfig)
+
+	// 利用中でないユーザーはログインできず、紐付けも作成しない
+	_, err := env.login(t, oidctest.User{Subject: "emp-001", Email: "john@example.com", EmailVerified: true})
+	if !errors.As(err, new(*domain.AuthenticationError)) {
+		t.Errorf("Execute() for suspended user error = %v, want AuthenticationError", err)
+	}
+	if identities := store.ExternalIdentities(suspended.ID); len(identities) != 0 {
+		t.Errorf("ExternalIdentities = %+v, want none", identities)
+	}
+
+	// 明示的な紐付けのユーザーで、二要素認証が有効な場合はログインの2段階目のトークンを返す
+	got, err := env.login(t, oidctest.User{Subject: "emp-002", Email: "jane@corp.example.com", EmailVerified: true})
+	if err != nil {
+		t.Fatalf("Execute() unexpected error: %v", err)
+	}
+	if got.User.ID != enrolled.ID || got.MFAToken == "" || got.Session != nil {
+		t.Errorf("Execute() = %+v, want mfa challenge for %s", got, enrolled.ID)
+	}
+	if sessions := store.Sessions(enrolled.ID); len(sessions) != 0 {
+		t.Errorf("sessions = %d, want 0 before the second step", len(sessions))
+	}
+}
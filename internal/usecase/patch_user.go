@@ -0,0 +1,75 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure"
+)
+
+// UserPatch 現在の名前とメールアドレスに部分更新を適用し、更新後の値を返す
+//
+// 行ロックを取得したユーザーに対して呼ばれるため、並行する更新を取りこぼさない。
+type UserPatch func(name, email string) (newName, newEmail string, err error)
+
+// PatchUserUsecase ユーザー部分更新ユースケース
+type PatchUserUsecase struct {
+	userQuery   UserQueryRepository
+	userCommand UserCommandRepository
+	txManager   TransactionManager
+}
+
+// NewPatchUserUsecase PatchUserUsecaseのコンストラクタ
+func NewPatchUserUsecase(
+	userQuery UserQueryRepository,
+	userCommand UserCommandRepository,
+	txManager TransactionManager,
+) *PatchUserUsecase {
+	return &PatchUserUsecase{
+		userQuery:   userQuery,
+		userCommand: userCommand,
+		txManager:   txManager,
+	}
+}
+
+// Execute ユーザーに部分更新を適用し、更新後のユーザーを返す（変更がない場合は更新イベントを記録しない）
+func (u *PatchUserUsecase) Execute(ctx context.Context, id string, patch UserPatch) (*domain.User, error) {
+	var result *domain.User
+	err := u.txManager.RunInTransaction(ctx, func(ctx context.Context, tx infrastructure.DBTX) error {
+		// 行ロック付きでユーザーを取得
+		user, err := u.userCommand.FindByIDForUpdate(ctx, tx, id)
+		if err != nil {
+			return err
+		}
+		if user == nil {
+			return domain.ErrUserNotFound(id)
+		}
+
+		name, email, err := patch(user.Name, user.Email)
+		if err != nil {
+			return err
+		}
+		result = user
+		if name == user.Name && email == user.Email {
+			return nil
+		}
+		return replaceUser(ctx, u.userCommand, tx, user, name, email)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// SetUserFields 指定された（nil でない）値だけを置き換える UserPatch を返す
+func SetUserFields(name, email *string) UserPatch {
+	return func(currentName, currentEmail string) (string, string, error) {
+		if name != nil {
+			currentName = *name
+		}
+		if email != nil {
+			currentEmail = *email
+		}
+		return currentName, currentEmail, nil
+	}
+}
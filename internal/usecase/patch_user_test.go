@@ -0,0 +1,106 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure/memory"
+	"github.com/example/go-react-cqrs-template/internal/usecase"
+)
+
+func TestPatchUserUsecase_Execute(t *testing.T) {
+	user := mustNewUser(t, "John Doe", "john@example.com")
+	other := mustNewUser(t, "Jane Doe", "jane@example.com")
+	strPtr := func(s string) *string { return &s }
+	patchErr := domain.NewValidationError("patch", "bad patch", "パッチが不正です")
+
+	tests := []struct {
+		name       string
+		id         string
+		patch      usecase.UserPatch
+		wantErr    any
+		wantEvents []domain.UserEventType
+		wantName   string
+		wantEmail  string
+	}{
+		{
+			name:       "set name only",
+			id:         user.ID,
+			patch:      usecase.SetUserFields(strPtr("John Smith"), nil),
+			wantEvents: []domain.UserEventType{domain.UserEventTypeUpdated},
+			wantName:   "John Smith",
+			wantEmail:  "john@example.com",
+		},
+		{
+			name:      "no changes records no event",
+			id:        user.ID,
+			patch:     usecase.SetUserFields(strPtr("John Doe"), nil),
+			wantName:  "John Doe",
+			wantEmail: "john@example.com",
+		},
+		{
+			name:      "cleared name is rejected",
+			id:        user.ID,
+			patch:     usecase.SetUserFields(strPtr(""), nil),
+			wantErr:   new(*domain.ValidationError),
+			wantName:  "John Doe",
+			wantEmail: "john@example.com",
+		},
+		{
+			name:      "email taken by another user",
+			id:        user.ID,
+			patch:     usecase.SetUserFields(nil, strPtr("jane@example.com")),
+			wantErr:   new(*domain.ConflictError),
+			wantName:  "John Doe",
+			wantEmail: "john@example.com",
+		},
+		{
+			name: "patch error",
+			id:   user.ID,
+			patch: func(string, string) (string, string, error) {
+				return "", "", patchErr
+			},
+			wantErr:   new(*domain.ValidationError),
+			wantName:  "John Doe",
+			wantEmail: "john@example.com",
+		},
+		{
+			name:    "unknown user",
+			id:      "01ARZ3NDEKTSV4RRFFQ69G5FAV",
+			patch:   usecase.SetUserFields(strPtr("Nobody"), nil),
+			wantErr: new(*domain.NotFoundError),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			store := memory.NewStore()
+			store.Seed(user, other)
+			uc := usecase.NewPatchUserUsecase(store, store, store)
+
+			got, err := uc.Execute(ctx, tt.id, tt.patch)
+
+			if tt.wantErr != nil {
+				if err == nil || !errors.As(err, tt.wantErr) {
+					t.Fatalf("Execute() error = %v, want %T", err, tt.wantErr)
+				}
+			} else if err != nil {
+				t.Fatalf("Execute() unexpected error: %v", err)
+			} else if got.Name != tt.wantName || got.Email != tt.wantEmail {
+				t.Errorf("Execute() = %+v, want %s <%s>", got, tt.wantName, tt.wantEmail)
+			}
+			assertUserEventTypes(t, store, tt.id, tt.wantEvents...)
+
+			if tt.wantName == "" {
+				return
+			}
+			stored, _ := store.FindByID(ctx, tt.id)
+			if stored.Name != tt.wantName || stored.Email != tt.wantEmail {
+				t.Errorf("stored = %s <%s>, want %s <%s>", stored.Name, stored.Email, tt.wantName, tt.wantEmail)
+			}
+		})
+	}
+}
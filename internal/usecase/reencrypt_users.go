@@ -0,0 +1,78 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/example/go-react-cqrs-template/internal/infrastructure"
+	"github.com/example/go-react-cqrs-template/internal/tenant"
+)
+
+const (
+	// reencryptUsersBatchSize 1トランザクションで再暗号化するユーザーの最大件数
+	reencryptUsersBatchSize = 100
+	// reencryptUsersOrgPageSize 組織を取得する際のページサイズ
+	reencryptUsersOrgPageSize = 100
+)
+
+// ReencryptUsersUsecase 鍵のローテーション後にユーザーの個人情報を主鍵で暗号化し直すユースケース（定期実行用）
+type ReencryptUsersUsecase struct {
+	orgQuery     OrganizationQueryRepository
+	reencryption UserReencryptionRepository
+	txManager    TransactionManager
+}
+
+// NewReencryptUsersUsecase ReencryptUsersUsecaseのコンストラクタ
+func NewReencryptUsersUsecase(
+	orgQuery OrganizationQueryRepository,
+	reencryption UserReencryptionRepository,
+	txManager TransactionManager,
+) *ReencryptUsersUsecase {
+	return &ReencryptUsersUsecase{
+		orgQuery:     orgQuery,
+		reencryption: reencryption,
+		txManager:    txManager,
+	}
+}
+
+// Execute すべての組織について主鍵以外の鍵で暗号化したユーザーを主鍵で暗号化し直す
+//
+// 再暗号化した件数を返す。他のトランザクションがロック中のユーザーは次回に回す。
+func (u *ReencryptUsersUsecase) Execute(ctx context.Context) (int, error) {
+	total := 0
+	for offset := 0; ; offset += reencryptUsersOrgPageSize {
+		orgs, err := u.orgQuery.FindAllOrganizations(ctx, reencryptUsersOrgPageSize, offset)
+		if err != nil {
+			return total, err
+		}
+		for _, org := range orgs {
+			n, err := u.reencryptOrg(tenant.WithOrgID(ctx, org.ID))
+			total += n
+			if err != nil {
+				return total, err
+			}
+		}
+		if len(orgs) < reencryptUsersOrgPageSize {
+			return total, nil
+		}
+	}
+}
+
+// reencryptOrg コンテキストの組織のユーザーをバッチごとに再暗号化
+func (u *ReencryptUsersUsecase) reencryptOrg(ctx context.Context) (int, error) {
+	total := 0
+	for {
+		var n int
+		err := u.txManager.RunInTransaction(ctx, func(ctx context.Context, tx infrastructure.DBTX) error {
+			var err error
+			n, err = u.reencryption.ReencryptUsers(ctx, tx, reencryptUsersBatchSize)
+			return err
+		})
+		if err != nil {
+			return total, err
+		}
+		total += n
+		if n < reencryptUsersBatchSize {
+			return total, nil
+		}
+	}
+}
@@ -0,0 +1,54 @@
+package usecase_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure/memory"
+	"github.com/example/go-react-cqrs-template/internal/usecase"
+)
+
+func TestReencryptUsersUsecase_Execute(t *testing.T) {
+	store := memory.NewStore()
+	org, err := domain.NewOrganization("Acme")
+	if err != nil {
+		t.Fatalf("NewOrganization() unexpected error: %v", err)
+	}
+	store.SeedOrganizations(org)
+
+	// 既定の組織にバッチサイズを超える人数、別の組織に1人
+	var users []*domain.User
+	for i := range 101 {
+		users = append(users, mustNewUser(t, "John Doe", fmt.Sprintf("john%d@example.com", i)))
+	}
+	store.Seed(users...)
+	other := mustNewUser(t, "Jane Roe", "jane@example.com")
+	store.SeedInOrg(org.ID, other)
+	users = append(users, other)
+
+	uc := usecase.NewReencryptUsersUsecase(store, store, store)
+	if n, err := uc.Execute(context.Background()); err != nil || n != 0 {
+		t.Fatalf("Execute() before rotation = %d, %v, want 0", n, err)
+	}
+
+	store.RotatePIIKey("rotated")
+	n, err := uc.Execute(context.Background())
+	if err != nil {
+		t.Fatalf("Execute() unexpected error: %v", err)
+	}
+	if n != len(users) {
+		t.Errorf("Execute() = %d, want %d", n, len(users))
+	}
+	for _, user := range users {
+		if got := store.PIIKeyID(user.ID); got != "rotated" {
+			t.Errorf("PIIKeyID(%s) = %q, want rotated", user.ID, got)
+		}
+	}
+
+	// 再暗号化したユーザーは再び処理しない
+	if n, err := uc.Execute(context.Background()); err != nil || n != 0 {
+		t.Errorf("Execute() twice = %d, %v, want 0", n, err)
+	}
+}
@@ -0,0 +1,62 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure"
+)
+
+// RemoveGroupMembersUsecase グループメンバー一括削除ユースケース
+type RemoveGroupMembersUsecase struct {
+	groupCommand GroupCommandRepository
+	userCommand  UserCommandRepository
+	txManager    TransactionManager
+}
+
+// NewRemoveGroupMembersUsecase RemoveGroupMembersUsecaseのコンストラクタ
+func NewRemoveGroupMembersUsecase(
+	groupCommand GroupCommandRepository,
+	userCommand UserCommandRepository,
+	txManager TransactionManager,
+) *RemoveGroupMembersUsecase {
+	return &RemoveGroupMembersUsecase{
+		groupCommand: groupCommand,
+		userCommand:  userCommand,
+		txManager:    txManager,
+	}
+}
+
+// Execute ユーザーをまとめてグループから外す
+//
+// メンバーでないユーザーは無視する。実際に外したユーザーについてのみユーザーログに記録する。
+func (u *RemoveGroupMembersUsecase) Execute(ctx context.Context, groupID string, userIDs []string) error {
+	if err := validateGroupMemberUserIDs(userIDs); err != nil {
+		return err
+	}
+
+	return u.txManager.RunInTransaction(ctx, func(ctx context.Context, tx infrastructure.DBTX) error {
+		// 同じグループへの変更を直列化するためグループを行ロック付きで取得
+		group, err := u.groupCommand.FindGroupByIDForUpdate(ctx, tx, groupID)
+		if err != nil {
+			return err
+		}
+		if group == nil {
+			return domain.ErrGroupNotFound(groupID)
+		}
+
+		for _, userID := range userIDs {
+			removed, err := u.groupCommand.DeleteGroupMember(ctx, tx, group.ID, userID)
+			if err != nil {
+				return err
+			}
+			if !removed {
+				continue
+			}
+			if err := u.userCommand.SaveUserLog(ctx, tx, domain.NewUserLog(userID, domain.UserLogActionGroupLeft)); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
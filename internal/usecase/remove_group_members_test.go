@@ -0,0 +1,76 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure/memory"
+	"github.com/example/go-react-cqrs-template/internal/usecase"
+)
+
+func TestRemoveGroupMembersUsecase_Execute(t *testing.T) {
+	alice := mustNewUser(t, "Alice", "alice@example.com")
+	bob := mustNewUser(t, "Bob", "bob@example.com")
+	group := mustNewGroup(t, "Engineering")
+
+	tests := []struct {
+		name      string
+		groupID   string
+		userIDs   []string
+		wantErr   any
+		wantCount int
+	}{
+		{
+			name:      "removes only existing members",
+			groupID:   group.ID,
+			userIDs:   []string{alice.ID, bob.ID},
+			wantCount: 0,
+		},
+		{
+			name:      "unknown group",
+			groupID:   "01ARZ3NDEKTSV4RRFFQ69G5FAW",
+			userIDs:   []string{alice.ID},
+			wantErr:   new(*domain.NotFoundError),
+			wantCount: 1,
+		},
+		{
+			name:      "no users",
+			groupID:   group.ID,
+			wantErr:   new(*domain.ValidationError),
+			wantCount: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			store := memory.NewStore()
+			store.Seed(alice, bob)
+			store.SeedGroups(group)
+			store.SeedGroupMembers(mustNewGroupMember(t, group, alice, domain.GroupRoleOwner))
+			uc := usecase.NewRemoveGroupMembersUsecase(store, store, store)
+
+			err := uc.Execute(ctx, tt.groupID, tt.userIDs)
+
+			if tt.wantErr != nil {
+				if err == nil || !errors.As(err, tt.wantErr) {
+					t.Fatalf("Execute() error = %v, want %T", err, tt.wantErr)
+				}
+			} else if err != nil {
+				t.Fatalf("Execute() unexpected error: %v", err)
+			}
+
+			if count, _ := store.CountGroupMembers(ctx, group.ID); count != tt.wantCount {
+				t.Errorf("CountGroupMembers() = %d, want %d", count, tt.wantCount)
+			}
+			if tt.wantErr != nil {
+				assertUserLogActions(t, store, alice.ID)
+				return
+			}
+			assertUserLogActions(t, store, alice.ID, domain.UserLogActionGroupLeft)
+			assertUserLogActions(t, store, bob.ID)
+		})
+	}
+}
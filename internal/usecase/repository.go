@@ -2,6 +2,7 @@ package usecase
 
 import (
 	"context"
+	"time"
 
 	"github.com/example/go-react-cqrs-template/internal/domain"
 	"github.com/example/go-react-cqrs-template/internal/infrastructure"
@@ -13,9 +14,157 @@ type TransactionManager interface {
 }
 
 // UserQueryRepository 読み取り操作のインターフェース
+//
+// ユーザーに関するリポジトリはいずれもコンテキストの組織（tenant.OrgID）に絞り込んで読み書きする。
 type UserQueryRepository interface {
 	FindByID(ctx context.Context, id string) (*domain.User, error)
 	FindByEmail(ctx context.Context, email string) (*domain.User, error)
-	FindAll(ctx context.Context, limit, offset int) ([]*domain.User, error)
-	Count(ctx context.Context) (int, error)
+	FindAll(ctx context.Context, status domain.UserStatus, limit, offset int) ([]*domain.User, error)
+	Count(ctx context.Context, status domain.UserStatus) (int, error)
+}
+
+// UserLogQueryRepository ユーザーログの読み取り操作のインターフェース
+type UserLogQueryRepository interface {
+	FindLogsByUserIDs(ctx context.Context, userIDs []string, limit, offset int) (map[string][]*domain.UserLog, error)
+	CountLogsByUserIDs(ctx context.Context, userIDs []string) (map[string]int, error)
+}
+
+// UserCommandRepository 書き込み操作のインターフェース（トランザクション内で使用）
+type UserCommandRepository interface {
+	Save(ctx context.Context, tx infrastructure.DBTX, user *domain.User) error
+	Delete(ctx context.Context, tx infrastructure.DBTX, id string) error
+	FindByIDForUpdate(ctx context.Context, tx infrastructure.DBTX, id string) (*domain.User, error)
+	FindByEmailForUpdate(ctx context.Context, tx infrastructure.DBTX, email string) (*domain.User, error)
+	SaveUserLog(ctx context.Context, tx infrastructure.DBTX, log *domain.UserLog) error
+	SaveEvents(ctx context.Context, tx infrastructure.DBTX, events []domain.UserEvent) error
+}
+
+// UserReencryptionRepository ユーザーの個人情報の再暗号化のインターフェース（トランザクション内で使用）
+type UserReencryptionRepository interface {
+	// ReencryptUsers コンテキストの組織で主鍵以外の鍵で暗号化したユーザー（とメールで送るトークン・招待）を
+	// 最大 limit 件ロックして主鍵で暗号化し直し、件数を返す
+	ReencryptUsers(ctx context.Context, tx infrastructure.DBTX, limit int) (int, error)
+}
+
+// CredentialCommandRepository パスワード認証情報の読み書き操作のインターフェース（トランザクション内で使用）
+//
+// 認証情報は照合のたびに失敗回数を更新するため、読み取りも行ロック付きで行う。
+type CredentialCommandRepository interface {
+	FindCredentialForUpdate(ctx context.Context, tx infrastructure.DBTX, userID string) (*domain.Credential, error)
+	SaveCredential(ctx context.Context, tx infrastructure.DBTX, cred *domain.Credential) error
+}
+
+// TOTPCredentialCommandRepository TOTP の二要素認証の登録の読み書き操作のインターフェース（トランザクション内で使用）
+//
+// 確認コードの照合のたびに使用したステップを更新するため、読み取りも行ロック付きで行う。
+type TOTPCredentialCommandRepository interface {
+	// FindTOTPCredentialForUpdate ユーザーIDで TOTP の登録を検索しロックを取得（登録されていない場合は nil）
+	FindTOTPCredentialForUpdate(ctx context.Context, tx infrastructure.DBTX, userID string) (*domain.TOTPCredential, error)
+	SaveTOTPCredential(ctx context.Context, tx infrastructure.DBTX, cred *domain.TOTPCredential) error
+	DeleteTOTPCredential(ctx context.Context, tx infrastructure.DBTX, userID string) error
+}
+
+// UserTokenCommandRepository メールで送るトークンの読み書き操作のインターフェース（トランザクション内で使用）
+type UserTokenCommandRepository interface {
+	// FindUserTokenForUpdate 用途とハッシュでトークンを検索しロックを取得（見つからない場合は nil）
+	FindUserTokenForUpdate(ctx context.Context, tx infrastructure.DBTX, purpose domain.UserTokenPurpose, tokenHash string) (*domain.UserToken, error)
+	SaveUserToken(ctx context.Context, tx infrastructure.DBTX, token *domain.UserToken) error
+	// RevokeUserTokens ユーザーの未使用のトークンを時刻 now で使用済みにする
+	RevokeUserTokens(ctx context.Context, tx infrastructure.DBTX, userID string, purpose domain.UserTokenPurpose, now time.Time) error
+}
+
+// ExternalIdentityCommandRepository 外部の IdP のユーザーとの紐付けの読み書き操作のインターフェース（トランザクション内で使用）
+type ExternalIdentityCommandRepository interface {
+	// FindExternalIdentityForUpdate IdP の名前と subject で紐付けを検索しロックを取得（紐付けがない場合は nil）
+	FindExternalIdentityForUpdate(ctx context.Context, tx infrastructure.DBTX, provider, subject string) (*domain.ExternalIdentity, error)
+	SaveExternalIdentity(ctx context.Context, tx infrastructure.DBTX, identity *domain.ExternalIdentity) error
+}
+
+// OIDCLoginStateCommandRepository OpenID Connect の認可リクエストの状態の読み書き操作のインターフェース（トランザクション内で使用）
+type OIDCLoginStateCommandRepository interface {
+	// FindOIDCLoginStateForUpdate IdP の名前と state のハッシュで状態を検索しロックを取得（見つからない場合は nil）
+	FindOIDCLoginStateForUpdate(ctx context.Context, tx infrastructure.DBTX, provider, stateHash string) (*domain.OIDCLoginState, error)
+	SaveOIDCLoginState(ctx context.Context, tx infrastructure.DBTX, state *domain.OIDCLoginState) error
+}
+
+// InvitationQueryRepository 招待の読み取り操作のインターフェース
+type InvitationQueryRepository interface {
+	// FindPendingInvitations 時刻 now に承諾待ちで有効期限内の招待を新しい順に取得
+	FindPendingInvitations(ctx context.Context, now time.Time, limit, offset int) ([]*domain.Invitation, error)
+	CountPendingInvitations(ctx context.Context, now time.Time) (int, error)
+}
+
+// InvitationCommandRepository 招待の読み書き操作のインターフェース（トランザクション内で使用）
+type InvitationCommandRepository interface {
+	FindInvitationByIDForUpdate(ctx context.Context, tx infrastructure.DBTX, id string) (*domain.Invitation, error)
+	// FindInvitationByTokenForUpdate トークンのハッシュで招待を検索しロックを取得（見つからない場合は nil）
+	FindInvitationByTokenForUpdate(ctx context.Context, tx infrastructure.DBTX, tokenHash string) (*domain.Invitation, error)
+	// FindExpiredInvitationsForUpdate 時刻 now に有効期限を過ぎた承諾待ちの招待を最大 limit 件ロックして取得
+	FindExpiredInvitationsForUpdate(ctx context.Context, tx infrastructure.DBTX, now time.Time, limit int) ([]*domain.Invitation, error)
+	SaveInvitation(ctx context.Context, tx infrastructure.DBTX, inv *domain.Invitation) error
+}
+
+// SessionQueryRepository セッションの読み取り操作のインターフェース
+type SessionQueryRepository interface {
+	// FindSessionByID IDでセッションを検索（見つからない場合は nil）
+	FindSessionByID(ctx context.Context, id string) (*domain.Session, error)
+	// FindActiveSessions 時刻 now に方針 policy で有効なユーザーのセッションを最近使用した順に取得
+	FindActiveSessions(ctx context.Context, userID string, now time.Time, policy domain.SessionPolicy) ([]*domain.Session, error)
+}
+
+// SessionCommandRepository セッションの読み書き操作のインターフェース（トランザクション内で使用）
+type SessionCommandRepository interface {
+	// FindSessionByIDForUpdate IDでセッションを検索しロックを取得（見つからない場合は nil）
+	FindSessionByIDForUpdate(ctx context.Context, tx infrastructure.DBTX, id string) (*domain.Session, error)
+	// FindUnexpiredSessionsForUpdate 時刻 now に取り消されておらずログインからの有効期限内のユーザーのセッションをロックして取得
+	// （無操作で失効したセッションも含む）
+	FindUnexpiredSessionsForUpdate(ctx context.Context, tx infrastructure.DBTX, userID string, now time.Time) ([]*domain.Session, error)
+	SaveSession(ctx context.Context, tx infrastructure.DBTX, session *domain.Session) error
+}
+
+// WebhookQueryRepository Webhook購読・配信ログの読み取り操作のインターフェース
+type WebhookQueryRepository interface {
+	FindSubscriptionByID(ctx context.Context, id string) (*domain.WebhookSubscription, error)
+	FindAllSubscriptions(ctx context.Context, limit, offset int) ([]*domain.WebhookSubscription, error)
+	CountSubscriptions(ctx context.Context) (int, error)
+	FindDeliveries(ctx context.Context, subscriptionID string, status domain.WebhookDeliveryStatus, limit, offset int) ([]*domain.WebhookDelivery, error)
+	CountDeliveries(ctx context.Context, subscriptionID string, status domain.WebhookDeliveryStatus) (int, error)
+}
+
+// WebhookCommandRepository Webhook購読の書き込み操作のインターフェース（トランザクション内で使用）
+type WebhookCommandRepository interface {
+	SaveSubscription(ctx context.Context, tx infrastructure.DBTX, sub *domain.WebhookSubscription) error
+	DeleteSubscription(ctx context.Context, tx infrastructure.DBTX, id string) error
+	FindSubscriptionByIDForUpdate(ctx context.Context, tx infrastructure.DBTX, id string) (*domain.WebhookSubscription, error)
+}
+
+// OrganizationQueryRepository 組織の読み取り操作のインターフェース
+type OrganizationQueryRepository interface {
+	FindOrganizationByID(ctx context.Context, id string) (*domain.Organization, error)
+	FindAllOrganizations(ctx context.Context, limit, offset int) ([]*domain.Organization, error)
+	CountOrganizations(ctx context.Context) (int, error)
+}
+
+// OrganizationCommandRepository 組織の書き込み操作のインターフェース（トランザクション内で使用）
+type OrganizationCommandRepository interface {
+	SaveOrganization(ctx context.Context, tx infrastructure.DBTX, org *domain.Organization) error
+}
+
+// GroupQueryRepository グループ・メンバーシップの読み取り操作のインターフェース
+type GroupQueryRepository interface {
+	FindGroupByID(ctx context.Context, id string) (*domain.Group, error)
+	FindGroupMembers(ctx context.Context, groupID string, limit, offset int) ([]*domain.GroupMember, error)
+	CountGroupMembers(ctx context.Context, groupID string) (int, error)
+	FindGroupsByUserID(ctx context.Context, userID string) ([]*domain.GroupMembership, error)
+}
+
+// GroupCommandRepository グループ・メンバーシップの書き込み操作のインターフェース（トランザクション内で使用）
+type GroupCommandRepository interface {
+	SaveGroup(ctx context.Context, tx infrastructure.DBTX, group *domain.Group) error
+	FindGroupByIDForUpdate(ctx context.Context, tx infrastructure.DBTX, id string) (*domain.Group, error)
+	FindGroupByNameForUpdate(ctx context.Context, tx infrastructure.DBTX, name string) (*domain.Group, error)
+	FindGroupMember(ctx context.Context, tx infrastructure.DBTX, groupID, userID string) (*domain.GroupMember, error)
+	SaveGroupMember(ctx context.Context, tx infrastructure.DBTX, member *domain.GroupMember) error
+	// DeleteGroupMember メンバーシップを削除する（メンバーでなかった場合は false を返す）
+	DeleteGroupMember(ctx context.Context, tx infrastructure.DBTX, groupID, userID string) (bool, error)
 }
@@ -0,0 +1,78 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure"
+	"github.com/example/go-react-cqrs-template/internal/mail"
+	"github.com/example/go-react-cqrs-template/internal/tenant"
+)
+
+// RequestEmailVerificationUsecase メールアドレスの確認のメールを送るユースケース
+type RequestEmailVerificationUsecase struct {
+	userCommand  UserCommandRepository
+	tokenCommand UserTokenCommandRepository
+	mailer       mail.Mailer
+	txManager    TransactionManager
+	cfg          UserTokenConfig
+}
+
+// NewRequestEmailVerificationUsecase RequestEmailVerificationUsecaseのコンストラクタ
+func NewRequestEmailVerificationUsecase(
+	userCommand UserCommandRepository,
+	tokenCommand UserTokenCommandRepository,
+	mailer mail.Mailer,
+	txManager TransactionManager,
+	cfg UserTokenConfig,
+) *RequestEmailVerificationUsecase {
+	return &RequestEmailVerificationUsecase{
+		userCommand:  userCommand,
+		tokenCommand: tokenCommand,
+		mailer:       mailer,
+		txManager:    txManager,
+		cfg:          cfg,
+	}
+}
+
+// Execute 確認のトークンを発行し、ユーザーのメールアドレスに確認のリンクを送る
+//
+// 以前に送った確認のリンクは使えなくなる。確認済みの場合は ErrEmailAlreadyVerified を返す。
+// メールはトークンのコミット後に送るため、送信に失敗した場合は再度リクエストする。
+func (u *RequestEmailVerificationUsecase) Execute(ctx context.Context, userID string) error {
+	var (
+		user      *domain.User
+		secret    string
+		expiresAt time.Time
+	)
+	err := u.txManager.RunInTransaction(ctx, func(ctx context.Context, tx infrastructure.DBTX) error {
+		found, err := u.userCommand.FindByIDForUpdate(ctx, tx, userID)
+		if err != nil {
+			return err
+		}
+		if found == nil {
+			return domain.ErrUserNotFound(userID)
+		}
+		if found.IsEmailVerified() {
+			return domain.ErrEmailAlreadyVerified(userID)
+		}
+
+		token, s, err := issueUserToken(ctx, u.tokenCommand, tx, found, domain.UserTokenPurposeEmailVerification, u.cfg.EmailVerificationTTL)
+		if err != nil {
+			return err
+		}
+		user, secret, expiresAt = found, s, token.ExpiresAt
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	link := u.cfg.userTokenLink("/verify-email", tenant.OrgID(ctx), secret)
+	if err := u.mailer.Send(ctx, emailVerificationMessage(user, link, expiresAt)); err != nil {
+		return fmt.Errorf("failed to send verification email: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,106 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure/memory"
+	"github.com/example/go-react-cqrs-template/internal/testutil/mailtest"
+	"github.com/example/go-react-cqrs-template/internal/usecase"
+)
+
+func TestRequestEmailVerificationUsecase_Execute(t *testing.T) {
+	unverified := mustNewUser(t, "John Doe", "john@example.com")
+	verified := mustNewUser(t, "Jane Doe", "jane@example.com")
+	verified.VerifyEmail(time.Now())
+
+	tests := []struct {
+		name     string
+		userID   string
+		wantErr  any
+		wantSent int
+	}{
+		{name: "send verification link", userID: unverified.ID, wantSent: 1},
+		{name: "already verified", userID: verified.ID, wantErr: new(*domain.ConflictError)},
+		{name: "unknown user", userID: "01ARZ3NDEKTSV4RRFFQ69G5FAV", wantErr: new(*domain.NotFoundError)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			store := memory.NewStore()
+			store.Seed(unverified, verified)
+			mailer := &recordingMailer{}
+			uc := usecase.NewRequestEmailVerificationUsecase(store, store, mailer, store, usecase.DefaultUserTokenConfig)
+
+			err := uc.Execute(ctx, tt.userID)
+
+			if tt.wantErr != nil {
+				if err == nil || !errors.As(err, tt.wantErr) {
+					t.Fatalf("Execute() error = %v, want %T", err, tt.wantErr)
+				}
+			} else if err != nil {
+				t.Fatalf("Execute() unexpected error: %v", err)
+			}
+
+			sent := mailer.sent()
+			if len(sent) != tt.wantSent {
+				t.Fatalf("len(sent) = %d, want %d", len(sent), tt.wantSent)
+			}
+			if tt.wantSent == 0 {
+				return
+			}
+			if sent[0].To != unverified.Email || !strings.Contains(sent[0].Body, usecase.DefaultUserTokenConfig.BaseURL+"/verify-email?") {
+				t.Errorf("sent = %+v, want verification link to %s", sent[0], unverified.Email)
+			}
+			tokens := store.UserTokens(unverified.ID, domain.UserTokenPurposeEmailVerification)
+			if len(tokens) != 1 || tokens[0].TokenHash != domain.HashUserToken(mailtest.LinkToken(t, sent[0])) {
+				t.Errorf("UserTokens() = %+v, want the hash of the sent token", tokens)
+			}
+		})
+	}
+}
+
+func TestRequestEmailVerificationUsecase_RevokesPreviousTokens(t *testing.T) {
+	ctx := context.Background()
+	user := mustNewUser(t, "John Doe", "john@example.com")
+	store := memory.NewStore()
+	store.Seed(user)
+	mailer := &recordingMailer{}
+	uc := usecase.NewRequestEmailVerificationUsecase(store, store, mailer, store, usecase.DefaultUserTokenConfig)
+
+	for range 2 {
+		if err := uc.Execute(ctx, user.ID); err != nil {
+			t.Fatalf("Execute() unexpected error: %v", err)
+		}
+	}
+
+	sent := mailer.sent()
+	latest := domain.HashUserToken(mailtest.LinkToken(t, sent[len(sent)-1]))
+	tokens := store.UserTokens(user.ID, domain.UserTokenPurposeEmailVerification)
+	if len(tokens) != 2 {
+		t.Fatalf("len(UserTokens) = %d, want 2", len(tokens))
+	}
+	// 最後に送ったトークンだけが使用できる
+	for _, token := range tokens {
+		if usable := token.UsedAt.IsZero(); usable != (token.TokenHash == latest) {
+			t.Errorf("token %s usable = %v, want %v", token.ID, usable, !usable)
+		}
+	}
+}
+
+func TestRequestEmailVerificationUsecase_MailError(t *testing.T) {
+	user := mustNewUser(t, "John Doe", "john@example.com")
+	store := memory.NewStore()
+	store.Seed(user)
+	mailer := &recordingMailer{err: errors.New("connection refused")}
+	uc := usecase.NewRequestEmailVerificationUsecase(store, store, mailer, store, usecase.DefaultUserTokenConfig)
+
+	if err := uc.Execute(context.Background(), user.ID); err == nil {
+		t.Fatal("Execute() succeeded, want mail error")
+	}
+}
@@ -0,0 +1,75 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure"
+	"github.com/example/go-react-cqrs-template/internal/mail"
+	"github.com/example/go-react-cqrs-template/internal/tenant"
+)
+
+// RequestPasswordResetUsecase パスワードのリセットのメールを送るユースケース
+type RequestPasswordResetUsecase struct {
+	userCommand  UserCommandRepository
+	tokenCommand UserTokenCommandRepository
+	mailer       mail.Mailer
+	txManager    TransactionManager
+	cfg          UserTokenConfig
+}
+
+// NewRequestPasswordResetUsecase RequestPasswordResetUsecaseのコンストラクタ
+func NewRequestPasswordResetUsecase(
+	userCommand UserCommandRepository,
+	tokenCommand UserTokenCommandRepository,
+	mailer mail.Mailer,
+	txManager TransactionManager,
+	cfg UserTokenConfig,
+) *RequestPasswordResetUsecase {
+	return &RequestPasswordResetUsecase{
+		userCommand:  userCommand,
+		tokenCommand: tokenCommand,
+		mailer:       mailer,
+		txManager:    txManager,
+		cfg:          cfg,
+	}
+}
+
+// Execute リセットのトークンを発行し、メールアドレスにリセットのリンクを送る
+//
+// ユーザーの有無を推測されないよう、存在しないか利用中でないユーザーのメールアドレスでも
+// 何もせずに成功を返す。以前に送ったリセットのリンクは使えなくなる。
+func (u *RequestPasswordResetUsecase) Execute(ctx context.Context, email string) error {
+	var (
+		user      *domain.User
+		secret    string
+		expiresAt time.Time
+	)
+	err := u.txManager.RunInTransaction(ctx, func(ctx context.Context, tx infrastructure.DBTX) error {
+		found, err := u.userCommand.FindByEmailForUpdate(ctx, tx, email)
+		if err != nil {
+			return err
+		}
+		if found == nil || found.Status != domain.UserStatusActive {
+			return nil
+		}
+
+		token, s, err := issueUserToken(ctx, u.tokenCommand, tx, found, domain.UserTokenPurposePasswordReset, u.cfg.PasswordResetTTL)
+		if err != nil {
+			return err
+		}
+		user, secret, expiresAt = found, s, token.ExpiresAt
+		return nil
+	})
+	if err != nil || user == nil {
+		return err
+	}
+
+	link := u.cfg.userTokenLink("/reset-password", tenant.OrgID(ctx), secret)
+	if err := u.mailer.Send(ctx, passwordResetMessage(user, link, expiresAt)); err != nil {
+		return fmt.Errorf("failed to send password reset email: %w", err)
+	}
+	return nil
+}
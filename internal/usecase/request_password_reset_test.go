@@ -0,0 +1,70 @@
+package usecase_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure/memory"
+	"github.com/example/go-react-cqrs-template/internal/testutil/mailtest"
+	"github.com/example/go-react-cqrs-template/internal/usecase"
+)
+
+// requestPasswordReset リセットのメールを送り、リンクのトークンを返す
+func requestPasswordReset(t *testing.T, store *memory.Store, email string) string {
+	t.Helper()
+	mailer := &recordingMailer{}
+	uc := usecase.NewRequestPasswordResetUsecase(store, store, mailer, store, usecase.DefaultUserTokenConfig)
+	if err := uc.Execute(context.Background(), email); err != nil {
+		t.Fatalf("RequestPasswordReset() unexpected error: %v", err)
+	}
+	return mailtest.LinkToken(t, mailer.sent()[0])
+}
+
+func TestRequestPasswordResetUsecase_Execute(t *testing.T) {
+	active := mustNewUser(t, "John Doe", "john@example.com")
+	suspended := mustNewUser(t, "Jane Doe", "jane@example.com")
+	if err := suspended.Suspend("test"); err != nil {
+		t.Fatalf("Suspend() unexpected error: %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		email    string
+		wantUser *domain.User
+	}{
+		{name: "send reset link", email: "john@example.com", wantUser: active},
+		// 存在しない・利用できないアカウントでも同じ結果を返し、登録の有無を明かさない
+		{name: "unknown email", email: "unknown@example.com"},
+		{name: "suspended user", email: "jane@example.com"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := memory.NewStore()
+			store.Seed(active, suspended)
+			mailer := &recordingMailer{}
+			uc := usecase.NewRequestPasswordResetUsecase(store, store, mailer, store, usecase.DefaultUserTokenConfig)
+
+			if err := uc.Execute(context.Background(), tt.email); err != nil {
+				t.Fatalf("Execute() unexpected error: %v", err)
+			}
+
+			sent := mailer.sent()
+			if tt.wantUser == nil {
+				if len(sent) != 0 {
+					t.Errorf("sent = %+v, want no mail", sent)
+				}
+				return
+			}
+			if len(sent) != 1 || sent[0].To != tt.wantUser.Email || !strings.Contains(sent[0].Body, "/reset-password?") {
+				t.Fatalf("sent = %+v, want reset link to %s", sent, tt.wantUser.Email)
+			}
+			tokens := store.UserTokens(tt.wantUser.ID, domain.UserTokenPurposePasswordReset)
+			if len(tokens) != 1 || tokens[0].TokenHash != domain.HashUserToken(mailtest.LinkToken(t, sent[0])) {
+				t.Errorf("UserTokens() = %+v, want the hash of the sent token", tokens)
+			}
+		})
+	}
+}
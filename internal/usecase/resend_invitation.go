@@ -0,0 +1,88 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure"
+	"github.com/example/go-react-cqrs-template/internal/mail"
+	"github.com/example/go-react-cqrs-template/internal/tenant"
+)
+
+// ResendInvitationUsecase 招待の再送ユースケース
+type ResendInvitationUsecase struct {
+	userCommand       UserCommandRepository
+	invitationCommand InvitationCommandRepository
+	mailer            mail.Mailer
+	txManager         TransactionManager
+	cfg               UserTokenConfig
+}
+
+// NewResendInvitationUsecase ResendInvitationUsecaseのコンストラクタ
+func NewResendInvitationUsecase(
+	userCommand UserCommandRepository,
+	invitationCommand InvitationCommandRepository,
+	mailer mail.Mailer,
+	txManager TransactionManager,
+	cfg UserTokenConfig,
+) *ResendInvitationUsecase {
+	return &ResendInvitationUsecase{
+		userCommand:       userCommand,
+		invitationCommand: invitationCommand,
+		mailer:            mailer,
+		txManager:         txManager,
+		cfg:               cfg,
+	}
+}
+
+// Execute 新しいトークンで招待のリンクを送り直し、有効期限を延ばした招待を返す
+//
+// 期限切れの招待も再送でき、以前に送ったリンクは使えなくなる。期限切れをまだ記録していない場合は
+// ユーザーログに invitation_expired を記録してから再送する。承諾済み・取り消し済みの招待は ErrInvitationNotPending。
+func (u *ResendInvitationUsecase) Execute(ctx context.Context, id string) (*domain.Invitation, error) {
+	var (
+		user   *domain.User
+		inv    *domain.Invitation
+		secret string
+	)
+	err := u.txManager.RunInTransaction(ctx, func(ctx context.Context, tx infrastructure.DBTX) error {
+		found, err := u.invitationCommand.FindInvitationByIDForUpdate(ctx, tx, id)
+		if err != nil {
+			return err
+		}
+		if found == nil {
+			return domain.ErrInvitationNotFound(id)
+		}
+		invited, err := u.userCommand.FindByIDForUpdate(ctx, tx, found.UserID)
+		if err != nil {
+			return err
+		}
+		if invited == nil {
+			return domain.ErrInvitationNotFound(id)
+		}
+
+		if _, err := expireInvitation(ctx, u.userCommand, u.invitationCommand, tx, found, time.Now()); err != nil {
+			return err
+		}
+		s, err := found.Renew(invited, u.cfg.InvitationTTL)
+		if err != nil {
+			return err
+		}
+		if err := u.invitationCommand.SaveInvitation(ctx, tx, found); err != nil {
+			return err
+		}
+		user, inv, secret = invited, found, s
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	link := u.cfg.userTokenLink("/accept-invitation", tenant.OrgID(ctx), secret)
+	if err := u.mailer.Send(ctx, invitationMessage(user, link, inv.ExpiresAt)); err != nil {
+		return nil, fmt.Errorf("failed to send invitation email: %w", err)
+	}
+	return inv, nil
+}
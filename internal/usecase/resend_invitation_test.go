@@ -0,0 +1,104 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure/memory"
+	"github.com/example/go-react-cqrs-template/internal/testutil/mailtest"
+	"github.com/example/go-react-cqrs-template/internal/usecase"
+)
+
+func TestResendInvitationUsecase_Execute(t *testing.T) {
+	tests := []struct {
+		name        string
+		expired     bool
+		wantActions []domain.UserLogAction
+	}{
+		{name: "pending", wantActions: []domain.UserLogAction{domain.UserLogActionInvited}},
+		{
+			name:        "expired",
+			expired:     true,
+			wantActions: []domain.UserLogAction{domain.UserLogActionInvited, domain.UserLogActionInvitationExpired},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := memory.NewStore()
+			inv, oldToken := createInvitation(t, store, "john@example.com")
+			if tt.expired {
+				expired := *inv
+				expired.ExpiresAt = time.Now().Add(-time.Minute)
+				store.SeedInvitations(&expired)
+			}
+			mailer := &recordingMailer{}
+			uc := usecase.NewResendInvitationUsecase(store, store, mailer, store, usecase.DefaultUserTokenConfig)
+
+			got, err := uc.Execute(context.Background(), inv.ID)
+			if err != nil {
+				t.Fatalf("Execute() unexpected error: %v", err)
+			}
+			if got.ID != inv.ID || got.Status != domain.InvitationStatusPending || !got.ExpiresAt.After(time.Now()) {
+				t.Errorf("Execute() = %+v, want pending invitation %s", got, inv.ID)
+			}
+			sent := mailer.sent()
+			if len(sent) != 1 || sent[0].To != inv.Email {
+				t.Fatalf("sent = %+v, want one mail to %s", sent, inv.Email)
+			}
+			token := mailtest.LinkToken(t, sent[0])
+			if token == oldToken || domain.HashUserToken(token) != got.TokenHash {
+				t.Errorf("resent link token does not match the renewed invitation")
+			}
+			assertUserLogActions(t, store, inv.UserID, tt.wantActions...)
+		})
+	}
+}
+
+func TestResendInvitationUsecase_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		setup   func(t *testing.T, store *memory.Store, inv *domain.Invitation) string
+		wantErr any
+	}{
+		{
+			name: "not found",
+			setup: func(*testing.T, *memory.Store, *domain.Invitation) string {
+				return "01ARZ3NDEKTSV4RRFFQ69G5FAV"
+			},
+			wantErr: new(*domain.NotFoundError),
+		},
+		{
+			name: "revoked",
+			setup: func(t *testing.T, store *memory.Store, inv *domain.Invitation) string {
+				if err := usecase.NewRevokeInvitationUsecase(store, store, store).Execute(context.Background(), inv.ID); err != nil {
+					t.Fatalf("RevokeInvitation() unexpected error: %v", err)
+				}
+				return inv.ID
+			},
+			wantErr: new(*domain.ConflictError),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := memory.NewStore()
+			inv, _ := createInvitation(t, store, "john@example.com")
+			id := tt.setup(t, store, inv)
+			mailer := &recordingMailer{}
+			uc := usecase.NewResendInvitationUsecase(store, store, mailer, store, usecase.DefaultUserTokenConfig)
+
+			_, err := uc.Execute(context.Background(), id)
+
+			if !errors.As(err, tt.wantErr) {
+				t.Fatalf("Execute() error = %v, want %T", err, tt.wantErr)
+			}
+			if sent := mailer.sent(); len(sent) != 0 {
+				t.Errorf("sent = %+v, want no mail", sent)
+			}
+		})
+	}
+}
@@ -0,0 +1,79 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure"
+)
+
+// ResetPasswordUsecase メールで送ったトークンによるパスワードのリセットユースケース
+type ResetPasswordUsecase struct {
+	userCommand       UserCommandRepository
+	credentialCommand CredentialCommandRepository
+	tokenCommand      UserTokenCommandRepository
+	sessionCommand    SessionCommandRepository
+	hasher            PasswordHasher
+	txManager         TransactionManager
+}
+
+// NewResetPasswordUsecase ResetPasswordUsecaseのコンストラクタ
+func NewResetPasswordUsecase(
+	userCommand UserCommandRepository,
+	credentialCommand CredentialCommandRepository,
+	tokenCommand UserTokenCommandRepository,
+	sessionCommand SessionCommandRepository,
+	hasher PasswordHasher,
+	txManager TransactionManager,
+) *ResetPasswordUsecase {
+	return &ResetPasswordUsecase{
+		userCommand:       userCommand,
+		credentialCommand: credentialCommand,
+		tokenCommand:      tokenCommand,
+		sessionCommand:    sessionCommand,
+		hasher:            hasher,
+		txManager:         txManager,
+	}
+}
+
+// Execute トークンを使用済みにし、パスワードを newPassword に置き換える
+//
+// トークンが無効な場合は ErrUserTokenInvalid を返す。パスワードを置き換えると失敗回数とロックは解除される。
+// 新しいパスワードがポリシーを満たさない場合はトークンを使用済みにしない。
+// パスワードを知っていた第三者のログインを残さないよう、ユーザーのセッションはすべて取り消す。
+func (u *ResetPasswordUsecase) Execute(ctx context.Context, token, newPassword string) error {
+	return u.txManager.RunInTransaction(ctx, func(ctx context.Context, tx infrastructure.DBTX) error {
+		user, err := useUserToken(ctx, u.userCommand, u.tokenCommand, tx, domain.UserTokenPurposePasswordReset, token)
+		if err != nil {
+			return err
+		}
+		if user.Status != domain.UserStatusActive {
+			return domain.ErrUserTokenInvalid()
+		}
+		if err := domain.ValidatePassword(newPassword, user.Email); err != nil {
+			return err
+		}
+
+		hash, err := u.hasher.Hash(newPassword)
+		if err != nil {
+			return err
+		}
+		cred, err := u.credentialCommand.FindCredentialForUpdate(ctx, tx, user.ID)
+		if err != nil {
+			return err
+		}
+		if cred == nil {
+			cred = domain.NewCredential(user.ID, hash)
+		} else {
+			cred.ChangePassword(hash)
+		}
+		if err := u.credentialCommand.SaveCredential(ctx, tx, cred); err != nil {
+			return err
+		}
+		if err := u.userCommand.SaveUserLog(ctx, tx, domain.NewUserLog(user.ID, domain.UserLogActionPasswordReset)); err != nil {
+			return err
+		}
+		return revokeUserSessions(ctx, u.userCommand, u.sessionCommand, tx, user.ID, time.Now())
+	})
+}
@@ -0,0 +1,145 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure/memory"
+	"github.com/example/go-react-cqrs-template/internal/usecase"
+)
+
+func TestResetPasswordUsecase_Execute(t *testing.T) {
+	hasher := newTestPasswordHasher()
+
+	tests := []struct {
+		name          string
+		hasCredential bool
+		setup         func(t *testing.T, store *memory.Store, user *domain.User)
+		newPassword   string
+		wantErr       any
+		wantLogs      []domain.UserLogAction
+		wantPassword  string
+	}{
+		{
+			name:          "reset locked password",
+			hasCredential: true,
+			newPassword:   "brand new password",
+			wantLogs:      []domain.UserLogAction{domain.UserLogActionPasswordReset},
+			wantPassword:  "brand new password",
+		},
+		{
+			name:         "set first password",
+			newPassword:  "brand new password",
+			wantLogs:     []domain.UserLogAction{domain.UserLogActionPasswordReset},
+			wantPassword: "brand new password",
+		},
+		{
+			name:          "too short",
+			hasCredential: true,
+			newPassword:   "short",
+			wantErr:       new(*domain.ValidationError),
+			wantPassword:  "correct horse battery",
+		},
+		{
+			name:          "suspended after the request",
+			hasCredential: true,
+			setup: func(t *testing.T, store *memory.Store, user *domain.User) {
+				uc := usecase.NewChangeUserStatusUsecase(store, store, store)
+				if _, err := uc.Execute(context.Background(), user.ID, domain.UserLogActionSuspended, "test"); err != nil {
+					t.Fatalf("ChangeUserStatus() unexpected error: %v", err)
+				}
+			},
+			newPassword:  "brand new password",
+			wantErr:      new(*domain.ValidationError),
+			wantLogs:     []domain.UserLogAction{domain.UserLogActionSuspended},
+			wantPassword: "correct horse battery",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			user := mustNewUser(t, "John Doe", "john@example.com")
+			store := memory.NewStore()
+			store.Seed(user)
+			if tt.hasCredential {
+				cred := mustNewCredential(t, hasher, user, "correct horse battery")
+				for range domain.DefaultLockoutPolicy.MaxAttempts {
+					cred.RecordFailure(time.Now(), domain.DefaultLockoutPolicy)
+				}
+				store.SeedCredentials(cred)
+			}
+			token := requestPasswordReset(t, store, user.Email)
+			if tt.setup != nil {
+				tt.setup(t, store, user)
+			}
+			uc := usecase.NewResetPasswordUsecase(store, store, store, store, hasher, store)
+
+			err := uc.Execute(ctx, token, tt.newPassword)
+
+			if tt.wantErr != nil {
+				if err == nil || !errors.As(err, tt.wantErr) {
+					t.Fatalf("Execute() error = %v, want %T", err, tt.wantErr)
+				}
+			} else if err != nil {
+				t.Fatalf("Execute() unexpected error: %v", err)
+			}
+
+			assertUserLogActions(t, store, user.ID, tt.wantLogs...)
+			if tt.wantPassword == "" {
+				return
+			}
+			cred := store.Credential(user.ID)
+			if cred == nil {
+				t.Fatal("Credential() = nil, want stored credential")
+			}
+			if ok, _ := hasher.Verify(cred.PasswordHash, tt.wantPassword); !ok {
+				t.Errorf("stored password does not match %q", tt.wantPassword)
+			}
+			if tt.wantErr == nil && cred.IsLocked(time.Now()) {
+				t.Error("IsLocked() = true after reset, want false")
+			}
+		})
+	}
+}
+
+func TestResetPasswordUsecase_TokenIsSingleUse(t *testing.T) {
+	ctx := context.Background()
+	hasher := newTestPasswordHasher()
+	user := mustNewUser(t, "John Doe", "john@example.com")
+	store := memory.NewStore()
+	store.Seed(user)
+	token := requestPasswordReset(t, store, user.Email)
+	uc := usecase.NewResetPasswordUsecase(store, store, store, store, hasher, store)
+
+	// ポリシー違反ではトークンを使用済みにしないため、やり直せる
+	if err := uc.Execute(ctx, token, "short"); !errors.As(err, new(*domain.ValidationError)) {
+		t.Fatalf("Execute() error = %v, want validation error", err)
+	}
+	if err := uc.Execute(ctx, token, "brand new password"); err != nil {
+		t.Fatalf("Execute() unexpected error: %v", err)
+	}
+	var validationErr *domain.ValidationError
+	if err := uc.Execute(ctx, token, "another new password"); !errors.As(err, &validationErr) || validationErr.Field != "token" {
+		t.Errorf("Execute() twice error = %v, want validation error on token", err)
+	}
+}
+
+func TestResetPasswordUsecase_RevokesSessions(t *testing.T) {
+	ctx := context.Background()
+	user := mustNewUser(t, "John Doe", "john@example.com")
+	store := memory.NewStore()
+	store.Seed(user)
+	store.SeedSessions(newTestSession(user, time.Now()), newTestSession(user, time.Now()))
+	token := requestPasswordReset(t, store, user.Email)
+	uc := usecase.NewResetPasswordUsecase(store, store, store, store, newTestPasswordHasher(), store)
+
+	if err := uc.Execute(ctx, token, "brand new password"); err != nil {
+		t.Fatalf("Execute() unexpected error: %v", err)
+	}
+	assertRevokedSessions(t, store, user.ID, 2)
+	assertUserLogActions(t, store, user.ID, domain.UserLogActionPasswordReset, domain.UserLogActionAllSessionsRevoked)
+}
@@ -0,0 +1,43 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure"
+)
+
+// RevokeAllSessionsUsecase ユーザーのすべてのセッションの取り消しユースケース
+type RevokeAllSessionsUsecase struct {
+	userCommand    UserCommandRepository
+	sessionCommand SessionCommandRepository
+	txManager      TransactionManager
+}
+
+// NewRevokeAllSessionsUsecase RevokeAllSessionsUsecaseのコンストラクタ
+func NewRevokeAllSessionsUsecase(
+	userCommand UserCommandRepository,
+	sessionCommand SessionCommandRepository,
+	txManager TransactionManager,
+) *RevokeAllSessionsUsecase {
+	return &RevokeAllSessionsUsecase{
+		userCommand:    userCommand,
+		sessionCommand: sessionCommand,
+		txManager:      txManager,
+	}
+}
+
+// Execute ユーザーの有効なセッションをすべて取り消す（ユーザーが存在しない場合は ErrUserNotFound）
+func (u *RevokeAllSessionsUsecase) Execute(ctx context.Context, userID string) error {
+	return u.txManager.RunInTransaction(ctx, func(ctx context.Context, tx infrastructure.DBTX) error {
+		user, err := u.userCommand.FindByIDForUpdate(ctx, tx, userID)
+		if err != nil {
+			return err
+		}
+		if user == nil {
+			return domain.ErrUserNotFound(userID)
+		}
+		return revokeUserSessions(ctx, u.userCommand, u.sessionCommand, tx, userID, time.Now())
+	})
+}
@@ -0,0 +1,40 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure/memory"
+	"github.com/example/go-react-cqrs-template/internal/usecase"
+)
+
+func TestRevokeAllSessionsUsecase_Execute(t *testing.T) {
+	ctx := context.Background()
+	user := mustNewUser(t, "John Doe", "john@example.com")
+	other := mustNewUser(t, "Jane Doe", "jane@example.com")
+	now := time.Now()
+	store := memory.NewStore()
+	store.Seed(user, other)
+	store.SeedSessions(newTestSession(user, now), newTestSession(user, now), newTestSession(other, now))
+	uc := usecase.NewRevokeAllSessionsUsecase(store, store, store)
+
+	if err := uc.Execute(ctx, user.ID); err != nil {
+		t.Fatalf("Execute() unexpected error: %v", err)
+	}
+	assertRevokedSessions(t, store, user.ID, 2)
+	assertRevokedSessions(t, store, other.ID, 0)
+	assertUserLogActions(t, store, user.ID, domain.UserLogActionAllSessionsRevoked)
+
+	// 取り消すセッションがなければ記録しない
+	if err := uc.Execute(ctx, user.ID); err != nil {
+		t.Fatalf("Execute() twice unexpected error: %v", err)
+	}
+	assertUserLogActions(t, store, user.ID, domain.UserLogActionAllSessionsRevoked)
+
+	if err := uc.Execute(ctx, "01ARZ3NDEKTSV4RRFFQ69G5FAV"); !errors.As(err, new(*domain.NotFoundError)) {
+		t.Errorf("Execute(unknown) error = %v, want NotFoundError", err)
+	}
+}
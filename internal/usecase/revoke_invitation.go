@@ -0,0 +1,76 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure"
+)
+
+// revokedInvitationReason 招待の取り消しで招待済みのユーザーを無効化する理由
+const revokedInvitationReason = "invitation revoked"
+
+// RevokeInvitationUsecase 招待の取り消しユースケース
+type RevokeInvitationUsecase struct {
+	userCommand       UserCommandRepository
+	invitationCommand InvitationCommandRepository
+	txManager         TransactionManager
+}
+
+// NewRevokeInvitationUsecase RevokeInvitationUsecaseのコンストラクタ
+func NewRevokeInvitationUsecase(
+	userCommand UserCommandRepository,
+	invitationCommand InvitationCommandRepository,
+	txManager TransactionManager,
+) *RevokeInvitationUsecase {
+	return &RevokeInvitationUsecase{
+		userCommand:       userCommand,
+		invitationCommand: invitationCommand,
+		txManager:         txManager,
+	}
+}
+
+// Execute 承諾前の招待を取り消し、招待済みのユーザーを無効化（deactivated）する
+//
+// 承諾済み・取り消し済みの招待は ErrInvitationNotPending。
+func (u *RevokeInvitationUsecase) Execute(ctx context.Context, id string) error {
+	return u.txManager.RunInTransaction(ctx, func(ctx context.Context, tx infrastructure.DBTX) error {
+		inv, err := u.invitationCommand.FindInvitationByIDForUpdate(ctx, tx, id)
+		if err != nil {
+			return err
+		}
+		if inv == nil {
+			return domain.ErrInvitationNotFound(id)
+		}
+		invited, err := u.userCommand.FindByIDForUpdate(ctx, tx, inv.UserID)
+		if err != nil {
+			return err
+		}
+		if invited == nil {
+			return domain.ErrInvitationNotFound(id)
+		}
+
+		if err := inv.Revoke(time.Now()); err != nil {
+			return err
+		}
+		if err := u.invitationCommand.SaveInvitation(ctx, tx, inv); err != nil {
+			return err
+		}
+		if err := u.userCommand.SaveUserLog(ctx, tx, domain.NewUserLog(invited.ID, domain.UserLogActionInvitationRevoked)); err != nil {
+			return err
+		}
+
+		// 招待とは別に無効化などで利用中でなくなったユーザーはそのままにする
+		if invited.Status != domain.UserStatusInvited {
+			return nil
+		}
+		if err := invited.Deactivate(revokedInvitationReason); err != nil {
+			return err
+		}
+		if err := u.userCommand.Save(ctx, tx, invited); err != nil {
+			return err
+		}
+		return u.userCommand.SaveEvents(ctx, tx, invited.PullEvents())
+	})
+}
@@ -0,0 +1,58 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure/memory"
+	"github.com/example/go-react-cqrs-template/internal/usecase"
+)
+
+func TestRevokeInvitationUsecase_Execute(t *testing.T) {
+	ctx := context.Background()
+	store := memory.NewStore()
+	inv, _ := createInvitation(t, store, "john@example.com")
+	uc := usecase.NewRevokeInvitationUsecase(store, store, store)
+
+	if err := uc.Execute(ctx, inv.ID); err != nil {
+		t.Fatalf("Execute() unexpected error: %v", err)
+	}
+
+	if invs := store.Invitations(inv.UserID); len(invs) != 1 || invs[0].Status != domain.InvitationStatusRevoked {
+		t.Errorf("Invitations = %+v, want one revoked invitation", invs)
+	}
+	user, _ := store.FindByID(ctx, inv.UserID)
+	if user.Status != domain.UserStatusDeactivated {
+		t.Errorf("Status = %q, want %q", user.Status, domain.UserStatusDeactivated)
+	}
+	assertUserLogActions(t, store, inv.UserID, domain.UserLogActionInvited, domain.UserLogActionInvitationRevoked)
+	assertUserEventTypes(t, store, inv.UserID, domain.UserEventTypeCreated, domain.UserEventTypeUpdated)
+
+	// 取り消し済みの招待は取り消せない
+	if err := uc.Execute(ctx, inv.ID); !errors.As(err, new(*domain.ConflictError)) {
+		t.Errorf("Execute() twice error = %v, want ConflictError", err)
+	}
+}
+
+func TestRevokeInvitationUsecase_Accepted(t *testing.T) {
+	ctx := context.Background()
+	store := memory.NewStore()
+	inv, token := createInvitation(t, store, "john@example.com")
+	accept := usecase.NewAcceptInvitationUsecase(store, store, store, newTestPasswordHasher(), store)
+	if _, err := accept.Execute(ctx, token, "", invitedPassword); err != nil {
+		t.Fatalf("AcceptInvitation() unexpected error: %v", err)
+	}
+	uc := usecase.NewRevokeInvitationUsecase(store, store, store)
+
+	err := uc.Execute(ctx, inv.ID)
+
+	if !errors.As(err, new(*domain.ConflictError)) {
+		t.Fatalf("Execute() error = %v, want ConflictError", err)
+	}
+	user, _ := store.FindByID(ctx, inv.UserID)
+	if user.Status != domain.UserStatusActive {
+		t.Errorf("Status = %q, want %q", user.Status, domain.UserStatusActive)
+	}
+}
@@ -0,0 +1,59 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure"
+)
+
+// RevokeSessionUsecase ユーザーのセッションの取り消しユースケース
+type RevokeSessionUsecase struct {
+	userCommand    UserCommandRepository
+	sessionCommand SessionCommandRepository
+	txManager      TransactionManager
+}
+
+// NewRevokeSessionUsecase RevokeSessionUsecaseのコンストラクタ
+func NewRevokeSessionUsecase(
+	userCommand UserCommandRepository,
+	sessionCommand SessionCommandRepository,
+	txManager TransactionManager,
+) *RevokeSessionUsecase {
+	return &RevokeSessionUsecase{
+		userCommand:    userCommand,
+		sessionCommand: sessionCommand,
+		txManager:      txManager,
+	}
+}
+
+// Execute ユーザー userID のセッションを取り消し、ユーザーログに記録する
+//
+// ユーザーのセッションでない場合は ErrSessionNotFound。取り消し済みのセッションでは何もしない。
+func (u *RevokeSessionUsecase) Execute(ctx context.Context, userID, sessionID string) error {
+	return u.txManager.RunInTransaction(ctx, func(ctx context.Context, tx infrastructure.DBTX) error {
+		user, err := u.userCommand.FindByIDForUpdate(ctx, tx, userID)
+		if err != nil {
+			return err
+		}
+		if user == nil {
+			return domain.ErrUserNotFound(userID)
+		}
+		session, err := u.sessionCommand.FindSessionByIDForUpdate(ctx, tx, sessionID)
+		if err != nil {
+			return err
+		}
+		if session == nil || session.UserID != userID {
+			return domain.ErrSessionNotFound(sessionID)
+		}
+
+		if !session.Revoke(time.Now()) {
+			return nil
+		}
+		if err := u.sessionCommand.SaveSession(ctx, tx, session); err != nil {
+			return err
+		}
+		return u.userCommand.SaveUserLog(ctx, tx, domain.NewUserLog(userID, domain.UserLogActionSessionRevoked))
+	})
+}
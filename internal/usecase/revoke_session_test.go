@@ -0,0 +1,82 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure/memory"
+	"github.com/example/go-react-cqrs-template/internal/usecase"
+)
+
+func TestRevokeSessionUsecase_Execute(t *testing.T) {
+	user := mustNewUser(t, "John Doe", "john@example.com")
+	other := mustNewUser(t, "Jane Doe", "jane@example.com")
+	now := time.Now()
+	session := newTestSession(user, now)
+	revoked := newTestSession(user, now)
+	revoked.Revoke(now)
+	othersSession := newTestSession(other, now)
+
+	tests := []struct {
+		name        string
+		userID      string
+		sessionID   string
+		wantErr     any
+		wantLogs    []domain.UserLogAction
+		wantRevoked int
+	}{
+		{
+			name:        "revoke",
+			userID:      user.ID,
+			sessionID:   session.ID,
+			wantLogs:    []domain.UserLogAction{domain.UserLogActionSessionRevoked},
+			wantRevoked: 2,
+		},
+		{
+			name:        "already revoked",
+			userID:      user.ID,
+			sessionID:   revoked.ID,
+			wantRevoked: 1,
+		},
+		{
+			name:        "session of another user",
+			userID:      user.ID,
+			sessionID:   othersSession.ID,
+			wantErr:     new(*domain.NotFoundError),
+			wantRevoked: 1,
+		},
+		{
+			name:        "unknown user",
+			userID:      "01ARZ3NDEKTSV4RRFFQ69G5FAV",
+			sessionID:   session.ID,
+			wantErr:     new(*domain.NotFoundError),
+			wantRevoked: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			store := memory.NewStore()
+			store.Seed(user, other)
+			store.SeedSessions(session, revoked, othersSession)
+			uc := usecase.NewRevokeSessionUsecase(store, store, store)
+
+			err := uc.Execute(ctx, tt.userID, tt.sessionID)
+
+			if tt.wantErr != nil {
+				if err == nil || !errors.As(err, tt.wantErr) {
+					t.Fatalf("Execute() error = %v, want %T", err, tt.wantErr)
+				}
+			} else if err != nil {
+				t.Fatalf("Execute() unexpected error: %v", err)
+			}
+			assertUserLogActions(t, store, user.ID, tt.wantLogs...)
+			assertRevokedSessions(t, store, user.ID, tt.wantRevoked)
+			assertRevokedSessions(t, store, other.ID, 0)
+		})
+	}
+}
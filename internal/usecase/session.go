@@ -0,0 +1,36 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure"
+)
+
+// revokeUserSessions ユーザーの有効なセッションをすべて取り消す（トランザクション内で使用）
+//
+// 取り消したセッションがあった場合は UserLogActionAllSessionsRevoked をユーザーログに記録する。
+func revokeUserSessions(
+	ctx context.Context,
+	userCommand UserCommandRepository,
+	sessionCommand SessionCommandRepository,
+	tx infrastructure.DBTX,
+	userID string,
+	now time.Time,
+) error {
+	sessions, err := sessionCommand.FindUnexpiredSessionsForUpdate(ctx, tx, userID, now)
+	if err != nil {
+		return err
+	}
+	if len(sessions) == 0 {
+		return nil
+	}
+	for _, session := range sessions {
+		session.Revoke(now)
+		if err := sessionCommand.SaveSession(ctx, tx, session); err != nil {
+			return err
+		}
+	}
+	return userCommand.SaveUserLog(ctx, tx, domain.NewUserLog(userID, domain.UserLogActionAllSessionsRevoked))
+}
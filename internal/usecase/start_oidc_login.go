@@ -0,0 +1,60 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure"
+)
+
+// StartOIDCLoginUsecase OpenID Connect のログインの開始（IdP への認可リクエストの作成）ユースケース
+type StartOIDCLoginUsecase struct {
+	stateCommand OIDCLoginStateCommandRepository
+	providers    map[string]OIDCProvider
+	txManager    TransactionManager
+	config       OIDCConfig
+}
+
+// NewStartOIDCLoginUsecase StartOIDCLoginUsecaseのコンストラクタ
+//
+// providers は IdP の名前ごとの IdP（パスの {provider} で指定する）。
+func NewStartOIDCLoginUsecase(
+	stateCommand OIDCLoginStateCommandRepository,
+	providers map[string]OIDCProvider,
+	txManager TransactionManager,
+	config OIDCConfig,
+) *StartOIDCLoginUsecase {
+	return &StartOIDCLoginUsecase{
+		stateCommand: stateCommand,
+		providers:    providers,
+		txManager:    txManager,
+		config:       config,
+	}
+}
+
+// Execute IdP provider への認可リクエストの状態（state・nonce・PKCE の code_verifier）を保存し、認可エンドポイントの URL を返す
+//
+// 設定されていない IdP の場合は ErrOIDCProviderNotFound を返す。
+func (u *StartOIDCLoginUsecase) Execute(ctx context.Context, provider string) (*OIDCAuthorization, error) {
+	idp, err := findOIDCProvider(u.providers, provider)
+	if err != nil {
+		return nil, err
+	}
+	state, secret, err := domain.NewOIDCLoginState(provider, u.config.StateTTL)
+	if err != nil {
+		return nil, err
+	}
+	// IdP のディスカバリーに失敗した場合は状態を保存しない
+	url, err := idp.AuthCodeURL(ctx, secret, state.Nonce, state.CodeVerifier)
+	if err != nil {
+		return nil, err
+	}
+
+	err = u.txManager.RunInTransaction(ctx, func(ctx context.Context, tx infrastructure.DBTX) error {
+		return u.stateCommand.SaveOIDCLoginState(ctx, tx, state)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &OIDCAuthorization{URL: url, ExpiresAt: state.ExpiresAt, StateHash: state.StateHash}, nil
+}
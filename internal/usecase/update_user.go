@@ -3,57 +3,68 @@ package usecase
 import (
 	"context"
 
-	"github.com/example/go-react-cqrs-template/internal/command"
 	"github.com/example/go-react-cqrs-template/internal/domain"
 	"github.com/example/go-react-cqrs-template/internal/infrastructure"
 )
 
 // UpdateUserUsecase ユーザー更新ユースケース
 type UpdateUserUsecase struct {
-	userQuery UserQueryRepository
-	txManager TransactionManager
+	userQuery   UserQueryRepository
+	userCommand UserCommandRepository
+	txManager   TransactionManager
 }
 
 // NewUpdateUserUsecase UpdateUserUsecaseのコンストラクタ
 func NewUpdateUserUsecase(
 	userQuery UserQueryRepository,
+	userCommand UserCommandRepository,
 	txManager TransactionManager,
 ) *UpdateUserUsecase {
 	return &UpdateUserUsecase{
-		userQuery: userQuery,
-		txManager: txManager,
+		userQuery:   userQuery,
+		userCommand: userCommand,
+		txManager:   txManager,
 	}
 }
 
-// Execute ユーザーを更新
+// Execute ユーザーの名前とメールアドレスを置き換える（PUT）
 func (u *UpdateUserUsecase) Execute(ctx context.Context, id, name, email string) error {
 	return u.txManager.RunInTransaction(ctx, func(ctx context.Context, tx infrastructure.DBTX) error {
 		// 行ロック付きでユーザーを取得
-		user, err := command.FindByIDForUpdate(ctx, tx, id)
+		user, err := u.userCommand.FindByIDForUpdate(ctx, tx, id)
 		if err != nil {
 			return err
 		}
 		if user == nil {
 			return domain.ErrUserNotFound(id)
 		}
+		return replaceUser(ctx, u.userCommand, tx, user, name, email)
+	})
+}
 
-		// メールアドレスが変更される場合、重複チェック（ロック付き）
-		if email != "" && email != user.Email {
-			existingUser, err := command.FindByEmailForUpdate(ctx, tx, email)
-			if err != nil {
-				return err
-			}
-			if existingUser != nil {
-				return domain.ErrEmailAlreadyExists(email)
-			}
-		}
-
-		// ドメインモデルの更新
-		if err := user.Update(name, email); err != nil {
+// replaceUser 行ロック済みのユーザーの値を置き換えて永続化する
+func replaceUser(ctx context.Context, userCommand UserCommandRepository, tx infrastructure.DBTX, user *domain.User, name, email string) error {
+	// メールアドレスが変更される場合、重複チェック（ロック付き）
+	if email != user.Email {
+		existingUser, err := userCommand.FindByEmailForUpdate(ctx, tx, email)
+		if err != nil {
 			return err
 		}
+		if existingUser != nil {
+			return domain.ErrEmailAlreadyExists(email)
+		}
+	}
 
-		// 永続化
-		return command.Save(ctx, tx, user)
-	})
+	// ドメインモデルの更新
+	if err := user.Update(name, email); err != nil {
+		return err
+	}
+
+	// 永続化
+	if err := userCommand.Save(ctx, tx, user); err != nil {
+		return err
+	}
+
+	// ドメインイベントを outbox に保存
+	return userCommand.SaveEvents(ctx, tx, user.PullEvents())
 }
@@ -0,0 +1,102 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure/memory"
+	"github.com/example/go-react-cqrs-template/internal/usecase"
+)
+
+func TestUpdateUserUsecase_Execute(t *testing.T) {
+	user := mustNewUser(t, "John Doe", "john@example.com")
+	other := mustNewUser(t, "Jane Doe", "jane@example.com")
+
+	tests := []struct {
+		name      string
+		id        string
+		newName   string
+		newEmail  string
+		wantErr   any
+		wantName  string
+		wantEmail string
+	}{
+		{
+			name:      "update name and email",
+			id:        user.ID,
+			newName:   "John Smith",
+			newEmail:  "smith@example.com",
+			wantName:  "John Smith",
+			wantEmail: "smith@example.com",
+		},
+		{
+			name:      "empty name is rejected",
+			id:        user.ID,
+			newEmail:  "smith@example.com",
+			wantErr:   new(*domain.ValidationError),
+			wantName:  "John Doe",
+			wantEmail: "john@example.com",
+		},
+		{
+			name:      "same email is not a conflict",
+			id:        user.ID,
+			newName:   "John Doe",
+			newEmail:  "john@example.com",
+			wantName:  "John Doe",
+			wantEmail: "john@example.com",
+		},
+		{
+			name:      "email taken by another user",
+			id:        user.ID,
+			newName:   "John Doe",
+			newEmail:  "jane@example.com",
+			wantErr:   new(*domain.ConflictError),
+			wantName:  "John Doe",
+			wantEmail: "john@example.com",
+		},
+		{
+			name:     "unknown user",
+			id:       "01ARZ3NDEKTSV4RRFFQ69G5FAV",
+			newName:  "Nobody",
+			newEmail: "nobody@example.com",
+			wantErr:  new(*domain.NotFoundError),
+			wantName: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			store := memory.NewStore()
+			store.Seed(user, other)
+			uc := usecase.NewUpdateUserUsecase(store, store, store)
+
+			err := uc.Execute(ctx, tt.id, tt.newName, tt.newEmail)
+
+			if tt.wantErr != nil {
+				if err == nil || !errors.As(err, tt.wantErr) {
+					t.Fatalf("Execute() error = %v, want %T", err, tt.wantErr)
+				}
+				// 失敗した更新のイベントは outbox に残らない
+				assertUserEventTypes(t, store, tt.id)
+			} else if err != nil {
+				t.Fatalf("Execute() unexpected error: %v", err)
+			} else {
+				assertUserEventTypes(t, store, tt.id, domain.UserEventTypeUpdated)
+			}
+
+			if tt.wantName == "" {
+				return
+			}
+			got, _ := store.FindByID(ctx, tt.id)
+			if got.Name != tt.wantName {
+				t.Errorf("Name = %v, want %v", got.Name, tt.wantName)
+			}
+			if got.Email != tt.wantEmail {
+				t.Errorf("Email = %v, want %v", got.Email, tt.wantEmail)
+			}
+		})
+	}
+}
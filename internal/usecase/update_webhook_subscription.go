@@ -0,0 +1,56 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure"
+)
+
+// UpdateWebhookSubscriptionUsecase Webhook購読更新ユースケース
+type UpdateWebhookSubscriptionUsecase struct {
+	webhookCommand WebhookCommandRepository
+	txManager      TransactionManager
+}
+
+// NewUpdateWebhookSubscriptionUsecase UpdateWebhookSubscriptionUsecaseのコンストラクタ
+func NewUpdateWebhookSubscriptionUsecase(
+	webhookCommand WebhookCommandRepository,
+	txManager TransactionManager,
+) *UpdateWebhookSubscriptionUsecase {
+	return &UpdateWebhookSubscriptionUsecase{
+		webhookCommand: webhookCommand,
+		txManager:      txManager,
+	}
+}
+
+// Execute Webhook購読を更新し、更新後の購読を返す（空文字・nil の項目は変更しない）
+func (u *UpdateWebhookSubscriptionUsecase) Execute(ctx context.Context, id, url, secret string, eventTypes []domain.UserEventType, active *bool) (*domain.WebhookSubscription, error) {
+	var updated *domain.WebhookSubscription
+	err := u.txManager.RunInTransaction(ctx, func(ctx context.Context, tx infrastructure.DBTX) error {
+		// 行ロック付きで購読を取得
+		sub, err := u.webhookCommand.FindSubscriptionByIDForUpdate(ctx, tx, id)
+		if err != nil {
+			return err
+		}
+		if sub == nil {
+			return domain.ErrWebhookSubscriptionNotFound(id)
+		}
+
+		// ドメインモデルの更新
+		if err := sub.Update(url, secret, eventTypes, active); err != nil {
+			return err
+		}
+
+		// 永続化
+		if err := u.webhookCommand.SaveSubscription(ctx, tx, sub); err != nil {
+			return err
+		}
+		updated = sub
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return updated, nil
+}
@@ -0,0 +1,83 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure/memory"
+	"github.com/example/go-react-cqrs-template/internal/usecase"
+)
+
+func TestUpdateWebhookSubscriptionUsecase_Execute(t *testing.T) {
+	sub := mustNewWebhookSubscription(t, "https://example.com/hooks", domain.UserEventTypeCreated)
+	inactive := false
+
+	tests := []struct {
+		name       string
+		id         string
+		url        string
+		events     []domain.UserEventType
+		active     *bool
+		wantErr    any
+		wantURL    string
+		wantActive bool
+	}{
+		{
+			name:       "update url and deactivate",
+			id:         sub.ID,
+			url:        "https://example.com/v2/hooks",
+			active:     &inactive,
+			wantURL:    "https://example.com/v2/hooks",
+			wantActive: false,
+		},
+		{
+			name:       "empty values keep existing",
+			id:         sub.ID,
+			wantURL:    "https://example.com/hooks",
+			wantActive: true,
+		},
+		{
+			name:       "empty event list is rejected",
+			id:         sub.ID,
+			events:     []domain.UserEventType{},
+			wantErr:    new(*domain.ValidationError),
+			wantURL:    "https://example.com/hooks",
+			wantActive: true,
+		},
+		{
+			name:    "unknown subscription",
+			id:      "01ARZ3NDEKTSV4RRFFQ69G5FAV",
+			url:     "https://example.com/other",
+			wantErr: new(*domain.NotFoundError),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			store := memory.NewStore()
+			store.SeedWebhookSubscriptions(sub)
+			uc := usecase.NewUpdateWebhookSubscriptionUsecase(store, store)
+
+			_, err := uc.Execute(ctx, tt.id, tt.url, "", tt.events, tt.active)
+
+			if tt.wantErr != nil {
+				if err == nil || !errors.As(err, tt.wantErr) {
+					t.Fatalf("Execute() error = %v, want %T", err, tt.wantErr)
+				}
+			} else if err != nil {
+				t.Fatalf("Execute() unexpected error: %v", err)
+			}
+
+			if tt.wantURL == "" {
+				return
+			}
+			saved, _ := store.FindSubscriptionByID(ctx, tt.id)
+			if saved.URL != tt.wantURL || saved.Active != tt.wantActive {
+				t.Errorf("saved subscription = %+v, want url %s active %v", saved, tt.wantURL, tt.wantActive)
+			}
+		})
+	}
+}
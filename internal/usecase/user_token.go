@@ -0,0 +1,133 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure"
+	"github.com/example/go-react-cqrs-template/internal/mail"
+)
+
+// UserTokenConfig メールで送るトークンの設定
+type UserTokenConfig struct {
+	// BaseURL はメールのリンクの基準となるフロントエンドの URL
+	BaseURL string
+	// EmailVerificationTTL はメールアドレスの確認のトークンの有効期間
+	EmailVerificationTTL time.Duration
+	// PasswordResetTTL はパスワードのリセットのトークンの有効期間
+	PasswordResetTTL time.Duration
+	// InvitationTTL は招待の有効期間
+	InvitationTTL time.Duration
+}
+
+// DefaultUserTokenConfig 既定のトークンの設定（確認は24時間、リセットは1時間、招待は7日間有効）
+var DefaultUserTokenConfig = UserTokenConfig{
+	BaseURL:              "http://localhost:5173",
+	EmailVerificationTTL: 24 * time.Hour,
+	PasswordResetTTL:     time.Hour,
+	InvitationTTL:        7 * 24 * time.Hour,
+}
+
+// userTokenLink トークンを受け取るフロントエンドのページの URL
+//
+// トークンは組織内で照合するため、組織IDも含める（確認時は X-Org-ID で指定する）。
+func (c UserTokenConfig) userTokenLink(path, orgID, token string) string {
+	q := url.Values{}
+	q.Set("orgId", orgID)
+	q.Set("token", token)
+	return strings.TrimRight(c.BaseURL, "/") + path + "?" + q.Encode()
+}
+
+// emailVerificationMessage メールアドレスの確認のメール
+func emailVerificationMessage(user *domain.User, link string, expiresAt time.Time) mail.Message {
+	return mail.Message{
+		To:      user.Email,
+		Subject: "メールアドレスの確認",
+		Body: fmt.Sprintf(`%s さん
+
+以下のリンクを開いて、メールアドレスの確認を完了してください。
+
+%s
+
+このリンクは %s まで有効で、一度だけ使用できます。
+心当たりがない場合は、このメールを破棄してください。
+`, user.Name, link, expiresAt.Format(time.RFC3339)),
+	}
+}
+
+// passwordResetMessage パスワードのリセットのメール
+func passwordResetMessage(user *domain.User, link string, expiresAt time.Time) mail.Message {
+	return mail.Message{
+		To:      user.Email,
+		Subject: "パスワードの再設定",
+		Body: fmt.Sprintf(`%s さん
+
+パスワードの再設定を受け付けました。以下のリンクを開いて、新しいパスワードを設定してください。
+
+%s
+
+このリンクは %s まで有効で、一度だけ使用できます。
+心当たりがない場合は、このメールを破棄してください（パスワードは変更されません）。
+`, user.Name, link, expiresAt.Format(time.RFC3339)),
+	}
+}
+
+// issueUserToken ユーザーの未使用の同じ用途のトークンを無効化し、新しいトークンを保存する（トランザクション内で使用）
+func issueUserToken(
+	ctx context.Context,
+	tokenCommand UserTokenCommandRepository,
+	tx infrastructure.DBTX,
+	user *domain.User,
+	purpose domain.UserTokenPurpose,
+	ttl time.Duration,
+) (*domain.UserToken, string, error) {
+	token, secret, err := domain.NewUserToken(user, purpose, ttl)
+	if err != nil {
+		return nil, "", err
+	}
+	if err := tokenCommand.RevokeUserTokens(ctx, tx, user.ID, purpose, token.CreatedAt); err != nil {
+		return nil, "", err
+	}
+	if err := tokenCommand.SaveUserToken(ctx, tx, token); err != nil {
+		return nil, "", err
+	}
+	return token, secret, nil
+}
+
+// useUserToken トークンを照合して使用済みにし、対象のユーザーを行ロック付きで返す（トランザクション内で使用）
+//
+// トークンが存在しない・使用済み・期限切れの場合と、発行後にメールアドレスが変更された場合は ErrUserTokenInvalid を返す。
+func useUserToken(
+	ctx context.Context,
+	userCommand UserCommandRepository,
+	tokenCommand UserTokenCommandRepository,
+	tx infrastructure.DBTX,
+	purpose domain.UserTokenPurpose,
+	secret string,
+) (*domain.User, error) {
+	token, err := tokenCommand.FindUserTokenForUpdate(ctx, tx, purpose, domain.HashUserToken(secret))
+	if err != nil {
+		return nil, err
+	}
+	if token == nil {
+		return nil, domain.ErrUserTokenInvalid()
+	}
+	user, err := userCommand.FindByIDForUpdate(ctx, tx, token.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil || user.Email != token.Email {
+		return nil, domain.ErrUserTokenInvalid()
+	}
+	if err := token.Use(time.Now()); err != nil {
+		return nil, err
+	}
+	if err := tokenCommand.SaveUserToken(ctx, tx, token); err != nil {
+		return nil, err
+	}
+	return user, nil
+}
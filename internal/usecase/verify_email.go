@@ -0,0 +1,59 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure"
+)
+
+// VerifyEmailUsecase メールで送ったトークンによるメールアドレスの確認ユースケース
+type VerifyEmailUsecase struct {
+	userCommand  UserCommandRepository
+	tokenCommand UserTokenCommandRepository
+	txManager    TransactionManager
+}
+
+// NewVerifyEmailUsecase VerifyEmailUsecaseのコンストラクタ
+func NewVerifyEmailUsecase(
+	userCommand UserCommandRepository,
+	tokenCommand UserTokenCommandRepository,
+	txManager TransactionManager,
+) *VerifyEmailUsecase {
+	return &VerifyEmailUsecase{
+		userCommand:  userCommand,
+		tokenCommand: tokenCommand,
+		txManager:    txManager,
+	}
+}
+
+// Execute トークンを使用済みにし、ユーザーのメールアドレスを確認済みにする
+//
+// トークンが無効な場合は ErrUserTokenInvalid を返す。確認済みのユーザーは変更せずにそのまま返す。
+func (u *VerifyEmailUsecase) Execute(ctx context.Context, token string) (*domain.User, error) {
+	var result *domain.User
+	err := u.txManager.RunInTransaction(ctx, func(ctx context.Context, tx infrastructure.DBTX) error {
+		user, err := useUserToken(ctx, u.userCommand, u.tokenCommand, tx, domain.UserTokenPurposeEmailVerification, token)
+		if err != nil {
+			return err
+		}
+		result = user
+		if user.IsEmailVerified() {
+			return nil
+		}
+
+		user.VerifyEmail(time.Now())
+		if err := u.userCommand.Save(ctx, tx, user); err != nil {
+			return err
+		}
+		if err := u.userCommand.SaveUserLog(ctx, tx, domain.NewUserLog(user.ID, domain.UserLogActionEmailVerified)); err != nil {
+			return err
+		}
+		return u.userCommand.SaveEvents(ctx, tx, user.PullEvents())
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
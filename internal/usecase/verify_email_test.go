@@ -0,0 +1,108 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure/memory"
+	"github.com/example/go-react-cqrs-template/internal/testutil/mailtest"
+	"github.com/example/go-react-cqrs-template/internal/usecase"
+)
+
+// requestEmailVerification 確認のメールを送り、リンクのトークンを返す
+func requestEmailVerification(t *testing.T, store *memory.Store, userID string) string {
+	t.Helper()
+	mailer := &recordingMailer{}
+	uc := usecase.NewRequestEmailVerificationUsecase(store, store, mailer, store, usecase.DefaultUserTokenConfig)
+	if err := uc.Execute(context.Background(), userID); err != nil {
+		t.Fatalf("RequestEmailVerification() unexpected error: %v", err)
+	}
+	return mailtest.LinkToken(t, mailer.sent()[0])
+}
+
+func TestVerifyEmailUsecase_Execute(t *testing.T) {
+	ctx := context.Background()
+	user := mustNewUser(t, "John Doe", "john@example.com")
+	store := memory.NewStore()
+	store.Seed(user)
+	token := requestEmailVerification(t, store, user.ID)
+	uc := usecase.NewVerifyEmailUsecase(store, store, store)
+
+	got, err := uc.Execute(ctx, token)
+	if err != nil {
+		t.Fatalf("Execute() unexpected error: %v", err)
+	}
+	if got.ID != user.ID || !got.IsEmailVerified() {
+		t.Errorf("Execute() = %+v, want verified user %s", got, user.ID)
+	}
+	assertUserLogActions(t, store, user.ID, domain.UserLogActionEmailVerified)
+	assertUserEventTypes(t, store, user.ID, domain.UserEventTypeUpdated)
+
+	// トークンは一度しか使えない
+	if _, err := uc.Execute(ctx, token); !errors.As(err, new(*domain.ValidationError)) {
+		t.Errorf("Execute() twice error = %v, want validation error", err)
+	}
+}
+
+func TestVerifyEmailUsecase_InvalidToken(t *testing.T) {
+	tests := []struct {
+		name  string
+		setup func(t *testing.T, store *memory.Store, user *domain.User) string
+	}{
+		{
+			name: "unknown token",
+			setup: func(*testing.T, *memory.Store, *domain.User) string {
+				return "unknown"
+			},
+		},
+		{
+			name: "superseded by a newer request",
+			setup: func(t *testing.T, store *memory.Store, user *domain.User) string {
+				token := requestEmailVerification(t, store, user.ID)
+				requestEmailVerification(t, store, user.ID)
+				return token
+			},
+		},
+		{
+			name: "email changed after the request",
+			setup: func(t *testing.T, store *memory.Store, user *domain.User) string {
+				token := requestEmailVerification(t, store, user.ID)
+				uc := usecase.NewUpdateUserUsecase(store, store, store)
+				if err := uc.Execute(context.Background(), user.ID, user.Name, "new@example.com"); err != nil {
+					t.Fatalf("UpdateUser() unexpected error: %v", err)
+				}
+				return token
+			},
+		},
+		{
+			name: "password reset token",
+			setup: func(t *testing.T, store *memory.Store, user *domain.User) string {
+				return requestPasswordReset(t, store, user.Email)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			user := mustNewUser(t, "John Doe", "john@example.com")
+			store := memory.NewStore()
+			store.Seed(user)
+			token := tt.setup(t, store, user)
+			uc := usecase.NewVerifyEmailUsecase(store, store, store)
+
+			_, err := uc.Execute(context.Background(), token)
+
+			var validationErr *domain.ValidationError
+			if !errors.As(err, &validationErr) || validationErr.Field != "token" {
+				t.Fatalf("Execute() error = %v, want validation error on token", err)
+			}
+			for _, log := range store.UserLogs(user.ID) {
+				if log.Action == domain.UserLogActionEmailVerified {
+					t.Errorf("UserLogs contains %s, want none", log.Action)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,149 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure"
+	"github.com/example/go-react-cqrs-template/internal/tenant"
+)
+
+// VerifyMFALoginUsecase 二要素認証のログインの2段階目（確認コードの照合）ユースケース
+type VerifyMFALoginUsecase struct {
+	userCommand       UserCommandRepository
+	credentialCommand CredentialCommandRepository
+	totpCommand       TOTPCredentialCommandRepository
+	tokenCommand      UserTokenCommandRepository
+	sessionCommand    SessionCommandRepository
+	totp              TOTPAuthenticator
+	sessions          SessionIssuer
+	txManager         TransactionManager
+	lockout           domain.LockoutPolicy
+	sessionPolicy     domain.SessionPolicy
+}
+
+// NewVerifyMFALoginUsecase VerifyMFALoginUsecaseのコンストラクタ
+func NewVerifyMFALoginUsecase(
+	userCommand UserCommandRepository,
+	credentialCommand CredentialCommandRepository,
+	totpCommand TOTPCredentialCommandRepository,
+	tokenCommand UserTokenCommandRepository,
+	sessionCommand SessionCommandRepository,
+	totp TOTPAuthenticator,
+	sessions SessionIssuer,
+	txManager TransactionManager,
+	lockout domain.LockoutPolicy,
+	sessionPolicy domain.SessionPolicy,
+) *VerifyMFALoginUsecase {
+	return &VerifyMFALoginUsecase{
+		userCommand:       userCommand,
+		credentialCommand: credentialCommand,
+		totpCommand:       totpCommand,
+		tokenCommand:      tokenCommand,
+		sessionCommand:    sessionCommand,
+		totp:              totp,
+		sessions:          sessions,
+		txManager:         txManager,
+		lockout:           lockout,
+		sessionPolicy:     sessionPolicy,
+	}
+}
+
+// Execute ログインの2段階目のトークン mfaToken と確認コードまたはリカバリーコード code を照合し、
+// 成功した場合は client のセッションを作成してトークンを発行する
+//
+// トークンが存在しない・使用済み・期限切れの場合と、発行後にメールアドレスの変更や二要素認証の無効化が
+// あった場合は ErrMFAChallengeInvalid を返す。コードが一致しない場合はパスワードと同じく失敗として記録して
+// ErrInvalidMFACode を返し、トークンは有効期限まで再試行に使用できる。ロック中はコードを照合するが
+// 結果は使わず、一致しても一致しなくても ErrAccountLocked を返す。
+// 成功すると失敗回数をリセットし、リカバリーコードを使用した場合はそのこともユーザーログに記録する。
+func (u *VerifyMFALoginUsecase) Execute(ctx context.Context, mfaToken, code string, client domain.SessionClient) (*LoginResult, error) {
+	var (
+		user    *domain.User
+		session *domain.Session
+		authErr error
+	)
+	err := u.txManager.RunInTransaction(ctx, func(ctx context.Context, tx infrastructure.DBTX) error {
+		now := time.Now()
+		challenge, err := u.tokenCommand.FindUserTokenForUpdate(ctx, tx, domain.UserTokenPurposeMFAChallenge, domain.HashUserToken(mfaToken))
+		if err != nil {
+			return err
+		}
+		if challenge == nil || !challenge.IsUsable(now) {
+			return domain.ErrMFAChallengeInvalid()
+		}
+		found, err := u.userCommand.FindByIDForUpdate(ctx, tx, challenge.UserID)
+		if err != nil {
+			return err
+		}
+		if found == nil || found.Email != challenge.Email {
+			return domain.ErrMFAChallengeInvalid()
+		}
+		if found.Status != domain.UserStatusActive {
+			return domain.ErrUserNotActive(found.ID, found.Status)
+		}
+		cred, err := u.credentialCommand.FindCredentialForUpdate(ctx, tx, found.ID)
+		if err != nil {
+			return err
+		}
+		totpCred, err := u.totpCommand.FindTOTPCredentialForUpdate(ctx, tx, found.ID)
+		if err != nil {
+			return err
+		}
+		if cred == nil || totpCred == nil || !totpCred.IsEnabled() {
+			return domain.ErrMFAChallengeInvalid()
+		}
+
+		recovery, ok := useMFACode(u.totp, totpCred, code, now)
+		if cred.IsLocked(now) {
+			// ロック中はコードによらず同じ応答を返す（使用したリカバリーコードも保存しない）
+			return domain.ErrAccountLocked(found.ID)
+		}
+		if !ok {
+			// ログインは拒否するが、失敗の記録はコミットする
+			authErr = domain.ErrInvalidMFACode()
+			return recordPasswordFailure(ctx, u.userCommand, u.credentialCommand, tx, cred, u.lockout, now)
+		}
+
+		if err := challenge.Use(now); err != nil {
+			return err
+		}
+		if err := u.tokenCommand.SaveUserToken(ctx, tx, challenge); err != nil {
+			return err
+		}
+		if err := u.totpCommand.SaveTOTPCredential(ctx, tx, totpCred); err != nil {
+			return err
+		}
+		cred.RecordSuccess(now)
+		if err := u.credentialCommand.SaveCredential(ctx, tx, cred); err != nil {
+			return err
+		}
+		if recovery {
+			if err := u.userCommand.SaveUserLog(ctx, tx, domain.NewUserLog(found.ID, domain.UserLogActionMFARecoveryCodeUsed)); err != nil {
+				return err
+			}
+		}
+		if err := u.userCommand.SaveUserLog(ctx, tx, domain.NewUserLog(found.ID, domain.UserLogActionLoginSucceeded)); err != nil {
+			return err
+		}
+		created := domain.NewSession(found.ID, client, u.sessionPolicy, now)
+		if err := u.sessionCommand.SaveSession(ctx, tx, created); err != nil {
+			return err
+		}
+		user, session = found, created
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if authErr != nil {
+		return nil, authErr
+	}
+
+	token, err := u.sessions.Issue(user.ID, tenant.OrgID(ctx), session.ID, session.CreatedAt, session.ExpiresAt)
+	if err != nil {
+		return nil, err
+	}
+	return &LoginResult{User: user, Session: session, Token: token, ExpiresAt: session.ExpiresAt}, nil
+}
@@ -0,0 +1,153 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure/memory"
+	"github.com/example/go-react-cqrs-template/internal/usecase"
+)
+
+func TestVerifyMFALoginUsecase_Execute(t *testing.T) {
+	ctx := context.Background()
+	hasher := newTestPasswordHasher()
+	user := mustNewUser(t, "John Doe", "john@example.com")
+	totpCred, recoveryCodes := mustNewEnabledTOTPCredential(t, user)
+	store := memory.NewStore()
+	store.Seed(user)
+	store.SeedCredentials(mustNewCredential(t, hasher, user, "correct horse battery"))
+	store.SeedTOTPCredentials(totpCred)
+	sessions := mustNewTokenIssuer(t)
+	login := usecase.NewLoginUsecase(store, store, store, store, store, store, hasher, sessions, store, domain.DefaultLockoutPolicy, domain.DefaultSessionPolicy, domain.DefaultMFAPolicy)
+	uc := usecase.NewVerifyMFALoginUsecase(store, store, store, store, store, testTOTP, sessions, store, domain.DefaultLockoutPolicy, domain.DefaultSessionPolicy)
+
+	challenge, err := login.Execute(ctx, "john@example.com", "correct horse battery", testSessionClient)
+	if err != nil {
+		t.Fatalf("login unexpected error: %v", err)
+	}
+
+	// 誤ったコードは失敗として記録し、トークンは再試行に使用できる
+	if _, err := uc.Execute(ctx, challenge.MFAToken, "aaaa-bbbb-cccc-dddd", testSessionClient); !errors.As(err, new(*domain.AuthenticationError)) {
+		t.Fatalf("Execute() with wrong code error = %v, want AuthenticationError", err)
+	}
+	if cred := store.Credential(user.ID); cred.FailedAttempts != 1 {
+		t.Errorf("FailedAttempts = %d, want 1", cred.FailedAttempts)
+	}
+
+	code := totpCode(t, totpCred.Secret, time.Now())
+	got, err := uc.Execute(ctx, challenge.MFAToken, code, testSessionClient)
+	if err != nil {
+		t.Fatalf("Execute() unexpected error: %v", err)
+	}
+	claims, err := sessions.Parse(got.Token, time.Now())
+	if err != nil || claims.UserID != user.ID || claims.SessionID != got.Session.ID {
+		t.Errorf("Parse(Execute().Token) = %+v, %v, want token for session %s", claims, err, got.Session.ID)
+	}
+	if cred := store.Credential(user.ID); cred.FailedAttempts != 0 {
+		t.Errorf("FailedAttempts = %d, want 0 after success", cred.FailedAttempts)
+	}
+
+	// トークンは一度限り
+	if _, err := uc.Execute(ctx, challenge.MFAToken, code, testSessionClient); !errors.As(err, new(*domain.AuthenticationError)) {
+		t.Errorf("Execute() with used token error = %v, want AuthenticationError", err)
+	}
+
+	// 同じ確認コードは再使用できず、リカバリーコードは一度だけ使用できる
+	challenge, err = login.Execute(ctx, "john@example.com", "correct horse battery", testSessionClient)
+	if err != nil {
+		t.Fatalf("login unexpected error: %v", err)
+	}
+	if _, err := uc.Execute(ctx, challenge.MFAToken, code, testSessionClient); !errors.As(err, new(*domain.AuthenticationError)) {
+		t.Errorf("Execute() with replayed code error = %v, want AuthenticationError", err)
+	}
+	if _, err := uc.Execute(ctx, challenge.MFAToken, recoveryCodes[0], testSessionClient); err != nil {
+		t.Fatalf("Execute() with recovery code unexpected error: %v", err)
+	}
+	if cred := store.TOTPCredential(user.ID); len(cred.RecoveryCodeHashes) != domain.RecoveryCodeCount-1 {
+		t.Errorf("len(RecoveryCodeHashes) = %d, want %d", len(cred.RecoveryCodeHashes), domain.RecoveryCodeCount-1)
+	}
+	challenge, err = login.Execute(ctx, "john@example.com", "correct horse battery", testSessionClient)
+	if err != nil {
+		t.Fatalf("login unexpected error: %v", err)
+	}
+	if _, err := uc.Execute(ctx, challenge.MFAToken, recoveryCodes[0], testSessionClient); !errors.As(err, new(*domain.AuthenticationError)) {
+		t.Errorf("Execute() with used recovery code error = %v, want AuthenticationError", err)
+	}
+
+	if len(store.Sessions(user.ID)) != 2 {
+		t.Errorf("Sessions() = %d, want 2", len(store.Sessions(user.ID)))
+	}
+	assertUserLogActions(t, store, user.ID,
+		domain.UserLogActionLoginFailed,
+		domain.UserLogActionLoginSucceeded,
+		domain.UserLogActionLoginFailed,
+		domain.UserLogActionMFARecoveryCodeUsed,
+		domain.UserLogActionLoginSucceeded,
+		domain.UserLogActionLoginFailed,
+	)
+}
+
+func TestVerifyMFALoginUsecase_Locked(t *testing.T) {
+	ctx := context.Background()
+	hasher := newTestPasswordHasher()
+	user := mustNewUser(t, "John Doe", "john@example.com")
+	totpCred, recoveryCodes := mustNewEnabledTOTPCredential(t, user)
+	cred := mustNewCredential(t, hasher, user, "correct horse battery")
+	store := memory.NewStore()
+	store.Seed(user)
+	store.SeedCredentials(cred)
+	store.SeedTOTPCredentials(totpCred)
+	sessions := mustNewTokenIssuer(t)
+	login := usecase.NewLoginUsecase(store, store, store, store, store, store, hasher, sessions, store, domain.DefaultLockoutPolicy, domain.DefaultSessionPolicy, domain.DefaultMFAPolicy)
+	uc := usecase.NewVerifyMFALoginUsecase(store, store, store, store, store, testTOTP, sessions, store, domain.DefaultLockoutPolicy, domain.DefaultSessionPolicy)
+
+	challenge, err := login.Execute(ctx, "john@example.com", "correct horse battery", testSessionClient)
+	if err != nil {
+		t.Fatalf("login unexpected error: %v", err)
+	}
+	// 2段階目の途中で（他のトークンでの失敗により）ロックされた
+	cred.LockedUntil = time.Now().Add(time.Hour)
+	store.SeedCredentials(cred)
+
+	// ロック中はコードが一致してもしなくても同じ応答を返し、失敗もリカバリーコードの使用も記録しない
+	for _, code := range []string{"aaaa-bbbb-cccc-dddd", totpCode(t, totpCred.Secret, time.Now()), recoveryCodes[0]} {
+		_, err := uc.Execute(ctx, challenge.MFAToken, code, testSessionClient)
+		if err == nil || err.Error() != domain.ErrAccountLocked(user.ID).Error() {
+			t.Errorf("Execute(%q) while locked error = %v, want ErrAccountLocked", code, err)
+		}
+	}
+	if got := store.Credential(user.ID); got.FailedAttempts != 0 {
+		t.Errorf("FailedAttempts = %d, want 0", got.FailedAttempts)
+	}
+	if got := store.TOTPCredential(user.ID); len(got.RecoveryCodeHashes) != domain.RecoveryCodeCount {
+		t.Errorf("len(RecoveryCodeHashes) = %d, want %d", len(got.RecoveryCodeHashes), domain.RecoveryCodeCount)
+	}
+	if len(store.Sessions(user.ID)) != 0 {
+		t.Error("session created while locked")
+	}
+	assertUserLogActions(t, store, user.ID)
+}
+
+func TestVerifyMFALoginUsecase_InvalidChallenge(t *testing.T) {
+	ctx := context.Background()
+	hasher := newTestPasswordHasher()
+	user := mustNewUser(t, "John Doe", "john@example.com")
+	totpCred, _ := mustNewEnabledTOTPCredential(t, user)
+	store := memory.NewStore()
+	store.Seed(user)
+	store.SeedCredentials(mustNewCredential(t, hasher, user, "correct horse battery"))
+	store.SeedTOTPCredentials(totpCred)
+	uc := usecase.NewVerifyMFALoginUsecase(store, store, store, store, store, testTOTP, mustNewTokenIssuer(t), store, domain.DefaultLockoutPolicy, domain.DefaultSessionPolicy)
+
+	// 存在しないトークンは区別せずに拒否し、失敗も記録しない
+	if _, err := uc.Execute(ctx, "unknown", totpCode(t, totpCred.Secret, time.Now()), testSessionClient); !errors.As(err, new(*domain.AuthenticationError)) {
+		t.Errorf("Execute() with unknown token error = %v, want AuthenticationError", err)
+	}
+	assertUserLogActions(t, store, user.ID)
+	if len(store.Sessions(user.ID)) != 0 {
+		t.Error("session created with an unknown token")
+	}
+}
@@ -0,0 +1,57 @@
+package usercache
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+// listenerPingInterval 通知のない間も切断を検知するための疎通確認の間隔
+const listenerPingInterval = time.Minute
+
+// Listen PostgreSQL の channel を LISTEN し、通知されたユーザー（ペイロードのID）のエントリを削除する
+//
+// 他のレプリカでコミットされた変更をキャッシュに反映するために使用する（channel は command.UserChangedChannel）。
+// 再接続時は切断中の通知を取りこぼした可能性があるため、すべてのエントリを削除する。
+// ctx がキャンセルされると LISTEN を終了する。
+func (c *Cache) Listen(ctx context.Context, dsn, channel string, log *slog.Logger) error {
+	listener := pq.NewListener(dsn, time.Second, time.Minute, func(ev pq.ListenerEventType, err error) {
+		if err != nil {
+			log.Warn("user cache listener connection problem",
+				slog.String("channel", channel),
+				slog.String("error", err.Error()),
+			)
+		}
+	})
+	if err := listener.Listen(channel); err != nil {
+		listener.Close()
+		return fmt.Errorf("failed to listen on %s: %w", channel, err)
+	}
+
+	go func() {
+		defer listener.Close()
+		ping := time.NewTicker(listenerPingInterval)
+		defer ping.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case n := <-listener.Notify:
+				// 再接続時は nil が届く
+				if n == nil {
+					c.Purge()
+					continue
+				}
+				c.Invalidate(n.Extra)
+			case <-ping.C:
+				if err := listener.Ping(); err != nil {
+					log.Warn("user cache listener ping failed", slog.String("error", err.Error()))
+				}
+			}
+		}
+	}()
+	return nil
+}
@@ -0,0 +1,59 @@
+package usercache
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/example/go-react-cqrs-template/internal/command"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure"
+	"github.com/example/go-react-cqrs-template/internal/queryservice"
+	"github.com/example/go-react-cqrs-template/internal/testutil/dbtest"
+	"github.com/example/go-react-cqrs-template/internal/usecase"
+)
+
+func TestCache_Listen_InvalidatesOnNotify(t *testing.T) {
+	db := dbtest.New(t)
+	cfg, _ := dbtest.Config()
+	log := slog.New(slog.NewTextHandler(io.Discard, nil))
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	keyring := dbtest.Keyring(t)
+	c := New(queryservice.NewUserQueryService(db, keyring))
+	if err := c.Listen(ctx, cfg.DSN(), command.UserChangedChannel, log); err != nil {
+		t.Fatalf("Listen() unexpected error: %v", err)
+	}
+
+	// CommandRepository を経由しない（他のレプリカでの）変更は NOTIFY でのみ無効化される
+	tm := infrastructure.NewTransactionManager(db)
+	query := queryservice.NewUserQueryService(db, keyring)
+	repo := command.NewUserRepository(keyring)
+	user, err := usecase.NewCreateUserUsecase(query, repo, tm).Execute(ctx, "John Doe", "john@example.com")
+	if err != nil {
+		t.Fatalf("CreateUser unexpected error: %v", err)
+	}
+	if _, err := c.FindByID(ctx, user.ID); err != nil {
+		t.Fatalf("FindByID() unexpected error: %v", err)
+	}
+	if err := usecase.NewUpdateUserUsecase(query, repo, tm).Execute(ctx, user.ID, "John Smith", "john@example.com"); err != nil {
+		t.Fatalf("UpdateUser unexpected error: %v", err)
+	}
+
+	deadline := time.Now().Add(10 * time.Second)
+	for {
+		got, err := c.FindByID(ctx, user.ID)
+		if err != nil {
+			t.Fatalf("FindByID() unexpected error: %v", err)
+		}
+		if got.Name == "John Smith" {
+			return
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("cache entry was not invalidated after commit")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
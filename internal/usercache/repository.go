@@ -0,0 +1,43 @@
+package usercache
+
+import (
+	"context"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure"
+	"github.com/example/go-react-cqrs-template/internal/usecase"
+)
+
+// CommandRepository コマンド側のリポジトリをラップし、保存・削除したユーザーをコミット後に Cache から削除する
+// （usecase.UserCommandRepository の実装）
+//
+// コミット前に削除すると、コミットまでの間の読み込みで変更前の値が再びキャッシュされるため、
+// infrastructure.AfterCommit でコミット後に削除する。ロールバックした場合は削除しない。
+type CommandRepository struct {
+	usecase.UserCommandRepository
+	cache *Cache
+}
+
+// NewCommandRepository CommandRepositoryのコンストラクタ
+func NewCommandRepository(repo usecase.UserCommandRepository, cache *Cache) *CommandRepository {
+	return &CommandRepository{UserCommandRepository: repo, cache: cache}
+}
+
+// Save ユーザーを保存し、コミット後にキャッシュから削除する
+func (r *CommandRepository) Save(ctx context.Context, tx infrastructure.DBTX, user *domain.User) error {
+	if err := r.UserCommandRepository.Save(ctx, tx, user); err != nil {
+		return err
+	}
+	id := user.ID
+	infrastructure.AfterCommit(ctx, func() { r.cache.Invalidate(id) })
+	return nil
+}
+
+// Delete ユーザーを削除し、コミット後にキャッシュから削除する
+func (r *CommandRepository) Delete(ctx context.Context, tx infrastructure.DBTX, id string) error {
+	if err := r.UserCommandRepository.Delete(ctx, tx, id); err != nil {
+		return err
+	}
+	infrastructure.AfterCommit(ctx, func() { r.cache.Invalidate(id) })
+	return nil
+}
@@ -0,0 +1,156 @@
+// Package usercache はユーザーの読み取り（UserQueryRepository.FindByID）のインプロセスキャッシュを提供する
+//
+// Cache はクエリ側のリポジトリをラップするデコレーターで、以下の性質を持つ（LRU・TTL・世代の管理は readcache）:
+//   - LRU で件数を制限し、エントリは TTL で失効する。存在しないユーザーも短い TTL でキャッシュする（ネガティブキャッシュ）
+//   - 同じユーザーへの同時のキャッシュミスは1回の読み込みにまとめる
+//   - エントリは読み込んだテナント（tenant.OrgID）のもので、他の組織からの読み取りにはヒットしない
+//   - ユーザーを変更したトランザクションのコミット後に該当のエントリを削除する（CommandRepository）。
+//     他のレプリカでのコミットは PostgreSQL の LISTEN/NOTIFY（command.UserChangedChannel）で受け取る（Listen）
+//
+// ヒット・ミスの件数は Prometheus のメトリクスとして公開する（Cache は prometheus.Collector を実装する）。
+package usercache
+
+import (
+	"context"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/readcache"
+	"github.com/example/go-react-cqrs-template/internal/tenant"
+	"github.com/example/go-react-cqrs-template/internal/usecase"
+)
+
+// Cache ユーザーの読み取りキャッシュ（usecase.UserQueryRepository の実装）
+//
+// FindByID 以外の読み取りはキャッシュせず、ラップしたリポジトリにそのまま委譲する。
+type Cache struct {
+	next    usecase.UserQueryRepository
+	entries *readcache.Cache[domain.User]
+
+	requests      *prometheus.CounterVec
+	invalidations prometheus.Counter
+	entriesGauge  prometheus.GaugeFunc
+}
+
+// Option はCacheの設定を変更する
+type Option func(*readcache.Config)
+
+// WithSize キャッシュするユーザー数の上限を設定する
+func WithSize(n int) Option {
+	return func(c *readcache.Config) {
+		if n > 0 {
+			c.Size = n
+		}
+	}
+}
+
+// WithTTL 存在するユーザーのエントリの有効期間を設定する
+func WithTTL(d time.Duration) Option {
+	return func(c *readcache.Config) {
+		if d > 0 {
+			c.TTL = d
+		}
+	}
+}
+
+// WithNegativeTTL 存在しないユーザーのエントリの有効期間を設定する（0 の場合はキャッシュしない）
+func WithNegativeTTL(d time.Duration) Option {
+	return func(c *readcache.Config) {
+		if d >= 0 {
+			c.NegativeTTL = d
+		}
+	}
+}
+
+// withClock テスト用に現在時刻の取得関数を差し替える
+func withClock(now func() time.Time) Option {
+	return func(c *readcache.Config) {
+		c.Now = now
+	}
+}
+
+// New Cacheのコンストラクタ
+func New(next usecase.UserQueryRepository, opts ...Option) *Cache {
+	c := &Cache{
+		next: next,
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "user_cache_requests_total",
+			Help: "Number of user lookups by ID served by the cache, partitioned by result (hit or miss).",
+		}, []string{"result"}),
+		invalidations: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "user_cache_invalidations_total",
+			Help: "Number of user cache invalidations caused by committed changes.",
+		}),
+	}
+	config := readcache.Config{
+		Size:          10000,
+		TTL:           time.Minute,
+		NegativeTTL:   10 * time.Second,
+		Requests:      c.requests,
+		Invalidations: c.invalidations,
+	}
+	for _, opt := range opts {
+		opt(&config)
+	}
+	c.entries = readcache.New(config, (*domain.User).Snapshot)
+	c.entriesGauge = prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+		Name: "user_cache_entries",
+		Help: "Number of entries currently held in the user cache.",
+	}, func() float64 { return float64(c.entries.Len()) })
+	return c
+}
+
+// FindByID IDでユーザーを検索（キャッシュにない場合のみラップしたリポジトリから読み込む）
+//
+// 同じIDの同時の読み込みは1回にまとめる。読み込みは待っている全員で共有するため、
+// 呼び出し元の ctx がキャンセルされても中断せず、その呼び出し元だけが ctx.Err() を返す。
+//
+// 他の組織の読み込みと重なった場合は、その結果を使わずにラップしたリポジトリから読み込む。
+func (c *Cache) FindByID(ctx context.Context, id string) (*domain.User, error) {
+	return c.entries.Get(ctx, tenant.OrgID(ctx), id, func(ctx context.Context) (*domain.User, error) {
+		return c.next.FindByID(ctx, id)
+	})
+}
+
+// FindByEmail メールアドレスでユーザーを検索（キャッシュしない）
+func (c *Cache) FindByEmail(ctx context.Context, email string) (*domain.User, error) {
+	return c.next.FindByEmail(ctx, email)
+}
+
+// FindAll ユーザーを取得（キャッシュしない）
+func (c *Cache) FindAll(ctx context.Context, status domain.UserStatus, limit, offset int) ([]*domain.User, error) {
+	return c.next.FindAll(ctx, status, limit, offset)
+}
+
+// Count ユーザーの総数を取得（キャッシュしない）
+func (c *Cache) Count(ctx context.Context, status domain.UserStatus) (int, error) {
+	return c.next.Count(ctx, status)
+}
+
+// Invalidate ユーザーのエントリを削除する
+//
+// 実行中の読み込みの結果もキャッシュせず、以降の FindByID は改めて読み込む。
+func (c *Cache) Invalidate(id string) {
+	c.entries.Invalidate(id)
+}
+
+// Purge すべてのエントリを削除する
+func (c *Cache) Purge() {
+	c.entries.Purge()
+}
+
+// Describe prometheus.Collector の実装
+func (c *Cache) Describe(ch chan<- *prometheus.Desc) {
+	c.requests.Describe(ch)
+	c.invalidations.Describe(ch)
+	c.entriesGauge.Describe(ch)
+}
+
+// Collect prometheus.Collector の実装
+func (c *Cache) Collect(ch chan<- prometheus.Metric) {
+	c.requests.Collect(ch)
+	c.invalidations.Collect(ch)
+	c.entriesGauge.Collect(ch)
+}
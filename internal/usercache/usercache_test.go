@@ -0,0 +1,293 @@
+package usercache
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure/memory"
+	"github.com/example/go-react-cqrs-template/internal/tenant"
+	"github.com/example/go-react-cqrs-template/internal/usecase"
+)
+
+// countingStore FindByID の呼び出し回数を数え、block が設定されていれば読み込みを止める
+type countingStore struct {
+	*memory.Store
+	calls atomic.Int32
+	// started は読み込みを始めるたびに送信される（block が設定されている場合のみ）
+	started chan struct{}
+	block   chan struct{}
+}
+
+func (s *countingStore) FindByID(ctx context.Context, id string) (*domain.User, error) {
+	s.calls.Add(1)
+	if s.block != nil {
+		s.started <- struct{}{}
+		<-s.block
+	}
+	return s.Store.FindByID(ctx, id)
+}
+
+func newTestUser(t *testing.T, name, email string) *domain.User {
+	t.Helper()
+	user, err := domain.NewUser(name, email)
+	if err != nil {
+		t.Fatalf("Failed to create user: %v", err)
+	}
+	return user
+}
+
+func assertRequests(t *testing.T, c *Cache, wantHits, wantMisses float64) {
+	t.Helper()
+	if got := testutil.ToFloat64(c.requests.WithLabelValues("hit")); got != wantHits {
+		t.Errorf("hits = %v, want %v", got, wantHits)
+	}
+	if got := testutil.ToFloat64(c.requests.WithLabelValues("miss")); got != wantMisses {
+		t.Errorf("misses = %v, want %v", got, wantMisses)
+	}
+}
+
+func TestCache_FindByID(t *testing.T) {
+	ctx := context.Background()
+	user := newTestUser(t, "John Doe", "john@example.com")
+	store := &countingStore{Store: memory.NewStore()}
+	store.Seed(user)
+	c := New(store)
+
+	for range 3 {
+		got, err := c.FindByID(ctx, user.ID)
+		if err != nil {
+			t.Fatalf("FindByID() unexpected error: %v", err)
+		}
+		if got == nil || got.Name != "John Doe" {
+			t.Fatalf("FindByID() = %+v, want John Doe", got)
+		}
+		// 返した値を変更してもキャッシュには影響しない
+		got.Name = "Mutated"
+	}
+	if calls := store.calls.Load(); calls != 1 {
+		t.Errorf("repository calls = %d, want 1", calls)
+	}
+	assertRequests(t, c, 2, 1)
+}
+
+func TestCache_FindByID_ScopedByTenant(t *testing.T) {
+	ctx := context.Background()
+	otherCtx := tenant.WithOrgID(ctx, "01HZZZZZZZZZZZZZZZZZZZZZZZ")
+	user := newTestUser(t, "John Doe", "john@example.com")
+	store := &countingStore{Store: memory.NewStore()}
+	store.Seed(user)
+	c := New(store)
+
+	if got, err := c.FindByID(ctx, user.ID); err != nil || got == nil {
+		t.Fatalf("FindByID() = %+v, %v, want the user", got, err)
+	}
+	// 他の組織からの読み取りはキャッシュにヒットしない
+	if got, err := c.FindByID(otherCtx, user.ID); err != nil || got != nil {
+		t.Errorf("FindByID() from another organization = %+v, %v, want nil", got, err)
+	}
+	if calls := store.calls.Load(); calls != 2 {
+		t.Errorf("repository calls = %d, want 2", calls)
+	}
+	assertRequests(t, c, 0, 2)
+}
+
+func TestCache_FindByID_TTL(t *testing.T) {
+	ctx := context.Background()
+	user := newTestUser(t, "John Doe", "john@example.com")
+	store := &countingStore{Store: memory.NewStore()}
+	store.Seed(user)
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	c := New(store, WithTTL(time.Minute), WithNegativeTTL(10*time.Second), withClock(func() time.Time { return now }))
+	missing := "01ARZ3NDEKTSV4RRFFQ69G5FAV"
+
+	// 存在しないユーザーもキャッシュする
+	for range 2 {
+		if got, err := c.FindByID(ctx, missing); err != nil || got != nil {
+			t.Fatalf("FindByID(missing) = %+v, %v, want nil", got, err)
+		}
+	}
+	if _, err := c.FindByID(ctx, user.ID); err != nil {
+		t.Fatalf("FindByID() unexpected error: %v", err)
+	}
+	if calls := store.calls.Load(); calls != 2 {
+		t.Fatalf("repository calls = %d, want 2", calls)
+	}
+
+	// ネガティブキャッシュだけが失効している
+	now = now.Add(30 * time.Second)
+	c.FindByID(ctx, missing)
+	c.FindByID(ctx, user.ID)
+	if calls := store.calls.Load(); calls != 3 {
+		t.Errorf("repository calls after negative TTL = %d, want 3", calls)
+	}
+
+	now = now.Add(time.Minute)
+	c.FindByID(ctx, user.ID)
+	if calls := store.calls.Load(); calls != 4 {
+		t.Errorf("repository calls after TTL = %d, want 4", calls)
+	}
+}
+
+func TestCache_FindByID_CollapsesConcurrentMisses(t *testing.T) {
+	user := newTestUser(t, "John Doe", "john@example.com")
+	store := &countingStore{Store: memory.NewStore(), started: make(chan struct{}, 1), block: make(chan struct{})}
+	store.Seed(user)
+	c := New(store)
+
+	const callers = 10
+	var wg sync.WaitGroup
+	errs := make(chan error, callers)
+	for range callers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			got, err := c.FindByID(context.Background(), user.ID)
+			if err == nil && (got == nil || got.ID != user.ID) {
+				err = errors.New("unexpected user")
+			}
+			errs <- err
+		}()
+	}
+
+	<-store.started
+	// 全員がキャッシュミスして読み込みを待つまで待つ
+	for testutil.ToFloat64(c.requests.WithLabelValues("miss")) < callers {
+		time.Sleep(time.Millisecond)
+	}
+	close(store.block)
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		if err != nil {
+			t.Errorf("FindByID() error = %v", err)
+		}
+	}
+	if calls := store.calls.Load(); calls != 1 {
+		t.Errorf("repository calls = %d, want 1", calls)
+	}
+}
+
+func TestCache_FindByID_CallerCancelled(t *testing.T) {
+	user := newTestUser(t, "John Doe", "john@example.com")
+	store := &countingStore{Store: memory.NewStore(), started: make(chan struct{}, 1), block: make(chan struct{})}
+	store.Seed(user)
+	c := New(store)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() {
+		_, err := c.FindByID(ctx, user.ID)
+		done <- err
+	}()
+	<-store.started
+	cancel()
+	if err := <-done; !errors.Is(err, context.Canceled) {
+		t.Errorf("FindByID() error = %v, want %v", err, context.Canceled)
+	}
+
+	// キャンセルされた呼び出し元に関わらず読み込みは完了し、キャッシュされる
+	close(store.block)
+	got, err := c.FindByID(context.Background(), user.ID)
+	if err != nil || got == nil {
+		t.Fatalf("FindByID() = %+v, %v, want user", got, err)
+	}
+	if calls := store.calls.Load(); calls != 1 {
+		t.Errorf("repository calls = %d, want 1", calls)
+	}
+}
+
+func TestCache_Invalidate(t *testing.T) {
+	ctx := context.Background()
+	user := newTestUser(t, "John Doe", "john@example.com")
+
+	t.Run("removes entry", func(t *testing.T) {
+		store := &countingStore{Store: memory.NewStore()}
+		store.Seed(user)
+		c := New(store)
+
+		c.FindByID(ctx, user.ID)
+		c.Invalidate(user.ID)
+		c.FindByID(ctx, user.ID)
+		if calls := store.calls.Load(); calls != 2 {
+			t.Errorf("repository calls = %d, want 2", calls)
+		}
+	})
+
+	t.Run("discards value loaded before invalidation", func(t *testing.T) {
+		store := &countingStore{Store: memory.NewStore(), started: make(chan struct{}, 1), block: make(chan struct{})}
+		store.Seed(user)
+		c := New(store)
+
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			c.FindByID(ctx, user.ID)
+		}()
+		<-store.started
+		c.Invalidate(user.ID)
+		close(store.block)
+		<-done
+
+		// 読み込み中に無効化された値はキャッシュされていない
+		store.block = nil
+		c.FindByID(ctx, user.ID)
+		if calls := store.calls.Load(); calls != 2 {
+			t.Errorf("repository calls = %d, want 2", calls)
+		}
+	})
+}
+
+func TestCommandRepository_InvalidatesAfterCommit(t *testing.T) {
+	ctx := context.Background()
+	user := newTestUser(t, "John Doe", "john@example.com")
+	store := memory.NewStore()
+	store.Seed(user)
+	c := New(store)
+	repo := NewCommandRepository(store, c)
+
+	if _, err := c.FindByID(ctx, user.ID); err != nil {
+		t.Fatalf("FindByID() unexpected error: %v", err)
+	}
+
+	// ロールバックした変更では無効化しない
+	errBoom := errors.New("boom")
+	err := store.RunInTransaction(ctx, func(ctx context.Context, tx infrastructure.DBTX) error {
+		if err := repo.Delete(ctx, tx, user.ID); err != nil {
+			return err
+		}
+		return errBoom
+	})
+	if !errors.Is(err, errBoom) {
+		t.Fatalf("RunInTransaction() error = %v, want %v", err, errBoom)
+	}
+	if got := testutil.ToFloat64(c.invalidations); got != 0 {
+		t.Errorf("invalidations after rollback = %v, want 0", got)
+	}
+
+	if err := usecase.NewUpdateUserUsecase(store, repo, store).Execute(ctx, user.ID, "John Smith", "john@example.com"); err != nil {
+		t.Fatalf("UpdateUser unexpected error: %v", err)
+	}
+	got, err := c.FindByID(ctx, user.ID)
+	if err != nil {
+		t.Fatalf("FindByID() unexpected error: %v", err)
+	}
+	if got.Name != "John Smith" {
+		t.Errorf("FindByID() after update = %+v, want John Smith", got)
+	}
+
+	if err := usecase.NewDeleteUserUsecase(store, repo, store).Execute(ctx, user.ID); err != nil {
+		t.Fatalf("DeleteUser unexpected error: %v", err)
+	}
+	if got, _ := c.FindByID(ctx, user.ID); got != nil {
+		t.Errorf("FindByID() after delete = %+v, want nil", got)
+	}
+}
@@ -0,0 +1,89 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure"
+	"github.com/example/go-react-cqrs-template/internal/outbox"
+	"github.com/example/go-react-cqrs-template/internal/tenant"
+)
+
+// コンパイル時にインターフェースの実装を検証
+var _ outbox.Publisher = (*Dispatcher)(nil)
+
+// Dispatcher outbox のイベントを購読ごとのWebhook配信に振り分ける outbox.Publisher
+type Dispatcher struct {
+	repo      Repository
+	txManager TransactionManager
+	log       *slog.Logger
+	now       func() time.Time
+}
+
+// NewDispatcher Dispatcherのコンストラクタ
+func NewDispatcher(repo Repository, txManager TransactionManager, log *slog.Logger) *Dispatcher {
+	return &Dispatcher{
+		repo:      repo,
+		txManager: txManager,
+		log:       log,
+		now:       time.Now,
+	}
+}
+
+// Publish イベントを購読している有効な購読ごとに配信を作成する
+//
+// 作成は一つのトランザクションで行い、同じイベントの再配信では配信を重複して作成しない。
+// 配信先はイベントが発生した組織の購読に限る。Webhook の対象外のイベントは何もしない。
+func (d *Dispatcher) Publish(ctx context.Context, event outbox.Event) error {
+	eventType := domain.UserEventType(event.Type)
+	if event.AggregateType != domain.UserAggregateType || !eventType.Valid() {
+		return nil
+	}
+
+	payload, err := json.Marshal(Envelope{
+		ID:         event.ID,
+		Type:       event.Type,
+		OccurredAt: event.OccurredAt,
+		Data:       event.Payload,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to marshal webhook payload: %w", err)
+	}
+
+	created := 0
+	ctx = tenant.WithOrgID(ctx, event.OrgID)
+	err = d.txManager.RunInTransaction(ctx, func(ctx context.Context, tx infrastructure.DBTX) error {
+		subs, err := d.repo.FindSubscriptionsByEventType(ctx, tx, eventType)
+		if err != nil {
+			return err
+		}
+		now := d.now()
+		for _, sub := range subs {
+			delivery := domain.NewWebhookDelivery(sub.ID, event.ID, eventType, payload, now)
+			ok, err := d.repo.CreateDelivery(ctx, tx, delivery)
+			if err != nil {
+				return err
+			}
+			if ok {
+				created++
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if created > 0 {
+		d.log.InfoContext(ctx, "webhook deliveries scheduled",
+			slog.String("event_id", event.ID),
+			slog.String("event_type", event.Type),
+			slog.Int("deliveries", created),
+		)
+	}
+	return nil
+}
@@ -0,0 +1,128 @@
+package webhook
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure/dao"
+	"github.com/example/go-react-cqrs-template/internal/tenant"
+)
+
+// SQLRepository PostgreSQL の webhook_subscriptions / webhook_deliveries を使う Repository の実装
+type SQLRepository struct{}
+
+// NewSQLRepository SQLRepositoryのコンストラクタ
+func NewSQLRepository() *SQLRepository {
+	return &SQLRepository{}
+}
+
+// FindSubscriptionsByEventType イベント種別を購読している有効な購読を取得する
+//
+// コンテキストのテナント（tenant.OrgID）の購読のみを対象にする。
+func (r *SQLRepository) FindSubscriptionsByEventType(ctx context.Context, tx infrastructure.DBTX, eventType domain.UserEventType) ([]*domain.WebhookSubscription, error) {
+	rows, err := dao.New(tx).ListActiveWebhookSubscriptionsByEventType(ctx, dao.ListActiveWebhookSubscriptionsByEventTypeParams{
+		OrgID:     tenant.OrgID(ctx),
+		EventType: string(eventType),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to find webhook subscriptions: %w", err)
+	}
+	subs := make([]*domain.WebhookSubscription, 0, len(rows))
+	for _, row := range rows {
+		eventTypes := make([]domain.UserEventType, len(row.EventTypes))
+		for i, t := range row.EventTypes {
+			eventTypes[i] = domain.UserEventType(t)
+		}
+		subs = append(subs, &domain.WebhookSubscription{
+			ID:         row.ID,
+			URL:        row.Url,
+			Secret:     row.Secret,
+			EventTypes: eventTypes,
+			Active:     row.Active,
+			CreatedAt:  row.CreatedAt,
+			UpdatedAt:  row.UpdatedAt,
+		})
+	}
+	return subs, nil
+}
+
+// CreateDelivery 配信を作成する（同じ購読・イベントの配信が既にある場合は false）
+func (r *SQLRepository) CreateDelivery(ctx context.Context, tx infrastructure.DBTX, d *domain.WebhookDelivery) (bool, error) {
+	n, err := dao.New(tx).CreateWebhookDelivery(ctx, dao.CreateWebhookDeliveryParams{
+		ID:             d.ID,
+		SubscriptionID: d.SubscriptionID,
+		EventID:        d.EventID,
+		EventType:      string(d.EventType),
+		Payload:        d.Payload,
+		Status:         string(d.Status),
+		Attempts:       int32(d.Attempts),
+		NextAttemptAt:  d.NextAttemptAt,
+		CreatedAt:      d.CreatedAt,
+		UpdatedAt:      d.UpdatedAt,
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to create webhook delivery: %w", err)
+	}
+	return n > 0, nil
+}
+
+// ClaimDeliveries 配信時刻に達した配信を取得し、配信時刻を leaseUntil に進めてリースする（FOR UPDATE SKIP LOCKED）
+//
+// 他のワーカーがロック中またはリース中の配信はスキップするため、複数のワーカーを同時に動かせる。
+// 返す配信の NextAttemptAt は leaseUntil になる。
+func (r *SQLRepository) ClaimDeliveries(ctx context.Context, tx infrastructure.DBTX, now, leaseUntil time.Time, limit int) ([]Delivery, error) {
+	rows, err := dao.New(tx).ClaimWebhookDeliveries(ctx, dao.ClaimWebhookDeliveriesParams{
+		Now:        now,
+		Limit:      int32(limit),
+		LeaseUntil: leaseUntil,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim webhook deliveries: %w", err)
+	}
+
+	deliveries := make([]Delivery, 0, len(rows))
+	for _, row := range rows {
+		deliveries = append(deliveries, Delivery{
+			WebhookDelivery: domain.WebhookDelivery{
+				ID:             row.ID,
+				SubscriptionID: row.SubscriptionID,
+				EventID:        row.EventID,
+				EventType:      domain.UserEventType(row.EventType),
+				Payload:        row.Payload,
+				Status:         domain.WebhookDeliveryStatus(row.Status),
+				Attempts:       int(row.Attempts),
+				LastStatusCode: int(row.LastStatusCode.Int32),
+				LastError:      row.LastError.String,
+				NextAttemptAt:  row.NextAttemptAt,
+				DeliveredAt:    row.DeliveredAt.Time,
+				CreatedAt:      row.CreatedAt,
+				UpdatedAt:      row.UpdatedAt,
+			},
+			URL:    row.Url,
+			Secret: row.Secret,
+		})
+	}
+	return deliveries, nil
+}
+
+// UpdateDelivery 配信結果を記録する
+func (r *SQLRepository) UpdateDelivery(ctx context.Context, tx infrastructure.DBTX, d *domain.WebhookDelivery) error {
+	err := dao.New(tx).UpdateWebhookDelivery(ctx, dao.UpdateWebhookDeliveryParams{
+		Status:         string(d.Status),
+		Attempts:       int32(d.Attempts),
+		LastStatusCode: sql.NullInt32{Int32: int32(d.LastStatusCode), Valid: d.LastStatusCode != 0},
+		LastError:      sql.NullString{String: d.LastError, Valid: d.LastError != ""},
+		NextAttemptAt:  d.NextAttemptAt,
+		DeliveredAt:    sql.NullTime{Time: d.DeliveredAt, Valid: !d.DeliveredAt.IsZero()},
+		UpdatedAt:      d.UpdatedAt,
+		ID:             d.ID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update webhook delivery: %w", err)
+	}
+	return nil
+}
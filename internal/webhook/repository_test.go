@@ -0,0 +1,97 @@
+package webhook_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/example/go-react-cqrs-template/internal/command"
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure"
+	"github.com/example/go-react-cqrs-template/internal/outbox"
+	"github.com/example/go-react-cqrs-template/internal/queryservice"
+	"github.com/example/go-react-cqrs-template/internal/testutil/dbtest"
+	"github.com/example/go-react-cqrs-template/internal/usecase"
+	"github.com/example/go-react-cqrs-template/internal/webhook"
+)
+
+func TestSQLRepository_DeliversAndLogs(t *testing.T) {
+	db := dbtest.New(t)
+	ctx := context.Background()
+	tm := infrastructure.NewTransactionManager(db)
+	log := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	statuses := []int{http.StatusInternalServerError, http.StatusNoContent}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		status := statuses[0]
+		statuses = statuses[1:]
+		w.WriteHeader(status)
+	}))
+	t.Cleanup(srv.Close)
+
+	sub, err := usecase.NewCreateWebhookSubscriptionUsecase(command.NewWebhookRepository(), tm).
+		Execute(ctx, "https://example.com/hooks", "0123456789abcdef", []domain.UserEventType{domain.UserEventTypeCreated}, true)
+	if err != nil {
+		t.Fatalf("CreateWebhookSubscription unexpected error: %v", err)
+	}
+	// httptest の受信者はループバックのため、登録時の検証の後に差し替える
+	sub.URL = srv.URL
+	if err := command.NewWebhookRepository().SaveSubscription(ctx, db, sub); err != nil {
+		t.Fatalf("SaveSubscription unexpected error: %v", err)
+	}
+
+	repo := webhook.NewSQLRepository()
+	event := outbox.Event{
+		ID:            "01ARZ3NDEKTSV4RRFFQ69G5FAV",
+		AggregateType: domain.UserAggregateType,
+		AggregateID:   "01ARZ3NDEKTSV4RRFFQ69G5FAW",
+		Type:          string(domain.UserEventTypeCreated),
+		Payload:       json.RawMessage(`{"id":"01ARZ3NDEKTSV4RRFFQ69G5FAW"}`),
+		OccurredAt:    time.Now(),
+	}
+	dispatcher := webhook.NewDispatcher(repo, tm, log)
+	for i := 0; i < 2; i++ {
+		if err := dispatcher.Publish(ctx, event); err != nil {
+			t.Fatalf("Publish() unexpected error: %v", err)
+		}
+	}
+
+	worker := webhook.NewWorker(repo, tm, log, webhook.WithHTTPClient(srv.Client()), webhook.WithRetryBackoff(time.Millisecond, time.Millisecond))
+	for i := 0; i < 2; i++ {
+		time.Sleep(5 * time.Millisecond)
+		if _, err := worker.ProcessBatch(ctx); err != nil {
+			t.Fatalf("ProcessBatch() unexpected error: %v", err)
+		}
+	}
+
+	query := queryservice.NewWebhookQueryService(db)
+	deliveries, err := query.FindDeliveries(ctx, sub.ID, "", 10, 0)
+	if err != nil {
+		t.Fatalf("FindDeliveries() unexpected error: %v", err)
+	}
+	if len(deliveries) != 1 {
+		t.Fatalf("len(deliveries) = %d, want 1", len(deliveries))
+	}
+	d := deliveries[0]
+	if d.Status != domain.WebhookDeliveryStatusSucceeded || d.Attempts != 2 || d.LastStatusCode != http.StatusNoContent || d.DeliveredAt.IsZero() {
+		t.Errorf("delivery = %+v, want succeeded on 2nd attempt", d)
+	}
+
+	dead, err := query.CountDeliveries(ctx, sub.ID, domain.WebhookDeliveryStatusDead)
+	if err != nil || dead != 0 {
+		t.Errorf("CountDeliveries(dead) = %d, %v; want 0", dead, err)
+	}
+
+	// 購読の削除で配信ログも削除される
+	if err := usecase.NewDeleteWebhookSubscriptionUsecase(command.NewWebhookRepository(), tm).Execute(ctx, sub.ID); err != nil {
+		t.Fatalf("DeleteWebhookSubscription unexpected error: %v", err)
+	}
+	if n, err := query.CountDeliveries(ctx, sub.ID, ""); err != nil || n != 0 {
+		t.Errorf("CountDeliveries() after delete = %d, %v; want 0", n, err)
+	}
+}
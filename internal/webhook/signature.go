@@ -0,0 +1,82 @@
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// IDHeader はイベントIDを伝えるヘッダー（受信者での重複排除に使用）
+	IDHeader = "X-Webhook-Id"
+	// EventHeader はイベント種別を伝えるヘッダー
+	EventHeader = "X-Webhook-Event"
+	// DeliveryHeader は配信IDを伝えるヘッダー（配信ログとの突き合わせに使用）
+	DeliveryHeader = "X-Webhook-Delivery"
+	// SignatureHeader は署名を伝えるヘッダー（形式: t=<unix秒>,v1=<hex>）
+	SignatureHeader = "X-Webhook-Signature"
+)
+
+// ErrInvalidSignature は署名が一致しない、または形式が不正な場合のエラー
+var ErrInvalidSignature = errors.New("webhook: invalid signature")
+
+// ErrSignatureExpired は署名のタイムスタンプが許容範囲外の場合のエラー
+var ErrSignatureExpired = errors.New("webhook: signature timestamp out of tolerance")
+
+// Sign ボディの署名ヘッダーの値を作成する
+//
+// 署名は HMAC-SHA256(secret, "<unix秒>.<body>") の16進表現。タイムスタンプを
+// 署名対象に含めることで、受信者はリプレイを検出できる。
+func Sign(secret string, timestamp time.Time, body []byte) string {
+	t := strconv.FormatInt(timestamp.Unix(), 10)
+	return "t=" + t + ",v1=" + computeSignature(secret, t, body)
+}
+
+// Verify 署名ヘッダーの値を検証する（受信側の実装例・テスト用）
+//
+// tolerance が正の場合、タイムスタンプが now から tolerance 以上離れていればエラーにする。
+func Verify(secret, header string, body []byte, now time.Time, tolerance time.Duration) error {
+	var t, v1 string
+	for _, part := range strings.Split(header, ",") {
+		key, value, ok := strings.Cut(part, "=")
+		if !ok {
+			return ErrInvalidSignature
+		}
+		switch key {
+		case "t":
+			t = value
+		case "v1":
+			v1 = value
+		}
+	}
+	if t == "" || v1 == "" {
+		return ErrInvalidSignature
+	}
+
+	unix, err := strconv.ParseInt(t, 10, 64)
+	if err != nil {
+		return ErrInvalidSignature
+	}
+	if !hmac.Equal([]byte(v1), []byte(computeSignature(secret, t, body))) {
+		return ErrInvalidSignature
+	}
+	if tolerance > 0 {
+		if diff := now.Sub(time.Unix(unix, 0)); diff > tolerance || diff < -tolerance {
+			return ErrSignatureExpired
+		}
+	}
+	return nil
+}
+
+// computeSignature HMAC-SHA256(secret, "<t>.<body>") を16進で返す
+func computeSignature(secret, t string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(t))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
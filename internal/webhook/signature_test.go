@@ -0,0 +1,46 @@
+package webhook
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestSignAndVerify(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	body := []byte(`{"id":"event-1"}`)
+	header := Sign(testSecret, now, body)
+
+	tests := []struct {
+		name    string
+		secret  string
+		header  string
+		body    []byte
+		now     time.Time
+		wantErr error
+	}{
+		{name: "valid", secret: testSecret, header: header, body: body, now: now},
+		{name: "wrong secret", secret: "another-secret-value", header: header, body: body, now: now, wantErr: ErrInvalidSignature},
+		{name: "tampered body", secret: testSecret, header: header, body: []byte(`{"id":"event-2"}`), now: now, wantErr: ErrInvalidSignature},
+		{name: "malformed header", secret: testSecret, header: "v1=abc", body: body, now: now, wantErr: ErrInvalidSignature},
+		{name: "expired", secret: testSecret, header: header, body: body, now: now.Add(10 * time.Minute), wantErr: ErrSignatureExpired},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Verify(tt.secret, tt.header, tt.body, tt.now, 5*time.Minute)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Verify() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestSign_KnownValue(t *testing.T) {
+	// HMAC-SHA256("secret", "1.{}")。受信側の実装と突き合わせられるよう署名の形式を固定する
+	got := Sign("secret", time.Unix(1, 0), []byte("{}"))
+	want := "t=1,v1=1122767b193110cfec322b6f199b599edbf608ed087f2d27afb0b97d99523908"
+	if got != want {
+		t.Errorf("Sign() = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,56 @@
+// Package webhook はドメインイベントを外部のHTTPエンドポイントへ配信する
+//
+// 配信は二段階で行う:
+//   - Dispatcher: outbox.Publisher として動作し、イベントを購読している有効な
+//     Webhook購読ごとに配信（webhook_deliveries）を作成する
+//   - Worker: 配信時刻に達した配信をポーリングし、HMAC-SHA256 で署名した JSON を POST する。
+//     2xx 以外の応答や通信エラーは指数バックオフで再配信し、上限回数に達したらデッドレターにする
+//
+// 配信は at-least-once で、購読内での配信順序は保証しない。受信者は X-Webhook-Id
+// （イベントID）で重複を排除し、順序が必要な場合は occurredAt を使うこと。
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure"
+)
+
+// Envelope 受信者に POST する JSON ボディ
+type Envelope struct {
+	// ID はイベントID（X-Webhook-Id と同じ値）
+	ID         string    `json:"id"`
+	Type       string    `json:"type"`
+	OccurredAt time.Time `json:"occurredAt"`
+	// Data はイベント発生時点のリソース（ユーザーイベントの場合は API の UserEventData と同じ形で、名前とメールアドレスは含まない）
+	Data json.RawMessage `json:"data"`
+}
+
+// Delivery 宛先を含む配信対象のWebhook配信
+type Delivery struct {
+	domain.WebhookDelivery
+	// URL は購読のコールバックURL
+	URL string
+	// Secret は署名に使う購読の共有シークレット
+	Secret string
+}
+
+// Repository Webhook購読・配信の操作（トランザクション内で使用）
+type Repository interface {
+	// FindSubscriptionsByEventType はイベント種別を購読している有効な購読を取得する
+	FindSubscriptionsByEventType(ctx context.Context, tx infrastructure.DBTX, eventType domain.UserEventType) ([]*domain.WebhookSubscription, error)
+	// CreateDelivery は配信を作成する。同じ購読・イベントの配信が既にある場合は作成せず false を返す
+	CreateDelivery(ctx context.Context, tx infrastructure.DBTX, delivery *domain.WebhookDelivery) (bool, error)
+	// ClaimDeliveries は配信時刻に達した配信を取得し、配信時刻を leaseUntil に進めてリースする
+	ClaimDeliveries(ctx context.Context, tx infrastructure.DBTX, now, leaseUntil time.Time, limit int) ([]Delivery, error)
+	// UpdateDelivery は配信結果を記録する
+	UpdateDelivery(ctx context.Context, tx infrastructure.DBTX, delivery *domain.WebhookDelivery) error
+}
+
+// TransactionManager トランザクション管理のインターフェース
+type TransactionManager interface {
+	RunInTransaction(ctx context.Context, fn func(ctx context.Context, tx infrastructure.DBTX) error) error
+}
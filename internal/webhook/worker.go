@@ -0,0 +1,338 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net"
+	"net/http"
+	"net/netip"
+	"syscall"
+	"time"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure"
+	"github.com/example/go-react-cqrs-template/internal/tenant"
+)
+
+// userAgent 配信リクエストの User-Agent
+const userAgent = "go-react-cqrs-template-webhook/1.0"
+
+// maxErrorBodyBytes 失敗時に配信ログへ記録する応答ボディの最大長
+const maxErrorBodyBytes = 512
+
+// errAddressNotAllowed 配信先のホスト名が内部のアドレスに解決されたエラー
+var errAddressNotAllowed = errors.New("webhook: address is not allowed")
+
+// Worker 配信時刻に達したWebhook配信を POST する
+type Worker struct {
+	repo      Repository
+	txManager TransactionManager
+	client    *http.Client
+	log       *slog.Logger
+
+	batchSize      int
+	pollInterval   time.Duration
+	requestTimeout time.Duration
+	initialBackoff time.Duration
+	maxBackoff     time.Duration
+	maxAttempts    int
+	now            func() time.Time
+}
+
+// Option はワーカーの設定を変更する
+type Option func(*Worker)
+
+// WithBatchSize 一度のポーリングで配信する件数の上限を設定する
+func WithBatchSize(n int) Option {
+	return func(w *Worker) {
+		if n > 0 {
+			w.batchSize = n
+		}
+	}
+}
+
+// WithPollInterval 配信がないときのポーリング間隔を設定する
+func WithPollInterval(d time.Duration) Option {
+	return func(w *Worker) {
+		if d > 0 {
+			w.pollInterval = d
+		}
+	}
+}
+
+// WithRequestTimeout 一回の配信リクエストのタイムアウトを設定する
+func WithRequestTimeout(d time.Duration) Option {
+	return func(w *Worker) {
+		if d > 0 {
+			w.requestTimeout = d
+		}
+	}
+}
+
+// WithRetryBackoff 配信失敗時の再配信までの待ち時間（指数バックオフ）を設定する
+func WithRetryBackoff(initial, max time.Duration) Option {
+	return func(w *Worker) {
+		if initial > 0 && max >= initial {
+			w.initialBackoff = initial
+			w.maxBackoff = max
+		}
+	}
+}
+
+// WithMaxAttempts デッドレターにするまでの試行回数を設定する
+func WithMaxAttempts(n int) Option {
+	return func(w *Worker) {
+		if n > 0 {
+			w.maxAttempts = n
+		}
+	}
+}
+
+// WithHTTPClient 配信に使う HTTP クライアントを差し替える
+//
+// リダイレクトは追従せず、3xx 応答は失敗として扱うことを推奨する（デフォルトのクライアントはそう設定している）。
+// デフォルトのクライアントの接続先のアドレスの検証（NewTransport）は、client のトランスポートが行わない限り無効になる。
+func WithHTTPClient(client *http.Client) Option {
+	return func(w *Worker) {
+		if client != nil {
+			w.client = client
+		}
+	}
+}
+
+// withTransport テスト用に配信のトランスポートを差し替える（ループバックの httptest の受信者に配信するため）
+func withTransport(rt http.RoundTripper) Option {
+	return func(w *Worker) {
+		w.client.Transport = rt
+	}
+}
+
+// withClock テスト用に現在時刻の取得関数を差し替える
+func withClock(now func() time.Time) Option {
+	return func(w *Worker) {
+		w.now = now
+	}
+}
+
+// NewWorker Workerのコンストラクタ
+func NewWorker(repo Repository, txManager TransactionManager, log *slog.Logger, opts ...Option) *Worker {
+	w := &Worker{
+		repo:      repo,
+		txManager: txManager,
+		client: &http.Client{
+			Transport: NewTransport(),
+			CheckRedirect: func(*http.Request, []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+		log:            log,
+		batchSize:      20,
+		pollInterval:   time.Second,
+		requestTimeout: 10 * time.Second,
+		initialBackoff: 10 * time.Second,
+		maxBackoff:     time.Hour,
+		maxAttempts:    10,
+		now:            time.Now,
+	}
+	for _, opt := range opts {
+		opt(w)
+	}
+	return w
+}
+
+// Run コンテキストがキャンセルされるまで配信を続ける
+func (w *Worker) Run(ctx context.Context) error {
+	w.log.Info("webhook worker started",
+		slog.Int("batch_size", w.batchSize),
+		slog.Duration("poll_interval", w.pollInterval),
+		slog.Int("max_attempts", w.maxAttempts),
+	)
+
+	for {
+		if ctx.Err() != nil {
+			w.log.Info("webhook worker stopped")
+			return nil
+		}
+
+		n, err := w.ProcessBatch(ctx)
+		if err != nil && ctx.Err() == nil {
+			w.log.Error("failed to process webhook deliveries", slog.String("error", err.Error()))
+		}
+
+		// バッチが埋まっている場合は待たずに次を処理する
+		if err == nil && n >= w.batchSize {
+			continue
+		}
+
+		timer := time.NewTimer(w.pollInterval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+		case <-timer.C:
+		}
+	}
+}
+
+// ProcessBatch 配信時刻に達した配信を取得して POST し、処理した件数を返す
+//
+// 配信はリース（配信時刻を lease 後に進める）して短いトランザクションでコミットし、POST はトランザクションの外で行う。
+// 結果は配信ごとに別のトランザクションで記録するため、記録済みの結果は後の配信の失敗で取り消されない。
+// 結果を記録できなかった配信とシャットダウンで中断した配信は、リースが切れた後に再送される（at-least-once）。
+// すべての組織の配信を扱う（tenant.WithAllOrgs）。
+func (w *Worker) ProcessBatch(ctx context.Context) (int, error) {
+	ctx = tenant.WithAllOrgs(ctx)
+
+	var deliveries []Delivery
+	err := w.txManager.RunInTransaction(ctx, func(ctx context.Context, tx infrastructure.DBTX) error {
+		now := w.now()
+		var err error
+		deliveries, err = w.repo.ClaimDeliveries(ctx, tx, now, now.Add(w.lease()), w.batchSize)
+		return err
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	for i := range deliveries {
+		if err := w.deliver(ctx, &deliveries[i]); err != nil {
+			return i, err
+		}
+	}
+	return len(deliveries), nil
+}
+
+// lease 取得した配信のリースの期間
+//
+// バッチの配信を順に POST するため、すべての配信のタイムアウトと結果の記録の猶予を合わせた期間にする。
+func (w *Worker) lease() time.Duration {
+	return time.Duration(w.batchSize+1) * w.requestTimeout
+}
+
+// deliver 配信を POST し、結果を記録する
+func (w *Worker) deliver(ctx context.Context, d *Delivery) error {
+	statusCode, sendErr := w.send(ctx, d)
+
+	// シャットダウンによる中断は失敗として記録しない（リースが切れた後に再送する）
+	if sendErr != nil && ctx.Err() != nil {
+		return errors.Join(ctx.Err(), sendErr)
+	}
+
+	now := w.now()
+	if sendErr == nil {
+		d.Succeed(statusCode, now)
+		return w.record(ctx, d)
+	}
+
+	nextAttemptAt := now.Add(w.backoff(d.Attempts + 1))
+	d.Fail(statusCode, sendErr.Error(), now, nextAttemptAt, w.maxAttempts)
+
+	attrs := []any{
+		slog.String("delivery_id", d.ID),
+		slog.String("subscription_id", d.SubscriptionID),
+		slog.String("event_id", d.EventID),
+		slog.Int("attempts", d.Attempts),
+		slog.Int("status_code", statusCode),
+		slog.String("error", sendErr.Error()),
+	}
+	if d.Status == domain.WebhookDeliveryStatusDead {
+		w.log.Error("webhook delivery dead-lettered", attrs...)
+	} else {
+		w.log.Warn("webhook delivery failed", append(attrs, slog.Time("next_attempt_at", nextAttemptAt))...)
+	}
+
+	if err := w.record(ctx, d); err != nil {
+		return fmt.Errorf("failed to record webhook delivery failure: %w", err)
+	}
+	return nil
+}
+
+// record 配信の結果を配信ごとのトランザクションで記録する
+//
+// 送信済みの結果を残すため、シャットダウン中でも記録は中断しない。
+func (w *Worker) record(ctx context.Context, d *Delivery) error {
+	return w.txManager.RunInTransaction(context.WithoutCancel(ctx), func(ctx context.Context, tx infrastructure.DBTX) error {
+		return w.repo.UpdateDelivery(ctx, tx, &d.WebhookDelivery)
+	})
+}
+
+// NewTransport 接続先のアドレスを検証する配信用のトランスポートを作成する
+//
+// 登録時の URL の検証（domain.WebhookAddressAllowed）に加え、ホスト名を解決した接続の直前にも検証するため、
+// 内部のアドレスに解決される（DNS リバインディングを含む）ホストには接続しない。接続先を検証できないため
+// 環境変数のプロキシは使わない。
+func NewTransport() *http.Transport {
+	dialer := &net.Dialer{
+		Timeout:   30 * time.Second,
+		KeepAlive: 30 * time.Second,
+		Control:   checkDialAddress,
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+	return transport
+}
+
+// checkDialAddress 接続直前に解決済みの接続先のアドレスを検証する（net.Dialer.Control）
+func checkDialAddress(_, address string, _ syscall.RawConn) error {
+	addrPort, err := netip.ParseAddrPort(address)
+	if err != nil {
+		return fmt.Errorf("webhook: invalid address %q: %w", address, err)
+	}
+	if !domain.WebhookAddressAllowed(addrPort.Addr()) {
+		return fmt.Errorf("%w: %s", errAddressNotAllowed, addrPort.Addr())
+	}
+	return nil
+}
+
+// send 署名付きの JSON を POST し、応答のステータスコードを返す（応答がない場合は 0）
+//
+// 2xx 以外の応答はエラーとして返す。
+func (w *Worker) send(ctx context.Context, d *Delivery) (int, error) {
+	ctx, cancel := context.WithTimeout(ctx, w.requestTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, d.URL, bytes.NewReader(d.Payload))
+	if err != nil {
+		return 0, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", userAgent)
+	req.Header.Set(IDHeader, d.EventID)
+	req.Header.Set(EventHeader, string(d.EventType))
+	req.Header.Set(DeliveryHeader, d.ID)
+	req.Header.Set(SignatureHeader, Sign(d.Secret, w.now(), d.Payload))
+
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		// 接続を再利用できるようにボディを読み捨てる
+		_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+		return resp.StatusCode, nil
+	}
+
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBodyBytes))
+	if len(body) == 0 {
+		return resp.StatusCode, fmt.Errorf("unexpected status %d", resp.StatusCode)
+	}
+	return resp.StatusCode, fmt.Errorf("unexpected status %d: %s", resp.StatusCode, bytes.TrimSpace(body))
+}
+
+// backoff attempts 回目の失敗後の待ち時間を計算する
+func (w *Worker) backoff(attempts int) time.Duration {
+	wait := w.initialBackoff
+	for i := 1; i < attempts; i++ {
+		wait *= 2
+		if wait >= w.maxBackoff {
+			return w.maxBackoff
+		}
+	}
+	return wait
+}
@@ -0,0 +1,449 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure"
+	"github.com/example/go-react-cqrs-template/internal/outbox"
+)
+
+const testSecret = "0123456789abcdef"
+
+// fakeRepository ClaimWebhookDeliveries と同じ選択規則を持つインメモリの Repository
+type fakeRepository struct {
+	mu            sync.Mutex
+	subscriptions []*domain.WebhookSubscription
+	deliveries    []*domain.WebhookDelivery
+	// failUpdateID は UpdateDelivery を失敗させる配信のID
+	failUpdateID string
+}
+
+func (f *fakeRepository) subscribe(t *testing.T, url string, active bool, eventTypes ...domain.UserEventType) *domain.WebhookSubscription {
+	t.Helper()
+	sub, err := domain.NewWebhookSubscription("https://example.com/hooks", testSecret, eventTypes, active)
+	if err != nil {
+		t.Fatalf("NewWebhookSubscription() unexpected error: %v", err)
+	}
+	// httptest の受信者はループバックのため、登録時の検証の後に差し替える
+	sub.URL = url
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.subscriptions = append(f.subscriptions, sub)
+	return sub
+}
+
+func (f *fakeRepository) delivery(i int) domain.WebhookDelivery {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return *f.deliveries[i]
+}
+
+func (f *fakeRepository) FindSubscriptionsByEventType(_ context.Context, _ infrastructure.DBTX, eventType domain.UserEventType) ([]*domain.WebhookSubscription, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	var result []*domain.WebhookSubscription
+	for _, sub := range f.subscriptions {
+		if sub.Subscribes(eventType) {
+			result = append(result, sub)
+		}
+	}
+	return result, nil
+}
+
+func (f *fakeRepository) CreateDelivery(_ context.Context, _ infrastructure.DBTX, d *domain.WebhookDelivery) (bool, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for _, existing := range f.deliveries {
+		if existing.SubscriptionID == d.SubscriptionID && existing.EventID == d.EventID {
+			return false, nil
+		}
+	}
+	copied := *d
+	f.deliveries = append(f.deliveries, &copied)
+	return true, nil
+}
+
+func (f *fakeRepository) ClaimDeliveries(_ context.Context, _ infrastructure.DBTX, now, leaseUntil time.Time, limit int) ([]Delivery, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	var result []Delivery
+	for _, d := range f.deliveries {
+		if d.Status != domain.WebhookDeliveryStatusPending || d.NextAttemptAt.After(now) || len(result) >= limit {
+			continue
+		}
+		d.NextAttemptAt = leaseUntil
+		for _, sub := range f.subscriptions {
+			if sub.ID == d.SubscriptionID {
+				result = append(result, Delivery{WebhookDelivery: *d, URL: sub.URL, Secret: sub.Secret})
+			}
+		}
+	}
+	return result, nil
+}
+
+func (f *fakeRepository) UpdateDelivery(_ context.Context, _ infrastructure.DBTX, d *domain.WebhookDelivery) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if d.ID == f.failUpdateID {
+		return errors.New("update failed")
+	}
+	for i, existing := range f.deliveries {
+		if existing.ID == d.ID {
+			copied := *d
+			f.deliveries[i] = &copied
+			return nil
+		}
+	}
+	return errors.New("delivery not found")
+}
+
+// fakeTxManager fn をそのまま実行する TransactionManager
+type fakeTxManager struct{}
+
+func (fakeTxManager) RunInTransaction(ctx context.Context, fn func(ctx context.Context, tx infrastructure.DBTX) error) error {
+	return fn(ctx, nil)
+}
+
+// testClock テスト用の進められる時計
+type testClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+func (c *testClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *testClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+}
+
+// receivedRequest 受信者が受け取ったリクエスト
+type receivedRequest struct {
+	header http.Header
+	body   []byte
+}
+
+// receiver 応答ステータスを順に返す httptest の受信者
+type receiver struct {
+	mu       sync.Mutex
+	statuses []int
+	received []receivedRequest
+}
+
+func newReceiver(t *testing.T, statuses ...int) (*receiver, *httptest.Server) {
+	t.Helper()
+	rcv := &receiver{statuses: statuses}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		rcv.mu.Lock()
+		rcv.received = append(rcv.received, receivedRequest{header: r.Header.Clone(), body: body})
+		status := http.StatusNoContent
+		if len(rcv.statuses) > 0 {
+			status, rcv.statuses = rcv.statuses[0], rcv.statuses[1:]
+		}
+		rcv.mu.Unlock()
+		w.WriteHeader(status)
+	}))
+	t.Cleanup(srv.Close)
+	return rcv, srv
+}
+
+func (r *receiver) requests() []receivedRequest {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]receivedRequest(nil), r.received...)
+}
+
+func newTestDispatcher(repo Repository, clock *testClock) *Dispatcher {
+	d := NewDispatcher(repo, fakeTxManager{}, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	d.now = clock.Now
+	return d
+}
+
+func newTestWorker(repo Repository, clock *testClock, opts ...Option) *Worker {
+	log := slog.New(slog.NewTextHandler(io.Discard, nil))
+	opts = append([]Option{withTransport(http.DefaultTransport), withClock(clock.Now), WithRetryBackoff(time.Second, 10*time.Second)}, opts...)
+	return NewWorker(repo, fakeTxManager{}, log, opts...)
+}
+
+// userCreatedEvent outbox から配信されるユーザー作成イベント
+func userCreatedEvent(id string, occurredAt time.Time) outbox.Event {
+	return outbox.Event{
+		ID:            id,
+		AggregateType: domain.UserAggregateType,
+		AggregateID:   "user-1",
+		Type:          string(domain.UserEventTypeCreated),
+		Payload:       json.RawMessage(`{"id":"user-1","status":"active"}`),
+		OccurredAt:    occurredAt,
+	}
+}
+
+func processOnce(t *testing.T, w *Worker) int {
+	t.Helper()
+	n, err := w.ProcessBatch(context.Background())
+	if err != nil {
+		t.Fatalf("ProcessBatch() unexpected error: %v", err)
+	}
+	return n
+}
+
+func TestDispatcher_SchedulesMatchingSubscriptions(t *testing.T) {
+	repo := &fakeRepository{}
+	created := repo.subscribe(t, "https://example.com/created", true, domain.UserEventTypeCreated)
+	repo.subscribe(t, "https://example.com/deleted", true, domain.UserEventTypeDeleted)
+	repo.subscribe(t, "https://example.com/inactive", false, domain.UserEventTypeCreated)
+
+	clock := &testClock{now: time.Now()}
+	dispatcher := newTestDispatcher(repo, clock)
+	event := userCreatedEvent("event-1", clock.Now())
+
+	// outbox の再配信（at-least-once）でも配信は一つだけ作成される
+	for i := 0; i < 2; i++ {
+		if err := dispatcher.Publish(context.Background(), event); err != nil {
+			t.Fatalf("Publish() unexpected error: %v", err)
+		}
+	}
+
+	if len(repo.deliveries) != 1 {
+		t.Fatalf("len(deliveries) = %d, want 1", len(repo.deliveries))
+	}
+	d := repo.delivery(0)
+	if d.SubscriptionID != created.ID || d.EventID != event.ID || d.Status != domain.WebhookDeliveryStatusPending {
+		t.Errorf("delivery = %+v, want pending delivery of %s to %s", d, event.ID, created.ID)
+	}
+
+	var envelope Envelope
+	if err := json.Unmarshal(d.Payload, &envelope); err != nil {
+		t.Fatalf("payload is not an envelope: %v", err)
+	}
+	if envelope.ID != event.ID || envelope.Type != event.Type || string(envelope.Data) != string(event.Payload) {
+		t.Errorf("envelope = %+v, want event %s with its payload", envelope, event.ID)
+	}
+}
+
+func TestWorker_DeliversSignedPayload(t *testing.T) {
+	rcv, srv := newReceiver(t, http.StatusOK)
+	repo := &fakeRepository{}
+	repo.subscribe(t, srv.URL, true, domain.UserEventTypeCreated)
+
+	clock := &testClock{now: time.Now()}
+	if err := newTestDispatcher(repo, clock).Publish(context.Background(), userCreatedEvent("event-1", clock.Now())); err != nil {
+		t.Fatalf("Publish() unexpected error: %v", err)
+	}
+	if n := processOnce(t, newTestWorker(repo, clock)); n != 1 {
+		t.Fatalf("ProcessBatch() = %d, want 1", n)
+	}
+
+	requests := rcv.requests()
+	if len(requests) != 1 {
+		t.Fatalf("receiver got %d requests, want 1", len(requests))
+	}
+	req := requests[0]
+	if err := Verify(testSecret, req.header.Get(SignatureHeader), req.body, clock.Now(), 5*time.Minute); err != nil {
+		t.Errorf("Verify() error = %v, want valid signature", err)
+	}
+	if got := req.header.Get(IDHeader); got != "event-1" {
+		t.Errorf("%s = %q, want %q", IDHeader, got, "event-1")
+	}
+	if got := req.header.Get(EventHeader); got != string(domain.UserEventTypeCreated) {
+		t.Errorf("%s = %q, want %q", EventHeader, got, domain.UserEventTypeCreated)
+	}
+	if got := req.header.Get("Content-Type"); got != "application/json" {
+		t.Errorf("Content-Type = %q, want application/json", got)
+	}
+
+	d := repo.delivery(0)
+	if d.Status != domain.WebhookDeliveryStatusSucceeded || d.Attempts != 1 || d.LastStatusCode != http.StatusOK {
+		t.Errorf("delivery = %+v, want succeeded after 1 attempt", d)
+	}
+}
+
+func TestWorker_RetriesWithBackoffThenDeadLetters(t *testing.T) {
+	rcv, srv := newReceiver(t, http.StatusInternalServerError, http.StatusServiceUnavailable, http.StatusBadGateway)
+	repo := &fakeRepository{}
+	repo.subscribe(t, srv.URL, true, domain.UserEventTypeCreated)
+
+	clock := &testClock{now: time.Now()}
+	if err := newTestDispatcher(repo, clock).Publish(context.Background(), userCreatedEvent("event-1", clock.Now())); err != nil {
+		t.Fatalf("Publish() unexpected error: %v", err)
+	}
+	worker := newTestWorker(repo, clock, WithMaxAttempts(3))
+
+	// 1回目の失敗: 1秒後に再配信
+	processOnce(t, worker)
+	d := repo.delivery(0)
+	if d.Status != domain.WebhookDeliveryStatusPending || d.Attempts != 1 || d.LastStatusCode != http.StatusInternalServerError {
+		t.Fatalf("after 1st attempt = %+v, want pending with status 500", d)
+	}
+	if want := clock.Now().Add(time.Second); !d.NextAttemptAt.Equal(want) {
+		t.Errorf("NextAttemptAt = %v, want %v", d.NextAttemptAt, want)
+	}
+
+	// バックオフ前は再配信しない
+	if n := processOnce(t, worker); n != 0 {
+		t.Fatalf("ProcessBatch() before backoff = %d, want 0", n)
+	}
+
+	// 2回目の失敗: 待ち時間が倍になる
+	clock.Advance(time.Second)
+	processOnce(t, worker)
+	d = repo.delivery(0)
+	if want := clock.Now().Add(2 * time.Second); d.Attempts != 2 || !d.NextAttemptAt.Equal(want) {
+		t.Errorf("after 2nd attempt = %+v, want retry at %v", d, want)
+	}
+
+	// 3回目の失敗: デッドレター
+	clock.Advance(2 * time.Second)
+	processOnce(t, worker)
+	d = repo.delivery(0)
+	if d.Status != domain.WebhookDeliveryStatusDead || d.Attempts != 3 || d.LastStatusCode != http.StatusBadGateway {
+		t.Errorf("after max attempts = %+v, want dead with status 502", d)
+	}
+
+	clock.Advance(time.Hour)
+	if n := processOnce(t, worker); n != 0 {
+		t.Errorf("ProcessBatch() after dead letter = %d, want 0", n)
+	}
+	if got := len(rcv.requests()); got != 3 {
+		t.Errorf("receiver got %d requests, want 3", got)
+	}
+}
+
+func TestWorker_ConnectionErrorIsRetried(t *testing.T) {
+	_, srv := newReceiver(t)
+	url := srv.URL
+	srv.Close()
+
+	repo := &fakeRepository{}
+	repo.subscribe(t, url, true, domain.UserEventTypeCreated)
+	clock := &testClock{now: time.Now()}
+	if err := newTestDispatcher(repo, clock).Publish(context.Background(), userCreatedEvent("event-1", clock.Now())); err != nil {
+		t.Fatalf("Publish() unexpected error: %v", err)
+	}
+
+	processOnce(t, newTestWorker(repo, clock))
+	d := repo.delivery(0)
+	if d.Status != domain.WebhookDeliveryStatusPending || d.Attempts != 1 || d.LastStatusCode != 0 || d.LastError == "" {
+		t.Errorf("delivery = %+v, want pending retry without status code", d)
+	}
+}
+
+func TestWorker_RefusesInternalAddress(t *testing.T) {
+	rcv, srv := newReceiver(t, http.StatusOK)
+	repo := &fakeRepository{}
+	repo.subscribe(t, srv.URL, true, domain.UserEventTypeCreated)
+	clock := &testClock{now: time.Now()}
+	if err := newTestDispatcher(repo, clock).Publish(context.Background(), userCreatedEvent("event-1", clock.Now())); err != nil {
+		t.Fatalf("Publish() unexpected error: %v", err)
+	}
+
+	// デフォルトのトランスポートはループバックに解決されたアドレスに接続しない
+	log := slog.New(slog.NewTextHandler(io.Discard, nil))
+	processOnce(t, NewWorker(repo, fakeTxManager{}, log, withClock(clock.Now)))
+	if got := len(rcv.requests()); got != 0 {
+		t.Errorf("receiver got %d requests, want 0", got)
+	}
+	if d := repo.delivery(0); d.Status != domain.WebhookDeliveryStatusPending || d.LastStatusCode != 0 || !strings.Contains(d.LastError, errAddressNotAllowed.Error()) {
+		t.Errorf("delivery = %+v, want pending retry with address not allowed", d)
+	}
+}
+
+func TestWorker_RedirectIsFailure(t *testing.T) {
+	_, target := newReceiver(t)
+	redirect := httptest.NewServer(http.RedirectHandler(target.URL, http.StatusFound))
+	t.Cleanup(redirect.Close)
+
+	repo := &fakeRepository{}
+	repo.subscribe(t, redirect.URL, true, domain.UserEventTypeCreated)
+	clock := &testClock{now: time.Now()}
+	if err := newTestDispatcher(repo, clock).Publish(context.Background(), userCreatedEvent("event-1", clock.Now())); err != nil {
+		t.Fatalf("Publish() unexpected error: %v", err)
+	}
+
+	processOnce(t, newTestWorker(repo, clock))
+	if d := repo.delivery(0); d.Status != domain.WebhookDeliveryStatusPending || d.LastStatusCode != http.StatusFound {
+		t.Errorf("delivery = %+v, want pending retry with status 302", d)
+	}
+}
+
+func TestWorker_CanceledDeliveryIsNotRecordedAsFailure(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	srv := httptest.NewServer(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {
+		cancel()
+	}))
+	t.Cleanup(srv.Close)
+
+	repo := &fakeRepository{}
+	repo.subscribe(t, srv.URL, true, domain.UserEventTypeCreated)
+	clock := &testClock{now: time.Now()}
+	if err := newTestDispatcher(repo, clock).Publish(context.Background(), userCreatedEvent("event-1", clock.Now())); err != nil {
+		t.Fatalf("Publish() unexpected error: %v", err)
+	}
+
+	if _, err := newTestWorker(repo, clock).ProcessBatch(ctx); !errors.Is(err, context.Canceled) {
+		t.Fatalf("ProcessBatch() error = %v, want context.Canceled", err)
+	}
+	if d := repo.delivery(0); d.Attempts != 0 || d.Status != domain.WebhookDeliveryStatusPending {
+		t.Errorf("delivery = %+v, want untouched", d)
+	}
+
+	// 中断した配信はリースが切れるまで再送しない
+	worker := newTestWorker(repo, clock)
+	if n := processOnce(t, worker); n != 0 {
+		t.Errorf("ProcessBatch() during lease = %d, want 0", n)
+	}
+	clock.Advance(worker.lease())
+	if n := processOnce(t, worker); n != 1 {
+		t.Errorf("ProcessBatch() after lease = %d, want 1", n)
+	}
+}
+
+func TestWorker_RecordsEachResultSeparately(t *testing.T) {
+	rcv, srv := newReceiver(t, http.StatusOK, http.StatusOK)
+	repo := &fakeRepository{}
+	repo.subscribe(t, srv.URL, true, domain.UserEventTypeCreated)
+	repo.subscribe(t, srv.URL, true, domain.UserEventTypeCreated)
+	clock := &testClock{now: time.Now()}
+	if err := newTestDispatcher(repo, clock).Publish(context.Background(), userCreatedEvent("event-1", clock.Now())); err != nil {
+		t.Fatalf("Publish() unexpected error: %v", err)
+	}
+	repo.failUpdateID = repo.delivery(1).ID
+
+	// 2件目の結果を記録できなくても、記録済みの1件目の成功は取り消されない
+	worker := newTestWorker(repo, clock)
+	if n, err := worker.ProcessBatch(context.Background()); err == nil || n != 1 {
+		t.Fatalf("ProcessBatch() = %d, %v, want 1 and error", n, err)
+	}
+	if d := repo.delivery(0); d.Status != domain.WebhookDeliveryStatusSucceeded {
+		t.Errorf("first delivery = %+v, want succeeded", d)
+	}
+	if d := repo.delivery(1); d.Status != domain.WebhookDeliveryStatusPending || d.Attempts != 0 {
+		t.Errorf("second delivery = %+v, want pending without attempts", d)
+	}
+
+	// 記録できなかった配信のみリースが切れた後に再送する
+	repo.failUpdateID = ""
+	clock.Advance(worker.lease())
+	if n := processOnce(t, worker); n != 1 {
+		t.Errorf("ProcessBatch() after lease = %d, want 1", n)
+	}
+	if got := len(rcv.requests()); got != 3 {
+		t.Errorf("receiver got %d requests, want 3", got)
+	}
+}
@@ -0,0 +1,197 @@
+// Package client は User Management API の Go クライアントです
+//
+// pkg/generated/openapi の生成クライアントを薄くラップし、以下を提供します:
+//   - 5xx / 429 と通信エラーに対する指数バックオフ付きリトライ（Retry-After を尊重。POST / PATCH は 429 と送信前のエラーのみ）
+//   - コンテキストからの X-Request-ID の伝搬
+//   - Error モデルの型付きエラー（*APIError）へのデコード
+//
+// 使用例:
+//
+//	c, err := client.New("http://localhost:8080/api/v1")
+//	ctx = client.WithRequestID(ctx, requestID)
+//	user, err := c.GetUser(ctx, id)
+//	if client.IsNotFound(err) { ... }
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/example/go-react-cqrs-template/pkg/generated/openapi"
+)
+
+const (
+	// RequestIDHeader はリクエストIDを伝搬するヘッダー
+	RequestIDHeader = "X-Request-ID"
+	// OrgIDHeader は対象の組織（テナント）を指定するヘッダー
+	OrgIDHeader = "X-Org-ID"
+)
+
+// Client は User Management API のクライアント
+type Client struct {
+	api *openapi.ClientWithResponses
+}
+
+// options はクライアントの設定
+type options struct {
+	httpClient    openapi.HttpRequestDoer
+	retry         RetryPolicy
+	requestIDFunc func(ctx context.Context) string
+}
+
+// Option はクライアントの設定を変更する
+type Option func(*options)
+
+// WithHTTPClient は内部で使用する HTTP クライアントを差し替える
+func WithHTTPClient(doer openapi.HttpRequestDoer) Option {
+	return func(o *options) {
+		o.httpClient = doer
+	}
+}
+
+// WithRetryPolicy はリトライ方針を設定する
+func WithRetryPolicy(policy RetryPolicy) Option {
+	return func(o *options) {
+		o.retry = policy
+	}
+}
+
+// WithRequestIDFunc はコンテキストからリクエストIDを取り出す関数を設定する
+//
+// サーバー側で独自のコンテキストキーを使っている場合に利用する。デフォルトは RequestIDFromContext。
+func WithRequestIDFunc(fn func(ctx context.Context) string) Option {
+	return func(o *options) {
+		o.requestIDFunc = fn
+	}
+}
+
+// New は新しいクライアントを作成する
+//
+// baseURL はAPIのベースURL（例: http://localhost:8080/api/v1）。
+func New(baseURL string, opts ...Option) (*Client, error) {
+	o := options{
+		httpClient:    http.DefaultClient,
+		retry:         DefaultRetryPolicy(),
+		requestIDFunc: RequestIDFromContext,
+	}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	api, err := openapi.NewClientWithResponses(
+		baseURL,
+		openapi.WithHTTPClient(&retryDoer{doer: o.httpClient, policy: o.retry}),
+		openapi.WithRequestEditorFn(requestIDEditor(o.requestIDFunc)),
+		openapi.WithRequestEditorFn(orgIDEditor),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create client: %w", err)
+	}
+	return &Client{api: api}, nil
+}
+
+// ListUsers ユーザー一覧を取得する
+func (c *Client) ListUsers(ctx context.Context, limit, offset int32) (*openapi.UserList, error) {
+	resp, err := c.api.UsersListUsersWithResponse(ctx, &openapi.UsersListUsersParams{
+		Limit:  &limit,
+		Offset: &offset,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if resp.JSON200 == nil {
+		return nil, newAPIError(resp.HTTPResponse, resp.Body)
+	}
+	return resp.JSON200, nil
+}
+
+// CreateUser ユーザーを作成する
+func (c *Client) CreateUser(ctx context.Context, req openapi.CreateUserRequest) error {
+	resp, err := c.api.UsersCreateUserWithResponse(ctx, req)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode() != http.StatusCreated {
+		return newAPIError(resp.HTTPResponse, resp.Body)
+	}
+	return nil
+}
+
+// GetUser ユーザーを取得する
+func (c *Client) GetUser(ctx context.Context, userID string) (*openapi.User, error) {
+	resp, err := c.api.UsersGetUserWithResponse(ctx, userID, nil)
+	if err != nil {
+		return nil, err
+	}
+	if resp.JSON200 == nil {
+		return nil, newAPIError(resp.HTTPResponse, resp.Body)
+	}
+	return resp.JSON200, nil
+}
+
+// UpdateUser ユーザーを更新する
+func (c *Client) UpdateUser(ctx context.Context, userID string, req openapi.UpdateUserRequest) error {
+	resp, err := c.api.UsersUpdateUserWithResponse(ctx, userID, req)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode() != http.StatusNoContent {
+		return newAPIError(resp.HTTPResponse, resp.Body)
+	}
+	return nil
+}
+
+// PatchUser JSON Merge Patch（RFC 7396）でユーザーを部分更新し、更新後のユーザーを返す
+//
+// patch は JSON のオブジェクトにエンコードされ、含めた項目だけが変更される（例: map[string]any{"name": "John Smith"}）。
+// openapi.UserMergePatch は未指定の項目も null として送るため、そのままでは使用しないこと。
+func (c *Client) PatchUser(ctx context.Context, userID string, patch any) (*openapi.User, error) {
+	body, err := json.Marshal(patch)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.api.UsersPatchUserWithBodyWithResponse(ctx, userID, "application/merge-patch+json", bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	if resp.JSON200 == nil {
+		return nil, newAPIError(resp.HTTPResponse, resp.Body)
+	}
+	return resp.JSON200, nil
+}
+
+// DeleteUser ユーザーを削除する
+func (c *Client) DeleteUser(ctx context.Context, userID string) error {
+	resp, err := c.api.UsersDeleteUserWithResponse(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode() != http.StatusNoContent {
+		return newAPIError(resp.HTTPResponse, resp.Body)
+	}
+	return nil
+}
+
+// BatchUsers ユーザーを一括で作成・更新・削除する
+//
+// 操作ごとの成否は結果の Status / Code で判定する（一部の操作が失敗してもエラーにはならない）。
+func (c *Client) BatchUsers(ctx context.Context, req openapi.BatchUsersRequest) (*openapi.BatchUsersResponse, error) {
+	resp, err := c.api.UsersBatchUsersWithResponse(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.JSON200 == nil {
+		return nil, newAPIError(resp.HTTPResponse, resp.Body)
+	}
+	return resp.JSON200, nil
+}
+
+// API は生成クライアントを返す（ラッパーが未対応のオペレーションを呼ぶ場合に使用）
+//
+// 返されるクライアントにもリトライ・リクエストIDの処理が適用される。
+func (c *Client) API() openapi.ClientWithResponsesInterface {
+	return c.api
+}
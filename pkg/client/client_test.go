@@ -0,0 +1,431 @@
+package client_test
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+
+	"github.com/example/go-react-cqrs-template/internal/auth"
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/eventstream"
+	"github.com/example/go-react-cqrs-template/internal/handler"
+	"github.com/example/go-react-cqrs-template/internal/handler/validation"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure/memory"
+	"github.com/example/go-react-cqrs-template/internal/mail"
+	"github.com/example/go-react-cqrs-template/internal/pkg/logger"
+	"github.com/example/go-react-cqrs-template/internal/usecase"
+	openapispec "github.com/example/go-react-cqrs-template/openapi"
+	"github.com/example/go-react-cqrs-template/pkg/client"
+	"github.com/example/go-react-cqrs-template/pkg/generated/openapi"
+)
+
+// fastRetry はテスト用の待ち時間の短いリトライ方針
+var fastRetry = client.RetryPolicy{
+	MaxAttempts:    3,
+	InitialBackoff: time.Millisecond,
+	MaxBackoff:     10 * time.Millisecond,
+}
+
+// newContractServer インメモリストア上の実ハンドラーを起動する
+func newContractServer(t *testing.T) *httptest.Server {
+	t.Helper()
+	log := slog.New(slog.NewTextHandler(io.Discard, nil))
+	store := memory.NewStore()
+
+	userHandler := handler.NewUserHandler(
+		usecase.NewCreateUserUsecase(store, store, store),
+		usecase.NewFindUserUsecase(store),
+		usecase.NewListUsersUsecase(store),
+		usecase.NewUpdateUserUsecase(store, store, store),
+		usecase.NewPatchUserUsecase(store, store, store),
+		usecase.NewDeleteUserUsecase(store, store, store),
+		usecase.NewBatchUsersUsecase(store, store),
+		usecase.NewChangeUserStatusUsecase(store, store, store),
+		log,
+	)
+	webhookHandler := handler.NewWebhookHandler(
+		usecase.NewCreateWebhookSubscriptionUsecase(store, store),
+		usecase.NewFindWebhookSubscriptionUsecase(store),
+		usecase.NewListWebhookSubscriptionsUsecase(store),
+		usecase.NewUpdateWebhookSubscriptionUsecase(store, store),
+		usecase.NewDeleteWebhookSubscriptionUsecase(store, store),
+		usecase.NewListWebhookDeliveriesUsecase(store),
+		log,
+	)
+	findOrganization := usecase.NewFindOrganizationUsecase(store)
+	organizationHandler := handler.NewOrganizationHandler(
+		usecase.NewCreateOrganizationUsecase(store, store),
+		findOrganization,
+		usecase.NewListOrganizationsUsecase(store),
+		log,
+	)
+	orgUserHandler := handler.NewOrgUserHandler(userHandler, findOrganization, log)
+	groupHandler := handler.NewGroupHandler(
+		usecase.NewCreateGroupUsecase(store, store),
+		usecase.NewFindGroupUsecase(store),
+		usecase.NewAddGroupMembersUsecase(store, store, store),
+		usecase.NewRemoveGroupMembersUsecase(store, store, store),
+		usecase.NewListGroupMembersUsecase(store),
+		usecase.NewListUserGroupsUsecase(store, store),
+		log,
+	)
+	sessions, err := auth.NewTokenIssuer([]byte("0123456789abcdef0123456789abcdef"))
+	if err != nil {
+		t.Fatalf("failed to create token issuer: %v", err)
+	}
+	hasher := auth.NewPasswordHasher(auth.Argon2Params{Memory: 64, Iterations: 1, Parallelism: 1, SaltLength: 16, KeyLength: 32})
+	mailer := mail.NewLogMailer(log)
+	authHandler := handler.NewAuthHandler(
+		usecase.NewLoginUsecase(store, store, store, store, store, store, hasher, sessions, store, domain.DefaultLockoutPolicy, domain.DefaultSessionPolicy, domain.DefaultMFAPolicy),
+		usecase.NewChangePasswordUsecase(store, store, hasher, store, domain.DefaultLockoutPolicy),
+		usecase.NewRequestEmailVerificationUsecase(store, store, mailer, store, usecase.DefaultUserTokenConfig),
+		usecase.NewVerifyEmailUsecase(store, store, store),
+		usecase.NewRequestPasswordResetUsecase(store, store, mailer, store, usecase.DefaultUserTokenConfig),
+		usecase.NewResetPasswordUsecase(store, store, store, store, hasher, store),
+		log,
+	)
+	invitationHandler := handler.NewInvitationHandler(
+		usecase.NewCreateInvitationUsecase(store, store, mailer, store, usecase.DefaultUserTokenConfig),
+		usecase.NewListInvitationsUsecase(store),
+		usecase.NewResendInvitationUsecase(store, store, mailer, store, usecase.DefaultUserTokenConfig),
+		usecase.NewRevokeInvitationUsecase(store, store, store),
+		usecase.NewAcceptInvitationUsecase(store, store, store, hasher, store),
+		log,
+	)
+
+	sessionHandler := handler.NewSessionHandler(
+		usecase.NewListSessionsUsecase(store, store, domain.DefaultSessionPolicy),
+		usecase.NewRevokeSessionUsecase(store, store, store),
+		usecase.NewRevokeAllSessionsUsecase(store, store, store),
+		log,
+	)
+	totp := auth.NewTOTP("Example")
+	mfaHandler := handler.NewMFAHandler(
+		usecase.NewEnrollTOTPUsecase(store, store, store, hasher, totp, store, domain.DefaultLockoutPolicy),
+		usecase.NewConfirmTOTPUsecase(store, store, store, totp, store, domain.DefaultLockoutPolicy),
+		usecase.NewDisableTOTPUsecase(store, store, store, store, totp, store, domain.DefaultLockoutPolicy, domain.DefaultMFAPolicy),
+		usecase.NewVerifyMFALoginUsecase(store, store, store, store, store, totp, sessions, store, domain.DefaultLockoutPolicy, domain.DefaultSessionPolicy),
+		log,
+	)
+	oidcHandler := handler.NewOIDCHandler(
+		usecase.NewStartOIDCLoginUsecase(store, nil, store, usecase.DefaultOIDCConfig),
+		usecase.NewCompleteOIDCLoginUsecase(store, store, store, store, store, store, store, usecase.NewCreateUserUsecase(store, store, store), nil, sessions, store, usecase.DefaultOIDCConfig, domain.DefaultSessionPolicy, domain.DefaultMFAPolicy),
+		log,
+	)
+
+	validationMiddleware, err := validation.NewMiddleware(
+		openapispec.Spec,
+		validation.WithResponseValidation(validation.ResponseModeStrict),
+	)
+	if err != nil {
+		t.Fatalf("failed to create validation middleware: %v", err)
+	}
+
+	r := chi.NewRouter()
+	r.Use(logger.Middleware)
+	r.Route("/api/v1", func(r chi.Router) {
+		r.Use(validationMiddleware.Handler)
+		userStreamHandler := handler.NewUserStreamHandler(eventstream.NewHub(store, log), 0, log)
+		openapi.HandlerFromMux(handler.NewServer(userHandler, webhookHandler, userStreamHandler, organizationHandler, orgUserHandler, groupHandler, authHandler, invitationHandler, sessionHandler, mfaHandler, oidcHandler), r)
+	})
+
+	srv := httptest.NewServer(r)
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+// newClient テスト用のクライアントを作成する
+func newClient(t *testing.T, baseURL string, opts ...client.Option) *client.Client {
+	t.Helper()
+	opts = append([]client.Option{client.WithRetryPolicy(fastRetry)}, opts...)
+	c, err := client.New(baseURL, opts...)
+	if err != nil {
+		t.Fatalf("client.New() unexpected error: %v", err)
+	}
+	return c
+}
+
+func TestClient_Contract(t *testing.T) {
+	srv := newContractServer(t)
+	c := newClient(t, srv.URL+"/api/v1")
+	ctx := context.Background()
+
+	// 作成
+	if err := c.CreateUser(ctx, openapi.CreateUserRequest{Name: "John Doe", Email: "john@example.com"}); err != nil {
+		t.Fatalf("CreateUser() unexpected error: %v", err)
+	}
+
+	// 重複は APIError(409) にデコードされる
+	err := c.CreateUser(ctx, openapi.CreateUserRequest{Name: "Other", Email: "john@example.com"})
+	if !client.IsConflict(err) {
+		t.Fatalf("CreateUser() duplicate error = %v, want conflict", err)
+	}
+
+	// バリデーションエラー
+	err = c.CreateUser(ctx, openapi.CreateUserRequest{Name: "", Email: "jane@example.com"})
+	if !client.IsBadRequest(err) {
+		t.Fatalf("CreateUser() invalid email error = %v, want bad request", err)
+	}
+
+	// 一覧
+	list, err := c.ListUsers(ctx, 10, 0)
+	if err != nil {
+		t.Fatalf("ListUsers() unexpected error: %v", err)
+	}
+	if list.Total != 1 || len(list.Users) != 1 {
+		t.Fatalf("ListUsers() = %+v, want one user", list)
+	}
+	id := list.Users[0].Id
+
+	// 置き換え
+	name := "John Smith"
+	if err := c.UpdateUser(ctx, id, openapi.UpdateUserRequest{Name: "John", Email: "smith@example.com"}); err != nil {
+		t.Fatalf("UpdateUser() unexpected error: %v", err)
+	}
+
+	// 部分更新（メールアドレスは置き換え後の値のまま）
+	patched, err := c.PatchUser(ctx, id, map[string]any{"name": name})
+	if err != nil {
+		t.Fatalf("PatchUser() unexpected error: %v", err)
+	}
+	if patched.Name != name || patched.Email != "smith@example.com" {
+		t.Errorf("PatchUser() = %+v, want %s <smith@example.com>", patched, name)
+	}
+
+	// 一括操作（失敗した操作は結果のステータスで返る）
+	batchName, batchEmail, missingID := "Batch", openapi_types.Email("batch@example.com"), "01ARZ3NDEKTSV4RRFFQ69G5FAV"
+	batch, err := c.BatchUsers(ctx, openapi.BatchUsersRequest{Operations: []openapi.BatchUserOperation{
+		{Method: openapi.Create, Name: &batchName, Email: &batchEmail},
+		{Method: openapi.Delete, Id: &missingID},
+	}})
+	if err != nil {
+		t.Fatalf("BatchUsers() unexpected error: %v", err)
+	}
+	if len(batch.Results) != 2 || batch.Results[0].Status != http.StatusCreated || batch.Results[1].Status != http.StatusNotFound {
+		t.Errorf("BatchUsers() = %+v, want created and not found", batch.Results)
+	}
+
+	// 取得
+	user, err := c.GetUser(ctx, id)
+	if err != nil {
+		t.Fatalf("GetUser() unexpected error: %v", err)
+	}
+	if user.Name != name {
+		t.Errorf("GetUser().Name = %q, want %q", user.Name, name)
+	}
+
+	// 削除
+	if err := c.DeleteUser(ctx, id); err != nil {
+		t.Fatalf("DeleteUser() unexpected error: %v", err)
+	}
+	_, err = c.GetUser(ctx, id)
+	if !client.IsNotFound(err) {
+		t.Fatalf("GetUser() after delete error = %v, want not found", err)
+	}
+}
+
+func TestClient_RequestIDPropagation(t *testing.T) {
+	srv := newContractServer(t)
+	c := newClient(t, srv.URL+"/api/v1")
+
+	ctx := client.WithRequestID(context.Background(), "req-123")
+	_, err := c.GetUser(ctx, "01ARZ3NDEKTSV4RRFFQ69G5FAV")
+
+	apiErr, ok := err.(*client.APIError)
+	if !ok {
+		t.Fatalf("GetUser() error = %T, want *client.APIError", err)
+	}
+	if apiErr.RequestID != "req-123" {
+		t.Errorf("APIError.RequestID = %q, want %q", apiErr.RequestID, "req-123")
+	}
+	if apiErr.Message == "" {
+		t.Error("APIError.Message is empty")
+	}
+}
+
+func TestClient_Retry(t *testing.T) {
+	tests := []struct {
+		name         string
+		statuses     []int
+		retryAfter   string
+		call         func(ctx context.Context, c *client.Client) error
+		wantAttempts int32
+		wantErr      bool
+	}{
+		{
+			name:         "retries 503 then succeeds",
+			statuses:     []int{http.StatusServiceUnavailable, http.StatusNoContent},
+			call:         func(ctx context.Context, c *client.Client) error { return c.DeleteUser(ctx, "id") },
+			wantAttempts: 2,
+		},
+		{
+			name:         "retries 429 honoring Retry-After",
+			statuses:     []int{http.StatusTooManyRequests, http.StatusNoContent},
+			retryAfter:   "0",
+			call:         func(ctx context.Context, c *client.Client) error { return c.DeleteUser(ctx, "id") },
+			wantAttempts: 2,
+		},
+		{
+			name:       "retries POST on 429",
+			statuses:   []int{http.StatusTooManyRequests, http.StatusCreated},
+			retryAfter: "0",
+			call: func(ctx context.Context, c *client.Client) error {
+				return c.CreateUser(ctx, openapi.CreateUserRequest{Name: "John", Email: "john@example.com"})
+			},
+			wantAttempts: 2,
+		},
+		{
+			name:     "does not retry POST on 5xx",
+			statuses: []int{http.StatusBadGateway},
+			call: func(ctx context.Context, c *client.Client) error {
+				return c.CreateUser(ctx, openapi.CreateUserRequest{Name: "John", Email: "john@example.com"})
+			},
+			wantAttempts: 1,
+			wantErr:      true,
+		},
+		{
+			name:         "gives up after max attempts",
+			statuses:     []int{http.StatusInternalServerError, http.StatusInternalServerError, http.StatusInternalServerError},
+			call:         func(ctx context.Context, c *client.Client) error { return c.DeleteUser(ctx, "id") },
+			wantAttempts: 3,
+			wantErr:      true,
+		},
+		{
+			name:         "does not retry 4xx",
+			statuses:     []int{http.StatusNotFound},
+			call:         func(ctx context.Context, c *client.Client) error { return c.DeleteUser(ctx, "id") },
+			wantAttempts: 1,
+			wantErr:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var attempts atomic.Int32
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				n := attempts.Add(1)
+				// リトライ時にもボディが再送されていること
+				if r.Method == http.MethodPost {
+					if body, _ := io.ReadAll(r.Body); len(body) == 0 {
+						t.Errorf("attempt %d: request body is empty", n)
+					}
+				}
+				if tt.retryAfter != "" {
+					w.Header().Set("Retry-After", tt.retryAfter)
+				}
+				status := tt.statuses[n-1]
+				if status < http.StatusBadRequest {
+					w.WriteHeader(status)
+					return
+				}
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(status)
+				io.WriteString(w, `{"message":"error"}`)
+			}))
+			t.Cleanup(srv.Close)
+
+			err := tt.call(context.Background(), newClient(t, srv.URL))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got := attempts.Load(); got != tt.wantAttempts {
+				t.Errorf("attempts = %d, want %d", got, tt.wantAttempts)
+			}
+		})
+	}
+}
+
+// failingDoer 最初の呼び出しで err を返し、以降は 204 を返す openapi.HttpRequestDoer
+type failingDoer struct {
+	err      error
+	attempts atomic.Int32
+}
+
+func (d *failingDoer) Do(*http.Request) (*http.Response, error) {
+	if d.attempts.Add(1) == 1 {
+		return nil, d.err
+	}
+	return &http.Response{StatusCode: http.StatusNoContent, Header: http.Header{}, Body: http.NoBody}, nil
+}
+
+func TestClient_RetryTransportError(t *testing.T) {
+	dialErr := &net.OpError{Op: "dial", Net: "tcp", Err: syscall.ECONNREFUSED}
+	createUser := func(ctx context.Context, c *client.Client) error {
+		return c.CreateUser(ctx, openapi.CreateUserRequest{Name: "John", Email: "john@example.com"})
+	}
+	deleteUser := func(ctx context.Context, c *client.Client) error { return c.DeleteUser(ctx, "id") }
+
+	tests := []struct {
+		name         string
+		err          error
+		call         func(ctx context.Context, c *client.Client) error
+		wantAttempts int32
+	}{
+		{name: "retries POST before send", err: dialErr, call: createUser, wantAttempts: 2},
+		{name: "does not retry POST after send", err: io.ErrUnexpectedEOF, call: createUser, wantAttempts: 1},
+		{name: "retries DELETE after send", err: io.ErrUnexpectedEOF, call: deleteUser, wantAttempts: 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			doer := &failingDoer{err: tt.err}
+			_ = tt.call(context.Background(), newClient(t, "http://example.com", client.WithHTTPClient(doer)))
+			if got := doer.attempts.Load(); got != tt.wantAttempts {
+				t.Errorf("attempts = %d, want %d", got, tt.wantAttempts)
+			}
+		})
+	}
+}
+
+func TestClient_OrgID(t *testing.T) {
+	var gotOrgID string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotOrgID = r.Header.Get(client.OrgIDHeader)
+		w.WriteHeader(http.StatusCreated)
+	}))
+	t.Cleanup(srv.Close)
+
+	c := newClient(t, srv.URL)
+	ctx := client.WithOrgID(context.Background(), "01ARZ3NDEKTSV4RRFFQ69G5FAV")
+	if err := c.CreateUser(ctx, openapi.CreateUserRequest{Name: "John", Email: "john@example.com"}); err != nil {
+		t.Fatalf("CreateUser() unexpected error: %v", err)
+	}
+	if gotOrgID != "01ARZ3NDEKTSV4RRFFQ69G5FAV" {
+		t.Errorf("%s = %q, want %q", client.OrgIDHeader, gotOrgID, "01ARZ3NDEKTSV4RRFFQ69G5FAV")
+	}
+}
+
+func TestClient_RetryStopsOnContextCancel(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "60")
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	t.Cleanup(srv.Close)
+
+	c := newClient(t, srv.URL, client.WithRetryPolicy(client.RetryPolicy{
+		MaxAttempts:    3,
+		InitialBackoff: time.Millisecond,
+		MaxBackoff:     time.Minute,
+	}))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	if err := c.DeleteUser(ctx, "id"); err == nil {
+		t.Fatal("DeleteUser() expected error, got nil")
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("DeleteUser() took %v, want it to stop on context cancel", elapsed)
+	}
+}
@@ -0,0 +1,58 @@
+package client
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/example/go-react-cqrs-template/pkg/generated/openapi"
+)
+
+type contextKey string
+
+const (
+	requestIDKey contextKey = "request_id"
+	orgIDKey     contextKey = "org_id"
+)
+
+// WithRequestID はリクエストIDをコンテキストに設定する
+//
+// 設定したIDは X-Request-ID ヘッダーとしてサーバーに伝搬される。
+func WithRequestID(ctx context.Context, requestID string) context.Context {
+	return context.WithValue(ctx, requestIDKey, requestID)
+}
+
+// RequestIDFromContext は WithRequestID で設定したリクエストIDを取得する
+func RequestIDFromContext(ctx context.Context) string {
+	if id, ok := ctx.Value(requestIDKey).(string); ok {
+		return id
+	}
+	return ""
+}
+
+// WithOrgID は対象の組織（テナント）をコンテキストに設定する
+//
+// 設定した組織IDは X-Org-ID ヘッダーとしてサーバーに送られる。未設定の場合はサーバーの既定の組織を対象にする。
+func WithOrgID(ctx context.Context, orgID string) context.Context {
+	return context.WithValue(ctx, orgIDKey, orgID)
+}
+
+// orgIDEditor はコンテキストの組織IDをヘッダーに設定する
+func orgIDEditor(ctx context.Context, req *http.Request) error {
+	if orgID, ok := ctx.Value(orgIDKey).(string); ok && orgID != "" && req.Header.Get(OrgIDHeader) == "" {
+		req.Header.Set(OrgIDHeader, orgID)
+	}
+	return nil
+}
+
+// requestIDEditor はコンテキストのリクエストIDをヘッダーに設定する
+func requestIDEditor(fn func(ctx context.Context) string) openapi.RequestEditorFn {
+	return func(ctx context.Context, req *http.Request) error {
+		if req.Header.Get(RequestIDHeader) != "" {
+			return nil
+		}
+		if id := fn(ctx); id != "" {
+			req.Header.Set(RequestIDHeader, id)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,74 @@
+package client
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/example/go-react-cqrs-template/pkg/generated/openapi"
+)
+
+// APIError はAPIが返したエラーレスポンス（Error モデル）を表す
+type APIError struct {
+	// StatusCode はHTTPステータスコード
+	StatusCode int
+	// Message はサーバーが返したユーザー向けメッセージ
+	Message string
+	// Code はサーバーが返したエラーコード（任意）
+	Code string
+	// RequestID はサーバーが処理したリクエストのID（ログ突合用）
+	RequestID string
+}
+
+func (e *APIError) Error() string {
+	if e.Code != "" {
+		return fmt.Sprintf("api error %d (%s): %s", e.StatusCode, e.Code, e.Message)
+	}
+	return fmt.Sprintf("api error %d: %s", e.StatusCode, e.Message)
+}
+
+// newAPIError はレスポンスを APIError に変換する
+func newAPIError(resp *http.Response, body []byte) *APIError {
+	apiErr := &APIError{
+		StatusCode: resp.StatusCode,
+		RequestID:  resp.Header.Get(RequestIDHeader),
+	}
+
+	var model openapi.Error
+	if err := json.Unmarshal(body, &model); err == nil && model.Message != "" {
+		apiErr.Message = model.Message
+		if model.Code != nil {
+			apiErr.Code = *model.Code
+		}
+		return apiErr
+	}
+
+	// Error モデル以外のボディ（プロキシのエラーページなど）
+	apiErr.Message = http.StatusText(resp.StatusCode)
+	return apiErr
+}
+
+// StatusCode はエラーが APIError の場合にそのステータスコードを返す（それ以外は 0）
+func StatusCode(err error) int {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.StatusCode
+	}
+	return 0
+}
+
+// IsNotFound はリソースが存在しないエラーか判定する
+func IsNotFound(err error) bool {
+	return StatusCode(err) == http.StatusNotFound
+}
+
+// IsConflict は競合エラー（メールアドレス重複など）か判定する
+func IsConflict(err error) bool {
+	return StatusCode(err) == http.StatusConflict
+}
+
+// IsBadRequest はバリデーションエラーか判定する
+func IsBadRequest(err error) bool {
+	return StatusCode(err) == http.StatusBadRequest
+}
@@ -0,0 +1,162 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/example/go-react-cqrs-template/pkg/generated/openapi"
+)
+
+// RetryPolicy はリトライの方針
+type RetryPolicy struct {
+	// MaxAttempts は最初の試行を含む最大試行回数（1 以下ならリトライしない）
+	MaxAttempts int
+	// InitialBackoff は最初のリトライまでの待ち時間
+	InitialBackoff time.Duration
+	// MaxBackoff は待ち時間の上限（Retry-After もこの値で切り詰める）
+	MaxBackoff time.Duration
+}
+
+// DefaultRetryPolicy はデフォルトのリトライ方針を返す
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts:    3,
+		InitialBackoff: 100 * time.Millisecond,
+		MaxBackoff:     2 * time.Second,
+	}
+}
+
+// retryDoer は 5xx / 429 と通信エラーをバックオフ付きでリトライする openapi.HttpRequestDoer
+//
+// 冪等でないメソッド（POST / PATCH）は、サーバーが処理していない 429 と送信前のエラーのみリトライする。
+type retryDoer struct {
+	doer   openapi.HttpRequestDoer
+	policy RetryPolicy
+}
+
+// Do はリクエストを実行し、必要に応じてリトライする
+func (d *retryDoer) Do(req *http.Request) (*http.Response, error) {
+	attempts := max(d.policy.MaxAttempts, 1)
+	if !canRewind(req) {
+		attempts = 1
+	}
+
+	for attempt := 1; ; attempt++ {
+		resp, err := d.doer.Do(req)
+		if attempt >= attempts || !shouldRetry(req, resp, err) || req.Context().Err() != nil {
+			return resp, err
+		}
+
+		wait := d.backoff(attempt, resp)
+		if resp != nil {
+			// 接続を再利用できるようボディを読み捨てる
+			io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}
+
+		next, rewindErr := rewind(req)
+		if rewindErr != nil {
+			return nil, rewindErr
+		}
+		req = next
+
+		if err := sleep(req.Context(), wait); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// backoff は次の試行までの待ち時間を計算する（Retry-After があればそれを優先）
+func (d *retryDoer) backoff(attempt int, resp *http.Response) time.Duration {
+	if resp != nil {
+		if wait, ok := retryAfter(resp.Header.Get("Retry-After")); ok {
+			return min(wait, d.policy.MaxBackoff)
+		}
+	}
+	wait := d.policy.InitialBackoff << (attempt - 1)
+	if wait <= 0 || wait > d.policy.MaxBackoff {
+		wait = d.policy.MaxBackoff
+	}
+	// 待ち時間の半分をランダムにして（イコールジッター）同時リトライの集中を避ける
+	return wait/2 + rand.N(wait/2+1)
+}
+
+// canRewind はリクエストボディを再送できるか判定する
+func canRewind(req *http.Request) bool {
+	return req.Body == nil || req.Body == http.NoBody || req.GetBody != nil
+}
+
+// shouldRetry はレスポンスがリトライ対象か判定する
+//
+// 冪等なメソッドは 5xx / 429 と通信エラーをリトライする。冪等でないメソッドは、サーバーが処理していない 429 と
+// 送信前のエラーのみリトライする（5xx や送信後のエラーは処理済みの可能性があり、二重に作成・更新されうる）。
+func shouldRetry(req *http.Request, resp *http.Response, err error) bool {
+	if err != nil {
+		return isIdempotent(req.Method) || notSent(err)
+	}
+	if resp.StatusCode == http.StatusTooManyRequests {
+		return true
+	}
+	return isIdempotent(req.Method) && resp.StatusCode >= http.StatusInternalServerError
+}
+
+// isIdempotent はメソッドが冪等か判定する
+func isIdempotent(method string) bool {
+	return method != http.MethodPost && method != http.MethodPatch
+}
+
+// notSent はエラーがリクエストの送信前（名前解決・接続の確立）に起きたか判定する
+func notSent(err error) bool {
+	var opErr *net.OpError
+	if errors.As(err, &opErr) && opErr.Op == "dial" {
+		return true
+	}
+	var dnsErr *net.DNSError
+	return errors.As(err, &dnsErr)
+}
+
+// rewind はリクエストボディを巻き戻した再送用のリクエストを作成する
+func rewind(req *http.Request) (*http.Request, error) {
+	next := req.Clone(req.Context())
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, fmt.Errorf("failed to rewind request body: %w", err)
+		}
+		next.Body = body
+	}
+	return next, nil
+}
+
+// retryAfter は Retry-After ヘッダー（秒数または HTTP-date）を解釈する
+func retryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if secs, err := strconv.Atoi(value); err == nil && secs >= 0 {
+		return time.Duration(secs) * time.Second, true
+	}
+	if t, err := http.ParseTime(value); err == nil {
+		return max(time.Until(t), 0), true
+	}
+	return 0, false
+}
+
+// sleep はコンテキストがキャンセルされるまで待つ
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,7524 @@
+// Package openapi provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/oapi-codegen/oapi-codegen/v2 version v2.5.1 DO NOT EDIT.
+package openapi
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/oapi-codegen/runtime"
+)
+
+// RequestEditorFn  is the function signature for the RequestEditor callback function
+type RequestEditorFn func(ctx context.Context, req *http.Request) error
+
+// Doer performs HTTP requests.
+//
+// The standard http.Client implements this interface.
+type HttpRequestDoer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// Client which conforms to the OpenAPI3 specification for this service.
+type Client struct {
+	// The endpoint of the server conforming to this interface, with scheme,
+	// https://api.deepmap.com for example. This can contain a path relative
+	// to the server, such as https://api.deepmap.com/dev-test, and all the
+	// paths in the swagger spec will be appended to the server.
+	Server string
+
+	// Doer for performing requests, typically a *http.Client with any
+	// customized settings, such as certificate chains.
+	Client HttpRequestDoer
+
+	// A list of callbacks for modifying requests which are generated before sending over
+	// the network.
+	RequestEditors []RequestEditorFn
+}
+
+// ClientOption allows setting custom parameters during construction
+type ClientOption func(*Client) error
+
+// Creates a new Client, with reasonable defaults
+func NewClient(server string, opts ...ClientOption) (*Client, error) {
+	// create a client with sane default values
+	client := Client{
+		Server: server,
+	}
+	// mutate client and add all optional params
+	for _, o := range opts {
+		if err := o(&client); err != nil {
+			return nil, err
+		}
+	}
+	// ensure the server URL always has a trailing slash
+	if !strings.HasSuffix(client.Server, "/") {
+		client.Server += "/"
+	}
+	// create httpClient, if not already present
+	if client.Client == nil {
+		client.Client = &http.Client{}
+	}
+	return &client, nil
+}
+
+// WithHTTPClient allows overriding the default Doer, which is
+// automatically created using http.Client. This is useful for tests.
+func WithHTTPClient(doer HttpRequestDoer) ClientOption {
+	return func(c *Client) error {
+		c.Client = doer
+		return nil
+	}
+}
+
+// WithRequestEditorFn allows setting up a callback function, which will be
+// called right before sending the request. This can be used to mutate the request.
+func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
+	return func(c *Client) error {
+		c.RequestEditors = append(c.RequestEditors, fn)
+		return nil
+	}
+}
+
+// The interface specification for the client above.
+type ClientInterface interface {
+	// AuthConfirmEmailVerificationWithBody request with any body
+	AuthConfirmEmailVerificationWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	AuthConfirmEmailVerification(ctx context.Context, body AuthConfirmEmailVerificationJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AuthLoginWithBody request with any body
+	AuthLoginWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	AuthLogin(ctx context.Context, body AuthLoginJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AuthVerifyMFAWithBody request with any body
+	AuthVerifyMFAWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	AuthVerifyMFA(ctx context.Context, body AuthVerifyMFAJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// OIDCLoginAuthorize request
+	OIDCLoginAuthorize(ctx context.Context, provider string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// OIDCLoginCallbackWithBody request with any body
+	OIDCLoginCallbackWithBody(ctx context.Context, provider string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	OIDCLoginCallback(ctx context.Context, provider string, body OIDCLoginCallbackJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AuthRequestPasswordResetWithBody request with any body
+	AuthRequestPasswordResetWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	AuthRequestPasswordReset(ctx context.Context, body AuthRequestPasswordResetJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AuthConfirmPasswordResetWithBody request with any body
+	AuthConfirmPasswordResetWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	AuthConfirmPasswordReset(ctx context.Context, body AuthConfirmPasswordResetJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GroupsCreateGroupWithBody request with any body
+	GroupsCreateGroupWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	GroupsCreateGroup(ctx context.Context, body GroupsCreateGroupJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GroupsGetGroup request
+	GroupsGetGroup(ctx context.Context, groupId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GroupsListGroupMembers request
+	GroupsListGroupMembers(ctx context.Context, groupId string, params *GroupsListGroupMembersParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GroupsAddGroupMembersWithBody request with any body
+	GroupsAddGroupMembersWithBody(ctx context.Context, groupId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	GroupsAddGroupMembers(ctx context.Context, groupId string, body GroupsAddGroupMembersJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GroupsRemoveGroupMembersWithBody request with any body
+	GroupsRemoveGroupMembersWithBody(ctx context.Context, groupId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	GroupsRemoveGroupMembers(ctx context.Context, groupId string, body GroupsRemoveGroupMembersJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// InvitationsListInvitations request
+	InvitationsListInvitations(ctx context.Context, params *InvitationsListInvitationsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// InvitationsCreateInvitationWithBody request with any body
+	InvitationsCreateInvitationWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	InvitationsCreateInvitation(ctx context.Context, body InvitationsCreateInvitationJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// InvitationsResendInvitation request
+	InvitationsResendInvitation(ctx context.Context, invitationId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// InvitationsRevokeInvitation request
+	InvitationsRevokeInvitation(ctx context.Context, invitationId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// InvitationsAcceptInvitationWithBody request with any body
+	InvitationsAcceptInvitationWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	InvitationsAcceptInvitation(ctx context.Context, body InvitationsAcceptInvitationJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// OrganizationsListOrganizations request
+	OrganizationsListOrganizations(ctx context.Context, params *OrganizationsListOrganizationsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// OrganizationsCreateOrganizationWithBody request with any body
+	OrganizationsCreateOrganizationWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	OrganizationsCreateOrganization(ctx context.Context, body OrganizationsCreateOrganizationJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// OrganizationsGetOrganization request
+	OrganizationsGetOrganization(ctx context.Context, orgId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// OrgUsersListOrgUsers request
+	OrgUsersListOrgUsers(ctx context.Context, orgId string, params *OrgUsersListOrgUsersParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// OrgUsersCreateOrgUserWithBody request with any body
+	OrgUsersCreateOrgUserWithBody(ctx context.Context, orgId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	OrgUsersCreateOrgUser(ctx context.Context, orgId string, body OrgUsersCreateOrgUserJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// OrgUsersDeleteOrgUser request
+	OrgUsersDeleteOrgUser(ctx context.Context, orgId string, userId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// OrgUsersGetOrgUser request
+	OrgUsersGetOrgUser(ctx context.Context, orgId string, userId string, params *OrgUsersGetOrgUserParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// OrgUsersPatchOrgUserWithBody request with any body
+	OrgUsersPatchOrgUserWithBody(ctx context.Context, orgId string, userId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	OrgUsersPatchOrgUserWithApplicationJSONPatchPlusJSONBody(ctx context.Context, orgId string, userId string, body OrgUsersPatchOrgUserApplicationJSONPatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	OrgUsersPatchOrgUserWithApplicationMergePatchPlusJSONBody(ctx context.Context, orgId string, userId string, body OrgUsersPatchOrgUserApplicationMergePatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// OrgUsersUpdateOrgUserWithBody request with any body
+	OrgUsersUpdateOrgUserWithBody(ctx context.Context, orgId string, userId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	OrgUsersUpdateOrgUser(ctx context.Context, orgId string, userId string, body OrgUsersUpdateOrgUserJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UsersListUsers request
+	UsersListUsers(ctx context.Context, params *UsersListUsersParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UsersCreateUserWithBody request with any body
+	UsersCreateUserWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UsersCreateUser(ctx context.Context, body UsersCreateUserJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UsersDeleteUser request
+	UsersDeleteUser(ctx context.Context, userId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UsersGetUser request
+	UsersGetUser(ctx context.Context, userId string, params *UsersGetUserParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UsersPatchUserWithBody request with any body
+	UsersPatchUserWithBody(ctx context.Context, userId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UsersPatchUserWithApplicationJSONPatchPlusJSONBody(ctx context.Context, userId string, body UsersPatchUserApplicationJSONPatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UsersPatchUserWithApplicationMergePatchPlusJSONBody(ctx context.Context, userId string, body UsersPatchUserApplicationMergePatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UsersUpdateUserWithBody request with any body
+	UsersUpdateUserWithBody(ctx context.Context, userId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UsersUpdateUser(ctx context.Context, userId string, body UsersUpdateUserJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UserEmailVerificationRequestEmailVerification request
+	UserEmailVerificationRequestEmailVerification(ctx context.Context, userId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UserGroupsListUserGroups request
+	UserGroupsListUserGroups(ctx context.Context, userId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UserMFAEnrollTOTPWithBody request with any body
+	UserMFAEnrollTOTPWithBody(ctx context.Context, userId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UserMFAEnrollTOTP(ctx context.Context, userId string, body UserMFAEnrollTOTPJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UserMFADisableTOTPWithBody request with any body
+	UserMFADisableTOTPWithBody(ctx context.Context, userId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UserMFADisableTOTP(ctx context.Context, userId string, body UserMFADisableTOTPJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UserMFAVerifyTOTPWithBody request with any body
+	UserMFAVerifyTOTPWithBody(ctx context.Context, userId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UserMFAVerifyTOTP(ctx context.Context, userId string, body UserMFAVerifyTOTPJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UserPasswordsChangePasswordWithBody request with any body
+	UserPasswordsChangePasswordWithBody(ctx context.Context, userId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UserPasswordsChangePassword(ctx context.Context, userId string, body UserPasswordsChangePasswordJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UserSessionsListSessions request
+	UserSessionsListSessions(ctx context.Context, userId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UserSessionsRevokeSession request
+	UserSessionsRevokeSession(ctx context.Context, userId string, sessionId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UserSessionsRevokeAllSessions request
+	UserSessionsRevokeAllSessions(ctx context.Context, userId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UsersDeactivateUserWithBody request with any body
+	UsersDeactivateUserWithBody(ctx context.Context, userId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UsersDeactivateUser(ctx context.Context, userId string, body UsersDeactivateUserJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UsersReactivateUserWithBody request with any body
+	UsersReactivateUserWithBody(ctx context.Context, userId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UsersReactivateUser(ctx context.Context, userId string, body UsersReactivateUserJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UsersSuspendUserWithBody request with any body
+	UsersSuspendUserWithBody(ctx context.Context, userId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UsersSuspendUser(ctx context.Context, userId string, body UsersSuspendUserJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UsersBatchUsersWithBody request with any body
+	UsersBatchUsersWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UsersBatchUsers(ctx context.Context, body UsersBatchUsersJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UsersWatchUsers request
+	UsersWatchUsers(ctx context.Context, params *UsersWatchUsersParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// WebhookSubscriptionsListWebhookSubscriptions request
+	WebhookSubscriptionsListWebhookSubscriptions(ctx context.Context, params *WebhookSubscriptionsListWebhookSubscriptionsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// WebhookSubscriptionsCreateWebhookSubscriptionWithBody request with any body
+	WebhookSubscriptionsCreateWebhookSubscriptionWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	WebhookSubscriptionsCreateWebhookSubscription(ctx context.Context, body WebhookSubscriptionsCreateWebhookSubscriptionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// WebhookSubscriptionsDeleteWebhookSubscription request
+	WebhookSubscriptionsDeleteWebhookSubscription(ctx context.Context, subscriptionId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// WebhookSubscriptionsGetWebhookSubscription request
+	WebhookSubscriptionsGetWebhookSubscription(ctx context.Context, subscriptionId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// WebhookSubscriptionsUpdateWebhookSubscriptionWithBody request with any body
+	WebhookSubscriptionsUpdateWebhookSubscriptionWithBody(ctx context.Context, subscriptionId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	WebhookSubscriptionsUpdateWebhookSubscription(ctx context.Context, subscriptionId string, body WebhookSubscriptionsUpdateWebhookSubscriptionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// WebhookSubscriptionsListWebhookDeliveries request
+	WebhookSubscriptionsListWebhookDeliveries(ctx context.Context, subscriptionId string, params *WebhookSubscriptionsListWebhookDeliveriesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+}
+
+func (c *Client) AuthConfirmEmailVerificationWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAuthConfirmEmailVerificationRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AuthConfirmEmailVerification(ctx context.Context, body AuthConfirmEmailVerificationJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAuthConfirmEmailVerificationRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AuthLoginWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAuthLoginRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AuthLogin(ctx context.Context, body AuthLoginJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAuthLoginRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AuthVerifyMFAWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAuthVerifyMFARequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AuthVerifyMFA(ctx context.Context, body AuthVerifyMFAJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAuthVerifyMFARequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) OIDCLoginAuthorize(ctx context.Context, provider string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewOIDCLoginAuthorizeRequest(c.Server, provider)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) OIDCLoginCallbackWithBody(ctx context.Context, provider string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewOIDCLoginCallbackRequestWithBody(c.Server, provider, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) OIDCLoginCallback(ctx context.Context, provider string, body OIDCLoginCallbackJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewOIDCLoginCallbackRequest(c.Server, provider, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AuthRequestPasswordResetWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAuthRequestPasswordResetRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AuthRequestPasswordReset(ctx context.Context, body AuthRequestPasswordResetJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAuthRequestPasswordResetRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AuthConfirmPasswordResetWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAuthConfirmPasswordResetRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AuthConfirmPasswordReset(ctx context.Context, body AuthConfirmPasswordResetJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAuthConfirmPasswordResetRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GroupsCreateGroupWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGroupsCreateGroupRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GroupsCreateGroup(ctx context.Context, body GroupsCreateGroupJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGroupsCreateGroupRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GroupsGetGroup(ctx context.Context, groupId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGroupsGetGroupRequest(c.Server, groupId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GroupsListGroupMembers(ctx context.Context, groupId string, params *GroupsListGroupMembersParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGroupsListGroupMembersRequest(c.Server, groupId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GroupsAddGroupMembersWithBody(ctx context.Context, groupId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGroupsAddGroupMembersRequestWithBody(c.Server, groupId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GroupsAddGroupMembers(ctx context.Context, groupId string, body GroupsAddGroupMembersJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGroupsAddGroupMembersRequest(c.Server, groupId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GroupsRemoveGroupMembersWithBody(ctx context.Context, groupId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGroupsRemoveGroupMembersRequestWithBody(c.Server, groupId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GroupsRemoveGroupMembers(ctx context.Context, groupId string, body GroupsRemoveGroupMembersJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGroupsRemoveGroupMembersRequest(c.Server, groupId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) InvitationsListInvitations(ctx context.Context, params *InvitationsListInvitationsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewInvitationsListInvitationsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) InvitationsCreateInvitationWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewInvitationsCreateInvitationRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) InvitationsCreateInvitation(ctx context.Context, body InvitationsCreateInvitationJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewInvitationsCreateInvitationRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) InvitationsResendInvitation(ctx context.Context, invitationId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewInvitationsResendInvitationRequest(c.Server, invitationId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) InvitationsRevokeInvitation(ctx context.Context, invitationId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewInvitationsRevokeInvitationRequest(c.Server, invitationId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) InvitationsAcceptInvitationWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewInvitationsAcceptInvitationRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) InvitationsAcceptInvitation(ctx context.Context, body InvitationsAcceptInvitationJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewInvitationsAcceptInvitationRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) OrganizationsListOrganizations(ctx context.Context, params *OrganizationsListOrganizationsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewOrganizationsListOrganizationsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) OrganizationsCreateOrganizationWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewOrganizationsCreateOrganizationRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) OrganizationsCreateOrganization(ctx context.Context, body OrganizationsCreateOrganizationJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewOrganizationsCreateOrganizationRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) OrganizationsGetOrganization(ctx context.Context, orgId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewOrganizationsGetOrganizationRequest(c.Server, orgId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) OrgUsersListOrgUsers(ctx context.Context, orgId string, params *OrgUsersListOrgUsersParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewOrgUsersListOrgUsersRequest(c.Server, orgId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) OrgUsersCreateOrgUserWithBody(ctx context.Context, orgId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewOrgUsersCreateOrgUserRequestWithBody(c.Server, orgId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) OrgUsersCreateOrgUser(ctx context.Context, orgId string, body OrgUsersCreateOrgUserJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewOrgUsersCreateOrgUserRequest(c.Server, orgId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) OrgUsersDeleteOrgUser(ctx context.Context, orgId string, userId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewOrgUsersDeleteOrgUserRequest(c.Server, orgId, userId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) OrgUsersGetOrgUser(ctx context.Context, orgId string, userId string, params *OrgUsersGetOrgUserParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewOrgUsersGetOrgUserRequest(c.Server, orgId, userId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) OrgUsersPatchOrgUserWithBody(ctx context.Context, orgId string, userId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewOrgUsersPatchOrgUserRequestWithBody(c.Server, orgId, userId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) OrgUsersPatchOrgUserWithApplicationJSONPatchPlusJSONBody(ctx context.Context, orgId string, userId string, body OrgUsersPatchOrgUserApplicationJSONPatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewOrgUsersPatchOrgUserRequestWithApplicationJSONPatchPlusJSONBody(c.Server, orgId, userId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) OrgUsersPatchOrgUserWithApplicationMergePatchPlusJSONBody(ctx context.Context, orgId string, userId string, body OrgUsersPatchOrgUserApplicationMergePatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewOrgUsersPatchOrgUserRequestWithApplicationMergePatchPlusJSONBody(c.Server, orgId, userId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) OrgUsersUpdateOrgUserWithBody(ctx context.Context, orgId string, userId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewOrgUsersUpdateOrgUserRequestWithBody(c.Server, orgId, userId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) OrgUsersUpdateOrgUser(ctx context.Context, orgId string, userId string, body OrgUsersUpdateOrgUserJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewOrgUsersUpdateOrgUserRequest(c.Server, orgId, userId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UsersListUsers(ctx context.Context, params *UsersListUsersParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUsersListUsersRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UsersCreateUserWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUsersCreateUserRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UsersCreateUser(ctx context.Context, body UsersCreateUserJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUsersCreateUserRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UsersDeleteUser(ctx context.Context, userId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUsersDeleteUserRequest(c.Server, userId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UsersGetUser(ctx context.Context, userId string, params *UsersGetUserParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUsersGetUserRequest(c.Server, userId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UsersPatchUserWithBody(ctx context.Context, userId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUsersPatchUserRequestWithBody(c.Server, userId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UsersPatchUserWithApplicationJSONPatchPlusJSONBody(ctx context.Context, userId string, body UsersPatchUserApplicationJSONPatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUsersPatchUserRequestWithApplicationJSONPatchPlusJSONBody(c.Server, userId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UsersPatchUserWithApplicationMergePatchPlusJSONBody(ctx context.Context, userId string, body UsersPatchUserApplicationMergePatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUsersPatchUserRequestWithApplicationMergePatchPlusJSONBody(c.Server, userId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UsersUpdateUserWithBody(ctx context.Context, userId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUsersUpdateUserRequestWithBody(c.Server, userId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UsersUpdateUser(ctx context.Context, userId string, body UsersUpdateUserJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUsersUpdateUserRequest(c.Server, userId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UserEmailVerificationRequestEmailVerification(ctx context.Context, userId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserEmailVerificationRequestEmailVerificationRequest(c.Server, userId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UserGroupsListUserGroups(ctx context.Context, userId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserGroupsListUserGroupsRequest(c.Server, userId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UserMFAEnrollTOTPWithBody(ctx context.Context, userId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserMFAEnrollTOTPRequestWithBody(c.Server, userId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UserMFAEnrollTOTP(ctx context.Context, userId string, body UserMFAEnrollTOTPJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserMFAEnrollTOTPRequest(c.Server, userId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UserMFADisableTOTPWithBody(ctx context.Context, userId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserMFADisableTOTPRequestWithBody(c.Server, userId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UserMFADisableTOTP(ctx context.Context, userId string, body UserMFADisableTOTPJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserMFADisableTOTPRequest(c.Server, userId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UserMFAVerifyTOTPWithBody(ctx context.Context, userId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserMFAVerifyTOTPRequestWithBody(c.Server, userId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UserMFAVerifyTOTP(ctx context.Context, userId string, body UserMFAVerifyTOTPJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserMFAVerifyTOTPRequest(c.Server, userId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UserPasswordsChangePasswordWithBody(ctx context.Context, userId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserPasswordsChangePasswordRequestWithBody(c.Server, userId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UserPasswordsChangePassword(ctx context.Context, userId string, body UserPasswordsChangePasswordJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserPasswordsChangePasswordRequest(c.Server, userId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UserSessionsListSessions(ctx context.Context, userId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserSessionsListSessionsRequest(c.Server, userId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UserSessionsRevokeSession(ctx context.Context, userId string, sessionId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserSessionsRevokeSessionRequest(c.Server, userId, sessionId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UserSessionsRevokeAllSessions(ctx context.Context, userId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserSessionsRevokeAllSessionsRequest(c.Server, userId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UsersDeactivateUserWithBody(ctx context.Context, userId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUsersDeactivateUserRequestWithBody(c.Server, userId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UsersDeactivateUser(ctx context.Context, userId string, body UsersDeactivateUserJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUsersDeactivateUserRequest(c.Server, userId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UsersReactivateUserWithBody(ctx context.Context, userId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUsersReactivateUserRequestWithBody(c.Server, userId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UsersReactivateUser(ctx context.Context, userId string, body UsersReactivateUserJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUsersReactivateUserRequest(c.Server, userId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UsersSuspendUserWithBody(ctx context.Context, userId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUsersSuspendUserRequestWithBody(c.Server, userId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UsersSuspendUser(ctx context.Context, userId string, body UsersSuspendUserJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUsersSuspendUserRequest(c.Server, userId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UsersBatchUsersWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUsersBatchUsersRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UsersBatchUsers(ctx context.Context, body UsersBatchUsersJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUsersBatchUsersRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UsersWatchUsers(ctx context.Context, params *UsersWatchUsersParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUsersWatchUsersRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) WebhookSubscriptionsListWebhookSubscriptions(ctx context.Context, params *WebhookSubscriptionsListWebhookSubscriptionsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWebhookSubscriptionsListWebhookSubscriptionsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) WebhookSubscriptionsCreateWebhookSubscriptionWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWebhookSubscriptionsCreateWebhookSubscriptionRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) WebhookSubscriptionsCreateWebhookSubscription(ctx context.Context, body WebhookSubscriptionsCreateWebhookSubscriptionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWebhookSubscriptionsCreateWebhookSubscriptionRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) WebhookSubscriptionsDeleteWebhookSubscription(ctx context.Context, subscriptionId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWebhookSubscriptionsDeleteWebhookSubscriptionRequest(c.Server, subscriptionId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) WebhookSubscriptionsGetWebhookSubscription(ctx context.Context, subscriptionId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWebhookSubscriptionsGetWebhookSubscriptionRequest(c.Server, subscriptionId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) WebhookSubscriptionsUpdateWebhookSubscriptionWithBody(ctx context.Context, subscriptionId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWebhookSubscriptionsUpdateWebhookSubscriptionRequestWithBody(c.Server, subscriptionId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) WebhookSubscriptionsUpdateWebhookSubscription(ctx context.Context, subscriptionId string, body WebhookSubscriptionsUpdateWebhookSubscriptionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWebhookSubscriptionsUpdateWebhookSubscriptionRequest(c.Server, subscriptionId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) WebhookSubscriptionsListWebhookDeliveries(ctx context.Context, subscriptionId string, params *WebhookSubscriptionsListWebhookDeliveriesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWebhookSubscriptionsListWebhookDeliveriesRequest(c.Server, subscriptionId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewAuthConfirmEmailVerificationRequest calls the generic AuthConfirmEmailVerification builder with application/json body
+func NewAuthConfirmEmailVerificationRequest(server string, body AuthConfirmEmailVerificationJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAuthConfirmEmailVerificationRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewAuthConfirmEmailVerificationRequestWithBody generates requests for AuthConfirmEmailVerification with any type of body
+func NewAuthConfirmEmailVerificationRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/auth/email-verification:confirm")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewAuthLoginRequest calls the generic AuthLogin builder with application/json body
+func NewAuthLoginRequest(server string, body AuthLoginJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAuthLoginRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewAuthLoginRequestWithBody generates requests for AuthLogin with any type of body
+func NewAuthLoginRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/auth/login")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewAuthVerifyMFARequest calls the generic AuthVerifyMFA builder with application/json body
+func NewAuthVerifyMFARequest(server string, body AuthVerifyMFAJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAuthVerifyMFARequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewAuthVerifyMFARequestWithBody generates requests for AuthVerifyMFA with any type of body
+func NewAuthVerifyMFARequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/auth/mfa:verify")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewOIDCLoginAuthorizeRequest generates requests for OIDCLoginAuthorize
+func NewOIDCLoginAuthorizeRequest(server string, provider string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "provider", runtime.ParamLocationPath, provider)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/auth/oidc/%s:authorize", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewOIDCLoginCallbackRequest calls the generic OIDCLoginCallback builder with application/json body
+func NewOIDCLoginCallbackRequest(server string, provider string, body OIDCLoginCallbackJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewOIDCLoginCallbackRequestWithBody(server, provider, "application/json", bodyReader)
+}
+
+// NewOIDCLoginCallbackRequestWithBody generates requests for OIDCLoginCallback with any type of body
+func NewOIDCLoginCallbackRequestWithBody(server string, provider string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "provider", runtime.ParamLocationPath, provider)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/auth/oidc/%s:callback", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewAuthRequestPasswordResetRequest calls the generic AuthRequestPasswordReset builder with application/json body
+func NewAuthRequestPasswordResetRequest(server string, body AuthRequestPasswordResetJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAuthRequestPasswordResetRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewAuthRequestPasswordResetRequestWithBody generates requests for AuthRequestPasswordReset with any type of body
+func NewAuthRequestPasswordResetRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/auth/password-reset")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewAuthConfirmPasswordResetRequest calls the generic AuthConfirmPasswordReset builder with application/json body
+func NewAuthConfirmPasswordResetRequest(server string, body AuthConfirmPasswordResetJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAuthConfirmPasswordResetRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewAuthConfirmPasswordResetRequestWithBody generates requests for AuthConfirmPasswordReset with any type of body
+func NewAuthConfirmPasswordResetRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/auth/password-reset:confirm")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGroupsCreateGroupRequest calls the generic GroupsCreateGroup builder with application/json body
+func NewGroupsCreateGroupRequest(server string, body GroupsCreateGroupJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewGroupsCreateGroupRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewGroupsCreateGroupRequestWithBody generates requests for GroupsCreateGroup with any type of body
+func NewGroupsCreateGroupRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/groups")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGroupsGetGroupRequest generates requests for GroupsGetGroup
+func NewGroupsGetGroupRequest(server string, groupId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "groupId", runtime.ParamLocationPath, groupId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/groups/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGroupsListGroupMembersRequest generates requests for GroupsListGroupMembers
+func NewGroupsListGroupMembersRequest(server string, groupId string, params *GroupsListGroupMembersParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "groupId", runtime.ParamLocationPath, groupId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/groups/%s/members", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", false, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", false, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGroupsAddGroupMembersRequest calls the generic GroupsAddGroupMembers builder with application/json body
+func NewGroupsAddGroupMembersRequest(server string, groupId string, body GroupsAddGroupMembersJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewGroupsAddGroupMembersRequestWithBody(server, groupId, "application/json", bodyReader)
+}
+
+// NewGroupsAddGroupMembersRequestWithBody generates requests for GroupsAddGroupMembers with any type of body
+func NewGroupsAddGroupMembersRequestWithBody(server string, groupId string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "groupId", runtime.ParamLocationPath, groupId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/groups/%s/members:add", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGroupsRemoveGroupMembersRequest calls the generic GroupsRemoveGroupMembers builder with application/json body
+func NewGroupsRemoveGroupMembersRequest(server string, groupId string, body GroupsRemoveGroupMembersJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewGroupsRemoveGroupMembersRequestWithBody(server, groupId, "application/json", bodyReader)
+}
+
+// NewGroupsRemoveGroupMembersRequestWithBody generates requests for GroupsRemoveGroupMembers with any type of body
+func NewGroupsRemoveGroupMembersRequestWithBody(server string, groupId string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "groupId", runtime.ParamLocationPath, groupId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/groups/%s/members:remove", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewInvitationsListInvitationsRequest generates requests for InvitationsListInvitations
+func NewInvitationsListInvitationsRequest(server string, params *InvitationsListInvitationsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/invitations")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", false, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", false, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewInvitationsCreateInvitationRequest calls the generic InvitationsCreateInvitation builder with application/json body
+func NewInvitationsCreateInvitationRequest(server string, body InvitationsCreateInvitationJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewInvitationsCreateInvitationRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewInvitationsCreateInvitationRequestWithBody generates requests for InvitationsCreateInvitation with any type of body
+func NewInvitationsCreateInvitationRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/invitations")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewInvitationsResendInvitationRequest generates requests for InvitationsResendInvitation
+func NewInvitationsResendInvitationRequest(server string, invitationId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "invitationId", runtime.ParamLocationPath, invitationId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/invitations/%s:resend", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewInvitationsRevokeInvitationRequest generates requests for InvitationsRevokeInvitation
+func NewInvitationsRevokeInvitationRequest(server string, invitationId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "invitationId", runtime.ParamLocationPath, invitationId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/invitations/%s:revoke", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewInvitationsAcceptInvitationRequest calls the generic InvitationsAcceptInvitation builder with application/json body
+func NewInvitationsAcceptInvitationRequest(server string, body InvitationsAcceptInvitationJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewInvitationsAcceptInvitationRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewInvitationsAcceptInvitationRequestWithBody generates requests for InvitationsAcceptInvitation with any type of body
+func NewInvitationsAcceptInvitationRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/invitations:accept")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewOrganizationsListOrganizationsRequest generates requests for OrganizationsListOrganizations
+func NewOrganizationsListOrganizationsRequest(server string, params *OrganizationsListOrganizationsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/orgs")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", false, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", false, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewOrganizationsCreateOrganizationRequest calls the generic OrganizationsCreateOrganization builder with application/json body
+func NewOrganizationsCreateOrganizationRequest(server string, body OrganizationsCreateOrganizationJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewOrganizationsCreateOrganizationRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewOrganizationsCreateOrganizationRequestWithBody generates requests for OrganizationsCreateOrganization with any type of body
+func NewOrganizationsCreateOrganizationRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/orgs")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewOrganizationsGetOrganizationRequest generates requests for OrganizationsGetOrganization
+func NewOrganizationsGetOrganizationRequest(server string, orgId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "orgId", runtime.ParamLocationPath, orgId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/orgs/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewOrgUsersListOrgUsersRequest generates requests for OrgUsersListOrgUsers
+func NewOrgUsersListOrgUsersRequest(server string, orgId string, params *OrgUsersListOrgUsersParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "orgId", runtime.ParamLocationPath, orgId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/orgs/%s/users", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Status != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", false, "status", runtime.ParamLocationQuery, *params.Status); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", false, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", false, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.IfNoneMatch != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-None-Match", runtime.ParamLocationHeader, *params.IfNoneMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-None-Match", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewOrgUsersCreateOrgUserRequest calls the generic OrgUsersCreateOrgUser builder with application/json body
+func NewOrgUsersCreateOrgUserRequest(server string, orgId string, body OrgUsersCreateOrgUserJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewOrgUsersCreateOrgUserRequestWithBody(server, orgId, "application/json", bodyReader)
+}
+
+// NewOrgUsersCreateOrgUserRequestWithBody generates requests for OrgUsersCreateOrgUser with any type of body
+func NewOrgUsersCreateOrgUserRequestWithBody(server string, orgId string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "orgId", runtime.ParamLocationPath, orgId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/orgs/%s/users", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewOrgUsersDeleteOrgUserRequest generates requests for OrgUsersDeleteOrgUser
+func NewOrgUsersDeleteOrgUserRequest(server string, orgId string, userId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "orgId", runtime.ParamLocationPath, orgId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "userId", runtime.ParamLocationPath, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/orgs/%s/users/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewOrgUsersGetOrgUserRequest generates requests for OrgUsersGetOrgUser
+func NewOrgUsersGetOrgUserRequest(server string, orgId string, userId string, params *OrgUsersGetOrgUserParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "orgId", runtime.ParamLocationPath, orgId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "userId", runtime.ParamLocationPath, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/orgs/%s/users/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.IfNoneMatch != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-None-Match", runtime.ParamLocationHeader, *params.IfNoneMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-None-Match", headerParam0)
+		}
+
+		if params.IfModifiedSince != nil {
+			var headerParam1 string
+
+			headerParam1, err = runtime.StyleParamWithLocation("simple", false, "If-Modified-Since", runtime.ParamLocationHeader, *params.IfModifiedSince)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-Modified-Since", headerParam1)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewOrgUsersPatchOrgUserRequestWithApplicationJSONPatchPlusJSONBody calls the generic OrgUsersPatchOrgUser builder with application/json-patch+json body
+func NewOrgUsersPatchOrgUserRequestWithApplicationJSONPatchPlusJSONBody(server string, orgId string, userId string, body OrgUsersPatchOrgUserApplicationJSONPatchPlusJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewOrgUsersPatchOrgUserRequestWithBody(server, orgId, userId, "application/json-patch+json", bodyReader)
+}
+
+// NewOrgUsersPatchOrgUserRequestWithApplicationMergePatchPlusJSONBody calls the generic OrgUsersPatchOrgUser builder with application/merge-patch+json body
+func NewOrgUsersPatchOrgUserRequestWithApplicationMergePatchPlusJSONBody(server string, orgId string, userId string, body OrgUsersPatchOrgUserApplicationMergePatchPlusJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewOrgUsersPatchOrgUserRequestWithBody(server, orgId, userId, "application/merge-patch+json", bodyReader)
+}
+
+// NewOrgUsersPatchOrgUserRequestWithBody generates requests for OrgUsersPatchOrgUser with any type of body
+func NewOrgUsersPatchOrgUserRequestWithBody(server string, orgId string, userId string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "orgId", runtime.ParamLocationPath, orgId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "userId", runtime.ParamLocationPath, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/orgs/%s/users/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewOrgUsersUpdateOrgUserRequest calls the generic OrgUsersUpdateOrgUser builder with application/json body
+func NewOrgUsersUpdateOrgUserRequest(server string, orgId string, userId string, body OrgUsersUpdateOrgUserJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewOrgUsersUpdateOrgUserRequestWithBody(server, orgId, userId, "application/json", bodyReader)
+}
+
+// NewOrgUsersUpdateOrgUserRequestWithBody generates requests for OrgUsersUpdateOrgUser with any type of body
+func NewOrgUsersUpdateOrgUserRequestWithBody(server string, orgId string, userId string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "orgId", runtime.ParamLocationPath, orgId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "userId", runtime.ParamLocationPath, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/orgs/%s/users/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewUsersListUsersRequest generates requests for UsersListUsers
+func NewUsersListUsersRequest(server string, params *UsersListUsersParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Status != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", false, "status", runtime.ParamLocationQuery, *params.Status); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", false, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", false, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.IfNoneMatch != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-None-Match", runtime.ParamLocationHeader, *params.IfNoneMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-None-Match", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewUsersCreateUserRequest calls the generic UsersCreateUser builder with application/json body
+func NewUsersCreateUserRequest(server string, body UsersCreateUserJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUsersCreateUserRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewUsersCreateUserRequestWithBody generates requests for UsersCreateUser with any type of body
+func NewUsersCreateUserRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewUsersDeleteUserRequest generates requests for UsersDeleteUser
+func NewUsersDeleteUserRequest(server string, userId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "userId", runtime.ParamLocationPath, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUsersGetUserRequest generates requests for UsersGetUser
+func NewUsersGetUserRequest(server string, userId string, params *UsersGetUserParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "userId", runtime.ParamLocationPath, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.IfNoneMatch != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-None-Match", runtime.ParamLocationHeader, *params.IfNoneMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-None-Match", headerParam0)
+		}
+
+		if params.IfModifiedSince != nil {
+			var headerParam1 string
+
+			headerParam1, err = runtime.StyleParamWithLocation("simple", false, "If-Modified-Since", runtime.ParamLocationHeader, *params.IfModifiedSince)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-Modified-Since", headerParam1)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewUsersPatchUserRequestWithApplicationJSONPatchPlusJSONBody calls the generic UsersPatchUser builder with application/json-patch+json body
+func NewUsersPatchUserRequestWithApplicationJSONPatchPlusJSONBody(server string, userId string, body UsersPatchUserApplicationJSONPatchPlusJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUsersPatchUserRequestWithBody(server, userId, "application/json-patch+json", bodyReader)
+}
+
+// NewUsersPatchUserRequestWithApplicationMergePatchPlusJSONBody calls the generic UsersPatchUser builder with application/merge-patch+json body
+func NewUsersPatchUserRequestWithApplicationMergePatchPlusJSONBody(server string, userId string, body UsersPatchUserApplicationMergePatchPlusJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUsersPatchUserRequestWithBody(server, userId, "application/merge-patch+json", bodyReader)
+}
+
+// NewUsersPatchUserRequestWithBody generates requests for UsersPatchUser with any type of body
+func NewUsersPatchUserRequestWithBody(server string, userId string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "userId", runtime.ParamLocationPath, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewUsersUpdateUserRequest calls the generic UsersUpdateUser builder with application/json body
+func NewUsersUpdateUserRequest(server string, userId string, body UsersUpdateUserJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUsersUpdateUserRequestWithBody(server, userId, "application/json", bodyReader)
+}
+
+// NewUsersUpdateUserRequestWithBody generates requests for UsersUpdateUser with any type of body
+func NewUsersUpdateUserRequestWithBody(server string, userId string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "userId", runtime.ParamLocationPath, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewUserEmailVerificationRequestEmailVerificationRequest generates requests for UserEmailVerificationRequestEmailVerification
+func NewUserEmailVerificationRequestEmailVerificationRequest(server string, userId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "userId", runtime.ParamLocationPath, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/%s/email-verification", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUserGroupsListUserGroupsRequest generates requests for UserGroupsListUserGroups
+func NewUserGroupsListUserGroupsRequest(server string, userId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "userId", runtime.ParamLocationPath, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/%s/groups", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUserMFAEnrollTOTPRequest calls the generic UserMFAEnrollTOTP builder with application/json body
+func NewUserMFAEnrollTOTPRequest(server string, userId string, body UserMFAEnrollTOTPJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUserMFAEnrollTOTPRequestWithBody(server, userId, "application/json", bodyReader)
+}
+
+// NewUserMFAEnrollTOTPRequestWithBody generates requests for UserMFAEnrollTOTP with any type of body
+func NewUserMFAEnrollTOTPRequestWithBody(server string, userId string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "userId", runtime.ParamLocationPath, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/%s/mfa/totp", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewUserMFADisableTOTPRequest calls the generic UserMFADisableTOTP builder with application/json body
+func NewUserMFADisableTOTPRequest(server string, userId string, body UserMFADisableTOTPJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUserMFADisableTOTPRequestWithBody(server, userId, "application/json", bodyReader)
+}
+
+// NewUserMFADisableTOTPRequestWithBody generates requests for UserMFADisableTOTP with any type of body
+func NewUserMFADisableTOTPRequestWithBody(server string, userId string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "userId", runtime.ParamLocationPath, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/%s/mfa/totp:disable", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewUserMFAVerifyTOTPRequest calls the generic UserMFAVerifyTOTP builder with application/json body
+func NewUserMFAVerifyTOTPRequest(server string, userId string, body UserMFAVerifyTOTPJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUserMFAVerifyTOTPRequestWithBody(server, userId, "application/json", bodyReader)
+}
+
+// NewUserMFAVerifyTOTPRequestWithBody generates requests for UserMFAVerifyTOTP with any type of body
+func NewUserMFAVerifyTOTPRequestWithBody(server string, userId string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "userId", runtime.ParamLocationPath, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/%s/mfa/totp:verify", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewUserPasswordsChangePasswordRequest calls the generic UserPasswordsChangePassword builder with application/json body
+func NewUserPasswordsChangePasswordRequest(server string, userId string, body UserPasswordsChangePasswordJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUserPasswordsChangePasswordRequestWithBody(server, userId, "application/json", bodyReader)
+}
+
+// NewUserPasswordsChangePasswordRequestWithBody generates requests for UserPasswordsChangePassword with any type of body
+func NewUserPasswordsChangePasswordRequestWithBody(server string, userId string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "userId", runtime.ParamLocationPath, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/%s/password", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewUserSessionsListSessionsRequest generates requests for UserSessionsListSessions
+func NewUserSessionsListSessionsRequest(server string, userId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "userId", runtime.ParamLocationPath, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/%s/sessions", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUserSessionsRevokeSessionRequest generates requests for UserSessionsRevokeSession
+func NewUserSessionsRevokeSessionRequest(server string, userId string, sessionId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "userId", runtime.ParamLocationPath, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "sessionId", runtime.ParamLocationPath, sessionId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/%s/sessions/%s:revoke", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUserSessionsRevokeAllSessionsRequest generates requests for UserSessionsRevokeAllSessions
+func NewUserSessionsRevokeAllSessionsRequest(server string, userId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "userId", runtime.ParamLocationPath, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/%s/sessions:revoke", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUsersDeactivateUserRequest calls the generic UsersDeactivateUser builder with application/json body
+func NewUsersDeactivateUserRequest(server string, userId string, body UsersDeactivateUserJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUsersDeactivateUserRequestWithBody(server, userId, "application/json", bodyReader)
+}
+
+// NewUsersDeactivateUserRequestWithBody generates requests for UsersDeactivateUser with any type of body
+func NewUsersDeactivateUserRequestWithBody(server string, userId string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "userId", runtime.ParamLocationPath, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/%s:deactivate", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewUsersReactivateUserRequest calls the generic UsersReactivateUser builder with application/json body
+func NewUsersReactivateUserRequest(server string, userId string, body UsersReactivateUserJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUsersReactivateUserRequestWithBody(server, userId, "application/json", bodyReader)
+}
+
+// NewUsersReactivateUserRequestWithBody generates requests for UsersReactivateUser with any type of body
+func NewUsersReactivateUserRequestWithBody(server string, userId string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "userId", runtime.ParamLocationPath, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/%s:reactivate", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewUsersSuspendUserRequest calls the generic UsersSuspendUser builder with application/json body
+func NewUsersSuspendUserRequest(server string, userId string, body UsersSuspendUserJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUsersSuspendUserRequestWithBody(server, userId, "application/json", bodyReader)
+}
+
+// NewUsersSuspendUserRequestWithBody generates requests for UsersSuspendUser with any type of body
+func NewUsersSuspendUserRequestWithBody(server string, userId string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "userId", runtime.ParamLocationPath, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/%s:suspend", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewUsersBatchUsersRequest calls the generic UsersBatchUsers builder with application/json body
+func NewUsersBatchUsersRequest(server string, body UsersBatchUsersJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUsersBatchUsersRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewUsersBatchUsersRequestWithBody generates requests for UsersBatchUsers with any type of body
+func NewUsersBatchUsersRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users:batch")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewUsersWatchUsersRequest generates requests for UsersWatchUsers
+func NewUsersWatchUsersRequest(server string, params *UsersWatchUsersParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users:watch")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.LastEventID != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Last-Event-ID", runtime.ParamLocationHeader, *params.LastEventID)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Last-Event-ID", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewWebhookSubscriptionsListWebhookSubscriptionsRequest generates requests for WebhookSubscriptionsListWebhookSubscriptions
+func NewWebhookSubscriptionsListWebhookSubscriptionsRequest(server string, params *WebhookSubscriptionsListWebhookSubscriptionsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/webhook-subscriptions")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", false, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", false, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewWebhookSubscriptionsCreateWebhookSubscriptionRequest calls the generic WebhookSubscriptionsCreateWebhookSubscription builder with application/json body
+func NewWebhookSubscriptionsCreateWebhookSubscriptionRequest(server string, body WebhookSubscriptionsCreateWebhookSubscriptionJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewWebhookSubscriptionsCreateWebhookSubscriptionRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewWebhookSubscriptionsCreateWebhookSubscriptionRequestWithBody generates requests for WebhookSubscriptionsCreateWebhookSubscription with any type of body
+func NewWebhookSubscriptionsCreateWebhookSubscriptionRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/webhook-subscriptions")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewWebhookSubscriptionsDeleteWebhookSubscriptionRequest generates requests for WebhookSubscriptionsDeleteWebhookSubscription
+func NewWebhookSubscriptionsDeleteWebhookSubscriptionRequest(server string, subscriptionId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "subscriptionId", runtime.ParamLocationPath, subscriptionId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/webhook-subscriptions/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewWebhookSubscriptionsGetWebhookSubscriptionRequest generates requests for WebhookSubscriptionsGetWebhookSubscription
+func NewWebhookSubscriptionsGetWebhookSubscriptionRequest(server string, subscriptionId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "subscriptionId", runtime.ParamLocationPath, subscriptionId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/webhook-subscriptions/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewWebhookSubscriptionsUpdateWebhookSubscriptionRequest calls the generic WebhookSubscriptionsUpdateWebhookSubscription builder with application/json body
+func NewWebhookSubscriptionsUpdateWebhookSubscriptionRequest(server string, subscriptionId string, body WebhookSubscriptionsUpdateWebhookSubscriptionJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewWebhookSubscriptionsUpdateWebhookSubscriptionRequestWithBody(server, subscriptionId, "application/json", bodyReader)
+}
+
+// NewWebhookSubscriptionsUpdateWebhookSubscriptionRequestWithBody generates requests for WebhookSubscriptionsUpdateWebhookSubscription with any type of body
+func NewWebhookSubscriptionsUpdateWebhookSubscriptionRequestWithBody(server string, subscriptionId string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "subscriptionId", runtime.ParamLocationPath, subscriptionId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/webhook-subscriptions/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewWebhookSubscriptionsListWebhookDeliveriesRequest generates requests for WebhookSubscriptionsListWebhookDeliveries
+func NewWebhookSubscriptionsListWebhookDeliveriesRequest(server string, subscriptionId string, params *WebhookSubscriptionsListWebhookDeliveriesParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "subscriptionId", runtime.ParamLocationPath, subscriptionId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/webhook-subscriptions/%s/deliveries", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Status != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", false, "status", runtime.ParamLocationQuery, *params.Status); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", false, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", false, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// AuthConfirmEmailVerificationWithBodyWithResponse request with any body
+	AuthConfirmEmailVerificationWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AuthConfirmEmailVerificationResponse, error)
+
+	AuthConfirmEmailVerificationWithResponse(ctx context.Context, body AuthConfirmEmailVerificationJSONRequestBody, reqEditors ...RequestEditorFn) (*AuthConfirmEmailVerificationResponse, error)
+
+	// AuthLoginWithBodyWithResponse request with any body
+	AuthLoginWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AuthLoginResponse, error)
+
+	AuthLoginWithResponse(ctx context.Context, body AuthLoginJSONRequestBody, reqEditors ...RequestEditorFn) (*AuthLoginResponse, error)
+
+	// AuthVerifyMFAWithBodyWithResponse request with any body
+	AuthVerifyMFAWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AuthVerifyMFAResponse, error)
+
+	AuthVerifyMFAWithResponse(ctx context.Context, body AuthVerifyMFAJSONRequestBody, reqEditors ...RequestEditorFn) (*AuthVerifyMFAResponse, error)
+
+	// OIDCLoginAuthorizeWithResponse request
+	OIDCLoginAuthorizeWithResponse(ctx context.Context, provider string, reqEditors ...RequestEditorFn) (*OIDCLoginAuthorizeResponse, error)
+
+	// OIDCLoginCallbackWithBodyWithResponse request with any body
+	OIDCLoginCallbackWithBodyWithResponse(ctx context.Context, provider string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*OIDCLoginCallbackResponse, error)
+
+	OIDCLoginCallbackWithResponse(ctx context.Context, provider string, body OIDCLoginCallbackJSONRequestBody, reqEditors ...RequestEditorFn) (*OIDCLoginCallbackResponse, error)
+
+	// AuthRequestPasswordResetWithBodyWithResponse request with any body
+	AuthRequestPasswordResetWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AuthRequestPasswordResetResponse, error)
+
+	AuthRequestPasswordResetWithResponse(ctx context.Context, body AuthRequestPasswordResetJSONRequestBody, reqEditors ...RequestEditorFn) (*AuthRequestPasswordResetResponse, error)
+
+	// AuthConfirmPasswordResetWithBodyWithResponse request with any body
+	AuthConfirmPasswordResetWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AuthConfirmPasswordResetResponse, error)
+
+	AuthConfirmPasswordResetWithResponse(ctx context.Context, body AuthConfirmPasswordResetJSONRequestBody, reqEditors ...RequestEditorFn) (*AuthConfirmPasswordResetResponse, error)
+
+	// GroupsCreateGroupWithBodyWithResponse request with any body
+	GroupsCreateGroupWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*GroupsCreateGroupResponse, error)
+
+	GroupsCreateGroupWithResponse(ctx context.Context, body GroupsCreateGroupJSONRequestBody, reqEditors ...RequestEditorFn) (*GroupsCreateGroupResponse, error)
+
+	// GroupsGetGroupWithResponse request
+	GroupsGetGroupWithResponse(ctx context.Context, groupId string, reqEditors ...RequestEditorFn) (*GroupsGetGroupResponse, error)
+
+	// GroupsListGroupMembersWithResponse request
+	GroupsListGroupMembersWithResponse(ctx context.Context, groupId string, params *GroupsListGroupMembersParams, reqEditors ...RequestEditorFn) (*GroupsListGroupMembersResponse, error)
+
+	// GroupsAddGroupMembersWithBodyWithResponse request with any body
+	GroupsAddGroupMembersWithBodyWithResponse(ctx context.Context, groupId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*GroupsAddGroupMembersResponse, error)
+
+	GroupsAddGroupMembersWithResponse(ctx context.Context, groupId string, body GroupsAddGroupMembersJSONRequestBody, reqEditors ...RequestEditorFn) (*GroupsAddGroupMembersResponse, error)
+
+	// GroupsRemoveGroupMembersWithBodyWithResponse request with any body
+	GroupsRemoveGroupMembersWithBodyWithResponse(ctx context.Context, groupId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*GroupsRemoveGroupMembersResponse, error)
+
+	GroupsRemoveGroupMembersWithResponse(ctx context.Context, groupId string, body GroupsRemoveGroupMembersJSONRequestBody, reqEditors ...RequestEditorFn) (*GroupsRemoveGroupMembersResponse, error)
+
+	// InvitationsListInvitationsWithResponse request
+	InvitationsListInvitationsWithResponse(ctx context.Context, params *InvitationsListInvitationsParams, reqEditors ...RequestEditorFn) (*InvitationsListInvitationsResponse, error)
+
+	// InvitationsCreateInvitationWithBodyWithResponse request with any body
+	InvitationsCreateInvitationWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*InvitationsCreateInvitationResponse, error)
+
+	InvitationsCreateInvitationWithResponse(ctx context.Context, body InvitationsCreateInvitationJSONRequestBody, reqEditors ...RequestEditorFn) (*InvitationsCreateInvitationResponse, error)
+
+	// InvitationsResendInvitationWithResponse request
+	InvitationsResendInvitationWithResponse(ctx context.Context, invitationId string, reqEditors ...RequestEditorFn) (*InvitationsResendInvitationResponse, error)
+
+	// InvitationsRevokeInvitationWithResponse request
+	InvitationsRevokeInvitationWithResponse(ctx context.Context, invitationId string, reqEditors ...RequestEditorFn) (*InvitationsRevokeInvitationResponse, error)
+
+	// InvitationsAcceptInvitationWithBodyWithResponse request with any body
+	InvitationsAcceptInvitationWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*InvitationsAcceptInvitationResponse, error)
+
+	InvitationsAcceptInvitationWithResponse(ctx context.Context, body InvitationsAcceptInvitationJSONRequestBody, reqEditors ...RequestEditorFn) (*InvitationsAcceptInvitationResponse, error)
+
+	// OrganizationsListOrganizationsWithResponse request
+	OrganizationsListOrganizationsWithResponse(ctx context.Context, params *OrganizationsListOrganizationsParams, reqEditors ...RequestEditorFn) (*OrganizationsListOrganizationsResponse, error)
+
+	// OrganizationsCreateOrganizationWithBodyWithResponse request with any body
+	OrganizationsCreateOrganizationWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*OrganizationsCreateOrganizationResponse, error)
+
+	OrganizationsCreateOrganizationWithResponse(ctx context.Context, body OrganizationsCreateOrganizationJSONRequestBody, reqEditors ...RequestEditorFn) (*OrganizationsCreateOrganizationResponse, error)
+
+	// OrganizationsGetOrganizationWithResponse request
+	OrganizationsGetOrganizationWithResponse(ctx context.Context, orgId string, reqEditors ...RequestEditorFn) (*OrganizationsGetOrganizationResponse, error)
+
+	// OrgUsersListOrgUsersWithResponse request
+	OrgUsersListOrgUsersWithResponse(ctx context.Context, orgId string, params *OrgUsersListOrgUsersParams, reqEditors ...RequestEditorFn) (*OrgUsersListOrgUsersResponse, error)
+
+	// OrgUsersCreateOrgUserWithBodyWithResponse request with any body
+	OrgUsersCreateOrgUserWithBodyWithResponse(ctx context.Context, orgId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*OrgUsersCreateOrgUserResponse, error)
+
+	OrgUsersCreateOrgUserWithResponse(ctx context.Context, orgId string, body OrgUsersCreateOrgUserJSONRequestBody, reqEditors ...RequestEditorFn) (*OrgUsersCreateOrgUserResponse, error)
+
+	// OrgUsersDeleteOrgUserWithResponse request
+	OrgUsersDeleteOrgUserWithResponse(ctx context.Context, orgId string, userId string, reqEditors ...RequestEditorFn) (*OrgUsersDeleteOrgUserResponse, error)
+
+	// OrgUsersGetOrgUserWithResponse request
+	OrgUsersGetOrgUserWithResponse(ctx context.Context, orgId string, userId string, params *OrgUsersGetOrgUserParams, reqEditors ...RequestEditorFn) (*OrgUsersGetOrgUserResponse, error)
+
+	// OrgUsersPatchOrgUserWithBodyWithResponse request with any body
+	OrgUsersPatchOrgUserWithBodyWithResponse(ctx context.Context, orgId string, userId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*OrgUsersPatchOrgUserResponse, error)
+
+	OrgUsersPatchOrgUserWithApplicationJSONPatchPlusJSONBodyWithResponse(ctx context.Context, orgId string, userId string, body OrgUsersPatchOrgUserApplicationJSONPatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*OrgUsersPatchOrgUserResponse, error)
+
+	OrgUsersPatchOrgUserWithApplicationMergePatchPlusJSONBodyWithResponse(ctx context.Context, orgId string, userId string, body OrgUsersPatchOrgUserApplicationMergePatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*OrgUsersPatchOrgUserResponse, error)
+
+	// OrgUsersUpdateOrgUserWithBodyWithResponse request with any body
+	OrgUsersUpdateOrgUserWithBodyWithResponse(ctx context.Context, orgId string, userId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*OrgUsersUpdateOrgUserResponse, error)
+
+	OrgUsersUpdateOrgUserWithResponse(ctx context.Context, orgId string, userId string, body OrgUsersUpdateOrgUserJSONRequestBody, reqEditors ...RequestEditorFn) (*OrgUsersUpdateOrgUserResponse, error)
+
+	// UsersListUsersWithResponse request
+	UsersListUsersWithResponse(ctx context.Context, params *UsersListUsersParams, reqEditors ...RequestEditorFn) (*UsersListUsersResponse, error)
+
+	// UsersCreateUserWithBodyWithResponse request with any body
+	UsersCreateUserWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UsersCreateUserResponse, error)
+
+	UsersCreateUserWithResponse(ctx context.Context, body UsersCreateUserJSONRequestBody, reqEditors ...RequestEditorFn) (*UsersCreateUserResponse, error)
+
+	// UsersDeleteUserWithResponse request
+	UsersDeleteUserWithResponse(ctx context.Context, userId string, reqEditors ...RequestEditorFn) (*UsersDeleteUserResponse, error)
+
+	// UsersGetUserWithResponse request
+	UsersGetUserWithResponse(ctx context.Context, userId string, params *UsersGetUserParams, reqEditors ...RequestEditorFn) (*UsersGetUserResponse, error)
+
+	// UsersPatchUserWithBodyWithResponse request with any body
+	UsersPatchUserWithBodyWithResponse(ctx context.Context, userId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UsersPatchUserResponse, error)
+
+	UsersPatchUserWithApplicationJSONPatchPlusJSONBodyWithResponse(ctx context.Context, userId string, body UsersPatchUserApplicationJSONPatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*UsersPatchUserResponse, error)
+
+	UsersPatchUserWithApplicationMergePatchPlusJSONBodyWithResponse(ctx context.Context, userId string, body UsersPatchUserApplicationMergePatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*UsersPatchUserResponse, error)
+
+	// UsersUpdateUserWithBodyWithResponse request with any body
+	UsersUpdateUserWithBodyWithResponse(ctx context.Context, userId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UsersUpdateUserResponse, error)
+
+	UsersUpdateUserWithResponse(ctx context.Context, userId string, body UsersUpdateUserJSONRequestBody, reqEditors ...RequestEditorFn) (*UsersUpdateUserResponse, error)
+
+	// UserEmailVerificationRequestEmailVerificationWithResponse request
+	UserEmailVerificationRequestEmailVerificationWithResponse(ctx context.Context, userId string, reqEditors ...RequestEditorFn) (*UserEmailVerificationRequestEmailVerificationResponse, error)
+
+	// UserGroupsListUserGroupsWithResponse request
+	UserGroupsListUserGroupsWithResponse(ctx context.Context, userId string, reqEditors ...RequestEditorFn) (*UserGroupsListUserGroupsResponse, error)
+
+	// UserMFAEnrollTOTPWithBodyWithResponse request with any body
+	UserMFAEnrollTOTPWithBodyWithResponse(ctx context.Context, userId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UserMFAEnrollTOTPResponse, error)
+
+	UserMFAEnrollTOTPWithResponse(ctx context.Context, userId string, body UserMFAEnrollTOTPJSONRequestBody, reqEditors ...RequestEditorFn) (*UserMFAEnrollTOTPResponse, error)
+
+	// UserMFADisableTOTPWithBodyWithResponse request with any body
+	UserMFADisableTOTPWithBodyWithResponse(ctx context.Context, userId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UserMFADisableTOTPResponse, error)
+
+	UserMFADisableTOTPWithResponse(ctx context.Context, userId string, body UserMFADisableTOTPJSONRequestBody, reqEditors ...RequestEditorFn) (*UserMFADisableTOTPResponse, error)
+
+	// UserMFAVerifyTOTPWithBodyWithResponse request with any body
+	UserMFAVerifyTOTPWithBodyWithResponse(ctx context.Context, userId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UserMFAVerifyTOTPResponse, error)
+
+	UserMFAVerifyTOTPWithResponse(ctx context.Context, userId string, body UserMFAVerifyTOTPJSONRequestBody, reqEditors ...RequestEditorFn) (*UserMFAVerifyTOTPResponse, error)
+
+	// UserPasswordsChangePasswordWithBodyWithResponse request with any body
+	UserPasswordsChangePasswordWithBodyWithResponse(ctx context.Context, userId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UserPasswordsChangePasswordResponse, error)
+
+	UserPasswordsChangePasswordWithResponse(ctx context.Context, userId string, body UserPasswordsChangePasswordJSONRequestBody, reqEditors ...RequestEditorFn) (*UserPasswordsChangePasswordResponse, error)
+
+	// UserSessionsListSessionsWithResponse request
+	UserSessionsListSessionsWithResponse(ctx context.Context, userId string, reqEditors ...RequestEditorFn) (*UserSessionsListSessionsResponse, error)
+
+	// UserSessionsRevokeSessionWithResponse request
+	UserSessionsRevokeSessionWithResponse(ctx context.Context, userId string, sessionId string, reqEditors ...RequestEditorFn) (*UserSessionsRevokeSessionResponse, error)
+
+	// UserSessionsRevokeAllSessionsWithResponse request
+	UserSessionsRevokeAllSessionsWithResponse(ctx context.Context, userId string, reqEditors ...RequestEditorFn) (*UserSessionsRevokeAllSessionsResponse, error)
+
+	// UsersDeactivateUserWithBodyWithResponse request with any body
+	UsersDeactivateUserWithBodyWithResponse(ctx context.Context, userId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UsersDeactivateUserResponse, error)
+
+	UsersDeactivateUserWithResponse(ctx context.Context, userId string, body UsersDeactivateUserJSONRequestBody, reqEditors ...RequestEditorFn) (*UsersDeactivateUserResponse, error)
+
+	// UsersReactivateUserWithBodyWithResponse request with any body
+	UsersReactivateUserWithBodyWithResponse(ctx context.Context, userId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UsersReactivateUserResponse, error)
+
+	UsersReactivateUserWithResponse(ctx context.Context, userId string, body UsersReactivateUserJSONRequestBody, reqEditors ...RequestEditorFn) (*UsersReactivateUserResponse, error)
+
+	// UsersSuspendUserWithBodyWithResponse request with any body
+	UsersSuspendUserWithBodyWithResponse(ctx context.Context, userId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UsersSuspendUserResponse, error)
+
+	UsersSuspendUserWithResponse(ctx context.Context, userId string, body UsersSuspendUserJSONRequestBody, reqEditors ...RequestEditorFn) (*UsersSuspendUserResponse, error)
+
+	// UsersBatchUsersWithBodyWithResponse request with any body
+	UsersBatchUsersWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UsersBatchUsersResponse, error)
+
+	UsersBatchUsersWithResponse(ctx context.Context, body UsersBatchUsersJSONRequestBody, reqEditors ...RequestEditorFn) (*UsersBatchUsersResponse, error)
+
+	// UsersWatchUsersWithResponse request
+	UsersWatchUsersWithResponse(ctx context.Context, params *UsersWatchUsersParams, reqEditors ...RequestEditorFn) (*UsersWatchUsersResponse, error)
+
+	// WebhookSubscriptionsListWebhookSubscriptionsWithResponse request
+	WebhookSubscriptionsListWebhookSubscriptionsWithResponse(ctx context.Context, params *WebhookSubscriptionsListWebhookSubscriptionsParams, reqEditors ...RequestEditorFn) (*WebhookSubscriptionsListWebhookSubscriptionsResponse, error)
+
+	// WebhookSubscriptionsCreateWebhookSubscriptionWithBodyWithResponse request with any body
+	WebhookSubscriptionsCreateWebhookSubscriptionWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*WebhookSubscriptionsCreateWebhookSubscriptionResponse, error)
+
+	WebhookSubscriptionsCreateWebhookSubscriptionWithResponse(ctx context.Context, body WebhookSubscriptionsCreateWebhookSubscriptionJSONRequestBody, reqEditors ...RequestEditorFn) (*WebhookSubscriptionsCreateWebhookSubscriptionResponse, error)
+
+	// WebhookSubscriptionsDeleteWebhookSubscriptionWithResponse request
+	WebhookSubscriptionsDeleteWebhookSubscriptionWithResponse(ctx context.Context, subscriptionId string, reqEditors ...RequestEditorFn) (*WebhookSubscriptionsDeleteWebhookSubscriptionResponse, error)
+
+	// WebhookSubscriptionsGetWebhookSubscriptionWithResponse request
+	WebhookSubscriptionsGetWebhookSubscriptionWithResponse(ctx context.Context, subscriptionId string, reqEditors ...RequestEditorFn) (*WebhookSubscriptionsGetWebhookSubscriptionResponse, error)
+
+	// WebhookSubscriptionsUpdateWebhookSubscriptionWithBodyWithResponse request with any body
+	WebhookSubscriptionsUpdateWebhookSubscriptionWithBodyWithResponse(ctx context.Context, subscriptionId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*WebhookSubscriptionsUpdateWebhookSubscriptionResponse, error)
+
+	WebhookSubscriptionsUpdateWebhookSubscriptionWithResponse(ctx context.Context, subscriptionId string, body WebhookSubscriptionsUpdateWebhookSubscriptionJSONRequestBody, reqEditors ...RequestEditorFn) (*WebhookSubscriptionsUpdateWebhookSubscriptionResponse, error)
+
+	// WebhookSubscriptionsListWebhookDeliveriesWithResponse request
+	WebhookSubscriptionsListWebhookDeliveriesWithResponse(ctx context.Context, subscriptionId string, params *WebhookSubscriptionsListWebhookDeliveriesParams, reqEditors ...RequestEditorFn) (*WebhookSubscriptionsListWebhookDeliveriesResponse, error)
+}
+
+type AuthConfirmEmailVerificationResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *User
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r AuthConfirmEmailVerificationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AuthConfirmEmailVerificationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AuthLoginResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *LoginResponse
+	JSON202      *MFAChallenge
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r AuthLoginResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AuthLoginResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AuthVerifyMFAResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *LoginResponse
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r AuthVerifyMFAResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AuthVerifyMFAResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type OIDCLoginAuthorizeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *OIDCAuthorization
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r OIDCLoginAuthorizeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r OIDCLoginAuthorizeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type OIDCLoginCallbackResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *LoginResponse
+	JSON202      *MFAChallenge
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r OIDCLoginCallbackResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r OIDCLoginCallbackResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AuthRequestPasswordResetResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r AuthRequestPasswordResetResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AuthRequestPasswordResetResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AuthConfirmPasswordResetResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r AuthConfirmPasswordResetResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AuthConfirmPasswordResetResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GroupsCreateGroupResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Group
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GroupsCreateGroupResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GroupsCreateGroupResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GroupsGetGroupResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Group
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GroupsGetGroupResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GroupsGetGroupResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GroupsListGroupMembersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GroupMemberList
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GroupsListGroupMembersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GroupsListGroupMembersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GroupsAddGroupMembersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GroupsAddGroupMembersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GroupsAddGroupMembersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GroupsRemoveGroupMembersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GroupsRemoveGroupMembersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GroupsRemoveGroupMembersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type InvitationsListInvitationsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *InvitationList
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r InvitationsListInvitationsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r InvitationsListInvitationsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type InvitationsCreateInvitationResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Invitation
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r InvitationsCreateInvitationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r InvitationsCreateInvitationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type InvitationsResendInvitationResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Invitation
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r InvitationsResendInvitationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r InvitationsResendInvitationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type InvitationsRevokeInvitationResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r InvitationsRevokeInvitationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r InvitationsRevokeInvitationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type InvitationsAcceptInvitationResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *User
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r InvitationsAcceptInvitationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r InvitationsAcceptInvitationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type OrganizationsListOrganizationsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *OrganizationList
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r OrganizationsListOrganizationsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r OrganizationsListOrganizationsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type OrganizationsCreateOrganizationResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Organization
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r OrganizationsCreateOrganizationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r OrganizationsCreateOrganizationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type OrganizationsGetOrganizationResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Organization
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r OrganizationsGetOrganizationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r OrganizationsGetOrganizationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type OrgUsersListOrgUsersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *UserList
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r OrgUsersListOrgUsersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r OrgUsersListOrgUsersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type OrgUsersCreateOrgUserResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r OrgUsersCreateOrgUserResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r OrgUsersCreateOrgUserResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type OrgUsersDeleteOrgUserResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r OrgUsersDeleteOrgUserResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r OrgUsersDeleteOrgUserResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type OrgUsersGetOrgUserResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *User
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r OrgUsersGetOrgUserResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r OrgUsersGetOrgUserResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type OrgUsersPatchOrgUserResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *User
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r OrgUsersPatchOrgUserResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r OrgUsersPatchOrgUserResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type OrgUsersUpdateOrgUserResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r OrgUsersUpdateOrgUserResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r OrgUsersUpdateOrgUserResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UsersListUsersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *UserList
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r UsersListUsersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UsersListUsersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UsersCreateUserResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r UsersCreateUserResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UsersCreateUserResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UsersDeleteUserResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r UsersDeleteUserResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UsersDeleteUserResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UsersGetUserResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *User
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r UsersGetUserResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UsersGetUserResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UsersPatchUserResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *User
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r UsersPatchUserResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UsersPatchUserResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UsersUpdateUserResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r UsersUpdateUserResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UsersUpdateUserResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UserEmailVerificationRequestEmailVerificationResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r UserEmailVerificationRequestEmailVerificationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UserEmailVerificationRequestEmailVerificationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UserGroupsListUserGroupsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *UserGroupList
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r UserGroupsListUserGroupsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UserGroupsListUserGroupsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UserMFAEnrollTOTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TOTPEnrollment
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r UserMFAEnrollTOTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UserMFAEnrollTOTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UserMFADisableTOTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r UserMFADisableTOTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UserMFADisableTOTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UserMFAVerifyTOTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RecoveryCodeList
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r UserMFAVerifyTOTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UserMFAVerifyTOTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UserPasswordsChangePasswordResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r UserPasswordsChangePasswordResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UserPasswordsChangePasswordResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UserSessionsListSessionsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SessionList
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r UserSessionsListSessionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UserSessionsListSessionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UserSessionsRevokeSessionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r UserSessionsRevokeSessionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UserSessionsRevokeSessionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UserSessionsRevokeAllSessionsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r UserSessionsRevokeAllSessionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UserSessionsRevokeAllSessionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UsersDeactivateUserResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *User
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r UsersDeactivateUserResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UsersDeactivateUserResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UsersReactivateUserResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *User
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r UsersReactivateUserResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UsersReactivateUserResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UsersSuspendUserResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *User
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r UsersSuspendUserResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UsersSuspendUserResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UsersBatchUsersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *BatchUsersResponse
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r UsersBatchUsersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UsersBatchUsersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UsersWatchUsersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r UsersWatchUsersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UsersWatchUsersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type WebhookSubscriptionsListWebhookSubscriptionsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *WebhookSubscriptionList
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r WebhookSubscriptionsListWebhookSubscriptionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r WebhookSubscriptionsListWebhookSubscriptionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type WebhookSubscriptionsCreateWebhookSubscriptionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *WebhookSubscription
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r WebhookSubscriptionsCreateWebhookSubscriptionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r WebhookSubscriptionsCreateWebhookSubscriptionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type WebhookSubscriptionsDeleteWebhookSubscriptionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r WebhookSubscriptionsDeleteWebhookSubscriptionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r WebhookSubscriptionsDeleteWebhookSubscriptionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type WebhookSubscriptionsGetWebhookSubscriptionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *WebhookSubscription
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r WebhookSubscriptionsGetWebhookSubscriptionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r WebhookSubscriptionsGetWebhookSubscriptionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type WebhookSubscriptionsUpdateWebhookSubscriptionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *WebhookSubscription
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r WebhookSubscriptionsUpdateWebhookSubscriptionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r WebhookSubscriptionsUpdateWebhookSubscriptionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type WebhookSubscriptionsListWebhookDeliveriesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *WebhookDeliveryList
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r WebhookSubscriptionsListWebhookDeliveriesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r WebhookSubscriptionsListWebhookDeliveriesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// AuthConfirmEmailVerificationWithBodyWithResponse request with arbitrary body returning *AuthConfirmEmailVerificationResponse
+func (c *ClientWithResponses) AuthConfirmEmailVerificationWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AuthConfirmEmailVerificationResponse, error) {
+	rsp, err := c.AuthConfirmEmailVerificationWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAuthConfirmEmailVerificationResponse(rsp)
+}
+
+func (c *ClientWithResponses) AuthConfirmEmailVerificationWithResponse(ctx context.Context, body AuthConfirmEmailVerificationJSONRequestBody, reqEditors ...RequestEditorFn) (*AuthConfirmEmailVerificationResponse, error) {
+	rsp, err := c.AuthConfirmEmailVerification(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAuthConfirmEmailVerificationResponse(rsp)
+}
+
+// AuthLoginWithBodyWithResponse request with arbitrary body returning *AuthLoginResponse
+func (c *ClientWithResponses) AuthLoginWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AuthLoginResponse, error) {
+	rsp, err := c.AuthLoginWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAuthLoginResponse(rsp)
+}
+
+func (c *ClientWithResponses) AuthLoginWithResponse(ctx context.Context, body AuthLoginJSONRequestBody, reqEditors ...RequestEditorFn) (*AuthLoginResponse, error) {
+	rsp, err := c.AuthLogin(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAuthLoginResponse(rsp)
+}
+
+// AuthVerifyMFAWithBodyWithResponse request with arbitrary body returning *AuthVerifyMFAResponse
+func (c *ClientWithResponses) AuthVerifyMFAWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AuthVerifyMFAResponse, error) {
+	rsp, err := c.AuthVerifyMFAWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAuthVerifyMFAResponse(rsp)
+}
+
+func (c *ClientWithResponses) AuthVerifyMFAWithResponse(ctx context.Context, body AuthVerifyMFAJSONRequestBody, reqEditors ...RequestEditorFn) (*AuthVerifyMFAResponse, error) {
+	rsp, err := c.AuthVerifyMFA(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAuthVerifyMFAResponse(rsp)
+}
+
+// OIDCLoginAuthorizeWithResponse request returning *OIDCLoginAuthorizeResponse
+func (c *ClientWithResponses) OIDCLoginAuthorizeWithResponse(ctx context.Context, provider string, reqEditors ...RequestEditorFn) (*OIDCLoginAuthorizeResponse, error) {
+	rsp, err := c.OIDCLoginAuthorize(ctx, provider, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseOIDCLoginAuthorizeResponse(rsp)
+}
+
+// OIDCLoginCallbackWithBodyWithResponse request with arbitrary body returning *OIDCLoginCallbackResponse
+func (c *ClientWithResponses) OIDCLoginCallbackWithBodyWithResponse(ctx context.Context, provider string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*OIDCLoginCallbackResponse, error) {
+	rsp, err := c.OIDCLoginCallbackWithBody(ctx, provider, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseOIDCLoginCallbackResponse(rsp)
+}
+
+func (c *ClientWithResponses) OIDCLoginCallbackWithResponse(ctx context.Context, provider string, body OIDCLoginCallbackJSONRequestBody, reqEditors ...RequestEditorFn) (*OIDCLoginCallbackResponse, error) {
+	rsp, err := c.OIDCLoginCallback(ctx, provider, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseOIDCLoginCallbackResponse(rsp)
+}
+
+// AuthRequestPasswordResetWithBodyWithResponse request with arbitrary body returning *AuthRequestPasswordResetResponse
+func (c *ClientWithResponses) AuthRequestPasswordResetWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AuthRequestPasswordResetResponse, error) {
+	rsp, err := c.AuthRequestPasswordResetWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAuthRequestPasswordResetResponse(rsp)
+}
+
+func (c *ClientWithResponses) AuthRequestPasswordResetWithResponse(ctx context.Context, body AuthRequestPasswordResetJSONRequestBody, reqEditors ...RequestEditorFn) (*AuthRequestPasswordResetResponse, error) {
+	rsp, err := c.AuthRequestPasswordReset(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAuthRequestPasswordResetResponse(rsp)
+}
+
+// AuthConfirmPasswordResetWithBodyWithResponse request with arbitrary body returning *AuthConfirmPasswordResetResponse
+func (c *ClientWithResponses) AuthConfirmPasswordResetWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AuthConfirmPasswordResetResponse, error) {
+	rsp, err := c.AuthConfirmPasswordResetWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAuthConfirmPasswordResetResponse(rsp)
+}
+
+func (c *ClientWithResponses) AuthConfirmPasswordResetWithResponse(ctx context.Context, body AuthConfirmPasswordResetJSONRequestBody, reqEditors ...RequestEditorFn) (*AuthConfirmPasswordResetResponse, error) {
+	rsp, err := c.AuthConfirmPasswordReset(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAuthConfirmPasswordResetResponse(rsp)
+}
+
+// GroupsCreateGroupWithBodyWithResponse request with arbitrary body returning *GroupsCreateGroupResponse
+func (c *ClientWithResponses) GroupsCreateGroupWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*GroupsCreateGroupResponse, error) {
+	rsp, err := c.GroupsCreateGroupWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGroupsCreateGroupResponse(rsp)
+}
+
+func (c *ClientWithResponses) GroupsCreateGroupWithResponse(ctx context.Context, body GroupsCreateGroupJSONRequestBody, reqEditors ...RequestEditorFn) (*GroupsCreateGroupResponse, error) {
+	rsp, err := c.GroupsCreateGroup(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGroupsCreateGroupResponse(rsp)
+}
+
+// GroupsGetGroupWithResponse request returning *GroupsGetGroupResponse
+func (c *ClientWithResponses) GroupsGetGroupWithResponse(ctx context.Context, groupId string, reqEditors ...RequestEditorFn) (*GroupsGetGroupResponse, error) {
+	rsp, err := c.GroupsGetGroup(ctx, groupId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGroupsGetGroupResponse(rsp)
+}
+
+// GroupsListGroupMembersWithResponse request returning *GroupsListGroupMembersResponse
+func (c *ClientWithResponses) GroupsListGroupMembersWithResponse(ctx context.Context, groupId string, params *GroupsListGroupMembersParams, reqEditors ...RequestEditorFn) (*GroupsListGroupMembersResponse, error) {
+	rsp, err := c.GroupsListGroupMembers(ctx, groupId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGroupsListGroupMembersResponse(rsp)
+}
+
+// GroupsAddGroupMembersWithBodyWithResponse request with arbitrary body returning *GroupsAddGroupMembersResponse
+func (c *ClientWithResponses) GroupsAddGroupMembersWithBodyWithResponse(ctx context.Context, groupId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*GroupsAddGroupMembersResponse, error) {
+	rsp, err := c.GroupsAddGroupMembersWithBody(ctx, groupId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGroupsAddGroupMembersResponse(rsp)
+}
+
+func (c *ClientWithResponses) GroupsAddGroupMembersWithResponse(ctx context.Context, groupId string, body GroupsAddGroupMembersJSONRequestBody, reqEditors ...RequestEditorFn) (*GroupsAddGroupMembersResponse, error) {
+	rsp, err := c.GroupsAddGroupMembers(ctx, groupId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGroupsAddGroupMembersResponse(rsp)
+}
+
+// GroupsRemoveGroupMembersWithBodyWithResponse request with arbitrary body returning *GroupsRemoveGroupMembersResponse
+func (c *ClientWithResponses) GroupsRemoveGroupMembersWithBodyWithResponse(ctx context.Context, groupId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*GroupsRemoveGroupMembersResponse, error) {
+	rsp, err := c.GroupsRemoveGroupMembersWithBody(ctx, groupId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGroupsRemoveGroupMembersResponse(rsp)
+}
+
+func (c *ClientWithResponses) GroupsRemoveGroupMembersWithResponse(ctx context.Context, groupId string, body GroupsRemoveGroupMembersJSONRequestBody, reqEditors ...RequestEditorFn) (*GroupsRemoveGroupMembersResponse, error) {
+	rsp, err := c.GroupsRemoveGroupMembers(ctx, groupId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGroupsRemoveGroupMembersResponse(rsp)
+}
+
+// InvitationsListInvitationsWithResponse request returning *InvitationsListInvitationsResponse
+func (c *ClientWithResponses) InvitationsListInvitationsWithResponse(ctx context.Context, params *InvitationsListInvitationsParams, reqEditors ...RequestEditorFn) (*InvitationsListInvitationsResponse, error) {
+	rsp, err := c.InvitationsListInvitations(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseInvitationsListInvitationsResponse(rsp)
+}
+
+// InvitationsCreateInvitationWithBodyWithResponse request with arbitrary body returning *InvitationsCreateInvitationResponse
+func (c *ClientWithResponses) InvitationsCreateInvitationWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*InvitationsCreateInvitationResponse, error) {
+	rsp, err := c.InvitationsCreateInvitationWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseInvitationsCreateInvitationResponse(rsp)
+}
+
+func (c *ClientWithResponses) InvitationsCreateInvitationWithResponse(ctx context.Context, body InvitationsCreateInvitationJSONRequestBody, reqEditors ...RequestEditorFn) (*InvitationsCreateInvitationResponse, error) {
+	rsp, err := c.InvitationsCreateInvitation(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseInvitationsCreateInvitationResponse(rsp)
+}
+
+// InvitationsResendInvitationWithResponse request returning *InvitationsResendInvitationResponse
+func (c *ClientWithResponses) InvitationsResendInvitationWithResponse(ctx context.Context, invitationId string, reqEditors ...RequestEditorFn) (*InvitationsResendInvitationResponse, error) {
+	rsp, err := c.InvitationsResendInvitation(ctx, invitationId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseInvitationsResendInvitationResponse(rsp)
+}
+
+// InvitationsRevokeInvitationWithResponse request returning *InvitationsRevokeInvitationResponse
+func (c *ClientWithResponses) InvitationsRevokeInvitationWithResponse(ctx context.Context, invitationId string, reqEditors ...RequestEditorFn) (*InvitationsRevokeInvitationResponse, error) {
+	rsp, err := c.InvitationsRevokeInvitation(ctx, invitationId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseInvitationsRevokeInvitationResponse(rsp)
+}
+
+// InvitationsAcceptInvitationWithBodyWithResponse request with arbitrary body returning *InvitationsAcceptInvitationResponse
+func (c *ClientWithResponses) InvitationsAcceptInvitationWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*InvitationsAcceptInvitationResponse, error) {
+	rsp, err := c.InvitationsAcceptInvitationWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseInvitationsAcceptInvitationResponse(rsp)
+}
+
+func (c *ClientWithResponses) InvitationsAcceptInvitationWithResponse(ctx context.Context, body InvitationsAcceptInvitationJSONRequestBody, reqEditors ...RequestEditorFn) (*InvitationsAcceptInvitationResponse, error) {
+	rsp, err := c.InvitationsAcceptInvitation(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseInvitationsAcceptInvitationResponse(rsp)
+}
+
+// OrganizationsListOrganizationsWithResponse request returning *OrganizationsListOrganizationsResponse
+func (c *ClientWithResponses) OrganizationsListOrganizationsWithResponse(ctx context.Context, params *OrganizationsListOrganizationsParams, reqEditors ...RequestEditorFn) (*OrganizationsListOrganizationsResponse, error) {
+	rsp, err := c.OrganizationsListOrganizations(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseOrganizationsListOrganizationsResponse(rsp)
+}
+
+// OrganizationsCreateOrganizationWithBodyWithResponse request with arbitrary body returning *OrganizationsCreateOrganizationResponse
+func (c *ClientWithResponses) OrganizationsCreateOrganizationWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*OrganizationsCreateOrganizationResponse, error) {
+	rsp, err := c.OrganizationsCreateOrganizationWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseOrganizationsCreateOrganizationResponse(rsp)
+}
+
+func (c *ClientWithResponses) OrganizationsCreateOrganizationWithResponse(ctx context.Context, body OrganizationsCreateOrganizationJSONRequestBody, reqEditors ...RequestEditorFn) (*OrganizationsCreateOrganizationResponse, error) {
+	rsp, err := c.OrganizationsCreateOrganization(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseOrganizationsCreateOrganizationResponse(rsp)
+}
+
+// OrganizationsGetOrganizationWithResponse request returning *OrganizationsGetOrganizationResponse
+func (c *ClientWithResponses) OrganizationsGetOrganizationWithResponse(ctx context.Context, orgId string, reqEditors ...RequestEditorFn) (*OrganizationsGetOrganizationResponse, error) {
+	rsp, err := c.OrganizationsGetOrganization(ctx, orgId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseOrganizationsGetOrganizationResponse(rsp)
+}
+
+// OrgUsersListOrgUsersWithResponse request returning *OrgUsersListOrgUsersResponse
+func (c *ClientWithResponses) OrgUsersListOrgUsersWithResponse(ctx context.Context, orgId string, params *OrgUsersListOrgUsersParams, reqEditors ...RequestEditorFn) (*OrgUsersListOrgUsersResponse, error) {
+	rsp, err := c.OrgUsersListOrgUsers(ctx, orgId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseOrgUsersListOrgUsersResponse(rsp)
+}
+
+// OrgUsersCreateOrgUserWithBodyWithResponse request with arbitrary body returning *OrgUsersCreateOrgUserResponse
+func (c *ClientWithResponses) OrgUsersCreateOrgUserWithBodyWithResponse(ctx context.Context, orgId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*OrgUsersCreateOrgUserResponse, error) {
+	rsp, err := c.OrgUsersCreateOrgUserWithBody(ctx, orgId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseOrgUsersCreateOrgUserResponse(rsp)
+}
+
+func (c *ClientWithResponses) OrgUsersCreateOrgUserWithResponse(ctx context.Context, orgId string, body OrgUsersCreateOrgUserJSONRequestBody, reqEditors ...RequestEditorFn) (*OrgUsersCreateOrgUserResponse, error) {
+	rsp, err := c.OrgUsersCreateOrgUser(ctx, orgId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseOrgUsersCreateOrgUserResponse(rsp)
+}
+
+// OrgUsersDeleteOrgUserWithResponse request returning *OrgUsersDeleteOrgUserResponse
+func (c *ClientWithResponses) OrgUsersDeleteOrgUserWithResponse(ctx context.Context, orgId string, userId string, reqEditors ...RequestEditorFn) (*OrgUsersDeleteOrgUserResponse, error) {
+	rsp, err := c.OrgUsersDeleteOrgUser(ctx, orgId, userId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseOrgUsersDeleteOrgUserResponse(rsp)
+}
+
+// OrgUsersGetOrgUserWithResponse request returning *OrgUsersGetOrgUserResponse
+func (c *ClientWithResponses) OrgUsersGetOrgUserWithResponse(ctx context.Context, orgId string, userId string, params *OrgUsersGetOrgUserParams, reqEditors ...RequestEditorFn) (*OrgUsersGetOrgUserResponse, error) {
+	rsp, err := c.OrgUsersGetOrgUser(ctx, orgId, userId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseOrgUsersGetOrgUserResponse(rsp)
+}
+
+// OrgUsersPatchOrgUserWithBodyWithResponse request with arbitrary body returning *OrgUsersPatchOrgUserResponse
+func (c *ClientWithResponses) OrgUsersPatchOrgUserWithBodyWithResponse(ctx context.Context, orgId string, userId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*OrgUsersPatchOrgUserResponse, error) {
+	rsp, err := c.OrgUsersPatchOrgUserWithBody(ctx, orgId, userId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseOrgUsersPatchOrgUserResponse(rsp)
+}
+
+func (c *ClientWithResponses) OrgUsersPatchOrgUserWithApplicationJSONPatchPlusJSONBodyWithResponse(ctx context.Context, orgId string, userId string, body OrgUsersPatchOrgUserApplicationJSONPatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*OrgUsersPatchOrgUserResponse, error) {
+	rsp, err := c.OrgUsersPatchOrgUserWithApplicationJSONPatchPlusJSONBody(ctx, orgId, userId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseOrgUsersPatchOrgUserResponse(rsp)
+}
+
+func (c *ClientWithResponses) OrgUsersPatchOrgUserWithApplicationMergePatchPlusJSONBodyWithResponse(ctx context.Context, orgId string, userId string, body OrgUsersPatchOrgUserApplicationMergePatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*OrgUsersPatchOrgUserResponse, error) {
+	rsp, err := c.OrgUsersPatchOrgUserWithApplicationMergePatchPlusJSONBody(ctx, orgId, userId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseOrgUsersPatchOrgUserResponse(rsp)
+}
+
+// OrgUsersUpdateOrgUserWithBodyWithResponse request with arbitrary body returning *OrgUsersUpdateOrgUserResponse
+func (c *ClientWithResponses) OrgUsersUpdateOrgUserWithBodyWithResponse(ctx context.Context, orgId string, userId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*OrgUsersUpdateOrgUserResponse, error) {
+	rsp, err := c.OrgUsersUpdateOrgUserWithBody(ctx, orgId, userId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseOrgUsersUpdateOrgUserResponse(rsp)
+}
+
+func (c *ClientWithResponses) OrgUsersUpdateOrgUserWithResponse(ctx context.Context, orgId string, userId string, body OrgUsersUpdateOrgUserJSONRequestBody, reqEditors ...RequestEditorFn) (*OrgUsersUpdateOrgUserResponse, error) {
+	rsp, err := c.OrgUsersUpdateOrgUser(ctx, orgId, userId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseOrgUsersUpdateOrgUserResponse(rsp)
+}
+
+// UsersListUsersWithResponse request returning *UsersListUsersResponse
+func (c *ClientWithResponses) UsersListUsersWithResponse(ctx context.Context, params *UsersListUsersParams, reqEditors ...RequestEditorFn) (*UsersListUsersResponse, error) {
+	rsp, err := c.UsersListUsers(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUsersListUsersResponse(rsp)
+}
+
+// UsersCreateUserWithBodyWithResponse request with arbitrary body returning *UsersCreateUserResponse
+func (c *ClientWithResponses) UsersCreateUserWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UsersCreateUserResponse, error) {
+	rsp, err := c.UsersCreateUserWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUsersCreateUserResponse(rsp)
+}
+
+func (c *ClientWithResponses) UsersCreateUserWithResponse(ctx context.Context, body UsersCreateUserJSONRequestBody, reqEditors ...RequestEditorFn) (*UsersCreateUserResponse, error) {
+	rsp, err := c.UsersCreateUser(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUsersCreateUserResponse(rsp)
+}
+
+// UsersDeleteUserWithResponse request returning *UsersDeleteUserResponse
+func (c *ClientWithResponses) UsersDeleteUserWithResponse(ctx context.Context, userId string, reqEditors ...RequestEditorFn) (*UsersDeleteUserResponse, error) {
+	rsp, err := c.UsersDeleteUser(ctx, userId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUsersDeleteUserResponse(rsp)
+}
+
+// UsersGetUserWithResponse request returning *UsersGetUserResponse
+func (c *ClientWithResponses) UsersGetUserWithResponse(ctx context.Context, userId string, params *UsersGetUserParams, reqEditors ...RequestEditorFn) (*UsersGetUserResponse, error) {
+	rsp, err := c.UsersGetUser(ctx, userId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUsersGetUserResponse(rsp)
+}
+
+// UsersPatchUserWithBodyWithResponse request with arbitrary body returning *UsersPatchUserResponse
+func (c *ClientWithResponses) UsersPatchUserWithBodyWithResponse(ctx context.Context, userId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UsersPatchUserResponse, error) {
+	rsp, err := c.UsersPatchUserWithBody(ctx, userId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUsersPatchUserResponse(rsp)
+}
+
+func (c *ClientWithResponses) UsersPatchUserWithApplicationJSONPatchPlusJSONBodyWithResponse(ctx context.Context, userId string, body UsersPatchUserApplicationJSONPatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*UsersPatchUserResponse, error) {
+	rsp, err := c.UsersPatchUserWithApplicationJSONPatchPlusJSONBody(ctx, userId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUsersPatchUserResponse(rsp)
+}
+
+func (c *ClientWithResponses) UsersPatchUserWithApplicationMergePatchPlusJSONBodyWithResponse(ctx context.Context, userId string, body UsersPatchUserApplicationMergePatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*UsersPatchUserResponse, error) {
+	rsp, err := c.UsersPatchUserWithApplicationMergePatchPlusJSONBody(ctx, userId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUsersPatchUserResponse(rsp)
+}
+
+// UsersUpdateUserWithBodyWithResponse request with arbitrary body returning *UsersUpdateUserResponse
+func (c *ClientWithResponses) UsersUpdateUserWithBodyWithResponse(ctx context.Context, userId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UsersUpdateUserResponse, error) {
+	rsp, err := c.UsersUpdateUserWithBody(ctx, userId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUsersUpdateUserResponse(rsp)
+}
+
+func (c *ClientWithResponses) UsersUpdateUserWithResponse(ctx context.Context, userId string, body UsersUpdateUserJSONRequestBody, reqEditors ...RequestEditorFn) (*UsersUpdateUserResponse, error) {
+	rsp, err := c.UsersUpdateUser(ctx, userId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUsersUpdateUserResponse(rsp)
+}
+
+// UserEmailVerificationRequestEmailVerificationWithResponse request returning *UserEmailVerificationRequestEmailVerificationResponse
+func (c *ClientWithResponses) UserEmailVerificationRequestEmailVerificationWithResponse(ctx context.Context, userId string, reqEditors ...RequestEditorFn) (*UserEmailVerificationRequestEmailVerificationResponse, error) {
+	rsp, err := c.UserEmailVerificationRequestEmailVerification(ctx, userId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUserEmailVerificationRequestEmailVerificationResponse(rsp)
+}
+
+// UserGroupsListUserGroupsWithResponse request returning *UserGroupsListUserGroupsResponse
+func (c *ClientWithResponses) UserGroupsListUserGroupsWithResponse(ctx context.Context, userId string, reqEditors ...RequestEditorFn) (*UserGroupsListUserGroupsResponse, error) {
+	rsp, err := c.UserGroupsListUserGroups(ctx, userId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUserGroupsListUserGroupsResponse(rsp)
+}
+
+// UserMFAEnrollTOTPWithBodyWithResponse request with arbitrary body returning *UserMFAEnrollTOTPResponse
+func (c *ClientWithResponses) UserMFAEnrollTOTPWithBodyWithResponse(ctx context.Context, userId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UserMFAEnrollTOTPResponse, error) {
+	rsp, err := c.UserMFAEnrollTOTPWithBody(ctx, userId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUserMFAEnrollTOTPResponse(rsp)
+}
+
+func (c *ClientWithResponses) UserMFAEnrollTOTPWithResponse(ctx context.Context, userId string, body UserMFAEnrollTOTPJSONRequestBody, reqEditors ...RequestEditorFn) (*UserMFAEnrollTOTPResponse, error) {
+	rsp, err := c.UserMFAEnrollTOTP(ctx, userId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUserMFAEnrollTOTPResponse(rsp)
+}
+
+// UserMFADisableTOTPWithBodyWithResponse request with arbitrary body returning *UserMFADisableTOTPResponse
+func (c *ClientWithResponses) UserMFADisableTOTPWithBodyWithResponse(ctx context.Context, userId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UserMFADisableTOTPResponse, error) {
+	rsp, err := c.UserMFADisableTOTPWithBody(ctx, userId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUserMFADisableTOTPResponse(rsp)
+}
+
+func (c *ClientWithResponses) UserMFADisableTOTPWithResponse(ctx context.Context, userId string, body UserMFADisableTOTPJSONRequestBody, reqEditors ...RequestEditorFn) (*UserMFADisableTOTPResponse, error) {
+	rsp, err := c.UserMFADisableTOTP(ctx, userId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUserMFADisableTOTPResponse(rsp)
+}
+
+// UserMFAVerifyTOTPWithBodyWithResponse request with arbitrary body returning *UserMFAVerifyTOTPResponse
+func (c *ClientWithResponses) UserMFAVerifyTOTPWithBodyWithResponse(ctx context.Context, userId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UserMFAVerifyTOTPResponse, error) {
+	rsp, err := c.UserMFAVerifyTOTPWithBody(ctx, userId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUserMFAVerifyTOTPResponse(rsp)
+}
+
+func (c *ClientWithResponses) UserMFAVerifyTOTPWithResponse(ctx context.Context, userId string, body UserMFAVerifyTOTPJSONRequestBody, reqEditors ...RequestEditorFn) (*UserMFAVerifyTOTPResponse, error) {
+	rsp, err := c.UserMFAVerifyTOTP(ctx, userId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUserMFAVerifyTOTPResponse(rsp)
+}
+
+// UserPasswordsChangePasswordWithBodyWithResponse request with arbitrary body returning *UserPasswordsChangePasswordResponse
+func (c *ClientWithResponses) UserPasswordsChangePasswordWithBodyWithResponse(ctx context.Context, userId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UserPasswordsChangePasswordResponse, error) {
+	rsp, err := c.UserPasswordsChangePasswordWithBody(ctx, userId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUserPasswordsChangePasswordResponse(rsp)
+}
+
+func (c *ClientWithResponses) UserPasswordsChangePasswordWithResponse(ctx context.Context, userId string, body UserPasswordsChangePasswordJSONRequestBody, reqEditors ...RequestEditorFn) (*UserPasswordsChangePasswordResponse, error) {
+	rsp, err := c.UserPasswordsChangePassword(ctx, userId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUserPasswordsChangePasswordResponse(rsp)
+}
+
+// UserSessionsListSessionsWithResponse request returning *UserSessionsListSessionsResponse
+func (c *ClientWithResponses) UserSessionsListSessionsWithResponse(ctx context.Context, userId string, reqEditors ...RequestEditorFn) (*UserSessionsListSessionsResponse, error) {
+	rsp, err := c.UserSessionsListSessions(ctx, userId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUserSessionsListSessionsResponse(rsp)
+}
+
+// UserSessionsRevokeSessionWithResponse request returning *UserSessionsRevokeSessionResponse
+func (c *ClientWithResponses) UserSessionsRevokeSessionWithResponse(ctx context.Context, userId string, sessionId string, reqEditors ...RequestEditorFn) (*UserSessionsRevokeSessionResponse, error) {
+	rsp, err := c.UserSessionsRevokeSession(ctx, userId, sessionId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUserSessionsRevokeSessionResponse(rsp)
+}
+
+// UserSessionsRevokeAllSessionsWithResponse request returning *UserSessionsRevokeAllSessionsResponse
+func (c *ClientWithResponses) UserSessionsRevokeAllSessionsWithResponse(ctx context.Context, userId string, reqEditors ...RequestEditorFn) (*UserSessionsRevokeAllSessionsResponse, error) {
+	rsp, err := c.UserSessionsRevokeAllSessions(ctx, userId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUserSessionsRevokeAllSessionsResponse(rsp)
+}
+
+// UsersDeactivateUserWithBodyWithResponse request with arbitrary body returning *UsersDeactivateUserResponse
+func (c *ClientWithResponses) UsersDeactivateUserWithBodyWithResponse(ctx context.Context, userId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UsersDeactivateUserResponse, error) {
+	rsp, err := c.UsersDeactivateUserWithBody(ctx, userId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUsersDeactivateUserResponse(rsp)
+}
+
+func (c *ClientWithResponses) UsersDeactivateUserWithResponse(ctx context.Context, userId string, body UsersDeactivateUserJSONRequestBody, reqEditors ...RequestEditorFn) (*UsersDeactivateUserResponse, error) {
+	rsp, err := c.UsersDeactivateUser(ctx, userId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUsersDeactivateUserResponse(rsp)
+}
+
+// UsersReactivateUserWithBodyWithResponse request with arbitrary body returning *UsersReactivateUserResponse
+func (c *ClientWithResponses) UsersReactivateUserWithBodyWithResponse(ctx context.Context, userId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UsersReactivateUserResponse, error) {
+	rsp, err := c.UsersReactivateUserWithBody(ctx, userId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUsersReactivateUserResponse(rsp)
+}
+
+func (c *ClientWithResponses) UsersReactivateUserWithResponse(ctx context.Context, userId string, body UsersReactivateUserJSONRequestBody, reqEditors ...RequestEditorFn) (*UsersReactivateUserResponse, error) {
+	rsp, err := c.UsersReactivateUser(ctx, userId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUsersReactivateUserResponse(rsp)
+}
+
+// UsersSuspendUserWithBodyWithResponse request with arbitrary body returning *UsersSuspendUserResponse
+func (c *ClientWithResponses) UsersSuspendUserWithBodyWithResponse(ctx context.Context, userId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UsersSuspendUserResponse, error) {
+	rsp, err := c.UsersSuspendUserWithBody(ctx, userId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUsersSuspendUserResponse(rsp)
+}
+
+func (c *ClientWithResponses) UsersSuspendUserWithResponse(ctx context.Context, userId string, body UsersSuspendUserJSONRequestBody, reqEditors ...RequestEditorFn) (*UsersSuspendUserResponse, error) {
+	rsp, err := c.UsersSuspendUser(ctx, userId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUsersSuspendUserResponse(rsp)
+}
+
+// UsersBatchUsersWithBodyWithResponse request with arbitrary body returning *UsersBatchUsersResponse
+func (c *ClientWithResponses) UsersBatchUsersWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UsersBatchUsersResponse, error) {
+	rsp, err := c.UsersBatchUsersWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUsersBatchUsersResponse(rsp)
+}
+
+func (c *ClientWithResponses) UsersBatchUsersWithResponse(ctx context.Context, body UsersBatchUsersJSONRequestBody, reqEditors ...RequestEditorFn) (*UsersBatchUsersResponse, error) {
+	rsp, err := c.UsersBatchUsers(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUsersBatchUsersResponse(rsp)
+}
+
+// UsersWatchUsersWithResponse request returning *UsersWatchUsersResponse
+func (c *ClientWithResponses) UsersWatchUsersWithResponse(ctx context.Context, params *UsersWatchUsersParams, reqEditors ...RequestEditorFn) (*UsersWatchUsersResponse, error) {
+	rsp, err := c.UsersWatchUsers(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUsersWatchUsersResponse(rsp)
+}
+
+// WebhookSubscriptionsListWebhookSubscriptionsWithResponse request returning *WebhookSubscriptionsListWebhookSubscriptionsResponse
+func (c *ClientWithResponses) WebhookSubscriptionsListWebhookSubscriptionsWithResponse(ctx context.Context, params *WebhookSubscriptionsListWebhookSubscriptionsParams, reqEditors ...RequestEditorFn) (*WebhookSubscriptionsListWebhookSubscriptionsResponse, error) {
+	rsp, err := c.WebhookSubscriptionsListWebhookSubscriptions(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseWebhookSubscriptionsListWebhookSubscriptionsResponse(rsp)
+}
+
+// WebhookSubscriptionsCreateWebhookSubscriptionWithBodyWithResponse request with arbitrary body returning *WebhookSubscriptionsCreateWebhookSubscriptionResponse
+func (c *ClientWithResponses) WebhookSubscriptionsCreateWebhookSubscriptionWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*WebhookSubscriptionsCreateWebhookSubscriptionResponse, error) {
+	rsp, err := c.WebhookSubscriptionsCreateWebhookSubscriptionWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseWebhookSubscriptionsCreateWebhookSubscriptionResponse(rsp)
+}
+
+func (c *ClientWithResponses) WebhookSubscriptionsCreateWebhookSubscriptionWithResponse(ctx context.Context, body WebhookSubscriptionsCreateWebhookSubscriptionJSONRequestBody, reqEditors ...RequestEditorFn) (*WebhookSubscriptionsCreateWebhookSubscriptionResponse, error) {
+	rsp, err := c.WebhookSubscriptionsCreateWebhookSubscription(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseWebhookSubscriptionsCreateWebhookSubscriptionResponse(rsp)
+}
+
+// WebhookSubscriptionsDeleteWebhookSubscriptionWithResponse request returning *WebhookSubscriptionsDeleteWebhookSubscriptionResponse
+func (c *ClientWithResponses) WebhookSubscriptionsDeleteWebhookSubscriptionWithResponse(ctx context.Context, subscriptionId string, reqEditors ...RequestEditorFn) (*WebhookSubscriptionsDeleteWebhookSubscriptionResponse, error) {
+	rsp, err := c.WebhookSubscriptionsDeleteWebhookSubscription(ctx, subscriptionId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseWebhookSubscriptionsDeleteWebhookSubscriptionResponse(rsp)
+}
+
+// WebhookSubscriptionsGetWebhookSubscriptionWithResponse request returning *WebhookSubscriptionsGetWebhookSubscriptionResponse
+func (c *ClientWithResponses) WebhookSubscriptionsGetWebhookSubscriptionWithResponse(ctx context.Context, subscriptionId string, reqEditors ...RequestEditorFn) (*WebhookSubscriptionsGetWebhookSubscriptionResponse, error) {
+	rsp, err := c.WebhookSubscriptionsGetWebhookSubscription(ctx, subscriptionId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseWebhookSubscriptionsGetWebhookSubscriptionResponse(rsp)
+}
+
+// WebhookSubscriptionsUpdateWebhookSubscriptionWithBodyWithResponse request with arbitrary body returning *WebhookSubscriptionsUpdateWebhookSubscriptionResponse
+func (c *ClientWithResponses) WebhookSubscriptionsUpdateWebhookSubscriptionWithBodyWithResponse(ctx context.Context, subscriptionId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*WebhookSubscriptionsUpdateWebhookSubscriptionResponse, error) {
+	rsp, err := c.WebhookSubscriptionsUpdateWebhookSubscriptionWithBody(ctx, subscriptionId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseWebhookSubscriptionsUpdateWebhookSubscriptionResponse(rsp)
+}
+
+func (c *ClientWithResponses) WebhookSubscriptionsUpdateWebhookSubscriptionWithResponse(ctx context.Context, subscriptionId string, body WebhookSubscriptionsUpdateWebhookSubscriptionJSONRequestBody, reqEditors ...RequestEditorFn) (*WebhookSubscriptionsUpdateWebhookSubscriptionResponse, error) {
+	rsp, err := c.WebhookSubscriptionsUpdateWebhookSubscription(ctx, subscriptionId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseWebhookSubscriptionsUpdateWebhookSubscriptionResponse(rsp)
+}
+
+// WebhookSubscriptionsListWebhookDeliveriesWithResponse request returning *WebhookSubscriptionsListWebhookDeliveriesResponse
+func (c *ClientWithResponses) WebhookSubscriptionsListWebhookDeliveriesWithResponse(ctx context.Context, subscriptionId string, params *WebhookSubscriptionsListWebhookDeliveriesParams, reqEditors ...RequestEditorFn) (*WebhookSubscriptionsListWebhookDeliveriesResponse, error) {
+	rsp, err := c.WebhookSubscriptionsListWebhookDeliveries(ctx, subscriptionId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseWebhookSubscriptionsListWebhookDeliveriesResponse(rsp)
+}
+
+// ParseAuthConfirmEmailVerificationResponse parses an HTTP response from a AuthConfirmEmailVerificationWithResponse call
+func ParseAuthConfirmEmailVerificationResponse(rsp *http.Response) (*AuthConfirmEmailVerificationResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AuthConfirmEmailVerificationResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest User
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAuthLoginResponse parses an HTTP response from a AuthLoginWithResponse call
+func ParseAuthLoginResponse(rsp *http.Response) (*AuthLoginResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AuthLoginResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest LoginResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
+		var dest MFAChallenge
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON202 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAuthVerifyMFAResponse parses an HTTP response from a AuthVerifyMFAWithResponse call
+func ParseAuthVerifyMFAResponse(rsp *http.Response) (*AuthVerifyMFAResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AuthVerifyMFAResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest LoginResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseOIDCLoginAuthorizeResponse parses an HTTP response from a OIDCLoginAuthorizeWithResponse call
+func ParseOIDCLoginAuthorizeResponse(rsp *http.Response) (*OIDCLoginAuthorizeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &OIDCLoginAuthorizeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest OIDCAuthorization
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseOIDCLoginCallbackResponse parses an HTTP response from a OIDCLoginCallbackWithResponse call
+func ParseOIDCLoginCallbackResponse(rsp *http.Response) (*OIDCLoginCallbackResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &OIDCLoginCallbackResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest LoginResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
+		var dest MFAChallenge
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON202 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAuthRequestPasswordResetResponse parses an HTTP response from a AuthRequestPasswordResetWithResponse call
+func ParseAuthRequestPasswordResetResponse(rsp *http.Response) (*AuthRequestPasswordResetResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AuthRequestPasswordResetResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAuthConfirmPasswordResetResponse parses an HTTP response from a AuthConfirmPasswordResetWithResponse call
+func ParseAuthConfirmPasswordResetResponse(rsp *http.Response) (*AuthConfirmPasswordResetResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AuthConfirmPasswordResetResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGroupsCreateGroupResponse parses an HTTP response from a GroupsCreateGroupWithResponse call
+func ParseGroupsCreateGroupResponse(rsp *http.Response) (*GroupsCreateGroupResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GroupsCreateGroupResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Group
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGroupsGetGroupResponse parses an HTTP response from a GroupsGetGroupWithResponse call
+func ParseGroupsGetGroupResponse(rsp *http.Response) (*GroupsGetGroupResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GroupsGetGroupResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Group
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGroupsListGroupMembersResponse parses an HTTP response from a GroupsListGroupMembersWithResponse call
+func ParseGroupsListGroupMembersResponse(rsp *http.Response) (*GroupsListGroupMembersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GroupsListGroupMembersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GroupMemberList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGroupsAddGroupMembersResponse parses an HTTP response from a GroupsAddGroupMembersWithResponse call
+func ParseGroupsAddGroupMembersResponse(rsp *http.Response) (*GroupsAddGroupMembersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GroupsAddGroupMembersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGroupsRemoveGroupMembersResponse parses an HTTP response from a GroupsRemoveGroupMembersWithResponse call
+func ParseGroupsRemoveGroupMembersResponse(rsp *http.Response) (*GroupsRemoveGroupMembersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GroupsRemoveGroupMembersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseInvitationsListInvitationsResponse parses an HTTP response from a InvitationsListInvitationsWithResponse call
+func ParseInvitationsListInvitationsResponse(rsp *http.Response) (*InvitationsListInvitationsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &InvitationsListInvitationsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest InvitationList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseInvitationsCreateInvitationResponse parses an HTTP response from a InvitationsCreateInvitationWithResponse call
+func ParseInvitationsCreateInvitationResponse(rsp *http.Response) (*InvitationsCreateInvitationResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &InvitationsCreateInvitationResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Invitation
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseInvitationsResendInvitationResponse parses an HTTP response from a InvitationsResendInvitationWithResponse call
+func ParseInvitationsResendInvitationResponse(rsp *http.Response) (*InvitationsResendInvitationResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &InvitationsResendInvitationResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Invitation
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseInvitationsRevokeInvitationResponse parses an HTTP response from a InvitationsRevokeInvitationWithResponse call
+func ParseInvitationsRevokeInvitationResponse(rsp *http.Response) (*InvitationsRevokeInvitationResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &InvitationsRevokeInvitationResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseInvitationsAcceptInvitationResponse parses an HTTP response from a InvitationsAcceptInvitationWithResponse call
+func ParseInvitationsAcceptInvitationResponse(rsp *http.Response) (*InvitationsAcceptInvitationResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &InvitationsAcceptInvitationResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest User
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseOrganizationsListOrganizationsResponse parses an HTTP response from a OrganizationsListOrganizationsWithResponse call
+func ParseOrganizationsListOrganizationsResponse(rsp *http.Response) (*OrganizationsListOrganizationsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &OrganizationsListOrganizationsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest OrganizationList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseOrganizationsCreateOrganizationResponse parses an HTTP response from a OrganizationsCreateOrganizationWithResponse call
+func ParseOrganizationsCreateOrganizationResponse(rsp *http.Response) (*OrganizationsCreateOrganizationResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &OrganizationsCreateOrganizationResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Organization
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseOrganizationsGetOrganizationResponse parses an HTTP response from a OrganizationsGetOrganizationWithResponse call
+func ParseOrganizationsGetOrganizationResponse(rsp *http.Response) (*OrganizationsGetOrganizationResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &OrganizationsGetOrganizationResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Organization
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseOrgUsersListOrgUsersResponse parses an HTTP response from a OrgUsersListOrgUsersWithResponse call
+func ParseOrgUsersListOrgUsersResponse(rsp *http.Response) (*OrgUsersListOrgUsersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &OrgUsersListOrgUsersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest UserList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseOrgUsersCreateOrgUserResponse parses an HTTP response from a OrgUsersCreateOrgUserWithResponse call
+func ParseOrgUsersCreateOrgUserResponse(rsp *http.Response) (*OrgUsersCreateOrgUserResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &OrgUsersCreateOrgUserResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseOrgUsersDeleteOrgUserResponse parses an HTTP response from a OrgUsersDeleteOrgUserWithResponse call
+func ParseOrgUsersDeleteOrgUserResponse(rsp *http.Response) (*OrgUsersDeleteOrgUserResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &OrgUsersDeleteOrgUserResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseOrgUsersGetOrgUserResponse parses an HTTP response from a OrgUsersGetOrgUserWithResponse call
+func ParseOrgUsersGetOrgUserResponse(rsp *http.Response) (*OrgUsersGetOrgUserResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &OrgUsersGetOrgUserResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest User
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseOrgUsersPatchOrgUserResponse parses an HTTP response from a OrgUsersPatchOrgUserWithResponse call
+func ParseOrgUsersPatchOrgUserResponse(rsp *http.Response) (*OrgUsersPatchOrgUserResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &OrgUsersPatchOrgUserResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest User
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseOrgUsersUpdateOrgUserResponse parses an HTTP response from a OrgUsersUpdateOrgUserWithResponse call
+func ParseOrgUsersUpdateOrgUserResponse(rsp *http.Response) (*OrgUsersUpdateOrgUserResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &OrgUsersUpdateOrgUserResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUsersListUsersResponse parses an HTTP response from a UsersListUsersWithResponse call
+func ParseUsersListUsersResponse(rsp *http.Response) (*UsersListUsersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UsersListUsersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest UserList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUsersCreateUserResponse parses an HTTP response from a UsersCreateUserWithResponse call
+func ParseUsersCreateUserResponse(rsp *http.Response) (*UsersCreateUserResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UsersCreateUserResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUsersDeleteUserResponse parses an HTTP response from a UsersDeleteUserWithResponse call
+func ParseUsersDeleteUserResponse(rsp *http.Response) (*UsersDeleteUserResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UsersDeleteUserResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUsersGetUserResponse parses an HTTP response from a UsersGetUserWithResponse call
+func ParseUsersGetUserResponse(rsp *http.Response) (*UsersGetUserResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UsersGetUserResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest User
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUsersPatchUserResponse parses an HTTP response from a UsersPatchUserWithResponse call
+func ParseUsersPatchUserResponse(rsp *http.Response) (*UsersPatchUserResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UsersPatchUserResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest User
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUsersUpdateUserResponse parses an HTTP response from a UsersUpdateUserWithResponse call
+func ParseUsersUpdateUserResponse(rsp *http.Response) (*UsersUpdateUserResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UsersUpdateUserResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUserEmailVerificationRequestEmailVerificationResponse parses an HTTP response from a UserEmailVerificationRequestEmailVerificationWithResponse call
+func ParseUserEmailVerificationRequestEmailVerificationResponse(rsp *http.Response) (*UserEmailVerificationRequestEmailVerificationResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UserEmailVerificationRequestEmailVerificationResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUserGroupsListUserGroupsResponse parses an HTTP response from a UserGroupsListUserGroupsWithResponse call
+func ParseUserGroupsListUserGroupsResponse(rsp *http.Response) (*UserGroupsListUserGroupsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UserGroupsListUserGroupsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest UserGroupList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUserMFAEnrollTOTPResponse parses an HTTP response from a UserMFAEnrollTOTPWithResponse call
+func ParseUserMFAEnrollTOTPResponse(rsp *http.Response) (*UserMFAEnrollTOTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UserMFAEnrollTOTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TOTPEnrollment
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUserMFADisableTOTPResponse parses an HTTP response from a UserMFADisableTOTPWithResponse call
+func ParseUserMFADisableTOTPResponse(rsp *http.Response) (*UserMFADisableTOTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UserMFADisableTOTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUserMFAVerifyTOTPResponse parses an HTTP response from a UserMFAVerifyTOTPWithResponse call
+func ParseUserMFAVerifyTOTPResponse(rsp *http.Response) (*UserMFAVerifyTOTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UserMFAVerifyTOTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RecoveryCodeList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUserPasswordsChangePasswordResponse parses an HTTP response from a UserPasswordsChangePasswordWithResponse call
+func ParseUserPasswordsChangePasswordResponse(rsp *http.Response) (*UserPasswordsChangePasswordResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UserPasswordsChangePasswordResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUserSessionsListSessionsResponse parses an HTTP response from a UserSessionsListSessionsWithResponse call
+func ParseUserSessionsListSessionsResponse(rsp *http.Response) (*UserSessionsListSessionsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UserSessionsListSessionsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SessionList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUserSessionsRevokeSessionResponse parses an HTTP response from a UserSessionsRevokeSessionWithResponse call
+func ParseUserSessionsRevokeSessionResponse(rsp *http.Response) (*UserSessionsRevokeSessionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UserSessionsRevokeSessionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUserSessionsRevokeAllSessionsResponse parses an HTTP response from a UserSessionsRevokeAllSessionsWithResponse call
+func ParseUserSessionsRevokeAllSessionsResponse(rsp *http.Response) (*UserSessionsRevokeAllSessionsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UserSessionsRevokeAllSessionsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUsersDeactivateUserResponse parses an HTTP response from a UsersDeactivateUserWithResponse call
+func ParseUsersDeactivateUserResponse(rsp *http.Response) (*UsersDeactivateUserResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UsersDeactivateUserResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest User
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUsersReactivateUserResponse parses an HTTP response from a UsersReactivateUserWithResponse call
+func ParseUsersReactivateUserResponse(rsp *http.Response) (*UsersReactivateUserResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UsersReactivateUserResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest User
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUsersSuspendUserResponse parses an HTTP response from a UsersSuspendUserWithResponse call
+func ParseUsersSuspendUserResponse(rsp *http.Response) (*UsersSuspendUserResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UsersSuspendUserResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest User
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUsersBatchUsersResponse parses an HTTP response from a UsersBatchUsersWithResponse call
+func ParseUsersBatchUsersResponse(rsp *http.Response) (*UsersBatchUsersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UsersBatchUsersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest BatchUsersResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUsersWatchUsersResponse parses an HTTP response from a UsersWatchUsersWithResponse call
+func ParseUsersWatchUsersResponse(rsp *http.Response) (*UsersWatchUsersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UsersWatchUsersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseWebhookSubscriptionsListWebhookSubscriptionsResponse parses an HTTP response from a WebhookSubscriptionsListWebhookSubscriptionsWithResponse call
+func ParseWebhookSubscriptionsListWebhookSubscriptionsResponse(rsp *http.Response) (*WebhookSubscriptionsListWebhookSubscriptionsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &WebhookSubscriptionsListWebhookSubscriptionsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest WebhookSubscriptionList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseWebhookSubscriptionsCreateWebhookSubscriptionResponse parses an HTTP response from a WebhookSubscriptionsCreateWebhookSubscriptionWithResponse call
+func ParseWebhookSubscriptionsCreateWebhookSubscriptionResponse(rsp *http.Response) (*WebhookSubscriptionsCreateWebhookSubscriptionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &WebhookSubscriptionsCreateWebhookSubscriptionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest WebhookSubscription
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseWebhookSubscriptionsDeleteWebhookSubscriptionResponse parses an HTTP response from a WebhookSubscriptionsDeleteWebhookSubscriptionWithResponse call
+func ParseWebhookSubscriptionsDeleteWebhookSubscriptionResponse(rsp *http.Response) (*WebhookSubscriptionsDeleteWebhookSubscriptionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &WebhookSubscriptionsDeleteWebhookSubscriptionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseWebhookSubscriptionsGetWebhookSubscriptionResponse parses an HTTP response from a WebhookSubscriptionsGetWebhookSubscriptionWithResponse call
+func ParseWebhookSubscriptionsGetWebhookSubscriptionResponse(rsp *http.Response) (*WebhookSubscriptionsGetWebhookSubscriptionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &WebhookSubscriptionsGetWebhookSubscriptionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest WebhookSubscription
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseWebhookSubscriptionsUpdateWebhookSubscriptionResponse parses an HTTP response from a WebhookSubscriptionsUpdateWebhookSubscriptionWithResponse call
+func ParseWebhookSubscriptionsUpdateWebhookSubscriptionResponse(rsp *http.Response) (*WebhookSubscriptionsUpdateWebhookSubscriptionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &WebhookSubscriptionsUpdateWebhookSubscriptionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest WebhookSubscription
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseWebhookSubscriptionsListWebhookDeliveriesResponse parses an HTTP response from a WebhookSubscriptionsListWebhookDeliveriesWithResponse call
+func ParseWebhookSubscriptionsListWebhookDeliveriesResponse(rsp *http.Response) (*WebhookSubscriptionsListWebhookDeliveriesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &WebhookSubscriptionsListWebhookDeliveriesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest WebhookDeliveryList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
@@ -4,6 +4,7 @@
 package openapi
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"time"
@@ -13,6 +14,185 @@ import (
 	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
+// Defines values for BatchOperationMethod.
+const (
+	Create BatchOperationMethod = "create"
+	Delete BatchOperationMethod = "delete"
+	Update BatchOperationMethod = "update"
+)
+
+// Defines values for GroupRole.
+const (
+	Member GroupRole = "member"
+	Owner  GroupRole = "owner"
+)
+
+// Defines values for InvitationStatus.
+const (
+	InvitationStatusAccepted InvitationStatus = "accepted"
+	InvitationStatusExpired  InvitationStatus = "expired"
+	InvitationStatusPending  InvitationStatus = "pending"
+	InvitationStatusRevoked  InvitationStatus = "revoked"
+)
+
+// Defines values for JsonPatchOp.
+const (
+	Add     JsonPatchOp = "add"
+	Copy    JsonPatchOp = "copy"
+	Move    JsonPatchOp = "move"
+	Remove  JsonPatchOp = "remove"
+	Replace JsonPatchOp = "replace"
+	Test    JsonPatchOp = "test"
+)
+
+// Defines values for UserStatus.
+const (
+	Active      UserStatus = "active"
+	Deactivated UserStatus = "deactivated"
+	Invited     UserStatus = "invited"
+	Suspended   UserStatus = "suspended"
+)
+
+// Defines values for WebhookDeliveryStatus.
+const (
+	WebhookDeliveryStatusDead      WebhookDeliveryStatus = "dead"
+	WebhookDeliveryStatusPending   WebhookDeliveryStatus = "pending"
+	WebhookDeliveryStatusSucceeded WebhookDeliveryStatus = "succeeded"
+)
+
+// Defines values for WebhookEventType.
+const (
+	UserCreated WebhookEventType = "UserCreated"
+	UserDeleted WebhookEventType = "UserDeleted"
+	UserUpdated WebhookEventType = "UserUpdated"
+)
+
+// AcceptInvitationRequest Request to accept an invitation with the token sent by email
+type AcceptInvitationRequest struct {
+	// Name Name of the user (keeps the provisional name when omitted)
+	Name *string `json:"name,omitempty"`
+
+	// Password Password (12 to 128 characters, must differ from the email address)
+	Password string `json:"password"`
+
+	// Token Token from the invitation email
+	Token string `json:"token"`
+}
+
+// AddGroupMember Member to add to a group
+type AddGroupMember struct {
+	// Role Role in the group
+	Role *GroupRole `json:"role,omitempty"`
+
+	// UserId User ID (ULID format)
+	UserId string `json:"userId"`
+}
+
+// AddGroupMembersRequest Add group members request
+type AddGroupMembersRequest struct {
+	// Members Users to add; the role of existing members is changed to the given role
+	Members []AddGroupMember `json:"members"`
+}
+
+// BatchOperationMethod Operation type of a users:batch request
+type BatchOperationMethod string
+
+// BatchUserOperation Operation in a users:batch request
+type BatchUserOperation struct {
+	// Email User email address; required for create, changed only when given for update
+	Email *openapi_types.Email `json:"email,omitempty"`
+
+	// Id Target user ID (ULID format); required for update and delete
+	Id *string `json:"id,omitempty"`
+
+	// Method Operation type
+	Method BatchOperationMethod `json:"method"`
+
+	// Name User name; required for create, changed only when given for update
+	Name *string `json:"name,omitempty"`
+}
+
+// BatchUserResult Result of an operation in a users:batch request
+type BatchUserResult struct {
+	// Code Error code when the operation failed (VALIDATION_ERROR, NOT_FOUND, CONFLICT, ABORTED or INTERNAL_ERROR)
+	Code *string `json:"code,omitempty"`
+
+	// Message Error message when the operation failed
+	Message *string `json:"message,omitempty"`
+
+	// Status HTTP status of the operation (201 created, 200 updated, 204 deleted, 424 rolled back by another failure in atomic mode)
+	Status int32 `json:"status"`
+
+	// User User after create or update
+	User *User `json:"user,omitempty"`
+}
+
+// BatchUsersRequest Batch users request
+type BatchUsersRequest struct {
+	// Atomic Run all operations in one transaction and roll all of them back if any fails
+	Atomic *bool `json:"atomic,omitempty"`
+
+	// Operations Operations, applied in order
+	Operations []BatchUserOperation `json:"operations"`
+}
+
+// BatchUsersResponse Batch users response
+type BatchUsersResponse struct {
+	// Results Results in the same order as the operations
+	Results []BatchUserResult `json:"results"`
+}
+
+// ChangePasswordRequest Request to set or change the password of a user
+type ChangePasswordRequest struct {
+	// CurrentPassword Current password (required when a password has already been set)
+	CurrentPassword *string `json:"currentPassword,omitempty"`
+
+	// NewPassword New password (12 to 128 characters, must differ from the email address)
+	NewPassword string `json:"newPassword"`
+}
+
+// ChangeUserStatusRequest Request to change the account status of a user
+type ChangeUserStatusRequest struct {
+	// Reason Reason for the change (recorded in the user log)
+	Reason string `json:"reason"`
+}
+
+// ConfirmEmailVerificationRequest Request to confirm an email address with the token sent by email
+type ConfirmEmailVerificationRequest struct {
+	// Token Token from the verification email
+	Token string `json:"token"`
+}
+
+// ConfirmPasswordResetRequest Request to reset the password with the token sent by email
+type ConfirmPasswordResetRequest struct {
+	// NewPassword New password (12 to 128 characters, must differ from the email address)
+	NewPassword string `json:"newPassword"`
+
+	// Token Token from the password reset email
+	Token string `json:"token"`
+}
+
+// CreateGroupRequest Create group request
+type CreateGroupRequest struct {
+	// Name Group name (unique in the organization)
+	Name string `json:"name"`
+}
+
+// CreateInvitationRequest Request to invite a user by email
+type CreateInvitationRequest struct {
+	// Email Email address to invite
+	Email openapi_types.Email `json:"email"`
+
+	// Name Provisional name of the user (defaults to the local part of the email address)
+	Name *string `json:"name,omitempty"`
+}
+
+// CreateOrganizationRequest Create organization request
+type CreateOrganizationRequest struct {
+	// Name Organization name
+	Name string `json:"name"`
+}
+
 // CreateUserRequest Create user request
 type CreateUserRequest struct {
 	// Email User email address
@@ -22,6 +202,27 @@ type CreateUserRequest struct {
 	Name string `json:"name"`
 }
 
+// CreateWebhookSubscriptionRequest Create webhook subscription request
+type CreateWebhookSubscriptionRequest struct {
+	// Active Whether new events are delivered
+	Active *bool `json:"active,omitempty"`
+
+	// Events Event types to deliver
+	Events []WebhookEventType `json:"events"`
+
+	// Secret Shared secret used to sign payloads with HMAC-SHA256
+	Secret string `json:"secret"`
+
+	// Url Callback URL (http or https; loopback, link-local and private addresses are rejected)
+	Url string `json:"url"`
+}
+
+// EnrollTOTPRequest Request to start enrolling TOTP two-factor authentication
+type EnrollTOTPRequest struct {
+	// Password Current password
+	Password string `json:"password"`
+}
+
 // Error Error response
 type Error struct {
 	// Code Error code
@@ -31,13 +232,280 @@ type Error struct {
 	Message string `json:"message"`
 }
 
-// UpdateUserRequest Update user request
+// Group Group (team) of users in an organization
+type Group struct {
+	// CreatedAt Creation timestamp
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Id Group ID (ULID format)
+	Id string `json:"id"`
+
+	// Name Group name (unique in the organization)
+	Name string `json:"name"`
+
+	// UpdatedAt Last update timestamp
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// GroupMember Member of a group
+type GroupMember struct {
+	// JoinedAt Timestamp when the user joined the group
+	JoinedAt time.Time `json:"joinedAt"`
+
+	// Role Role in the group
+	Role GroupRole `json:"role"`
+
+	// UserId User ID (ULID format)
+	UserId string `json:"userId"`
+}
+
+// GroupMemberList Group member list response
+type GroupMemberList struct {
+	// Members List of members (in join order)
+	Members []GroupMember `json:"members"`
+
+	// Total Total number of members
+	Total int32 `json:"total"`
+}
+
+// GroupRole Role of a user in a group
+type GroupRole string
+
+// Invitation Invitation of a user by email
+type Invitation struct {
+	// AcceptedAt Timestamp when the invitation was accepted
+	AcceptedAt *time.Time `json:"acceptedAt,omitempty"`
+
+	// CreatedAt Creation timestamp
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Email Email address the invitation was sent to
+	Email openapi_types.Email `json:"email"`
+
+	// ExpiresAt Expiration time of the invitation link
+	ExpiresAt time.Time `json:"expiresAt"`
+
+	// Id Invitation ID (ULID format)
+	Id string `json:"id"`
+
+	// InvitedBy ID of the user who sent the invitation (absent when invited without authentication)
+	InvitedBy *string `json:"invitedBy,omitempty"`
+
+	// Status Status of the invitation
+	Status InvitationStatus `json:"status"`
+
+	// UserId ID of the invited user
+	UserId string `json:"userId"`
+}
+
+// InvitationList Pending invitation list response
+type InvitationList struct {
+	// Invitations Pending invitations (newest first)
+	Invitations []Invitation `json:"invitations"`
+
+	// Total Total number of pending invitations
+	Total int32 `json:"total"`
+}
+
+// InvitationStatus Invitation status
+type InvitationStatus string
+
+// JsonPatch Partial user update (RFC 6902 JSON Patch). Operations are applied in order and all or nothing.
+type JsonPatch = []JsonPatchOperation
+
+// JsonPatchOp JSON Patch operation type
+type JsonPatchOp string
+
+// JsonPatchOperation JSON Patch operation (RFC 6902)
+type JsonPatchOperation struct {
+	// From JSON Pointer to the source field for move and copy
+	From *string `json:"from,omitempty"`
+
+	// Op Operation type
+	Op JsonPatchOp `json:"op"`
+
+	// Path JSON Pointer to the target field (e.g. /name)
+	Path string `json:"path"`
+
+	// Value Value for add, replace and test
+	Value interface{} `json:"value,omitempty"`
+}
+
+// LoginRequest Login request
+type LoginRequest struct {
+	// Device Name of the device, shown in the session list
+	Device *string `json:"device,omitempty"`
+
+	// Email User email address
+	Email openapi_types.Email `json:"email"`
+
+	// Password Password
+	Password string `json:"password"`
+}
+
+// LoginResponse Login response with a session token
+type LoginResponse struct {
+	// ExpiresAt Expiration time of the token
+	ExpiresAt time.Time `json:"expiresAt"`
+
+	// SessionId ID of the session created by the login (ULID format)
+	SessionId string `json:"sessionId"`
+
+	// Token Session token (send as `Authorization: Bearer <token>`)
+	Token string `json:"token"`
+
+	// TokenType Token type (always `Bearer`)
+	TokenType string `json:"tokenType"`
+
+	// User The logged-in user
+	User User `json:"user"`
+}
+
+// MFAChallenge Second login step required when two-factor authentication is enabled
+type MFAChallenge struct {
+	// ExpiresAt Expiration time of the token
+	ExpiresAt time.Time `json:"expiresAt"`
+
+	// MfaToken Token to send with the authenticator code to `/auth/mfa:verify`
+	MfaToken string `json:"mfaToken"`
+}
+
+// OIDCAuthorization Authorization request to the identity provider
+type OIDCAuthorization struct {
+	// AuthorizationUrl URL of the identity provider to open in the browser
+	AuthorizationUrl string `json:"authorizationUrl"`
+
+	// ExpiresAt Expiration time of the login; the callback must be completed before this time
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+// OIDCCallbackRequest Authorization response from the identity provider, passed to the redirect page
+type OIDCCallbackRequest struct {
+	// Code Authorization code (`code` query parameter of the redirect)
+	Code string `json:"code"`
+
+	// Device Name of the device, shown in the session list
+	Device *string `json:"device,omitempty"`
+
+	// State State (`state` query parameter of the redirect)
+	State string `json:"state"`
+}
+
+// Organization Organization (tenant) model
+type Organization struct {
+	// CreatedAt Creation timestamp
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Id Organization ID (ULID format)
+	Id string `json:"id"`
+
+	// Name Organization name
+	Name string `json:"name"`
+
+	// UpdatedAt Last update timestamp
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// OrganizationList Organization list response
+type OrganizationList struct {
+	// Organizations List of organizations
+	Organizations []Organization `json:"organizations"`
+
+	// Total Total number of organizations
+	Total int32 `json:"total"`
+}
+
+// PasswordResetRequest Request to send a password reset email
+type PasswordResetRequest struct {
+	// Email Email address of the account
+	Email openapi_types.Email `json:"email"`
+}
+
+// RecoveryCodeList One-time recovery codes issued when two-factor authentication is enabled
+type RecoveryCodeList struct {
+	// RecoveryCodes Recovery codes (each can be used once in place of an authenticator code; shown only once)
+	RecoveryCodes []string `json:"recoveryCodes"`
+}
+
+// RemoveGroupMembersRequest Remove group members request
+type RemoveGroupMembersRequest struct {
+	// UserIds IDs of the users to remove; users that are not members are ignored
+	UserIds []string `json:"userIds"`
+}
+
+// Session Server-side session created by a login
+type Session struct {
+	// CreatedAt Login timestamp
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Current Whether the request was authenticated with this session
+	Current bool `json:"current"`
+
+	// Device Name of the device given at login
+	Device *string `json:"device,omitempty"`
+
+	// ExpiresAt Time the session expires regardless of activity
+	ExpiresAt time.Time `json:"expiresAt"`
+
+	// Id Session ID (ULID format)
+	Id string `json:"id"`
+
+	// IpAddress IP address the user logged in from
+	IpAddress *string `json:"ipAddress,omitempty"`
+
+	// LastSeenAt Last time the session was used (updated at most once a minute)
+	LastSeenAt time.Time `json:"lastSeenAt"`
+
+	// UserAgent User agent the user logged in with
+	UserAgent *string `json:"userAgent,omitempty"`
+}
+
+// SessionList Active session list response
+type SessionList struct {
+	// Sessions Sessions that have not been revoked or expired (most recently used first)
+	Sessions []Session `json:"sessions"`
+}
+
+// TOTPCodeRequest Request with a two-factor authentication code
+type TOTPCodeRequest struct {
+	// Code Code from the authenticator app (or an unused recovery code when disabling)
+	Code string `json:"code"`
+}
+
+// TOTPEnrollment TOTP secret to register in an authenticator app
+type TOTPEnrollment struct {
+	// OtpauthUri `otpauth://` URI of the secret
+	OtpauthUri string `json:"otpauthUri"`
+
+	// QrCodePng QR code of the URI (PNG image)
+	QrCodePng []byte `json:"qrCodePng"`
+
+	// Secret Secret key (base32) for entering manually in the authenticator app
+	Secret string `json:"secret"`
+}
+
+// UpdateUserRequest Update user request (replaces all fields of the user)
 type UpdateUserRequest struct {
 	// Email User email address
-	Email *openapi_types.Email `json:"email,omitempty"`
+	Email openapi_types.Email `json:"email"`
 
 	// Name User name
-	Name *string `json:"name,omitempty"`
+	Name string `json:"name"`
+}
+
+// UpdateWebhookSubscriptionRequest Update webhook subscription request
+type UpdateWebhookSubscriptionRequest struct {
+	// Active Whether new events are delivered
+	Active *bool `json:"active,omitempty"`
+
+	// Events Event types to deliver
+	Events *[]WebhookEventType `json:"events,omitempty"`
+
+	// Secret Shared secret used to sign payloads with HMAC-SHA256
+	Secret *string `json:"secret,omitempty"`
+
+	// Url Callback URL (http or https; loopback, link-local and private addresses are rejected)
+	Url *string `json:"url,omitempty"`
 }
 
 // User User model
@@ -48,104 +516,2113 @@ type User struct {
 	// Email User email address
 	Email openapi_types.Email `json:"email"`
 
+	// EmailVerifiedAt Email verification timestamp (absent until the email address is verified)
+	EmailVerifiedAt *time.Time `json:"emailVerifiedAt,omitempty"`
+
 	// Id User ID (ULID format)
 	Id string `json:"id"`
 
 	// Name User name
 	Name string `json:"name"`
 
+	// Status Account status
+	Status UserStatus `json:"status"`
+
 	// UpdatedAt Last update timestamp
 	UpdatedAt time.Time `json:"updatedAt"`
 }
 
+// UserGroup Group of a user with the user's role
+type UserGroup struct {
+	// Group Group
+	Group Group `json:"group"`
+
+	// JoinedAt Timestamp when the user joined the group
+	JoinedAt time.Time `json:"joinedAt"`
+
+	// Role Role of the user in the group
+	Role GroupRole `json:"role"`
+}
+
+// UserGroupList User group list response
+type UserGroupList struct {
+	// Groups Groups of the user (in name order)
+	Groups []UserGroup `json:"groups"`
+}
+
 // UserList User list response
 type UserList struct {
 	// Total Total number of users
 	Total int32 `json:"total"`
 
-	// Users List of users
-	Users []User `json:"users"`
+	// Users List of users
+	Users []User `json:"users"`
+}
+
+// UserMergePatch Partial user update (RFC 7396 JSON Merge Patch).
+// Omitted fields are kept; null removes the field, which fails validation for required fields.
+type UserMergePatch struct {
+	// Email User email address
+	Email *openapi_types.Email `json:"email"`
+
+	// Name User name
+	Name *string `json:"name"`
+}
+
+// UserStatus Account status of a user
+type UserStatus string
+
+// VerifyMFALoginRequest Request to complete a login with a two-factor authentication code
+type VerifyMFALoginRequest struct {
+	// Code Code from the authenticator app, or an unused recovery code
+	Code string `json:"code"`
+
+	// Device Name of the device, shown in the session list
+	Device *string `json:"device,omitempty"`
+
+	// MfaToken Token returned by the login
+	MfaToken string `json:"mfaToken"`
+}
+
+// WebhookDelivery Webhook delivery attempt log
+type WebhookDelivery struct {
+	// Attempts Number of attempts made so far
+	Attempts int32 `json:"attempts"`
+
+	// CreatedAt Creation timestamp
+	CreatedAt time.Time `json:"createdAt"`
+
+	// DeliveredAt Successful delivery timestamp
+	DeliveredAt *time.Time `json:"deliveredAt,omitempty"`
+
+	// EventId Domain event ID (ULID format)
+	EventId string `json:"eventId"`
+
+	// EventType Domain event type
+	EventType WebhookEventType `json:"eventType"`
+
+	// Id Delivery ID (ULID format)
+	Id string `json:"id"`
+
+	// LastError Error of the last failed attempt
+	LastError *string `json:"lastError,omitempty"`
+
+	// LastStatusCode HTTP status code of the last attempt
+	LastStatusCode *int32 `json:"lastStatusCode,omitempty"`
+
+	// NextAttemptAt Next attempt timestamp (pending deliveries only)
+	NextAttemptAt *time.Time `json:"nextAttemptAt,omitempty"`
+
+	// Status Delivery status
+	Status WebhookDeliveryStatus `json:"status"`
+
+	// SubscriptionId Subscription ID (ULID format)
+	SubscriptionId string `json:"subscriptionId"`
+
+	// UpdatedAt Last update timestamp
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// WebhookDeliveryList Webhook delivery list response
+type WebhookDeliveryList struct {
+	// Deliveries List of deliveries
+	Deliveries []WebhookDelivery `json:"deliveries"`
+
+	// Total Total number of deliveries
+	Total int32 `json:"total"`
+}
+
+// WebhookDeliveryStatus Webhook delivery status
+type WebhookDeliveryStatus string
+
+// WebhookEventType Domain event type delivered to webhooks
+type WebhookEventType string
+
+// WebhookSubscription Webhook subscription
+type WebhookSubscription struct {
+	// Active Whether new events are delivered
+	Active bool `json:"active"`
+
+	// CreatedAt Creation timestamp
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Events Event types delivered to this subscription
+	Events []WebhookEventType `json:"events"`
+
+	// Id Subscription ID (ULID format)
+	Id string `json:"id"`
+
+	// UpdatedAt Last update timestamp
+	UpdatedAt time.Time `json:"updatedAt"`
+
+	// Url Callback URL that receives event payloads
+	Url string `json:"url"`
+}
+
+// WebhookSubscriptionList Webhook subscription list response
+type WebhookSubscriptionList struct {
+	// Subscriptions List of webhook subscriptions
+	Subscriptions []WebhookSubscription `json:"subscriptions"`
+
+	// Total Total number of webhook subscriptions
+	Total int32 `json:"total"`
+}
+
+// GroupsListGroupMembersParams defines parameters for GroupsListGroupMembers.
+type GroupsListGroupMembersParams struct {
+	// Limit Maximum number of members to return
+	Limit *int32 `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Number of members to skip
+	Offset *int32 `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// InvitationsListInvitationsParams defines parameters for InvitationsListInvitations.
+type InvitationsListInvitationsParams struct {
+	// Limit Maximum number of invitations to return
+	Limit *int32 `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Number of invitations to skip
+	Offset *int32 `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// OrganizationsListOrganizationsParams defines parameters for OrganizationsListOrganizations.
+type OrganizationsListOrganizationsParams struct {
+	// Limit Maximum number of organizations to return
+	Limit *int32 `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Number of organizations to skip
+	Offset *int32 `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// OrgUsersListOrgUsersParams defines parameters for OrgUsersListOrgUsers.
+type OrgUsersListOrgUsersParams struct {
+	// Status Filter by account status
+	Status *UserStatus `form:"status,omitempty" json:"status,omitempty"`
+
+	// Limit Maximum number of users to return
+	Limit *int32 `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Number of users to skip
+	Offset *int32 `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// IfNoneMatch Entity tags of cached representations; 304 is returned if one of them matches
+	IfNoneMatch *string `json:"If-None-Match,omitempty"`
+}
+
+// OrgUsersGetOrgUserParams defines parameters for OrgUsersGetOrgUser.
+type OrgUsersGetOrgUserParams struct {
+	// IfNoneMatch Entity tags of cached representations; 304 is returned if one of them matches
+	IfNoneMatch *string `json:"If-None-Match,omitempty"`
+
+	// IfModifiedSince 304 is returned if the resource has not been modified since this time
+	// (ignored when If-None-Match is present)
+	IfModifiedSince *string `json:"If-Modified-Since,omitempty"`
+}
+
+// OrgUsersPatchOrgUserApplicationJSONPatchPlusJSONBody defines parameters for OrgUsersPatchOrgUser.
+type OrgUsersPatchOrgUserApplicationJSONPatchPlusJSONBody struct {
+	union json.RawMessage
+}
+
+// OrgUsersPatchOrgUserApplicationMergePatchPlusJSONBody defines parameters for OrgUsersPatchOrgUser.
+type OrgUsersPatchOrgUserApplicationMergePatchPlusJSONBody struct {
+	union json.RawMessage
+}
+
+// UsersListUsersParams defines parameters for UsersListUsers.
+type UsersListUsersParams struct {
+	// Status Filter by account status
+	Status *UserStatus `form:"status,omitempty" json:"status,omitempty"`
+
+	// Limit Maximum number of users to return
+	Limit *int32 `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Number of users to skip
+	Offset *int32 `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// IfNoneMatch Entity tags of cached representations; 304 is returned if one of them matches
+	IfNoneMatch *string `json:"If-None-Match,omitempty"`
+}
+
+// UsersGetUserParams defines parameters for UsersGetUser.
+type UsersGetUserParams struct {
+	// IfNoneMatch Entity tags of cached representations; 304 is returned if one of them matches
+	IfNoneMatch *string `json:"If-None-Match,omitempty"`
+
+	// IfModifiedSince 304 is returned if the resource has not been modified since this time
+	// (ignored when If-None-Match is present)
+	IfModifiedSince *string `json:"If-Modified-Since,omitempty"`
+}
+
+// UsersPatchUserApplicationJSONPatchPlusJSONBody defines parameters for UsersPatchUser.
+type UsersPatchUserApplicationJSONPatchPlusJSONBody struct {
+	union json.RawMessage
+}
+
+// UsersPatchUserApplicationMergePatchPlusJSONBody defines parameters for UsersPatchUser.
+type UsersPatchUserApplicationMergePatchPlusJSONBody struct {
+	union json.RawMessage
+}
+
+// UsersWatchUsersParams defines parameters for UsersWatchUsers.
+type UsersWatchUsersParams struct {
+	// LastEventID ID of the last received event; later events are replayed before live changes
+	LastEventID *string `json:"Last-Event-ID,omitempty"`
+}
+
+// WebhookSubscriptionsListWebhookSubscriptionsParams defines parameters for WebhookSubscriptionsListWebhookSubscriptions.
+type WebhookSubscriptionsListWebhookSubscriptionsParams struct {
+	// Limit Maximum number of subscriptions to return
+	Limit *int32 `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Number of subscriptions to skip
+	Offset *int32 `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// WebhookSubscriptionsListWebhookDeliveriesParams defines parameters for WebhookSubscriptionsListWebhookDeliveries.
+type WebhookSubscriptionsListWebhookDeliveriesParams struct {
+	// Status Filter by delivery status
+	Status *WebhookDeliveryStatus `form:"status,omitempty" json:"status,omitempty"`
+
+	// Limit Maximum number of deliveries to return
+	Limit *int32 `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Number of deliveries to skip
+	Offset *int32 `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// AuthConfirmEmailVerificationJSONRequestBody defines body for AuthConfirmEmailVerification for application/json ContentType.
+type AuthConfirmEmailVerificationJSONRequestBody = ConfirmEmailVerificationRequest
+
+// AuthLoginJSONRequestBody defines body for AuthLogin for application/json ContentType.
+type AuthLoginJSONRequestBody = LoginRequest
+
+// AuthVerifyMFAJSONRequestBody defines body for AuthVerifyMFA for application/json ContentType.
+type AuthVerifyMFAJSONRequestBody = VerifyMFALoginRequest
+
+// OIDCLoginCallbackJSONRequestBody defines body for OIDCLoginCallback for application/json ContentType.
+type OIDCLoginCallbackJSONRequestBody = OIDCCallbackRequest
+
+// AuthRequestPasswordResetJSONRequestBody defines body for AuthRequestPasswordReset for application/json ContentType.
+type AuthRequestPasswordResetJSONRequestBody = PasswordResetRequest
+
+// AuthConfirmPasswordResetJSONRequestBody defines body for AuthConfirmPasswordReset for application/json ContentType.
+type AuthConfirmPasswordResetJSONRequestBody = ConfirmPasswordResetRequest
+
+// GroupsCreateGroupJSONRequestBody defines body for GroupsCreateGroup for application/json ContentType.
+type GroupsCreateGroupJSONRequestBody = CreateGroupRequest
+
+// GroupsAddGroupMembersJSONRequestBody defines body for GroupsAddGroupMembers for application/json ContentType.
+type GroupsAddGroupMembersJSONRequestBody = AddGroupMembersRequest
+
+// GroupsRemoveGroupMembersJSONRequestBody defines body for GroupsRemoveGroupMembers for application/json ContentType.
+type GroupsRemoveGroupMembersJSONRequestBody = RemoveGroupMembersRequest
+
+// InvitationsCreateInvitationJSONRequestBody defines body for InvitationsCreateInvitation for application/json ContentType.
+type InvitationsCreateInvitationJSONRequestBody = CreateInvitationRequest
+
+// InvitationsAcceptInvitationJSONRequestBody defines body for InvitationsAcceptInvitation for application/json ContentType.
+type InvitationsAcceptInvitationJSONRequestBody = AcceptInvitationRequest
+
+// OrganizationsCreateOrganizationJSONRequestBody defines body for OrganizationsCreateOrganization for application/json ContentType.
+type OrganizationsCreateOrganizationJSONRequestBody = CreateOrganizationRequest
+
+// OrgUsersCreateOrgUserJSONRequestBody defines body for OrgUsersCreateOrgUser for application/json ContentType.
+type OrgUsersCreateOrgUserJSONRequestBody = CreateUserRequest
+
+// OrgUsersPatchOrgUserApplicationJSONPatchPlusJSONRequestBody defines body for OrgUsersPatchOrgUser for application/json-patch+json ContentType.
+type OrgUsersPatchOrgUserApplicationJSONPatchPlusJSONRequestBody OrgUsersPatchOrgUserApplicationJSONPatchPlusJSONBody
+
+// OrgUsersPatchOrgUserApplicationMergePatchPlusJSONRequestBody defines body for OrgUsersPatchOrgUser for application/merge-patch+json ContentType.
+type OrgUsersPatchOrgUserApplicationMergePatchPlusJSONRequestBody OrgUsersPatchOrgUserApplicationMergePatchPlusJSONBody
+
+// OrgUsersUpdateOrgUserJSONRequestBody defines body for OrgUsersUpdateOrgUser for application/json ContentType.
+type OrgUsersUpdateOrgUserJSONRequestBody = UpdateUserRequest
+
+// UsersCreateUserJSONRequestBody defines body for UsersCreateUser for application/json ContentType.
+type UsersCreateUserJSONRequestBody = CreateUserRequest
+
+// UsersPatchUserApplicationJSONPatchPlusJSONRequestBody defines body for UsersPatchUser for application/json-patch+json ContentType.
+type UsersPatchUserApplicationJSONPatchPlusJSONRequestBody UsersPatchUserApplicationJSONPatchPlusJSONBody
+
+// UsersPatchUserApplicationMergePatchPlusJSONRequestBody defines body for UsersPatchUser for application/merge-patch+json ContentType.
+type UsersPatchUserApplicationMergePatchPlusJSONRequestBody UsersPatchUserApplicationMergePatchPlusJSONBody
+
+// UsersUpdateUserJSONRequestBody defines body for UsersUpdateUser for application/json ContentType.
+type UsersUpdateUserJSONRequestBody = UpdateUserRequest
+
+// UserMFAEnrollTOTPJSONRequestBody defines body for UserMFAEnrollTOTP for application/json ContentType.
+type UserMFAEnrollTOTPJSONRequestBody = EnrollTOTPRequest
+
+// UserMFADisableTOTPJSONRequestBody defines body for UserMFADisableTOTP for application/json ContentType.
+type UserMFADisableTOTPJSONRequestBody = TOTPCodeRequest
+
+// UserMFAVerifyTOTPJSONRequestBody defines body for UserMFAVerifyTOTP for application/json ContentType.
+type UserMFAVerifyTOTPJSONRequestBody = TOTPCodeRequest
+
+// UserPasswordsChangePasswordJSONRequestBody defines body for UserPasswordsChangePassword for application/json ContentType.
+type UserPasswordsChangePasswordJSONRequestBody = ChangePasswordRequest
+
+// UsersDeactivateUserJSONRequestBody defines body for UsersDeactivateUser for application/json ContentType.
+type UsersDeactivateUserJSONRequestBody = ChangeUserStatusRequest
+
+// UsersReactivateUserJSONRequestBody defines body for UsersReactivateUser for application/json ContentType.
+type UsersReactivateUserJSONRequestBody = ChangeUserStatusRequest
+
+// UsersSuspendUserJSONRequestBody defines body for UsersSuspendUser for application/json ContentType.
+type UsersSuspendUserJSONRequestBody = ChangeUserStatusRequest
+
+// UsersBatchUsersJSONRequestBody defines body for UsersBatchUsers for application/json ContentType.
+type UsersBatchUsersJSONRequestBody = BatchUsersRequest
+
+// WebhookSubscriptionsCreateWebhookSubscriptionJSONRequestBody defines body for WebhookSubscriptionsCreateWebhookSubscription for application/json ContentType.
+type WebhookSubscriptionsCreateWebhookSubscriptionJSONRequestBody = CreateWebhookSubscriptionRequest
+
+// WebhookSubscriptionsUpdateWebhookSubscriptionJSONRequestBody defines body for WebhookSubscriptionsUpdateWebhookSubscription for application/json ContentType.
+type WebhookSubscriptionsUpdateWebhookSubscriptionJSONRequestBody = UpdateWebhookSubscriptionRequest
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+
+	// (POST /auth/email-verification:confirm)
+	AuthConfirmEmailVerification(w http.ResponseWriter, r *http.Request)
+
+	// (POST /auth/login)
+	AuthLogin(w http.ResponseWriter, r *http.Request)
+
+	// (POST /auth/mfa:verify)
+	AuthVerifyMFA(w http.ResponseWriter, r *http.Request)
+
+	// (POST /auth/oidc/{provider}:authorize)
+	OIDCLoginAuthorize(w http.ResponseWriter, r *http.Request, provider string)
+
+	// (POST /auth/oidc/{provider}:callback)
+	OIDCLoginCallback(w http.ResponseWriter, r *http.Request, provider string)
+
+	// (POST /auth/password-reset)
+	AuthRequestPasswordReset(w http.ResponseWriter, r *http.Request)
+
+	// (POST /auth/password-reset:confirm)
+	AuthConfirmPasswordReset(w http.ResponseWriter, r *http.Request)
+
+	// (POST /groups)
+	GroupsCreateGroup(w http.ResponseWriter, r *http.Request)
+
+	// (GET /groups/{groupId})
+	GroupsGetGroup(w http.ResponseWriter, r *http.Request, groupId string)
+
+	// (GET /groups/{groupId}/members)
+	GroupsListGroupMembers(w http.ResponseWriter, r *http.Request, groupId string, params GroupsListGroupMembersParams)
+
+	// (POST /groups/{groupId}/members:add)
+	GroupsAddGroupMembers(w http.ResponseWriter, r *http.Request, groupId string)
+
+	// (POST /groups/{groupId}/members:remove)
+	GroupsRemoveGroupMembers(w http.ResponseWriter, r *http.Request, groupId string)
+
+	// (GET /invitations)
+	InvitationsListInvitations(w http.ResponseWriter, r *http.Request, params InvitationsListInvitationsParams)
+
+	// (POST /invitations)
+	InvitationsCreateInvitation(w http.ResponseWriter, r *http.Request)
+
+	// (POST /invitations/{invitationId}:resend)
+	InvitationsResendInvitation(w http.ResponseWriter, r *http.Request, invitationId string)
+
+	// (POST /invitations/{invitationId}:revoke)
+	InvitationsRevokeInvitation(w http.ResponseWriter, r *http.Request, invitationId string)
+
+	// (POST /invitations:accept)
+	InvitationsAcceptInvitation(w http.ResponseWriter, r *http.Request)
+
+	// (GET /orgs)
+	OrganizationsListOrganizations(w http.ResponseWriter, r *http.Request, params OrganizationsListOrganizationsParams)
+
+	// (POST /orgs)
+	OrganizationsCreateOrganization(w http.ResponseWriter, r *http.Request)
+
+	// (GET /orgs/{orgId})
+	OrganizationsGetOrganization(w http.ResponseWriter, r *http.Request, orgId string)
+
+	// (GET /orgs/{orgId}/users)
+	OrgUsersListOrgUsers(w http.ResponseWriter, r *http.Request, orgId string, params OrgUsersListOrgUsersParams)
+
+	// (POST /orgs/{orgId}/users)
+	OrgUsersCreateOrgUser(w http.ResponseWriter, r *http.Request, orgId string)
+
+	// (DELETE /orgs/{orgId}/users/{userId})
+	OrgUsersDeleteOrgUser(w http.ResponseWriter, r *http.Request, orgId string, userId string)
+
+	// (GET /orgs/{orgId}/users/{userId})
+	OrgUsersGetOrgUser(w http.ResponseWriter, r *http.Request, orgId string, userId string, params OrgUsersGetOrgUserParams)
+
+	// (PATCH /orgs/{orgId}/users/{userId})
+	OrgUsersPatchOrgUser(w http.ResponseWriter, r *http.Request, orgId string, userId string)
+
+	// (PUT /orgs/{orgId}/users/{userId})
+	OrgUsersUpdateOrgUser(w http.ResponseWriter, r *http.Request, orgId string, userId string)
+
+	// (GET /users)
+	UsersListUsers(w http.ResponseWriter, r *http.Request, params UsersListUsersParams)
+
+	// (POST /users)
+	UsersCreateUser(w http.ResponseWriter, r *http.Request)
+
+	// (DELETE /users/{userId})
+	UsersDeleteUser(w http.ResponseWriter, r *http.Request, userId string)
+
+	// (GET /users/{userId})
+	UsersGetUser(w http.ResponseWriter, r *http.Request, userId string, params UsersGetUserParams)
+
+	// (PATCH /users/{userId})
+	UsersPatchUser(w http.ResponseWriter, r *http.Request, userId string)
+
+	// (PUT /users/{userId})
+	UsersUpdateUser(w http.ResponseWriter, r *http.Request, userId string)
+
+	// (POST /users/{userId}/email-verification)
+	UserEmailVerificationRequestEmailVerification(w http.ResponseWriter, r *http.Request, userId string)
+
+	// (GET /users/{userId}/groups)
+	UserGroupsListUserGroups(w http.ResponseWriter, r *http.Request, userId string)
+
+	// (POST /users/{userId}/mfa/totp)
+	UserMFAEnrollTOTP(w http.ResponseWriter, r *http.Request, userId string)
+
+	// (POST /users/{userId}/mfa/totp:disable)
+	UserMFADisableTOTP(w http.ResponseWriter, r *http.Request, userId string)
+
+	// (POST /users/{userId}/mfa/totp:verify)
+	UserMFAVerifyTOTP(w http.ResponseWriter, r *http.Request, userId string)
+
+	// (POST /users/{userId}/password)
+	UserPasswordsChangePassword(w http.ResponseWriter, r *http.Request, userId string)
+
+	// (GET /users/{userId}/sessions)
+	UserSessionsListSessions(w http.ResponseWriter, r *http.Request, userId string)
+
+	// (POST /users/{userId}/sessions/{sessionId}:revoke)
+	UserSessionsRevokeSession(w http.ResponseWriter, r *http.Request, userId string, sessionId string)
+
+	// (POST /users/{userId}/sessions:revoke)
+	UserSessionsRevokeAllSessions(w http.ResponseWriter, r *http.Request, userId string)
+
+	// (POST /users/{userId}:deactivate)
+	UsersDeactivateUser(w http.ResponseWriter, r *http.Request, userId string)
+
+	// (POST /users/{userId}:reactivate)
+	UsersReactivateUser(w http.ResponseWriter, r *http.Request, userId string)
+
+	// (POST /users/{userId}:suspend)
+	UsersSuspendUser(w http.ResponseWriter, r *http.Request, userId string)
+
+	// (POST /users:batch)
+	UsersBatchUsers(w http.ResponseWriter, r *http.Request)
+
+	// (GET /users:watch)
+	UsersWatchUsers(w http.ResponseWriter, r *http.Request, params UsersWatchUsersParams)
+
+	// (GET /webhook-subscriptions)
+	WebhookSubscriptionsListWebhookSubscriptions(w http.ResponseWriter, r *http.Request, params WebhookSubscriptionsListWebhookSubscriptionsParams)
+
+	// (POST /webhook-subscriptions)
+	WebhookSubscriptionsCreateWebhookSubscription(w http.ResponseWriter, r *http.Request)
+
+	// (DELETE /webhook-subscriptions/{subscriptionId})
+	WebhookSubscriptionsDeleteWebhookSubscription(w http.ResponseWriter, r *http.Request, subscriptionId string)
+
+	// (GET /webhook-subscriptions/{subscriptionId})
+	WebhookSubscriptionsGetWebhookSubscription(w http.ResponseWriter, r *http.Request, subscriptionId string)
+
+	// (PUT /webhook-subscriptions/{subscriptionId})
+	WebhookSubscriptionsUpdateWebhookSubscription(w http.ResponseWriter, r *http.Request, subscriptionId string)
+
+	// (GET /webhook-subscriptions/{subscriptionId}/deliveries)
+	WebhookSubscriptionsListWebhookDeliveries(w http.ResponseWriter, r *http.Request, subscriptionId string, params WebhookSubscriptionsListWebhookDeliveriesParams)
+}
+
+// Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
+
+type Unimplemented struct{}
+
+// (POST /auth/email-verification:confirm)
+func (_ Unimplemented) AuthConfirmEmailVerification(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (POST /auth/login)
+func (_ Unimplemented) AuthLogin(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (POST /auth/mfa:verify)
+func (_ Unimplemented) AuthVerifyMFA(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (POST /auth/oidc/{provider}:authorize)
+func (_ Unimplemented) OIDCLoginAuthorize(w http.ResponseWriter, r *http.Request, provider string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (POST /auth/oidc/{provider}:callback)
+func (_ Unimplemented) OIDCLoginCallback(w http.ResponseWriter, r *http.Request, provider string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (POST /auth/password-reset)
+func (_ Unimplemented) AuthRequestPasswordReset(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (POST /auth/password-reset:confirm)
+func (_ Unimplemented) AuthConfirmPasswordReset(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (POST /groups)
+func (_ Unimplemented) GroupsCreateGroup(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (GET /groups/{groupId})
+func (_ Unimplemented) GroupsGetGroup(w http.ResponseWriter, r *http.Request, groupId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (GET /groups/{groupId}/members)
+func (_ Unimplemented) GroupsListGroupMembers(w http.ResponseWriter, r *http.Request, groupId string, params GroupsListGroupMembersParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (POST /groups/{groupId}/members:add)
+func (_ Unimplemented) GroupsAddGroupMembers(w http.ResponseWriter, r *http.Request, groupId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (POST /groups/{groupId}/members:remove)
+func (_ Unimplemented) GroupsRemoveGroupMembers(w http.ResponseWriter, r *http.Request, groupId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (GET /invitations)
+func (_ Unimplemented) InvitationsListInvitations(w http.ResponseWriter, r *http.Request, params InvitationsListInvitationsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (POST /invitations)
+func (_ Unimplemented) InvitationsCreateInvitation(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (POST /invitations/{invitationId}:resend)
+func (_ Unimplemented) InvitationsResendInvitation(w http.ResponseWriter, r *http.Request, invitationId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (POST /invitations/{invitationId}:revoke)
+func (_ Unimplemented) InvitationsRevokeInvitation(w http.ResponseWriter, r *http.Request, invitationId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (POST /invitations:accept)
+func (_ Unimplemented) InvitationsAcceptInvitation(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (GET /orgs)
+func (_ Unimplemented) OrganizationsListOrganizations(w http.ResponseWriter, r *http.Request, params OrganizationsListOrganizationsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (POST /orgs)
+func (_ Unimplemented) OrganizationsCreateOrganization(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (GET /orgs/{orgId})
+func (_ Unimplemented) OrganizationsGetOrganization(w http.ResponseWriter, r *http.Request, orgId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (GET /orgs/{orgId}/users)
+func (_ Unimplemented) OrgUsersListOrgUsers(w http.ResponseWriter, r *http.Request, orgId string, params OrgUsersListOrgUsersParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (POST /orgs/{orgId}/users)
+func (_ Unimplemented) OrgUsersCreateOrgUser(w http.ResponseWriter, r *http.Request, orgId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (DELETE /orgs/{orgId}/users/{userId})
+func (_ Unimplemented) OrgUsersDeleteOrgUser(w http.ResponseWriter, r *http.Request, orgId string, userId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (GET /orgs/{orgId}/users/{userId})
+func (_ Unimplemented) OrgUsersGetOrgUser(w http.ResponseWriter, r *http.Request, orgId string, userId string, params OrgUsersGetOrgUserParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (PATCH /orgs/{orgId}/users/{userId})
+func (_ Unimplemented) OrgUsersPatchOrgUser(w http.ResponseWriter, r *http.Request, orgId string, userId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (PUT /orgs/{orgId}/users/{userId})
+func (_ Unimplemented) OrgUsersUpdateOrgUser(w http.ResponseWriter, r *http.Request, orgId string, userId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (GET /users)
+func (_ Unimplemented) UsersListUsers(w http.ResponseWriter, r *http.Request, params UsersListUsersParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (POST /users)
+func (_ Unimplemented) UsersCreateUser(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (DELETE /users/{userId})
+func (_ Unimplemented) UsersDeleteUser(w http.ResponseWriter, r *http.Request, userId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (GET /users/{userId})
+func (_ Unimplemented) UsersGetUser(w http.ResponseWriter, r *http.Request, userId string, params UsersGetUserParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (PATCH /users/{userId})
+func (_ Unimplemented) UsersPatchUser(w http.ResponseWriter, r *http.Request, userId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (PUT /users/{userId})
+func (_ Unimplemented) UsersUpdateUser(w http.ResponseWriter, r *http.Request, userId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (POST /users/{userId}/email-verification)
+func (_ Unimplemented) UserEmailVerificationRequestEmailVerification(w http.ResponseWriter, r *http.Request, userId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (GET /users/{userId}/groups)
+func (_ Unimplemented) UserGroupsListUserGroups(w http.ResponseWriter, r *http.Request, userId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (POST /users/{userId}/mfa/totp)
+func (_ Unimplemented) UserMFAEnrollTOTP(w http.ResponseWriter, r *http.Request, userId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (POST /users/{userId}/mfa/totp:disable)
+func (_ Unimplemented) UserMFADisableTOTP(w http.ResponseWriter, r *http.Request, userId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (POST /users/{userId}/mfa/totp:verify)
+func (_ Unimplemented) UserMFAVerifyTOTP(w http.ResponseWriter, r *http.Request, userId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (POST /users/{userId}/password)
+func (_ Unimplemented) UserPasswordsChangePassword(w http.ResponseWriter, r *http.Request, userId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (GET /users/{userId}/sessions)
+func (_ Unimplemented) UserSessionsListSessions(w http.ResponseWriter, r *http.Request, userId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (POST /users/{userId}/sessions/{sessionId}:revoke)
+func (_ Unimplemented) UserSessionsRevokeSession(w http.ResponseWriter, r *http.Request, userId string, sessionId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (POST /users/{userId}/sessions:revoke)
+func (_ Unimplemented) UserSessionsRevokeAllSessions(w http.ResponseWriter, r *http.Request, userId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (POST /users/{userId}:deactivate)
+func (_ Unimplemented) UsersDeactivateUser(w http.ResponseWriter, r *http.Request, userId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (POST /users/{userId}:reactivate)
+func (_ Unimplemented) UsersReactivateUser(w http.ResponseWriter, r *http.Request, userId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (POST /users/{userId}:suspend)
+func (_ Unimplemented) UsersSuspendUser(w http.ResponseWriter, r *http.Request, userId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (POST /users:batch)
+func (_ Unimplemented) UsersBatchUsers(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (GET /users:watch)
+func (_ Unimplemented) UsersWatchUsers(w http.ResponseWriter, r *http.Request, params UsersWatchUsersParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (GET /webhook-subscriptions)
+func (_ Unimplemented) WebhookSubscriptionsListWebhookSubscriptions(w http.ResponseWriter, r *http.Request, params WebhookSubscriptionsListWebhookSubscriptionsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (POST /webhook-subscriptions)
+func (_ Unimplemented) WebhookSubscriptionsCreateWebhookSubscription(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (DELETE /webhook-subscriptions/{subscriptionId})
+func (_ Unimplemented) WebhookSubscriptionsDeleteWebhookSubscription(w http.ResponseWriter, r *http.Request, subscriptionId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (GET /webhook-subscriptions/{subscriptionId})
+func (_ Unimplemented) WebhookSubscriptionsGetWebhookSubscription(w http.ResponseWriter, r *http.Request, subscriptionId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (PUT /webhook-subscriptions/{subscriptionId})
+func (_ Unimplemented) WebhookSubscriptionsUpdateWebhookSubscription(w http.ResponseWriter, r *http.Request, subscriptionId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (GET /webhook-subscriptions/{subscriptionId}/deliveries)
+func (_ Unimplemented) WebhookSubscriptionsListWebhookDeliveries(w http.ResponseWriter, r *http.Request, subscriptionId string, params WebhookSubscriptionsListWebhookDeliveriesParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+type MiddlewareFunc func(http.Handler) http.Handler
+
+// AuthConfirmEmailVerification operation middleware
+func (siw *ServerInterfaceWrapper) AuthConfirmEmailVerification(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AuthConfirmEmailVerification(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// AuthLogin operation middleware
+func (siw *ServerInterfaceWrapper) AuthLogin(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AuthLogin(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// AuthVerifyMFA operation middleware
+func (siw *ServerInterfaceWrapper) AuthVerifyMFA(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AuthVerifyMFA(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// OIDCLoginAuthorize operation middleware
+func (siw *ServerInterfaceWrapper) OIDCLoginAuthorize(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "provider" -------------
+	var provider string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "provider", chi.URLParam(r, "provider"), &provider, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "provider", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.OIDCLoginAuthorize(w, r, provider)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// OIDCLoginCallback operation middleware
+func (siw *ServerInterfaceWrapper) OIDCLoginCallback(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "provider" -------------
+	var provider string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "provider", chi.URLParam(r, "provider"), &provider, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "provider", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.OIDCLoginCallback(w, r, provider)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// AuthRequestPasswordReset operation middleware
+func (siw *ServerInterfaceWrapper) AuthRequestPasswordReset(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AuthRequestPasswordReset(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// AuthConfirmPasswordReset operation middleware
+func (siw *ServerInterfaceWrapper) AuthConfirmPasswordReset(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AuthConfirmPasswordReset(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GroupsCreateGroup operation middleware
+func (siw *ServerInterfaceWrapper) GroupsCreateGroup(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GroupsCreateGroup(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GroupsGetGroup operation middleware
+func (siw *ServerInterfaceWrapper) GroupsGetGroup(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "groupId" -------------
+	var groupId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "groupId", chi.URLParam(r, "groupId"), &groupId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "groupId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GroupsGetGroup(w, r, groupId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GroupsListGroupMembers operation middleware
+func (siw *ServerInterfaceWrapper) GroupsListGroupMembers(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "groupId" -------------
+	var groupId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "groupId", chi.URLParam(r, "groupId"), &groupId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "groupId", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GroupsListGroupMembersParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GroupsListGroupMembers(w, r, groupId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GroupsAddGroupMembers operation middleware
+func (siw *ServerInterfaceWrapper) GroupsAddGroupMembers(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "groupId" -------------
+	var groupId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "groupId", chi.URLParam(r, "groupId"), &groupId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "groupId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GroupsAddGroupMembers(w, r, groupId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GroupsRemoveGroupMembers operation middleware
+func (siw *ServerInterfaceWrapper) GroupsRemoveGroupMembers(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "groupId" -------------
+	var groupId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "groupId", chi.URLParam(r, "groupId"), &groupId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "groupId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GroupsRemoveGroupMembers(w, r, groupId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// InvitationsListInvitations operation middleware
+func (siw *ServerInterfaceWrapper) InvitationsListInvitations(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params InvitationsListInvitationsParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.InvitationsListInvitations(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// InvitationsCreateInvitation operation middleware
+func (siw *ServerInterfaceWrapper) InvitationsCreateInvitation(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.InvitationsCreateInvitation(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// InvitationsResendInvitation operation middleware
+func (siw *ServerInterfaceWrapper) InvitationsResendInvitation(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "invitationId" -------------
+	var invitationId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "invitationId", chi.URLParam(r, "invitationId"), &invitationId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "invitationId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.InvitationsResendInvitation(w, r, invitationId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// InvitationsRevokeInvitation operation middleware
+func (siw *ServerInterfaceWrapper) InvitationsRevokeInvitation(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "invitationId" -------------
+	var invitationId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "invitationId", chi.URLParam(r, "invitationId"), &invitationId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "invitationId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.InvitationsRevokeInvitation(w, r, invitationId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// InvitationsAcceptInvitation operation middleware
+func (siw *ServerInterfaceWrapper) InvitationsAcceptInvitation(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.InvitationsAcceptInvitation(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// OrganizationsListOrganizations operation middleware
+func (siw *ServerInterfaceWrapper) OrganizationsListOrganizations(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params OrganizationsListOrganizationsParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.OrganizationsListOrganizations(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// OrganizationsCreateOrganization operation middleware
+func (siw *ServerInterfaceWrapper) OrganizationsCreateOrganization(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.OrganizationsCreateOrganization(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// OrganizationsGetOrganization operation middleware
+func (siw *ServerInterfaceWrapper) OrganizationsGetOrganization(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "orgId" -------------
+	var orgId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "orgId", chi.URLParam(r, "orgId"), &orgId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "orgId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.OrganizationsGetOrganization(w, r, orgId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// OrgUsersListOrgUsers operation middleware
+func (siw *ServerInterfaceWrapper) OrgUsersListOrgUsers(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "orgId" -------------
+	var orgId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "orgId", chi.URLParam(r, "orgId"), &orgId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "orgId", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params OrgUsersListOrgUsersParams
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "status", r.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-None-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-None-Match")]; found {
+		var IfNoneMatch string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-None-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-None-Match", valueList[0], &IfNoneMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-None-Match", Err: err})
+			return
+		}
+
+		params.IfNoneMatch = &IfNoneMatch
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.OrgUsersListOrgUsers(w, r, orgId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// OrgUsersCreateOrgUser operation middleware
+func (siw *ServerInterfaceWrapper) OrgUsersCreateOrgUser(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "orgId" -------------
+	var orgId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "orgId", chi.URLParam(r, "orgId"), &orgId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "orgId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.OrgUsersCreateOrgUser(w, r, orgId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// OrgUsersDeleteOrgUser operation middleware
+func (siw *ServerInterfaceWrapper) OrgUsersDeleteOrgUser(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "orgId" -------------
+	var orgId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "orgId", chi.URLParam(r, "orgId"), &orgId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "orgId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "userId" -------------
+	var userId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "userId", chi.URLParam(r, "userId"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.OrgUsersDeleteOrgUser(w, r, orgId, userId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// OrgUsersGetOrgUser operation middleware
+func (siw *ServerInterfaceWrapper) OrgUsersGetOrgUser(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "orgId" -------------
+	var orgId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "orgId", chi.URLParam(r, "orgId"), &orgId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "orgId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "userId" -------------
+	var userId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "userId", chi.URLParam(r, "userId"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userId", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params OrgUsersGetOrgUserParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-None-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-None-Match")]; found {
+		var IfNoneMatch string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-None-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-None-Match", valueList[0], &IfNoneMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-None-Match", Err: err})
+			return
+		}
+
+		params.IfNoneMatch = &IfNoneMatch
+
+	}
+
+	// ------------- Optional header parameter "If-Modified-Since" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Modified-Since")]; found {
+		var IfModifiedSince string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Modified-Since", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Modified-Since", valueList[0], &IfModifiedSince, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Modified-Since", Err: err})
+			return
+		}
+
+		params.IfModifiedSince = &IfModifiedSince
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.OrgUsersGetOrgUser(w, r, orgId, userId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// OrgUsersPatchOrgUser operation middleware
+func (siw *ServerInterfaceWrapper) OrgUsersPatchOrgUser(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "orgId" -------------
+	var orgId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "orgId", chi.URLParam(r, "orgId"), &orgId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "orgId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "userId" -------------
+	var userId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "userId", chi.URLParam(r, "userId"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.OrgUsersPatchOrgUser(w, r, orgId, userId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// OrgUsersUpdateOrgUser operation middleware
+func (siw *ServerInterfaceWrapper) OrgUsersUpdateOrgUser(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "orgId" -------------
+	var orgId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "orgId", chi.URLParam(r, "orgId"), &orgId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "orgId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "userId" -------------
+	var userId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "userId", chi.URLParam(r, "userId"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.OrgUsersUpdateOrgUser(w, r, orgId, userId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UsersListUsers operation middleware
+func (siw *ServerInterfaceWrapper) UsersListUsers(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UsersListUsersParams
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "status", r.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-None-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-None-Match")]; found {
+		var IfNoneMatch string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-None-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-None-Match", valueList[0], &IfNoneMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-None-Match", Err: err})
+			return
+		}
+
+		params.IfNoneMatch = &IfNoneMatch
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UsersListUsers(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UsersCreateUser operation middleware
+func (siw *ServerInterfaceWrapper) UsersCreateUser(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UsersCreateUser(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UsersDeleteUser operation middleware
+func (siw *ServerInterfaceWrapper) UsersDeleteUser(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "userId" -------------
+	var userId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "userId", chi.URLParam(r, "userId"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UsersDeleteUser(w, r, userId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UsersGetUser operation middleware
+func (siw *ServerInterfaceWrapper) UsersGetUser(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "userId" -------------
+	var userId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "userId", chi.URLParam(r, "userId"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userId", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UsersGetUserParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-None-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-None-Match")]; found {
+		var IfNoneMatch string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-None-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-None-Match", valueList[0], &IfNoneMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-None-Match", Err: err})
+			return
+		}
+
+		params.IfNoneMatch = &IfNoneMatch
+
+	}
+
+	// ------------- Optional header parameter "If-Modified-Since" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Modified-Since")]; found {
+		var IfModifiedSince string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Modified-Since", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Modified-Since", valueList[0], &IfModifiedSince, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Modified-Since", Err: err})
+			return
+		}
+
+		params.IfModifiedSince = &IfModifiedSince
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UsersGetUser(w, r, userId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UsersPatchUser operation middleware
+func (siw *ServerInterfaceWrapper) UsersPatchUser(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "userId" -------------
+	var userId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "userId", chi.URLParam(r, "userId"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UsersPatchUser(w, r, userId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UsersUpdateUser operation middleware
+func (siw *ServerInterfaceWrapper) UsersUpdateUser(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "userId" -------------
+	var userId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "userId", chi.URLParam(r, "userId"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UsersUpdateUser(w, r, userId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UserEmailVerificationRequestEmailVerification operation middleware
+func (siw *ServerInterfaceWrapper) UserEmailVerificationRequestEmailVerification(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "userId" -------------
+	var userId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "userId", chi.URLParam(r, "userId"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UserEmailVerificationRequestEmailVerification(w, r, userId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UserGroupsListUserGroups operation middleware
+func (siw *ServerInterfaceWrapper) UserGroupsListUserGroups(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "userId" -------------
+	var userId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "userId", chi.URLParam(r, "userId"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UserGroupsListUserGroups(w, r, userId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UserMFAEnrollTOTP operation middleware
+func (siw *ServerInterfaceWrapper) UserMFAEnrollTOTP(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "userId" -------------
+	var userId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "userId", chi.URLParam(r, "userId"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UserMFAEnrollTOTP(w, r, userId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UserMFADisableTOTP operation middleware
+func (siw *ServerInterfaceWrapper) UserMFADisableTOTP(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "userId" -------------
+	var userId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "userId", chi.URLParam(r, "userId"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UserMFADisableTOTP(w, r, userId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UserMFAVerifyTOTP operation middleware
+func (siw *ServerInterfaceWrapper) UserMFAVerifyTOTP(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "userId" -------------
+	var userId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "userId", chi.URLParam(r, "userId"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UserMFAVerifyTOTP(w, r, userId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UserPasswordsChangePassword operation middleware
+func (siw *ServerInterfaceWrapper) UserPasswordsChangePassword(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "userId" -------------
+	var userId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "userId", chi.URLParam(r, "userId"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UserPasswordsChangePassword(w, r, userId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UserSessionsListSessions operation middleware
+func (siw *ServerInterfaceWrapper) UserSessionsListSessions(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "userId" -------------
+	var userId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "userId", chi.URLParam(r, "userId"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UserSessionsListSessions(w, r, userId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UserSessionsRevokeSession operation middleware
+func (siw *ServerInterfaceWrapper) UserSessionsRevokeSession(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "userId" -------------
+	var userId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "userId", chi.URLParam(r, "userId"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "sessionId" -------------
+	var sessionId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "sessionId", chi.URLParam(r, "sessionId"), &sessionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sessionId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UserSessionsRevokeSession(w, r, userId, sessionId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UserSessionsRevokeAllSessions operation middleware
+func (siw *ServerInterfaceWrapper) UserSessionsRevokeAllSessions(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "userId" -------------
+	var userId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "userId", chi.URLParam(r, "userId"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UserSessionsRevokeAllSessions(w, r, userId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UsersDeactivateUser operation middleware
+func (siw *ServerInterfaceWrapper) UsersDeactivateUser(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "userId" -------------
+	var userId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "userId", chi.URLParam(r, "userId"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UsersDeactivateUser(w, r, userId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UsersReactivateUser operation middleware
+func (siw *ServerInterfaceWrapper) UsersReactivateUser(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "userId" -------------
+	var userId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "userId", chi.URLParam(r, "userId"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UsersReactivateUser(w, r, userId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-// UsersListUsersParams defines parameters for UsersListUsers.
-type UsersListUsersParams struct {
-	// Limit Maximum number of users to return
-	Limit *int32 `form:"limit,omitempty" json:"limit,omitempty"`
+// UsersSuspendUser operation middleware
+func (siw *ServerInterfaceWrapper) UsersSuspendUser(w http.ResponseWriter, r *http.Request) {
 
-	// Offset Number of users to skip
-	Offset *int32 `form:"offset,omitempty" json:"offset,omitempty"`
-}
+	var err error
 
-// UsersCreateUserJSONRequestBody defines body for UsersCreateUser for application/json ContentType.
-type UsersCreateUserJSONRequestBody = CreateUserRequest
+	// ------------- Path parameter "userId" -------------
+	var userId string
 
-// UsersUpdateUserJSONRequestBody defines body for UsersUpdateUser for application/json ContentType.
-type UsersUpdateUserJSONRequestBody = UpdateUserRequest
+	err = runtime.BindStyledParameterWithOptions("simple", "userId", chi.URLParam(r, "userId"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userId", Err: err})
+		return
+	}
 
-// ServerInterface represents all server handlers.
-type ServerInterface interface {
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UsersSuspendUser(w, r, userId)
+	}))
 
-	// (GET /users)
-	UsersListUsers(w http.ResponseWriter, r *http.Request, params UsersListUsersParams)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	// (POST /users)
-	UsersCreateUser(w http.ResponseWriter, r *http.Request)
+	handler.ServeHTTP(w, r)
+}
 
-	// (DELETE /users/{userId})
-	UsersDeleteUser(w http.ResponseWriter, r *http.Request, userId string)
+// UsersBatchUsers operation middleware
+func (siw *ServerInterfaceWrapper) UsersBatchUsers(w http.ResponseWriter, r *http.Request) {
 
-	// (GET /users/{userId})
-	UsersGetUser(w http.ResponseWriter, r *http.Request, userId string)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UsersBatchUsers(w, r)
+	}))
 
-	// (PUT /users/{userId})
-	UsersUpdateUser(w http.ResponseWriter, r *http.Request, userId string)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-// Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
+// UsersWatchUsers operation middleware
+func (siw *ServerInterfaceWrapper) UsersWatchUsers(w http.ResponseWriter, r *http.Request) {
 
-type Unimplemented struct{}
+	var err error
 
-// (GET /users)
-func (_ Unimplemented) UsersListUsers(w http.ResponseWriter, r *http.Request, params UsersListUsersParams) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UsersWatchUsersParams
 
-// (POST /users)
-func (_ Unimplemented) UsersCreateUser(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	headers := r.Header
 
-// (DELETE /users/{userId})
-func (_ Unimplemented) UsersDeleteUser(w http.ResponseWriter, r *http.Request, userId string) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	// ------------- Optional header parameter "Last-Event-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Last-Event-ID")]; found {
+		var LastEventID string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Last-Event-ID", Count: n})
+			return
+		}
 
-// (GET /users/{userId})
-func (_ Unimplemented) UsersGetUser(w http.ResponseWriter, r *http.Request, userId string) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+		err = runtime.BindStyledParameterWithOptions("simple", "Last-Event-ID", valueList[0], &LastEventID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Last-Event-ID", Err: err})
+			return
+		}
 
-// (PUT /users/{userId})
-func (_ Unimplemented) UsersUpdateUser(w http.ResponseWriter, r *http.Request, userId string) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+		params.LastEventID = &LastEventID
 
-// ServerInterfaceWrapper converts contexts to parameters.
-type ServerInterfaceWrapper struct {
-	Handler            ServerInterface
-	HandlerMiddlewares []MiddlewareFunc
-	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
-}
+	}
 
-type MiddlewareFunc func(http.Handler) http.Handler
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UsersWatchUsers(w, r, params)
+	}))
 
-// UsersListUsers operation middleware
-func (siw *ServerInterfaceWrapper) UsersListUsers(w http.ResponseWriter, r *http.Request) {
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// WebhookSubscriptionsListWebhookSubscriptions operation middleware
+func (siw *ServerInterfaceWrapper) WebhookSubscriptionsListWebhookSubscriptions(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params UsersListUsersParams
+	var params WebhookSubscriptionsListWebhookSubscriptionsParams
 
 	// ------------- Optional query parameter "limit" -------------
 
@@ -164,7 +2641,7 @@ func (siw *ServerInterfaceWrapper) UsersListUsers(w http.ResponseWriter, r *http
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UsersListUsers(w, r, params)
+		siw.Handler.WebhookSubscriptionsListWebhookSubscriptions(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -174,11 +2651,11 @@ func (siw *ServerInterfaceWrapper) UsersListUsers(w http.ResponseWriter, r *http
 	handler.ServeHTTP(w, r)
 }
 
-// UsersCreateUser operation middleware
-func (siw *ServerInterfaceWrapper) UsersCreateUser(w http.ResponseWriter, r *http.Request) {
+// WebhookSubscriptionsCreateWebhookSubscription operation middleware
+func (siw *ServerInterfaceWrapper) WebhookSubscriptionsCreateWebhookSubscription(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UsersCreateUser(w, r)
+		siw.Handler.WebhookSubscriptionsCreateWebhookSubscription(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -188,22 +2665,22 @@ func (siw *ServerInterfaceWrapper) UsersCreateUser(w http.ResponseWriter, r *htt
 	handler.ServeHTTP(w, r)
 }
 
-// UsersDeleteUser operation middleware
-func (siw *ServerInterfaceWrapper) UsersDeleteUser(w http.ResponseWriter, r *http.Request) {
+// WebhookSubscriptionsDeleteWebhookSubscription operation middleware
+func (siw *ServerInterfaceWrapper) WebhookSubscriptionsDeleteWebhookSubscription(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "userId" -------------
-	var userId string
+	// ------------- Path parameter "subscriptionId" -------------
+	var subscriptionId string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "userId", chi.URLParam(r, "userId"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "subscriptionId", chi.URLParam(r, "subscriptionId"), &subscriptionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "subscriptionId", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UsersDeleteUser(w, r, userId)
+		siw.Handler.WebhookSubscriptionsDeleteWebhookSubscription(w, r, subscriptionId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -213,22 +2690,22 @@ func (siw *ServerInterfaceWrapper) UsersDeleteUser(w http.ResponseWriter, r *htt
 	handler.ServeHTTP(w, r)
 }
 
-// UsersGetUser operation middleware
-func (siw *ServerInterfaceWrapper) UsersGetUser(w http.ResponseWriter, r *http.Request) {
+// WebhookSubscriptionsGetWebhookSubscription operation middleware
+func (siw *ServerInterfaceWrapper) WebhookSubscriptionsGetWebhookSubscription(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "userId" -------------
-	var userId string
+	// ------------- Path parameter "subscriptionId" -------------
+	var subscriptionId string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "userId", chi.URLParam(r, "userId"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "subscriptionId", chi.URLParam(r, "subscriptionId"), &subscriptionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "subscriptionId", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UsersGetUser(w, r, userId)
+		siw.Handler.WebhookSubscriptionsGetWebhookSubscription(w, r, subscriptionId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -238,22 +2715,74 @@ func (siw *ServerInterfaceWrapper) UsersGetUser(w http.ResponseWriter, r *http.R
 	handler.ServeHTTP(w, r)
 }
 
-// UsersUpdateUser operation middleware
-func (siw *ServerInterfaceWrapper) UsersUpdateUser(w http.ResponseWriter, r *http.Request) {
+// WebhookSubscriptionsUpdateWebhookSubscription operation middleware
+func (siw *ServerInterfaceWrapper) WebhookSubscriptionsUpdateWebhookSubscription(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "userId" -------------
-	var userId string
+	// ------------- Path parameter "subscriptionId" -------------
+	var subscriptionId string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "userId", chi.URLParam(r, "userId"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "subscriptionId", chi.URLParam(r, "subscriptionId"), &subscriptionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "subscriptionId", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UsersUpdateUser(w, r, userId)
+		siw.Handler.WebhookSubscriptionsUpdateWebhookSubscription(w, r, subscriptionId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// WebhookSubscriptionsListWebhookDeliveries operation middleware
+func (siw *ServerInterfaceWrapper) WebhookSubscriptionsListWebhookDeliveries(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "subscriptionId" -------------
+	var subscriptionId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "subscriptionId", chi.URLParam(r, "subscriptionId"), &subscriptionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "subscriptionId", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params WebhookSubscriptionsListWebhookDeliveriesParams
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "status", r.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.WebhookSubscriptionsListWebhookDeliveries(w, r, subscriptionId, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -376,6 +2905,84 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 		ErrorHandlerFunc:   options.ErrorHandlerFunc,
 	}
 
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/auth/email-verification:confirm", wrapper.AuthConfirmEmailVerification)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/auth/login", wrapper.AuthLogin)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/auth/mfa:verify", wrapper.AuthVerifyMFA)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/auth/oidc/{provider}:authorize", wrapper.OIDCLoginAuthorize)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/auth/oidc/{provider}:callback", wrapper.OIDCLoginCallback)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/auth/password-reset", wrapper.AuthRequestPasswordReset)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/auth/password-reset:confirm", wrapper.AuthConfirmPasswordReset)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/groups", wrapper.GroupsCreateGroup)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/groups/{groupId}", wrapper.GroupsGetGroup)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/groups/{groupId}/members", wrapper.GroupsListGroupMembers)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/groups/{groupId}/members:add", wrapper.GroupsAddGroupMembers)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/groups/{groupId}/members:remove", wrapper.GroupsRemoveGroupMembers)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/invitations", wrapper.InvitationsListInvitations)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/invitations", wrapper.InvitationsCreateInvitation)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/invitations/{invitationId}:resend", wrapper.InvitationsResendInvitation)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/invitations/{invitationId}:revoke", wrapper.InvitationsRevokeInvitation)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/invitations:accept", wrapper.InvitationsAcceptInvitation)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/orgs", wrapper.OrganizationsListOrganizations)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/orgs", wrapper.OrganizationsCreateOrganization)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/orgs/{orgId}", wrapper.OrganizationsGetOrganization)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/orgs/{orgId}/users", wrapper.OrgUsersListOrgUsers)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/orgs/{orgId}/users", wrapper.OrgUsersCreateOrgUser)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/orgs/{orgId}/users/{userId}", wrapper.OrgUsersDeleteOrgUser)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/orgs/{orgId}/users/{userId}", wrapper.OrgUsersGetOrgUser)
+	})
+	r.Group(func(r chi.Router) {
+		r.Patch(options.BaseURL+"/orgs/{orgId}/users/{userId}", wrapper.OrgUsersPatchOrgUser)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/orgs/{orgId}/users/{userId}", wrapper.OrgUsersUpdateOrgUser)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/users", wrapper.UsersListUsers)
 	})
@@ -388,9 +2995,72 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/users/{userId}", wrapper.UsersGetUser)
 	})
+	r.Group(func(r chi.Router) {
+		r.Patch(options.BaseURL+"/users/{userId}", wrapper.UsersPatchUser)
+	})
 	r.Group(func(r chi.Router) {
 		r.Put(options.BaseURL+"/users/{userId}", wrapper.UsersUpdateUser)
 	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/users/{userId}/email-verification", wrapper.UserEmailVerificationRequestEmailVerification)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/users/{userId}/groups", wrapper.UserGroupsListUserGroups)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/users/{userId}/mfa/totp", wrapper.UserMFAEnrollTOTP)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/users/{userId}/mfa/totp:disable", wrapper.UserMFADisableTOTP)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/users/{userId}/mfa/totp:verify", wrapper.UserMFAVerifyTOTP)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/users/{userId}/password", wrapper.UserPasswordsChangePassword)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/users/{userId}/sessions", wrapper.UserSessionsListSessions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/users/{userId}/sessions/{sessionId}:revoke", wrapper.UserSessionsRevokeSession)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/users/{userId}/sessions:revoke", wrapper.UserSessionsRevokeAllSessions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/users/{userId}:deactivate", wrapper.UsersDeactivateUser)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/users/{userId}:reactivate", wrapper.UsersReactivateUser)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/users/{userId}:suspend", wrapper.UsersSuspendUser)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/users:batch", wrapper.UsersBatchUsers)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/users:watch", wrapper.UsersWatchUsers)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/webhook-subscriptions", wrapper.WebhookSubscriptionsListWebhookSubscriptions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/webhook-subscriptions", wrapper.WebhookSubscriptionsCreateWebhookSubscription)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/webhook-subscriptions/{subscriptionId}", wrapper.WebhookSubscriptionsDeleteWebhookSubscription)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/webhook-subscriptions/{subscriptionId}", wrapper.WebhookSubscriptionsGetWebhookSubscription)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/webhook-subscriptions/{subscriptionId}", wrapper.WebhookSubscriptionsUpdateWebhookSubscription)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/webhook-subscriptions/{subscriptionId}/deliveries", wrapper.WebhookSubscriptionsListWebhookDeliveries)
+	})
 
 	return r
 }
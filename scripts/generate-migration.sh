@@ -26,8 +26,8 @@ echo "Output file: $MIGRATION_FILE"
 echo ""
 
 # psqldefでdry-runを実行してマイグレーションSQLを生成
-go tool psqldef -U postgres -p 5432 -h localhost app_db --password=postgres \
-    --file=db/schema/schema.sql --dry-run > "$MIGRATION_FILE"
+cat db/schema/*.sql | go tool psqldef -U postgres -p 5432 -h localhost app_db --password=postgres \
+    --dry-run > "$MIGRATION_FILE"
 
 # ファイルが空でないかチェック
 if [ -s "$MIGRATION_FILE" ]; then
@@ -10,4 +10,5 @@ export * from './error';
 export * from './updateUserRequest';
 export * from './user';
 export * from './userList';
+export * from './userStatus';
 export * from './usersListUsersParams';
\ No newline at end of file
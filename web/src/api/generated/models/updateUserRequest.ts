@@ -6,7 +6,7 @@
  */
 
 /**
- * Update user request
+ * Update user request (replaces all fields of the user)
  */
 export interface UpdateUserRequest {
   /**
@@ -14,7 +14,7 @@ export interface UpdateUserRequest {
    * @minLength 1
    * @maxLength 100
    */
-  name?: string;
+  name: string;
   /** User email address */
-  email?: string;
+  email: string;
 }
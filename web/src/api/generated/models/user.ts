@@ -4,6 +4,7 @@
  * User Management API
  * OpenAPI spec version: 0.0.0
  */
+import type { UserStatus } from './userStatus';
 
 /**
  * User model
@@ -19,6 +20,10 @@ export interface User {
   name: string;
   /** User email address */
   email: string;
+  /** Account status */
+  status: UserStatus;
+  /** Email verification timestamp (absent until the email address is verified) */
+  emailVerifiedAt?: string;
   /** Creation timestamp */
   createdAt: string;
   /** Last update timestamp */
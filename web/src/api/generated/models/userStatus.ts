@@ -0,0 +1,20 @@
+/**
+ * Generated by orval v7.14.0 🍺
+ * Do not edit manually.
+ * User Management API
+ * OpenAPI spec version: 0.0.0
+ */
+
+/**
+ * Account status of a user
+ */
+export type UserStatus = typeof UserStatus[keyof typeof UserStatus];
+
+
+// eslint-disable-next-line @typescript-eslint/no-redeclare
+export const UserStatus = {
+  invited: 'invited',
+  active: 'active',
+  suspended: 'suspended',
+  deactivated: 'deactivated',
+} as const;
@@ -4,8 +4,13 @@
  * User Management API
  * OpenAPI spec version: 0.0.0
  */
+import type { UserStatus } from './userStatus';
 
 export type UsersListUsersParams = {
+/**
+ * Filter by account status
+ */
+status?: UserStatus;
 /**
  * Maximum number of users to return
  * @minimum 1
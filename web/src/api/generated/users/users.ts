@@ -276,7 +276,7 @@ export function useUsersGetUser<TData = Awaited<ReturnType<typeof usersGetUser>>
 
 
 /**
- * Update user
+ * Replace user (all fields are required; use patchUser for partial updates)
  */
 export const usersUpdateUser = (
     userId: string,
@@ -8,6 +8,7 @@ const mockUser: User = {
   id: '123',
   name: 'John Doe',
   email: 'john@example.com',
+  status: 'active',
   createdAt: '2024-01-01T00:00:00Z',
   updatedAt: '2024-01-02T00:00:00Z',
 }
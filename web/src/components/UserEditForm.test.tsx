@@ -8,6 +8,7 @@ const mockUser: User = {
   id: '123',
   name: 'John Doe',
   email: 'john@example.com',
+  status: 'active',
   createdAt: '2024-01-01T00:00:00Z',
   updatedAt: '2024-01-02T00:00:00Z',
 }
@@ -192,6 +193,7 @@ describe('UserEditForm', () => {
       id: '456',
       name: 'Jane Smith',
       email: 'jane@example.com',
+      status: 'active',
       createdAt: '2024-01-03T00:00:00Z',
       updatedAt: '2024-01-04T00:00:00Z',
     }
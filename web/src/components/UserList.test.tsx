@@ -9,6 +9,7 @@ const mockUsers: User[] = [
     id: '1',
     name: 'John Doe',
     email: 'john@example.com',
+    status: 'active',
     createdAt: '2024-01-01T00:00:00Z',
     updatedAt: '2024-01-01T00:00:00Z',
   },
@@ -16,6 +17,7 @@ const mockUsers: User[] = [
     id: '2',
     name: 'Jane Smith',
     email: 'jane@example.com',
+    status: 'active',
     createdAt: '2024-01-02T00:00:00Z',
     updatedAt: '2024-01-02T00:00:00Z',
   },
@@ -0,0 +1,39 @@
+import { useEffect } from 'react'
+import { useQueryClient } from '@tanstack/react-query'
+import {
+  getUsersListUsersQueryKey,
+  getUsersGetUserQueryKey,
+} from '../api/generated/users/users'
+
+const USER_EVENT_TYPES = ['UserCreated', 'UserUpdated', 'UserDeleted'] as const
+
+/**
+ * /users:watch の Server-Sent Events を購読し、他の管理者による変更でユーザーのクエリを無効化する
+ *
+ * 切断時は EventSource が Last-Event-ID 付きで自動的に再接続し、取りこぼした変更を受け取る。
+ */
+export function useUserChangeStream() {
+  const queryClient = useQueryClient()
+
+  useEffect(() => {
+    const source = new EventSource('/api/v1/users:watch')
+
+    const handleChange = (event: MessageEvent<string>) => {
+      queryClient.invalidateQueries({ queryKey: getUsersListUsersQueryKey() })
+      try {
+        const { user } = JSON.parse(event.data) as { user: { id: string } }
+        queryClient.invalidateQueries({ queryKey: getUsersGetUserQueryKey(user.id) })
+      } catch {
+        // 一覧の無効化だけで十分
+      }
+    }
+    // 再送できない変更があった場合はすべて取り直す
+    const handleReset = () => {
+      queryClient.invalidateQueries()
+    }
+
+    USER_EVENT_TYPES.forEach((type) => source.addEventListener(type, handleChange))
+    source.addEventListener('reset', handleReset)
+    return () => source.close()
+  }, [queryClient])
+}
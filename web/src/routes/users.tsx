@@ -15,6 +15,7 @@ import { UserList } from '../components/UserList'
 import { UserCreateForm } from '../components/UserCreateForm'
 import { UserDetail } from '../components/UserDetail'
 import { UserEditForm } from '../components/UserEditForm'
+import { useUserChangeStream } from '../hooks/useUserChangeStream'
 
 const usersSearchSchema = z.object({
   userId: z.string().optional(),
@@ -36,6 +37,9 @@ function Users() {
   const showCreateForm = search.showCreate ?? false
   const isEditMode = search.isEdit ?? false
 
+  // 他の管理者による変更をリアルタイムに反映する
+  useUserChangeStream()
+
   // Queries
   const { data: usersList, isLoading: isLoadingList, error: listError } = useUsersListUsers()
   const {